@@ -1,4 +1,57 @@
-data "snowflake_alerts" "current" {
-  database = "MYDB"
-  schema   = "MYSCHEMA"
-}
\ No newline at end of file
+# Simple usage
+data "snowflake_alerts" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_alerts.simple.alerts
+}
+
+# Filtering (like)
+data "snowflake_alerts" "like" {
+  like = "alert-name"
+}
+
+output "like_output" {
+  value = data.snowflake_alerts.like.alerts
+}
+
+# Filtering (starts_with)
+data "snowflake_alerts" "starts_with" {
+  starts_with = "prefix-"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_alerts.starts_with.alerts
+}
+
+# Filtering (limit)
+data "snowflake_alerts" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_alerts.limit.alerts
+}
+
+# Filtering (in)
+data "snowflake_alerts" "in" {
+  in {
+    schema = "<database_name>.<schema_name>"
+  }
+}
+
+output "in_output" {
+  value = data.snowflake_alerts.in.alerts
+}
+
+# Without the additional DESCRIBE ALERT for each alert
+data "snowflake_alerts" "without_describe" {
+  with_describe = false
+}
+
+output "without_describe_output" {
+  value = data.snowflake_alerts.without_describe.alerts
+}
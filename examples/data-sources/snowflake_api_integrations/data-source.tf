@@ -0,0 +1,25 @@
+# Simple usage
+data "snowflake_api_integrations" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_api_integrations.simple.api_integrations
+}
+
+# Filtering (like)
+data "snowflake_api_integrations" "like" {
+  like = "api-integration-name"
+}
+
+output "like_output" {
+  value = data.snowflake_api_integrations.like.api_integrations
+}
+
+# Without the additional DESCRIBE API INTEGRATION for each API integration
+data "snowflake_api_integrations" "without_describe" {
+  with_describe = false
+}
+
+output "without_describe_output" {
+  value = data.snowflake_api_integrations.without_describe.api_integrations
+}
@@ -0,0 +1,46 @@
+# Simple usage
+data "snowflake_application_packages" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_application_packages.simple.application_packages
+}
+
+# Filtering (like)
+data "snowflake_application_packages" "like" {
+  like = "application-package-name"
+}
+
+output "like_output" {
+  value = data.snowflake_application_packages.like.application_packages
+}
+
+# Filtering (starts_with)
+data "snowflake_application_packages" "starts_with" {
+  starts_with = "prefix-"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_application_packages.starts_with.application_packages
+}
+
+# Filtering (limit)
+data "snowflake_application_packages" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_application_packages.limit.application_packages
+}
+
+# Without the additional DESCRIBE APPLICATION PACKAGE for each application package
+data "snowflake_application_packages" "without_describe" {
+  with_describe = false
+}
+
+output "without_describe_output" {
+  value = data.snowflake_application_packages.without_describe.application_packages
+}
@@ -0,0 +1,57 @@
+# Simple usage
+data "snowflake_event_tables" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_event_tables.simple.event_tables
+}
+
+# Filtering (like)
+data "snowflake_event_tables" "like" {
+  like = "event-table-name"
+}
+
+output "like_output" {
+  value = data.snowflake_event_tables.like.event_tables
+}
+
+# Filtering (starts_with)
+data "snowflake_event_tables" "starts_with" {
+  starts_with = "prefix-"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_event_tables.starts_with.event_tables
+}
+
+# Filtering (limit)
+data "snowflake_event_tables" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_event_tables.limit.event_tables
+}
+
+# Filtering (in)
+data "snowflake_event_tables" "in" {
+  in {
+    schema = "<database_name>.<schema_name>"
+  }
+}
+
+output "in_output" {
+  value = data.snowflake_event_tables.in.event_tables
+}
+
+# Without the additional DESCRIBE EVENT TABLE for each event table
+data "snowflake_event_tables" "without_describe" {
+  with_describe = false
+}
+
+output "without_describe_output" {
+  value = data.snowflake_event_tables.without_describe.event_tables
+}
@@ -0,0 +1,25 @@
+# Simple usage
+data "snowflake_external_access_integrations" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_external_access_integrations.simple.external_access_integrations
+}
+
+# Filtering (like)
+data "snowflake_external_access_integrations" "like" {
+  like = "external-access-integration-name"
+}
+
+output "like_output" {
+  value = data.snowflake_external_access_integrations.like.external_access_integrations
+}
+
+# Without the additional DESCRIBE EXTERNAL ACCESS INTEGRATION for each external access integration
+data "snowflake_external_access_integrations" "without_describe" {
+  with_describe = false
+}
+
+output "without_describe_output" {
+  value = data.snowflake_external_access_integrations.without_describe.external_access_integrations
+}
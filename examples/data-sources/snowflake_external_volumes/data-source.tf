@@ -0,0 +1,25 @@
+# Simple usage
+data "snowflake_external_volumes" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_external_volumes.simple.external_volumes
+}
+
+# Filtering (like)
+data "snowflake_external_volumes" "like" {
+  like = "external-volume-name"
+}
+
+output "like_output" {
+  value = data.snowflake_external_volumes.like.external_volumes
+}
+
+# Without the additional DESCRIBE EXTERNAL VOLUME for each external volume
+data "snowflake_external_volumes" "without_describe" {
+  with_describe = false
+}
+
+output "without_describe_output" {
+  value = data.snowflake_external_volumes.without_describe.external_volumes
+}
@@ -0,0 +1,76 @@
+# Simple usage
+data "snowflake_iceberg_tables" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_iceberg_tables.simple.iceberg_tables
+}
+
+# Filtering (like)
+data "snowflake_iceberg_tables" "like" {
+  like = "iceberg-table-name"
+}
+
+output "like_output" {
+  value = data.snowflake_iceberg_tables.like.iceberg_tables
+}
+
+# Filtering (starts_with)
+data "snowflake_iceberg_tables" "starts_with" {
+  starts_with = "prefix-"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_iceberg_tables.starts_with.iceberg_tables
+}
+
+# Filtering (in)
+data "snowflake_iceberg_tables" "in_account" {
+  in {
+    account = true
+  }
+}
+
+data "snowflake_iceberg_tables" "in_database" {
+  in {
+    database = "<database_name>"
+  }
+}
+
+data "snowflake_iceberg_tables" "in_schema" {
+  in {
+    schema = "<database_name>.<schema_name>"
+  }
+}
+
+output "in_output" {
+  value = {
+    "account" : data.snowflake_iceberg_tables.in_account.iceberg_tables,
+    "database" : data.snowflake_iceberg_tables.in_database.iceberg_tables,
+    "schema" : data.snowflake_iceberg_tables.in_schema.iceberg_tables,
+  }
+}
+
+# Filtering (limit)
+data "snowflake_iceberg_tables" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_iceberg_tables.limit.iceberg_tables
+}
+
+# Ensure the number of iceberg tables is equal to exactly one element (with the use of check block)
+check "iceberg_table_check" {
+  data "snowflake_iceberg_tables" "assert_with_check_block" {
+    like = "iceberg-table-name"
+  }
+
+  assert {
+    condition     = length(data.snowflake_iceberg_tables.assert_with_check_block.iceberg_tables) == 1
+    error_message = "iceberg tables filtered by '${data.snowflake_iceberg_tables.assert_with_check_block.like}' returned ${length(data.snowflake_iceberg_tables.assert_with_check_block.iceberg_tables)} iceberg tables where one was expected"
+  }
+}
@@ -0,0 +1,46 @@
+# Simple usage
+data "snowflake_listings" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_listings.simple.listings
+}
+
+# Filtering (like)
+data "snowflake_listings" "like" {
+  like = "listing-name"
+}
+
+output "like_output" {
+  value = data.snowflake_listings.like.listings
+}
+
+# Filtering (starts_with)
+data "snowflake_listings" "starts_with" {
+  starts_with = "prefix-"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_listings.starts_with.listings
+}
+
+# Filtering (limit)
+data "snowflake_listings" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_listings.limit.listings
+}
+
+# Without the additional DESCRIBE LISTING for each listing
+data "snowflake_listings" "without_describe" {
+  with_describe = false
+}
+
+output "without_describe_output" {
+  value = data.snowflake_listings.without_describe.listings
+}
@@ -0,0 +1,16 @@
+# Simple usage
+data "snowflake_managed_accounts" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_managed_accounts.simple.managed_accounts
+}
+
+# Filtering (like)
+data "snowflake_managed_accounts" "like" {
+  like = "managed-account-name"
+}
+
+output "like_output" {
+  value = data.snowflake_managed_accounts.like.managed_accounts
+}
@@ -0,0 +1,57 @@
+# Simple usage
+data "snowflake_network_rules" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_network_rules.simple.network_rules
+}
+
+# Filtering (like)
+data "snowflake_network_rules" "like" {
+  like = "network-rule-name"
+}
+
+output "like_output" {
+  value = data.snowflake_network_rules.like.network_rules
+}
+
+# Filtering (starts_with)
+data "snowflake_network_rules" "starts_with" {
+  starts_with = "prefix-"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_network_rules.starts_with.network_rules
+}
+
+# Filtering (limit)
+data "snowflake_network_rules" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_network_rules.limit.network_rules
+}
+
+# Filtering (in)
+data "snowflake_network_rules" "in" {
+  in {
+    schema = "<database_name>.<schema_name>"
+  }
+}
+
+output "in_output" {
+  value = data.snowflake_network_rules.in.network_rules
+}
+
+# Without the additional DESCRIBE NETWORK RULE for each network rule
+data "snowflake_network_rules" "without_describe" {
+  with_describe = false
+}
+
+output "without_describe_output" {
+  value = data.snowflake_network_rules.without_describe.network_rules
+}
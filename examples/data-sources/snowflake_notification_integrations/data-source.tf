@@ -0,0 +1,25 @@
+# Simple usage
+data "snowflake_notification_integrations" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_notification_integrations.simple.notification_integrations
+}
+
+# Filtering (like)
+data "snowflake_notification_integrations" "like" {
+  like = "notification-integration-name"
+}
+
+output "like_output" {
+  value = data.snowflake_notification_integrations.like.notification_integrations
+}
+
+# Without the additional DESCRIBE NOTIFICATION INTEGRATION for each notification integration
+data "snowflake_notification_integrations" "without_describe" {
+  with_describe = false
+}
+
+output "without_describe_output" {
+  value = data.snowflake_notification_integrations.without_describe.notification_integrations
+}
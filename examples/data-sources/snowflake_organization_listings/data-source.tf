@@ -0,0 +1,43 @@
+# Simple usage
+data "snowflake_organization_listings" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_organization_listings.simple.organization_listings
+}
+
+# Filtering (like)
+data "snowflake_organization_listings" "like" {
+  like = "listing-title"
+}
+
+output "like_output" {
+  value = data.snowflake_organization_listings.like.organization_listings
+}
+
+# Filtering (starts_with)
+data "snowflake_organization_listings" "starts_with" {
+  starts_with = "Sales"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_organization_listings.starts_with.organization_listings
+}
+
+# Filtering (limit)
+data "snowflake_organization_listings" "limit" {
+  limit {
+    rows = 10
+    from = "Sales"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_organization_listings.limit.organization_listings
+}
+
+# Use the global name to consume the listing
+resource "snowflake_database_from_listing" "from_organization_listing" {
+  name    = "DATABASE"
+  listing = data.snowflake_organization_listings.like.organization_listings[0].show_output[0].global_name
+}
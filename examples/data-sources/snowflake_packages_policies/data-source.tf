@@ -0,0 +1,36 @@
+# Simple usage
+data "snowflake_packages_policies" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_packages_policies.simple.packages_policies
+}
+
+# Filtering (like)
+data "snowflake_packages_policies" "like" {
+  like = "packages-policy-name"
+}
+
+output "like_output" {
+  value = data.snowflake_packages_policies.like.packages_policies
+}
+
+# Filtering (in)
+data "snowflake_packages_policies" "in" {
+  in {
+    schema = "<database_name>.<schema_name>"
+  }
+}
+
+output "in_output" {
+  value = data.snowflake_packages_policies.in.packages_policies
+}
+
+# Without the additional DESCRIBE PACKAGES POLICY for each policy
+data "snowflake_packages_policies" "without_describe" {
+  with_describe = false
+}
+
+output "without_describe_output" {
+  value = data.snowflake_packages_policies.without_describe.packages_policies
+}
@@ -0,0 +1,36 @@
+# Simple usage
+data "snowflake_password_policies" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_password_policies.simple.password_policies
+}
+
+# Filtering (like)
+data "snowflake_password_policies" "like" {
+  like = "password-policy-name"
+}
+
+output "like_output" {
+  value = data.snowflake_password_policies.like.password_policies
+}
+
+# Filtering (in)
+data "snowflake_password_policies" "in" {
+  in {
+    schema = "<database_name>.<schema_name>"
+  }
+}
+
+output "in_output" {
+  value = data.snowflake_password_policies.in.password_policies
+}
+
+# Without the additional DESCRIBE PASSWORD POLICY for each password policy
+data "snowflake_password_policies" "without_describe" {
+  with_describe = false
+}
+
+output "without_describe_output" {
+  value = data.snowflake_password_policies.without_describe.password_policies
+}
@@ -0,0 +1,27 @@
+# Simple usage
+data "snowflake_snapshot_sets" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_snapshot_sets.simple.snapshot_sets
+}
+
+# Filtering (like)
+data "snowflake_snapshot_sets" "like" {
+  like = "snapshot-set-name"
+}
+
+output "like_output" {
+  value = data.snowflake_snapshot_sets.like.snapshot_sets
+}
+
+# Filtering (in)
+data "snowflake_snapshot_sets" "in" {
+  in {
+    schema = "<database_name>.<schema_name>"
+  }
+}
+
+output "in_output" {
+  value = data.snowflake_snapshot_sets.in.snapshot_sets
+}
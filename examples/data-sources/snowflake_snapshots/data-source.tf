@@ -0,0 +1,21 @@
+# Simple usage
+data "snowflake_snapshots" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_snapshots.simple.snapshots
+}
+
+# Filtering (in_snapshot_set)
+data "snowflake_snapshots" "in_snapshot_set" {
+  in_snapshot_set = snowflake_snapshot_set.example.fully_qualified_name
+}
+
+output "in_snapshot_set_output" {
+  value = data.snowflake_snapshots.in_snapshot_set.snapshots
+}
+
+# Referencing the snapshot identifiers, e.g. in a restore runbook
+output "snapshot_ids" {
+  value = [for snapshot in data.snowflake_snapshots.in_snapshot_set.snapshots : snapshot.show_output[0].snapshot_id]
+}
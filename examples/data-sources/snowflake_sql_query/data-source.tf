@@ -0,0 +1,28 @@
+# Simple usage
+data "snowflake_sql_query" "simple" {
+  query = "SELECT CURRENT_ACCOUNT() AS ACCOUNT, CURRENT_REGION() AS REGION"
+}
+
+output "simple_output" {
+  value = data.snowflake_sql_query.simple.rows[0].ACCOUNT
+}
+
+# Query with bind variables
+data "snowflake_sql_query" "bind_variables" {
+  query          = "SHOW DATABASES LIKE ?"
+  bind_variables = ["DATABASE_NAME"]
+}
+
+output "bind_variables_output" {
+  value = length(data.snowflake_sql_query.bind_variables.rows) > 0 ? data.snowflake_sql_query.bind_variables.rows[0].owner : null
+}
+
+# Querying the account usage views
+data "snowflake_sql_query" "account_usage" {
+  query          = "SELECT USER_NAME, COUNT(*) AS LOGINS FROM SNOWFLAKE.ACCOUNT_USAGE.LOGIN_HISTORY WHERE EVENT_TIMESTAMP > DATEADD(DAY, ?::NUMBER, CURRENT_TIMESTAMP()) GROUP BY USER_NAME"
+  bind_variables = ["-7"]
+}
+
+output "account_usage_output" {
+  value = { for row in data.snowflake_sql_query.account_usage.rows : row.USER_NAME => tonumber(row.LOGINS) }
+}
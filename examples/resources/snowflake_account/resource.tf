@@ -41,6 +41,33 @@ resource "snowflake_account" "complete" {
   grace_period_in_days = 3
 }
 
+## Bootstrapping the new account (with admin key-pair authentication)
+resource "snowflake_account" "bootstrapped" {
+  name                 = "ACCOUNT_NAME"
+  admin_name           = var.admin_name
+  admin_rsa_public_key = file("~/.ssh/snowflake_admin_key.pub")
+  admin_user_type      = "SERVICE"
+  email                = var.email
+  edition              = "STANDARD"
+  grace_period_in_days = 3
+}
+
+# The provider for the new account is configured from the connection_details output of the account resource.
+provider "snowflake" {
+  alias             = "bootstrapped"
+  organization_name = snowflake_account.bootstrapped.connection_details[0].organization_name
+  account_name      = snowflake_account.bootstrapped.connection_details[0].account_name
+  user              = var.admin_name
+  role              = "ACCOUNTADMIN"
+  authenticator     = "SNOWFLAKE_JWT"
+  private_key       = file("~/.ssh/snowflake_admin_key.p8")
+}
+
+resource "snowflake_database" "in_bootstrapped_account" {
+  provider = snowflake.bootstrapped
+  name     = "DATABASE_NAME"
+}
+
 variable "admin_name" {
   type      = string
   sensitive = true
@@ -0,0 +1 @@
+terraform import snowflake_account_budget.example '"SNOWFLAKE"."LOCAL"."ACCOUNT_ROOT_BUDGET"'
@@ -0,0 +1,13 @@
+# Basic resource
+resource "snowflake_account_budget" "basic" {
+  spending_limit = 1000
+}
+
+# Complete resource
+resource "snowflake_account_budget" "complete" {
+  spending_limit = 1000
+
+  notification_emails      = ["finops@example.com"]
+  notification_integration = snowflake_email_notification_integration.example.name
+  mute_notifications       = "false"
+}
@@ -1,13 +1,51 @@
+# Basic alert with a warehouse and an interval schedule
 resource "snowflake_alert" "alert" {
   database  = "database"
   schema    = "schema"
   name      = "alert"
   warehouse = "warehouse"
-  alert_schedule {
-    interval = 10
+  started   = true
+  schedule {
+    minutes = 10
+  }
+  condition = "select 1 as c"
+  action    = "select 1 as c"
+}
+
+# Serverless alert with a cron schedule
+resource "snowflake_alert" "serverless_alert" {
+  database = "database"
+  schema   = "schema"
+  name     = "serverless_alert"
+  started  = true
+  schedule {
+    using_cron = "0 9 * * MON UTC"
+  }
+  condition = "select 1 as c"
+  action    = "select 1 as c"
+}
+
+# Alert on new data (evaluated when new rows are inserted into the table queried by the condition)
+resource "snowflake_alert" "alert_on_new_data" {
+  database  = "database"
+  schema    = "schema"
+  name      = "alert_on_new_data"
+  started   = true
+  condition = "select * from \"database\".\"schema\".\"event_table\" where value > 100"
+  action    = "call system$send_email('my_email_integration', 'admin@example.com', 'Alert', 'New rows exceeded the threshold')"
+}
+
+# Complete alert
+resource "snowflake_alert" "complete_alert" {
+  database  = "database"
+  schema    = "schema"
+  name      = "complete_alert"
+  warehouse = "warehouse"
+  started   = false
+  schedule {
+    minutes = 5
   }
   condition = "select 1 as c"
   action    = "select 1 as c"
-  enabled   = true
   comment   = "my alert"
 }
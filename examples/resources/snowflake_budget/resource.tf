@@ -0,0 +1,23 @@
+# Basic resource
+resource "snowflake_budget" "basic" {
+  database       = "database"
+  schema         = "schema"
+  name           = "budget"
+  spending_limit = 100
+}
+
+# Complete resource
+resource "snowflake_budget" "complete" {
+  database       = "database"
+  schema         = "schema"
+  name           = "budget"
+  spending_limit = 100
+
+  notification_emails      = ["finops@example.com"]
+  notification_integration = snowflake_email_notification_integration.example.name
+  mute_notifications       = "false"
+
+  warehouses = [snowflake_warehouse.example.fully_qualified_name]
+  databases  = [snowflake_database.example.fully_qualified_name]
+  tasks      = [snowflake_task.example.fully_qualified_name]
+}
@@ -0,0 +1,42 @@
+# object storage catalog integration
+resource "snowflake_catalog_integration" "object_store" {
+  name           = "CATALOG_INTEGRATION"
+  catalog_source = "OBJECT_STORE"
+  table_format   = "ICEBERG"
+  enabled        = true
+}
+
+# AWS Glue catalog integration
+resource "snowflake_catalog_integration" "glue" {
+  name                     = "CATALOG_INTEGRATION"
+  catalog_source           = "GLUE"
+  table_format             = "ICEBERG"
+  enabled                  = true
+  catalog_namespace        = "glue_database"
+  glue_aws_role_arn        = "arn:aws:iam::123456789012:role/glue-role"
+  glue_catalog_id          = "123456789012"
+  glue_region              = "us-west-2"
+  refresh_interval_seconds = 60
+  comment                  = "comment"
+}
+
+# Iceberg REST catalog integration
+resource "snowflake_catalog_integration" "rest" {
+  name              = "CATALOG_INTEGRATION"
+  catalog_source    = "ICEBERG_REST"
+  table_format      = "ICEBERG"
+  enabled           = true
+  catalog_namespace = "namespace"
+
+  rest_config {
+    catalog_uri  = "https://example.com/api/catalog"
+    catalog_name = "catalog"
+  }
+
+  rest_authentication {
+    type                 = "OAUTH"
+    oauth_client_id      = var.oauth_client_id
+    oauth_client_secret  = var.oauth_client_secret
+    oauth_allowed_scopes = ["PRINCIPAL_ROLE:ALL"]
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_database_from_listing.example '"<database_name>"'
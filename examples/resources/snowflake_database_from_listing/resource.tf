@@ -0,0 +1,12 @@
+# basic resource
+resource "snowflake_database_from_listing" "basic" {
+  name    = "DATABASE"
+  listing = "ORGDATACLOUD$INTERNAL$LISTING"
+}
+
+# complete resource
+resource "snowflake_database_from_listing" "complete" {
+  name    = "DATABASE"
+  listing = "ORGDATACLOUD$INTERNAL$LISTING"
+  comment = "COMMENT"
+}
@@ -1 +1 @@
-terraform import snowflake_dynamic_table.dt "mydb|myschema|product"
+terraform import snowflake_dynamic_table.example '"<database_name>"."<schema_name>"."<dynamic_table_name>"'
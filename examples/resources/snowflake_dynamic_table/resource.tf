@@ -1,12 +1,54 @@
-# https://docs.snowflake.com/en/sql-reference/sql/create-dynamic-table#examples
-resource "snowflake_dynamic_table" "dt" {
-  name     = "product"
-  database = "mydb"
-  schema   = "myschema"
+# basic resource
+resource "snowflake_dynamic_table" "basic" {
+  database  = "database"
+  schema    = "schema"
+  name      = "dynamic_table"
+  warehouse = "warehouse"
+  query     = "SELECT product_id, product_name FROM \"database\".\"schema\".\"staging_table\""
   target_lag {
     maximum_duration = "20 minutes"
   }
-  warehouse = "mywh"
-  query     = "SELECT product_id, product_name FROM \"mydb\".\"myschema\".\"staging_table\""
-  comment   = "example comment"
+}
+
+# resource with all fields set
+resource "snowflake_dynamic_table" "complete" {
+  database                 = "database"
+  schema                   = "schema"
+  name                     = "dynamic_table"
+  warehouse                = "warehouse"
+  initialization_warehouse = "initialization_warehouse"
+  query                    = "SELECT product_id, product_name FROM \"database\".\"schema\".\"staging_table\""
+  target_lag {
+    downstream = true
+  }
+  scheduler                       = "ENABLE"
+  started                         = true
+  transient                       = false
+  refresh_mode                    = "INCREMENTAL"
+  initialize                      = "ON_SCHEDULE"
+  cluster_by                      = ["product_id"]
+  data_retention_time_in_days     = 1
+  max_data_extension_time_in_days = 7
+  immutable_where                 = "product_id < 100"
+  comment                         = "example comment"
+  tags = {
+    "\"database\".\"schema\".\"cost_center\"" = "finance"
+  }
+}
+
+# iceberg-backed dynamic table
+resource "snowflake_dynamic_table" "iceberg" {
+  database  = "database"
+  schema    = "schema"
+  name      = "dynamic_table"
+  warehouse = "warehouse"
+  query     = "SELECT product_id, product_name FROM \"database\".\"schema\".\"staging_table\""
+  target_lag {
+    maximum_duration = "20 minutes"
+  }
+  iceberg {
+    external_volume = "external_volume"
+    catalog         = "SNOWFLAKE"
+    base_location   = "dynamic_table"
+  }
 }
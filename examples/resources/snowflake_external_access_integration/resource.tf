@@ -0,0 +1,16 @@
+# basic resource
+resource "snowflake_external_access_integration" "basic" {
+  name                  = "EXTERNAL_ACCESS_INTEGRATION"
+  allowed_network_rules = [snowflake_network_rule.example.fully_qualified_name]
+  enabled               = true
+}
+
+# complete resource
+resource "snowflake_external_access_integration" "complete" {
+  name                                    = "EXTERNAL_ACCESS_INTEGRATION"
+  allowed_network_rules                   = [snowflake_network_rule.example.fully_qualified_name]
+  allowed_api_authentication_integrations = [snowflake_api_authentication_integration_with_client_credentials.example.fully_qualified_name]
+  allowed_authentication_secrets          = [snowflake_secret_with_client_credentials.example.fully_qualified_name]
+  enabled                                 = true
+  comment                                 = "comment"
+}
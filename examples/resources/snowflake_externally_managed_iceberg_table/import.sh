@@ -0,0 +1 @@
+terraform import snowflake_externally_managed_iceberg_table.example '"<db_name>"."<schema_name>"."<iceberg_table_name>"'
\ No newline at end of file
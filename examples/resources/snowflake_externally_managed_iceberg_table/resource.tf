@@ -0,0 +1,26 @@
+# table from an object storage catalog
+resource "snowflake_externally_managed_iceberg_table" "object_store" {
+  database           = "DATABASE"
+  schema             = "SCHEMA"
+  name               = "ICEBERG_TABLE"
+  catalog            = snowflake_catalog_integration.object_store.name
+  external_volume    = snowflake_external_volume.example.name
+  metadata_file_path = "path/to/metadata/v1.metadata.json"
+}
+
+# table from an AWS Glue catalog
+resource "snowflake_externally_managed_iceberg_table" "glue" {
+  database                   = "DATABASE"
+  schema                     = "SCHEMA"
+  name                       = "ICEBERG_TABLE"
+  catalog                    = snowflake_catalog_integration.glue.name
+  external_volume            = snowflake_external_volume.example.name
+  catalog_table_name         = "glue_table"
+  catalog_namespace          = "glue_database"
+  replace_invalid_characters = "true"
+  auto_refresh               = "true"
+  comment                    = "comment"
+
+  # change this value to refresh the table metadata
+  refresh_trigger = "1"
+}
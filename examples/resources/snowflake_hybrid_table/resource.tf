@@ -0,0 +1,69 @@
+# basic resource
+resource "snowflake_hybrid_table" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "HYBRID_TABLE"
+
+  column {
+    name = "ID"
+    type = "NUMBER(38,0)"
+  }
+
+  primary_key {
+    columns = ["ID"]
+  }
+}
+
+# complete resource
+resource "snowflake_hybrid_table" "complete" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "HYBRID_TABLE"
+
+  column {
+    name          = "ID"
+    type          = "NUMBER(38,0)"
+    autoincrement = true
+  }
+  column {
+    name     = "NAME"
+    type     = "VARCHAR(100)"
+    not_null = true
+    default  = "'unknown'"
+    comment  = "name of the customer"
+  }
+  column {
+    name    = "EMAIL"
+    type    = "VARCHAR(200)"
+    collate = "en-ci"
+  }
+  column {
+    name = "REGION_ID"
+    type = "NUMBER(38,0)"
+  }
+
+  primary_key {
+    name    = "PK_CUSTOMER"
+    columns = ["ID"]
+  }
+
+  unique_key {
+    columns = ["EMAIL"]
+  }
+
+  foreign_key {
+    name               = "FK_REGION"
+    columns            = ["REGION_ID"]
+    references_table   = snowflake_hybrid_table.basic.fully_qualified_name
+    references_columns = ["ID"]
+  }
+
+  index {
+    name            = "IDX_NAME"
+    columns         = ["NAME"]
+    include_columns = ["EMAIL"]
+  }
+
+  data_retention_time_in_days = 1
+  comment                     = "comment"
+}
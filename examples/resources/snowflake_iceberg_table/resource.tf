@@ -0,0 +1,41 @@
+# basic resource
+resource "snowflake_iceberg_table" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "ICEBERG_TABLE"
+
+  column {
+    name = "id"
+    type = "NUMBER(38,0)"
+  }
+}
+
+# complete resource
+resource "snowflake_iceberg_table" "complete" {
+  database        = "DATABASE"
+  schema          = "SCHEMA"
+  name            = "ICEBERG_TABLE"
+  external_volume = snowflake_external_volume.example.name
+  base_location   = "path/to/table"
+
+  column {
+    name     = "id"
+    type     = "NUMBER(38,0)"
+    not_null = true
+    comment  = "identifier"
+  }
+  column {
+    name = "data"
+    type = "VARCHAR"
+  }
+
+  cluster_by                      = ["id"]
+  catalog_sync                    = "OPEN_CATALOG_INTEGRATION"
+  storage_serialization_policy    = "OPTIMIZED"
+  data_retention_time_in_days     = 1
+  max_data_extension_time_in_days = 14
+  change_tracking                 = "true"
+  default_ddl_collation           = "en-ci"
+  target_file_size                = "AUTO"
+  comment                         = "comment"
+}
@@ -62,3 +62,44 @@ resource "snowflake_listing" "basic_staged" {
   publish = true
   comment = "This is a comment for the listing"
 }
+
+# basic resource with structured manifest
+resource "snowflake_listing" "basic_structured" {
+  name = "LISTING"
+  manifest {
+    structured {
+      title = "title"
+    }
+  }
+}
+
+# complete resource with structured manifest
+resource "snowflake_listing" "complete_structured" {
+  name = "LISTING"
+  manifest {
+    structured {
+      title       = "title"
+      subtitle    = "subtitle"
+      description = "description"
+      listing_terms {
+        type = "CUSTOM"
+        link = "https://example.com/terms"
+      }
+      targets {
+        accounts = ["ORGANIZATION.ACCOUNT"]
+      }
+      auto_fulfillment {
+        refresh_type     = "SUB_DATABASE"
+        refresh_schedule = "60 MINUTE"
+      }
+      usage_examples {
+        title       = "Daily orders"
+        description = "Orders aggregated per day"
+        query       = "SELECT DATE_TRUNC('DAY', ORDERED_AT), COUNT(*) FROM ORDERS GROUP BY 1"
+      }
+    }
+  }
+
+  share   = snowflake_share.test_share.fully_qualified_name
+  publish = true
+}
@@ -0,0 +1 @@
+terraform import snowflake_organization_listing.example '"<listing_name>"'
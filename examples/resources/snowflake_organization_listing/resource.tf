@@ -0,0 +1,42 @@
+# basic resource
+resource "snowflake_organization_listing" "basic" {
+  name  = "LISTING"
+  share = snowflake_share.test.fully_qualified_name
+  title = "Sales data"
+
+  discovery_targets {
+    all_internal_accounts = true
+  }
+  access_targets {
+    all_internal_accounts = true
+  }
+}
+
+# complete resource
+resource "snowflake_organization_listing" "complete" {
+  name                 = "LISTING"
+  share                = snowflake_share.test.fully_qualified_name
+  title                = "Sales data"
+  description          = "Daily sales data shared within the organization."
+  organization_profile = "INTERNAL"
+
+  discovery_targets {
+    all_internal_accounts = true
+  }
+  access_targets {
+    account = "ANALYTICS_ACCOUNT"
+    roles   = ["ANALYST", "DATA_ENGINEER"]
+  }
+  access_targets {
+    account = "REPORTING_ACCOUNT"
+  }
+
+  access_regions = ["ALL"]
+  auto_fulfillment {
+    refresh_type     = "SUB_DATABASE"
+    refresh_schedule = "10 MINUTE"
+  }
+
+  publish = true
+  comment = "COMMENT"
+}
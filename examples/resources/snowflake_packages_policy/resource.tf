@@ -0,0 +1,22 @@
+# basic resource - all the packages are allowed
+resource "snowflake_packages_policy" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "packages_policy"
+}
+
+# complete resource
+resource "snowflake_packages_policy" "complete" {
+  database                      = "database"
+  schema                        = "schema"
+  name                          = "packages_policy"
+  allowlist                     = ["numpy", "pandas==2.2.*"]
+  blocklist                     = ["scikit-learn"]
+  additional_creation_blocklist = ["requests"]
+  comment                       = "comment"
+}
+
+# set the packages policy on the current account
+resource "snowflake_current_account" "current" {
+  packages_policy = snowflake_packages_policy.complete.fully_qualified_name
+}
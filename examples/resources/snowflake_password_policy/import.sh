@@ -0,0 +1 @@
+terraform import snowflake_password_policy.example '"<database_name>"."<schema_name>"."<password_policy_name>"'
@@ -0,0 +1 @@
+terraform import snowflake_privacy_policy.example '"<database_name>"."<schema_name>"."<privacy_policy_name>"'
@@ -0,0 +1,16 @@
+# basic resource
+resource "snowflake_privacy_policy" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "privacy_policy"
+  body     = "NO_PRIVACY_POLICY()"
+}
+
+# complete resource
+resource "snowflake_privacy_policy" "complete" {
+  database = "database"
+  schema   = "schema"
+  name     = "privacy_policy"
+  body     = "PRIVACY_BUDGET(BUDGET_NAME => 'analysts', BUDGET_LIMIT => 233, MAX_BUDGET_PER_AGGREGATE => 1)"
+  comment  = "comment"
+}
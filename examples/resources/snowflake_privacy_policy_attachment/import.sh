@@ -0,0 +1,5 @@
+# table attachment
+terraform import snowflake_privacy_policy_attachment.example 'TABLE|"<database_name>"."<schema_name>"."<table_name>"|"<database_name>"."<schema_name>"."<privacy_policy_name>"'
+
+# view attachment
+terraform import snowflake_privacy_policy_attachment.example 'VIEW|"<database_name>"."<schema_name>"."<view_name>"|"<database_name>"."<schema_name>"."<privacy_policy_name>"'
@@ -0,0 +1,12 @@
+# attach the policy to a table
+resource "snowflake_privacy_policy_attachment" "table" {
+  privacy_policy = snowflake_privacy_policy.example.fully_qualified_name
+  table          = snowflake_table.example.fully_qualified_name
+}
+
+# attach the policy to a view with the entity-level privacy
+resource "snowflake_privacy_policy_attachment" "view" {
+  privacy_policy = snowflake_privacy_policy.example.fully_qualified_name
+  view           = snowflake_view.example.fully_qualified_name
+  entity_key     = ["CUSTOMER_ID"]
+}
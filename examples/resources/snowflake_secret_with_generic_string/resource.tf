@@ -15,6 +15,21 @@ resource "snowflake_secret_with_generic_string" "test" {
   comment       = "EXAMPLE_COMMENT"
 }
 
+# resource with the write-only secret string (requires Terraform 1.11 or later); the value is not stored in the state
+resource "snowflake_secret_with_generic_string" "test" {
+  name                     = "EXAMPLE_SECRET"
+  database                 = "EXAMPLE_DB"
+  schema                   = "EXAMPLE_SCHEMA"
+  secret_string_wo         = var.secret_string_wo
+  secret_string_wo_version = 1
+}
+
+variable "secret_string_wo" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
 variable "secret_string" {
   type      = string
   sensitive = true
@@ -24,6 +24,29 @@ resource "snowflake_service_user" "service_user" {
   rsa_public_key_2 = "..."
 }
 
+# with workload identity federation (secretless authentication)
+resource "snowflake_service_user" "with_aws_workload_identity" {
+  name = "Snowflake Service User - AWS"
+
+  workload_identity {
+    aws {
+      arn = "arn:aws:iam::123456789012:role/ci-pipeline"
+    }
+  }
+}
+
+resource "snowflake_service_user" "with_oidc_workload_identity" {
+  name = "Snowflake Service User - OIDC"
+
+  workload_identity {
+    oidc {
+      issuer             = "https://token.actions.githubusercontent.com"
+      subject            = "repo:my-org/my-repo:environment:prod"
+      oidc_audience_list = ["snowflakecomputing.com"]
+    }
+  }
+}
+
 # all parameters set on the resource level
 resource "snowflake_service_user" "u" {
   name = "Snowflake Service User with all parameters"
@@ -0,0 +1 @@
+terraform import snowflake_snapshot_policy.example '"<database_name>"."<schema_name>"."<snapshot_policy_name>"'
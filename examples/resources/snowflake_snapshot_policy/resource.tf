@@ -0,0 +1,18 @@
+# basic resource
+resource "snowflake_snapshot_policy" "basic" {
+  database          = "database"
+  schema            = "schema"
+  name              = "snapshot_policy"
+  expire_after_days = 7
+}
+
+# complete resource
+resource "snowflake_snapshot_policy" "complete" {
+  database          = "database"
+  schema            = "schema"
+  name              = "snapshot_policy"
+  retention_lock    = true
+  schedule          = "USING CRON 0 0 * * * UTC"
+  expire_after_days = 30
+  comment           = "comment"
+}
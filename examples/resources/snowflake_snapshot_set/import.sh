@@ -0,0 +1 @@
+terraform import snowflake_snapshot_set.example '"<database_name>"."<schema_name>"."<snapshot_set_name>"'
@@ -0,0 +1,25 @@
+# basic resource for a table
+resource "snowflake_snapshot_set" "table" {
+  database  = "database"
+  schema    = "schema"
+  name      = "snapshot_set"
+  for_table = snowflake_table.example.fully_qualified_name
+}
+
+# complete resource for a database
+resource "snowflake_snapshot_set" "database" {
+  database        = "database"
+  schema          = "schema"
+  name            = "snapshot_set"
+  for_database    = snowflake_database.example.name
+  snapshot_policy = snowflake_snapshot_policy.example.fully_qualified_name
+  comment         = "comment"
+}
+
+# resource for a schema
+resource "snowflake_snapshot_set" "schema" {
+  database   = "database"
+  schema     = "schema"
+  name       = "snapshot_set"
+  for_schema = snowflake_schema.example.fully_qualified_name
+}
@@ -0,0 +1,28 @@
+# single file
+resource "snowflake_stage_file" "handler" {
+  stage  = snowflake_stage.example.fully_qualified_name
+  path   = "functions"
+  source = "${path.module}/functions/handler.py"
+}
+
+# all the files matching the pattern in the directory
+resource "snowflake_stage_file" "streamlit" {
+  stage   = snowflake_stage.example.fully_qualified_name
+  path    = "streamlit"
+  source  = "${path.module}/streamlit"
+  pattern = "*.py"
+}
+
+# use the uploaded file
+resource "snowflake_function_python" "example" {
+  database        = "DATABASE"
+  schema          = "SCHEMA"
+  name            = "FUNCTION"
+  runtime_version = "3.9"
+  handler         = "handler.handler"
+  return_type     = "NUMBER(38, 0)"
+  imports {
+    stage_location = snowflake_stage.example.fully_qualified_name
+    path_on_stage  = snowflake_stage_file.handler.files[0].path
+  }
+}
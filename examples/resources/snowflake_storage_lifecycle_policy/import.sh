@@ -0,0 +1 @@
+terraform import snowflake_storage_lifecycle_policy.example '"<database_name>"."<schema_name>"."<storage_lifecycle_policy_name>"'
@@ -0,0 +1,30 @@
+# basic resource - expires the matching rows
+resource "snowflake_storage_lifecycle_policy" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "storage_lifecycle_policy"
+  argument {
+    name = "CREATED_ON"
+    type = "TIMESTAMP_NTZ"
+  }
+  body = "CREATED_ON < DATEADD('day', -90, CURRENT_TIMESTAMP())"
+}
+
+# complete resource - archives the matching rows
+resource "snowflake_storage_lifecycle_policy" "complete" {
+  database = "database"
+  schema   = "schema"
+  name     = "storage_lifecycle_policy"
+  argument {
+    name = "CREATED_ON"
+    type = "TIMESTAMP_NTZ"
+  }
+  argument {
+    name = "REGION"
+    type = "VARCHAR"
+  }
+  body             = "CREATED_ON < DATEADD('day', -90, CURRENT_TIMESTAMP()) AND REGION = 'EU'"
+  archive_tier     = "COOL"
+  archive_for_days = 365
+  comment          = "comment"
+}
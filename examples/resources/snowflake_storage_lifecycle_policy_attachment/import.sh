@@ -0,0 +1 @@
+terraform import snowflake_storage_lifecycle_policy_attachment.example '"<database_name>"."<schema_name>"."<table_name>"|"<database_name>"."<schema_name>"."<storage_lifecycle_policy_name>"'
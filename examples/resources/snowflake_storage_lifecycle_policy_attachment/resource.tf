@@ -0,0 +1,5 @@
+resource "snowflake_storage_lifecycle_policy_attachment" "example" {
+  storage_lifecycle_policy = snowflake_storage_lifecycle_policy.complete.fully_qualified_name
+  table                    = snowflake_table.example.fully_qualified_name
+  on                       = ["LOADED_AT", "REGION"]
+}
@@ -0,0 +1,20 @@
+# categorical column
+resource "snowflake_table_column_privacy_domain" "values" {
+  table  = snowflake_table.example.fully_qualified_name
+  column = "COUNTRY"
+  values = ["PL", "DE", "US"]
+
+  depends_on = [snowflake_privacy_policy_attachment.example]
+}
+
+# numerical column
+resource "snowflake_table_column_privacy_domain" "range" {
+  table  = snowflake_table.example.fully_qualified_name
+  column = "AGE"
+  range {
+    lower = "0"
+    upper = "120"
+  }
+
+  depends_on = [snowflake_privacy_policy_attachment.example]
+}
@@ -0,0 +1 @@
+terraform import snowflake_task_graph.example '"<database_name>"."<schema_name>"."<root_task_name>"'
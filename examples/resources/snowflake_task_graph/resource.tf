@@ -0,0 +1,67 @@
+# Basic resource
+resource "snowflake_task_graph" "basic" {
+  database = "database"
+  schema   = "schema"
+  started  = true
+
+  schedule {
+    minutes = 5
+  }
+
+  root {
+    name          = "ROOT"
+    sql_statement = "SELECT 1"
+  }
+
+  task {
+    name          = "CHILD"
+    sql_statement = "SELECT 2"
+    after         = ["ROOT"]
+  }
+}
+
+# Complete resource
+resource "snowflake_task_graph" "complete" {
+  database = "database"
+  schema   = "schema"
+  started  = true
+
+  schedule {
+    using_cron = "0 * * * * UTC"
+  }
+  config                      = "{\"output_dir\": \"/temp/test_directory/\", \"learning_rate\": 0.1}"
+  allow_overlapping_execution = "false"
+  error_integration           = "<error_integration_name>"
+
+  root {
+    name          = "ROOT"
+    sql_statement = "CALL load_data()"
+    warehouse     = "<warehouse_name>"
+    comment       = "Loads the data."
+  }
+
+  task {
+    name          = "TRANSFORM"
+    sql_statement = "CALL transform_data()"
+    after         = ["ROOT"]
+  }
+
+  task {
+    name          = "AGGREGATE"
+    sql_statement = "CALL aggregate_data()"
+    warehouse     = "<warehouse_name>"
+    when          = "SYSTEM$GET_PREDECESSOR_RETURN_VALUE('TRANSFORM') = 'SUCCESS'"
+    after         = ["TRANSFORM"]
+  }
+
+  task {
+    name          = "REPORT"
+    sql_statement = "CALL report()"
+    after         = ["TRANSFORM", "AGGREGATE"]
+  }
+
+  finalizer {
+    name          = "CLEANUP"
+    sql_statement = "CALL cleanup()"
+  }
+}
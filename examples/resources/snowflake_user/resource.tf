@@ -32,6 +32,14 @@ resource "snowflake_user" "user" {
   disable_mfa          = "false"
 }
 
+# with the write-only password (requires Terraform 1.11 or later); the password is not stored in the state
+# change password_wo_version to rotate the password
+resource "snowflake_user" "write_only_password" {
+  name                = "Snowflake User with write-only password"
+  password_wo         = var.password_wo
+  password_wo_version = 1
+}
+
 # all parameters set on the resource level
 resource "snowflake_user" "u" {
   name = "Snowflake User with all parameters"
@@ -111,6 +119,12 @@ variable "password" {
   sensitive = true
 }
 
+variable "password_wo" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
 variable "first_name" {
   type      = string
   sensitive = true
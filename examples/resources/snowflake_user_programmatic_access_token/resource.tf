@@ -66,3 +66,15 @@ resource "snowflake_user_programmatic_access_token" "rotating" {
 resource "time_rotating" "rotation_schedule" {
   rotation_days = 30
 }
+
+# Rotate the token automatically before it expires.
+resource "snowflake_user_programmatic_access_token" "renewed_before_expiry" {
+  user           = "USER"
+  name           = "TOKEN"
+  days_to_expiry = 30
+
+  # When the token expires in less than 7 days (based on `show_output.0.expires_at`), the plan shows the rotation.
+  rotate_before_expiry_days = 7
+  # Keep the previous token secret valid for 24 hours after the rotation, so that the integrations can switch to the new one.
+  expire_rotated_token_after_hours = 24
+}
@@ -0,0 +1,56 @@
+# The key pair is generated by the provider. The private key is written only to the local file, and it is never stored in the Terraform plan or state.
+# basic resource
+resource "snowflake_user_rsa_key_pair" "basic" {
+  user             = "USER"
+  private_key_file = "${path.module}/rsa_key.p8"
+}
+
+# complete resource
+# The private key is encrypted (PKCS#8) with the write-only passphrase; write-only attributes require Terraform 1.11 or later.
+resource "snowflake_user_rsa_key_pair" "complete" {
+  user                              = "USER"
+  private_key_file                  = "${path.module}/rsa_key.p8"
+  key_size                          = 4096
+  private_key_passphrase_wo         = var.private_key_passphrase
+  private_key_passphrase_wo_version = 1
+  rotation_period_days              = 90
+  keeper                            = "1"
+  retain_previous_key               = true
+}
+
+variable "private_key_passphrase" {
+  type      = string
+  sensitive = true
+}
+
+# Set up the user and ignore the keys managed by the key pair resource.
+resource "snowflake_service_user" "user" {
+  name = "USER"
+
+  lifecycle {
+    ignore_changes = [rsa_public_key, rsa_public_key_2]
+  }
+}
+
+# resource with external references
+resource "snowflake_user_rsa_key_pair" "with_external_references" {
+  user             = snowflake_service_user.user.name
+  private_key_file = "${path.module}/rsa_key.p8"
+}
+
+# Key Pair Rotation
+
+# The key pair is rotated on the first `terraform apply` after 90 days from the last rotation (see rotated_on),
+# or on demand, when the keeper changes.
+# The new public key is set in the free user property (RSA_PUBLIC_KEY or RSA_PUBLIC_KEY_2) before the previous one is retired,
+# and only then the private key file is replaced.
+resource "snowflake_user_rsa_key_pair" "rotating" {
+  user                 = "USER"
+  private_key_file     = "${path.module}/rsa_key.p8"
+  rotation_period_days = 90
+  keeper               = "2"
+
+  # Keep the previous public key assigned to the user until the next rotation,
+  # so that the clients have time to switch to the new private key.
+  retain_previous_key = true
+}
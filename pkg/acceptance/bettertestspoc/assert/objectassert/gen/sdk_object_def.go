@@ -183,6 +183,41 @@ var allStructs = []SdkObjectDef{
 		ObjectType:   sdk.ObjectTypeStreamlit,
 		ObjectStruct: sdk.Streamlit{},
 	},
+	{
+		IdType:       "sdk.SchemaObjectIdentifier",
+		ObjectType:   sdk.ObjectTypeHybridTable,
+		ObjectStruct: sdk.HybridTable{},
+	},
+	{
+		IdType:       "sdk.SchemaObjectIdentifier",
+		ObjectType:   sdk.ObjectTypeIcebergTable,
+		ObjectStruct: sdk.IcebergTable{},
+	},
+	{
+		IdType:       "sdk.SchemaObjectIdentifier",
+		ObjectType:   sdk.ObjectTypeDynamicTable,
+		ObjectStruct: sdk.DynamicTable{},
+	},
+	{
+		IdType:       "sdk.AccountObjectIdentifier",
+		ObjectType:   sdk.ObjectTypeCatalogIntegration,
+		ObjectStruct: sdk.CatalogIntegration{},
+	},
+	{
+		IdType:       "sdk.AccountObjectIdentifier",
+		ObjectType:   sdk.ObjectTypeExternalAccessIntegration,
+		ObjectStruct: sdk.ExternalAccessIntegration{},
+	},
+	{
+		IdType:       "sdk.SchemaObjectIdentifier",
+		ObjectType:   sdk.ObjectTypeAlert,
+		ObjectStruct: sdk.Alert{},
+	},
+	{
+		IdType:       "sdk.SchemaObjectIdentifier",
+		ObjectType:   sdk.ObjectTypePasswordPolicy,
+		ObjectStruct: sdk.PasswordPolicy{},
+	},
 }
 
 func GetSdkObjectDetails() []genhelpers.SdkObjectDetails {
@@ -9,3 +9,14 @@ func (a *AccountResourceAssert) HasAdminUserType(expected sdk.UserType) *Account
 	a.AddAssertion(assert.ValueSet("admin_user_type", string(expected)))
 	return a
 }
+
+func (a *AccountResourceAssert) HasConnectionDetails(organizationName string, accountName string, region string) *AccountResourceAssert {
+	a.AddAssertion(assert.ValueSet("connection_details.#", "1"))
+	a.AddAssertion(assert.ValueSet("connection_details.0.organization_name", organizationName))
+	a.AddAssertion(assert.ValueSet("connection_details.0.account_name", accountName))
+	a.AddAssertion(assert.ValuePresent("connection_details.0.account_url"))
+	a.AddAssertion(assert.ValuePresent("connection_details.0.account_locator"))
+	a.AddAssertion(assert.ValuePresent("connection_details.0.account_locator_url"))
+	a.AddAssertion(assert.ValueSet("connection_details.0.region", region))
+	return a
+}
@@ -47,6 +47,11 @@ func (a *AccountResourceAssert) HasAdminPasswordString(expected string) *Account
 	return a
 }
 
+func (a *AccountResourceAssert) HasAdminPasswordWoString(expected string) *AccountResourceAssert {
+	a.AddAssertion(assert.ValueSet("admin_password_wo", expected))
+	return a
+}
+
 func (a *AccountResourceAssert) HasAdminRsaPublicKeyString(expected string) *AccountResourceAssert {
 	a.AddAssertion(assert.ValueSet("admin_rsa_public_key", expected))
 	return a
@@ -62,6 +67,11 @@ func (a *AccountResourceAssert) HasCommentString(expected string) *AccountResour
 	return a
 }
 
+func (a *AccountResourceAssert) HasConnectionDetailsString(expected string) *AccountResourceAssert {
+	a.AddAssertion(assert.ValueSet("connection_details", expected))
+	return a
+}
+
 func (a *AccountResourceAssert) HasConsumptionBillingEntityString(expected string) *AccountResourceAssert {
 	a.AddAssertion(assert.ValueSet("consumption_billing_entity", expected))
 	return a
@@ -136,6 +146,11 @@ func (a *AccountResourceAssert) HasNoAdminPassword() *AccountResourceAssert {
 	return a
 }
 
+func (a *AccountResourceAssert) HasNoAdminPasswordWo() *AccountResourceAssert {
+	a.AddAssertion(assert.ValueNotSet("admin_password_wo"))
+	return a
+}
+
 func (a *AccountResourceAssert) HasNoAdminRsaPublicKey() *AccountResourceAssert {
 	a.AddAssertion(assert.ValueNotSet("admin_rsa_public_key"))
 	return a
@@ -215,6 +230,11 @@ func (a *AccountResourceAssert) HasAdminPasswordEmpty() *AccountResourceAssert {
 	return a
 }
 
+func (a *AccountResourceAssert) HasAdminPasswordWoEmpty() *AccountResourceAssert {
+	a.AddAssertion(assert.ValueSet("admin_password_wo", ""))
+	return a
+}
+
 func (a *AccountResourceAssert) HasAdminRsaPublicKeyEmpty() *AccountResourceAssert {
 	a.AddAssertion(assert.ValueSet("admin_rsa_public_key", ""))
 	return a
@@ -230,6 +250,11 @@ func (a *AccountResourceAssert) HasCommentEmpty() *AccountResourceAssert {
 	return a
 }
 
+func (a *AccountResourceAssert) HasConnectionDetailsEmpty() *AccountResourceAssert {
+	a.AddAssertion(assert.ValueSet("connection_details.#", "0"))
+	return a
+}
+
 func (a *AccountResourceAssert) HasConsumptionBillingEntityEmpty() *AccountResourceAssert {
 	a.AddAssertion(assert.ValueSet("consumption_billing_entity", ""))
 	return a
@@ -289,6 +314,11 @@ func (a *AccountResourceAssert) HasAdminPasswordNotEmpty() *AccountResourceAsser
 	return a
 }
 
+func (a *AccountResourceAssert) HasAdminPasswordWoNotEmpty() *AccountResourceAssert {
+	a.AddAssertion(assert.ValuePresent("admin_password_wo"))
+	return a
+}
+
 func (a *AccountResourceAssert) HasAdminRsaPublicKeyNotEmpty() *AccountResourceAssert {
 	a.AddAssertion(assert.ValuePresent("admin_rsa_public_key"))
 	return a
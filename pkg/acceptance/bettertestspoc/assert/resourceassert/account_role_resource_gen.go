@@ -47,6 +47,11 @@ func (a *AccountRoleResourceAssert) HasFullyQualifiedNameString(expected string)
 	return a
 }
 
+func (a *AccountRoleResourceAssert) HasTagsString(expected string) *AccountRoleResourceAssert {
+	a.AddAssertion(assert.ValueSet("tags", expected))
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -66,6 +71,11 @@ func (a *AccountRoleResourceAssert) HasNoFullyQualifiedName() *AccountRoleResour
 	return a
 }
 
+func (a *AccountRoleResourceAssert) HasNoTags() *AccountRoleResourceAssert {
+	a.AddAssertion(assert.ValueNotSet("tags"))
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -80,6 +90,11 @@ func (a *AccountRoleResourceAssert) HasFullyQualifiedNameEmpty() *AccountRoleRes
 	return a
 }
 
+func (a *AccountRoleResourceAssert) HasTagsEmpty() *AccountRoleResourceAssert {
+	a.AddAssertion(assert.ValueSet("tags", ""))
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -98,3 +113,8 @@ func (a *AccountRoleResourceAssert) HasFullyQualifiedNameNotEmpty() *AccountRole
 	a.AddAssertion(assert.ValuePresent("fully_qualified_name"))
 	return a
 }
+
+func (a *AccountRoleResourceAssert) HasTagsNotEmpty() *AccountRoleResourceAssert {
+	a.AddAssertion(assert.ValuePresent("tags"))
+	return a
+}
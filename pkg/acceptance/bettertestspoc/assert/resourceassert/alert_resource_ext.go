@@ -0,0 +1,26 @@
+package resourceassert
+
+import (
+	"strconv"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+func (a *AlertResourceAssert) HasScheduleMinutes(minutes int) *AlertResourceAssert {
+	a.AddAssertion(assert.ValueSet("schedule.#", "1"))
+	a.AddAssertion(assert.ValueSet("schedule.0.minutes", strconv.Itoa(minutes)))
+	a.AddAssertion(assert.ValueSet("schedule.0.using_cron", ""))
+	return a
+}
+
+func (a *AlertResourceAssert) HasScheduleCron(cron string) *AlertResourceAssert {
+	a.AddAssertion(assert.ValueSet("schedule.#", "1"))
+	a.AddAssertion(assert.ValueSet("schedule.0.using_cron", cron))
+	a.AddAssertion(assert.ValueSet("schedule.0.minutes", "0"))
+	return a
+}
+
+func (a *AlertResourceAssert) HasNoScheduleSet() *AlertResourceAssert {
+	a.AddAssertion(assert.ValueSet("schedule.#", "0"))
+	return a
+}
@@ -82,6 +82,21 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthClientSecretWoString(expected string) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo", expected))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthClientSecretWoHashString(expected string) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo_hash", expected))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthClientSecretWoVersionString(expected string) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo_version", expected))
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenValidityString(expected string) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
 	a.AddAssertion(assert.ValueSet("oauth_refresh_token_validity", expected))
 	return a
@@ -92,6 +107,11 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasTagsString(expected string) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueSet("tags", expected))
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -141,6 +161,21 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasNoOauthClientSecretWo() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueNotSet("oauth_client_secret_wo"))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasNoOauthClientSecretWoHash() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueNotSet("oauth_client_secret_wo_hash"))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasNoOauthClientSecretWoVersion() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueNotSet("oauth_client_secret_wo_version"))
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasNoOauthRefreshTokenValidity() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
 	a.AddAssertion(assert.ValueNotSet("oauth_refresh_token_validity"))
 	return a
@@ -151,6 +186,11 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasNoTags() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueNotSet("tags"))
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -185,6 +225,26 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthClientSecretEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret", ""))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthClientSecretWoEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo", ""))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthClientSecretWoHashEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo_hash", ""))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthClientSecretWoVersionEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo_version", ""))
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenValidityEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
 	a.AddAssertion(assert.ValueSet("oauth_refresh_token_validity", ""))
 	return a
@@ -195,6 +255,11 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasTagsEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValueSet("tags", ""))
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -244,6 +309,21 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthClientSecretWoNotEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValuePresent("oauth_client_secret_wo"))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthClientSecretWoHashNotEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValuePresent("oauth_client_secret_wo_hash"))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthClientSecretWoVersionNotEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValuePresent("oauth_client_secret_wo_version"))
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenValidityNotEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
 	a.AddAssertion(assert.ValuePresent("oauth_refresh_token_validity"))
 	return a
@@ -253,3 +333,8 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	a.AddAssertion(assert.ValuePresent("oauth_token_endpoint"))
 	return a
 }
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasTagsNotEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.AddAssertion(assert.ValuePresent("tags"))
+	return a
+}
@@ -77,6 +77,21 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOau
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthClientSecretWoString(expected string) *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo", expected))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthClientSecretWoHashString(expected string) *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo_hash", expected))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthClientSecretWoVersionString(expected string) *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo_version", expected))
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthRefreshTokenValidityString(expected string) *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
 	a.AddAssertion(assert.ValueSet("oauth_refresh_token_validity", expected))
 	return a
@@ -87,6 +102,11 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOau
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasTagsString(expected string) *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueSet("tags", expected))
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -131,6 +151,21 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasNoO
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasNoOauthClientSecretWo() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueNotSet("oauth_client_secret_wo"))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasNoOauthClientSecretWoHash() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueNotSet("oauth_client_secret_wo_hash"))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasNoOauthClientSecretWoVersion() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueNotSet("oauth_client_secret_wo_version"))
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasNoOauthRefreshTokenValidity() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
 	a.AddAssertion(assert.ValueNotSet("oauth_refresh_token_validity"))
 	return a
@@ -141,6 +176,11 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasNoO
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasNoTags() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueNotSet("tags"))
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -170,6 +210,26 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOau
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthClientSecretEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret", ""))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthClientSecretWoEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo", ""))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthClientSecretWoHashEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo_hash", ""))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthClientSecretWoVersionEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueSet("oauth_client_secret_wo_version", ""))
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthRefreshTokenValidityEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
 	a.AddAssertion(assert.ValueSet("oauth_refresh_token_validity", ""))
 	return a
@@ -180,6 +240,11 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOau
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasTagsEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValueSet("tags", ""))
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -224,6 +289,21 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOau
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthClientSecretWoNotEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValuePresent("oauth_client_secret_wo"))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthClientSecretWoHashNotEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValuePresent("oauth_client_secret_wo_hash"))
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthClientSecretWoVersionNotEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValuePresent("oauth_client_secret_wo_version"))
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOauthRefreshTokenValidityNotEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
 	a.AddAssertion(assert.ValuePresent("oauth_refresh_token_validity"))
 	return a
@@ -233,3 +313,8 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOau
 	a.AddAssertion(assert.ValuePresent("oauth_token_endpoint"))
 	return a
 }
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasTagsNotEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.AddAssertion(assert.ValuePresent("tags"))
+	return a
+}
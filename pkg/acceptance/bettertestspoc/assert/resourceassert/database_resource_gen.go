@@ -117,6 +117,11 @@ func (d *DatabaseResourceAssert) HasSuspendTaskAfterNumFailuresString(expected s
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasTagsString(expected string) *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValueSet("tags", expected))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasTaskAutoRetryAttemptsString(expected string) *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValueSet("task_auto_retry_attempts", expected))
 	return d
@@ -226,6 +231,11 @@ func (d *DatabaseResourceAssert) HasNoSuspendTaskAfterNumFailures() *DatabaseRes
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasNoTags() *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValueNotSet("tags"))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasNoTaskAutoRetryAttempts() *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValueNotSet("task_auto_retry_attempts"))
 	return d
@@ -335,6 +345,11 @@ func (d *DatabaseResourceAssert) HasSuspendTaskAfterNumFailuresEmpty() *Database
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasTagsEmpty() *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValueSet("tags", ""))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasTaskAutoRetryAttemptsEmpty() *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValueSet("task_auto_retry_attempts", ""))
 	return d
@@ -444,6 +459,11 @@ func (d *DatabaseResourceAssert) HasSuspendTaskAfterNumFailuresNotEmpty() *Datab
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasTagsNotEmpty() *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValuePresent("tags"))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasTaskAutoRetryAttemptsNotEmpty() *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValuePresent("task_auto_retry_attempts"))
 	return d
@@ -52,6 +52,11 @@ func (d *DatabaseRoleResourceAssert) HasFullyQualifiedNameString(expected string
 	return d
 }
 
+func (d *DatabaseRoleResourceAssert) HasTagsString(expected string) *DatabaseRoleResourceAssert {
+	d.AddAssertion(assert.ValueSet("tags", expected))
+	return d
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -76,6 +81,11 @@ func (d *DatabaseRoleResourceAssert) HasNoFullyQualifiedName() *DatabaseRoleReso
 	return d
 }
 
+func (d *DatabaseRoleResourceAssert) HasNoTags() *DatabaseRoleResourceAssert {
+	d.AddAssertion(assert.ValueNotSet("tags"))
+	return d
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -90,6 +100,11 @@ func (d *DatabaseRoleResourceAssert) HasFullyQualifiedNameEmpty() *DatabaseRoleR
 	return d
 }
 
+func (d *DatabaseRoleResourceAssert) HasTagsEmpty() *DatabaseRoleResourceAssert {
+	d.AddAssertion(assert.ValueSet("tags", ""))
+	return d
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -113,3 +128,8 @@ func (d *DatabaseRoleResourceAssert) HasFullyQualifiedNameNotEmpty() *DatabaseRo
 	d.AddAssertion(assert.ValuePresent("fully_qualified_name"))
 	return d
 }
+
+func (d *DatabaseRoleResourceAssert) HasTagsNotEmpty() *DatabaseRoleResourceAssert {
+	d.AddAssertion(assert.ValuePresent("tags"))
+	return d
+}
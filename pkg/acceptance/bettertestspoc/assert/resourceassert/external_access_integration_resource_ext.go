@@ -0,0 +1,32 @@
+package resourceassert
+
+import (
+	"strconv"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func (e *ExternalAccessIntegrationResourceAssert) HasAllowedNetworkRules(ids ...sdk.SchemaObjectIdentifier) *ExternalAccessIntegrationResourceAssert {
+	e.AddAssertion(assert.ValueSet("allowed_network_rules.#", strconv.Itoa(len(ids))))
+	for _, id := range ids {
+		e.AddAssertion(assert.SetElem("allowed_network_rules.*", id.FullyQualifiedName()))
+	}
+	return e
+}
+
+func (e *ExternalAccessIntegrationResourceAssert) HasAllowedApiAuthenticationIntegrations(ids ...sdk.AccountObjectIdentifier) *ExternalAccessIntegrationResourceAssert {
+	e.AddAssertion(assert.ValueSet("allowed_api_authentication_integrations.#", strconv.Itoa(len(ids))))
+	for _, id := range ids {
+		e.AddAssertion(assert.SetElem("allowed_api_authentication_integrations.*", id.FullyQualifiedName()))
+	}
+	return e
+}
+
+func (e *ExternalAccessIntegrationResourceAssert) HasAllowedAuthenticationSecrets(ids ...sdk.SchemaObjectIdentifier) *ExternalAccessIntegrationResourceAssert {
+	e.AddAssertion(assert.ValueSet("allowed_authentication_secrets.#", strconv.Itoa(len(ids))))
+	for _, id := range ids {
+		e.AddAssertion(assert.SetElem("allowed_authentication_secrets.*", id.FullyQualifiedName()))
+	}
+	return e
+}
@@ -122,6 +122,11 @@ func (e *ExternalOauthSecurityIntegrationResourceAssert) HasRelatedParametersStr
 	return e
 }
 
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasTagsString(expected string) *ExternalOauthSecurityIntegrationResourceAssert {
+	e.AddAssertion(assert.ValueSet("tags", expected))
+	return e
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -186,6 +191,11 @@ func (e *ExternalOauthSecurityIntegrationResourceAssert) HasNoFullyQualifiedName
 	return e
 }
 
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasNoTags() *ExternalOauthSecurityIntegrationResourceAssert {
+	e.AddAssertion(assert.ValueNotSet("tags"))
+	return e
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -250,6 +260,11 @@ func (e *ExternalOauthSecurityIntegrationResourceAssert) HasRelatedParametersEmp
 	return e
 }
 
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasTagsEmpty() *ExternalOauthSecurityIntegrationResourceAssert {
+	e.AddAssertion(assert.ValueSet("tags", ""))
+	return e
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -313,3 +328,8 @@ func (e *ExternalOauthSecurityIntegrationResourceAssert) HasFullyQualifiedNameNo
 	e.AddAssertion(assert.ValuePresent("fully_qualified_name"))
 	return e
 }
+
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasTagsNotEmpty() *ExternalOauthSecurityIntegrationResourceAssert {
+	e.AddAssertion(assert.ValuePresent("tags"))
+	return e
+}
@@ -25,6 +25,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "Account",
 		schema: resources.Account().Schema,
 	},
+	{
+		name:   "AccountBudget",
+		schema: resources.AccountBudget().Schema,
+	},
 	{
 		name:   "AccountParameter",
 		schema: resources.AccountParameter().Schema,
@@ -33,6 +37,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "AccountRole",
 		schema: resources.AccountRole().Schema,
 	},
+	{
+		name:   "Alert",
+		schema: resources.Alert().Schema,
+	},
 	{
 		name:   "ApiAuthenticationIntegrationWithAuthorizationCodeGrant",
 		schema: resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant().Schema,
@@ -45,6 +53,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "AuthenticationPolicy",
 		schema: resources.AuthenticationPolicy().Schema,
 	},
+	{
+		name:   "Budget",
+		schema: resources.Budget().Schema,
+	},
 	{
 		name:   "ComputePool",
 		schema: resources.ComputePool().Schema,
@@ -61,6 +73,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "Database",
 		schema: resources.Database().Schema,
 	},
+	{
+		name:   "DatabaseFromListing",
+		schema: resources.DatabaseFromListing().Schema,
+	},
 	{
 		name:   "DatabaseRole",
 		schema: resources.DatabaseRole().Schema,
@@ -146,10 +162,30 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "OauthIntegrationForPartnerApplications",
 		schema: resources.OauthIntegrationForPartnerApplications().Schema,
 	},
+	{
+		name:   "OrganizationListing",
+		schema: resources.OrganizationListing().Schema,
+	},
+	{
+		name:   "PackagesPolicy",
+		schema: resources.PackagesPolicy().Schema,
+	},
+	{
+		name:   "PasswordPolicy",
+		schema: resources.PasswordPolicy().Schema,
+	},
 	{
 		name:   "PrimaryConnection",
 		schema: resources.PrimaryConnection().Schema,
 	},
+	{
+		name:   "PrivacyPolicy",
+		schema: resources.PrivacyPolicy().Schema,
+	},
+	{
+		name:   "PrivacyPolicyAttachment",
+		schema: resources.PrivacyPolicyAttachment().Schema,
+	},
 	{
 		name:   "ProcedureJava",
 		schema: resources.ProcedureJava().Schema,
@@ -230,6 +266,22 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "SharedDatabase",
 		schema: resources.SharedDatabase().Schema,
 	},
+	{
+		name:   "SnapshotPolicy",
+		schema: resources.SnapshotPolicy().Schema,
+	},
+	{
+		name:   "SnapshotSet",
+		schema: resources.SnapshotSet().Schema,
+	},
+	{
+		name:   "StorageLifecyclePolicy",
+		schema: resources.StorageLifecyclePolicy().Schema,
+	},
+	{
+		name:   "StorageLifecyclePolicyAttachment",
+		schema: resources.StorageLifecyclePolicyAttachment().Schema,
+	},
 	{
 		name:   "Streamlit",
 		schema: resources.Streamlit().Schema,
@@ -254,6 +306,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "Table",
 		schema: resources.Table().Schema,
 	},
+	{
+		name:   "TableColumnPrivacyDomain",
+		schema: resources.TableColumnPrivacyDomain().Schema,
+	},
 	{
 		name:   "Tag",
 		schema: resources.Tag().Schema,
@@ -266,6 +322,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "Task",
 		schema: resources.Task().Schema,
 	},
+	{
+		name:   "TaskGraph",
+		schema: resources.TaskGraph().Schema,
+	},
 	{
 		name:   "User",
 		schema: resources.User().Schema,
@@ -274,6 +334,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "UserProgrammaticAccessToken",
 		schema: resources.UserProgrammaticAccessToken().Schema,
 	},
+	{
+		name:   "UserRsaKeyPair",
+		schema: resources.UserRsaKeyPair().Schema,
+	},
 	{
 		name:   "View",
 		schema: resources.View().Schema,
@@ -314,8 +378,32 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "Stage",
 		schema: resources.Stage().Schema,
 	},
+	{
+		name:   "StageFile",
+		schema: resources.StageFile().Schema,
+	},
 	{
 		name:   "DynamicTable",
 		schema: resources.DynamicTable().Schema,
 	},
+	{
+		name:   "IcebergTable",
+		schema: resources.IcebergTable().Schema,
+	},
+	{
+		name:   "ExternallyManagedIcebergTable",
+		schema: resources.ExternallyManagedIcebergTable().Schema,
+	},
+	{
+		name:   "CatalogIntegration",
+		schema: resources.CatalogIntegration().Schema,
+	},
+	{
+		name:   "HybridTable",
+		schema: resources.HybridTable().Schema,
+	},
+	{
+		name:   "ExternalAccessIntegration",
+		schema: resources.ExternalAccessIntegration().Schema,
+	},
 }
@@ -257,6 +257,21 @@ func (l *LegacyServiceUserResourceAssert) HasPasswordString(expected string) *Le
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasPasswordWoString(expected string) *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueSet("password_wo", expected))
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasPasswordWoHashString(expected string) *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueSet("password_wo_hash", expected))
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasPasswordWoVersionString(expected string) *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueSet("password_wo_version", expected))
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasPreventUnloadToInternalStagesString(expected string) *LegacyServiceUserResourceAssert {
 	l.AddAssertion(assert.ValueSet("prevent_unload_to_internal_stages", expected))
 	return l
@@ -317,6 +332,11 @@ func (l *LegacyServiceUserResourceAssert) HasStrictJsonOutputString(expected str
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasTagsString(expected string) *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueSet("tags", expected))
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasTimeInputFormatString(expected string) *LegacyServiceUserResourceAssert {
 	l.AddAssertion(assert.ValueSet("time_input_format", expected))
 	return l
@@ -641,6 +661,21 @@ func (l *LegacyServiceUserResourceAssert) HasNoPassword() *LegacyServiceUserReso
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasNoPasswordWo() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueNotSet("password_wo"))
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasNoPasswordWoHash() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueNotSet("password_wo_hash"))
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasNoPasswordWoVersion() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueNotSet("password_wo_version"))
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasNoPreventUnloadToInternalStages() *LegacyServiceUserResourceAssert {
 	l.AddAssertion(assert.ValueNotSet("prevent_unload_to_internal_stages"))
 	return l
@@ -701,6 +736,11 @@ func (l *LegacyServiceUserResourceAssert) HasNoStrictJsonOutput() *LegacyService
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasNoTags() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueNotSet("tags"))
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasNoTimeInputFormat() *LegacyServiceUserResourceAssert {
 	l.AddAssertion(assert.ValueNotSet("time_input_format"))
 	return l
@@ -1020,6 +1060,21 @@ func (l *LegacyServiceUserResourceAssert) HasPasswordEmpty() *LegacyServiceUserR
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasPasswordWoEmpty() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueSet("password_wo", ""))
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasPasswordWoHashEmpty() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueSet("password_wo_hash", ""))
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasPasswordWoVersionEmpty() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueSet("password_wo_version", ""))
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasPreventUnloadToInternalStagesEmpty() *LegacyServiceUserResourceAssert {
 	l.AddAssertion(assert.ValueSet("prevent_unload_to_internal_stages", ""))
 	return l
@@ -1080,6 +1135,11 @@ func (l *LegacyServiceUserResourceAssert) HasStrictJsonOutputEmpty() *LegacyServ
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasTagsEmpty() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValueSet("tags", ""))
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasTimeInputFormatEmpty() *LegacyServiceUserResourceAssert {
 	l.AddAssertion(assert.ValueSet("time_input_format", ""))
 	return l
@@ -1404,6 +1464,21 @@ func (l *LegacyServiceUserResourceAssert) HasPasswordNotEmpty() *LegacyServiceUs
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasPasswordWoNotEmpty() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValuePresent("password_wo"))
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasPasswordWoHashNotEmpty() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValuePresent("password_wo_hash"))
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasPasswordWoVersionNotEmpty() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValuePresent("password_wo_version"))
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasPreventUnloadToInternalStagesNotEmpty() *LegacyServiceUserResourceAssert {
 	l.AddAssertion(assert.ValuePresent("prevent_unload_to_internal_stages"))
 	return l
@@ -1464,6 +1539,11 @@ func (l *LegacyServiceUserResourceAssert) HasStrictJsonOutputNotEmpty() *LegacyS
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasTagsNotEmpty() *LegacyServiceUserResourceAssert {
+	l.AddAssertion(assert.ValuePresent("tags"))
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasTimeInputFormatNotEmpty() *LegacyServiceUserResourceAssert {
 	l.AddAssertion(assert.ValuePresent("time_input_format"))
 	return l
@@ -1,6 +1,8 @@
 package resourceassert
 
 import (
+	"strconv"
+
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
@@ -44,3 +46,13 @@ func (l *ListingResourceAssert) HasNoManifest() *ListingResourceAssert {
 	l.AddAssertion(assert.ValueNotSet("manifest"))
 	return l
 }
+
+func (l *ListingResourceAssert) HasManifestStructuredTitle(title string) *ListingResourceAssert {
+	l.AddAssertion(assert.ValueSet("manifest.0.structured.0.title", title))
+	return l
+}
+
+func (l *ListingResourceAssert) HasVersionsLength(len int) *ListingResourceAssert {
+	l.AddAssertion(assert.ValueSet("versions.#", strconv.Itoa(len)))
+	return l
+}
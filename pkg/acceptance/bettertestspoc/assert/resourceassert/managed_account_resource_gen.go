@@ -47,6 +47,11 @@ func (m *ManagedAccountResourceAssert) HasAdminPasswordString(expected string) *
 	return m
 }
 
+func (m *ManagedAccountResourceAssert) HasAdminPasswordWoString(expected string) *ManagedAccountResourceAssert {
+	m.AddAssertion(assert.ValueSet("admin_password_wo", expected))
+	return m
+}
+
 func (m *ManagedAccountResourceAssert) HasCloudString(expected string) *ManagedAccountResourceAssert {
 	m.AddAssertion(assert.ValueSet("cloud", expected))
 	return m
@@ -106,6 +111,11 @@ func (m *ManagedAccountResourceAssert) HasNoAdminPassword() *ManagedAccountResou
 	return m
 }
 
+func (m *ManagedAccountResourceAssert) HasNoAdminPasswordWo() *ManagedAccountResourceAssert {
+	m.AddAssertion(assert.ValueNotSet("admin_password_wo"))
+	return m
+}
+
 func (m *ManagedAccountResourceAssert) HasNoCloud() *ManagedAccountResourceAssert {
 	m.AddAssertion(assert.ValueNotSet("cloud"))
 	return m
@@ -150,6 +160,16 @@ func (m *ManagedAccountResourceAssert) HasNoUrl() *ManagedAccountResourceAssert
 // Attribute empty checks //
 ////////////////////////////
 
+func (m *ManagedAccountResourceAssert) HasAdminPasswordEmpty() *ManagedAccountResourceAssert {
+	m.AddAssertion(assert.ValueSet("admin_password", ""))
+	return m
+}
+
+func (m *ManagedAccountResourceAssert) HasAdminPasswordWoEmpty() *ManagedAccountResourceAssert {
+	m.AddAssertion(assert.ValueSet("admin_password_wo", ""))
+	return m
+}
+
 func (m *ManagedAccountResourceAssert) HasCloudEmpty() *ManagedAccountResourceAssert {
 	m.AddAssertion(assert.ValueSet("cloud", ""))
 	return m
@@ -209,6 +229,11 @@ func (m *ManagedAccountResourceAssert) HasAdminPasswordNotEmpty() *ManagedAccoun
 	return m
 }
 
+func (m *ManagedAccountResourceAssert) HasAdminPasswordWoNotEmpty() *ManagedAccountResourceAssert {
+	m.AddAssertion(assert.ValuePresent("admin_password_wo"))
+	return m
+}
+
 func (m *ManagedAccountResourceAssert) HasCloudNotEmpty() *ManagedAccountResourceAssert {
 	m.AddAssertion(assert.ValuePresent("cloud"))
 	return m
@@ -67,6 +67,11 @@ func (n *NetworkPolicyResourceAssert) HasFullyQualifiedNameString(expected strin
 	return n
 }
 
+func (n *NetworkPolicyResourceAssert) HasTagsString(expected string) *NetworkPolicyResourceAssert {
+	n.AddAssertion(assert.ValueSet("tags", expected))
+	return n
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -86,6 +91,11 @@ func (n *NetworkPolicyResourceAssert) HasNoFullyQualifiedName() *NetworkPolicyRe
 	return n
 }
 
+func (n *NetworkPolicyResourceAssert) HasNoTags() *NetworkPolicyResourceAssert {
+	n.AddAssertion(assert.ValueNotSet("tags"))
+	return n
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -120,6 +130,11 @@ func (n *NetworkPolicyResourceAssert) HasFullyQualifiedNameEmpty() *NetworkPolic
 	return n
 }
 
+func (n *NetworkPolicyResourceAssert) HasTagsEmpty() *NetworkPolicyResourceAssert {
+	n.AddAssertion(assert.ValueSet("tags", ""))
+	return n
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -138,3 +153,8 @@ func (n *NetworkPolicyResourceAssert) HasFullyQualifiedNameNotEmpty() *NetworkPo
 	n.AddAssertion(assert.ValuePresent("fully_qualified_name"))
 	return n
 }
+
+func (n *NetworkPolicyResourceAssert) HasTagsNotEmpty() *NetworkPolicyResourceAssert {
+	n.AddAssertion(assert.ValuePresent("tags"))
+	return n
+}
@@ -117,6 +117,11 @@ func (o *OauthIntegrationForCustomClientsResourceAssert) HasRelatedParametersStr
 	return o
 }
 
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasTagsString(expected string) *OauthIntegrationForCustomClientsResourceAssert {
+	o.AddAssertion(assert.ValueSet("tags", expected))
+	return o
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -191,6 +196,11 @@ func (o *OauthIntegrationForCustomClientsResourceAssert) HasNoOauthUseSecondaryR
 	return o
 }
 
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasNoTags() *OauthIntegrationForCustomClientsResourceAssert {
+	o.AddAssertion(assert.ValueNotSet("tags"))
+	return o
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -265,6 +275,11 @@ func (o *OauthIntegrationForCustomClientsResourceAssert) HasRelatedParametersEmp
 	return o
 }
 
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasTagsEmpty() *OauthIntegrationForCustomClientsResourceAssert {
+	o.AddAssertion(assert.ValueSet("tags", ""))
+	return o
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -338,3 +353,8 @@ func (o *OauthIntegrationForCustomClientsResourceAssert) HasOauthUseSecondaryRol
 	o.AddAssertion(assert.ValuePresent("oauth_use_secondary_roles"))
 	return o
 }
+
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasTagsNotEmpty() *OauthIntegrationForCustomClientsResourceAssert {
+	o.AddAssertion(assert.ValuePresent("tags"))
+	return o
+}
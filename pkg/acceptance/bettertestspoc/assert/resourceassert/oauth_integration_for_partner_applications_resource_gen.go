@@ -87,6 +87,11 @@ func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasRelatedParamet
 	return o
 }
 
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasTagsString(expected string) *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.AddAssertion(assert.ValueSet("tags", expected))
+	return o
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -136,6 +141,11 @@ func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasNoOauthUseSeco
 	return o
 }
 
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasNoTags() *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.AddAssertion(assert.ValueNotSet("tags"))
+	return o
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -185,6 +195,11 @@ func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasRelatedParamet
 	return o
 }
 
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasTagsEmpty() *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.AddAssertion(assert.ValueSet("tags", ""))
+	return o
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -233,3 +248,8 @@ func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasOauthUseSecond
 	o.AddAssertion(assert.ValuePresent("oauth_use_secondary_roles"))
 	return o
 }
+
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasTagsNotEmpty() *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.AddAssertion(assert.ValuePresent("tags"))
+	return o
+}
@@ -122,6 +122,11 @@ func (s *Saml2SecurityIntegrationResourceAssert) HasSaml2X509CertString(expected
 	return s
 }
 
+func (s *Saml2SecurityIntegrationResourceAssert) HasTagsString(expected string) *Saml2SecurityIntegrationResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", expected))
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -206,6 +211,11 @@ func (s *Saml2SecurityIntegrationResourceAssert) HasNoSaml2X509Cert() *Saml2Secu
 	return s
 }
 
+func (s *Saml2SecurityIntegrationResourceAssert) HasNoTags() *Saml2SecurityIntegrationResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("tags"))
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -275,6 +285,11 @@ func (s *Saml2SecurityIntegrationResourceAssert) HasSaml2SpInitiatedLoginPageLab
 	return s
 }
 
+func (s *Saml2SecurityIntegrationResourceAssert) HasTagsEmpty() *Saml2SecurityIntegrationResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", ""))
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -358,3 +373,8 @@ func (s *Saml2SecurityIntegrationResourceAssert) HasSaml2X509CertNotEmpty() *Sam
 	s.AddAssertion(assert.ValuePresent("saml2_x509_cert"))
 	return s
 }
+
+func (s *Saml2SecurityIntegrationResourceAssert) HasTagsNotEmpty() *Saml2SecurityIntegrationResourceAssert {
+	s.AddAssertion(assert.ValuePresent("tags"))
+	return s
+}
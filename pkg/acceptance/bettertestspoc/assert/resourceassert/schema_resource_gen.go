@@ -117,6 +117,11 @@ func (s *SchemaResourceAssert) HasSuspendTaskAfterNumFailuresString(expected str
 	return s
 }
 
+func (s *SchemaResourceAssert) HasTagsString(expected string) *SchemaResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", expected))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasTaskAutoRetryAttemptsString(expected string) *SchemaResourceAssert {
 	s.AddAssertion(assert.ValueSet("task_auto_retry_attempts", expected))
 	return s
@@ -236,6 +241,11 @@ func (s *SchemaResourceAssert) HasNoSuspendTaskAfterNumFailures() *SchemaResourc
 	return s
 }
 
+func (s *SchemaResourceAssert) HasNoTags() *SchemaResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("tags"))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasNoTaskAutoRetryAttempts() *SchemaResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("task_auto_retry_attempts"))
 	return s
@@ -345,6 +355,11 @@ func (s *SchemaResourceAssert) HasSuspendTaskAfterNumFailuresEmpty() *SchemaReso
 	return s
 }
 
+func (s *SchemaResourceAssert) HasTagsEmpty() *SchemaResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", ""))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasTaskAutoRetryAttemptsEmpty() *SchemaResourceAssert {
 	s.AddAssertion(assert.ValueSet("task_auto_retry_attempts", ""))
 	return s
@@ -464,6 +479,11 @@ func (s *SchemaResourceAssert) HasSuspendTaskAfterNumFailuresNotEmpty() *SchemaR
 	return s
 }
 
+func (s *SchemaResourceAssert) HasTagsNotEmpty() *SchemaResourceAssert {
+	s.AddAssertion(assert.ValuePresent("tags"))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasTaskAutoRetryAttemptsNotEmpty() *SchemaResourceAssert {
 	s.AddAssertion(assert.ValuePresent("task_auto_retry_attempts"))
 	return s
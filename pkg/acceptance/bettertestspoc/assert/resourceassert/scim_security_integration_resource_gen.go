@@ -72,6 +72,11 @@ func (s *ScimSecurityIntegrationResourceAssert) HasSyncPasswordString(expected s
 	return s
 }
 
+func (s *ScimSecurityIntegrationResourceAssert) HasTagsString(expected string) *ScimSecurityIntegrationResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", expected))
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -116,6 +121,11 @@ func (s *ScimSecurityIntegrationResourceAssert) HasNoSyncPassword() *ScimSecurit
 	return s
 }
 
+func (s *ScimSecurityIntegrationResourceAssert) HasNoTags() *ScimSecurityIntegrationResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("tags"))
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -140,6 +150,11 @@ func (s *ScimSecurityIntegrationResourceAssert) HasSyncPasswordEmpty() *ScimSecu
 	return s
 }
 
+func (s *ScimSecurityIntegrationResourceAssert) HasTagsEmpty() *ScimSecurityIntegrationResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", ""))
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -183,3 +198,8 @@ func (s *ScimSecurityIntegrationResourceAssert) HasSyncPasswordNotEmpty() *ScimS
 	s.AddAssertion(assert.ValuePresent("sync_password"))
 	return s
 }
+
+func (s *ScimSecurityIntegrationResourceAssert) HasTagsNotEmpty() *ScimSecurityIntegrationResourceAssert {
+	s.AddAssertion(assert.ValuePresent("tags"))
+	return s
+}
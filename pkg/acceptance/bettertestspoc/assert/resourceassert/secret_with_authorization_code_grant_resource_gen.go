@@ -72,6 +72,21 @@ func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenExp
 	return s
 }
 
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenWoString(expected string) *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValueSet("oauth_refresh_token_wo", expected))
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenWoHashString(expected string) *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValueSet("oauth_refresh_token_wo_hash", expected))
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenWoVersionString(expected string) *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValueSet("oauth_refresh_token_wo_version", expected))
+	return s
+}
+
 func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasSecretTypeString(expected string) *SecretWithAuthorizationCodeGrantResourceAssert {
 	s.AddAssertion(assert.ValueSet("secret_type", expected))
 	return s
@@ -121,6 +136,21 @@ func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasNoOauthRefreshTokenE
 	return s
 }
 
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasNoOauthRefreshTokenWo() *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("oauth_refresh_token_wo"))
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasNoOauthRefreshTokenWoHash() *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("oauth_refresh_token_wo_hash"))
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasNoOauthRefreshTokenWoVersion() *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("oauth_refresh_token_wo_version"))
+	return s
+}
+
 func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasNoSecretType() *SecretWithAuthorizationCodeGrantResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("secret_type"))
 	return s
@@ -140,6 +170,26 @@ func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasFullyQualifiedNameEm
 	return s
 }
 
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenEmpty() *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValueSet("oauth_refresh_token", ""))
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenWoEmpty() *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValueSet("oauth_refresh_token_wo", ""))
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenWoHashEmpty() *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValueSet("oauth_refresh_token_wo_hash", ""))
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenWoVersionEmpty() *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValueSet("oauth_refresh_token_wo_version", ""))
+	return s
+}
+
 func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasSecretTypeEmpty() *SecretWithAuthorizationCodeGrantResourceAssert {
 	s.AddAssertion(assert.ValueSet("secret_type", ""))
 	return s
@@ -189,6 +239,21 @@ func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenExp
 	return s
 }
 
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenWoNotEmpty() *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValuePresent("oauth_refresh_token_wo"))
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenWoHashNotEmpty() *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValuePresent("oauth_refresh_token_wo_hash"))
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasOauthRefreshTokenWoVersionNotEmpty() *SecretWithAuthorizationCodeGrantResourceAssert {
+	s.AddAssertion(assert.ValuePresent("oauth_refresh_token_wo_version"))
+	return s
+}
+
 func (s *SecretWithAuthorizationCodeGrantResourceAssert) HasSecretTypeNotEmpty() *SecretWithAuthorizationCodeGrantResourceAssert {
 	s.AddAssertion(assert.ValuePresent("secret_type"))
 	return s
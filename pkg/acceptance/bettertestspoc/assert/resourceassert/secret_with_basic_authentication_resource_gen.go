@@ -62,6 +62,21 @@ func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordString(expected
 	return s
 }
 
+func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordWoString(expected string) *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValueSet("password_wo", expected))
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordWoHashString(expected string) *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValueSet("password_wo_hash", expected))
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordWoVersionString(expected string) *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValueSet("password_wo_version", expected))
+	return s
+}
+
 func (s *SecretWithBasicAuthenticationResourceAssert) HasSecretTypeString(expected string) *SecretWithBasicAuthenticationResourceAssert {
 	s.AddAssertion(assert.ValueSet("secret_type", expected))
 	return s
@@ -106,6 +121,21 @@ func (s *SecretWithBasicAuthenticationResourceAssert) HasNoPassword() *SecretWit
 	return s
 }
 
+func (s *SecretWithBasicAuthenticationResourceAssert) HasNoPasswordWo() *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("password_wo"))
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationResourceAssert) HasNoPasswordWoHash() *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("password_wo_hash"))
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationResourceAssert) HasNoPasswordWoVersion() *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("password_wo_version"))
+	return s
+}
+
 func (s *SecretWithBasicAuthenticationResourceAssert) HasNoSecretType() *SecretWithBasicAuthenticationResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("secret_type"))
 	return s
@@ -130,6 +160,26 @@ func (s *SecretWithBasicAuthenticationResourceAssert) HasFullyQualifiedNameEmpty
 	return s
 }
 
+func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordEmpty() *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValueSet("password", ""))
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordWoEmpty() *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValueSet("password_wo", ""))
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordWoHashEmpty() *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValueSet("password_wo_hash", ""))
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordWoVersionEmpty() *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValueSet("password_wo_version", ""))
+	return s
+}
+
 func (s *SecretWithBasicAuthenticationResourceAssert) HasSecretTypeEmpty() *SecretWithBasicAuthenticationResourceAssert {
 	s.AddAssertion(assert.ValueSet("secret_type", ""))
 	return s
@@ -169,6 +219,21 @@ func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordNotEmpty() *Sec
 	return s
 }
 
+func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordWoNotEmpty() *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValuePresent("password_wo"))
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordWoHashNotEmpty() *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValuePresent("password_wo_hash"))
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationResourceAssert) HasPasswordWoVersionNotEmpty() *SecretWithBasicAuthenticationResourceAssert {
+	s.AddAssertion(assert.ValuePresent("password_wo_version"))
+	return s
+}
+
 func (s *SecretWithBasicAuthenticationResourceAssert) HasSecretTypeNotEmpty() *SecretWithBasicAuthenticationResourceAssert {
 	s.AddAssertion(assert.ValuePresent("secret_type"))
 	return s
@@ -62,6 +62,21 @@ func (s *SecretWithGenericStringResourceAssert) HasSecretStringString(expected s
 	return s
 }
 
+func (s *SecretWithGenericStringResourceAssert) HasSecretStringWoString(expected string) *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValueSet("secret_string_wo", expected))
+	return s
+}
+
+func (s *SecretWithGenericStringResourceAssert) HasSecretStringWoHashString(expected string) *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValueSet("secret_string_wo_hash", expected))
+	return s
+}
+
+func (s *SecretWithGenericStringResourceAssert) HasSecretStringWoVersionString(expected string) *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValueSet("secret_string_wo_version", expected))
+	return s
+}
+
 func (s *SecretWithGenericStringResourceAssert) HasSecretTypeString(expected string) *SecretWithGenericStringResourceAssert {
 	s.AddAssertion(assert.ValueSet("secret_type", expected))
 	return s
@@ -101,6 +116,21 @@ func (s *SecretWithGenericStringResourceAssert) HasNoSecretString() *SecretWithG
 	return s
 }
 
+func (s *SecretWithGenericStringResourceAssert) HasNoSecretStringWo() *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("secret_string_wo"))
+	return s
+}
+
+func (s *SecretWithGenericStringResourceAssert) HasNoSecretStringWoHash() *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("secret_string_wo_hash"))
+	return s
+}
+
+func (s *SecretWithGenericStringResourceAssert) HasNoSecretStringWoVersion() *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("secret_string_wo_version"))
+	return s
+}
+
 func (s *SecretWithGenericStringResourceAssert) HasNoSecretType() *SecretWithGenericStringResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("secret_type"))
 	return s
@@ -120,6 +150,26 @@ func (s *SecretWithGenericStringResourceAssert) HasFullyQualifiedNameEmpty() *Se
 	return s
 }
 
+func (s *SecretWithGenericStringResourceAssert) HasSecretStringEmpty() *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValueSet("secret_string", ""))
+	return s
+}
+
+func (s *SecretWithGenericStringResourceAssert) HasSecretStringWoEmpty() *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValueSet("secret_string_wo", ""))
+	return s
+}
+
+func (s *SecretWithGenericStringResourceAssert) HasSecretStringWoHashEmpty() *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValueSet("secret_string_wo_hash", ""))
+	return s
+}
+
+func (s *SecretWithGenericStringResourceAssert) HasSecretStringWoVersionEmpty() *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValueSet("secret_string_wo_version", ""))
+	return s
+}
+
 func (s *SecretWithGenericStringResourceAssert) HasSecretTypeEmpty() *SecretWithGenericStringResourceAssert {
 	s.AddAssertion(assert.ValueSet("secret_type", ""))
 	return s
@@ -159,6 +209,21 @@ func (s *SecretWithGenericStringResourceAssert) HasSecretStringNotEmpty() *Secre
 	return s
 }
 
+func (s *SecretWithGenericStringResourceAssert) HasSecretStringWoNotEmpty() *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValuePresent("secret_string_wo"))
+	return s
+}
+
+func (s *SecretWithGenericStringResourceAssert) HasSecretStringWoHashNotEmpty() *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValuePresent("secret_string_wo_hash"))
+	return s
+}
+
+func (s *SecretWithGenericStringResourceAssert) HasSecretStringWoVersionNotEmpty() *SecretWithGenericStringResourceAssert {
+	s.AddAssertion(assert.ValuePresent("secret_string_wo_version"))
+	return s
+}
+
 func (s *SecretWithGenericStringResourceAssert) HasSecretTypeNotEmpty() *SecretWithGenericStringResourceAssert {
 	s.AddAssertion(assert.ValuePresent("secret_type"))
 	return s
@@ -307,6 +307,11 @@ func (s *ServiceUserResourceAssert) HasStrictJsonOutputString(expected string) *
 	return s
 }
 
+func (s *ServiceUserResourceAssert) HasTagsString(expected string) *ServiceUserResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", expected))
+	return s
+}
+
 func (s *ServiceUserResourceAssert) HasTimeInputFormatString(expected string) *ServiceUserResourceAssert {
 	s.AddAssertion(assert.ValueSet("time_input_format", expected))
 	return s
@@ -402,6 +407,11 @@ func (s *ServiceUserResourceAssert) HasWeekStartString(expected string) *Service
 	return s
 }
 
+func (s *ServiceUserResourceAssert) HasWorkloadIdentityString(expected string) *ServiceUserResourceAssert {
+	s.AddAssertion(assert.ValueSet("workload_identity", expected))
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -681,6 +691,11 @@ func (s *ServiceUserResourceAssert) HasNoStrictJsonOutput() *ServiceUserResource
 	return s
 }
 
+func (s *ServiceUserResourceAssert) HasNoTags() *ServiceUserResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("tags"))
+	return s
+}
+
 func (s *ServiceUserResourceAssert) HasNoTimeInputFormat() *ServiceUserResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("time_input_format"))
 	return s
@@ -1050,6 +1065,11 @@ func (s *ServiceUserResourceAssert) HasStrictJsonOutputEmpty() *ServiceUserResou
 	return s
 }
 
+func (s *ServiceUserResourceAssert) HasTagsEmpty() *ServiceUserResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", ""))
+	return s
+}
+
 func (s *ServiceUserResourceAssert) HasTimeInputFormatEmpty() *ServiceUserResourceAssert {
 	s.AddAssertion(assert.ValueSet("time_input_format", ""))
 	return s
@@ -1145,6 +1165,11 @@ func (s *ServiceUserResourceAssert) HasWeekStartEmpty() *ServiceUserResourceAsse
 	return s
 }
 
+func (s *ServiceUserResourceAssert) HasWorkloadIdentityEmpty() *ServiceUserResourceAssert {
+	s.AddAssertion(assert.ValueSet("workload_identity.#", "0"))
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -1424,6 +1449,11 @@ func (s *ServiceUserResourceAssert) HasStrictJsonOutputNotEmpty() *ServiceUserRe
 	return s
 }
 
+func (s *ServiceUserResourceAssert) HasTagsNotEmpty() *ServiceUserResourceAssert {
+	s.AddAssertion(assert.ValuePresent("tags"))
+	return s
+}
+
 func (s *ServiceUserResourceAssert) HasTimeInputFormatNotEmpty() *ServiceUserResourceAssert {
 	s.AddAssertion(assert.ValuePresent("time_input_format"))
 	return s
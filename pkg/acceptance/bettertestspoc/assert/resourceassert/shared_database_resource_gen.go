@@ -97,6 +97,11 @@ func (s *SharedDatabaseResourceAssert) HasSuspendTaskAfterNumFailuresString(expe
 	return s
 }
 
+func (s *SharedDatabaseResourceAssert) HasTagsString(expected string) *SharedDatabaseResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", expected))
+	return s
+}
+
 func (s *SharedDatabaseResourceAssert) HasTaskAutoRetryAttemptsString(expected string) *SharedDatabaseResourceAssert {
 	s.AddAssertion(assert.ValueSet("task_auto_retry_attempts", expected))
 	return s
@@ -191,6 +196,11 @@ func (s *SharedDatabaseResourceAssert) HasNoSuspendTaskAfterNumFailures() *Share
 	return s
 }
 
+func (s *SharedDatabaseResourceAssert) HasNoTags() *SharedDatabaseResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("tags"))
+	return s
+}
+
 func (s *SharedDatabaseResourceAssert) HasNoTaskAutoRetryAttempts() *SharedDatabaseResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("task_auto_retry_attempts"))
 	return s
@@ -275,6 +285,11 @@ func (s *SharedDatabaseResourceAssert) HasSuspendTaskAfterNumFailuresEmpty() *Sh
 	return s
 }
 
+func (s *SharedDatabaseResourceAssert) HasTagsEmpty() *SharedDatabaseResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", ""))
+	return s
+}
+
 func (s *SharedDatabaseResourceAssert) HasTaskAutoRetryAttemptsEmpty() *SharedDatabaseResourceAssert {
 	s.AddAssertion(assert.ValueSet("task_auto_retry_attempts", ""))
 	return s
@@ -369,6 +384,11 @@ func (s *SharedDatabaseResourceAssert) HasSuspendTaskAfterNumFailuresNotEmpty()
 	return s
 }
 
+func (s *SharedDatabaseResourceAssert) HasTagsNotEmpty() *SharedDatabaseResourceAssert {
+	s.AddAssertion(assert.ValuePresent("tags"))
+	return s
+}
+
 func (s *SharedDatabaseResourceAssert) HasTaskAutoRetryAttemptsNotEmpty() *SharedDatabaseResourceAssert {
 	s.AddAssertion(assert.ValuePresent("task_auto_retry_attempts"))
 	return s
@@ -77,6 +77,11 @@ func (s *StreamOnDirectoryTableResourceAssert) HasStreamTypeString(expected stri
 	return s
 }
 
+func (s *StreamOnDirectoryTableResourceAssert) HasTagsString(expected string) *StreamOnDirectoryTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", expected))
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -126,6 +131,11 @@ func (s *StreamOnDirectoryTableResourceAssert) HasNoStreamType() *StreamOnDirect
 	return s
 }
 
+func (s *StreamOnDirectoryTableResourceAssert) HasNoTags() *StreamOnDirectoryTableResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("tags"))
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -155,6 +165,11 @@ func (s *StreamOnDirectoryTableResourceAssert) HasStreamTypeEmpty() *StreamOnDir
 	return s
 }
 
+func (s *StreamOnDirectoryTableResourceAssert) HasTagsEmpty() *StreamOnDirectoryTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", ""))
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -203,3 +218,8 @@ func (s *StreamOnDirectoryTableResourceAssert) HasStreamTypeNotEmpty() *StreamOn
 	s.AddAssertion(assert.ValuePresent("stream_type"))
 	return s
 }
+
+func (s *StreamOnDirectoryTableResourceAssert) HasTagsNotEmpty() *StreamOnDirectoryTableResourceAssert {
+	s.AddAssertion(assert.ValuePresent("tags"))
+	return s
+}
@@ -92,6 +92,11 @@ func (s *StreamOnExternalTableResourceAssert) HasStreamTypeString(expected strin
 	return s
 }
 
+func (s *StreamOnExternalTableResourceAssert) HasTagsString(expected string) *StreamOnExternalTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", expected))
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -146,6 +151,11 @@ func (s *StreamOnExternalTableResourceAssert) HasNoStreamType() *StreamOnExterna
 	return s
 }
 
+func (s *StreamOnExternalTableResourceAssert) HasNoTags() *StreamOnExternalTableResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("tags"))
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -190,6 +200,11 @@ func (s *StreamOnExternalTableResourceAssert) HasStreamTypeEmpty() *StreamOnExte
 	return s
 }
 
+func (s *StreamOnExternalTableResourceAssert) HasTagsEmpty() *StreamOnExternalTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", ""))
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -243,3 +258,8 @@ func (s *StreamOnExternalTableResourceAssert) HasStreamTypeNotEmpty() *StreamOnE
 	s.AddAssertion(assert.ValuePresent("stream_type"))
 	return s
 }
+
+func (s *StreamOnExternalTableResourceAssert) HasTagsNotEmpty() *StreamOnExternalTableResourceAssert {
+	s.AddAssertion(assert.ValuePresent("tags"))
+	return s
+}
@@ -97,6 +97,11 @@ func (s *StreamOnTableResourceAssert) HasTableString(expected string) *StreamOnT
 	return s
 }
 
+func (s *StreamOnTableResourceAssert) HasTagsString(expected string) *StreamOnTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", expected))
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -156,6 +161,11 @@ func (s *StreamOnTableResourceAssert) HasNoTable() *StreamOnTableResourceAssert
 	return s
 }
 
+func (s *StreamOnTableResourceAssert) HasNoTags() *StreamOnTableResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("tags"))
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -205,6 +215,11 @@ func (s *StreamOnTableResourceAssert) HasStreamTypeEmpty() *StreamOnTableResourc
 	return s
 }
 
+func (s *StreamOnTableResourceAssert) HasTagsEmpty() *StreamOnTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", ""))
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -263,3 +278,8 @@ func (s *StreamOnTableResourceAssert) HasTableNotEmpty() *StreamOnTableResourceA
 	s.AddAssertion(assert.ValuePresent("table"))
 	return s
 }
+
+func (s *StreamOnTableResourceAssert) HasTagsNotEmpty() *StreamOnTableResourceAssert {
+	s.AddAssertion(assert.ValuePresent("tags"))
+	return s
+}
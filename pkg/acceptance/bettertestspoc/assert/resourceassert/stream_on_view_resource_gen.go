@@ -92,6 +92,11 @@ func (s *StreamOnViewResourceAssert) HasStreamTypeString(expected string) *Strea
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasTagsString(expected string) *StreamOnViewResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", expected))
+	return s
+}
+
 func (s *StreamOnViewResourceAssert) HasViewString(expected string) *StreamOnViewResourceAssert {
 	s.AddAssertion(assert.ValueSet("view", expected))
 	return s
@@ -151,6 +156,11 @@ func (s *StreamOnViewResourceAssert) HasNoStreamType() *StreamOnViewResourceAsse
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasNoTags() *StreamOnViewResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("tags"))
+	return s
+}
+
 func (s *StreamOnViewResourceAssert) HasNoView() *StreamOnViewResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("view"))
 	return s
@@ -205,6 +215,11 @@ func (s *StreamOnViewResourceAssert) HasStreamTypeEmpty() *StreamOnViewResourceA
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasTagsEmpty() *StreamOnViewResourceAssert {
+	s.AddAssertion(assert.ValueSet("tags", ""))
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -259,6 +274,11 @@ func (s *StreamOnViewResourceAssert) HasStreamTypeNotEmpty() *StreamOnViewResour
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasTagsNotEmpty() *StreamOnViewResourceAssert {
+	s.AddAssertion(assert.ValuePresent("tags"))
+	return s
+}
+
 func (s *StreamOnViewResourceAssert) HasViewNotEmpty() *StreamOnViewResourceAssert {
 	s.AddAssertion(assert.ValuePresent("view"))
 	return s
@@ -277,6 +277,11 @@ func (t *TaskResourceAssert) HasSuspendTaskAfterNumFailuresString(expected strin
 	return t
 }
 
+func (t *TaskResourceAssert) HasTagsString(expected string) *TaskResourceAssert {
+	t.AddAssertion(assert.ValueSet("tags", expected))
+	return t
+}
+
 func (t *TaskResourceAssert) HasTaskAutoRetryAttemptsString(expected string) *TaskResourceAssert {
 	t.AddAssertion(assert.ValueSet("task_auto_retry_attempts", expected))
 	return t
@@ -636,6 +641,11 @@ func (t *TaskResourceAssert) HasNoSuspendTaskAfterNumFailures() *TaskResourceAss
 	return t
 }
 
+func (t *TaskResourceAssert) HasNoTags() *TaskResourceAssert {
+	t.AddAssertion(assert.ValueNotSet("tags"))
+	return t
+}
+
 func (t *TaskResourceAssert) HasNoTaskAutoRetryAttempts() *TaskResourceAssert {
 	t.AddAssertion(assert.ValueNotSet("task_auto_retry_attempts"))
 	return t
@@ -980,6 +990,11 @@ func (t *TaskResourceAssert) HasSuspendTaskAfterNumFailuresEmpty() *TaskResource
 	return t
 }
 
+func (t *TaskResourceAssert) HasTagsEmpty() *TaskResourceAssert {
+	t.AddAssertion(assert.ValueSet("tags", ""))
+	return t
+}
+
 func (t *TaskResourceAssert) HasTaskAutoRetryAttemptsEmpty() *TaskResourceAssert {
 	t.AddAssertion(assert.ValueSet("task_auto_retry_attempts", ""))
 	return t
@@ -1339,6 +1354,11 @@ func (t *TaskResourceAssert) HasSuspendTaskAfterNumFailuresNotEmpty() *TaskResou
 	return t
 }
 
+func (t *TaskResourceAssert) HasTagsNotEmpty() *TaskResourceAssert {
+	t.AddAssertion(assert.ValuePresent("tags"))
+	return t
+}
+
 func (t *TaskResourceAssert) HasTaskAutoRetryAttemptsNotEmpty() *TaskResourceAssert {
 	t.AddAssertion(assert.ValuePresent("task_auto_retry_attempts"))
 	return t
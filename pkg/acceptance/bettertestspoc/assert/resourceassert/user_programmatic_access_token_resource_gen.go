@@ -72,6 +72,11 @@ func (u *UserProgrammaticAccessTokenResourceAssert) HasRoleRestrictionString(exp
 	return u
 }
 
+func (u *UserProgrammaticAccessTokenResourceAssert) HasRotateBeforeExpiryDaysString(expected string) *UserProgrammaticAccessTokenResourceAssert {
+	u.AddAssertion(assert.ValueSet("rotate_before_expiry_days", expected))
+	return u
+}
+
 func (u *UserProgrammaticAccessTokenResourceAssert) HasRotatedTokenNameString(expected string) *UserProgrammaticAccessTokenResourceAssert {
 	u.AddAssertion(assert.ValueSet("rotated_token_name", expected))
 	return u
@@ -131,6 +136,11 @@ func (u *UserProgrammaticAccessTokenResourceAssert) HasNoRoleRestriction() *User
 	return u
 }
 
+func (u *UserProgrammaticAccessTokenResourceAssert) HasNoRotateBeforeExpiryDays() *UserProgrammaticAccessTokenResourceAssert {
+	u.AddAssertion(assert.ValueNotSet("rotate_before_expiry_days"))
+	return u
+}
+
 func (u *UserProgrammaticAccessTokenResourceAssert) HasNoRotatedTokenName() *UserProgrammaticAccessTokenResourceAssert {
 	u.AddAssertion(assert.ValueNotSet("rotated_token_name"))
 	return u
@@ -185,6 +195,11 @@ func (u *UserProgrammaticAccessTokenResourceAssert) HasRoleRestrictionEmpty() *U
 	return u
 }
 
+func (u *UserProgrammaticAccessTokenResourceAssert) HasRotateBeforeExpiryDaysEmpty() *UserProgrammaticAccessTokenResourceAssert {
+	u.AddAssertion(assert.ValueSet("rotate_before_expiry_days", ""))
+	return u
+}
+
 func (u *UserProgrammaticAccessTokenResourceAssert) HasRotatedTokenNameEmpty() *UserProgrammaticAccessTokenResourceAssert {
 	u.AddAssertion(assert.ValueSet("rotated_token_name", ""))
 	return u
@@ -239,6 +254,11 @@ func (u *UserProgrammaticAccessTokenResourceAssert) HasRoleRestrictionNotEmpty()
 	return u
 }
 
+func (u *UserProgrammaticAccessTokenResourceAssert) HasRotateBeforeExpiryDaysNotEmpty() *UserProgrammaticAccessTokenResourceAssert {
+	u.AddAssertion(assert.ValuePresent("rotate_before_expiry_days"))
+	return u
+}
+
 func (u *UserProgrammaticAccessTokenResourceAssert) HasRotatedTokenNameNotEmpty() *UserProgrammaticAccessTokenResourceAssert {
 	u.AddAssertion(assert.ValuePresent("rotated_token_name"))
 	return u
@@ -282,6 +282,21 @@ func (u *UserResourceAssert) HasPasswordString(expected string) *UserResourceAss
 	return u
 }
 
+func (u *UserResourceAssert) HasPasswordWoString(expected string) *UserResourceAssert {
+	u.AddAssertion(assert.ValueSet("password_wo", expected))
+	return u
+}
+
+func (u *UserResourceAssert) HasPasswordWoHashString(expected string) *UserResourceAssert {
+	u.AddAssertion(assert.ValueSet("password_wo_hash", expected))
+	return u
+}
+
+func (u *UserResourceAssert) HasPasswordWoVersionString(expected string) *UserResourceAssert {
+	u.AddAssertion(assert.ValueSet("password_wo_version", expected))
+	return u
+}
+
 func (u *UserResourceAssert) HasPreventUnloadToInternalStagesString(expected string) *UserResourceAssert {
 	u.AddAssertion(assert.ValueSet("prevent_unload_to_internal_stages", expected))
 	return u
@@ -342,6 +357,11 @@ func (u *UserResourceAssert) HasStrictJsonOutputString(expected string) *UserRes
 	return u
 }
 
+func (u *UserResourceAssert) HasTagsString(expected string) *UserResourceAssert {
+	u.AddAssertion(assert.ValueSet("tags", expected))
+	return u
+}
+
 func (u *UserResourceAssert) HasTimeInputFormatString(expected string) *UserResourceAssert {
 	u.AddAssertion(assert.ValueSet("time_input_format", expected))
 	return u
@@ -691,6 +711,21 @@ func (u *UserResourceAssert) HasNoPassword() *UserResourceAssert {
 	return u
 }
 
+func (u *UserResourceAssert) HasNoPasswordWo() *UserResourceAssert {
+	u.AddAssertion(assert.ValueNotSet("password_wo"))
+	return u
+}
+
+func (u *UserResourceAssert) HasNoPasswordWoHash() *UserResourceAssert {
+	u.AddAssertion(assert.ValueNotSet("password_wo_hash"))
+	return u
+}
+
+func (u *UserResourceAssert) HasNoPasswordWoVersion() *UserResourceAssert {
+	u.AddAssertion(assert.ValueNotSet("password_wo_version"))
+	return u
+}
+
 func (u *UserResourceAssert) HasNoPreventUnloadToInternalStages() *UserResourceAssert {
 	u.AddAssertion(assert.ValueNotSet("prevent_unload_to_internal_stages"))
 	return u
@@ -751,6 +786,11 @@ func (u *UserResourceAssert) HasNoStrictJsonOutput() *UserResourceAssert {
 	return u
 }
 
+func (u *UserResourceAssert) HasNoTags() *UserResourceAssert {
+	u.AddAssertion(assert.ValueNotSet("tags"))
+	return u
+}
+
 func (u *UserResourceAssert) HasNoTimeInputFormat() *UserResourceAssert {
 	u.AddAssertion(assert.ValueNotSet("time_input_format"))
 	return u
@@ -1095,6 +1135,21 @@ func (u *UserResourceAssert) HasPasswordEmpty() *UserResourceAssert {
 	return u
 }
 
+func (u *UserResourceAssert) HasPasswordWoEmpty() *UserResourceAssert {
+	u.AddAssertion(assert.ValueSet("password_wo", ""))
+	return u
+}
+
+func (u *UserResourceAssert) HasPasswordWoHashEmpty() *UserResourceAssert {
+	u.AddAssertion(assert.ValueSet("password_wo_hash", ""))
+	return u
+}
+
+func (u *UserResourceAssert) HasPasswordWoVersionEmpty() *UserResourceAssert {
+	u.AddAssertion(assert.ValueSet("password_wo_version", ""))
+	return u
+}
+
 func (u *UserResourceAssert) HasPreventUnloadToInternalStagesEmpty() *UserResourceAssert {
 	u.AddAssertion(assert.ValueSet("prevent_unload_to_internal_stages", ""))
 	return u
@@ -1155,6 +1210,11 @@ func (u *UserResourceAssert) HasStrictJsonOutputEmpty() *UserResourceAssert {
 	return u
 }
 
+func (u *UserResourceAssert) HasTagsEmpty() *UserResourceAssert {
+	u.AddAssertion(assert.ValueSet("tags", ""))
+	return u
+}
+
 func (u *UserResourceAssert) HasTimeInputFormatEmpty() *UserResourceAssert {
 	u.AddAssertion(assert.ValueSet("time_input_format", ""))
 	return u
@@ -1504,6 +1564,21 @@ func (u *UserResourceAssert) HasPasswordNotEmpty() *UserResourceAssert {
 	return u
 }
 
+func (u *UserResourceAssert) HasPasswordWoNotEmpty() *UserResourceAssert {
+	u.AddAssertion(assert.ValuePresent("password_wo"))
+	return u
+}
+
+func (u *UserResourceAssert) HasPasswordWoHashNotEmpty() *UserResourceAssert {
+	u.AddAssertion(assert.ValuePresent("password_wo_hash"))
+	return u
+}
+
+func (u *UserResourceAssert) HasPasswordWoVersionNotEmpty() *UserResourceAssert {
+	u.AddAssertion(assert.ValuePresent("password_wo_version"))
+	return u
+}
+
 func (u *UserResourceAssert) HasPreventUnloadToInternalStagesNotEmpty() *UserResourceAssert {
 	u.AddAssertion(assert.ValuePresent("prevent_unload_to_internal_stages"))
 	return u
@@ -1564,6 +1639,11 @@ func (u *UserResourceAssert) HasStrictJsonOutputNotEmpty() *UserResourceAssert {
 	return u
 }
 
+func (u *UserResourceAssert) HasTagsNotEmpty() *UserResourceAssert {
+	u.AddAssertion(assert.ValuePresent("tags"))
+	return u
+}
+
 func (u *UserResourceAssert) HasTimeInputFormatNotEmpty() *UserResourceAssert {
 	u.AddAssertion(assert.ValuePresent("time_input_format"))
 	return u
@@ -112,6 +112,11 @@ func (v *ViewResourceAssert) HasStatementString(expected string) *ViewResourceAs
 	return v
 }
 
+func (v *ViewResourceAssert) HasTagsString(expected string) *ViewResourceAssert {
+	v.AddAssertion(assert.ValueSet("tags", expected))
+	return v
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -171,6 +176,11 @@ func (v *ViewResourceAssert) HasNoStatement() *ViewResourceAssert {
 	return v
 }
 
+func (v *ViewResourceAssert) HasNoTags() *ViewResourceAssert {
+	v.AddAssertion(assert.ValueNotSet("tags"))
+	return v
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -235,6 +245,11 @@ func (v *ViewResourceAssert) HasRowAccessPolicyEmpty() *ViewResourceAssert {
 	return v
 }
 
+func (v *ViewResourceAssert) HasTagsEmpty() *ViewResourceAssert {
+	v.AddAssertion(assert.ValueSet("tags", ""))
+	return v
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -293,3 +308,8 @@ func (v *ViewResourceAssert) HasStatementNotEmpty() *ViewResourceAssert {
 	v.AddAssertion(assert.ValuePresent("statement"))
 	return v
 }
+
+func (v *ViewResourceAssert) HasTagsNotEmpty() *ViewResourceAssert {
+	v.AddAssertion(assert.ValuePresent("tags"))
+	return v
+}
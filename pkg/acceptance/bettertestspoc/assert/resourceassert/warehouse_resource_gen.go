@@ -117,6 +117,11 @@ func (w *WarehouseResourceAssert) HasStatementTimeoutInSecondsString(expected st
 	return w
 }
 
+func (w *WarehouseResourceAssert) HasTagsString(expected string) *WarehouseResourceAssert {
+	w.AddAssertion(assert.ValueSet("tags", expected))
+	return w
+}
+
 func (w *WarehouseResourceAssert) HasWarehouseSizeString(expected string) *WarehouseResourceAssert {
 	w.AddAssertion(assert.ValueSet("warehouse_size", expected))
 	return w
@@ -216,6 +221,11 @@ func (w *WarehouseResourceAssert) HasNoStatementTimeoutInSeconds() *WarehouseRes
 	return w
 }
 
+func (w *WarehouseResourceAssert) HasNoTags() *WarehouseResourceAssert {
+	w.AddAssertion(assert.ValueNotSet("tags"))
+	return w
+}
+
 func (w *WarehouseResourceAssert) HasNoWarehouseSize() *WarehouseResourceAssert {
 	w.AddAssertion(assert.ValueNotSet("warehouse_size"))
 	return w
@@ -310,6 +320,11 @@ func (w *WarehouseResourceAssert) HasStatementTimeoutInSecondsEmpty() *Warehouse
 	return w
 }
 
+func (w *WarehouseResourceAssert) HasTagsEmpty() *WarehouseResourceAssert {
+	w.AddAssertion(assert.ValueSet("tags", ""))
+	return w
+}
+
 func (w *WarehouseResourceAssert) HasWarehouseSizeEmpty() *WarehouseResourceAssert {
 	w.AddAssertion(assert.ValueSet("warehouse_size", ""))
 	return w
@@ -409,6 +424,11 @@ func (w *WarehouseResourceAssert) HasStatementTimeoutInSecondsNotEmpty() *Wareho
 	return w
 }
 
+func (w *WarehouseResourceAssert) HasTagsNotEmpty() *WarehouseResourceAssert {
+	w.AddAssertion(assert.ValuePresent("tags"))
+	return w
+}
+
 func (w *WarehouseResourceAssert) HasWarehouseSizeNotEmpty() *WarehouseResourceAssert {
 	w.AddAssertion(assert.ValuePresent("warehouse_size"))
 	return w
@@ -0,0 +1,23 @@
+package resourceshowoutputassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+// CatalogIntegrationsDatasourceShowOutput is a temporary workaround to have better show output assertions in data source acceptance tests.
+func CatalogIntegrationsDatasourceShowOutput(t *testing.T, name string) *CatalogIntegrationShowOutputAssert {
+	t.Helper()
+
+	c := CatalogIntegrationShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceAssert("data."+name, "show_output", "catalog_integrations.0."),
+	}
+	c.AddAssertion(assert.ValueSet("show_output.#", "1"))
+	return &c
+}
+
+func (c *CatalogIntegrationShowOutputAssert) HasCreatedOnNotEmpty() *CatalogIntegrationShowOutputAssert {
+	c.AddAssertion(assert.ResourceShowOutputValuePresent("created_on"))
+	return c
+}
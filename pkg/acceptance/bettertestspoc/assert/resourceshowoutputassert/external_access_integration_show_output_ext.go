@@ -0,0 +1,10 @@
+package resourceshowoutputassert
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+func (e *ExternalAccessIntegrationShowOutputAssert) HasCreatedOnNotEmpty() *ExternalAccessIntegrationShowOutputAssert {
+	e.AddAssertion(assert.ResourceShowOutputValuePresent("created_on"))
+	return e
+}
@@ -0,0 +1,23 @@
+package resourceshowoutputassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+// HybridTablesDatasourceShowOutput is a temporary workaround to have better show output assertions in data source acceptance tests.
+func HybridTablesDatasourceShowOutput(t *testing.T, name string) *HybridTableShowOutputAssert {
+	t.Helper()
+
+	i := HybridTableShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceAssert("data."+name, "show_output", "hybrid_tables.0."),
+	}
+	i.AddAssertion(assert.ValueSet("show_output.#", "1"))
+	return &i
+}
+
+func (i *HybridTableShowOutputAssert) HasCreatedOnNotEmpty() *HybridTableShowOutputAssert {
+	i.AddAssertion(assert.ResourceShowOutputValuePresent("created_on"))
+	return i
+}
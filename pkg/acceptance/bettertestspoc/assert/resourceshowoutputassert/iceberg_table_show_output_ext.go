@@ -0,0 +1,23 @@
+package resourceshowoutputassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+// IcebergTablesDatasourceShowOutput is a temporary workaround to have better show output assertions in data source acceptance tests.
+func IcebergTablesDatasourceShowOutput(t *testing.T, name string) *IcebergTableShowOutputAssert {
+	t.Helper()
+
+	i := IcebergTableShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceAssert("data."+name, "show_output", "iceberg_tables.0."),
+	}
+	i.AddAssertion(assert.ValueSet("show_output.#", "1"))
+	return &i
+}
+
+func (i *IcebergTableShowOutputAssert) HasCreatedOnNotEmpty() *IcebergTableShowOutputAssert {
+	i.AddAssertion(assert.ResourceShowOutputValuePresent("created_on"))
+	return i
+}
@@ -24,6 +24,9 @@ func ResourceFromModel(t *testing.T, model ResourceModel) string {
 	hcl, err := DefaultHclConfigProvider.HclFromJson(resourceJson)
 	require.NoError(t, err)
 
+	hcl, err = revertEqualSignForMapTypeAttributes(hcl)
+	require.NoError(t, err)
+
 	t.Logf("Generated config:\n%s", hcl)
 
 	return hcl
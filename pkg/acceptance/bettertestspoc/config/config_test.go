@@ -92,6 +92,26 @@ EOT
 		require.Equal(t, expectedOutput, result)
 	})
 
+	t.Run("test map attribute", func(t *testing.T) {
+		someModel := Some("test", "Some Name").
+			WithTags(map[string]string{
+				`"db"."schema"."tag"`:       "value",
+				`"db"."schema"."other_tag"`: "other value",
+			})
+		expectedOutput := strings.TrimPrefix(`
+resource "snowflake_share" "test" {
+  name = "Some Name"
+  tags = {
+    "\"db\".\"schema\".\"other_tag\"" = "other value"
+    "\"db\".\"schema\".\"tag\"" = "value"
+  }
+}
+`, "\n")
+		result := config.ResourceFromModel(t, someModel)
+
+		require.Equal(t, expectedOutput, result)
+	})
+
 	t.Run("test dynamic block", func(t *testing.T) {
 		model := DynamicBlockExample("test", "abc").
 			WithDynamicBlock(config.NewDynamicBlock("argument", "arguments", []string{"name", "type"}))
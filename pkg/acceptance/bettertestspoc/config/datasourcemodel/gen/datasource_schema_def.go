@@ -30,10 +30,26 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "AccountRoles",
 		schema: datasources.AccountRoles().Schema,
 	},
+	{
+		name:   "Alerts",
+		schema: datasources.Alerts().Schema,
+	},
+	{
+		name:   "ApiIntegrations",
+		schema: datasources.ApiIntegrations().Schema,
+	},
+	{
+		name:   "ApplicationPackages",
+		schema: datasources.ApplicationPackages().Schema,
+	},
 	{
 		name:   "AuthenticationPolicies",
 		schema: datasources.AuthenticationPolicies().Schema,
 	},
+	{
+		name:   "CatalogIntegrations",
+		schema: datasources.CatalogIntegrations().Schema,
+	},
 	{
 		name:   "ComputePools",
 		schema: datasources.ComputePools().Schema,
@@ -54,6 +70,18 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "Databases",
 		schema: datasources.Databases().Schema,
 	},
+	{
+		name:   "EventTables",
+		schema: datasources.EventTables().Schema,
+	},
+	{
+		name:   "ExternalAccessIntegrations",
+		schema: datasources.ExternalAccessIntegrations().Schema,
+	},
+	{
+		name:   "ExternalVolumes",
+		schema: datasources.ExternalVolumes().Schema,
+	},
 	{
 		name:   "Functions",
 		schema: datasources.Functions().Schema,
@@ -66,10 +94,26 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "Grants",
 		schema: datasources.Grants().Schema,
 	},
+	{
+		name:   "HybridTables",
+		schema: datasources.HybridTables().Schema,
+	},
+	{
+		name:   "IcebergTables",
+		schema: datasources.IcebergTables().Schema,
+	},
 	{
 		name:   "ImageRepositories",
 		schema: datasources.ImageRepositories().Schema,
 	},
+	{
+		name:   "Listings",
+		schema: datasources.Listings().Schema,
+	},
+	{
+		name:   "ManagedAccounts",
+		schema: datasources.ManagedAccounts().Schema,
+	},
 	{
 		name:   "MaskingPolicies",
 		schema: datasources.MaskingPolicies().Schema,
@@ -78,10 +122,30 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "NetworkPolicies",
 		schema: datasources.NetworkPolicies().Schema,
 	},
+	{
+		name:   "NetworkRules",
+		schema: datasources.NetworkRules().Schema,
+	},
 	{
 		name:   "Notebooks",
 		schema: datasources.Notebooks().Schema,
 	},
+	{
+		name:   "NotificationIntegrations",
+		schema: datasources.NotificationIntegrations().Schema,
+	},
+	{
+		name:   "OrganizationListings",
+		schema: datasources.OrganizationListings().Schema,
+	},
+	{
+		name:   "PackagesPolicies",
+		schema: datasources.PackagesPolicies().Schema,
+	},
+	{
+		name:   "PasswordPolicies",
+		schema: datasources.PasswordPolicies().Schema,
+	},
 	{
 		name:   "Procedures",
 		schema: datasources.Procedures().Schema,
@@ -114,6 +178,18 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "Services",
 		schema: datasources.Services().Schema,
 	},
+	{
+		name:   "SnapshotSets",
+		schema: datasources.SnapshotSets().Schema,
+	},
+	{
+		name:   "Snapshots",
+		schema: datasources.Snapshots().Schema,
+	},
+	{
+		name:   "SqlQuery",
+		schema: datasources.SqlQuery().Schema,
+	},
 	{
 		name:   "Streamlits",
 		schema: datasources.Streamlits().Schema,
@@ -0,0 +1,13 @@
+package datasourcemodel
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+func (s *SqlQueryModel) WithBindVariables(bindVariables ...string) *SqlQueryModel {
+	return s.WithBindVariablesValue(tfconfig.ListVariable(collections.Map(bindVariables, func(bindVariable string) tfconfig.Variable {
+		return tfconfig.StringVariable(bindVariable)
+	})...))
+}
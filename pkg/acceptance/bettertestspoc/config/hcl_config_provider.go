@@ -142,9 +142,9 @@ func unquoteDependsOnReferences(s string) (string, error) {
 	}
 }
 
-// fixBlockArguments messes with schema.TypeMap. We use it only in the provider (will be replaced) and in the old resources.
+// fixBlockArguments messes with schema.TypeMap. We use it in the provider (will be replaced), in the old resources, and for the tags attribute in resources.
 // TODO [SNOW-1501905]: remove this workaround after replacing schema.TypeMap everywhere or make it wiser (e.g. during generation we could programmatically gather all schema.TypeMap and use this workaround only for them)
 func revertEqualSignForMapTypeAttributes(s string) (string, error) {
-	argumentRegex := regexp.MustCompile(`( +)(params|sessions_params)( +)({\n)`)
+	argumentRegex := regexp.MustCompile(`( +)(params|sessions_params|tags)( +)({\n)`)
 	return argumentRegex.ReplaceAllString(s, `$1$2$3= $4`), nil
 }
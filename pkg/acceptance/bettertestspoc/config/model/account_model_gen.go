@@ -14,9 +14,11 @@ type AccountModel struct {
 	Name                     tfconfig.Variable `json:"name,omitempty"`
 	AdminName                tfconfig.Variable `json:"admin_name,omitempty"`
 	AdminPassword            tfconfig.Variable `json:"admin_password,omitempty"`
+	AdminPasswordWo          tfconfig.Variable `json:"admin_password_wo,omitempty"`
 	AdminRsaPublicKey        tfconfig.Variable `json:"admin_rsa_public_key,omitempty"`
 	AdminUserType            tfconfig.Variable `json:"admin_user_type,omitempty"`
 	Comment                  tfconfig.Variable `json:"comment,omitempty"`
+	ConnectionDetails        tfconfig.Variable `json:"connection_details,omitempty"`
 	ConsumptionBillingEntity tfconfig.Variable `json:"consumption_billing_entity,omitempty"`
 	Edition                  tfconfig.Variable `json:"edition,omitempty"`
 	Email                    tfconfig.Variable `json:"email,omitempty"`
@@ -115,6 +117,11 @@ func (a *AccountModel) WithAdminPassword(adminPassword string) *AccountModel {
 	return a
 }
 
+func (a *AccountModel) WithAdminPasswordWo(adminPasswordWo string) *AccountModel {
+	a.AdminPasswordWo = tfconfig.StringVariable(adminPasswordWo)
+	return a
+}
+
 func (a *AccountModel) WithAdminRsaPublicKey(adminRsaPublicKey string) *AccountModel {
 	a.AdminRsaPublicKey = config.MultilineWrapperVariable(adminRsaPublicKey)
 	return a
@@ -130,6 +137,8 @@ func (a *AccountModel) WithComment(comment string) *AccountModel {
 	return a
 }
 
+// connection_details attribute type is not yet supported, so WithConnectionDetails can't be generated
+
 func (a *AccountModel) WithConsumptionBillingEntity(consumptionBillingEntity string) *AccountModel {
 	a.ConsumptionBillingEntity = tfconfig.StringVariable(consumptionBillingEntity)
 	return a
@@ -204,6 +213,11 @@ func (a *AccountModel) WithAdminPasswordValue(value tfconfig.Variable) *AccountM
 	return a
 }
 
+func (a *AccountModel) WithAdminPasswordWoValue(value tfconfig.Variable) *AccountModel {
+	a.AdminPasswordWo = value
+	return a
+}
+
 func (a *AccountModel) WithAdminRsaPublicKeyValue(value tfconfig.Variable) *AccountModel {
 	a.AdminRsaPublicKey = value
 	return a
@@ -219,6 +233,11 @@ func (a *AccountModel) WithCommentValue(value tfconfig.Variable) *AccountModel {
 	return a
 }
 
+func (a *AccountModel) WithConnectionDetailsValue(value tfconfig.Variable) *AccountModel {
+	a.ConnectionDetails = value
+	return a
+}
+
 func (a *AccountModel) WithConsumptionBillingEntityValue(value tfconfig.Variable) *AccountModel {
 	a.ConsumptionBillingEntity = value
 	return a
@@ -0,0 +1,9 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func (a *AccountRoleModel) WithTags(tags ...sdk.TagAssociation) *AccountRoleModel {
+	return a.WithTagsValue(tagsVariable(tags))
+}
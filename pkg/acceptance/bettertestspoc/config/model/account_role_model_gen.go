@@ -14,6 +14,7 @@ type AccountRoleModel struct {
 	Name               tfconfig.Variable `json:"name,omitempty"`
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -85,6 +86,8 @@ func (a *AccountRoleModel) WithFullyQualifiedName(fullyQualifiedName string) *Ac
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -103,3 +106,8 @@ func (a *AccountRoleModel) WithFullyQualifiedNameValue(value tfconfig.Variable)
 	a.FullyQualifiedName = value
 	return a
 }
+
+func (a *AccountRoleModel) WithTagsValue(value tfconfig.Variable) *AccountRoleModel {
+	a.Tags = value
+	return a
+}
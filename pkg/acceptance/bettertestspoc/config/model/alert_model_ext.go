@@ -0,0 +1,33 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func AlertWithId(resourceName string, id sdk.SchemaObjectIdentifier, started bool, condition string, action string) *AlertModel {
+	a := &AlertModel{ResourceModelMeta: config.Meta(resourceName, resources.Alert)}
+	a.WithDatabase(id.DatabaseName())
+	a.WithSchema(id.SchemaName())
+	a.WithName(id.Name())
+	a.WithStarted(started)
+	a.WithCondition(condition)
+	a.WithAction(action)
+	return a
+}
+
+func (a *AlertModel) WithScheduleMinutes(minutes int) *AlertModel {
+	a.Schedule = tfconfig.MapVariable(map[string]tfconfig.Variable{
+		"minutes": tfconfig.IntegerVariable(minutes),
+	})
+	return a
+}
+
+func (a *AlertModel) WithScheduleCron(cron string) *AlertModel {
+	a.Schedule = tfconfig.MapVariable(map[string]tfconfig.Variable{
+		"using_cron": tfconfig.StringVariable(cron),
+	})
+	return a
+}
@@ -0,0 +1,9 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithTags(tags ...sdk.TagAssociation) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
+	return a.WithTagsValue(tagsVariable(tags))
+}
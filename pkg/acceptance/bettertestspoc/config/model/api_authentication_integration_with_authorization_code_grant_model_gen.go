@@ -21,8 +21,12 @@ type ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel struct {
 	OauthClientAuthMethod      tfconfig.Variable `json:"oauth_client_auth_method,omitempty"`
 	OauthClientId              tfconfig.Variable `json:"oauth_client_id,omitempty"`
 	OauthClientSecret          tfconfig.Variable `json:"oauth_client_secret,omitempty"`
+	OauthClientSecretWo        tfconfig.Variable `json:"oauth_client_secret_wo,omitempty"`
+	OauthClientSecretWoHash    tfconfig.Variable `json:"oauth_client_secret_wo_hash,omitempty"`
+	OauthClientSecretWoVersion tfconfig.Variable `json:"oauth_client_secret_wo_version,omitempty"`
 	OauthRefreshTokenValidity  tfconfig.Variable `json:"oauth_refresh_token_validity,omitempty"`
 	OauthTokenEndpoint         tfconfig.Variable `json:"oauth_token_endpoint,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -38,13 +42,11 @@ func ApiAuthenticationIntegrationWithAuthorizationCodeGrant(
 	name string,
 	enabled bool,
 	oauthClientId string,
-	oauthClientSecret string,
 ) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
 	a := &ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel{ResourceModelMeta: config.Meta(resourceName, resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant)}
 	a.WithName(name)
 	a.WithEnabled(enabled)
 	a.WithOauthClientId(oauthClientId)
-	a.WithOauthClientSecret(oauthClientSecret)
 	return a
 }
 
@@ -52,13 +54,11 @@ func ApiAuthenticationIntegrationWithAuthorizationCodeGrantWithDefaultMeta(
 	name string,
 	enabled bool,
 	oauthClientId string,
-	oauthClientSecret string,
 ) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
 	a := &ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel{ResourceModelMeta: config.DefaultMeta(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant)}
 	a.WithName(name)
 	a.WithEnabled(enabled)
 	a.WithOauthClientId(oauthClientId)
-	a.WithOauthClientSecret(oauthClientSecret)
 	return a
 }
 
@@ -138,6 +138,21 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthC
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthClientSecretWo(oauthClientSecretWo string) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
+	a.OauthClientSecretWo = tfconfig.StringVariable(oauthClientSecretWo)
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthClientSecretWoHash(oauthClientSecretWoHash string) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
+	a.OauthClientSecretWoHash = tfconfig.StringVariable(oauthClientSecretWoHash)
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthClientSecretWoVersion(oauthClientSecretWoVersion int) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
+	a.OauthClientSecretWoVersion = tfconfig.IntegerVariable(oauthClientSecretWoVersion)
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthRefreshTokenValidity(oauthRefreshTokenValidity int) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
 	a.OauthRefreshTokenValidity = tfconfig.IntegerVariable(oauthRefreshTokenValidity)
 	return a
@@ -148,6 +163,8 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthT
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -202,6 +219,21 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthC
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthClientSecretWoValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
+	a.OauthClientSecretWo = value
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthClientSecretWoHashValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
+	a.OauthClientSecretWoHash = value
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthClientSecretWoVersionValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
+	a.OauthClientSecretWoVersion = value
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthRefreshTokenValidityValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
 	a.OauthRefreshTokenValidity = value
 	return a
@@ -211,3 +243,8 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthT
 	a.OauthTokenEndpoint = value
 	return a
 }
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithTagsValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
+	a.Tags = value
+	return a
+}
@@ -0,0 +1,9 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithTags(tags ...sdk.TagAssociation) *ApiAuthenticationIntegrationWithClientCredentialsModel {
+	return a.WithTagsValue(tagsVariable(tags))
+}
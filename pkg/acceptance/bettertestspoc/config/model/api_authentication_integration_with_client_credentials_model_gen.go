@@ -11,17 +11,21 @@ import (
 )
 
 type ApiAuthenticationIntegrationWithClientCredentialsModel struct {
-	Name                      tfconfig.Variable `json:"name,omitempty"`
-	Comment                   tfconfig.Variable `json:"comment,omitempty"`
-	Enabled                   tfconfig.Variable `json:"enabled,omitempty"`
-	FullyQualifiedName        tfconfig.Variable `json:"fully_qualified_name,omitempty"`
-	OauthAccessTokenValidity  tfconfig.Variable `json:"oauth_access_token_validity,omitempty"`
-	OauthAllowedScopes        tfconfig.Variable `json:"oauth_allowed_scopes,omitempty"`
-	OauthClientAuthMethod     tfconfig.Variable `json:"oauth_client_auth_method,omitempty"`
-	OauthClientId             tfconfig.Variable `json:"oauth_client_id,omitempty"`
-	OauthClientSecret         tfconfig.Variable `json:"oauth_client_secret,omitempty"`
-	OauthRefreshTokenValidity tfconfig.Variable `json:"oauth_refresh_token_validity,omitempty"`
-	OauthTokenEndpoint        tfconfig.Variable `json:"oauth_token_endpoint,omitempty"`
+	Name                       tfconfig.Variable `json:"name,omitempty"`
+	Comment                    tfconfig.Variable `json:"comment,omitempty"`
+	Enabled                    tfconfig.Variable `json:"enabled,omitempty"`
+	FullyQualifiedName         tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	OauthAccessTokenValidity   tfconfig.Variable `json:"oauth_access_token_validity,omitempty"`
+	OauthAllowedScopes         tfconfig.Variable `json:"oauth_allowed_scopes,omitempty"`
+	OauthClientAuthMethod      tfconfig.Variable `json:"oauth_client_auth_method,omitempty"`
+	OauthClientId              tfconfig.Variable `json:"oauth_client_id,omitempty"`
+	OauthClientSecret          tfconfig.Variable `json:"oauth_client_secret,omitempty"`
+	OauthClientSecretWo        tfconfig.Variable `json:"oauth_client_secret_wo,omitempty"`
+	OauthClientSecretWoHash    tfconfig.Variable `json:"oauth_client_secret_wo_hash,omitempty"`
+	OauthClientSecretWoVersion tfconfig.Variable `json:"oauth_client_secret_wo_version,omitempty"`
+	OauthRefreshTokenValidity  tfconfig.Variable `json:"oauth_refresh_token_validity,omitempty"`
+	OauthTokenEndpoint         tfconfig.Variable `json:"oauth_token_endpoint,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -37,13 +41,11 @@ func ApiAuthenticationIntegrationWithClientCredentials(
 	name string,
 	enabled bool,
 	oauthClientId string,
-	oauthClientSecret string,
 ) *ApiAuthenticationIntegrationWithClientCredentialsModel {
 	a := &ApiAuthenticationIntegrationWithClientCredentialsModel{ResourceModelMeta: config.Meta(resourceName, resources.ApiAuthenticationIntegrationWithClientCredentials)}
 	a.WithName(name)
 	a.WithEnabled(enabled)
 	a.WithOauthClientId(oauthClientId)
-	a.WithOauthClientSecret(oauthClientSecret)
 	return a
 }
 
@@ -51,13 +53,11 @@ func ApiAuthenticationIntegrationWithClientCredentialsWithDefaultMeta(
 	name string,
 	enabled bool,
 	oauthClientId string,
-	oauthClientSecret string,
 ) *ApiAuthenticationIntegrationWithClientCredentialsModel {
 	a := &ApiAuthenticationIntegrationWithClientCredentialsModel{ResourceModelMeta: config.DefaultMeta(resources.ApiAuthenticationIntegrationWithClientCredentials)}
 	a.WithName(name)
 	a.WithEnabled(enabled)
 	a.WithOauthClientId(oauthClientId)
-	a.WithOauthClientSecret(oauthClientSecret)
 	return a
 }
 
@@ -132,6 +132,21 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthClient
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthClientSecretWo(oauthClientSecretWo string) *ApiAuthenticationIntegrationWithClientCredentialsModel {
+	a.OauthClientSecretWo = tfconfig.StringVariable(oauthClientSecretWo)
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthClientSecretWoHash(oauthClientSecretWoHash string) *ApiAuthenticationIntegrationWithClientCredentialsModel {
+	a.OauthClientSecretWoHash = tfconfig.StringVariable(oauthClientSecretWoHash)
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthClientSecretWoVersion(oauthClientSecretWoVersion int) *ApiAuthenticationIntegrationWithClientCredentialsModel {
+	a.OauthClientSecretWoVersion = tfconfig.IntegerVariable(oauthClientSecretWoVersion)
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthRefreshTokenValidity(oauthRefreshTokenValidity int) *ApiAuthenticationIntegrationWithClientCredentialsModel {
 	a.OauthRefreshTokenValidity = tfconfig.IntegerVariable(oauthRefreshTokenValidity)
 	return a
@@ -142,6 +157,8 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthTokenE
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -191,6 +208,21 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthClient
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthClientSecretWoValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithClientCredentialsModel {
+	a.OauthClientSecretWo = value
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthClientSecretWoHashValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithClientCredentialsModel {
+	a.OauthClientSecretWoHash = value
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthClientSecretWoVersionValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithClientCredentialsModel {
+	a.OauthClientSecretWoVersion = value
+	return a
+}
+
 func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthRefreshTokenValidityValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithClientCredentialsModel {
 	a.OauthRefreshTokenValidity = value
 	return a
@@ -200,3 +232,8 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthTokenE
 	a.OauthTokenEndpoint = value
 	return a
 }
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithTagsValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithClientCredentialsModel {
+	a.Tags = value
+	return a
+}
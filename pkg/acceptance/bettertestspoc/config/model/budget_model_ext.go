@@ -0,0 +1,47 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func BudgetWithId(resourceName string, id sdk.SchemaObjectIdentifier, spendingLimit int) *BudgetModel {
+	return Budget(resourceName, id.DatabaseName(), id.SchemaName(), id.Name(), spendingLimit)
+}
+
+func (b *BudgetModel) WithNotificationEmails(emails ...string) *BudgetModel {
+	return b.WithNotificationEmailsValue(stringsSetVariable(emails))
+}
+
+func (b *BudgetModel) WithWarehouses(warehouses ...sdk.AccountObjectIdentifier) *BudgetModel {
+	return b.WithWarehousesValue(identifiersSetVariable(warehouses))
+}
+
+func (b *BudgetModel) WithDatabases(databases ...sdk.AccountObjectIdentifier) *BudgetModel {
+	return b.WithDatabasesValue(identifiersSetVariable(databases))
+}
+
+func (b *BudgetModel) WithTasks(tasks ...sdk.SchemaObjectIdentifier) *BudgetModel {
+	return b.WithTasksValue(identifiersSetVariable(tasks))
+}
+
+func (a *AccountBudgetModel) WithNotificationEmails(emails ...string) *AccountBudgetModel {
+	return a.WithNotificationEmailsValue(stringsSetVariable(emails))
+}
+
+func stringsSetVariable(values []string) tfconfig.Variable {
+	return tfconfig.SetVariable(
+		collections.Map(values, func(value string) tfconfig.Variable {
+			return tfconfig.StringVariable(value)
+		})...,
+	)
+}
+
+func identifiersSetVariable[T sdk.ObjectIdentifier](ids []T) tfconfig.Variable {
+	return tfconfig.SetVariable(
+		collections.Map(ids, func(id T) tfconfig.Variable {
+			return tfconfig.StringVariable(id.FullyQualifiedName())
+		})...,
+	)
+}
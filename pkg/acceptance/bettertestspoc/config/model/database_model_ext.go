@@ -34,3 +34,7 @@ func (d *DatabaseModel) WithReplication(accountIdentifier sdk.AccountIdentifier,
 		),
 	)
 }
+
+func (d *DatabaseModel) WithTags(tags ...sdk.TagAssociation) *DatabaseModel {
+	return d.WithTagsValue(tagsVariable(tags))
+}
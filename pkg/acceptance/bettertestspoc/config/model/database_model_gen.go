@@ -28,6 +28,7 @@ type DatabaseModel struct {
 	Replication                             tfconfig.Variable `json:"replication,omitempty"`
 	StorageSerializationPolicy              tfconfig.Variable `json:"storage_serialization_policy,omitempty"`
 	SuspendTaskAfterNumFailures             tfconfig.Variable `json:"suspend_task_after_num_failures,omitempty"`
+	Tags                                    tfconfig.Variable `json:"tags,omitempty"`
 	TaskAutoRetryAttempts                   tfconfig.Variable `json:"task_auto_retry_attempts,omitempty"`
 	TraceLevel                              tfconfig.Variable `json:"trace_level,omitempty"`
 	UserTaskManagedInitialWarehouseSize     tfconfig.Variable `json:"user_task_managed_initial_warehouse_size,omitempty"`
@@ -171,6 +172,8 @@ func (d *DatabaseModel) WithSuspendTaskAfterNumFailures(suspendTaskAfterNumFailu
 	return d
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 func (d *DatabaseModel) WithTaskAutoRetryAttempts(taskAutoRetryAttempts int) *DatabaseModel {
 	d.TaskAutoRetryAttempts = tfconfig.IntegerVariable(taskAutoRetryAttempts)
 	return d
@@ -285,6 +288,11 @@ func (d *DatabaseModel) WithSuspendTaskAfterNumFailuresValue(value tfconfig.Vari
 	return d
 }
 
+func (d *DatabaseModel) WithTagsValue(value tfconfig.Variable) *DatabaseModel {
+	d.Tags = value
+	return d
+}
+
 func (d *DatabaseModel) WithTaskAutoRetryAttemptsValue(value tfconfig.Variable) *DatabaseModel {
 	d.TaskAutoRetryAttempts = value
 	return d
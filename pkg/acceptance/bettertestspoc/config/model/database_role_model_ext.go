@@ -0,0 +1,9 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func (d *DatabaseRoleModel) WithTags(tags ...sdk.TagAssociation) *DatabaseRoleModel {
+	return d.WithTagsValue(tagsVariable(tags))
+}
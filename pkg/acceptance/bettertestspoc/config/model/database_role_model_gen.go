@@ -15,6 +15,7 @@ type DatabaseRoleModel struct {
 	Name               tfconfig.Variable `json:"name,omitempty"`
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -95,6 +96,8 @@ func (d *DatabaseRoleModel) WithFullyQualifiedName(fullyQualifiedName string) *D
 	return d
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -118,3 +121,8 @@ func (d *DatabaseRoleModel) WithFullyQualifiedNameValue(value tfconfig.Variable)
 	d.FullyQualifiedName = value
 	return d
 }
+
+func (d *DatabaseRoleModel) WithTagsValue(value tfconfig.Variable) *DatabaseRoleModel {
+	d.Tags = value
+	return d
+}
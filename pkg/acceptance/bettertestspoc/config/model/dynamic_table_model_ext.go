@@ -3,10 +3,20 @@ package model
 import (
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
 )
 
+func DynamicTableWithId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	query string,
+	warehouseId sdk.AccountObjectIdentifier,
+) *DynamicTableModel {
+	return DynamicTable(resourceName, id.DatabaseName(), id.SchemaName(), id.Name(), query, warehouseId.Name())
+}
+
 // TODO(SNOW-1501905): Remove after complex non-list type overrides are handled
 func (d *DynamicTableModel) WithTargetLag(targetLag []sdk.TargetLag) *DynamicTableModel {
 	if len(targetLag) != 1 {
@@ -28,3 +38,21 @@ func (d *DynamicTableModel) WithTargetLag(targetLag []sdk.TargetLag) *DynamicTab
 	log.Fatalf("neither maximum_duration nor downstream is set in target lag: %+v", targetLag[0])
 	return nil
 }
+
+func (d *DynamicTableModel) WithTags(tags ...sdk.TagAssociation) *DynamicTableModel {
+	return d.WithTagsValue(tagsVariable(tags))
+}
+
+func (d *DynamicTableModel) WithClusterBy(clusterBy ...string) *DynamicTableModel {
+	return d.WithClusterByValue(tfconfig.ListVariable(collections.Map(clusterBy, func(expression string) tfconfig.Variable {
+		return tfconfig.StringVariable(expression)
+	})...))
+}
+
+func (d *DynamicTableModel) WithIceberg(externalVolume string, baseLocation string) *DynamicTableModel {
+	return d.WithIcebergValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"external_volume": tfconfig.StringVariable(externalVolume),
+		"catalog":         tfconfig.StringVariable("SNOWFLAKE"),
+		"base_location":   tfconfig.StringVariable(baseLocation),
+	}))
+}
@@ -7,34 +7,30 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
 )
 
 type DynamicTableModel struct {
-	Database            tfconfig.Variable `json:"database,omitempty"`
-	Schema              tfconfig.Variable `json:"schema,omitempty"`
-	Name                tfconfig.Variable `json:"name,omitempty"`
-	AutomaticClustering tfconfig.Variable `json:"automatic_clustering,omitempty"`
-	Bytes               tfconfig.Variable `json:"bytes,omitempty"`
-	ClusterBy           tfconfig.Variable `json:"cluster_by,omitempty"`
-	Comment             tfconfig.Variable `json:"comment,omitempty"`
-	CreatedOn           tfconfig.Variable `json:"created_on,omitempty"`
-	DataTimestamp       tfconfig.Variable `json:"data_timestamp,omitempty"`
-	FullyQualifiedName  tfconfig.Variable `json:"fully_qualified_name,omitempty"`
-	Initialize          tfconfig.Variable `json:"initialize,omitempty"`
-	IsClone             tfconfig.Variable `json:"is_clone,omitempty"`
-	IsReplica           tfconfig.Variable `json:"is_replica,omitempty"`
-	LastSuspendedOn     tfconfig.Variable `json:"last_suspended_on,omitempty"`
-	OrReplace           tfconfig.Variable `json:"or_replace,omitempty"`
-	Owner               tfconfig.Variable `json:"owner,omitempty"`
-	Query               tfconfig.Variable `json:"query,omitempty"`
-	RefreshMode         tfconfig.Variable `json:"refresh_mode,omitempty"`
-	RefreshModeReason   tfconfig.Variable `json:"refresh_mode_reason,omitempty"`
-	Rows                tfconfig.Variable `json:"rows,omitempty"`
-	SchedulingState     tfconfig.Variable `json:"scheduling_state,omitempty"`
-	TargetLag           tfconfig.Variable `json:"target_lag,omitempty"`
-	Warehouse           tfconfig.Variable `json:"warehouse,omitempty"`
+	Database                   tfconfig.Variable `json:"database,omitempty"`
+	Schema                     tfconfig.Variable `json:"schema,omitempty"`
+	Name                       tfconfig.Variable `json:"name,omitempty"`
+	ClusterBy                  tfconfig.Variable `json:"cluster_by,omitempty"`
+	Comment                    tfconfig.Variable `json:"comment,omitempty"`
+	DataRetentionTimeInDays    tfconfig.Variable `json:"data_retention_time_in_days,omitempty"`
+	FullyQualifiedName         tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Iceberg                    tfconfig.Variable `json:"iceberg,omitempty"`
+	ImmutableWhere             tfconfig.Variable `json:"immutable_where,omitempty"`
+	InitializationWarehouse    tfconfig.Variable `json:"initialization_warehouse,omitempty"`
+	Initialize                 tfconfig.Variable `json:"initialize,omitempty"`
+	MaxDataExtensionTimeInDays tfconfig.Variable `json:"max_data_extension_time_in_days,omitempty"`
+	Query                      tfconfig.Variable `json:"query,omitempty"`
+	RefreshMode                tfconfig.Variable `json:"refresh_mode,omitempty"`
+	Scheduler                  tfconfig.Variable `json:"scheduler,omitempty"`
+	Started                    tfconfig.Variable `json:"started,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TargetLag                  tfconfig.Variable `json:"target_lag,omitempty"`
+	Transient                  tfconfig.Variable `json:"transient,omitempty"`
+	Warehouse                  tfconfig.Variable `json:"warehouse,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -51,7 +47,6 @@ func DynamicTable(
 	schema string,
 	name string,
 	query string,
-	targetLag []sdk.TargetLag,
 	warehouse string,
 ) *DynamicTableModel {
 	d := &DynamicTableModel{ResourceModelMeta: config.Meta(resourceName, resources.DynamicTable)}
@@ -59,7 +54,6 @@ func DynamicTable(
 	d.WithSchema(schema)
 	d.WithName(name)
 	d.WithQuery(query)
-	d.WithTargetLag(targetLag)
 	d.WithWarehouse(warehouse)
 	return d
 }
@@ -69,7 +63,6 @@ func DynamicTableWithDefaultMeta(
 	schema string,
 	name string,
 	query string,
-	targetLag []sdk.TargetLag,
 	warehouse string,
 ) *DynamicTableModel {
 	d := &DynamicTableModel{ResourceModelMeta: config.DefaultMeta(resources.DynamicTable)}
@@ -77,7 +70,6 @@ func DynamicTableWithDefaultMeta(
 	d.WithSchema(schema)
 	d.WithName(name)
 	d.WithQuery(query)
-	d.WithTargetLag(targetLag)
 	d.WithWarehouse(warehouse)
 	return d
 }
@@ -126,33 +118,15 @@ func (d *DynamicTableModel) WithName(name string) *DynamicTableModel {
 	return d
 }
 
-func (d *DynamicTableModel) WithAutomaticClustering(automaticClustering bool) *DynamicTableModel {
-	d.AutomaticClustering = tfconfig.BoolVariable(automaticClustering)
-	return d
-}
-
-func (d *DynamicTableModel) WithBytes(bytes int) *DynamicTableModel {
-	d.Bytes = tfconfig.IntegerVariable(bytes)
-	return d
-}
-
-func (d *DynamicTableModel) WithClusterBy(clusterBy string) *DynamicTableModel {
-	d.ClusterBy = tfconfig.StringVariable(clusterBy)
-	return d
-}
+// cluster_by attribute type is not yet supported, so WithClusterBy can't be generated
 
 func (d *DynamicTableModel) WithComment(comment string) *DynamicTableModel {
 	d.Comment = tfconfig.StringVariable(comment)
 	return d
 }
 
-func (d *DynamicTableModel) WithCreatedOn(createdOn string) *DynamicTableModel {
-	d.CreatedOn = tfconfig.StringVariable(createdOn)
-	return d
-}
-
-func (d *DynamicTableModel) WithDataTimestamp(dataTimestamp string) *DynamicTableModel {
-	d.DataTimestamp = tfconfig.StringVariable(dataTimestamp)
+func (d *DynamicTableModel) WithDataRetentionTimeInDays(dataRetentionTimeInDays int) *DynamicTableModel {
+	d.DataRetentionTimeInDays = tfconfig.IntegerVariable(dataRetentionTimeInDays)
 	return d
 }
 
@@ -161,33 +135,25 @@ func (d *DynamicTableModel) WithFullyQualifiedName(fullyQualifiedName string) *D
 	return d
 }
 
-func (d *DynamicTableModel) WithInitialize(initialize string) *DynamicTableModel {
-	d.Initialize = tfconfig.StringVariable(initialize)
-	return d
-}
+// iceberg attribute type is not yet supported, so WithIceberg can't be generated
 
-func (d *DynamicTableModel) WithIsClone(isClone bool) *DynamicTableModel {
-	d.IsClone = tfconfig.BoolVariable(isClone)
+func (d *DynamicTableModel) WithImmutableWhere(immutableWhere string) *DynamicTableModel {
+	d.ImmutableWhere = tfconfig.StringVariable(immutableWhere)
 	return d
 }
 
-func (d *DynamicTableModel) WithIsReplica(isReplica bool) *DynamicTableModel {
-	d.IsReplica = tfconfig.BoolVariable(isReplica)
+func (d *DynamicTableModel) WithInitializationWarehouse(initializationWarehouse string) *DynamicTableModel {
+	d.InitializationWarehouse = tfconfig.StringVariable(initializationWarehouse)
 	return d
 }
 
-func (d *DynamicTableModel) WithLastSuspendedOn(lastSuspendedOn string) *DynamicTableModel {
-	d.LastSuspendedOn = tfconfig.StringVariable(lastSuspendedOn)
-	return d
-}
-
-func (d *DynamicTableModel) WithOrReplace(orReplace bool) *DynamicTableModel {
-	d.OrReplace = tfconfig.BoolVariable(orReplace)
+func (d *DynamicTableModel) WithInitialize(initialize string) *DynamicTableModel {
+	d.Initialize = tfconfig.StringVariable(initialize)
 	return d
 }
 
-func (d *DynamicTableModel) WithOwner(owner string) *DynamicTableModel {
-	d.Owner = tfconfig.StringVariable(owner)
+func (d *DynamicTableModel) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays int) *DynamicTableModel {
+	d.MaxDataExtensionTimeInDays = tfconfig.IntegerVariable(maxDataExtensionTimeInDays)
 	return d
 }
 
@@ -201,23 +167,25 @@ func (d *DynamicTableModel) WithRefreshMode(refreshMode string) *DynamicTableMod
 	return d
 }
 
-func (d *DynamicTableModel) WithRefreshModeReason(refreshModeReason string) *DynamicTableModel {
-	d.RefreshModeReason = tfconfig.StringVariable(refreshModeReason)
+func (d *DynamicTableModel) WithScheduler(scheduler string) *DynamicTableModel {
+	d.Scheduler = tfconfig.StringVariable(scheduler)
 	return d
 }
 
-func (d *DynamicTableModel) WithRows(rows int) *DynamicTableModel {
-	d.Rows = tfconfig.IntegerVariable(rows)
+func (d *DynamicTableModel) WithStarted(started bool) *DynamicTableModel {
+	d.Started = tfconfig.BoolVariable(started)
 	return d
 }
 
-func (d *DynamicTableModel) WithSchedulingState(schedulingState string) *DynamicTableModel {
-	d.SchedulingState = tfconfig.StringVariable(schedulingState)
-	return d
-}
+// tags attribute type is not yet supported, so WithTags can't be generated
 
 // target_lag attribute type is not yet supported, so WithTargetLag can't be generated
 
+func (d *DynamicTableModel) WithTransient(transient bool) *DynamicTableModel {
+	d.Transient = tfconfig.BoolVariable(transient)
+	return d
+}
+
 func (d *DynamicTableModel) WithWarehouse(warehouse string) *DynamicTableModel {
 	d.Warehouse = tfconfig.StringVariable(warehouse)
 	return d
@@ -242,16 +210,6 @@ func (d *DynamicTableModel) WithNameValue(value tfconfig.Variable) *DynamicTable
 	return d
 }
 
-func (d *DynamicTableModel) WithAutomaticClusteringValue(value tfconfig.Variable) *DynamicTableModel {
-	d.AutomaticClustering = value
-	return d
-}
-
-func (d *DynamicTableModel) WithBytesValue(value tfconfig.Variable) *DynamicTableModel {
-	d.Bytes = value
-	return d
-}
-
 func (d *DynamicTableModel) WithClusterByValue(value tfconfig.Variable) *DynamicTableModel {
 	d.ClusterBy = value
 	return d
@@ -262,13 +220,8 @@ func (d *DynamicTableModel) WithCommentValue(value tfconfig.Variable) *DynamicTa
 	return d
 }
 
-func (d *DynamicTableModel) WithCreatedOnValue(value tfconfig.Variable) *DynamicTableModel {
-	d.CreatedOn = value
-	return d
-}
-
-func (d *DynamicTableModel) WithDataTimestampValue(value tfconfig.Variable) *DynamicTableModel {
-	d.DataTimestamp = value
+func (d *DynamicTableModel) WithDataRetentionTimeInDaysValue(value tfconfig.Variable) *DynamicTableModel {
+	d.DataRetentionTimeInDays = value
 	return d
 }
 
@@ -277,33 +230,28 @@ func (d *DynamicTableModel) WithFullyQualifiedNameValue(value tfconfig.Variable)
 	return d
 }
 
-func (d *DynamicTableModel) WithInitializeValue(value tfconfig.Variable) *DynamicTableModel {
-	d.Initialize = value
-	return d
-}
-
-func (d *DynamicTableModel) WithIsCloneValue(value tfconfig.Variable) *DynamicTableModel {
-	d.IsClone = value
+func (d *DynamicTableModel) WithIcebergValue(value tfconfig.Variable) *DynamicTableModel {
+	d.Iceberg = value
 	return d
 }
 
-func (d *DynamicTableModel) WithIsReplicaValue(value tfconfig.Variable) *DynamicTableModel {
-	d.IsReplica = value
+func (d *DynamicTableModel) WithImmutableWhereValue(value tfconfig.Variable) *DynamicTableModel {
+	d.ImmutableWhere = value
 	return d
 }
 
-func (d *DynamicTableModel) WithLastSuspendedOnValue(value tfconfig.Variable) *DynamicTableModel {
-	d.LastSuspendedOn = value
+func (d *DynamicTableModel) WithInitializationWarehouseValue(value tfconfig.Variable) *DynamicTableModel {
+	d.InitializationWarehouse = value
 	return d
 }
 
-func (d *DynamicTableModel) WithOrReplaceValue(value tfconfig.Variable) *DynamicTableModel {
-	d.OrReplace = value
+func (d *DynamicTableModel) WithInitializeValue(value tfconfig.Variable) *DynamicTableModel {
+	d.Initialize = value
 	return d
 }
 
-func (d *DynamicTableModel) WithOwnerValue(value tfconfig.Variable) *DynamicTableModel {
-	d.Owner = value
+func (d *DynamicTableModel) WithMaxDataExtensionTimeInDaysValue(value tfconfig.Variable) *DynamicTableModel {
+	d.MaxDataExtensionTimeInDays = value
 	return d
 }
 
@@ -317,18 +265,18 @@ func (d *DynamicTableModel) WithRefreshModeValue(value tfconfig.Variable) *Dynam
 	return d
 }
 
-func (d *DynamicTableModel) WithRefreshModeReasonValue(value tfconfig.Variable) *DynamicTableModel {
-	d.RefreshModeReason = value
+func (d *DynamicTableModel) WithSchedulerValue(value tfconfig.Variable) *DynamicTableModel {
+	d.Scheduler = value
 	return d
 }
 
-func (d *DynamicTableModel) WithRowsValue(value tfconfig.Variable) *DynamicTableModel {
-	d.Rows = value
+func (d *DynamicTableModel) WithStartedValue(value tfconfig.Variable) *DynamicTableModel {
+	d.Started = value
 	return d
 }
 
-func (d *DynamicTableModel) WithSchedulingStateValue(value tfconfig.Variable) *DynamicTableModel {
-	d.SchedulingState = value
+func (d *DynamicTableModel) WithTagsValue(value tfconfig.Variable) *DynamicTableModel {
+	d.Tags = value
 	return d
 }
 
@@ -337,6 +285,11 @@ func (d *DynamicTableModel) WithTargetLagValue(value tfconfig.Variable) *Dynamic
 	return d
 }
 
+func (d *DynamicTableModel) WithTransientValue(value tfconfig.Variable) *DynamicTableModel {
+	d.Transient = value
+	return d
+}
+
 func (d *DynamicTableModel) WithWarehouseValue(value tfconfig.Variable) *DynamicTableModel {
 	d.Warehouse = value
 	return d
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func ExternalAccessIntegrationWithNetworkRules(
+	resourceName string,
+	name string,
+	enabled bool,
+	networkRules ...sdk.SchemaObjectIdentifier,
+) *ExternalAccessIntegrationModel {
+	return ExternalAccessIntegration(resourceName, name, collections.Map(networkRules, sdk.SchemaObjectIdentifier.FullyQualifiedName), enabled)
+}
+
+func (e *ExternalAccessIntegrationModel) WithAllowedNetworkRules(rules []string) *ExternalAccessIntegrationModel {
+	e.AllowedNetworkRules = stringsSetVariable(rules)
+	return e
+}
+
+func (e *ExternalAccessIntegrationModel) WithAllowedApiAuthenticationIntegrations(integrations ...sdk.AccountObjectIdentifier) *ExternalAccessIntegrationModel {
+	e.AllowedApiAuthenticationIntegrations = identifiersSetVariable(integrations)
+	return e
+}
+
+func (e *ExternalAccessIntegrationModel) WithAllowedAuthenticationSecrets(secrets ...sdk.SchemaObjectIdentifier) *ExternalAccessIntegrationModel {
+	e.AllowedAuthenticationSecrets = identifiersSetVariable(secrets)
+	return e
+}
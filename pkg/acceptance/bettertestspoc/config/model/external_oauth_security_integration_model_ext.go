@@ -44,3 +44,7 @@ func (e *ExternalOauthSecurityIntegrationModel) WithExternalOauthJwsKeysUrls(url
 	)
 	return e
 }
+
+func (e *ExternalOauthSecurityIntegrationModel) WithTags(tags ...sdk.TagAssociation) *ExternalOauthSecurityIntegrationModel {
+	return e.WithTagsValue(tagsVariable(tags))
+}
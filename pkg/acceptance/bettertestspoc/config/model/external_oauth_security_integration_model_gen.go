@@ -29,6 +29,7 @@ type ExternalOauthSecurityIntegrationModel struct {
 	ExternalOauthType                          tfconfig.Variable `json:"external_oauth_type,omitempty"`
 	FullyQualifiedName                         tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	RelatedParameters                          tfconfig.Variable `json:"related_parameters,omitempty"`
+	Tags                                       tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -177,6 +178,8 @@ func (e *ExternalOauthSecurityIntegrationModel) WithFullyQualifiedName(fullyQual
 
 // related_parameters attribute type is not yet supported, so WithRelatedParameters can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -270,3 +273,8 @@ func (e *ExternalOauthSecurityIntegrationModel) WithRelatedParametersValue(value
 	e.RelatedParameters = value
 	return e
 }
+
+func (e *ExternalOauthSecurityIntegrationModel) WithTagsValue(value tfconfig.Variable) *ExternalOauthSecurityIntegrationModel {
+	e.Tags = value
+	return e
+}
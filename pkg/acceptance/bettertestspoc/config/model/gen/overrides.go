@@ -23,12 +23,16 @@ var multilineAttributesOverrides = map[string][]string{
 }
 
 var complexListAttributesOverrides = map[string]map[string]string{
-	"ExternalVolume":  {"storage_location": "sdk.ExternalVolumeStorageLocation"},
-	"MaskingPolicy":   {"argument": "sdk.TableColumnSignature"},
-	"RowAccessPolicy": {"argument": "sdk.TableColumnSignature"},
-	"TagAssociation":  {"object_identifiers": "sdk.ObjectIdentifier"},
+	"ExternalVolume":         {"storage_location": "sdk.ExternalVolumeStorageLocation"},
+	"MaskingPolicy":          {"argument": "sdk.TableColumnSignature"},
+	"RowAccessPolicy":        {"argument": "sdk.TableColumnSignature"},
+	"StorageLifecyclePolicy": {"argument": "sdk.TableColumnSignature"},
+	"TagAssociation":         {"object_identifiers": "sdk.ObjectIdentifier"},
 	// TODO [SNOW-1348114]: use better type for override (not null and default are currently not supported)
 	"Table":        {"column": "sdk.TableColumnSignature"},
 	"SemanticView": {"tables": "sdk.LogicalTable", "metrics": "sdk.MetricDefinition", "facts": "sdk.SemanticExpression", "dimensions": "sdk.SemanticExpression", "relationships": "sdk.SemanticViewRelationship"},
 	"DynamicTable": {"target_lag": "sdk.TargetLag"},
+	"IcebergTable": {"column": "sdk.IcebergTableColumnRequest"},
+	"TaskGraph":    {"root": "TaskGraphTask", "task": "TaskGraphTask", "finalizer": "TaskGraphTask"},
+	"HybridTable":  {"column": "sdk.HybridTableColumnRequest", "primary_key": "sdk.HybridTableOutOfLineConstraintRequest", "unique_key": "sdk.HybridTableOutOfLineConstraintRequest", "foreign_key": "sdk.HybridTableOutOfLineConstraintRequest", "index": "sdk.HybridTableIndexDefinitionRequest"},
 }
@@ -0,0 +1,102 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func HybridTableWithId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	column []sdk.HybridTableColumnRequest,
+	primaryKeyColumns []string,
+) *HybridTableModel {
+	return HybridTable(resourceName, id.DatabaseName(), id.SchemaName(), id.Name(), column, []sdk.HybridTableOutOfLineConstraintRequest{
+		*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.HybridTableConstraintTypePrimaryKey, primaryKeyColumns),
+	})
+}
+
+func (h *HybridTableModel) WithColumn(column []sdk.HybridTableColumnRequest) *HybridTableModel {
+	maps := make([]tfconfig.Variable, len(column))
+	for idx, v := range column {
+		m := map[string]tfconfig.Variable{
+			"name": tfconfig.StringVariable(v.Name),
+			"type": tfconfig.StringVariable(string(v.DataType)),
+		}
+		if v.NotNull != nil {
+			m["not_null"] = tfconfig.BoolVariable(*v.NotNull)
+		}
+		if v.DefaultExpression != nil {
+			m["default"] = tfconfig.StringVariable(*v.DefaultExpression)
+		}
+		if v.Autoincrement != nil {
+			m["autoincrement"] = tfconfig.BoolVariable(*v.Autoincrement)
+		}
+		if v.Collate != nil {
+			m["collate"] = tfconfig.StringVariable(*v.Collate)
+		}
+		if v.Comment != nil {
+			m["comment"] = tfconfig.StringVariable(*v.Comment)
+		}
+		maps[idx] = tfconfig.MapVariable(m)
+	}
+	h.Column = tfconfig.ListVariable(maps...)
+	return h
+}
+
+func (h *HybridTableModel) WithPrimaryKey(primaryKey []sdk.HybridTableOutOfLineConstraintRequest) *HybridTableModel {
+	h.PrimaryKey = hybridTableConstraintsVariable(primaryKey)
+	return h
+}
+
+func (h *HybridTableModel) WithUniqueKey(uniqueKey []sdk.HybridTableOutOfLineConstraintRequest) *HybridTableModel {
+	h.UniqueKey = hybridTableConstraintsVariable(uniqueKey)
+	return h
+}
+
+func (h *HybridTableModel) WithForeignKey(foreignKey []sdk.HybridTableOutOfLineConstraintRequest) *HybridTableModel {
+	h.ForeignKey = hybridTableConstraintsVariable(foreignKey)
+	return h
+}
+
+func (h *HybridTableModel) WithIndex(index []sdk.HybridTableIndexDefinitionRequest) *HybridTableModel {
+	maps := make([]tfconfig.Variable, len(index))
+	for idx, v := range index {
+		m := map[string]tfconfig.Variable{
+			"name":    tfconfig.StringVariable(v.Name),
+			"columns": hybridTableColumnNamesVariable(v.Columns),
+		}
+		if len(v.IncludeColumns) > 0 {
+			m["include_columns"] = hybridTableColumnNamesVariable(v.IncludeColumns)
+		}
+		maps[idx] = tfconfig.MapVariable(m)
+	}
+	h.Index = tfconfig.ListVariable(maps...)
+	return h
+}
+
+func hybridTableConstraintsVariable(constraints []sdk.HybridTableOutOfLineConstraintRequest) tfconfig.Variable {
+	maps := make([]tfconfig.Variable, len(constraints))
+	for idx, v := range constraints {
+		m := map[string]tfconfig.Variable{
+			"columns": hybridTableColumnNamesVariable(v.Columns),
+		}
+		if v.Name != nil {
+			m["name"] = tfconfig.StringVariable(*v.Name)
+		}
+		if v.References != nil {
+			m["references_table"] = tfconfig.StringVariable(v.References.TableName.FullyQualifiedName())
+			m["references_columns"] = hybridTableColumnNamesVariable(v.References.Columns)
+		}
+		maps[idx] = tfconfig.MapVariable(m)
+	}
+	return tfconfig.ListVariable(maps...)
+}
+
+func hybridTableColumnNamesVariable(columns []string) tfconfig.Variable {
+	return tfconfig.ListVariable(collections.Map(columns, func(column string) tfconfig.Variable {
+		return tfconfig.StringVariable(column)
+	})...)
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func IcebergTableWithId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	column []sdk.IcebergTableColumnRequest,
+) *IcebergTableModel {
+	return IcebergTable(resourceName, id.DatabaseName(), id.SchemaName(), id.Name(), column)
+}
+
+func (i *IcebergTableModel) WithColumn(column []sdk.IcebergTableColumnRequest) *IcebergTableModel {
+	maps := make([]tfconfig.Variable, len(column))
+	for idx, v := range column {
+		m := map[string]tfconfig.Variable{
+			"name": tfconfig.StringVariable(v.Name),
+			"type": tfconfig.StringVariable(string(v.DataType)),
+		}
+		if v.NotNull != nil {
+			m["not_null"] = tfconfig.BoolVariable(*v.NotNull)
+		}
+		if v.Comment != nil {
+			m["comment"] = tfconfig.StringVariable(*v.Comment)
+		}
+		maps[idx] = tfconfig.MapVariable(m)
+	}
+	i.Column = tfconfig.ListVariable(maps...)
+	return i
+}
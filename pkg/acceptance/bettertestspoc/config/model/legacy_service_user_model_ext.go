@@ -64,3 +64,7 @@ func (u *LegacyServiceUserModel) WithNetworkPolicyId(networkPolicy sdk.AccountOb
 func (u *LegacyServiceUserModel) WithDefaultSecondaryRolesOptionEnum(option sdk.SecondaryRolesOption) *LegacyServiceUserModel {
 	return u.WithDefaultSecondaryRolesOption(string(option))
 }
+
+func (l *LegacyServiceUserModel) WithTags(tags ...sdk.TagAssociation) *LegacyServiceUserModel {
+	return l.WithTagsValue(tagsVariable(tags))
+}
@@ -56,6 +56,9 @@ type LegacyServiceUserModel struct {
 	NoorderSequenceAsDefault                 tfconfig.Variable `json:"noorder_sequence_as_default,omitempty"`
 	OdbcTreatDecimalAsInt                    tfconfig.Variable `json:"odbc_treat_decimal_as_int,omitempty"`
 	Password                                 tfconfig.Variable `json:"password,omitempty"`
+	PasswordWo                               tfconfig.Variable `json:"password_wo,omitempty"`
+	PasswordWoHash                           tfconfig.Variable `json:"password_wo_hash,omitempty"`
+	PasswordWoVersion                        tfconfig.Variable `json:"password_wo_version,omitempty"`
 	PreventUnloadToInternalStages            tfconfig.Variable `json:"prevent_unload_to_internal_stages,omitempty"`
 	QueryTag                                 tfconfig.Variable `json:"query_tag,omitempty"`
 	QuotedIdentifiersIgnoreCase              tfconfig.Variable `json:"quoted_identifiers_ignore_case,omitempty"`
@@ -68,6 +71,7 @@ type LegacyServiceUserModel struct {
 	StatementQueuedTimeoutInSeconds          tfconfig.Variable `json:"statement_queued_timeout_in_seconds,omitempty"`
 	StatementTimeoutInSeconds                tfconfig.Variable `json:"statement_timeout_in_seconds,omitempty"`
 	StrictJsonOutput                         tfconfig.Variable `json:"strict_json_output,omitempty"`
+	Tags                                     tfconfig.Variable `json:"tags,omitempty"`
 	TimeInputFormat                          tfconfig.Variable `json:"time_input_format,omitempty"`
 	TimeOutputFormat                         tfconfig.Variable `json:"time_output_format,omitempty"`
 	TimestampDayIsAlways24h                  tfconfig.Variable `json:"timestamp_day_is_always_24h,omitempty"`
@@ -368,6 +372,21 @@ func (l *LegacyServiceUserModel) WithPassword(password string) *LegacyServiceUse
 	return l
 }
 
+func (l *LegacyServiceUserModel) WithPasswordWo(passwordWo string) *LegacyServiceUserModel {
+	l.PasswordWo = tfconfig.StringVariable(passwordWo)
+	return l
+}
+
+func (l *LegacyServiceUserModel) WithPasswordWoHash(passwordWoHash string) *LegacyServiceUserModel {
+	l.PasswordWoHash = tfconfig.StringVariable(passwordWoHash)
+	return l
+}
+
+func (l *LegacyServiceUserModel) WithPasswordWoVersion(passwordWoVersion int) *LegacyServiceUserModel {
+	l.PasswordWoVersion = tfconfig.IntegerVariable(passwordWoVersion)
+	return l
+}
+
 func (l *LegacyServiceUserModel) WithPreventUnloadToInternalStages(preventUnloadToInternalStages bool) *LegacyServiceUserModel {
 	l.PreventUnloadToInternalStages = tfconfig.BoolVariable(preventUnloadToInternalStages)
 	return l
@@ -428,6 +447,8 @@ func (l *LegacyServiceUserModel) WithStrictJsonOutput(strictJsonOutput bool) *Le
 	return l
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 func (l *LegacyServiceUserModel) WithTimeInputFormat(timeInputFormat string) *LegacyServiceUserModel {
 	l.TimeInputFormat = tfconfig.StringVariable(timeInputFormat)
 	return l
@@ -752,6 +773,21 @@ func (l *LegacyServiceUserModel) WithPasswordValue(value tfconfig.Variable) *Leg
 	return l
 }
 
+func (l *LegacyServiceUserModel) WithPasswordWoValue(value tfconfig.Variable) *LegacyServiceUserModel {
+	l.PasswordWo = value
+	return l
+}
+
+func (l *LegacyServiceUserModel) WithPasswordWoHashValue(value tfconfig.Variable) *LegacyServiceUserModel {
+	l.PasswordWoHash = value
+	return l
+}
+
+func (l *LegacyServiceUserModel) WithPasswordWoVersionValue(value tfconfig.Variable) *LegacyServiceUserModel {
+	l.PasswordWoVersion = value
+	return l
+}
+
 func (l *LegacyServiceUserModel) WithPreventUnloadToInternalStagesValue(value tfconfig.Variable) *LegacyServiceUserModel {
 	l.PreventUnloadToInternalStages = value
 	return l
@@ -812,6 +848,11 @@ func (l *LegacyServiceUserModel) WithStrictJsonOutputValue(value tfconfig.Variab
 	return l
 }
 
+func (l *LegacyServiceUserModel) WithTagsValue(value tfconfig.Variable) *LegacyServiceUserModel {
+	l.Tags = value
+	return l
+}
+
 func (l *LegacyServiceUserModel) WithTimeInputFormatValue(value tfconfig.Variable) *LegacyServiceUserModel {
 	l.TimeInputFormat = value
 	return l
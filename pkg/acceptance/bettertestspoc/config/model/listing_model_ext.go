@@ -52,3 +52,20 @@ func ListingWithStagedManifestWithOptionals(
 	))
 	return l
 }
+
+func ListingWithStructuredManifest(
+	resourceName string,
+	name string,
+	structured map[string]tfconfig.Variable,
+) *ListingModel {
+	l := &ListingModel{ResourceModelMeta: config.Meta(resourceName, resources.Listing)}
+	l.WithName(name)
+	l.WithManifestValue(tfconfig.ListVariable(
+		tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"structured": tfconfig.ListVariable(
+				tfconfig.MapVariable(structured),
+			),
+		}),
+	))
+	return l
+}
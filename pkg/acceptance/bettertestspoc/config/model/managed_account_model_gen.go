@@ -14,6 +14,7 @@ type ManagedAccountModel struct {
 	Name               tfconfig.Variable `json:"name,omitempty"`
 	AdminName          tfconfig.Variable `json:"admin_name,omitempty"`
 	AdminPassword      tfconfig.Variable `json:"admin_password,omitempty"`
+	AdminPasswordWo    tfconfig.Variable `json:"admin_password_wo,omitempty"`
 	Cloud              tfconfig.Variable `json:"cloud,omitempty"`
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	CreatedOn          tfconfig.Variable `json:"created_on,omitempty"`
@@ -36,24 +37,20 @@ func ManagedAccount(
 	resourceName string,
 	name string,
 	adminName string,
-	adminPassword string,
 ) *ManagedAccountModel {
 	m := &ManagedAccountModel{ResourceModelMeta: config.Meta(resourceName, resources.ManagedAccount)}
 	m.WithName(name)
 	m.WithAdminName(adminName)
-	m.WithAdminPassword(adminPassword)
 	return m
 }
 
 func ManagedAccountWithDefaultMeta(
 	name string,
 	adminName string,
-	adminPassword string,
 ) *ManagedAccountModel {
 	m := &ManagedAccountModel{ResourceModelMeta: config.DefaultMeta(resources.ManagedAccount)}
 	m.WithName(name)
 	m.WithAdminName(adminName)
-	m.WithAdminPassword(adminPassword)
 	return m
 }
 
@@ -101,6 +98,11 @@ func (m *ManagedAccountModel) WithAdminPassword(adminPassword string) *ManagedAc
 	return m
 }
 
+func (m *ManagedAccountModel) WithAdminPasswordWo(adminPasswordWo string) *ManagedAccountModel {
+	m.AdminPasswordWo = tfconfig.StringVariable(adminPasswordWo)
+	return m
+}
+
 func (m *ManagedAccountModel) WithCloud(cloud string) *ManagedAccountModel {
 	m.Cloud = tfconfig.StringVariable(cloud)
 	return m
@@ -160,6 +162,11 @@ func (m *ManagedAccountModel) WithAdminPasswordValue(value tfconfig.Variable) *M
 	return m
 }
 
+func (m *ManagedAccountModel) WithAdminPasswordWoValue(value tfconfig.Variable) *ManagedAccountModel {
+	m.AdminPasswordWo = value
+	return m
+}
+
 func (m *ManagedAccountModel) WithCloudValue(value tfconfig.Variable) *ManagedAccountModel {
 	m.Cloud = value
 	return m
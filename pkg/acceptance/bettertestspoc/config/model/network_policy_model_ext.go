@@ -64,3 +64,7 @@ func (n *NetworkPolicyModel) WithBlockedIps(ips ...string) *NetworkPolicyModel {
 		),
 	)
 }
+
+func (n *NetworkPolicyModel) WithTags(tags ...sdk.TagAssociation) *NetworkPolicyModel {
+	return n.WithTagsValue(tagsVariable(tags))
+}
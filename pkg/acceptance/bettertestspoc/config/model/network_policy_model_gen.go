@@ -18,6 +18,7 @@ type NetworkPolicyModel struct {
 	BlockedNetworkRuleList tfconfig.Variable `json:"blocked_network_rule_list,omitempty"`
 	Comment                tfconfig.Variable `json:"comment,omitempty"`
 	FullyQualifiedName     tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Tags                   tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -97,6 +98,8 @@ func (n *NetworkPolicyModel) WithFullyQualifiedName(fullyQualifiedName string) *
 	return n
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -135,3 +138,8 @@ func (n *NetworkPolicyModel) WithFullyQualifiedNameValue(value tfconfig.Variable
 	n.FullyQualifiedName = value
 	return n
 }
+
+func (n *NetworkPolicyModel) WithTagsValue(value tfconfig.Variable) *NetworkPolicyModel {
+	n.Tags = value
+	return n
+}
@@ -41,3 +41,7 @@ func (t *OauthIntegrationForCustomClientsModel) WithOauthClientRsaPublicKey2Empt
 	t.OauthClientRsaPublicKey2 = tfconfig.StringVariable("")
 	return t
 }
+
+func (t *OauthIntegrationForCustomClientsModel) WithTags(tags ...sdk.TagAssociation) *OauthIntegrationForCustomClientsModel {
+	return t.WithTagsValue(tagsVariable(tags))
+}
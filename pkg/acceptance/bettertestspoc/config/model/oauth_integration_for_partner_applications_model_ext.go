@@ -2,6 +2,8 @@ package model
 
 import (
 	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
 
 func (t *OauthIntegrationForPartnerApplicationsModel) WithBlockedRolesList(blockedRoles ...string) *OauthIntegrationForPartnerApplicationsModel {
@@ -13,3 +15,7 @@ func (t *OauthIntegrationForPartnerApplicationsModel) WithBlockedRolesList(block
 	t.BlockedRolesList = tfconfig.SetVariable(blockedRolesListStringVariables...)
 	return t
 }
+
+func (t *OauthIntegrationForPartnerApplicationsModel) WithTags(tags ...sdk.TagAssociation) *OauthIntegrationForPartnerApplicationsModel {
+	return t.WithTagsValue(tagsVariable(tags))
+}
@@ -22,6 +22,7 @@ type OauthIntegrationForPartnerApplicationsModel struct {
 	OauthRefreshTokenValidity tfconfig.Variable `json:"oauth_refresh_token_validity,omitempty"`
 	OauthUseSecondaryRoles    tfconfig.Variable `json:"oauth_use_secondary_roles,omitempty"`
 	RelatedParameters         tfconfig.Variable `json:"related_parameters,omitempty"`
+	Tags                      tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -131,6 +132,8 @@ func (o *OauthIntegrationForPartnerApplicationsModel) WithOauthUseSecondaryRoles
 
 // related_parameters attribute type is not yet supported, so WithRelatedParameters can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -189,3 +192,8 @@ func (o *OauthIntegrationForPartnerApplicationsModel) WithRelatedParametersValue
 	o.RelatedParameters = value
 	return o
 }
+
+func (o *OauthIntegrationForPartnerApplicationsModel) WithTagsValue(value tfconfig.Variable) *OauthIntegrationForPartnerApplicationsModel {
+	o.Tags = value
+	return o
+}
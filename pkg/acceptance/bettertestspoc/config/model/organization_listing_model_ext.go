@@ -0,0 +1,45 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func OrganizationListingWithShare(resourceName string, name string, title string, shareId sdk.AccountObjectIdentifier) *OrganizationListingModel {
+	return OrganizationListing(resourceName, name, title).WithShare(shareId.Name())
+}
+
+func (o *OrganizationListingModel) WithDiscoveryTargetsAllInternalAccounts() *OrganizationListingModel {
+	return o.WithDiscoveryTargetsValue(organizationListingAllInternalAccountsVariable())
+}
+
+func (o *OrganizationListingModel) WithAccessTargetsAllInternalAccounts() *OrganizationListingModel {
+	return o.WithAccessTargetsValue(organizationListingAllInternalAccountsVariable())
+}
+
+func (o *OrganizationListingModel) WithAccessTargetsAccount(account string, roles ...string) *OrganizationListingModel {
+	target := map[string]tfconfig.Variable{
+		"account": tfconfig.StringVariable(account),
+	}
+	if len(roles) > 0 {
+		target["roles"] = stringsSetVariable(roles)
+	}
+	return o.WithAccessTargetsValue(tfconfig.ListVariable(tfconfig.MapVariable(target)))
+}
+
+func (o *OrganizationListingModel) WithAccessRegions(regions ...string) *OrganizationListingModel {
+	return o.WithAccessRegionsValue(stringsSetVariable(regions))
+}
+
+func (o *OrganizationListingModel) WithAutoFulfillment(refreshType string, refreshSchedule string) *OrganizationListingModel {
+	return o.WithAutoFulfillmentValue(tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+		"refresh_type":     tfconfig.StringVariable(refreshType),
+		"refresh_schedule": tfconfig.StringVariable(refreshSchedule),
+	})))
+}
+
+func organizationListingAllInternalAccountsVariable() tfconfig.Variable {
+	return tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+		"all_internal_accounts": tfconfig.BoolVariable(true),
+	}))
+}
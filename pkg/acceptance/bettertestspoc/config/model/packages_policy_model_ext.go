@@ -0,0 +1,21 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func PackagesPolicyWithId(resourceName string, id sdk.SchemaObjectIdentifier) *PackagesPolicyModel {
+	return PackagesPolicy(resourceName, id.DatabaseName(), id.SchemaName(), id.Name())
+}
+
+func (p *PackagesPolicyModel) WithAllowlist(packageSpecs ...string) *PackagesPolicyModel {
+	return p.WithAllowlistValue(stringsSetVariable(packageSpecs))
+}
+
+func (p *PackagesPolicyModel) WithBlocklist(packageSpecs ...string) *PackagesPolicyModel {
+	return p.WithBlocklistValue(stringsSetVariable(packageSpecs))
+}
+
+func (p *PackagesPolicyModel) WithAdditionalCreationBlocklist(packageSpecs ...string) *PackagesPolicyModel {
+	return p.WithAdditionalCreationBlocklistValue(stringsSetVariable(packageSpecs))
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func PasswordPolicyWithId(resourceName string, id sdk.SchemaObjectIdentifier) *PasswordPolicyModel {
+	p := &PasswordPolicyModel{ResourceModelMeta: config.Meta(resourceName, resources.PasswordPolicy)}
+	p.WithDatabase(id.DatabaseName())
+	p.WithSchema(id.SchemaName())
+	p.WithName(id.Name())
+	return p
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func PrivacyPolicyWithId(resourceName string, id sdk.SchemaObjectIdentifier, body string) *PrivacyPolicyModel {
+	return PrivacyPolicy(resourceName, id.DatabaseName(), id.SchemaName(), id.Name(), body)
+}
+
+func PrivacyPolicyAttachmentToTable(resourceName string, privacyPolicyId sdk.SchemaObjectIdentifier, tableId sdk.SchemaObjectIdentifier) *PrivacyPolicyAttachmentModel {
+	return PrivacyPolicyAttachment(resourceName, privacyPolicyId.FullyQualifiedName()).WithTable(tableId.FullyQualifiedName())
+}
+
+func PrivacyPolicyAttachmentToView(resourceName string, privacyPolicyId sdk.SchemaObjectIdentifier, viewId sdk.SchemaObjectIdentifier) *PrivacyPolicyAttachmentModel {
+	return PrivacyPolicyAttachment(resourceName, privacyPolicyId.FullyQualifiedName()).WithView(viewId.FullyQualifiedName())
+}
+
+func (p *PrivacyPolicyAttachmentModel) WithEntityKey(columns ...string) *PrivacyPolicyAttachmentModel {
+	return p.WithEntityKeyValue(stringsSetVariable(columns))
+}
+
+func (t *TableColumnPrivacyDomainModel) WithValues(values ...string) *TableColumnPrivacyDomainModel {
+	return t.WithValuesValue(stringsSetVariable(values))
+}
+
+func (t *TableColumnPrivacyDomainModel) WithRange(lower string, upper string) *TableColumnPrivacyDomainModel {
+	return t.WithRangeValue(tfconfig.ListVariable(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"lower": tfconfig.StringVariable(lower),
+			"upper": tfconfig.StringVariable(upper),
+		}),
+	))
+}
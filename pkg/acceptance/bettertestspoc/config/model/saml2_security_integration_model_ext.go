@@ -3,6 +3,7 @@ package model
 import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
@@ -42,3 +43,7 @@ func (s *Saml2SecurityIntegrationModel) WithAllowedUserDomains(values ...string)
 	)
 	return s
 }
+
+func (s *Saml2SecurityIntegrationModel) WithTags(tags ...sdk.TagAssociation) *Saml2SecurityIntegrationModel {
+	return s.WithTagsValue(tagsVariable(tags))
+}
@@ -29,6 +29,7 @@ type Saml2SecurityIntegrationModel struct {
 	Saml2SpInitiatedLoginPageLabel tfconfig.Variable `json:"saml2_sp_initiated_login_page_label,omitempty"`
 	Saml2SsoUrl                    tfconfig.Variable `json:"saml2_sso_url,omitempty"`
 	Saml2X509Cert                  tfconfig.Variable `json:"saml2_x509_cert,omitempty"`
+	Tags                           tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -185,6 +186,8 @@ func (s *Saml2SecurityIntegrationModel) WithSaml2X509Cert(saml2X509Cert string)
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -278,3 +281,8 @@ func (s *Saml2SecurityIntegrationModel) WithSaml2X509CertValue(value tfconfig.Va
 	s.Saml2X509Cert = value
 	return s
 }
+
+func (s *Saml2SecurityIntegrationModel) WithTagsValue(value tfconfig.Variable) *Saml2SecurityIntegrationModel {
+	s.Tags = value
+	return s
+}
@@ -0,0 +1,9 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func (s *SchemaModel) WithTags(tags ...sdk.TagAssociation) *SchemaModel {
+	return s.WithTagsValue(tagsVariable(tags))
+}
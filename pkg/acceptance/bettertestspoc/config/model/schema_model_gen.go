@@ -28,6 +28,7 @@ type SchemaModel struct {
 	ReplaceInvalidCharacters                tfconfig.Variable `json:"replace_invalid_characters,omitempty"`
 	StorageSerializationPolicy              tfconfig.Variable `json:"storage_serialization_policy,omitempty"`
 	SuspendTaskAfterNumFailures             tfconfig.Variable `json:"suspend_task_after_num_failures,omitempty"`
+	Tags                                    tfconfig.Variable `json:"tags,omitempty"`
 	TaskAutoRetryAttempts                   tfconfig.Variable `json:"task_auto_retry_attempts,omitempty"`
 	TraceLevel                              tfconfig.Variable `json:"trace_level,omitempty"`
 	UserTaskManagedInitialWarehouseSize     tfconfig.Variable `json:"user_task_managed_initial_warehouse_size,omitempty"`
@@ -179,6 +180,8 @@ func (s *SchemaModel) WithSuspendTaskAfterNumFailures(suspendTaskAfterNumFailure
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 func (s *SchemaModel) WithTaskAutoRetryAttempts(taskAutoRetryAttempts int) *SchemaModel {
 	s.TaskAutoRetryAttempts = tfconfig.IntegerVariable(taskAutoRetryAttempts)
 	return s
@@ -298,6 +301,11 @@ func (s *SchemaModel) WithSuspendTaskAfterNumFailuresValue(value tfconfig.Variab
 	return s
 }
 
+func (s *SchemaModel) WithTagsValue(value tfconfig.Variable) *SchemaModel {
+	s.Tags = value
+	return s
+}
+
 func (s *SchemaModel) WithTaskAutoRetryAttemptsValue(value tfconfig.Variable) *SchemaModel {
 	s.TaskAutoRetryAttempts = value
 	return s
@@ -0,0 +1,9 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func (s *ScimSecurityIntegrationModel) WithTags(tags ...sdk.TagAssociation) *ScimSecurityIntegrationModel {
+	return s.WithTagsValue(tagsVariable(tags))
+}
@@ -19,6 +19,7 @@ type ScimSecurityIntegrationModel struct {
 	RunAsRole          tfconfig.Variable `json:"run_as_role,omitempty"`
 	ScimClient         tfconfig.Variable `json:"scim_client,omitempty"`
 	SyncPassword       tfconfig.Variable `json:"sync_password,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -127,6 +128,8 @@ func (s *ScimSecurityIntegrationModel) WithSyncPassword(syncPassword string) *Sc
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -170,3 +173,8 @@ func (s *ScimSecurityIntegrationModel) WithSyncPasswordValue(value tfconfig.Vari
 	s.SyncPassword = value
 	return s
 }
+
+func (s *ScimSecurityIntegrationModel) WithTagsValue(value tfconfig.Variable) *ScimSecurityIntegrationModel {
+	s.Tags = value
+	return s
+}
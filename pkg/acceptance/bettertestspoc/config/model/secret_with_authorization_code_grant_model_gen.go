@@ -19,6 +19,9 @@ type SecretWithAuthorizationCodeGrantModel struct {
 	FullyQualifiedName          tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	OauthRefreshToken           tfconfig.Variable `json:"oauth_refresh_token,omitempty"`
 	OauthRefreshTokenExpiryTime tfconfig.Variable `json:"oauth_refresh_token_expiry_time,omitempty"`
+	OauthRefreshTokenWo         tfconfig.Variable `json:"oauth_refresh_token_wo,omitempty"`
+	OauthRefreshTokenWoHash     tfconfig.Variable `json:"oauth_refresh_token_wo_hash,omitempty"`
+	OauthRefreshTokenWoVersion  tfconfig.Variable `json:"oauth_refresh_token_wo_version,omitempty"`
 	SecretType                  tfconfig.Variable `json:"secret_type,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -36,7 +39,6 @@ func SecretWithAuthorizationCodeGrant(
 	schema string,
 	name string,
 	apiAuthentication string,
-	oauthRefreshToken string,
 	oauthRefreshTokenExpiryTime string,
 ) *SecretWithAuthorizationCodeGrantModel {
 	s := &SecretWithAuthorizationCodeGrantModel{ResourceModelMeta: config.Meta(resourceName, resources.SecretWithAuthorizationCodeGrant)}
@@ -44,7 +46,6 @@ func SecretWithAuthorizationCodeGrant(
 	s.WithSchema(schema)
 	s.WithName(name)
 	s.WithApiAuthentication(apiAuthentication)
-	s.WithOauthRefreshToken(oauthRefreshToken)
 	s.WithOauthRefreshTokenExpiryTime(oauthRefreshTokenExpiryTime)
 	return s
 }
@@ -54,7 +55,6 @@ func SecretWithAuthorizationCodeGrantWithDefaultMeta(
 	schema string,
 	name string,
 	apiAuthentication string,
-	oauthRefreshToken string,
 	oauthRefreshTokenExpiryTime string,
 ) *SecretWithAuthorizationCodeGrantModel {
 	s := &SecretWithAuthorizationCodeGrantModel{ResourceModelMeta: config.DefaultMeta(resources.SecretWithAuthorizationCodeGrant)}
@@ -62,7 +62,6 @@ func SecretWithAuthorizationCodeGrantWithDefaultMeta(
 	s.WithSchema(schema)
 	s.WithName(name)
 	s.WithApiAuthentication(apiAuthentication)
-	s.WithOauthRefreshToken(oauthRefreshToken)
 	s.WithOauthRefreshTokenExpiryTime(oauthRefreshTokenExpiryTime)
 	return s
 }
@@ -136,6 +135,21 @@ func (s *SecretWithAuthorizationCodeGrantModel) WithOauthRefreshTokenExpiryTime(
 	return s
 }
 
+func (s *SecretWithAuthorizationCodeGrantModel) WithOauthRefreshTokenWo(oauthRefreshTokenWo string) *SecretWithAuthorizationCodeGrantModel {
+	s.OauthRefreshTokenWo = tfconfig.StringVariable(oauthRefreshTokenWo)
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantModel) WithOauthRefreshTokenWoHash(oauthRefreshTokenWoHash string) *SecretWithAuthorizationCodeGrantModel {
+	s.OauthRefreshTokenWoHash = tfconfig.StringVariable(oauthRefreshTokenWoHash)
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantModel) WithOauthRefreshTokenWoVersion(oauthRefreshTokenWoVersion int) *SecretWithAuthorizationCodeGrantModel {
+	s.OauthRefreshTokenWoVersion = tfconfig.IntegerVariable(oauthRefreshTokenWoVersion)
+	return s
+}
+
 func (s *SecretWithAuthorizationCodeGrantModel) WithSecretType(secretType string) *SecretWithAuthorizationCodeGrantModel {
 	s.SecretType = tfconfig.StringVariable(secretType)
 	return s
@@ -185,6 +199,21 @@ func (s *SecretWithAuthorizationCodeGrantModel) WithOauthRefreshTokenExpiryTimeV
 	return s
 }
 
+func (s *SecretWithAuthorizationCodeGrantModel) WithOauthRefreshTokenWoValue(value tfconfig.Variable) *SecretWithAuthorizationCodeGrantModel {
+	s.OauthRefreshTokenWo = value
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantModel) WithOauthRefreshTokenWoHashValue(value tfconfig.Variable) *SecretWithAuthorizationCodeGrantModel {
+	s.OauthRefreshTokenWoHash = value
+	return s
+}
+
+func (s *SecretWithAuthorizationCodeGrantModel) WithOauthRefreshTokenWoVersionValue(value tfconfig.Variable) *SecretWithAuthorizationCodeGrantModel {
+	s.OauthRefreshTokenWoVersion = value
+	return s
+}
+
 func (s *SecretWithAuthorizationCodeGrantModel) WithSecretTypeValue(value tfconfig.Variable) *SecretWithAuthorizationCodeGrantModel {
 	s.SecretType = value
 	return s
@@ -17,6 +17,9 @@ type SecretWithBasicAuthenticationModel struct {
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	Password           tfconfig.Variable `json:"password,omitempty"`
+	PasswordWo         tfconfig.Variable `json:"password_wo,omitempty"`
+	PasswordWoHash     tfconfig.Variable `json:"password_wo_hash,omitempty"`
+	PasswordWoVersion  tfconfig.Variable `json:"password_wo_version,omitempty"`
 	SecretType         tfconfig.Variable `json:"secret_type,omitempty"`
 	Username           tfconfig.Variable `json:"username,omitempty"`
 
@@ -34,14 +37,12 @@ func SecretWithBasicAuthentication(
 	database string,
 	schema string,
 	name string,
-	password string,
 	username string,
 ) *SecretWithBasicAuthenticationModel {
 	s := &SecretWithBasicAuthenticationModel{ResourceModelMeta: config.Meta(resourceName, resources.SecretWithBasicAuthentication)}
 	s.WithDatabase(database)
 	s.WithSchema(schema)
 	s.WithName(name)
-	s.WithPassword(password)
 	s.WithUsername(username)
 	return s
 }
@@ -50,14 +51,12 @@ func SecretWithBasicAuthenticationWithDefaultMeta(
 	database string,
 	schema string,
 	name string,
-	password string,
 	username string,
 ) *SecretWithBasicAuthenticationModel {
 	s := &SecretWithBasicAuthenticationModel{ResourceModelMeta: config.DefaultMeta(resources.SecretWithBasicAuthentication)}
 	s.WithDatabase(database)
 	s.WithSchema(schema)
 	s.WithName(name)
-	s.WithPassword(password)
 	s.WithUsername(username)
 	return s
 }
@@ -121,6 +120,21 @@ func (s *SecretWithBasicAuthenticationModel) WithPassword(password string) *Secr
 	return s
 }
 
+func (s *SecretWithBasicAuthenticationModel) WithPasswordWo(passwordWo string) *SecretWithBasicAuthenticationModel {
+	s.PasswordWo = tfconfig.StringVariable(passwordWo)
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationModel) WithPasswordWoHash(passwordWoHash string) *SecretWithBasicAuthenticationModel {
+	s.PasswordWoHash = tfconfig.StringVariable(passwordWoHash)
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationModel) WithPasswordWoVersion(passwordWoVersion int) *SecretWithBasicAuthenticationModel {
+	s.PasswordWoVersion = tfconfig.IntegerVariable(passwordWoVersion)
+	return s
+}
+
 func (s *SecretWithBasicAuthenticationModel) WithSecretType(secretType string) *SecretWithBasicAuthenticationModel {
 	s.SecretType = tfconfig.StringVariable(secretType)
 	return s
@@ -165,6 +179,21 @@ func (s *SecretWithBasicAuthenticationModel) WithPasswordValue(value tfconfig.Va
 	return s
 }
 
+func (s *SecretWithBasicAuthenticationModel) WithPasswordWoValue(value tfconfig.Variable) *SecretWithBasicAuthenticationModel {
+	s.PasswordWo = value
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationModel) WithPasswordWoHashValue(value tfconfig.Variable) *SecretWithBasicAuthenticationModel {
+	s.PasswordWoHash = value
+	return s
+}
+
+func (s *SecretWithBasicAuthenticationModel) WithPasswordWoVersionValue(value tfconfig.Variable) *SecretWithBasicAuthenticationModel {
+	s.PasswordWoVersion = value
+	return s
+}
+
 func (s *SecretWithBasicAuthenticationModel) WithSecretTypeValue(value tfconfig.Variable) *SecretWithBasicAuthenticationModel {
 	s.SecretType = value
 	return s
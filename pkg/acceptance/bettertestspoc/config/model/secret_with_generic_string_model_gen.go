@@ -11,13 +11,16 @@ import (
 )
 
 type SecretWithGenericStringModel struct {
-	Database           tfconfig.Variable `json:"database,omitempty"`
-	Schema             tfconfig.Variable `json:"schema,omitempty"`
-	Name               tfconfig.Variable `json:"name,omitempty"`
-	Comment            tfconfig.Variable `json:"comment,omitempty"`
-	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
-	SecretString       tfconfig.Variable `json:"secret_string,omitempty"`
-	SecretType         tfconfig.Variable `json:"secret_type,omitempty"`
+	Database              tfconfig.Variable `json:"database,omitempty"`
+	Schema                tfconfig.Variable `json:"schema,omitempty"`
+	Name                  tfconfig.Variable `json:"name,omitempty"`
+	Comment               tfconfig.Variable `json:"comment,omitempty"`
+	FullyQualifiedName    tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	SecretString          tfconfig.Variable `json:"secret_string,omitempty"`
+	SecretStringWo        tfconfig.Variable `json:"secret_string_wo,omitempty"`
+	SecretStringWoHash    tfconfig.Variable `json:"secret_string_wo_hash,omitempty"`
+	SecretStringWoVersion tfconfig.Variable `json:"secret_string_wo_version,omitempty"`
+	SecretType            tfconfig.Variable `json:"secret_type,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -33,13 +36,11 @@ func SecretWithGenericString(
 	database string,
 	schema string,
 	name string,
-	secretString string,
 ) *SecretWithGenericStringModel {
 	s := &SecretWithGenericStringModel{ResourceModelMeta: config.Meta(resourceName, resources.SecretWithGenericString)}
 	s.WithDatabase(database)
 	s.WithSchema(schema)
 	s.WithName(name)
-	s.WithSecretString(secretString)
 	return s
 }
 
@@ -47,13 +48,11 @@ func SecretWithGenericStringWithDefaultMeta(
 	database string,
 	schema string,
 	name string,
-	secretString string,
 ) *SecretWithGenericStringModel {
 	s := &SecretWithGenericStringModel{ResourceModelMeta: config.DefaultMeta(resources.SecretWithGenericString)}
 	s.WithDatabase(database)
 	s.WithSchema(schema)
 	s.WithName(name)
-	s.WithSecretString(secretString)
 	return s
 }
 
@@ -116,6 +115,21 @@ func (s *SecretWithGenericStringModel) WithSecretString(secretString string) *Se
 	return s
 }
 
+func (s *SecretWithGenericStringModel) WithSecretStringWo(secretStringWo string) *SecretWithGenericStringModel {
+	s.SecretStringWo = tfconfig.StringVariable(secretStringWo)
+	return s
+}
+
+func (s *SecretWithGenericStringModel) WithSecretStringWoHash(secretStringWoHash string) *SecretWithGenericStringModel {
+	s.SecretStringWoHash = tfconfig.StringVariable(secretStringWoHash)
+	return s
+}
+
+func (s *SecretWithGenericStringModel) WithSecretStringWoVersion(secretStringWoVersion int) *SecretWithGenericStringModel {
+	s.SecretStringWoVersion = tfconfig.IntegerVariable(secretStringWoVersion)
+	return s
+}
+
 func (s *SecretWithGenericStringModel) WithSecretType(secretType string) *SecretWithGenericStringModel {
 	s.SecretType = tfconfig.StringVariable(secretType)
 	return s
@@ -155,6 +169,21 @@ func (s *SecretWithGenericStringModel) WithSecretStringValue(value tfconfig.Vari
 	return s
 }
 
+func (s *SecretWithGenericStringModel) WithSecretStringWoValue(value tfconfig.Variable) *SecretWithGenericStringModel {
+	s.SecretStringWo = value
+	return s
+}
+
+func (s *SecretWithGenericStringModel) WithSecretStringWoHashValue(value tfconfig.Variable) *SecretWithGenericStringModel {
+	s.SecretStringWoHash = value
+	return s
+}
+
+func (s *SecretWithGenericStringModel) WithSecretStringWoVersionValue(value tfconfig.Variable) *SecretWithGenericStringModel {
+	s.SecretStringWoVersion = value
+	return s
+}
+
 func (s *SecretWithGenericStringModel) WithSecretTypeValue(value tfconfig.Variable) *SecretWithGenericStringModel {
 	s.SecretType = value
 	return s
@@ -3,6 +3,7 @@ package model
 import (
 	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
 
@@ -64,3 +65,45 @@ func (u *ServiceUserModel) WithNetworkPolicyId(networkPolicy sdk.AccountObjectId
 func (u *ServiceUserModel) WithDefaultSecondaryRolesOptionEnum(option sdk.SecondaryRolesOption) *ServiceUserModel {
 	return u.WithDefaultSecondaryRolesOption(string(option))
 }
+
+func (s *ServiceUserModel) WithTags(tags ...sdk.TagAssociation) *ServiceUserModel {
+	return s.WithTagsValue(tagsVariable(tags))
+}
+
+func (u *ServiceUserModel) WithWorkloadIdentityAws(arn string) *ServiceUserModel {
+	return u.withWorkloadIdentity("aws", map[string]tfconfig.Variable{
+		"arn": tfconfig.StringVariable(arn),
+	})
+}
+
+func (u *ServiceUserModel) WithWorkloadIdentityGcp(subject string) *ServiceUserModel {
+	return u.withWorkloadIdentity("gcp", map[string]tfconfig.Variable{
+		"subject": tfconfig.StringVariable(subject),
+	})
+}
+
+func (u *ServiceUserModel) WithWorkloadIdentityAzure(issuer string, subject string) *ServiceUserModel {
+	return u.withWorkloadIdentity("azure", map[string]tfconfig.Variable{
+		"issuer":  tfconfig.StringVariable(issuer),
+		"subject": tfconfig.StringVariable(subject),
+	})
+}
+
+func (u *ServiceUserModel) WithWorkloadIdentityOidc(issuer string, subject string, audiences ...string) *ServiceUserModel {
+	oidc := map[string]tfconfig.Variable{
+		"issuer":  tfconfig.StringVariable(issuer),
+		"subject": tfconfig.StringVariable(subject),
+	}
+	if len(audiences) > 0 {
+		oidc["oidc_audience_list"] = tfconfig.SetVariable(collections.Map(audiences, func(audience string) tfconfig.Variable {
+			return tfconfig.StringVariable(audience)
+		})...)
+	}
+	return u.withWorkloadIdentity("oidc", oidc)
+}
+
+func (u *ServiceUserModel) withWorkloadIdentity(workloadIdentityType string, workloadIdentity map[string]tfconfig.Variable) *ServiceUserModel {
+	return u.WithWorkloadIdentityValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		workloadIdentityType: tfconfig.ObjectVariable(workloadIdentity),
+	}))
+}
@@ -66,6 +66,7 @@ type ServiceUserModel struct {
 	StatementQueuedTimeoutInSeconds          tfconfig.Variable `json:"statement_queued_timeout_in_seconds,omitempty"`
 	StatementTimeoutInSeconds                tfconfig.Variable `json:"statement_timeout_in_seconds,omitempty"`
 	StrictJsonOutput                         tfconfig.Variable `json:"strict_json_output,omitempty"`
+	Tags                                     tfconfig.Variable `json:"tags,omitempty"`
 	TimeInputFormat                          tfconfig.Variable `json:"time_input_format,omitempty"`
 	TimeOutputFormat                         tfconfig.Variable `json:"time_output_format,omitempty"`
 	TimestampDayIsAlways24h                  tfconfig.Variable `json:"timestamp_day_is_always_24h,omitempty"`
@@ -85,6 +86,7 @@ type ServiceUserModel struct {
 	UserType                                 tfconfig.Variable `json:"user_type,omitempty"`
 	WeekOfYearPolicy                         tfconfig.Variable `json:"week_of_year_policy,omitempty"`
 	WeekStart                                tfconfig.Variable `json:"week_start,omitempty"`
+	WorkloadIdentity                         tfconfig.Variable `json:"workload_identity,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -416,6 +418,8 @@ func (s *ServiceUserModel) WithStrictJsonOutput(strictJsonOutput bool) *ServiceU
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 func (s *ServiceUserModel) WithTimeInputFormat(timeInputFormat string) *ServiceUserModel {
 	s.TimeInputFormat = tfconfig.StringVariable(timeInputFormat)
 	return s
@@ -511,6 +515,8 @@ func (s *ServiceUserModel) WithWeekStart(weekStart int) *ServiceUserModel {
 	return s
 }
 
+// workload_identity attribute type is not yet supported, so WithWorkloadIdentity can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -790,6 +796,11 @@ func (s *ServiceUserModel) WithStrictJsonOutputValue(value tfconfig.Variable) *S
 	return s
 }
 
+func (s *ServiceUserModel) WithTagsValue(value tfconfig.Variable) *ServiceUserModel {
+	s.Tags = value
+	return s
+}
+
 func (s *ServiceUserModel) WithTimeInputFormatValue(value tfconfig.Variable) *ServiceUserModel {
 	s.TimeInputFormat = value
 	return s
@@ -884,3 +895,8 @@ func (s *ServiceUserModel) WithWeekStartValue(value tfconfig.Variable) *ServiceU
 	s.WeekStart = value
 	return s
 }
+
+func (s *ServiceUserModel) WithWorkloadIdentityValue(value tfconfig.Variable) *ServiceUserModel {
+	s.WorkloadIdentity = value
+	return s
+}
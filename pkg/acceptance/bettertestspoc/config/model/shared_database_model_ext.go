@@ -0,0 +1,9 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func (s *SharedDatabaseModel) WithTags(tags ...sdk.TagAssociation) *SharedDatabaseModel {
+	return s.WithTagsValue(tagsVariable(tags))
+}
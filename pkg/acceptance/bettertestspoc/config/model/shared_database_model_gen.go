@@ -24,6 +24,7 @@ type SharedDatabaseModel struct {
 	ReplaceInvalidCharacters                tfconfig.Variable `json:"replace_invalid_characters,omitempty"`
 	StorageSerializationPolicy              tfconfig.Variable `json:"storage_serialization_policy,omitempty"`
 	SuspendTaskAfterNumFailures             tfconfig.Variable `json:"suspend_task_after_num_failures,omitempty"`
+	Tags                                    tfconfig.Variable `json:"tags,omitempty"`
 	TaskAutoRetryAttempts                   tfconfig.Variable `json:"task_auto_retry_attempts,omitempty"`
 	TraceLevel                              tfconfig.Variable `json:"trace_level,omitempty"`
 	UserTaskManagedInitialWarehouseSize     tfconfig.Variable `json:"user_task_managed_initial_warehouse_size,omitempty"`
@@ -154,6 +155,8 @@ func (s *SharedDatabaseModel) WithSuspendTaskAfterNumFailures(suspendTaskAfterNu
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 func (s *SharedDatabaseModel) WithTaskAutoRetryAttempts(taskAutoRetryAttempts int) *SharedDatabaseModel {
 	s.TaskAutoRetryAttempts = tfconfig.IntegerVariable(taskAutoRetryAttempts)
 	return s
@@ -248,6 +251,11 @@ func (s *SharedDatabaseModel) WithSuspendTaskAfterNumFailuresValue(value tfconfi
 	return s
 }
 
+func (s *SharedDatabaseModel) WithTagsValue(value tfconfig.Variable) *SharedDatabaseModel {
+	s.Tags = value
+	return s
+}
+
 func (s *SharedDatabaseModel) WithTaskAutoRetryAttemptsValue(value tfconfig.Variable) *SharedDatabaseModel {
 	s.TaskAutoRetryAttempts = value
 	return s
@@ -0,0 +1,9 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func SnapshotPolicyWithId(resourceName string, id sdk.SchemaObjectIdentifier) *SnapshotPolicyModel {
+	return SnapshotPolicy(resourceName, id.DatabaseName(), id.SchemaName(), id.Name())
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func SnapshotSetForTable(resourceName string, id sdk.SchemaObjectIdentifier, tableId sdk.SchemaObjectIdentifier) *SnapshotSetModel {
+	return SnapshotSet(resourceName, id.DatabaseName(), id.SchemaName(), id.Name()).WithForTable(tableId.FullyQualifiedName())
+}
+
+func SnapshotSetForSchema(resourceName string, id sdk.SchemaObjectIdentifier, schemaId sdk.DatabaseObjectIdentifier) *SnapshotSetModel {
+	return SnapshotSet(resourceName, id.DatabaseName(), id.SchemaName(), id.Name()).WithForSchema(schemaId.FullyQualifiedName())
+}
+
+func SnapshotSetForDatabase(resourceName string, id sdk.SchemaObjectIdentifier, databaseId sdk.AccountObjectIdentifier) *SnapshotSetModel {
+	return SnapshotSet(resourceName, id.DatabaseName(), id.SchemaName(), id.Name()).WithForDatabase(databaseId.FullyQualifiedName())
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func StorageLifecyclePolicyFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	argument []sdk.TableColumnSignature,
+	body string,
+) *StorageLifecyclePolicyModel {
+	return StorageLifecyclePolicy(resourceName, id.DatabaseName(), id.SchemaName(), id.Name(), argument, body)
+}
+
+func (s *StorageLifecyclePolicyModel) WithArgument(argument []sdk.TableColumnSignature) *StorageLifecyclePolicyModel {
+	maps := make([]tfconfig.Variable, len(argument))
+	for i, v := range argument {
+		maps[i] = tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"name": tfconfig.StringVariable(v.Name),
+			"type": tfconfig.StringVariable(v.Type.ToSql()),
+		})
+	}
+	s.Argument = tfconfig.ListVariable(maps...)
+	return s
+}
+
+func (s *StorageLifecyclePolicyAttachmentModel) WithOn(on []string) *StorageLifecyclePolicyAttachmentModel {
+	return s.WithOnValue(tfconfig.ListVariable(collections.Map(on, func(column string) tfconfig.Variable { return tfconfig.StringVariable(column) })...))
+}
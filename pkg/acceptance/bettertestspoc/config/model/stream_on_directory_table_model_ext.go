@@ -0,0 +1,9 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func (s *StreamOnDirectoryTableModel) WithTags(tags ...sdk.TagAssociation) *StreamOnDirectoryTableModel {
+	return s.WithTagsValue(tagsVariable(tags))
+}
@@ -20,6 +20,7 @@ type StreamOnDirectoryTableModel struct {
 	Stage              tfconfig.Variable `json:"stage,omitempty"`
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -133,6 +134,8 @@ func (s *StreamOnDirectoryTableModel) WithStreamType(streamType string) *StreamO
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -181,3 +184,8 @@ func (s *StreamOnDirectoryTableModel) WithStreamTypeValue(value tfconfig.Variabl
 	s.StreamType = value
 	return s
 }
+
+func (s *StreamOnDirectoryTableModel) WithTagsValue(value tfconfig.Variable) *StreamOnDirectoryTableModel {
+	s.Tags = value
+	return s
+}
@@ -7,3 +7,7 @@ import (
 func StreamOnExternalTableBase(resourceName string, id, externalTableId sdk.SchemaObjectIdentifier) *StreamOnExternalTableModel {
 	return StreamOnExternalTable(resourceName, id.DatabaseName(), id.SchemaName(), id.Name(), externalTableId.FullyQualifiedName()).WithInsertOnly("true")
 }
+
+func (s *StreamOnExternalTableModel) WithTags(tags ...sdk.TagAssociation) *StreamOnExternalTableModel {
+	return s.WithTagsValue(tagsVariable(tags))
+}
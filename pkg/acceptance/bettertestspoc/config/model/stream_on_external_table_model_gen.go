@@ -23,6 +23,7 @@ type StreamOnExternalTableModel struct {
 	InsertOnly         tfconfig.Variable `json:"insert_only,omitempty"`
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -145,6 +146,8 @@ func (s *StreamOnExternalTableModel) WithStreamType(streamType string) *StreamOn
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -208,3 +211,8 @@ func (s *StreamOnExternalTableModel) WithStreamTypeValue(value tfconfig.Variable
 	s.StreamType = value
 	return s
 }
+
+func (s *StreamOnExternalTableModel) WithTagsValue(value tfconfig.Variable) *StreamOnExternalTableModel {
+	s.Tags = value
+	return s
+}
@@ -7,3 +7,7 @@ import (
 func StreamOnTableBase(resourceName string, id, tableId sdk.SchemaObjectIdentifier) *StreamOnTableModel {
 	return StreamOnTable(resourceName, id.DatabaseName(), id.SchemaName(), id.Name(), tableId.FullyQualifiedName())
 }
+
+func (s *StreamOnTableModel) WithTags(tags ...sdk.TagAssociation) *StreamOnTableModel {
+	return s.WithTagsValue(tagsVariable(tags))
+}
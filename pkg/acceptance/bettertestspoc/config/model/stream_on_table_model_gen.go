@@ -24,6 +24,7 @@ type StreamOnTableModel struct {
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
 	Table              tfconfig.Variable `json:"table,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -151,6 +152,8 @@ func (s *StreamOnTableModel) WithTable(table string) *StreamOnTableModel {
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -219,3 +222,8 @@ func (s *StreamOnTableModel) WithTableValue(value tfconfig.Variable) *StreamOnTa
 	s.Table = value
 	return s
 }
+
+func (s *StreamOnTableModel) WithTagsValue(value tfconfig.Variable) *StreamOnTableModel {
+	s.Tags = value
+	return s
+}
@@ -7,3 +7,7 @@ import (
 func StreamOnViewBase(resourceName string, id sdk.SchemaObjectIdentifier, viewId sdk.SchemaObjectIdentifier) *StreamOnViewModel {
 	return StreamOnView(resourceName, id.DatabaseName(), id.SchemaName(), id.Name(), viewId.FullyQualifiedName())
 }
+
+func (s *StreamOnViewModel) WithTags(tags ...sdk.TagAssociation) *StreamOnViewModel {
+	return s.WithTagsValue(tagsVariable(tags))
+}
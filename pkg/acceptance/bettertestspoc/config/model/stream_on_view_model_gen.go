@@ -23,6 +23,7 @@ type StreamOnViewModel struct {
 	ShowInitialRows    tfconfig.Variable `json:"show_initial_rows,omitempty"`
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
 	View               tfconfig.Variable `json:"view,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -146,6 +147,8 @@ func (s *StreamOnViewModel) WithStreamType(streamType string) *StreamOnViewModel
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 func (s *StreamOnViewModel) WithView(view string) *StreamOnViewModel {
 	s.View = tfconfig.StringVariable(view)
 	return s
@@ -215,6 +218,11 @@ func (s *StreamOnViewModel) WithStreamTypeValue(value tfconfig.Variable) *Stream
 	return s
 }
 
+func (s *StreamOnViewModel) WithTagsValue(value tfconfig.Variable) *StreamOnViewModel {
+	s.Tags = value
+	return s
+}
+
 func (s *StreamOnViewModel) WithViewValue(value tfconfig.Variable) *StreamOnViewModel {
 	s.View = value
 	return s
@@ -0,0 +1,16 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// tagsVariable builds the value of the tags attribute; the keys are fully qualified names of the given tags.
+func tagsVariable(tags []sdk.TagAssociation) tfconfig.Variable {
+	variables := make(map[string]tfconfig.Variable, len(tags))
+	for _, tag := range tags {
+		variables[tag.Name.FullyQualifiedName()] = tfconfig.StringVariable(tag.Value)
+	}
+	return tfconfig.MapVariable(variables)
+}
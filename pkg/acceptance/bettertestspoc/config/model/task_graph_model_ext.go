@@ -0,0 +1,83 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+// TaskGraphTask represents a single task block (root, task, or finalizer) of the task graph resource.
+type TaskGraphTask struct {
+	Name         string
+	SqlStatement string
+	Warehouse    string
+	When         string
+	Comment      string
+	After        []string
+}
+
+func TaskGraphWithRoot(resourceName string, schemaId sdk.DatabaseObjectIdentifier, root TaskGraphTask, started bool) *TaskGraphModel {
+	t := &TaskGraphModel{ResourceModelMeta: config.Meta(resourceName, resources.TaskGraph)}
+	t.WithDatabase(schemaId.DatabaseName())
+	t.WithSchema(schemaId.Name())
+	t.WithRoot([]TaskGraphTask{root})
+	t.WithStarted(started)
+	return t
+}
+
+func (t *TaskGraphModel) WithRoot(root []TaskGraphTask) *TaskGraphModel {
+	t.Root = taskGraphTasksVariable(root)
+	return t
+}
+
+func (t *TaskGraphModel) WithTask(task []TaskGraphTask) *TaskGraphModel {
+	t.Task = taskGraphTasksVariable(task)
+	return t
+}
+
+func (t *TaskGraphModel) WithFinalizer(finalizer []TaskGraphTask) *TaskGraphModel {
+	t.Finalizer = taskGraphTasksVariable(finalizer)
+	return t
+}
+
+func (t *TaskGraphModel) WithScheduleMinutes(minutes int) *TaskGraphModel {
+	t.Schedule = tfconfig.MapVariable(map[string]tfconfig.Variable{
+		"minutes": tfconfig.IntegerVariable(minutes),
+	})
+	return t
+}
+
+func (t *TaskGraphModel) WithScheduleCron(cron string) *TaskGraphModel {
+	t.Schedule = tfconfig.MapVariable(map[string]tfconfig.Variable{
+		"using_cron": tfconfig.StringVariable(cron),
+	})
+	return t
+}
+
+func taskGraphTasksVariable(tasks []TaskGraphTask) tfconfig.Variable {
+	maps := make([]tfconfig.Variable, len(tasks))
+	for idx, task := range tasks {
+		m := map[string]tfconfig.Variable{
+			"name":          tfconfig.StringVariable(task.Name),
+			"sql_statement": tfconfig.StringVariable(task.SqlStatement),
+		}
+		if task.Warehouse != "" {
+			m["warehouse"] = tfconfig.StringVariable(task.Warehouse)
+		}
+		if task.When != "" {
+			m["when"] = tfconfig.StringVariable(task.When)
+		}
+		if task.Comment != "" {
+			m["comment"] = tfconfig.StringVariable(task.Comment)
+		}
+		if len(task.After) > 0 {
+			m["after"] = tfconfig.SetVariable(collections.Map(task.After, func(after string) tfconfig.Variable {
+				return tfconfig.StringVariable(after)
+			})...)
+		}
+		maps[idx] = tfconfig.MapVariable(m)
+	}
+	return tfconfig.ListVariable(maps...)
+}
@@ -118,3 +118,7 @@ func (t *TaskModel) WithMultipleSchedules() *TaskModel {
 		}),
 	)
 }
+
+func (t *TaskModel) WithTags(tags ...sdk.TagAssociation) *TaskModel {
+	return t.WithTagsValue(tagsVariable(tags))
+}
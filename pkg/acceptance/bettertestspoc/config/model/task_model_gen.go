@@ -60,6 +60,7 @@ type TaskModel struct {
 	StatementTimeoutInSeconds                tfconfig.Variable `json:"statement_timeout_in_seconds,omitempty"`
 	StrictJsonOutput                         tfconfig.Variable `json:"strict_json_output,omitempty"`
 	SuspendTaskAfterNumFailures              tfconfig.Variable `json:"suspend_task_after_num_failures,omitempty"`
+	Tags                                     tfconfig.Variable `json:"tags,omitempty"`
 	TaskAutoRetryAttempts                    tfconfig.Variable `json:"task_auto_retry_attempts,omitempty"`
 	TimeInputFormat                          tfconfig.Variable `json:"time_input_format,omitempty"`
 	TimeOutputFormat                         tfconfig.Variable `json:"time_output_format,omitempty"`
@@ -395,6 +396,8 @@ func (t *TaskModel) WithSuspendTaskAfterNumFailures(suspendTaskAfterNumFailures
 	return t
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 func (t *TaskModel) WithTaskAutoRetryAttempts(taskAutoRetryAttempts int) *TaskModel {
 	t.TaskAutoRetryAttempts = tfconfig.IntegerVariable(taskAutoRetryAttempts)
 	return t
@@ -764,6 +767,11 @@ func (t *TaskModel) WithSuspendTaskAfterNumFailuresValue(value tfconfig.Variable
 	return t
 }
 
+func (t *TaskModel) WithTagsValue(value tfconfig.Variable) *TaskModel {
+	t.Tags = value
+	return t
+}
+
 func (t *TaskModel) WithTaskAutoRetryAttemptsValue(value tfconfig.Variable) *TaskModel {
 	t.TaskAutoRetryAttempts = value
 	return t
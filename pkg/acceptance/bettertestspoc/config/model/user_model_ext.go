@@ -69,3 +69,7 @@ func (u *UserModel) WithNullPassword() *UserModel {
 func (u *UserModel) WithDefaultSecondaryRolesOptionEnum(option sdk.SecondaryRolesOption) *UserModel {
 	return u.WithDefaultSecondaryRolesOption(string(option))
 }
+
+func (u *UserModel) WithTags(tags ...sdk.TagAssociation) *UserModel {
+	return u.WithTagsValue(tagsVariable(tags))
+}
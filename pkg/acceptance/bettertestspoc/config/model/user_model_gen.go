@@ -61,6 +61,9 @@ type UserModel struct {
 	NoorderSequenceAsDefault                 tfconfig.Variable `json:"noorder_sequence_as_default,omitempty"`
 	OdbcTreatDecimalAsInt                    tfconfig.Variable `json:"odbc_treat_decimal_as_int,omitempty"`
 	Password                                 tfconfig.Variable `json:"password,omitempty"`
+	PasswordWo                               tfconfig.Variable `json:"password_wo,omitempty"`
+	PasswordWoHash                           tfconfig.Variable `json:"password_wo_hash,omitempty"`
+	PasswordWoVersion                        tfconfig.Variable `json:"password_wo_version,omitempty"`
 	PreventUnloadToInternalStages            tfconfig.Variable `json:"prevent_unload_to_internal_stages,omitempty"`
 	QueryTag                                 tfconfig.Variable `json:"query_tag,omitempty"`
 	QuotedIdentifiersIgnoreCase              tfconfig.Variable `json:"quoted_identifiers_ignore_case,omitempty"`
@@ -73,6 +76,7 @@ type UserModel struct {
 	StatementQueuedTimeoutInSeconds          tfconfig.Variable `json:"statement_queued_timeout_in_seconds,omitempty"`
 	StatementTimeoutInSeconds                tfconfig.Variable `json:"statement_timeout_in_seconds,omitempty"`
 	StrictJsonOutput                         tfconfig.Variable `json:"strict_json_output,omitempty"`
+	Tags                                     tfconfig.Variable `json:"tags,omitempty"`
 	TimeInputFormat                          tfconfig.Variable `json:"time_input_format,omitempty"`
 	TimeOutputFormat                         tfconfig.Variable `json:"time_output_format,omitempty"`
 	TimestampDayIsAlways24h                  tfconfig.Variable `json:"timestamp_day_is_always_24h,omitempty"`
@@ -398,6 +402,21 @@ func (u *UserModel) WithPassword(password string) *UserModel {
 	return u
 }
 
+func (u *UserModel) WithPasswordWo(passwordWo string) *UserModel {
+	u.PasswordWo = tfconfig.StringVariable(passwordWo)
+	return u
+}
+
+func (u *UserModel) WithPasswordWoHash(passwordWoHash string) *UserModel {
+	u.PasswordWoHash = tfconfig.StringVariable(passwordWoHash)
+	return u
+}
+
+func (u *UserModel) WithPasswordWoVersion(passwordWoVersion int) *UserModel {
+	u.PasswordWoVersion = tfconfig.IntegerVariable(passwordWoVersion)
+	return u
+}
+
 func (u *UserModel) WithPreventUnloadToInternalStages(preventUnloadToInternalStages bool) *UserModel {
 	u.PreventUnloadToInternalStages = tfconfig.BoolVariable(preventUnloadToInternalStages)
 	return u
@@ -458,6 +477,8 @@ func (u *UserModel) WithStrictJsonOutput(strictJsonOutput bool) *UserModel {
 	return u
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 func (u *UserModel) WithTimeInputFormat(timeInputFormat string) *UserModel {
 	u.TimeInputFormat = tfconfig.StringVariable(timeInputFormat)
 	return u
@@ -807,6 +828,21 @@ func (u *UserModel) WithPasswordValue(value tfconfig.Variable) *UserModel {
 	return u
 }
 
+func (u *UserModel) WithPasswordWoValue(value tfconfig.Variable) *UserModel {
+	u.PasswordWo = value
+	return u
+}
+
+func (u *UserModel) WithPasswordWoHashValue(value tfconfig.Variable) *UserModel {
+	u.PasswordWoHash = value
+	return u
+}
+
+func (u *UserModel) WithPasswordWoVersionValue(value tfconfig.Variable) *UserModel {
+	u.PasswordWoVersion = value
+	return u
+}
+
 func (u *UserModel) WithPreventUnloadToInternalStagesValue(value tfconfig.Variable) *UserModel {
 	u.PreventUnloadToInternalStages = value
 	return u
@@ -867,6 +903,11 @@ func (u *UserModel) WithStrictJsonOutputValue(value tfconfig.Variable) *UserMode
 	return u
 }
 
+func (u *UserModel) WithTagsValue(value tfconfig.Variable) *UserModel {
+	u.Tags = value
+	return u
+}
+
 func (u *UserModel) WithTimeInputFormatValue(value tfconfig.Variable) *UserModel {
 	u.TimeInputFormat = value
 	return u
@@ -19,6 +19,7 @@ type UserProgrammaticAccessTokenModel struct {
 	Keeper                               tfconfig.Variable `json:"keeper,omitempty"`
 	MinsToBypassNetworkPolicyRequirement tfconfig.Variable `json:"mins_to_bypass_network_policy_requirement,omitempty"`
 	RoleRestriction                      tfconfig.Variable `json:"role_restriction,omitempty"`
+	RotateBeforeExpiryDays               tfconfig.Variable `json:"rotate_before_expiry_days,omitempty"`
 	RotatedTokenName                     tfconfig.Variable `json:"rotated_token_name,omitempty"`
 	Token                                tfconfig.Variable `json:"token,omitempty"`
 	User                                 tfconfig.Variable `json:"user,omitempty"`
@@ -122,6 +123,11 @@ func (u *UserProgrammaticAccessTokenModel) WithRoleRestriction(roleRestriction s
 	return u
 }
 
+func (u *UserProgrammaticAccessTokenModel) WithRotateBeforeExpiryDays(rotateBeforeExpiryDays int) *UserProgrammaticAccessTokenModel {
+	u.RotateBeforeExpiryDays = tfconfig.IntegerVariable(rotateBeforeExpiryDays)
+	return u
+}
+
 func (u *UserProgrammaticAccessTokenModel) WithRotatedTokenName(rotatedTokenName string) *UserProgrammaticAccessTokenModel {
 	u.RotatedTokenName = tfconfig.StringVariable(rotatedTokenName)
 	return u
@@ -181,6 +187,11 @@ func (u *UserProgrammaticAccessTokenModel) WithRoleRestrictionValue(value tfconf
 	return u
 }
 
+func (u *UserProgrammaticAccessTokenModel) WithRotateBeforeExpiryDaysValue(value tfconfig.Variable) *UserProgrammaticAccessTokenModel {
+	u.RotateBeforeExpiryDays = value
+	return u
+}
+
 func (u *UserProgrammaticAccessTokenModel) WithRotatedTokenNameValue(value tfconfig.Variable) *UserProgrammaticAccessTokenModel {
 	u.RotatedTokenName = value
 	return u
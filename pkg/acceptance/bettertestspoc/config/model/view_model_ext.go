@@ -61,3 +61,7 @@ func (v *ViewModel) WithDataMetricSchedule(cron string) *ViewModel {
 		),
 	)
 }
+
+func (v *ViewModel) WithTags(tags ...sdk.TagAssociation) *ViewModel {
+	return v.WithTagsValue(tagsVariable(tags))
+}
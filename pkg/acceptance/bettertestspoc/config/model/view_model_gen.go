@@ -27,6 +27,7 @@ type ViewModel struct {
 	IsTemporary        tfconfig.Variable `json:"is_temporary,omitempty"`
 	RowAccessPolicy    tfconfig.Variable `json:"row_access_policy,omitempty"`
 	Statement          tfconfig.Variable `json:"statement,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -160,6 +161,8 @@ func (v *ViewModel) WithStatement(statement string) *ViewModel {
 	return v
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -243,3 +246,8 @@ func (v *ViewModel) WithStatementValue(value tfconfig.Variable) *ViewModel {
 	v.Statement = value
 	return v
 }
+
+func (v *ViewModel) WithTagsValue(value tfconfig.Variable) *ViewModel {
+	v.Tags = value
+	return v
+}
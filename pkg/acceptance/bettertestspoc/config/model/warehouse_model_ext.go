@@ -52,3 +52,7 @@ func (w *WarehouseModel) WithResourceConstraintEnum(resourceConstraint sdk.Wareh
 func (w *WarehouseModel) WithGenerationEnum(generation sdk.WarehouseGeneration) *WarehouseModel {
 	return w.WithGeneration(string(generation))
 }
+
+func (w *WarehouseModel) WithTags(tags ...sdk.TagAssociation) *WarehouseModel {
+	return w.WithTagsValue(tagsVariable(tags))
+}
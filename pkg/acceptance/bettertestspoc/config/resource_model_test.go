@@ -30,6 +30,8 @@ type SomeModel struct {
 	ListField      tfconfig.Variable `json:"list_field,omitempty"`
 	MultilineField tfconfig.Variable `json:"multiline_field,omitempty"`
 
+	Tags tfconfig.Variable `json:"tags,omitempty"`
+
 	*config.ResourceModelMeta
 }
 
@@ -110,6 +112,15 @@ func (m *SomeModel) WithMultilineField(multilineContent string) *SomeModel {
 	return m
 }
 
+func (m *SomeModel) WithTags(tags map[string]string) *SomeModel {
+	variables := make(map[string]tfconfig.Variable)
+	for k, v := range tags {
+		variables[k] = tfconfig.StringVariable(v)
+	}
+	m.Tags = tfconfig.MapVariable(variables)
+	return m
+}
+
 func (m *SomeModel) WithDependsOn(values ...string) *SomeModel {
 	m.SetDependsOn(values...)
 	return m
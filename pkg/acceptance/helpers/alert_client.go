@@ -38,7 +38,12 @@ func (c *AlertClient) CreateAlertWithOptions(t *testing.T, schedule string, cond
 
 	id := c.ids.RandomSchemaObjectIdentifier()
 
-	err := c.client().Create(ctx, id, c.ids.WarehouseId(), schedule, condition, action, opts)
+	if opts == nil {
+		opts = &sdk.CreateAlertOptions{}
+	}
+	opts.Warehouse = sdk.Pointer(c.ids.WarehouseId())
+	opts.Schedule = sdk.String(schedule)
+	err := c.client().Create(ctx, id, condition, action, opts)
 	require.NoError(t, err)
 
 	alert, err := c.client().ShowByID(ctx, id)
@@ -47,6 +52,21 @@ func (c *AlertClient) CreateAlertWithOptions(t *testing.T, schedule string, cond
 	return alert, c.DropAlertFunc(t, id)
 }
 
+func (c *AlertClient) Alter(t *testing.T, id sdk.SchemaObjectIdentifier, opts *sdk.AlterAlertOptions) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, id, opts)
+	require.NoError(t, err)
+}
+
+func (c *AlertClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.Alert, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
 func (c *AlertClient) DropAlertFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
 	t.Helper()
 	ctx := context.Background()
@@ -0,0 +1,103 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type BudgetClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewBudgetClient(context *TestClientContext, idsGenerator *IdsGenerator) *BudgetClient {
+	return &BudgetClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *BudgetClient) client() sdk.Budgets {
+	return c.context.client.Budgets
+}
+
+func (c *BudgetClient) Create(t *testing.T) (*sdk.Budget, func()) {
+	t.Helper()
+	return c.CreateInSchema(t, c.ids.SchemaId())
+}
+
+func (c *BudgetClient) CreateInSchema(t *testing.T, schemaId sdk.DatabaseObjectIdentifier) (*sdk.Budget, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomSchemaObjectIdentifierInSchema(schemaId)
+	err := c.client().Create(ctx, id, nil)
+	require.NoError(t, err)
+
+	budget, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return budget, c.DropFunc(t, id)
+}
+
+func (c *BudgetClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().DropSafely(ctx, id)
+		require.NoError(t, err)
+	}
+}
+
+func (c *BudgetClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.Budget, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *BudgetClient) SetSpendingLimit(t *testing.T, id sdk.SchemaObjectIdentifier, spendingLimit int) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().SetSpendingLimit(ctx, id, spendingLimit)
+	require.NoError(t, err)
+}
+
+func (c *BudgetClient) GetSpendingLimit(t *testing.T, id sdk.SchemaObjectIdentifier) int {
+	t.Helper()
+	ctx := context.Background()
+
+	spendingLimit, err := c.client().GetSpendingLimit(ctx, id)
+	require.NoError(t, err)
+	return spendingLimit
+}
+
+func (c *BudgetClient) AddResource(t *testing.T, id sdk.SchemaObjectIdentifier, resource sdk.BudgetResource) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().AddResource(ctx, id, resource)
+	require.NoError(t, err)
+}
+
+func (c *BudgetClient) GetLinkedResources(t *testing.T, id sdk.SchemaObjectIdentifier) []sdk.BudgetLinkedResource {
+	t.Helper()
+	ctx := context.Background()
+
+	resources, err := c.client().GetLinkedResources(ctx, id)
+	require.NoError(t, err)
+	return resources
+}
+
+func (c *BudgetClient) DeactivateAccountBudget(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().DeactivateAccountBudget(ctx)
+	require.NoError(t, err)
+}
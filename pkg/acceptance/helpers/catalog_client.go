@@ -2,7 +2,6 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -21,32 +20,49 @@ func NewCatalogIntegrationClient(context *TestClientContext, idsGenerator *IdsGe
 	}
 }
 
-func (c *CatalogIntegrationClient) exec(sql string) error {
-	ctx := context.Background()
-	_, err := c.context.client.ExecForTests(ctx, sql)
-	return err
+func (c *CatalogIntegrationClient) client() sdk.CatalogIntegrations {
+	return c.context.client.CatalogIntegrations
 }
 
-// TODO(SNOW-999142): Use SDK implementation for Catalog once it's available
 func (c *CatalogIntegrationClient) Create(t *testing.T) (sdk.AccountObjectIdentifier, func()) {
 	t.Helper()
 	id := c.ids.RandomAccountObjectIdentifier()
-	err := c.exec(fmt.Sprintf(`
-create catalog integration %s
-  catalog_source=object_store
-  table_format=iceberg
-  enabled=true
-`, id.FullyQualifiedName()))
-	require.NoError(t, err)
+	catalogIntegration, cleanup := c.CreateWithRequest(t, sdk.NewCreateCatalogIntegrationRequest(id, sdk.CatalogIntegrationCatalogSourceObjectStore, sdk.CatalogIntegrationTableFormatIceberg, true))
+	return catalogIntegration.ID(), cleanup
+}
+
+func (c *CatalogIntegrationClient) CreateWithRequest(t *testing.T, req *sdk.CreateCatalogIntegrationRequest) (*sdk.CatalogIntegration, func()) {
+	t.Helper()
+	ctx := context.Background()
 
-	return id, c.DropFunc(t, id)
+	err := c.client().Create(ctx, req)
+	require.NoError(t, err)
+	catalogIntegration, err := c.client().ShowByID(ctx, req.GetName())
+	require.NoError(t, err)
+	return catalogIntegration, c.DropFunc(t, req.GetName())
 }
 
 func (c *CatalogIntegrationClient) DropFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
 	t.Helper()
+	ctx := context.Background()
 
 	return func() {
-		err := c.exec(fmt.Sprintf(`drop catalog integration if exists %s`, id.FullyQualifiedName()))
+		err := c.client().Drop(ctx, sdk.NewDropCatalogIntegrationRequest(id).WithIfExists(true))
 		require.NoError(t, err)
 	}
 }
+
+func (c *CatalogIntegrationClient) Show(t *testing.T, id sdk.AccountObjectIdentifier) (*sdk.CatalogIntegration, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *CatalogIntegrationClient) Alter(t *testing.T, req *sdk.AlterCatalogIntegrationRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
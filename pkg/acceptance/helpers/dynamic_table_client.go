@@ -40,7 +40,7 @@ func (c *DynamicTableClient) CreateDynamicTableWithOptions(t *testing.T, id sdk.
 	comment := random.Comment()
 	ctx := context.Background()
 
-	err := c.client().Create(ctx, sdk.NewCreateDynamicTableRequest(id, warehouseId, targetLag, query).WithComment(&comment))
+	err := c.client().Create(ctx, sdk.NewCreateDynamicTableRequest(id, warehouseId, query).WithTargetLag(targetLag).WithComment(&comment))
 	require.NoError(t, err)
 
 	dynamicTable, err := c.client().ShowByID(ctx, id)
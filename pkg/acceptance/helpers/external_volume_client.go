@@ -56,6 +56,29 @@ func (c *ExternalVolumeClient) Create(t *testing.T) (sdk.AccountObjectIdentifier
 	return id, c.DropFunc(t, id)
 }
 
+// CreateWritableOnS3 creates an external volume that can be used by Snowflake-managed iceberg tables.
+func (c *ExternalVolumeClient) CreateWritableOnS3(t *testing.T, storageBaseUrl string, storageAwsRoleArn string) (sdk.AccountObjectIdentifier, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomAccountObjectIdentifier()
+	storageLocations := []sdk.ExternalVolumeStorageLocation{
+		{
+			S3StorageLocationParams: &sdk.S3StorageLocationParams{
+				Name:              "s3_iceberg_storage_location",
+				StorageProvider:   sdk.S3StorageProviderS3,
+				StorageAwsRoleArn: storageAwsRoleArn,
+				StorageBaseUrl:    storageBaseUrl,
+			},
+		},
+	}
+
+	err := c.client().Create(ctx, sdk.NewCreateExternalVolumeRequest(id, storageLocations).WithAllowWrites(true))
+	require.NoError(t, err)
+
+	return id, c.DropFunc(t, id)
+}
+
 func (c *ExternalVolumeClient) Show(t *testing.T, id sdk.AccountObjectIdentifier) (*sdk.ExternalVolume, error) {
 	t.Helper()
 	ctx := context.Background()
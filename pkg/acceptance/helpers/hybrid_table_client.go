@@ -2,7 +2,6 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -21,31 +20,75 @@ func NewHybridTableClient(context *TestClientContext, idsGenerator *IdsGenerator
 	}
 }
 
-func (c *HybridTableClient) exec(sql string) error {
-	ctx := context.Background()
-	_, err := c.context.client.ExecForTests(ctx, sql)
-	return err
+func (c *HybridTableClient) client() sdk.HybridTables {
+	return c.context.client.HybridTables
 }
 
-// TODO(SNOW-999142): Use SDK implementation for Hybrid Table once it's available
 func (c *HybridTableClient) Create(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
 	t.Helper()
 	id := c.ids.RandomSchemaObjectIdentifier()
-	err := c.exec(fmt.Sprintf(`
-create hybrid table %s (
-  id INT AUTOINCREMENT PRIMARY KEY
-)
-`, id.FullyQualifiedName()))
-	require.NoError(t, err)
+	_, cleanup := c.CreateWithRequest(t, sdk.NewCreateHybridTableRequest(id, c.DefaultColumnsConstraintsAndIndexes()))
+	return id, cleanup
+}
+
+// DefaultColumnsConstraintsAndIndexes returns a single autoincremented `ID` column used as the primary key.
+func (c *HybridTableClient) DefaultColumnsConstraintsAndIndexes() sdk.HybridTableColumnsConstraintsAndIndexesRequest {
+	return *sdk.NewHybridTableColumnsConstraintsAndIndexesRequest([]sdk.HybridTableColumnRequest{
+		*sdk.NewHybridTableColumnRequest("ID", sdk.DataTypeNumber).WithAutoincrement(true),
+	}).WithOutOfLineConstraints([]sdk.HybridTableOutOfLineConstraintRequest{
+		*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.HybridTableConstraintTypePrimaryKey, []string{"ID"}),
+	})
+}
+
+func (c *HybridTableClient) CreateWithRequest(t *testing.T, req *sdk.CreateHybridTableRequest) (*sdk.HybridTable, func()) {
+	t.Helper()
+	ctx := context.Background()
 
-	return id, c.DropFunc(t, id)
+	err := c.client().Create(ctx, req)
+	require.NoError(t, err)
+	hybridTable, err := c.client().ShowByID(ctx, req.GetName())
+	require.NoError(t, err)
+	return hybridTable, c.DropFunc(t, req.GetName())
 }
 
 func (c *HybridTableClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
 	t.Helper()
+	ctx := context.Background()
 
 	return func() {
-		err := c.exec(fmt.Sprintf(`drop table if exists %s`, id.FullyQualifiedName()))
+		err := c.client().Drop(ctx, sdk.NewDropHybridTableRequest(id).WithIfExists(true))
 		require.NoError(t, err)
 	}
 }
+
+func (c *HybridTableClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.HybridTable, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *HybridTableClient) Alter(t *testing.T, req *sdk.AlterHybridTableRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
+
+func (c *HybridTableClient) CreateIndex(t *testing.T, req *sdk.CreateIndexHybridTableRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().CreateIndex(ctx, req)
+	require.NoError(t, err)
+}
+
+func (c *HybridTableClient) ShowIndexes(t *testing.T, id sdk.SchemaObjectIdentifier) []sdk.HybridTableIndex {
+	t.Helper()
+	ctx := context.Background()
+
+	indexes, err := c.client().ShowIndexes(ctx, sdk.NewShowIndexesHybridTableRequest().WithIn(id))
+	require.NoError(t, err)
+	return indexes
+}
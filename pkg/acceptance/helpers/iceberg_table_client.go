@@ -0,0 +1,71 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type IcebergTableClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewIcebergTableClient(context *TestClientContext, idsGenerator *IdsGenerator) *IcebergTableClient {
+	return &IcebergTableClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *IcebergTableClient) client() sdk.IcebergTables {
+	return c.context.client.IcebergTables
+}
+
+func (c *IcebergTableClient) Create(t *testing.T, externalVolume sdk.AccountObjectIdentifier) (*sdk.IcebergTable, func()) {
+	t.Helper()
+	columns := []sdk.IcebergTableColumnRequest{*sdk.NewIcebergTableColumnRequest("id", sdk.DataTypeNumber)}
+	return c.CreateWithRequest(t, sdk.NewCreateIcebergTableRequest(c.ids.RandomSchemaObjectIdentifier(), columns).
+		WithExternalVolume(externalVolume).
+		WithCatalog("SNOWFLAKE").
+		WithBaseLocation(c.ids.Alpha()),
+	)
+}
+
+func (c *IcebergTableClient) CreateWithRequest(t *testing.T, req *sdk.CreateIcebergTableRequest) (*sdk.IcebergTable, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, req)
+	require.NoError(t, err)
+	icebergTable, err := c.client().ShowByID(ctx, req.GetName())
+	require.NoError(t, err)
+	return icebergTable, c.DropFunc(t, req.GetName())
+}
+
+func (c *IcebergTableClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropIcebergTableRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *IcebergTableClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.IcebergTable, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *IcebergTableClient) Alter(t *testing.T, req *sdk.AlterIcebergTableRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
@@ -50,6 +50,46 @@ func (c *ListingClient) CreateWithId(t *testing.T, id sdk.AccountObjectIdentifie
 	return listing, c.DropFunc(t, id)
 }
 
+// CreatePublishedWithShareAndTargetAccounts creates a listing attached to the share, published to the given accounts.
+func (c *ListingClient) CreatePublishedWithShareAndTargetAccounts(t *testing.T, shareId sdk.AccountObjectIdentifier, targetAccounts ...sdk.AccountIdentifier) (*sdk.Listing, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomAccountObjectIdentifier()
+	manifest, _ := c.BasicManifestWithTargetAccounts(t, targetAccounts...)
+	err := c.client().Create(ctx, sdk.NewCreateListingRequest(id).
+		WithAs(manifest).
+		WithWith(*sdk.NewListingWithRequest().WithShare(shareId)).
+		WithReview(false).
+		WithPublish(true),
+	)
+	require.NoError(t, err)
+
+	listing, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return listing, c.DropFunc(t, id)
+}
+
+// CreateOrganizationListingWithShare creates a published organization listing attached to the share and discoverable by all accounts in the organization.
+func (c *ListingClient) CreateOrganizationListingWithShare(t *testing.T, shareId sdk.AccountObjectIdentifier) (*sdk.Listing, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomAccountObjectIdentifier()
+	manifest, _ := c.BasicOrganizationManifest(t)
+	err := c.context.client.OrganizationListings.Create(ctx, sdk.NewCreateOrganizationListingRequest(id, manifest).
+		WithWith(*sdk.NewOrganizationListingWithRequest().WithShare(shareId)).
+		WithPublish(true),
+	)
+	require.NoError(t, err)
+
+	listing, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return listing, c.DropFunc(t, id)
+}
+
 func (c *ListingClient) Alter(t *testing.T, req *sdk.AlterListingRequest) {
 	t.Helper()
 	ctx := context.Background()
@@ -117,6 +157,22 @@ func (c *ListingClient) BasicManifestWithUnquotedValuesAndTargetAccountsAndDiffe
 	return c.basicManifestWithUnquotedValuesAndTargetAccount(t, "with_target_accounts_and_different_subtitle_", "different_subtitle", targetAccounts...)
 }
 
+func (c *ListingClient) BasicOrganizationManifest(t *testing.T) (string, string) {
+	t.Helper()
+	title := c.ids.WithTestObjectSuffix("organization_")
+	return fmt.Sprintf(`title: "%s"
+description: "description"
+organization_targets:
+  discovery:
+    - all_internal_accounts: true
+  access:
+    - all_internal_accounts: true
+locations:
+  access_regions:
+    - name: "ALL"
+`, title), title
+}
+
 func (c *ListingClient) basicManifest(t *testing.T, titleSuffix string, subtitle string) (string, string) {
 	t.Helper()
 	title := c.ids.WithTestObjectSuffix(titleSuffix)
@@ -0,0 +1,55 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type ManagedAccountClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewManagedAccountClient(context *TestClientContext, idsGenerator *IdsGenerator) *ManagedAccountClient {
+	return &ManagedAccountClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *ManagedAccountClient) client() sdk.ManagedAccounts {
+	return c.context.client.ManagedAccounts
+}
+
+func (c *ManagedAccountClient) Create(t *testing.T) (*sdk.ManagedAccount, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomAccountObjectIdentifier()
+	err := c.client().Create(ctx, sdk.NewCreateManagedAccountRequest(id, *sdk.NewCreateManagedAccountParamsRequest(random.AdminName(), random.Password())))
+	require.NoError(t, err)
+
+	// the managed account is not visible in SHOW MANAGED ACCOUNTS right after the creation
+	var managedAccount *sdk.ManagedAccount
+	require.Eventually(t, func() bool {
+		managedAccount, err = c.client().ShowByID(ctx, id)
+		return err == nil
+	}, 10*time.Second, 2*time.Second)
+
+	return managedAccount, c.DropFunc(t, id)
+}
+
+func (c *ManagedAccountClient) DropFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropManagedAccountRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,69 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type OrganizationProfileClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewOrganizationProfileClient(context *TestClientContext, idsGenerator *IdsGenerator) *OrganizationProfileClient {
+	return &OrganizationProfileClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *OrganizationProfileClient) client() sdk.OrganizationProfiles {
+	return c.context.client.OrganizationProfiles
+}
+
+func (c *OrganizationProfileClient) Create(t *testing.T) (*sdk.OrganizationProfile, func()) {
+	t.Helper()
+	id := c.ids.RandomAccountObjectIdentifier()
+	manifest, _ := c.BasicManifest(t)
+	return c.CreateWithRequest(t, *sdk.NewCreateOrganizationProfileRequest(id, manifest).WithPublish(true))
+}
+
+func (c *OrganizationProfileClient) CreateWithRequest(t *testing.T, req sdk.CreateOrganizationProfileRequest) (*sdk.OrganizationProfile, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, &req)
+	require.NoError(t, err)
+
+	organizationProfile, err := c.client().ShowByID(ctx, req.GetName())
+	require.NoError(t, err)
+
+	return organizationProfile, c.DropFunc(t, req.GetName())
+}
+
+func (c *OrganizationProfileClient) DropFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		require.NoError(t, c.client().DropSafely(ctx, id))
+	}
+}
+
+func (c *OrganizationProfileClient) Show(t *testing.T, id sdk.AccountObjectIdentifier) (*sdk.OrganizationProfile, error) {
+	t.Helper()
+	return c.client().ShowByID(context.Background(), id)
+}
+
+func (c *OrganizationProfileClient) BasicManifest(t *testing.T) (string, string) {
+	t.Helper()
+	title := c.ids.WithTestObjectSuffix("profile_")
+	return fmt.Sprintf(`title: "%s"
+description: "description"
+contact: "contact@example.com"
+`, title), title
+}
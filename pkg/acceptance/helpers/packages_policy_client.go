@@ -2,7 +2,6 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -21,17 +20,57 @@ func NewPackagesPolicyClient(context *TestClientContext, idsGenerator *IdsGenera
 	}
 }
 
+func (c *PackagesPolicyClient) client() sdk.PackagesPolicies {
+	return c.context.client.PackagesPolicies
+}
+
 func (c *PackagesPolicyClient) Create(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
 	t.Helper()
+	packagesPolicy, cleanup := c.CreateWithRequest(t, *sdk.NewCreatePackagesPolicyRequest(c.ids.RandomSchemaObjectIdentifier()))
+	return packagesPolicy.ID(), cleanup
+}
+
+func (c *PackagesPolicyClient) CreateWithRequest(t *testing.T, req sdk.CreatePackagesPolicyRequest) (*sdk.PackagesPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
 
-	// TODO(SNOW-1348357): Replace raw SQL with SDK
+	err := c.client().Create(ctx, &req)
+	require.NoError(t, err)
 
-	id := c.ids.RandomSchemaObjectIdentifier()
-	_, err := c.context.client.ExecForTests(context.Background(), fmt.Sprintf("CREATE PACKAGES POLICY %s LANGUAGE PYTHON", id.FullyQualifiedName()))
+	packagesPolicy, err := c.client().ShowByID(ctx, req.GetName())
 	require.NoError(t, err)
 
-	return id, func() {
-		_, err = c.context.client.ExecForTests(context.Background(), fmt.Sprintf("DROP PACKAGES POLICY IF EXISTS %s", id.FullyQualifiedName()))
+	return packagesPolicy, c.DropFunc(t, req.GetName())
+}
+
+func (c *PackagesPolicyClient) Alter(t *testing.T, req sdk.AlterPackagesPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, &req)
+	require.NoError(t, err)
+}
+
+func (c *PackagesPolicyClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropPackagesPolicyRequest(id).WithIfExists(true))
 		require.NoError(t, err)
 	}
 }
+
+func (c *PackagesPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.PackagesPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *PackagesPolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.PackagesPolicyDescription, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Describe(ctx, id)
+}
@@ -63,3 +63,18 @@ func (c *PasswordPolicyClient) DropPasswordPolicyFunc(t *testing.T, id sdk.Schem
 		require.NoError(t, err)
 	}
 }
+
+func (c *PasswordPolicyClient) Alter(t *testing.T, id sdk.SchemaObjectIdentifier, opts *sdk.AlterPasswordPolicyOptions) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, id, opts)
+	require.NoError(t, err)
+}
+
+func (c *PasswordPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.PasswordPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
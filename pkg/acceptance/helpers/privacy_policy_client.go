@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type PrivacyPolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewPrivacyPolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *PrivacyPolicyClient {
+	return &PrivacyPolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *PrivacyPolicyClient) client() sdk.PrivacyPolicies {
+	return c.context.client.PrivacyPolicies
+}
+
+func (c *PrivacyPolicyClient) Create(t *testing.T) (*sdk.PrivacyPolicy, func()) {
+	t.Helper()
+	return c.CreateWithRequest(t, *sdk.NewCreatePrivacyPolicyRequest(c.ids.RandomSchemaObjectIdentifier(), "NO_PRIVACY_POLICY()"))
+}
+
+func (c *PrivacyPolicyClient) CreateWithRequest(t *testing.T, req sdk.CreatePrivacyPolicyRequest) (*sdk.PrivacyPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, &req)
+	require.NoError(t, err)
+
+	privacyPolicy, err := c.client().ShowByID(ctx, req.GetName())
+	require.NoError(t, err)
+
+	return privacyPolicy, c.DropFunc(t, req.GetName())
+}
+
+func (c *PrivacyPolicyClient) Alter(t *testing.T, req sdk.AlterPrivacyPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, &req)
+	require.NoError(t, err)
+}
+
+func (c *PrivacyPolicyClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropPrivacyPolicyRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *PrivacyPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.PrivacyPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *PrivacyPolicyClient) AddToTable(t *testing.T, tableId sdk.SchemaObjectIdentifier, policyId sdk.SchemaObjectIdentifier) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.context.client.Tables.Alter(ctx, sdk.NewAlterTableRequest(tableId).WithAddPrivacyPolicy(sdk.NewTableAddPrivacyPolicyRequest(policyId)))
+	require.NoError(t, err)
+}
+
+func (c *PrivacyPolicyClient) DropFromTable(t *testing.T, tableId sdk.SchemaObjectIdentifier, policyId sdk.SchemaObjectIdentifier) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.context.client.Tables.Alter(ctx, sdk.NewAlterTableRequest(tableId).WithDropPrivacyPolicy(sdk.NewTableDropPrivacyPolicyRequest(policyId)))
+	require.NoError(t, err)
+}
+
+func (c *PrivacyPolicyClient) UnsetPrivacyDomain(t *testing.T, tableId sdk.SchemaObjectIdentifier, columnName string) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.context.client.Tables.Alter(ctx, sdk.NewAlterTableRequest(tableId).WithColumnAction(sdk.NewTableColumnActionRequest().WithUnsetPrivacyDomain(sdk.NewTableColumnAlterUnsetPrivacyDomainActionRequest(columnName))))
+	require.NoError(t, err)
+}
@@ -0,0 +1,68 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type SnapshotPolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewSnapshotPolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *SnapshotPolicyClient {
+	return &SnapshotPolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *SnapshotPolicyClient) client() sdk.SnapshotPolicies {
+	return c.context.client.SnapshotPolicies
+}
+
+func (c *SnapshotPolicyClient) Create(t *testing.T) (*sdk.SnapshotPolicy, func()) {
+	t.Helper()
+	return c.CreateWithRequest(t, *sdk.NewCreateSnapshotPolicyRequest(c.ids.RandomSchemaObjectIdentifier()).WithExpireAfterDays(1))
+}
+
+func (c *SnapshotPolicyClient) CreateWithRequest(t *testing.T, req sdk.CreateSnapshotPolicyRequest) (*sdk.SnapshotPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, &req)
+	require.NoError(t, err)
+
+	snapshotPolicy, err := c.client().ShowByID(ctx, req.GetName())
+	require.NoError(t, err)
+
+	return snapshotPolicy, c.DropFunc(t, req.GetName())
+}
+
+func (c *SnapshotPolicyClient) Alter(t *testing.T, req sdk.AlterSnapshotPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, &req)
+	require.NoError(t, err)
+}
+
+func (c *SnapshotPolicyClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropSnapshotPolicyRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *SnapshotPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.SnapshotPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
@@ -0,0 +1,83 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type SnapshotSetClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewSnapshotSetClient(context *TestClientContext, idsGenerator *IdsGenerator) *SnapshotSetClient {
+	return &SnapshotSetClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *SnapshotSetClient) client() sdk.SnapshotSets {
+	return c.context.client.SnapshotSets
+}
+
+func (c *SnapshotSetClient) CreateForTable(t *testing.T, tableId sdk.SchemaObjectIdentifier) (*sdk.SnapshotSet, func()) {
+	t.Helper()
+	return c.CreateWithRequest(t, *sdk.NewCreateSnapshotSetRequest(c.ids.RandomSchemaObjectIdentifier(), *sdk.NewSnapshotSetTargetRequest().WithTable(tableId)))
+}
+
+func (c *SnapshotSetClient) CreateWithRequest(t *testing.T, req sdk.CreateSnapshotSetRequest) (*sdk.SnapshotSet, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, &req)
+	require.NoError(t, err)
+
+	snapshotSet, err := c.client().ShowByID(ctx, req.GetName())
+	require.NoError(t, err)
+
+	return snapshotSet, c.DropFunc(t, req.GetName())
+}
+
+func (c *SnapshotSetClient) Alter(t *testing.T, req sdk.AlterSnapshotSetRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, &req)
+	require.NoError(t, err)
+}
+
+func (c *SnapshotSetClient) AddSnapshot(t *testing.T, id sdk.SchemaObjectIdentifier) {
+	t.Helper()
+	c.Alter(t, *sdk.NewAlterSnapshotSetRequest(id).WithAddSnapshot(true))
+}
+
+func (c *SnapshotSetClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropSnapshotSetRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *SnapshotSetClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.SnapshotSet, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *SnapshotSetClient) ShowSnapshots(t *testing.T, id sdk.SchemaObjectIdentifier) []sdk.Snapshot {
+	t.Helper()
+	ctx := context.Background()
+
+	snapshots, err := c.client().ShowSnapshots(ctx, sdk.NewShowSnapshotsSnapshotSetRequest().WithIn(id))
+	require.NoError(t, err)
+
+	return snapshots
+}
@@ -222,3 +222,12 @@ func (c *StageClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) ([]s
 
 	return c.client().Describe(ctx, id)
 }
+
+func (c *StageClient) ListFiles(t *testing.T, id sdk.SchemaObjectIdentifier, path string) []sdk.StageFile {
+	t.Helper()
+	ctx := context.Background()
+
+	files, err := c.context.client.StageFiles.List(ctx, sdk.NewStageLocation(id, path), nil)
+	require.NoError(t, err)
+	return files
+}
@@ -0,0 +1,94 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type StorageLifecyclePolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewStorageLifecyclePolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *StorageLifecyclePolicyClient {
+	return &StorageLifecyclePolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *StorageLifecyclePolicyClient) client() sdk.StorageLifecyclePolicies {
+	return c.context.client.StorageLifecyclePolicies
+}
+
+func (c *StorageLifecyclePolicyClient) Create(t *testing.T) (*sdk.StorageLifecyclePolicy, func()) {
+	t.Helper()
+	args := []sdk.StorageLifecyclePolicyArgRequest{*sdk.NewStorageLifecyclePolicyArgRequest("CREATED_ON", testdatatypes.DataTypeTimestampNTZ)}
+	body := "CREATED_ON < DATEADD('day', -90, CURRENT_TIMESTAMP())"
+	return c.CreateWithRequest(t, *sdk.NewCreateStorageLifecyclePolicyRequest(c.ids.RandomSchemaObjectIdentifier(), args, body))
+}
+
+func (c *StorageLifecyclePolicyClient) CreateWithRequest(t *testing.T, req sdk.CreateStorageLifecyclePolicyRequest) (*sdk.StorageLifecyclePolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, &req)
+	require.NoError(t, err)
+
+	storageLifecyclePolicy, err := c.client().ShowByID(ctx, req.GetName())
+	require.NoError(t, err)
+
+	return storageLifecyclePolicy, c.DropFunc(t, req.GetName())
+}
+
+func (c *StorageLifecyclePolicyClient) Alter(t *testing.T, req sdk.AlterStorageLifecyclePolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, &req)
+	require.NoError(t, err)
+}
+
+func (c *StorageLifecyclePolicyClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropStorageLifecyclePolicyRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *StorageLifecyclePolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.StorageLifecyclePolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *StorageLifecyclePolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.StorageLifecyclePolicyDescription, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Describe(ctx, id)
+}
+
+func (c *StorageLifecyclePolicyClient) AddToTable(t *testing.T, tableId sdk.SchemaObjectIdentifier, policyId sdk.SchemaObjectIdentifier, on []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.context.client.Tables.Alter(ctx, sdk.NewAlterTableRequest(tableId).WithAddStorageLifecyclePolicy(sdk.NewTableAddStorageLifecyclePolicyRequest(policyId, on)))
+	require.NoError(t, err)
+}
+
+func (c *StorageLifecyclePolicyClient) DropFromTable(t *testing.T, tableId sdk.SchemaObjectIdentifier) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.context.client.Tables.Alter(ctx, sdk.NewAlterTableRequest(tableId).WithDropStorageLifecyclePolicy(sdk.Bool(true)))
+	require.NoError(t, err)
+}
@@ -22,6 +22,7 @@ type TestClient struct {
 	ApplicationPackage           *ApplicationPackageClient
 	AuthenticationPolicy         *AuthenticationPolicyClient
 	BcrBundles                   *BcrBundlesClient
+	Budget                       *BudgetClient
 	ComputePool                  *ComputePoolClient
 	Connection                   *ConnectionClient
 	Context                      *ContextClient
@@ -44,9 +45,11 @@ type TestClient struct {
 	GitRepository                *GitRepositoryClient
 	Grant                        *GrantClient
 	HybridTable                  *HybridTableClient
+	IcebergTable                 *IcebergTableClient
 	ImageRepository              *ImageRepositoryClient
 	InformationSchema            *InformationSchemaClient
 	Listing                      *ListingClient
+	ManagedAccount               *ManagedAccountClient
 	MaskingPolicy                *MaskingPolicyClient
 	MaterializedView             *MaterializedViewClient
 	NetworkPolicy                *NetworkPolicyClient
@@ -54,10 +57,12 @@ type TestClient struct {
 	Notebook                     *NotebookClient
 	NotificationIntegration      *NotificationIntegrationClient
 	OrganizationAccount          *OrganizationAccountClient
+	OrganizationProfile          *OrganizationProfileClient
 	PackagesPolicy               *PackagesPolicyClient
 	Parameter                    *ParameterClient
 	PasswordPolicy               *PasswordPolicyClient
 	Pipe                         *PipeClient
+	PrivacyPolicy                *PrivacyPolicyClient
 	Procedure                    *ProcedureClient
 	ProjectionPolicy             *ProjectionPolicyClient
 	PolicyReferences             *PolicyReferencesClient
@@ -73,8 +78,11 @@ type TestClient struct {
 	Share                        *ShareClient
 	SemanticView                 *SemanticViewClient
 	Snapshot                     *SnapshotClient
+	SnapshotPolicy               *SnapshotPolicyClient
+	SnapshotSet                  *SnapshotSetClient
 	Stage                        *StageClient
 	StorageIntegration           *StorageIntegrationClient
+	StorageLifecyclePolicy       *StorageLifecyclePolicyClient
 	Stream                       *StreamClient
 	Streamlit                    *StreamlitClient
 	Table                        *TableClient
@@ -108,6 +116,7 @@ func NewTestClient(c *sdk.Client, database string, schema string, warehouse stri
 		ApplicationPackage:           NewApplicationPackageClient(context, idsGenerator),
 		AuthenticationPolicy:         NewAuthenticationPolicyClient(context, idsGenerator),
 		BcrBundles:                   NewBcrBundlesClient(context),
+		Budget:                       NewBudgetClient(context, idsGenerator),
 		ComputePool:                  NewComputePoolClient(context, idsGenerator),
 		Connection:                   NewConnectionClient(context, idsGenerator),
 		Context:                      NewContextClient(context),
@@ -130,9 +139,11 @@ func NewTestClient(c *sdk.Client, database string, schema string, warehouse stri
 		GitRepository:                NewGitRepositoryClient(context, idsGenerator),
 		Grant:                        NewGrantClient(context, idsGenerator),
 		HybridTable:                  NewHybridTableClient(context, idsGenerator),
+		IcebergTable:                 NewIcebergTableClient(context, idsGenerator),
 		ImageRepository:              NewImageRepositoryClient(context, idsGenerator),
 		InformationSchema:            NewInformationSchemaClient(context, idsGenerator),
 		Listing:                      NewListingClient(context, idsGenerator),
+		ManagedAccount:               NewManagedAccountClient(context, idsGenerator),
 		MaskingPolicy:                NewMaskingPolicyClient(context, idsGenerator),
 		MaterializedView:             NewMaterializedViewClient(context, idsGenerator),
 		NetworkPolicy:                NewNetworkPolicyClient(context, idsGenerator),
@@ -140,10 +151,12 @@ func NewTestClient(c *sdk.Client, database string, schema string, warehouse stri
 		Notebook:                     NewNotebookClient(context, idsGenerator),
 		NotificationIntegration:      NewNotificationIntegrationClient(context, idsGenerator),
 		OrganizationAccount:          NewOrganizationAccountClient(context, idsGenerator),
+		OrganizationProfile:          NewOrganizationProfileClient(context, idsGenerator),
 		PackagesPolicy:               NewPackagesPolicyClient(context, idsGenerator),
 		Parameter:                    NewParameterClient(context),
 		PasswordPolicy:               NewPasswordPolicyClient(context, idsGenerator),
 		Pipe:                         NewPipeClient(context, idsGenerator),
+		PrivacyPolicy:                NewPrivacyPolicyClient(context, idsGenerator),
 		Procedure:                    NewProcedureClient(context, idsGenerator),
 		ProjectionPolicy:             NewProjectionPolicyClient(context, idsGenerator),
 		PolicyReferences:             NewPolicyReferencesClient(context),
@@ -155,12 +168,15 @@ func NewTestClient(c *sdk.Client, database string, schema string, warehouse stri
 		SecurityIntegration:          NewSecurityIntegrationClient(context, idsGenerator),
 		SemanticView:                 NewSemanticViewClient(context, idsGenerator),
 		Snapshot:                     NewSnapshotClient(context, idsGenerator),
+		SnapshotPolicy:               NewSnapshotPolicyClient(context, idsGenerator),
+		SnapshotSet:                  NewSnapshotSetClient(context, idsGenerator),
 		Service:                      NewServiceClient(context, idsGenerator),
 		Sequence:                     NewSequenceClient(context, idsGenerator),
 		SessionPolicy:                NewSessionPolicyClient(context, idsGenerator),
 		Share:                        NewShareClient(context, idsGenerator),
 		Stage:                        NewStageClient(context, idsGenerator),
 		StorageIntegration:           NewStorageIntegrationClient(context, idsGenerator),
+		StorageLifecyclePolicy:       NewStorageLifecyclePolicyClient(context, idsGenerator),
 		Stream:                       NewStreamClient(context, idsGenerator),
 		Streamlit:                    NewStreamlitClient(context, idsGenerator),
 		Table:                        NewTableClient(context, idsGenerator),
@@ -163,6 +163,36 @@ func (c *UserClient) UnsetType(t *testing.T, id sdk.AccountObjectIdentifier) {
 	require.NoError(t, err)
 }
 
+func (c *UserClient) SetWorkloadIdentity(t *testing.T, id sdk.AccountObjectIdentifier, workloadIdentity sdk.UserWorkloadIdentity) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, id, &sdk.AlterUserOptions{
+		Set: &sdk.UserSet{
+			ObjectProperties: &sdk.UserAlterObjectProperties{
+				UserObjectProperties: sdk.UserObjectProperties{
+					WorkloadIdentity: &workloadIdentity,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func (c *UserClient) UnsetWorkloadIdentity(t *testing.T, id sdk.AccountObjectIdentifier) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, id, &sdk.AlterUserOptions{
+		Unset: &sdk.UserUnset{
+			ObjectProperties: &sdk.UserObjectPropertiesUnset{
+				WorkloadIdentity: sdk.Bool(true),
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
 func (c *UserClient) SetLoginName(t *testing.T, id sdk.AccountObjectIdentifier, newLoginName string) {
 	t.Helper()
 	ctx := context.Background()
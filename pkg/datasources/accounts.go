@@ -34,6 +34,14 @@ var accountsSchema = map[string]*schema.Schema{
 						Schema: schemas.ShowAccountSchema,
 					},
 				},
+				"connection_details": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the details needed to connect to the account, e.g. to configure a provider for it.",
+					Elem: &schema.Resource{
+						Schema: schemas.AccountConnectionSchema,
+					},
+				},
 				// TODO [SNOW-2298247]: Add parameters
 			},
 		},
@@ -68,6 +76,7 @@ func ReadAccounts(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		account := account
 		flattenedAccounts[i] = map[string]any{
 			resources.ShowOutputAttributeName: []map[string]any{schemas.AccountToSchema(&account)},
+			"connection_details":              []map[string]any{schemas.AccountToConnectionSchema(&account)},
 		}
 	}
 
@@ -0,0 +1,99 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var eventTablesSchema = map[string]*schema.Schema{
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESC EVENT TABLE for each event table returned by SHOW EVENT TABLES. The output of describe is saved to the description field. By default this value is set to true.",
+	},
+	"like":        likeSchema,
+	"in":          inSchema,
+	"starts_with": startsWithSchema,
+	"limit":       limitFromSchema,
+	"event_tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all event tables details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW EVENT TABLES.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowEventTableSchema,
+					},
+				},
+				resources.DescribeOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of DESCRIBE EVENT TABLE.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowEventTableDetailsSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func EventTables() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.EventTablesDatasource), TrackingReadWrapper(datasources.EventTables, ReadEventTables)),
+		Schema:      eventTablesSchema,
+		Description: "Data source used to get details of filtered event tables. Filtering is aligned with the current possibilities for [SHOW EVENT TABLES](https://docs.snowflake.com/en/sql-reference/sql/show-event-tables) query." +
+			" The results of SHOW and DESCRIBE are encapsulated in one output collection `event_tables`.",
+	}
+}
+
+func ReadEventTables(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowEventTableRequest{}
+
+	handleLike(d, &req.Like)
+	if err := handleIn(d, &req.In); err != nil {
+		return diag.FromErr(err)
+	}
+	handleStartsWith(d, &req.StartsWith)
+	handleLimitFrom(d, &req.Limit)
+
+	eventTables, err := client.EventTables.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("event_tables_read")
+
+	flattenedEventTables := make([]map[string]any, len(eventTables))
+	for i, eventTable := range eventTables {
+		var eventTableDescriptions []map[string]any
+		if d.Get("with_describe").(bool) {
+			describeResult, err := client.EventTables.Describe(ctx, eventTable.ID())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			eventTableDescriptions = []map[string]any{schemas.EventTableDetailsToSchema(describeResult)}
+		}
+		flattenedEventTables[i] = map[string]any{
+			resources.ShowOutputAttributeName:     []map[string]any{schemas.EventTableToSchema(&eventTable)},
+			resources.DescribeOutputAttributeName: eventTableDescriptions,
+		}
+	}
+	if err := d.Set("event_tables", flattenedEventTables); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
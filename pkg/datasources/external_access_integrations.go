@@ -0,0 +1,91 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalAccessIntegrationsSchema = map[string]*schema.Schema{
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESC EXTERNAL ACCESS INTEGRATION for each external access integration returned by SHOW EXTERNAL ACCESS INTEGRATIONS. The output of describe is saved to the description field. By default this value is set to true.",
+	},
+	"like": likeSchema,
+	"external_access_integrations": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all external access integrations details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW EXTERNAL ACCESS INTEGRATIONS.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowExternalAccessIntegrationSchema,
+					},
+				},
+				resources.DescribeOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of DESCRIBE EXTERNAL ACCESS INTEGRATION.",
+					Elem: &schema.Resource{
+						Schema: schemas.DescribeExternalAccessIntegrationSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func ExternalAccessIntegrations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.ExternalAccessIntegrationsDatasource), TrackingReadWrapper(datasources.ExternalAccessIntegrations, ReadExternalAccessIntegrations)),
+		Schema:      externalAccessIntegrationsSchema,
+		Description: "Data source used to get details of filtered external access integrations. Filtering is aligned with the current possibilities for [SHOW EXTERNAL ACCESS INTEGRATIONS](https://docs.snowflake.com/en/sql-reference/sql/show-external-access-integrations) query." +
+			" The results of SHOW and DESCRIBE are encapsulated in one output collection `external_access_integrations`.",
+	}
+}
+
+func ReadExternalAccessIntegrations(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowExternalAccessIntegrationRequest{}
+
+	handleLike(d, &req.Like)
+
+	externalAccessIntegrations, err := client.ExternalAccessIntegrations.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("external_access_integrations_read")
+
+	flattenedExternalAccessIntegrations := make([]map[string]any, len(externalAccessIntegrations))
+	for i, externalAccessIntegration := range externalAccessIntegrations {
+		var externalAccessIntegrationDescriptions []map[string]any
+		if d.Get("with_describe").(bool) {
+			describeResult, err := client.ExternalAccessIntegrations.Describe(ctx, externalAccessIntegration.ID())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			externalAccessIntegrationDescriptions = []map[string]any{schemas.DescribeExternalAccessIntegrationToSchema(describeResult)}
+		}
+		flattenedExternalAccessIntegrations[i] = map[string]any{
+			resources.ShowOutputAttributeName:     []map[string]any{schemas.ExternalAccessIntegrationToSchema(&externalAccessIntegration)},
+			resources.DescribeOutputAttributeName: externalAccessIntegrationDescriptions,
+		}
+	}
+	if err := d.Set("external_access_integrations", flattenedExternalAccessIntegrations); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var icebergTablesSchema = map[string]*schema.Schema{
+	"like":        likeSchema,
+	"in":          inSchema,
+	"starts_with": startsWithSchema,
+	"limit":       limitFromSchema,
+	"iceberg_tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all iceberg tables details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW ICEBERG TABLES.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowIcebergTableSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func IcebergTables() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.IcebergTablesDatasource), TrackingReadWrapper(datasources.IcebergTables, ReadIcebergTables)),
+		Schema:      icebergTablesSchema,
+		Description: "Data source used to get details of filtered iceberg tables (both Snowflake-managed and externally managed). Filtering is aligned with the current possibilities for [SHOW ICEBERG TABLES](https://docs.snowflake.com/en/sql-reference/sql/show-iceberg-tables) query. The results of SHOW are encapsulated in one output collection `iceberg_tables`.",
+	}
+}
+
+func ReadIcebergTables(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowIcebergTableRequest{}
+
+	handleLike(d, &req.Like)
+	err := handleIn(d, &req.In)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	handleStartsWith(d, &req.StartsWith)
+	handleLimitFrom(d, &req.Limit)
+
+	icebergTables, err := client.IcebergTables.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("iceberg_tables_read")
+
+	flattenedIcebergTables := make([]map[string]any, len(icebergTables))
+	for i, icebergTable := range icebergTables {
+		icebergTable := icebergTable
+		flattenedIcebergTables[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.IcebergTableToSchema(&icebergTable)},
+		}
+	}
+	if err := d.Set("iceberg_tables", flattenedIcebergTables); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
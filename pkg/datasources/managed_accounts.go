@@ -0,0 +1,68 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var managedAccountsSchema = map[string]*schema.Schema{
+	"like": likeSchema,
+	"managed_accounts": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all managed accounts details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW MANAGED ACCOUNTS.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowManagedAccountSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func ManagedAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.ManagedAccountsDatasource), TrackingReadWrapper(datasources.ManagedAccounts, ReadManagedAccounts)),
+		Schema:      managedAccountsSchema,
+		Description: "Data source used to get details of filtered managed accounts. Filtering is aligned with the current possibilities for [SHOW MANAGED ACCOUNTS](https://docs.snowflake.com/en/sql-reference/sql/show-managed-accounts) query." +
+			" The results of SHOW are encapsulated in one output collection `managed_accounts`.",
+	}
+}
+
+func ReadManagedAccounts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowManagedAccountRequest{}
+
+	handleLike(d, &req.Like)
+
+	managedAccounts, err := client.ManagedAccounts.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("managed_accounts_read")
+
+	flattenedManagedAccounts := make([]map[string]any, len(managedAccounts))
+	for i, managedAccount := range managedAccounts {
+		flattenedManagedAccounts[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.ManagedAccountToSchema(&managedAccount)},
+		}
+	}
+	if err := d.Set("managed_accounts", flattenedManagedAccounts); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var notificationIntegrationsSchema = map[string]*schema.Schema{
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESC NOTIFICATION INTEGRATION for each notification integration returned by SHOW NOTIFICATION INTEGRATIONS. The output of describe is saved to the description field. By default this value is set to true.",
+	},
+	"like": likeSchema,
+	"notification_integrations": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all notification integrations details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW NOTIFICATION INTEGRATIONS.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowNotificationIntegrationSchema,
+					},
+				},
+				resources.DescribeOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of DESCRIBE NOTIFICATION INTEGRATION.",
+					Elem: &schema.Resource{
+						Schema: schemas.DescribeNotificationIntegrationSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func NotificationIntegrations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.NotificationIntegrationsDatasource), TrackingReadWrapper(datasources.NotificationIntegrations, ReadNotificationIntegrations)),
+		Schema:      notificationIntegrationsSchema,
+		Description: "Data source used to get details of filtered notification integrations. Filtering is aligned with the current possibilities for [SHOW NOTIFICATION INTEGRATIONS](https://docs.snowflake.com/en/sql-reference/sql/show-integrations) query." +
+			" The results of SHOW and DESCRIBE are encapsulated in one output collection `notification_integrations`.",
+	}
+}
+
+func ReadNotificationIntegrations(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowNotificationIntegrationRequest{}
+
+	handleLike(d, &req.Like)
+
+	notificationIntegrations, err := client.NotificationIntegrations.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("notification_integrations_read")
+
+	flattenedNotificationIntegrations := make([]map[string]any, len(notificationIntegrations))
+	for i, notificationIntegration := range notificationIntegrations {
+		var notificationIntegrationDescriptions []map[string]any
+		if d.Get("with_describe").(bool) {
+			describeResult, err := client.NotificationIntegrations.Describe(ctx, notificationIntegration.ID())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			notificationIntegrationDescriptions = []map[string]any{schemas.DescribeNotificationIntegrationToSchema(describeResult)}
+		}
+		flattenedNotificationIntegrations[i] = map[string]any{
+			resources.ShowOutputAttributeName:     []map[string]any{schemas.NotificationIntegrationToSchema(&notificationIntegration)},
+			resources.DescribeOutputAttributeName: notificationIntegrationDescriptions,
+		}
+	}
+	if err := d.Set("notification_integrations", flattenedNotificationIntegrations); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var organizationListingsSchema = map[string]*schema.Schema{
+	"like":        likeSchema,
+	"starts_with": startsWithSchema,
+	"limit":       limitFromSchema,
+	"organization_listings": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all organization listings details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW AVAILABLE LISTINGS IS_ORGANIZATION = TRUE.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowOrganizationListingSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func OrganizationListings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.OrganizationListingsDatasource), TrackingReadWrapper(datasources.OrganizationListings, ReadOrganizationListings)),
+		Schema:      organizationListingsSchema,
+		Description: "Data source used to get details of organization listings available to the current account in the internal marketplace. Filtering is aligned with the current possibilities for [SHOW AVAILABLE LISTINGS](https://docs.snowflake.com/en/sql-reference/sql/show-available-listings) query." +
+			" The results of SHOW are encapsulated in one output collection `organization_listings`.",
+	}
+}
+
+func ReadOrganizationListings(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowOrganizationListingRequest{}
+
+	handleLike(d, &req.Like)
+	handleStartsWith(d, &req.StartsWith)
+	handleLimitFrom(d, &req.Limit)
+
+	organizationListings, err := client.OrganizationListings.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("organization_listings_read")
+
+	flattenedOrganizationListings := make([]map[string]any, len(organizationListings))
+	for i, organizationListing := range organizationListings {
+		flattenedOrganizationListings[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.OrganizationListingToSchema(&organizationListing)},
+		}
+	}
+	if err := d.Set("organization_listings", flattenedOrganizationListings); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var packagesPoliciesSchema = map[string]*schema.Schema{
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESC PACKAGES POLICY for each packages policy returned by SHOW PACKAGES POLICIES. The output of describe is saved to the description field. By default this value is set to true.",
+	},
+	"like": likeSchema,
+	"in":   inSchema,
+	"packages_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all packages policies details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW PACKAGES POLICIES.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowPackagesPolicySchema,
+					},
+				},
+				resources.DescribeOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of DESCRIBE PACKAGES POLICY.",
+					Elem: &schema.Resource{
+						Schema: schemas.PackagesPolicyDescribeSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func PackagesPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.PackagesPoliciesDatasource), TrackingReadWrapper(datasources.PackagesPolicies, ReadPackagesPolicies)),
+		Schema:      packagesPoliciesSchema,
+		Description: "Data source used to get details of filtered packages policies. Filtering is aligned with the current possibilities for [SHOW PACKAGES POLICIES](https://docs.snowflake.com/en/sql-reference/sql/show-packages-policies) query." +
+			" The results of SHOW and DESCRIBE are encapsulated in one output collection `packages_policies`.",
+	}
+}
+
+func ReadPackagesPolicies(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowPackagesPolicyRequest{}
+
+	handleLike(d, &req.Like)
+	if err := handleIn(d, &req.In); err != nil {
+		return diag.FromErr(err)
+	}
+
+	packagesPolicies, err := client.PackagesPolicies.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("packages_policies_read")
+
+	flattenedPackagesPolicies := make([]map[string]any, len(packagesPolicies))
+	for i, packagesPolicy := range packagesPolicies {
+		var packagesPolicyDescriptions []map[string]any
+		if d.Get("with_describe").(bool) {
+			describeResult, err := client.PackagesPolicies.Describe(ctx, packagesPolicy.ID())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			packagesPolicyDescriptions = []map[string]any{schemas.PackagesPolicyDescriptionToSchema(*describeResult)}
+		}
+		flattenedPackagesPolicies[i] = map[string]any{
+			resources.ShowOutputAttributeName:     []map[string]any{schemas.PackagesPolicyToSchema(&packagesPolicy)},
+			resources.DescribeOutputAttributeName: packagesPolicyDescriptions,
+		}
+	}
+	if err := d.Set("packages_policies", flattenedPackagesPolicies); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
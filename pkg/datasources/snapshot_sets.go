@@ -0,0 +1,71 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var snapshotSetsSchema = map[string]*schema.Schema{
+	"like": likeSchema,
+	"in":   inSchema,
+	"snapshot_sets": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all snapshot set details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW SNAPSHOT SETS.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowSnapshotSetSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func SnapshotSets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.SnapshotSetsDatasource), TrackingReadWrapper(datasources.SnapshotSets, ReadSnapshotSets)),
+		Schema:      snapshotSetsSchema,
+		Description: "Data source used to get details of filtered snapshot sets. Filtering is aligned with the current possibilities for [SHOW SNAPSHOT SETS](https://docs.snowflake.com/en/sql-reference/sql/show-snapshot-sets) query. The results of SHOW are encapsulated in one output collection `snapshot_sets`.",
+	}
+}
+
+func ReadSnapshotSets(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowSnapshotSetRequest{}
+
+	handleLike(d, &req.Like)
+	if err := handleIn(d, &req.In); err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshotSets, err := client.SnapshotSets.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("snapshot_sets_read")
+
+	flattenedSnapshotSets := make([]map[string]any, len(snapshotSets))
+	for i, snapshotSet := range snapshotSets {
+		flattenedSnapshotSets[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.SnapshotSetToSchema(&snapshotSet)},
+		}
+	}
+	if err := d.Set("snapshot_sets", flattenedSnapshotSets); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
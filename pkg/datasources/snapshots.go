@@ -0,0 +1,80 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var snapshotsSchema = map[string]*schema.Schema{
+	"like": likeSchema,
+	"in_snapshot_set": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Returns only the snapshots of the specified snapshot set (`database.schema.snapshot_set`).",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"snapshots": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all snapshot details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW SNAPSHOTS.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowSnapshotSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func Snapshots() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.SnapshotsDatasource), TrackingReadWrapper(datasources.Snapshots, ReadSnapshots)),
+		Schema:      snapshotsSchema,
+		Description: "Data source used to get details of filtered snapshots, e.g. to reference a snapshot identifier when restoring an object. Filtering is aligned with the current possibilities for [SHOW SNAPSHOTS](https://docs.snowflake.com/en/sql-reference/sql/show-snapshots) query. The results of SHOW are encapsulated in one output collection `snapshots`.",
+	}
+}
+
+func ReadSnapshots(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.NewShowSnapshotsSnapshotSetRequest()
+
+	handleLike(d, &req.Like)
+	if v, ok := d.GetOk("in_snapshot_set"); ok {
+		snapshotSetId, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.WithIn(snapshotSetId)
+	}
+
+	snapshots, err := client.SnapshotSets.ShowSnapshots(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("snapshots_read")
+
+	flattenedSnapshots := make([]map[string]any, len(snapshots))
+	for i, snapshot := range snapshots {
+		flattenedSnapshots[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.SnapshotToSchema(&snapshot)},
+		}
+	}
+	if err := d.Set("snapshots", flattenedSnapshots); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package datasources
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sqlQueryAllowedKeywords are the keywords with which a read-only statement can start.
+var sqlQueryAllowedKeywords = []string{"SELECT", "WITH", "SHOW", "DESCRIBE", "DESC", "LIST", "LS", "EXPLAIN"}
+
+// sqlQueryForbiddenKeywords are the keywords that cannot appear in a WITH statement, as they would turn it into DML.
+var sqlQueryForbiddenKeywords = []string{"INSERT", "UPDATE", "DELETE", "MERGE"}
+
+var sqlQuerySchema = map[string]*schema.Schema{
+	"query": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: isReadOnlySqlQuery,
+		Description:      fmt.Sprintf("Single read-only SQL statement to run. The statement has to start with one of: %s; DML and DDL statements, and multiple statements are rejected. Use `?` placeholders to pass the values from `bind_variables`. Note that the provider cannot detect side effects of functions called in the query, so for full safety, use a role with read-only privileges.", strings.Join(sqlQueryAllowedKeywords, " | ")),
+	},
+	"bind_variables": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Values bound to the `?` placeholders in `query`, in order. The values are passed as text; cast them in the query if needed (e.g. `?::NUMBER`).",
+	},
+	"rows": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Rows returned by the query. Each row is a map from the column name to the value converted to text (timestamps in the RFC 3339 format, binary values hex-encoded). NULL values are omitted from the map.",
+		Elem: &schema.Schema{
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{Type: schema.TypeString},
+		},
+	},
+	"columns": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Metadata of the columns returned by the query, as reported by the driver.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the column.",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Snowflake type of the column as reported by the driver, e.g. `FIXED`, `TEXT`, `BOOLEAN`, or `TIMESTAMP_NTZ`.",
+				},
+				"nullable": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the column can contain NULL values.",
+				},
+				"precision": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Precision of the numeric column; 0 for other types.",
+				},
+				"scale": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Scale of the numeric column; 0 for other types.",
+				},
+				"length": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Maximum length of the text or binary column; 0 for other types.",
+				},
+			},
+		},
+	},
+}
+
+func SqlQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.SqlQueryDatasource), TrackingReadWrapper(datasources.SqlQuery, ReadSqlQuery)),
+		Schema:      sqlQuerySchema,
+		Description: "Data source used to run a single read-only SQL statement (e.g. a query on the account usage views, a `SHOW` command, or a `SYSTEM$` function call) and get its results with the column metadata. Contrary to the `snowflake_execute` resource, the query is run during the plan, so its results can be used in the configuration.",
+	}
+}
+
+func ReadSqlQuery(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	query := d.Get("query").(string)
+
+	rawBindVariables := d.Get("bind_variables").([]any)
+	bindVariables := make([]any, len(rawBindVariables))
+	for i, bindVariable := range rawBindVariables {
+		// empty strings in the list are read as nil
+		if bindVariable == nil {
+			bindVariable = ""
+		}
+		bindVariables[i] = bindVariable
+	}
+
+	rows, columns, err := client.QueryUnsafeWithColumns(ctx, query, bindVariables...)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("sql_query_read")
+
+	flattenedRows := make([]map[string]any, len(rows))
+	for i, row := range rows {
+		flattenedRow := make(map[string]any)
+		for name, value := range row {
+			if value == nil || *value == nil {
+				continue
+			}
+			flattenedRow[name] = sqlQueryValueToString(*value)
+		}
+		flattenedRows[i] = flattenedRow
+	}
+
+	flattenedColumns := make([]map[string]any, len(columns))
+	for i, column := range columns {
+		flattenedColumn := map[string]any{
+			"name": column.Name,
+			"type": column.DatabaseType,
+		}
+		if column.Nullable != nil {
+			flattenedColumn["nullable"] = *column.Nullable
+		}
+		if column.Precision != nil {
+			flattenedColumn["precision"] = int(*column.Precision)
+		}
+		if column.Scale != nil {
+			flattenedColumn["scale"] = int(*column.Scale)
+		}
+		if column.Length != nil {
+			flattenedColumn["length"] = int(*column.Length)
+		}
+		flattenedColumns[i] = flattenedColumn
+	}
+
+	if err := d.Set("rows", flattenedRows); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("columns", flattenedColumns); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func sqlQueryValueToString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return hex.EncodeToString(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func isReadOnlySqlQuery(value any, path cty.Path) diag.Diagnostics {
+	if err := validateReadOnlySqlQuery(value.(string)); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid SQL query",
+				Detail:        err.Error(),
+				AttributePath: path,
+			},
+		}
+	}
+	return nil
+}
+
+// validateReadOnlySqlQuery checks that the query is a single statement starting with one of the allowed keywords.
+// String literals, quoted identifiers, and comments are skipped, so that their contents are not treated as keywords or statement separators.
+func validateReadOnlySqlQuery(query string) error {
+	words, statements := sqlQueryWordsAndStatements(query)
+	if statements > 1 {
+		return fmt.Errorf("only a single statement is allowed, got %d", statements)
+	}
+	if len(words) == 0 {
+		return fmt.Errorf("the query is empty")
+	}
+	if !slices.Contains(sqlQueryAllowedKeywords, words[0]) {
+		return fmt.Errorf("only read-only statements starting with one of %v are allowed, got %s", sqlQueryAllowedKeywords, words[0])
+	}
+	if words[0] == "WITH" {
+		for _, word := range words {
+			if slices.Contains(sqlQueryForbiddenKeywords, word) {
+				return fmt.Errorf("%s is not allowed in a read-only statement", word)
+			}
+		}
+	}
+	return nil
+}
+
+// sqlQueryWordsAndStatements returns the unquoted words (uppercased) of the query and the number of non-empty statements in it.
+func sqlQueryWordsAndStatements(query string) ([]string, int) {
+	words := make([]string, 0)
+	statements := 0
+	currentStatementEmpty := true
+	runes := []rune(query)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-', r == '/' && i+1 < len(runes) && runes[i+1] == '/':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i+1 < len(runes) && (runes[i] != '*' || runes[i+1] != '/') {
+				i++
+			}
+			i++
+		case r == '$' && i+1 < len(runes) && runes[i+1] == '$':
+			i += 2
+			for i+1 < len(runes) && (runes[i] != '$' || runes[i+1] != '$') {
+				i++
+			}
+			i++
+			currentStatementEmpty = false
+		case r == '\'' || r == '"':
+			i++
+			for i < len(runes) && runes[i] != r {
+				if runes[i] == '\\' && r == '\'' {
+					i++
+				}
+				i++
+			}
+			currentStatementEmpty = false
+		case r == ';':
+			if !currentStatementEmpty {
+				statements++
+			}
+			currentStatementEmpty = true
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i+1 < len(runes) && (unicode.IsLetter(runes[i+1]) || unicode.IsDigit(runes[i+1]) || runes[i+1] == '_' || runes[i+1] == '$') {
+				i++
+			}
+			words = append(words, strings.ToUpper(string(runes[start:i+1])))
+			currentStatementEmpty = false
+		case !unicode.IsSpace(r):
+			currentStatementEmpty = false
+		}
+	}
+	if !currentStatementEmpty {
+		statements++
+	}
+	return words, statements
+}
@@ -0,0 +1,75 @@
+package datasources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SqlQuery_validateReadOnlySqlQuery(t *testing.T) {
+	valid := []string{
+		"SELECT 1",
+		"select current_account()",
+		"  \n SELECT 1;  ",
+		"SELECT 1;\n-- trailing comment",
+		"-- comment\nSELECT 1",
+		"/* comment; DROP TABLE t */ SELECT 1",
+		"(SELECT 1) UNION (SELECT 2)",
+		"SELECT 'a;b', \"col;umn\", $$x;y$$",
+		"SELECT 'it''s; fine'",
+		`SELECT 'escaped \'; DROP TABLE t'`,
+		"SELECT * FROM t WHERE status = 'DELETE'",
+		"WITH x AS (SELECT 1 AS a) SELECT a FROM x",
+		"SHOW DATABASES LIKE 'a%'",
+		"DESCRIBE TABLE db.sc.t",
+		"DESC USER u",
+		"LIST @db.sc.st",
+		"ls @~",
+		"EXPLAIN SELECT 1",
+		"SELECT SYSTEM$GET_SNOWFLAKE_PLATFORM_INFO()",
+		"SELECT ? AS a, ? AS b",
+	}
+
+	invalid := []struct {
+		query string
+		err   string
+	}{
+		{query: "", err: "the query is empty"},
+		{query: " ; -- nothing", err: "the query is empty"},
+		{query: "SELECT 1; SELECT 2", err: "only a single statement is allowed, got 2"},
+		{query: "SELECT 1; DROP DATABASE d", err: "only a single statement is allowed, got 2"},
+		{query: "DROP DATABASE d", err: "got DROP"},
+		{query: "create table t (a int)", err: "got CREATE"},
+		{query: "INSERT INTO t VALUES (1)", err: "got INSERT"},
+		{query: "UPDATE t SET a = 1", err: "got UPDATE"},
+		{query: "DELETE FROM t", err: "got DELETE"},
+		{query: "MERGE INTO t USING s ON t.a = s.a WHEN MATCHED THEN DELETE", err: "got MERGE"},
+		{query: "CALL my_procedure()", err: "got CALL"},
+		{query: "GRANT ROLE r TO USER u", err: "got GRANT"},
+		{query: "USE ROLE ACCOUNTADMIN", err: "got USE"},
+		{query: "/* SELECT */ ALTER USER u SET PASSWORD = 'x'", err: "got ALTER"},
+		{query: "WITH x AS (SELECT 1 AS a) INSERT INTO t SELECT a FROM x", err: "INSERT is not allowed in a read-only statement"},
+	}
+
+	for _, query := range valid {
+		t.Run(query, func(t *testing.T) {
+			require.NoError(t, validateReadOnlySqlQuery(query))
+		})
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.query, func(t *testing.T) {
+			require.ErrorContains(t, validateReadOnlySqlQuery(tc.query), tc.err)
+		})
+	}
+}
+
+func Test_SqlQuery_sqlQueryValueToString(t *testing.T) {
+	require.Equal(t, "text", sqlQueryValueToString("text"))
+	require.Equal(t, "true", sqlQueryValueToString(true))
+	require.Equal(t, "1.5", sqlQueryValueToString(1.5))
+	require.Equal(t, "42", sqlQueryValueToString(int64(42)))
+	require.Equal(t, "00ff", sqlQueryValueToString([]byte{0, 255}))
+	require.Equal(t, "2024-01-02T03:04:05.000000006Z", sqlQueryValueToString(time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)))
+}
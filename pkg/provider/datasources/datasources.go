@@ -6,7 +6,10 @@ const (
 	Accounts                       datasource = "snowflake_accounts"
 	AccountRoles                   datasource = "snowflake_account_roles"
 	Alerts                         datasource = "snowflake_alerts"
+	ApiIntegrations                datasource = "snowflake_api_integrations"
+	ApplicationPackages            datasource = "snowflake_application_packages"
 	AuthenticationPolicies         datasource = "snowflake_authentication_policies"
+	CatalogIntegrations            datasource = "snowflake_catalog_integrations"
 	ComputePools                   datasource = "snowflake_compute_pools"
 	Connections                    datasource = "snowflake_connections"
 	CortexSearchServices           datasource = "snowflake_cortex_search_services"
@@ -17,19 +20,31 @@ const (
 	DatabaseRoles                  datasource = "snowflake_database_roles"
 	Databases                      datasource = "snowflake_databases"
 	DynamicTables                  datasource = "snowflake_dynamic_tables"
+	EventTables                    datasource = "snowflake_event_tables"
+	ExternalAccessIntegrations     datasource = "snowflake_external_access_integrations"
 	ExternalFunctions              datasource = "snowflake_external_functions"
 	ExternalTables                 datasource = "snowflake_external_tables"
+	ExternalVolumes                datasource = "snowflake_external_volumes"
 	FailoverGroups                 datasource = "snowflake_failover_groups"
 	FileFormats                    datasource = "snowflake_file_formats"
 	Functions                      datasource = "snowflake_functions"
 	GitRepositories                datasource = "snowflake_git_repositories"
 	Grants                         datasource = "snowflake_grants"
+	HybridTables                   datasource = "snowflake_hybrid_tables"
+	IcebergTables                  datasource = "snowflake_iceberg_tables"
 	ImageRepositories              datasource = "snowflake_image_repositories"
+	Listings                       datasource = "snowflake_listings"
+	ManagedAccounts                datasource = "snowflake_managed_accounts"
 	MaskingPolicies                datasource = "snowflake_masking_policies"
 	MaterializedViews              datasource = "snowflake_materialized_views"
 	NetworkPolicies                datasource = "snowflake_network_policies"
+	NetworkRules                   datasource = "snowflake_network_rules"
 	Notebooks                      datasource = "snowflake_notebooks"
+	NotificationIntegrations       datasource = "snowflake_notification_integrations"
+	OrganizationListings           datasource = "snowflake_organization_listings"
+	PackagesPolicies               datasource = "snowflake_packages_policies"
 	Parameters                     datasource = "snowflake_parameters"
+	PasswordPolicies               datasource = "snowflake_password_policies"
 	Pipes                          datasource = "snowflake_pipes"
 	Procedures                     datasource = "snowflake_procedures"
 	ResourceMonitors               datasource = "snowflake_resource_monitors"
@@ -41,6 +56,9 @@ const (
 	Services                       datasource = "snowflake_services"
 	Sequences                      datasource = "snowflake_sequences"
 	Shares                         datasource = "snowflake_shares"
+	SnapshotSets                   datasource = "snowflake_snapshot_sets"
+	Snapshots                      datasource = "snowflake_snapshots"
+	SqlQuery                       datasource = "snowflake_sql_query"
 	Stages                         datasource = "snowflake_stages"
 	StorageIntegrations            datasource = "snowflake_storage_integrations"
 	Streams                        datasource = "snowflake_streams"
@@ -12,12 +12,18 @@ type feature string
 
 const (
 	AccountAuthenticationPolicyAttachmentResource feature = "snowflake_account_authentication_policy_attachment_resource"
+	AccountBudgetResource                         feature = "snowflake_account_budget_resource"
 	AccountPasswordPolicyAttachmentResource       feature = "snowflake_account_password_policy_attachment_resource"
 	AlertResource                                 feature = "snowflake_alert_resource"
 	AlertsDatasource                              feature = "snowflake_alerts_datasource"
 	ApiIntegrationResource                        feature = "snowflake_api_integration_resource"
+	ApiIntegrationsDatasource                     feature = "snowflake_api_integrations_datasource"
+	ApplicationPackagesDatasource                 feature = "snowflake_application_packages_datasource"
 	AuthenticationPolicyResource                  feature = "snowflake_authentication_policy_resource"
 	AuthenticationPoliciesDatasource              feature = "snowflake_authentication_policies_datasource"
+	BudgetResource                                feature = "snowflake_budget_resource"
+	CatalogIntegrationResource                    feature = "snowflake_catalog_integration_resource"
+	CatalogIntegrationsDatasource                 feature = "snowflake_catalog_integrations_datasource"
 	ComputePoolResource                           feature = "snowflake_compute_pool_resource"
 	ComputePoolsDatasource                        feature = "snowflake_compute_pools_datasource"
 	CortexSearchServiceResource                   feature = "snowflake_cortex_search_service_resource"
@@ -26,15 +32,21 @@ const (
 	CurrentAccountDatasource                      feature = "snowflake_current_account_datasource"
 	CurrentOrganizationAccountResource            feature = "snowflake_current_organization_account_resource"
 	DatabaseDatasource                            feature = "snowflake_database_datasource"
+	DatabaseFromListingResource                   feature = "snowflake_database_from_listing_resource"
 	DatabaseRoleDatasource                        feature = "snowflake_database_role_datasource"
 	DynamicTableResource                          feature = "snowflake_dynamic_table_resource"
 	DynamicTablesDatasource                       feature = "snowflake_dynamic_tables_datasource"
+	EventTablesDatasource                         feature = "snowflake_event_tables_datasource"
+	ExternalAccessIntegrationResource             feature = "snowflake_external_access_integration_resource"
+	ExternalAccessIntegrationsDatasource          feature = "snowflake_external_access_integrations_datasource"
 	EmailNotificationIntegrationResource          feature = "snowflake_email_notification_integration_resource"
 	ExternalFunctionResource                      feature = "snowflake_external_function_resource"
 	ExternalFunctionsDatasource                   feature = "snowflake_external_functions_datasource"
 	ExternalTableResource                         feature = "snowflake_external_table_resource"
 	ExternalTablesDatasource                      feature = "snowflake_external_tables_datasource"
 	ExternalVolumeResource                        feature = "snowflake_external_volume_resource"
+	ExternalVolumesDatasource                     feature = "snowflake_external_volumes_datasource"
+	ExternallyManagedIcebergTableResource         feature = "snowflake_externally_managed_iceberg_table_resource"
 	FailoverGroupResource                         feature = "snowflake_failover_group_resource"
 	FailoverGroupsDatasource                      feature = "snowflake_failover_groups_datasource"
 	FileFormatResource                            feature = "snowflake_file_format_resource"
@@ -47,22 +59,37 @@ const (
 	FunctionsDatasource                           feature = "snowflake_functions_datasource"
 	GitRepositoryResource                         feature = "snowflake_git_repository_resource"
 	GitRepositoriesDatasource                     feature = "snowflake_git_repositories_datasource"
+	HybridTableResource                           feature = "snowflake_hybrid_table_resource"
+	HybridTablesDatasource                        feature = "snowflake_hybrid_tables_datasource"
+	IcebergTableResource                          feature = "snowflake_iceberg_table_resource"
+	IcebergTablesDatasource                       feature = "snowflake_iceberg_tables_datasource"
 	ImageRepositoryResource                       feature = "snowflake_image_repository_resource"
 	ImageRepositoriesDatasource                   feature = "snowflake_image_repositories_datasource"
 	JobServiceResource                            feature = "snowflake_job_service_resource"
+	ListingsDatasource                            feature = "snowflake_listings_datasource"
 	ListingResource                               feature = "snowflake_listing_resource"
 	ManagedAccountResource                        feature = "snowflake_managed_account_resource"
+	ManagedAccountsDatasource                     feature = "snowflake_managed_accounts_datasource"
 	MaterializedViewResource                      feature = "snowflake_materialized_view_resource"
 	MaterializedViewsDatasource                   feature = "snowflake_materialized_views_datasource"
 	NetworkPolicyAttachmentResource               feature = "snowflake_network_policy_attachment_resource"
 	NetworkRuleResource                           feature = "snowflake_network_rule_resource"
+	NetworkRulesDatasource                        feature = "snowflake_network_rules_datasource"
 	NotebookResource                              feature = "snowflake_notebook_resource"
 	NotebooksDatasource                           feature = "snowflake_notebooks_datasource"
 	NotificationIntegrationResource               feature = "snowflake_notification_integration_resource"
+	NotificationIntegrationsDatasource            feature = "snowflake_notification_integrations_datasource"
 	ObjectParameterResource                       feature = "snowflake_object_parameter_resource"
+	OrganizationListingResource                   feature = "snowflake_organization_listing_resource"
+	OrganizationListingsDatasource                feature = "snowflake_organization_listings_datasource"
+	PackagesPoliciesDatasource                    feature = "snowflake_packages_policies_datasource"
+	PackagesPolicyResource                        feature = "snowflake_packages_policy_resource"
 	PasswordPolicyResource                        feature = "snowflake_password_policy_resource"
+	PasswordPoliciesDatasource                    feature = "snowflake_password_policies_datasource"
 	PipeResource                                  feature = "snowflake_pipe_resource"
 	PipesDatasource                               feature = "snowflake_pipes_datasource"
+	PrivacyPolicyResource                         feature = "snowflake_privacy_policy_resource"
+	PrivacyPolicyAttachmentResource               feature = "snowflake_privacy_policy_attachment_resource"
 	ProcedureJavaResource                         feature = "snowflake_procedure_java_resource"
 	ProcedureJavascriptResource                   feature = "snowflake_procedure_javascript_resource"
 	ProcedurePythonResource                       feature = "snowflake_procedure_python_resource"
@@ -78,11 +105,19 @@ const (
 	SequencesDatasource                           feature = "snowflake_sequences_datasource"
 	ShareResource                                 feature = "snowflake_share_resource"
 	SharesDatasource                              feature = "snowflake_shares_datasource"
+	SnapshotPolicyResource                        feature = "snowflake_snapshot_policy_resource"
+	SnapshotSetResource                           feature = "snowflake_snapshot_set_resource"
+	SnapshotSetsDatasource                        feature = "snowflake_snapshot_sets_datasource"
+	SnapshotsDatasource                           feature = "snowflake_snapshots_datasource"
+	SqlQueryDatasource                            feature = "snowflake_sql_query_datasource"
 	ParametersDatasource                          feature = "snowflake_parameters_datasource"
 	StageResource                                 feature = "snowflake_stage_resource"
+	StageFileResource                             feature = "snowflake_stage_file_resource"
 	StagesDatasource                              feature = "snowflake_stages_datasource"
 	StorageIntegrationResource                    feature = "snowflake_storage_integration_resource"
 	StorageIntegrationsDatasource                 feature = "snowflake_storage_integrations_datasource"
+	StorageLifecyclePolicyResource                feature = "snowflake_storage_lifecycle_policy_resource"
+	StorageLifecyclePolicyAttachmentResource      feature = "snowflake_storage_lifecycle_policy_attachment_resource"
 	SystemGenerateSCIMAccessTokenDatasource       feature = "snowflake_system_generate_scim_access_token_datasource"
 	SystemGetAWSSNSIAMPolicyDatasource            feature = "snowflake_system_get_aws_sns_iam_policy_datasource"
 	SystemGetPrivateLinkConfigDatasource          feature = "snowflake_system_get_privatelink_config_datasource"
@@ -90,36 +125,51 @@ const (
 	TableResource                                 feature = "snowflake_table_resource"
 	TablesDatasource                              feature = "snowflake_tables_datasource"
 	TableColumnMaskingPolicyApplicationResource   feature = "snowflake_table_column_masking_policy_application_resource"
+	TableColumnPrivacyDomainResource              feature = "snowflake_table_column_privacy_domain_resource"
 	TableConstraintResource                       feature = "snowflake_table_constraint_resource"
+	TaskGraphResource                             feature = "snowflake_task_graph_resource"
 	UserAuthenticationPolicyAttachmentResource    feature = "snowflake_user_authentication_policy_attachment_resource"
 	UserPublicKeysResource                        feature = "snowflake_user_public_keys_resource"
 	UserPasswordPolicyAttachmentResource          feature = "snowflake_user_password_policy_attachment_resource"
+	UserRsaKeyPairResource                        feature = "snowflake_user_rsa_key_pair_resource"
 	UserProgrammaticAccessTokenResource           feature = "snowflake_user_programmatic_access_token_resource"
 	UserProgrammaticAccessTokensDatasource        feature = "snowflake_user_programmatic_access_tokens_datasource"
 )
 
 var allPreviewFeatures = []feature{
 	AccountAuthenticationPolicyAttachmentResource,
+	AccountBudgetResource,
 	AccountPasswordPolicyAttachmentResource,
 	AlertResource,
 	AlertsDatasource,
 	ApiIntegrationResource,
+	ApiIntegrationsDatasource,
+	ApplicationPackagesDatasource,
 	AuthenticationPolicyResource,
 	AuthenticationPoliciesDatasource,
+	BudgetResource,
+	CatalogIntegrationResource,
+	CatalogIntegrationsDatasource,
 	CortexSearchServiceResource,
 	CortexSearchServicesDatasource,
 	CurrentAccountResource,
 	CurrentAccountDatasource,
 	CurrentOrganizationAccountResource,
 	DatabaseDatasource,
+	DatabaseFromListingResource,
 	DatabaseRoleDatasource,
 	DynamicTableResource,
 	DynamicTablesDatasource,
+	EventTablesDatasource,
+	ExternalAccessIntegrationResource,
+	ExternalAccessIntegrationsDatasource,
 	ExternalFunctionResource,
 	ExternalFunctionsDatasource,
 	ExternalTableResource,
 	ExternalTablesDatasource,
 	ExternalVolumeResource,
+	ExternalVolumesDatasource,
+	ExternallyManagedIcebergTableResource,
 	FailoverGroupResource,
 	FailoverGroupsDatasource,
 	FileFormatResource,
@@ -130,20 +180,35 @@ var allPreviewFeatures = []feature{
 	FunctionScalaResource,
 	FunctionSqlResource,
 	FunctionsDatasource,
+	HybridTableResource,
+	HybridTablesDatasource,
+	IcebergTableResource,
+	IcebergTablesDatasource,
 	JobServiceResource,
+	ListingsDatasource,
 	ManagedAccountResource,
+	ManagedAccountsDatasource,
 	MaterializedViewResource,
 	MaterializedViewsDatasource,
 	NetworkPolicyAttachmentResource,
 	NetworkRuleResource,
+	NetworkRulesDatasource,
 	NotebookResource,
 	NotebooksDatasource,
 	EmailNotificationIntegrationResource,
 	NotificationIntegrationResource,
+	NotificationIntegrationsDatasource,
 	ObjectParameterResource,
+	OrganizationListingResource,
+	OrganizationListingsDatasource,
+	PackagesPoliciesDatasource,
+	PackagesPolicyResource,
 	PasswordPolicyResource,
+	PasswordPoliciesDatasource,
 	PipeResource,
 	PipesDatasource,
+	PrivacyPolicyResource,
+	PrivacyPolicyAttachmentResource,
 	CurrentRoleDatasource,
 	SemanticViewResource,
 	SemanticViewDatasource,
@@ -151,6 +216,11 @@ var allPreviewFeatures = []feature{
 	SequencesDatasource,
 	ShareResource,
 	SharesDatasource,
+	SnapshotPolicyResource,
+	SnapshotSetResource,
+	SnapshotSetsDatasource,
+	SnapshotsDatasource,
+	SqlQueryDatasource,
 	ParametersDatasource,
 	ProcedureJavaResource,
 	ProcedureJavascriptResource,
@@ -159,20 +229,26 @@ var allPreviewFeatures = []feature{
 	ProcedureSqlResource,
 	ProceduresDatasource,
 	StageResource,
+	StageFileResource,
 	StagesDatasource,
 	StorageIntegrationResource,
 	StorageIntegrationsDatasource,
+	StorageLifecyclePolicyResource,
+	StorageLifecyclePolicyAttachmentResource,
 	SystemGenerateSCIMAccessTokenDatasource,
 	SystemGetAWSSNSIAMPolicyDatasource,
 	SystemGetPrivateLinkConfigDatasource,
 	SystemGetSnowflakePlatformInfoDatasource,
 	TableColumnMaskingPolicyApplicationResource,
+	TableColumnPrivacyDomainResource,
 	TableConstraintResource,
 	TableResource,
 	TablesDatasource,
+	TaskGraphResource,
 	UserAuthenticationPolicyAttachmentResource,
 	UserPublicKeysResource,
 	UserPasswordPolicyAttachmentResource,
+	UserRsaKeyPairResource,
 }
 var AllPreviewFeatures = sdk.AsStringList(allPreviewFeatures)
 
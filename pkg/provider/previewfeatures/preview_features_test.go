@@ -18,12 +18,18 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "SNOWFLAKE_CURRENT_ACCOUNT_DATASOURCE", want: CurrentAccountDatasource},
 
 		// Supported Values.
+		{input: "snowflake_account_budget_resource", want: AccountBudgetResource},
 		{input: "snowflake_account_password_policy_attachment_resource", want: AccountPasswordPolicyAttachmentResource},
 		{input: "snowflake_alert_resource", want: AlertResource},
 		{input: "snowflake_alerts_datasource", want: AlertsDatasource},
 		{input: "snowflake_api_integration_resource", want: ApiIntegrationResource},
+		{input: "snowflake_api_integrations_datasource", want: ApiIntegrationsDatasource},
+		{input: "snowflake_application_packages_datasource", want: ApplicationPackagesDatasource},
 		{input: "snowflake_authentication_policy_resource", want: AuthenticationPolicyResource},
 		{input: "snowflake_authentication_policies_datasource", want: AuthenticationPoliciesDatasource},
+		{input: "snowflake_budget_resource", want: BudgetResource},
+		{input: "snowflake_catalog_integration_resource", want: CatalogIntegrationResource},
+		{input: "snowflake_catalog_integrations_datasource", want: CatalogIntegrationsDatasource},
 		{input: "snowflake_compute_pool_resource", want: ComputePoolResource},
 		{input: "snowflake_compute_pools_datasource", want: ComputePoolsDatasource},
 		{input: "snowflake_cortex_search_service_resource", want: CortexSearchServiceResource},
@@ -32,37 +38,58 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_current_account_datasource", want: CurrentAccountDatasource},
 		{input: "snowflake_current_organization_account_resource", want: CurrentOrganizationAccountResource},
 		{input: "snowflake_database_datasource", want: DatabaseDatasource},
+		{input: "snowflake_database_from_listing_resource", want: DatabaseFromListingResource},
 		{input: "snowflake_database_role_datasource", want: DatabaseRoleDatasource},
 		{input: "snowflake_dynamic_table_resource", want: DynamicTableResource},
 		{input: "snowflake_dynamic_tables_datasource", want: DynamicTablesDatasource},
+		{input: "snowflake_event_tables_datasource", want: EventTablesDatasource},
+		{input: "snowflake_external_access_integration_resource", want: ExternalAccessIntegrationResource},
+		{input: "snowflake_external_access_integrations_datasource", want: ExternalAccessIntegrationsDatasource},
 		{input: "snowflake_external_function_resource", want: ExternalFunctionResource},
 		{input: "snowflake_external_functions_datasource", want: ExternalFunctionsDatasource},
 		{input: "snowflake_external_table_resource", want: ExternalTableResource},
 		{input: "snowflake_external_tables_datasource", want: ExternalTablesDatasource},
 		{input: "snowflake_external_volume_resource", want: ExternalVolumeResource},
+		{input: "snowflake_external_volumes_datasource", want: ExternalVolumesDatasource},
+		{input: "snowflake_externally_managed_iceberg_table_resource", want: ExternallyManagedIcebergTableResource},
 		{input: "snowflake_failover_group_resource", want: FailoverGroupResource},
 		{input: "snowflake_failover_groups_datasource", want: FailoverGroupsDatasource},
 		{input: "snowflake_file_format_resource", want: FileFormatResource},
 		{input: "snowflake_file_formats_datasource", want: FileFormatsDatasource},
 		{input: "snowflake_git_repository_resource", want: GitRepositoryResource},
 		{input: "snowflake_git_repositories_datasource", want: GitRepositoriesDatasource},
+		{input: "snowflake_hybrid_table_resource", want: HybridTableResource},
+		{input: "snowflake_hybrid_tables_datasource", want: HybridTablesDatasource},
+		{input: "snowflake_iceberg_table_resource", want: IcebergTableResource},
+		{input: "snowflake_iceberg_tables_datasource", want: IcebergTablesDatasource},
 		{input: "snowflake_image_repository_resource", want: ImageRepositoryResource},
 		{input: "snowflake_image_repositories_datasource", want: ImageRepositoriesDatasource},
 		{input: "snowflake_job_service_resource", want: JobServiceResource},
+		{input: "snowflake_listings_datasource", want: ListingsDatasource},
 		{input: "snowflake_listing_resource", want: ListingResource},
 		{input: "snowflake_managed_account_resource", want: ManagedAccountResource},
+		{input: "snowflake_managed_accounts_datasource", want: ManagedAccountsDatasource},
 		{input: "snowflake_materialized_view_resource", want: MaterializedViewResource},
 		{input: "snowflake_materialized_views_datasource", want: MaterializedViewsDatasource},
 		{input: "snowflake_network_policy_attachment_resource", want: NetworkPolicyAttachmentResource},
 		{input: "snowflake_network_rule_resource", want: NetworkRuleResource},
+		{input: "snowflake_network_rules_datasource", want: NetworkRulesDatasource},
 		{input: "snowflake_notebook_resource", want: NotebookResource},
 		{input: "snowflake_notebooks_datasource", want: NotebooksDatasource},
 		{input: "snowflake_email_notification_integration_resource", want: EmailNotificationIntegrationResource},
 		{input: "snowflake_notification_integration_resource", want: NotificationIntegrationResource},
+		{input: "snowflake_notification_integrations_datasource", want: NotificationIntegrationsDatasource},
 		{input: "snowflake_object_parameter_resource", want: ObjectParameterResource},
+		{input: "snowflake_organization_listing_resource", want: OrganizationListingResource},
+		{input: "snowflake_organization_listings_datasource", want: OrganizationListingsDatasource},
+		{input: "snowflake_packages_policies_datasource", want: PackagesPoliciesDatasource},
+		{input: "snowflake_packages_policy_resource", want: PackagesPolicyResource},
 		{input: "snowflake_password_policy_resource", want: PasswordPolicyResource},
+		{input: "snowflake_password_policies_datasource", want: PasswordPoliciesDatasource},
 		{input: "snowflake_pipe_resource", want: PipeResource},
 		{input: "snowflake_pipes_datasource", want: PipesDatasource},
+		{input: "snowflake_privacy_policy_resource", want: PrivacyPolicyResource},
+		{input: "snowflake_privacy_policy_attachment_resource", want: PrivacyPolicyAttachmentResource},
 		{input: "snowflake_current_role_datasource", want: CurrentRoleDatasource},
 		{input: "snowflake_service_resource", want: ServiceResource},
 		{input: "snowflake_services_datasource", want: ServicesDatasource},
@@ -70,20 +97,31 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_sequences_datasource", want: SequencesDatasource},
 		{input: "snowflake_share_resource", want: ShareResource},
 		{input: "snowflake_shares_datasource", want: SharesDatasource},
+		{input: "snowflake_snapshot_policy_resource", want: SnapshotPolicyResource},
+		{input: "snowflake_snapshot_set_resource", want: SnapshotSetResource},
+		{input: "snowflake_snapshot_sets_datasource", want: SnapshotSetsDatasource},
+		{input: "snowflake_snapshots_datasource", want: SnapshotsDatasource},
+		{input: "snowflake_sql_query_datasource", want: SqlQueryDatasource},
 		{input: "snowflake_parameters_datasource", want: ParametersDatasource},
 		{input: "snowflake_stage_resource", want: StageResource},
+		{input: "snowflake_stage_file_resource", want: StageFileResource},
 		{input: "snowflake_stages_datasource", want: StagesDatasource},
 		{input: "snowflake_storage_integration_resource", want: StorageIntegrationResource},
 		{input: "snowflake_storage_integrations_datasource", want: StorageIntegrationsDatasource},
+		{input: "snowflake_storage_lifecycle_policy_resource", want: StorageLifecyclePolicyResource},
+		{input: "snowflake_storage_lifecycle_policy_attachment_resource", want: StorageLifecyclePolicyAttachmentResource},
 		{input: "snowflake_system_generate_scim_access_token_datasource", want: SystemGenerateSCIMAccessTokenDatasource},
 		{input: "snowflake_system_get_aws_sns_iam_policy_datasource", want: SystemGetAWSSNSIAMPolicyDatasource},
 		{input: "snowflake_system_get_privatelink_config_datasource", want: SystemGetPrivateLinkConfigDatasource},
 		{input: "snowflake_system_get_snowflake_platform_info_datasource", want: SystemGetSnowflakePlatformInfoDatasource},
 		{input: "snowflake_table_column_masking_policy_application_resource", want: TableColumnMaskingPolicyApplicationResource},
+		{input: "snowflake_table_column_privacy_domain_resource", want: TableColumnPrivacyDomainResource},
 		{input: "snowflake_table_constraint_resource", want: TableConstraintResource},
+		{input: "snowflake_task_graph_resource", want: TaskGraphResource},
 		{input: "snowflake_user_authentication_policy_attachment_resource", want: UserAuthenticationPolicyAttachmentResource},
 		{input: "snowflake_user_public_keys_resource", want: UserPublicKeysResource},
 		{input: "snowflake_user_password_policy_attachment_resource", want: UserPasswordPolicyAttachmentResource},
+		{input: "snowflake_user_rsa_key_pair_resource", want: UserRsaKeyPairResource},
 		{input: "snowflake_user_programmatic_access_token_resource", want: UserProgrammaticAccessTokenResource},
 		{input: "snowflake_user_programmatic_access_tokens_datasource", want: UserProgrammaticAccessTokensDatasource},
 	}
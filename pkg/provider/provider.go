@@ -492,12 +492,56 @@ func GetProviderSchema() map[string]*schema.Schema {
 	}
 }
 
+// resourcesWithExecutionRole lists the resources supporting the execution_role attribute. Only the resources creating owned objects
+// and running all their statements through the SDK client are listed.
+var resourcesWithExecutionRole = []string{
+	"snowflake_account_role",
+	"snowflake_database",
+	"snowflake_database_role",
+	"snowflake_dynamic_table",
+	"snowflake_execute",
+	"snowflake_external_table",
+	"snowflake_file_format",
+	"snowflake_function_java",
+	"snowflake_function_javascript",
+	"snowflake_function_python",
+	"snowflake_function_scala",
+	"snowflake_function_sql",
+	"snowflake_hybrid_table",
+	"snowflake_masking_policy",
+	"snowflake_materialized_view",
+	"snowflake_network_rule",
+	"snowflake_pipe",
+	"snowflake_procedure_java",
+	"snowflake_procedure_javascript",
+	"snowflake_procedure_python",
+	"snowflake_procedure_scala",
+	"snowflake_procedure_sql",
+	"snowflake_row_access_policy",
+	"snowflake_schema",
+	"snowflake_secret_with_authorization_code_grant",
+	"snowflake_secret_with_basic_authentication",
+	"snowflake_secret_with_client_credentials",
+	"snowflake_secret_with_generic_string",
+	"snowflake_sequence",
+	"snowflake_stream_on_directory_table",
+	"snowflake_stream_on_external_table",
+	"snowflake_stream_on_table",
+	"snowflake_stream_on_view",
+	"snowflake_table",
+	"snowflake_tag",
+	"snowflake_task",
+	"snowflake_view",
+	"snowflake_warehouse",
+}
+
 func getResources() map[string]*schema.Resource {
-	return map[string]*schema.Resource{
+	resourcesMap := map[string]*schema.Resource{
 		"snowflake_account": resources.Account(),
 		"snowflake_account_authentication_policy_attachment":                     resources.AccountAuthenticationPolicyAttachment(),
 		"snowflake_account_role":                                                 resources.AccountRole(),
 		"snowflake_account_password_policy_attachment":                           resources.AccountPasswordPolicyAttachment(),
+		"snowflake_account_budget":                                               resources.AccountBudget(),
 		"snowflake_account_parameter":                                            resources.AccountParameter(),
 		"snowflake_alert":                                                        resources.Alert(),
 		"snowflake_api_authentication_integration_with_authorization_code_grant": resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant(),
@@ -505,19 +549,24 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_api_authentication_integration_with_jwt_bearer":               resources.ApiAuthenticationIntegrationWithJwtBearer(),
 		"snowflake_api_integration":                                              resources.APIIntegration(),
 		"snowflake_authentication_policy":                                        resources.AuthenticationPolicy(),
+		"snowflake_budget":                                                       resources.Budget(),
+		"snowflake_catalog_integration":                                          resources.CatalogIntegration(),
 		"snowflake_compute_pool":                                                 resources.ComputePool(),
 		"snowflake_cortex_search_service":                                        resources.CortexSearchService(),
 		"snowflake_current_account":                                              resources.CurrentAccount(),
 		"snowflake_current_organization_account":                                 resources.CurrentOrganizationAccount(),
 		"snowflake_database":                                                     resources.Database(),
+		"snowflake_database_from_listing":                                        resources.DatabaseFromListing(),
 		"snowflake_database_role":                                                resources.DatabaseRole(),
 		"snowflake_dynamic_table":                                                resources.DynamicTable(),
 		"snowflake_email_notification_integration":                               resources.EmailNotificationIntegration(),
 		"snowflake_execute":                                                      resources.Execute(),
+		"snowflake_external_access_integration":                                  resources.ExternalAccessIntegration(),
 		"snowflake_external_function":                                            resources.ExternalFunction(),
 		"snowflake_external_oauth_integration":                                   resources.ExternalOauthIntegration(),
 		"snowflake_external_table":                                               resources.ExternalTable(),
 		"snowflake_external_volume":                                              resources.ExternalVolume(),
+		"snowflake_externally_managed_iceberg_table":                             resources.ExternallyManagedIcebergTable(),
 		"snowflake_failover_group":                                               resources.FailoverGroup(),
 		"snowflake_file_format":                                                  resources.FileFormat(),
 		"snowflake_function_java":                                                resources.FunctionJava(),
@@ -533,6 +582,8 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_grant_privileges_to_database_role":                            resources.GrantPrivilegesToDatabaseRole(),
 		"snowflake_grant_privileges_to_share":                                    resources.GrantPrivilegesToShare(),
 		"snowflake_git_repository":                                               resources.GitRepository(),
+		"snowflake_hybrid_table":                                                 resources.HybridTable(),
+		"snowflake_iceberg_table":                                                resources.IcebergTable(),
 		"snowflake_image_repository":                                             resources.ImageRepository(),
 		"snowflake_job_service":                                                  resources.JobService(),
 		"snowflake_legacy_service_user":                                          resources.LegacyServiceUser(),
@@ -548,9 +599,13 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_oauth_integration_for_partner_applications":                   resources.OauthIntegrationForPartnerApplications(),
 		"snowflake_oauth_integration_for_custom_clients":                         resources.OauthIntegrationForCustomClients(),
 		"snowflake_object_parameter":                                             resources.ObjectParameter(),
+		"snowflake_organization_listing":                                         resources.OrganizationListing(),
+		"snowflake_packages_policy":                                              resources.PackagesPolicy(),
 		"snowflake_password_policy":                                              resources.PasswordPolicy(),
 		"snowflake_pipe":                                                         resources.Pipe(),
 		"snowflake_primary_connection":                                           resources.PrimaryConnection(),
+		"snowflake_privacy_policy":                                               resources.PrivacyPolicy(),
+		"snowflake_privacy_policy_attachment":                                    resources.PrivacyPolicyAttachment(),
 		"snowflake_procedure_java":                                               resources.ProcedureJava(),
 		"snowflake_procedure_javascript":                                         resources.ProcedureJavascript(),
 		"snowflake_procedure_python":                                             resources.ProcedurePython(),
@@ -573,8 +628,13 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_service_user":                                                 resources.ServiceUser(),
 		"snowflake_share":                                                        resources.Share(),
 		"snowflake_shared_database":                                              resources.SharedDatabase(),
+		"snowflake_snapshot_policy":                                              resources.SnapshotPolicy(),
+		"snowflake_snapshot_set":                                                 resources.SnapshotSet(),
 		"snowflake_stage":                                                        resources.Stage(),
+		"snowflake_stage_file":                                                   resources.StageFile(),
 		"snowflake_storage_integration":                                          resources.StorageIntegration(),
+		"snowflake_storage_lifecycle_policy":                                     resources.StorageLifecyclePolicy(),
+		"snowflake_storage_lifecycle_policy_attachment":                          resources.StorageLifecyclePolicyAttachment(),
 		"snowflake_stream_on_directory_table":                                    resources.StreamOnDirectoryTable(),
 		"snowflake_stream_on_external_table":                                     resources.StreamOnExternalTable(),
 		"snowflake_stream_on_table":                                              resources.StreamOnTable(),
@@ -582,18 +642,25 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_streamlit":                                                    resources.Streamlit(),
 		"snowflake_table":                                                        resources.Table(),
 		"snowflake_table_column_masking_policy_application":                      resources.TableColumnMaskingPolicyApplication(),
+		"snowflake_table_column_privacy_domain":                                  resources.TableColumnPrivacyDomain(),
 		"snowflake_table_constraint":                                             resources.TableConstraint(),
 		"snowflake_tag":                                                          resources.Tag(),
 		"snowflake_tag_association":                                              resources.TagAssociation(),
 		"snowflake_task":                                                         resources.Task(),
+		"snowflake_task_graph":                                                   resources.TaskGraph(),
 		"snowflake_user":                                                         resources.User(),
 		"snowflake_user_authentication_policy_attachment":                        resources.UserAuthenticationPolicyAttachment(),
 		"snowflake_user_password_policy_attachment":                              resources.UserPasswordPolicyAttachment(),
 		"snowflake_user_programmatic_access_token":                               resources.UserProgrammaticAccessToken(),
 		"snowflake_user_public_keys":                                             resources.UserPublicKeys(),
+		"snowflake_user_rsa_key_pair":                                            resources.UserRsaKeyPair(),
 		"snowflake_view":                                                         resources.View(),
 		"snowflake_warehouse":                                                    resources.Warehouse(),
 	}
+	for _, resourceName := range resourcesWithExecutionRole {
+		resourcesMap[resourceName] = resources.WithExecutionRole(resourcesMap[resourceName])
+	}
+	return resourcesMap
 }
 
 func getDataSources() map[string]*schema.Resource {
@@ -601,7 +668,10 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_accounts":                           datasources.Accounts(),
 		"snowflake_account_roles":                      datasources.AccountRoles(),
 		"snowflake_alerts":                             datasources.Alerts(),
+		"snowflake_api_integrations":                   datasources.ApiIntegrations(),
+		"snowflake_application_packages":               datasources.ApplicationPackages(),
 		"snowflake_authentication_policies":            datasources.AuthenticationPolicies(),
+		"snowflake_catalog_integrations":               datasources.CatalogIntegrations(),
 		"snowflake_compute_pools":                      datasources.ComputePools(),
 		"snowflake_connections":                        datasources.Connections(),
 		"snowflake_cortex_search_services":             datasources.CortexSearchServices(),
@@ -612,19 +682,31 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_database_roles":                     datasources.DatabaseRoles(),
 		"snowflake_databases":                          datasources.Databases(),
 		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
+		"snowflake_event_tables":                       datasources.EventTables(),
+		"snowflake_external_access_integrations":       datasources.ExternalAccessIntegrations(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
+		"snowflake_external_volumes":                   datasources.ExternalVolumes(),
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
 		"snowflake_file_formats":                       datasources.FileFormats(),
 		"snowflake_functions":                          datasources.Functions(),
 		"snowflake_git_repositories":                   datasources.GitRepositories(),
 		"snowflake_grants":                             datasources.Grants(),
+		"snowflake_hybrid_tables":                      datasources.HybridTables(),
+		"snowflake_iceberg_tables":                     datasources.IcebergTables(),
 		"snowflake_image_repositories":                 datasources.ImageRepositories(),
+		"snowflake_listings":                           datasources.Listings(),
+		"snowflake_managed_accounts":                   datasources.ManagedAccounts(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_materialized_views":                 datasources.MaterializedViews(),
 		"snowflake_network_policies":                   datasources.NetworkPolicies(),
+		"snowflake_network_rules":                      datasources.NetworkRules(),
 		"snowflake_notebooks":                          datasources.Notebooks(),
+		"snowflake_notification_integrations":          datasources.NotificationIntegrations(),
+		"snowflake_organization_listings":              datasources.OrganizationListings(),
+		"snowflake_packages_policies":                  datasources.PackagesPolicies(),
 		"snowflake_parameters":                         datasources.Parameters(),
+		"snowflake_password_policies":                  datasources.PasswordPolicies(),
 		"snowflake_pipes":                              datasources.Pipes(),
 		"snowflake_procedures":                         datasources.Procedures(),
 		"snowflake_resource_monitors":                  datasources.ResourceMonitors(),
@@ -636,6 +718,9 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_services":                           datasources.Services(),
 		"snowflake_sequences":                          datasources.Sequences(),
 		"snowflake_shares":                             datasources.Shares(),
+		"snowflake_snapshot_sets":                      datasources.SnapshotSets(),
+		"snowflake_snapshots":                          datasources.Snapshots(),
+		"snowflake_sql_query":                          datasources.SqlQuery(),
 		"snowflake_stages":                             datasources.Stages(),
 		"snowflake_storage_integrations":               datasources.StorageIntegrations(),
 		"snowflake_streams":                            datasources.Streams(),
@@ -710,6 +795,11 @@ func ConfigureProvider(_ context.Context, s *schema.ResourceData) (any, diag.Dia
 	if v, ok := s.GetOk("experimental_features_enabled"); ok {
 		providerCtx.EnabledExperiments = expandStringList(v.(*schema.Set).List())
 	}
+	if experimentalfeatures.IsExperimentEnabled(experimentalfeatures.RestApiBackend, providerCtx.EnabledExperiments) {
+		if err := providerCtx.Client.EnableRestApiBackend(); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
 
 	return providerCtx, diags
 }
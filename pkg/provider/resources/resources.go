@@ -4,6 +4,7 @@ type resource string
 
 const (
 	Account                                                resource = "snowflake_account"
+	AccountBudget                                          resource = "snowflake_account_budget"
 	AccountAuthenticationPolicyAttachment                  resource = "snowflake_account_authentication_policy_attachment"
 	AccountParameter                                       resource = "snowflake_account_parameter"
 	AccountPasswordPolicyAttachment                        resource = "snowflake_account_password_policy_attachment"
@@ -14,19 +15,24 @@ const (
 	ApiAuthenticationIntegrationWithJwtBearer              resource = "snowflake_api_authentication_integration_with_jwt_bearer"
 	ApiIntegration                                         resource = "snowflake_api_integration"
 	AuthenticationPolicy                                   resource = "snowflake_authentication_policy"
+	Budget                                                 resource = "snowflake_budget"
+	CatalogIntegration                                     resource = "snowflake_catalog_integration"
 	ComputePool                                            resource = "snowflake_compute_pool"
 	CortexSearchService                                    resource = "snowflake_cortex_search_service"
 	CurrentAccount                                         resource = "snowflake_current_account"
 	CurrentOrganizationAccount                             resource = "snowflake_current_organization_account"
 	Database                                               resource = "snowflake_database"
+	DatabaseFromListing                                    resource = "snowflake_database_from_listing"
 	DatabaseRole                                           resource = "snowflake_database_role"
 	DynamicTable                                           resource = "snowflake_dynamic_table"
 	EmailNotificationIntegration                           resource = "snowflake_email_notification_integration"
 	Execute                                                resource = "snowflake_execute"
+	ExternalAccessIntegration                              resource = "snowflake_external_access_integration"
 	ExternalFunction                                       resource = "snowflake_external_function"
 	ExternalTable                                          resource = "snowflake_external_table"
 	ExternalOauthSecurityIntegration                       resource = "snowflake_external_oauth_integration"
 	ExternalVolume                                         resource = "snowflake_external_volume"
+	ExternallyManagedIcebergTable                          resource = "snowflake_externally_managed_iceberg_table"
 	FailoverGroup                                          resource = "snowflake_failover_group"
 	FileFormat                                             resource = "snowflake_file_format"
 	FunctionJava                                           resource = "snowflake_function_java"
@@ -42,6 +48,8 @@ const (
 	GrantPrivilegesToAccountRole                           resource = "snowflake_grant_privileges_to_account_role"
 	GrantPrivilegesToDatabaseRole                          resource = "snowflake_grant_privileges_to_database_role"
 	GrantPrivilegesToShare                                 resource = "snowflake_grant_privileges_to_share"
+	HybridTable                                            resource = "snowflake_hybrid_table"
+	IcebergTable                                           resource = "snowflake_iceberg_table"
 	ImageRepository                                        resource = "snowflake_image_repository"
 	JobService                                             resource = "snowflake_job_service"
 	LegacyServiceUser                                      resource = "snowflake_legacy_service_user"
@@ -58,9 +66,13 @@ const (
 	OauthIntegrationForCustomClients                       resource = "snowflake_oauth_integration_for_custom_clients"
 	OauthIntegrationForPartnerApplications                 resource = "snowflake_oauth_integration_for_partner_applications"
 	ObjectParameter                                        resource = "snowflake_object_parameter"
+	OrganizationListing                                    resource = "snowflake_organization_listing"
+	PackagesPolicy                                         resource = "snowflake_packages_policy"
 	PasswordPolicy                                         resource = "snowflake_password_policy"
 	Pipe                                                   resource = "snowflake_pipe"
 	PrimaryConnection                                      resource = "snowflake_primary_connection"
+	PrivacyPolicy                                          resource = "snowflake_privacy_policy"
+	PrivacyPolicyAttachment                                resource = "snowflake_privacy_policy_attachment"
 	ProcedureJava                                          resource = "snowflake_procedure_java"
 	ProcedureJavascript                                    resource = "snowflake_procedure_javascript"
 	ProcedurePython                                        resource = "snowflake_procedure_python"
@@ -85,8 +97,13 @@ const (
 	ServiceUser                                            resource = "snowflake_service_user"
 	Share                                                  resource = "snowflake_share"
 	SharedDatabase                                         resource = "snowflake_shared_database"
+	SnapshotPolicy                                         resource = "snowflake_snapshot_policy"
+	SnapshotSet                                            resource = "snowflake_snapshot_set"
 	Stage                                                  resource = "snowflake_stage"
+	StageFile                                              resource = "snowflake_stage_file"
 	StorageIntegration                                     resource = "snowflake_storage_integration"
+	StorageLifecyclePolicy                                 resource = "snowflake_storage_lifecycle_policy"
+	StorageLifecyclePolicyAttachment                       resource = "snowflake_storage_lifecycle_policy_attachment"
 	StreamOnDirectoryTable                                 resource = "snowflake_stream_on_directory_table"
 	StreamOnExternalTable                                  resource = "snowflake_stream_on_external_table"
 	StreamOnTable                                          resource = "snowflake_stream_on_table"
@@ -94,15 +111,18 @@ const (
 	Streamlit                                              resource = "snowflake_streamlit"
 	Table                                                  resource = "snowflake_table"
 	TableColumnMaskingPolicyApplication                    resource = "snowflake_table_column_masking_policy_application"
+	TableColumnPrivacyDomain                               resource = "snowflake_table_column_privacy_domain"
 	TableConstraint                                        resource = "snowflake_table_constraint"
 	Tag                                                    resource = "snowflake_tag"
 	TagAssociation                                         resource = "snowflake_tag_association"
 	TagMaskingPolicyAssociation                            resource = "snowflake_tag_masking_policy_association"
 	Task                                                   resource = "snowflake_task"
+	TaskGraph                                              resource = "snowflake_task_graph"
 	User                                                   resource = "snowflake_user"
 	UserAuthenticationPolicyAttachment                     resource = "snowflake_user_authentication_policy_attachment"
 	UserPasswordPolicyAttachment                           resource = "snowflake_user_password_policy_attachment"
 	UserPublicKeys                                         resource = "snowflake_user_public_keys"
+	UserRsaKeyPair                                         resource = "snowflake_user_rsa_key_pair"
 	UserProgrammaticAccessToken                            resource = "snowflake_user_programmatic_access_token"
 	View                                                   resource = "snowflake_view"
 	Warehouse                                              resource = "snowflake_warehouse"
@@ -42,16 +42,25 @@ var accountSchema = map[string]*schema.Schema{
 		Type:             schema.TypeString,
 		Optional:         true,
 		Sensitive:        true,
-		Description:      externalChangesNotDetectedFieldDescription("Password for the initial administrative user of the account. Either admin_password or admin_rsa_public_key has to be specified. This field cannot be used whenever admin_user_type is set to SERVICE."),
+		Description:      externalChangesNotDetectedFieldDescription("Password for the initial administrative user of the account. One of admin_password, admin_password_wo, or admin_rsa_public_key has to be specified. This field cannot be used whenever admin_user_type is set to SERVICE."),
 		DiffSuppressFunc: IgnoreAfterCreation,
-		AtLeastOneOf:     []string{"admin_password", "admin_rsa_public_key"},
+		AtLeastOneOf:     []string{"admin_password", "admin_password_wo", "admin_rsa_public_key"},
+	},
+	"admin_password_wo": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		WriteOnly:     true,
+		Sensitive:     true,
+		ConflictsWith: []string{"admin_password"},
+		AtLeastOneOf:  []string{"admin_password", "admin_password_wo", "admin_rsa_public_key"},
+		Description:   externalChangesNotDetectedFieldDescription("Password for the initial administrative user of the account. This is a [write-only attribute](https://developer.hashicorp.com/terraform/plugin/sdkv2/resources/write-only-arguments): its value is never persisted in the Terraform plan or state, and it requires Terraform 1.11 or later. The value is used only during the account creation, so it has no version companion field. One of admin_password, admin_password_wo, or admin_rsa_public_key has to be specified. This field cannot be used whenever admin_user_type is set to SERVICE."),
 	},
 	"admin_rsa_public_key": {
 		Type:             schema.TypeString,
 		Optional:         true,
-		Description:      externalChangesNotDetectedFieldDescription("Assigns a public key to the initial administrative user of the account. Either admin_password or admin_rsa_public_key has to be specified."),
+		Description:      externalChangesNotDetectedFieldDescription("Assigns a public key to the initial administrative user of the account. One of admin_password, admin_password_wo, or admin_rsa_public_key has to be specified."),
 		DiffSuppressFunc: IgnoreAfterCreation,
-		AtLeastOneOf:     []string{"admin_password", "admin_rsa_public_key"},
+		AtLeastOneOf:     []string{"admin_password", "admin_password_wo", "admin_rsa_public_key"},
 	},
 	"admin_user_type": {
 		Type:             schema.TypeString,
@@ -141,6 +150,14 @@ var accountSchema = map[string]*schema.Schema{
 		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShow("consumption_billing_entity_name"),
 		Description:      "Determines which billing entity is responsible for the account's consumption-based billing.",
 	},
+	"connection_details": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the details needed to connect to the account, e.g. to configure a provider for it (see the `Bootstrapping the new account` part of the [example](#example-usage)). The attribute is named `connection_details`, because `connection` is a name reserved by Terraform.",
+		Elem: &schema.Resource{
+			Schema: schemas.AccountConnectionSchema,
+		},
+	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 	ShowOutputAttributeName: {
 		Type:        schema.TypeList,
@@ -163,9 +180,13 @@ func Account() *schema.Resource {
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.Account, customdiff.All(
 			ComputedIfAnyAttributeChanged(accountSchema, FullyQualifiedNameAttributeName, "name"),
 			ComputedIfAnyAttributeChanged(accountSchema, ShowOutputAttributeName, "name", "is_org_admin", "consumption_billing_entity"),
+			ComputedIfAnyAttributeChanged(accountSchema, "connection_details", "name"),
 		)),
 
 		Schema: accountSchema,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			preferWriteOnlyAttribute("admin_password", "admin_password_wo"),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.Account, ImportAccount),
 		},
@@ -238,6 +259,9 @@ func CreateAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.D
 	if v, ok := d.GetOk("admin_password"); ok {
 		opts.AdminPassword = sdk.String(v.(string))
 	}
+	if err := writeOnlyStringAttributeCreate(d, "admin_password_wo", &opts.AdminPassword); err != nil {
+		return diag.FromErr(err)
+	}
 	if v, ok := d.GetOk("admin_rsa_public_key"); ok {
 		opts.AdminRSAPublicKey = sdk.String(v.(string))
 	}
@@ -392,6 +416,7 @@ func ReadAccount(withExternalChangesMarking bool) schema.ReadContextFunc {
 		if errs := errors.Join(
 			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
 			d.Set(ShowOutputAttributeName, []map[string]any{schemas.AccountToSchema(account)}),
+			d.Set("connection_details", []map[string]any{schemas.AccountToConnectionSchema(account)}),
 		); errs != nil {
 			return diag.FromErr(errs)
 		}
@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func AccountBudget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.AccountBudgetResource), TrackingCreateWrapper(resources.AccountBudget, CreateAccountBudget)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AccountBudgetResource), TrackingReadWrapper(resources.AccountBudget, ReadAccountBudget)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AccountBudgetResource), TrackingUpdateWrapper(resources.AccountBudget, UpdateAccountBudget)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AccountBudgetResource), TrackingDeleteWrapper(resources.AccountBudget, DeleteAccountBudget)),
+		Description: joinWithSpace(
+			"Resource used to activate and configure the [account budget](https://docs.snowflake.com/en/user-guide/budgets/account-budget) monitoring the credit usage of the whole account.",
+			"The account budget is activated on creation and deactivated on deletion. Deactivating the account budget removes its spending limit and notification settings.",
+			"Only one such resource should be used per account.",
+		),
+
+		Schema: budgetSettingsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.AccountBudget, ImportAccountBudget),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportAccountBudget(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if id.FullyQualifiedName() != sdk.AccountRootBudgetId.FullyQualifiedName() {
+		return nil, fmt.Errorf("the account budget can be imported only with the %s identifier, got: %s", sdk.AccountRootBudgetId.FullyQualifiedName(), id.FullyQualifiedName())
+	}
+	d.SetId(helpers.EncodeResourceIdentifier(sdk.AccountRootBudgetId))
+	if err := d.Set("mute_notifications", BooleanDefault); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateAccountBudget(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	if err := client.Budgets.ActivateAccountBudget(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeResourceIdentifier(sdk.AccountRootBudgetId))
+
+	if err := createBudgetSettings(ctx, client, sdk.AccountRootBudgetId, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadAccountBudget(ctx, d, meta)
+}
+
+func ReadAccountBudget(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	if err := readBudgetSettings(ctx, client, sdk.AccountRootBudgetId, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateAccountBudget(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	if err := updateBudgetSettings(ctx, client, sdk.AccountRootBudgetId, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadAccountBudget(ctx, d, meta)
+}
+
+func DeleteAccountBudget(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	if err := client.Budgets.DeactivateAccountBudget(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -65,7 +66,30 @@ func CreateAccountPasswordPolicyAttachment(ctx context.Context, d *schema.Resour
 }
 
 func ReadAccountPasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	passwordPolicy := helpers.DecodeSnowflakeIDLegacy(d.Id())
+	client := meta.(*provider.Context).Client
+
+	// Note: there is no alphanumeric id for an attachment, so we retrieve the password policy attached to the current account.
+	// For the ACCOUNT domain, POLICY_REFERENCES expects the account locator as the entity name (like in snowflake_current_account).
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(sdk.NewAccountObjectIdentifier(client.GetAccountLocator()), sdk.PolicyEntityDomainAccount))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	passwordPolicyReference, err := collections.FindFirst(policyReferences, func(p sdk.PolicyReference) bool { return p.PolicyKind == sdk.PolicyKindPasswordPolicy })
+	// Note: this means the resource has been deleted outside of Terraform.
+	if err != nil {
+		id := d.Id()
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to find account's password policy. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Password policy id: %s", id),
+			},
+		}
+	}
+
+	passwordPolicy := sdk.NewSchemaObjectIdentifier(*passwordPolicyReference.PolicyDb, *passwordPolicyReference.PolicySchema, passwordPolicyReference.PolicyName)
 	if err := d.Set("password_policy", passwordPolicy.FullyQualifiedName()); err != nil {
 		return diag.FromErr(err)
 	}
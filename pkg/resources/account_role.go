@@ -38,6 +38,7 @@ var accountRoleSchema = map[string]*schema.Schema{
 			Schema: schemas.ShowRoleSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -62,7 +63,7 @@ func AccountRole() *schema.Resource {
 		)),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.AccountRole, ImportName[sdk.AccountObjectIdentifier]),
+			StateContext: TrackingImportWrapper(resources.AccountRole, importWithTags(sdk.ObjectTypeRole, sdk.ParseAccountObjectIdentifier, ImportName[sdk.AccountObjectIdentifier])),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -80,6 +81,13 @@ func CreateAccountRole(ctx context.Context, d *schema.ResourceData, meta any) di
 	if v, ok := d.GetOk("comment"); ok {
 		req.WithComment(v.(string))
 	}
+	tags, err := getTagsForCreate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(tags) > 0 {
+		req.WithTag(tags)
+	}
 
 	err = client.Roles.Create(ctx, req)
 	if err != nil {
@@ -139,6 +147,10 @@ func ReadAccountRole(ctx context.Context, d *schema.ResourceData, meta any) diag
 		}
 	}
 
+	if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeRole); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err = d.Set(ShowOutputAttributeName, []map[string]any{schemas.RoleToSchema(accountRole)}); err != nil {
 		return diag.FromErr(err)
 	}
@@ -173,6 +185,10 @@ func UpdateAccountRole(ctx context.Context, d *schema.ResourceData, meta any) di
 		d.SetId(helpers.EncodeResourceIdentifier(newId))
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeRole); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("comment") {
 		if v, ok := d.GetOk("comment"); ok {
 			if err := client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(id).WithSetComment(v.(string))); err != nil {
@@ -4,86 +4,82 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
-	"strconv"
-	"strings"
 	"time"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
-
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/util"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var alertSchema = map[string]*schema.Schema{
-	"name": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "Specifies the identifier for the alert; must be unique for the database and schema in which the alert is created.",
-		ForceNew:    true,
-	},
 	"database": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The database in which to create the alert.",
-		ForceNew:    true,
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the alert."),
 	},
 	"schema": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The schema in which to create the alert.",
-		ForceNew:    true,
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the alert."),
 	},
-	"comment": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Specifies a comment for the alert.",
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the alert; must be unique for the database and schema in which the alert is created."),
+	},
+	"started": {
+		Type:     schema.TypeBool,
+		Required: true,
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShowWithMapping("state", func(state any) any {
+			return sdk.AlertState(state.(string)) == sdk.AlertStateStarted
+		}),
+		Description: "Specifies if the alert should be started (resumed) or suspended. Alerts are created as suspended.",
 	},
 	"warehouse": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The warehouse the alert will use.",
-		ForceNew:    true,
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("The warehouse that provides the compute resources for running the alert. Omit this field to use Snowflake-managed compute resources (serverless alert).", resources.Warehouse),
 	},
-	"alert_schedule": {
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Description: "The schedule for periodically running an alert.",
+	"schedule": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: joinWithSpace(
+			"The schedule for periodically evaluating the condition of the alert. This can be a cron or interval in minutes. (when set, one of the sub-fields `minutes` or `using_cron` should be set)",
+			"Omit this field to create an [alert on new data](https://docs.snowflake.com/en/user-guide/alerts#label-alerts-type-streaming), which is evaluated when new rows are inserted into the tables queried by the condition.",
+		),
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				"cron": {
-					Type:          schema.TypeList,
-					Optional:      true,
-					MaxItems:      1,
-					ConflictsWith: []string{"alert_schedule.interval"},
-					Description:   "Specifies the cron expression for the alert. The cron expression must be in the following format: \"minute hour day-of-month month day-of-week\". The following values are supported: minute: 0-59 hour: 0-23 day-of-month: 1-31 month: 1-12 day-of-week: 0-6 (0 is Sunday)",
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"expression": {
-								Type:        schema.TypeString,
-								Required:    true,
-								Description: "Specifies the cron expression for the alert. The cron expression must be in the following format: \"minute hour day-of-month month day-of-week\". The following values are supported: minute: 0-59 hour: 0-23 day-of-month: 1-31 month: 1-12 day-of-week: 0-6 (0 is Sunday)",
-							},
-							"time_zone": {
-								Type:        schema.TypeString,
-								Required:    true,
-								Description: "Specifies the time zone for alert refresh.",
-							},
-						},
-					},
+				"minutes": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Description:      "Specifies an interval (in minutes) of wait time inserted between evaluations of the alert. Accepts positive integers. (conflicts with `using_cron`)",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+					ExactlyOneOf:     []string{"schedule.0.minutes", "schedule.0.using_cron"},
 				},
-				"interval": {
-					Type:          schema.TypeInt,
-					Optional:      true,
-					ConflictsWith: []string{"alert_schedule.cron"},
-					Description:   "Specifies the interval in minutes for the alert schedule. The interval must be greater than 0 and less than 1440 (24 hours).",
+				"using_cron": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "Specifies a cron expression and time zone for periodically evaluating the alert. Supports a subset of standard cron utility syntax. (conflicts with `minutes`)",
+					DiffSuppressFunc: ignoreCaseSuppressFunc,
+					ExactlyOneOf:     []string{"schedule.0.minutes", "schedule.0.using_cron"},
 				},
 			},
 		},
@@ -91,29 +87,42 @@ var alertSchema = map[string]*schema.Schema{
 	"condition": {
 		Type:             schema.TypeString,
 		Required:         true,
-		Description:      "The SQL statement that represents the condition for the alert. (SELECT, SHOW, CALL)",
-		ForceNew:         false,
-		DiffSuppressFunc: DiffSuppressStatement,
+		DiffSuppressFunc: SuppressIfAny(DiffSuppressStatement, IgnoreChangeToCurrentSnowflakeValueInShow("condition")),
+		Description:      "The SQL statement that represents the condition for the alert (SELECT, SHOW, or CALL). The alert is triggered when the statement returns one or more rows.",
 	},
 	"action": {
 		Type:             schema.TypeString,
 		Required:         true,
+		DiffSuppressFunc: SuppressIfAny(DiffSuppressStatement, IgnoreChangeToCurrentSnowflakeValueInShow("action")),
 		Description:      "The SQL statement that should be executed if the condition returns one or more rows.",
-		ForceNew:         false,
-		DiffSuppressFunc: DiffSuppressStatement,
 	},
-	"enabled": {
-		Type:        schema.TypeBool,
+	"comment": {
+		Type:        schema.TypeString,
 		Optional:    true,
-		Default:     false,
-		Description: "Specifies if an alert should be 'started' (enabled) after creation or should remain 'suspended' (default).",
+		Description: "Specifies a comment for the alert.",
 	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW ALERTS` for the given alert.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowAlertSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE ALERT` for the given alert.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowAlertDetailsSchema,
+		},
+	},
 }
 
 func Alert() *schema.Resource {
 	deleteFunc := ResourceDeleteContextFunc(
-		helpers.DecodeSnowflakeIDErrLegacy[sdk.SchemaObjectIdentifier],
+		sdk.ParseSchemaObjectIdentifier,
 		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] { return client.Alerts.DropSafely },
 	)
 
@@ -122,290 +131,252 @@ func Alert() *schema.Resource {
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AlertResource), TrackingReadWrapper(resources.Alert, ReadAlert)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AlertResource), TrackingUpdateWrapper(resources.Alert, UpdateAlert)),
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AlertResource), TrackingDeleteWrapper(resources.Alert, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage alert objects. For more information, check [alert documentation](https://docs.snowflake.com/en/user-guide/alerts).",
+			"The resource has no `parameters` field; read more in the [migration guide](https://github.com/snowflakedb/terraform-provider-snowflake/blob/main/MIGRATION_GUIDE.md#breaking-change-snowflake_alert-and-snowflake_alerts-rework).",
+		),
 
 		Schema: alertSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: TrackingImportWrapper(resources.Alert, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.Alert, customdiff.All(
+			ComputedIfAnyAttributeChanged(alertSchema, ShowOutputAttributeName, "started", "warehouse", "schedule", "condition", "action", "comment"),
+			ComputedIfAnyAttributeChanged(alertSchema, DescribeOutputAttributeName, "started", "warehouse", "schedule", "condition", "action", "comment"),
+		)),
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting type to cty.EmptyObject is a bit hacky here but following https://developer.hashicorp.com/terraform/plugin/framework/migrating/resources/state-upgrade#sdkv2-1 would require lots of repetitive code; this should work with cty.EmptyObject
+				Type:    cty.EmptyObject,
+				Upgrade: v2_12_0_AlertStateUpgrader,
+			},
 		},
 		Timeouts: defaultTimeouts,
 	}
 }
 
-// ReadAlert implements schema.ReadContextFunc.
-func ReadAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func CreateAlert(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	id := helpers.DecodeSnowflakeIDLegacy(d.Id()).(sdk.SchemaObjectIdentifier)
-
-	alert, err := client.Alerts.ShowByIDSafely(ctx, id)
-	if err != nil {
-		if errors.Is(err, sdk.ErrObjectNotFound) {
-			d.SetId("")
-			return diag.Diagnostics{
-				diag.Diagnostic{
-					Severity: diag.Warning,
-					Summary:  "Failed to query alert. Marking the resource as removed.",
-					Detail:   fmt.Sprintf("Alert id: %s, Err: %s", id.FullyQualifiedName(), err),
-				},
-			}
-		}
-		return diag.FromErr(err)
-	}
 
-	if err := d.Set("enabled", alert.State == sdk.AlertStateStarted); err != nil {
-		return diag.FromErr(err)
-	}
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
-	if err := d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()); err != nil {
-		return diag.FromErr(err)
+	opts := &sdk.CreateAlertOptions{}
+	if errs := errors.Join(
+		accountObjectIdentifierAttributeCreate(d, "warehouse", &opts.Warehouse),
+		attributeMappedValueCreate(d, "schedule", &opts.Schedule, func(v any) (*string, error) {
+			return alertScheduleFromConfig(d)
+		}),
+		stringAttributeCreate(d, "comment", &opts.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
 	}
 
-	if err := d.Set("name", alert.Name); err != nil {
-		return diag.FromErr(err)
+	if err := client.Alerts.Create(ctx, id, d.Get("condition").(string), d.Get("action").(string), opts); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating alert %s, err = %w", id.FullyQualifiedName(), err))
 	}
 
-	if err := d.Set("database", alert.DatabaseName); err != nil {
-		return diag.FromErr(err)
-	}
+	d.SetId(helpers.EncodeResourceIdentifier(id))
 
-	alertSchedule := alert.Schedule
-	if alertSchedule != "" {
-		if strings.Contains(alertSchedule, "MINUTE") {
-			interval, err := strconv.Atoi(strings.TrimSuffix(alertSchedule, " MINUTE"))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			err = d.Set("alert_schedule", []interface{}{
-				map[string]interface{}{
-					"interval": interval,
-				},
-			})
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		} else {
-			repScheduleParts := strings.Split(alertSchedule, " ")
-			timeZone := repScheduleParts[len(repScheduleParts)-1]
-			expression := strings.TrimSuffix(strings.TrimPrefix(alertSchedule, "USING CRON "), " "+timeZone)
-			err = d.Set("alert_schedule", []interface{}{
-				map[string]interface{}{
-					"cron": []interface{}{
-						map[string]interface{}{
-							"expression": expression,
-							"time_zone":  timeZone,
-						},
-					},
+	// Else case not handled, because alerts are created as suspended (https://docs.snowflake.com/en/sql-reference/sql/create-alert; "usage notes" section).
+	if d.Get("started").(bool) {
+		if err := waitForAlertState(ctx, client, id, sdk.AlertActionResume, sdk.AlertStateStarted); err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "Failed to start the alert",
+					Detail:   fmt.Sprintf("Id: %s, err: %s", id.FullyQualifiedName(), err),
 				},
-			})
-			if err != nil {
-				return diag.FromErr(err)
 			}
 		}
 	}
 
-	if err := d.Set("schema", alert.SchemaName); err != nil {
-		return diag.FromErr(err)
-	}
+	return ReadAlert(ctx, d, meta)
+}
 
-	if err := d.Set("warehouse", alert.Warehouse); err != nil {
+func UpdateAlert(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("comment", alert.Comment); err != nil {
+	alert, err := client.Alerts.ShowByID(ctx, id)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("condition", alert.Condition); err != nil {
-		return diag.FromErr(err)
+	// The alert is suspended for the time of the update and resumed at the end (if it should be started).
+	if alert.IsStarted() && d.HasChanges("warehouse", "schedule", "condition", "action", "comment") {
+		if err := waitForAlertState(ctx, client, id, sdk.AlertActionSuspend, sdk.AlertStateSuspended); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	if err := d.Set("action", alert.Action); err != nil {
-		return diag.FromErr(err)
+	set, unset := &sdk.AlertSet{}, &sdk.AlertUnset{}
+	if errs := errors.Join(
+		accountObjectIdentifierAttributeUpdate(d, "warehouse", &set.Warehouse, &unset.Warehouse),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
 	}
-	return nil
-}
-
-// CreateAlert implements schema.CreateContextFunc.
-func CreateAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*provider.Context).Client
-
-	databaseName := d.Get("database").(string)
-	schemaName := d.Get("schema").(string)
-	name := d.Get("name").(string)
-
-	objectIdentifier := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
-	alertSchedule := getAlertSchedule(d.Get("alert_schedule"))
-
-	warehouseName := d.Get("warehouse").(string)
-	warehouse := sdk.NewAccountObjectIdentifier(warehouseName)
+	if d.HasChange("schedule") {
+		schedule, err := alertScheduleFromConfig(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if schedule != nil {
+			set.Schedule = schedule
+		} else {
+			unset.Schedule = sdk.Bool(true)
+		}
+	}
 
-	opts := &sdk.CreateAlertOptions{}
+	if *set != (sdk.AlertSet{}) {
+		if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{Set: set}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for alert %s, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
 
-	if v, ok := d.GetOk("comment"); ok {
-		opts.Comment = sdk.String(v.(string))
+	if *unset != (sdk.AlertUnset{}) {
+		if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{Unset: unset}); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for alert %s, err = %w", id.FullyQualifiedName(), err))
+		}
 	}
 
-	condition := d.Get("condition").(string)
+	if d.HasChange("condition") {
+		if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{ModifyCondition: &[]string{d.Get("condition").(string)}}); err != nil {
+			return diag.FromErr(fmt.Errorf("error modifying condition of alert %s, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
 
-	action := d.Get("action").(string)
+	if d.HasChange("action") {
+		if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{ModifyAction: sdk.String(d.Get("action").(string))}); err != nil {
+			return diag.FromErr(fmt.Errorf("error modifying action of alert %s, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
 
-	err := client.Alerts.Create(ctx, objectIdentifier, warehouse, alertSchedule, condition, action, opts)
+	alert, err = client.Alerts.ShowByID(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
-
-	enabled := d.Get("enabled").(bool)
-	var diags diag.Diagnostics
-	if enabled {
-		opts := sdk.AlterAlertOptions{Action: &sdk.AlertActionResume}
-		err := client.Alerts.Alter(ctx, objectIdentifier, &opts)
-		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  fmt.Sprintf("Enabling alert: %s", err.Error()),
-			})
+	switch started := d.Get("started").(bool); {
+	case started && !alert.IsStarted():
+		if err := waitForAlertState(ctx, client, id, sdk.AlertActionResume, sdk.AlertStateStarted); err != nil {
+			return diag.FromErr(err)
 		}
-	}
-	return append(diags, ReadAlert(ctx, d, meta)...)
-}
-
-func getAlertSchedule(v interface{}) string {
-	var alertSchedule string
-	schedule := v.([]interface{})[0].(map[string]interface{})
-	if v, ok := schedule["cron"]; ok {
-		c := v.([]interface{})
-		if len(c) > 0 {
-			cron := c[0].(map[string]interface{})
-			cronExpression := cron["expression"].(string)
-			timeZone := cron["time_zone"].(string)
-			alertSchedule = fmt.Sprintf("USING CRON %s %s", cronExpression, timeZone)
-		}
-	}
-	if v, ok := schedule["interval"]; ok {
-		interval := v.(int)
-		if interval > 0 {
-			alertSchedule = fmt.Sprintf("%s MINUTE", strconv.Itoa(interval))
+	case !started && alert.IsStarted():
+		if err := waitForAlertState(ctx, client, id, sdk.AlertActionSuspend, sdk.AlertStateSuspended); err != nil {
+			return diag.FromErr(err)
 		}
 	}
-	return alertSchedule
+
+	return ReadAlert(ctx, d, meta)
 }
 
-// UpdateAlert implements schema.UpdateContextFunc.
-func UpdateAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func ReadAlert(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	objectIdentifier := helpers.DecodeSnowflakeIDLegacy(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	enabled := d.Get("enabled").(bool)
-	if d.HasChanges("enabled", "warehouse", "alert_schedule", "condition", "action", "comment") {
-		if err := waitSuspendAlert(ctx, client, objectIdentifier); err != nil {
-			log.Printf("[WARN] failed to suspend alert %s", objectIdentifier.Name())
+	alert, err := client.Alerts.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query alert. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Alert id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
 		}
+		return diag.FromErr(err)
 	}
 
-	opts := &sdk.AlterAlertOptions{
-		Set:   &sdk.AlertSet{},
-		Unset: &sdk.AlertUnset{},
-	}
-	runSetStatement := false
-
-	if d.HasChange("warehouse") {
-		runSetStatement = true
-		_, v := d.GetChange("warehouse")
-		warehouseName := v.(string)
-		warehouse := sdk.NewAccountObjectIdentifier(warehouseName)
-		opts.Set.Warehouse = &warehouse
+	alertDetails, err := client.Alerts.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if d.HasChange("alert_schedule") {
-		runSetStatement = true
-		_, v := d.GetChange("alert_schedule")
-		alertSchedule := getAlertSchedule(v)
-		opts.Set.Schedule = &alertSchedule
+	var comment string
+	if alert.Comment != nil {
+		comment = *alert.Comment
 	}
 
-	if d.HasChange("comment") {
-		_, v := d.GetChange("comment")
-		runSetStatement = true
-		newComment := v.(string)
-		opts.Set.Comment = &newComment
+	if errs := errors.Join(
+		setAlertScheduleInState(d, alert.Schedule),
+		d.Set("warehouse", alert.Warehouse),
+		d.Set("started", alert.IsStarted()),
+		d.Set("condition", alert.Condition),
+		d.Set("action", alert.Action),
+		d.Set("comment", comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.AlertToSchema(alert)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.AlertDetailsToSchema(alertDetails)}),
+	); errs != nil {
+		return diag.FromErr(errs)
 	}
 
-	if runSetStatement {
-		setOptions := &sdk.AlterAlertOptions{Set: opts.Set}
-		err := client.Alerts.Alter(ctx, objectIdentifier, setOptions)
-		if err != nil {
-			return diag.Errorf("error updating alert %v: %v", objectIdentifier.Name(), err)
-		}
-	}
+	return nil
+}
 
-	if d.HasChange("condition") {
-		condition := d.Get("condition").(string)
-		alterOptions := &sdk.AlterAlertOptions{}
-		alterOptions.ModifyCondition = &[]string{condition}
-		err := client.Alerts.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
-			return diag.Errorf("error updating schedule on condition %v: %v", objectIdentifier.Name(), err)
-		}
+// alertScheduleFromConfig returns the schedule from the `schedule` block in the format accepted by Snowflake, or nil if the block is not set.
+func alertScheduleFromConfig(d *schema.ResourceData) (*string, error) {
+	if len(d.Get("schedule").([]any)) == 0 {
+		return nil, nil
 	}
-
-	if d.HasChange("action") {
-		action := d.Get("action").(string)
-		alterOptions := &sdk.AlterAlertOptions{}
-		alterOptions.ModifyAction = &action
-		err := client.Alerts.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
-			return diag.Errorf("error updating schedule on action %v: %v", objectIdentifier.Name(), err)
-		}
+	if minutes, ok := d.GetOk("schedule.0.minutes"); ok {
+		return sdk.String(fmt.Sprintf("%d MINUTE", minutes)), nil
 	}
-	var diags diag.Diagnostics
-	if enabled {
-		if err := waitResumeAlert(ctx, client, objectIdentifier); err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  fmt.Sprintf("failed to resume alert %s", objectIdentifier.Name()),
-			})
-		}
-	} else {
-		if err := waitSuspendAlert(ctx, client, objectIdentifier); err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  fmt.Sprintf("failed to suspend alert %s", objectIdentifier.Name()),
-			})
-		}
+	if cron, ok := d.GetOk("schedule.0.using_cron"); ok {
+		return sdk.String(fmt.Sprintf("USING CRON %s", cron)), nil
 	}
-	return append(diags, ReadAlert(ctx, d, meta)...)
+	return nil, fmt.Errorf("when setting a schedule one of minutes or using_cron field should be set")
 }
 
-func waitResumeAlert(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier) error {
-	resumeAlert := func() (error, bool) {
-		opts := sdk.AlterAlertOptions{Action: &sdk.AlertActionResume}
-		err := client.Alerts.Alter(ctx, id, &opts)
-		if err != nil {
-			return fmt.Errorf("error resuming alert %v err = %w", id.Name(), err), false
-		}
-		alert, err := client.Alerts.ShowByID(ctx, id)
-		if err != nil {
-			return err, false
-		}
-		return nil, alert.State == sdk.AlertStateStarted
+// setAlertScheduleInState sets the `schedule` block based on the schedule returned by SHOW ALERTS.
+func setAlertScheduleInState(d *schema.ResourceData, schedule string) error {
+	if len(schedule) == 0 {
+		return d.Set("schedule", nil)
+	}
+	// Alerts accept a subset of the task schedule formats, so the same parsing can be used.
+	alertSchedule, err := sdk.ParseTaskSchedule(schedule)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(alertSchedule.Cron) > 0:
+		return d.Set("schedule", []any{map[string]any{
+			"using_cron": alertSchedule.Cron,
+		}})
+	case alertSchedule.Minutes > 0:
+		return d.Set("schedule", []any{map[string]any{
+			"minutes": alertSchedule.Minutes,
+		}})
 	}
-	return util.Retry(5, 10*time.Second, resumeAlert)
+	return fmt.Errorf("unsupported alert schedule: %s", schedule)
 }
 
-func waitSuspendAlert(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier) error {
-	suspendAlert := func() (error, bool) {
-		opts := sdk.AlterAlertOptions{Action: &sdk.AlertActionSuspend}
-		err := client.Alerts.Alter(ctx, id, &opts)
-		if err != nil {
-			return fmt.Errorf("error suspending alert %v err = %w", id.Name(), err), false
-		}
+func waitForAlertState(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, action sdk.AlertAction, expectedState sdk.AlertState) error {
+	if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{Action: &action}); err != nil {
+		return fmt.Errorf("error changing state of alert %s to %s, err = %w", id.FullyQualifiedName(), expectedState, err)
+	}
+	return util.Retry(5, 5*time.Second, func() (error, bool) {
 		alert, err := client.Alerts.ShowByID(ctx, id)
 		if err != nil {
-			return err, false
+			return fmt.Errorf("error changing state of alert %s to %s, err = %w", id.FullyQualifiedName(), expectedState, err), false
 		}
-		return nil, alert.State == sdk.AlertStateSuspended
-	}
-	return util.Retry(5, 10*time.Second, suspendAlert)
+		return nil, alert.State == expectedState
+	})
 }
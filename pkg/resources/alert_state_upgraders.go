@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+func v2_12_0_AlertStateUpgrader(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	if enabled, ok := rawState["enabled"].(bool); ok {
+		rawState["started"] = enabled
+	} else {
+		rawState["started"] = false
+	}
+	delete(rawState, "enabled")
+
+	rawState["schedule"] = []any{}
+	if alertSchedule, ok := rawState["alert_schedule"].([]any); ok && len(alertSchedule) == 1 {
+		if alertScheduleMap, ok := alertSchedule[0].(map[string]any); ok {
+			if interval, ok := alertScheduleMap["interval"]; ok && interval != nil && fmt.Sprint(interval) != "0" {
+				rawState["schedule"] = []any{map[string]any{"minutes": interval}}
+			}
+			if cron, ok := alertScheduleMap["cron"].([]any); ok && len(cron) == 1 {
+				if cronMap, ok := cron[0].(map[string]any); ok {
+					usingCron := strings.TrimSpace(fmt.Sprintf("%v %v", cronMap["expression"], cronMap["time_zone"]))
+					rawState["schedule"] = []any{map[string]any{"using_cron": usingCron}}
+				}
+			}
+		}
+	}
+	delete(rawState, "alert_schedule")
+
+	return migratePipeSeparatedObjectIdentifierResourceIdToFullyQualifiedName(ctx, rawState, meta)
+}
@@ -32,11 +32,22 @@ var apiAuthCommonSchema = map[string]*schema.Schema{
 		Description: "Specifies the client ID for the OAuth application in the external service.",
 	},
 	"oauth_client_secret": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: externalChangesNotDetectedFieldDescription("Specifies the client secret for the OAuth application in the ServiceNow instance from the previous step. The connector uses this to request an access token from the ServiceNow instance."),
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		ExactlyOneOf: []string{"oauth_client_secret", "oauth_client_secret_wo"},
+		Description:  externalChangesNotDetectedFieldDescription("Specifies the client secret for the OAuth application in the ServiceNow instance from the previous step. The connector uses this to request an access token from the ServiceNow instance. Exactly one of `oauth_client_secret` or `oauth_client_secret_wo` has to be specified."),
 	},
+	"oauth_client_secret_wo": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		WriteOnly:    true,
+		Sensitive:    true,
+		ExactlyOneOf: []string{"oauth_client_secret", "oauth_client_secret_wo"},
+		Description:  externalChangesNotDetectedFieldDescription(writeOnlyFieldDescription("Specifies the client secret for the OAuth application in the external service. Exactly one of `oauth_client_secret` or `oauth_client_secret_wo` has to be specified.", "oauth_client_secret_wo_version")),
+	},
+	"oauth_client_secret_wo_version": writeOnlyVersionSchema("oauth_client_secret_wo"),
+	"oauth_client_secret_wo_hash":    writeOnlyHashSchema("oauth_client_secret_wo"),
 	"oauth_token_endpoint": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -85,6 +96,7 @@ var apiAuthCommonSchema = map[string]*schema.Schema{
 			Schema: schemas.DescribeApiAuthSecurityIntegrationSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -117,8 +129,14 @@ func handleApiAuthUpdate(d *schema.ResourceData) (commonApiAuthSet, commonApiAut
 	}
 
 	if d.HasChange("oauth_client_secret") {
-		// required field
-		set.oauthClientSecret = sdk.Pointer(d.Get("oauth_client_secret").(string))
+		// the value is unset only when switching to oauth_client_secret_wo, which is handled below
+		if v, ok := d.GetOk("oauth_client_secret"); ok {
+			set.oauthClientSecret = sdk.Pointer(v.(string))
+		}
+	}
+
+	if err := writeOnlyStringAttributeUpdate(d, "oauth_client_secret_wo", "oauth_client_secret_wo_version", &set.oauthClientSecret, nil); err != nil {
+		return commonApiAuthSet{}, commonApiAuthUnset{}, err
 	}
 
 	if d.HasChange("comment") {
@@ -181,11 +199,15 @@ type commonApiAuthCreate struct {
 }
 
 func handleApiAuthCreate(d *schema.ResourceData) (commonApiAuthCreate, error) {
+	oauthClientSecret, err := getStringOrWriteOnlyString(d, "oauth_client_secret", "oauth_client_secret_wo")
+	if err != nil {
+		return commonApiAuthCreate{}, err
+	}
 	create := commonApiAuthCreate{
 		enabled:           d.Get("enabled").(bool),
 		name:              d.Get("name").(string),
 		oauthClientId:     d.Get("oauth_client_id").(string),
-		oauthClientSecret: d.Get("oauth_client_secret").(string),
+		oauthClientSecret: oauthClientSecret,
 	}
 	if v, ok := d.GetOk("comment"); ok {
 		create.comment = sdk.Pointer(v.(string))
@@ -42,6 +42,7 @@ func ApiAuthenticationIntegrationWithAuthorizationCodeGrant() *schema.Resource {
 		Description:   "Resource used to manage api authentication security integration objects with authorization code grant. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, customdiff.All(
+			writeOnlyValueChangedCustomDiff("oauth_client_secret_wo"),
 			ForceNewIfChangeToEmptyString("oauth_token_endpoint"),
 			ForceNewIfChangeToEmptyString("oauth_authorization_endpoint"),
 			ForceNewIfChangeToEmptyString("oauth_client_auth_method"),
@@ -50,8 +51,11 @@ func ApiAuthenticationIntegrationWithAuthorizationCodeGrant() *schema.Resource {
 				"oauth_client_auth_method", "oauth_authorization_endpoint", "oauth_token_endpoint", "oauth_allowed_scopes"),
 		)),
 		Schema: apiAuthAuthorizationCodeGrantSchema,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			preferWriteOnlyAttribute("oauth_client_secret", "oauth_client_secret_wo"),
+		},
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, ImportApiAuthenticationWithAuthorizationCodeGrant),
+			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, importWithTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ImportApiAuthenticationWithAuthorizationCodeGrant)),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -133,6 +137,12 @@ func CreateContextApiAuthenticationIntegrationWithAuthorizationCodeGrant(ctx con
 	}
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
+	if err := handleTagsCreate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setWriteOnlyHash(d, "oauth_client_secret_wo"); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextApiAuthenticationIntegrationWithAuthorizationCodeGrant(false)(ctx, d, meta)
 }
 
@@ -192,6 +202,10 @@ func ReadContextApiAuthenticationIntegrationWithAuthorizationCodeGrant(withExter
 		}); err != nil {
 			return diag.FromErr(err)
 		}
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+			return diag.FromErr(err)
+		}
+
 		return nil
 	}
 }
@@ -203,6 +217,10 @@ func UpdateContextApiAuthenticationIntegrationWithAuthorizationCodeGrant(ctx con
 		return diag.FromErr(err)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
+
 	commonSet, commonUnset, err := handleApiAuthUpdate(d)
 	if err != nil {
 		return diag.FromErr(err)
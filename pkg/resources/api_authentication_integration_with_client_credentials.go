@@ -37,7 +37,11 @@ func ApiAuthenticationIntegrationWithClientCredentials() *schema.Resource {
 		Description:   "Resource used to manage api authentication security integration objects with client credentials. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth).",
 
 		Schema: apiAuthClientCredentialsSchema,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			preferWriteOnlyAttribute("oauth_client_secret", "oauth_client_secret_wo"),
+		},
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, customdiff.All(
+			writeOnlyValueChangedCustomDiff("oauth_client_secret_wo"),
 			ForceNewIfChangeToEmptyString("oauth_token_endpoint"),
 			ForceNewIfChangeToEmptyString("oauth_client_auth_method"),
 			ComputedIfAnyAttributeChanged(apiAuthClientCredentialsSchema, ShowOutputAttributeName, "enabled", "comment"),
@@ -45,7 +49,7 @@ func ApiAuthenticationIntegrationWithClientCredentials() *schema.Resource {
 				"oauth_client_auth_method", "oauth_token_endpoint", "oauth_allowed_scopes"),
 		)),
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, ImportApiAuthenticationWithClientCredentials),
+			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, importWithTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ImportApiAuthenticationWithClientCredentials)),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -114,6 +118,12 @@ func CreateContextApiAuthenticationIntegrationWithClientCredentials(ctx context.
 	}
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
+	if err := handleTagsCreate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setWriteOnlyHash(d, "oauth_client_secret_wo"); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextApiAuthenticationIntegrationWithClientCredentials(false)(ctx, d, meta)
 }
 
@@ -163,6 +173,10 @@ func ReadContextApiAuthenticationIntegrationWithClientCredentials(withExternalCh
 			return diag.FromErr(err)
 		}
 
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+			return diag.FromErr(err)
+		}
+
 		return nil
 	}
 }
@@ -174,6 +188,10 @@ func UpdateContextApiAuthenticationIntegrationWithClientCredentials(ctx context.
 		return diag.FromErr(err)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
+
 	commonSet, commonUnset, err := handleApiAuthUpdate(d)
 	if err != nil {
 		return diag.FromErr(err)
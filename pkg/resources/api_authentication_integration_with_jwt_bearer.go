@@ -40,7 +40,11 @@ func ApiAuthenticationIntegrationWithJwtBearer() *schema.Resource {
 		Description:   "Resource used to manage api authentication security integration objects with jwt bearer. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth).",
 
 		Schema: apiAuthJwtBearerSchema,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			preferWriteOnlyAttribute("oauth_client_secret", "oauth_client_secret_wo"),
+		},
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, customdiff.All(
+			writeOnlyValueChangedCustomDiff("oauth_client_secret_wo"),
 			ForceNewIfChangeToEmptyString("oauth_token_endpoint"),
 			ForceNewIfChangeToEmptyString("oauth_authorization_endpoint"),
 			ForceNewIfChangeToEmptyString("oauth_client_auth_method"),
@@ -49,7 +53,7 @@ func ApiAuthenticationIntegrationWithJwtBearer() *schema.Resource {
 				"oauth_client_auth_method", "oauth_authorization_endpoint", "oauth_token_endpoint", "oauth_assertion_issuer")),
 		),
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, ImportApiAuthenticationWithJwtBearer),
+			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, importWithTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ImportApiAuthenticationWithJwtBearer)),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -121,6 +125,12 @@ func CreateContextApiAuthenticationIntegrationWithJwtBearer(ctx context.Context,
 	}
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
+	if err := handleTagsCreate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setWriteOnlyHash(d, "oauth_client_secret_wo"); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextApiAuthenticationIntegrationWithJwtBearer(false)(ctx, d, meta)
 }
 
@@ -177,6 +187,10 @@ func ReadContextApiAuthenticationIntegrationWithJwtBearer(withExternalChangesMar
 		}); err != nil {
 			return diag.FromErr(err)
 		}
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+			return diag.FromErr(err)
+		}
+
 		return nil
 	}
 }
@@ -187,6 +201,10 @@ func UpdateContextApiAuthenticationIntegrationWithJwtBearer(ctx context.Context,
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
 	commonSet, commonUnset, err := handleApiAuthUpdate(d)
 	if err != nil {
 		return diag.FromErr(err)
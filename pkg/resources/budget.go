@@ -0,0 +1,389 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// budgetSettingsSchema is the common schema of the custom budget and the account budget.
+var budgetSettingsSchema = map[string]*schema.Schema{
+	"spending_limit": {
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Specifies the monthly spending limit (in credits) of the budget.",
+	},
+	"notification_emails": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Specifies the verified email addresses notified when the spending is projected to exceed the spending limit. Snowflake does not return the configured emails, so the external changes of this field are not detected.",
+	},
+	"notification_integration": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		RequiredWith:     []string{"notification_emails"},
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription(blocklistedCharactersFieldDescription("Specifies the name of the email notification integration used to send the notifications. Snowflake does not return the configured integration, so the external changes of this field are not detected."), resources.EmailNotificationIntegration),
+	},
+	"mute_notifications": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("Specifies if the notifications of the budget are muted."),
+	},
+}
+
+var budgetSchema = collections.MergeMaps(budgetSettingsSchema, map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the budget; must be unique for the schema in which the budget is created."),
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the budget."),
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the budget."),
+	},
+	"warehouses": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("warehouses"),
+		Description:      relatedResourceDescription("Specifies the warehouses monitored by the budget.", resources.Warehouse),
+	},
+	"databases": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("databases"),
+		Description:      relatedResourceDescription("Specifies the databases monitored by the budget.", resources.Database),
+	},
+	"tasks": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("tasks"),
+		Description:      relatedResourceDescription("Specifies the fully qualified names of the tasks monitored by the budget.", resources.Task),
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW SNOWFLAKE.CORE.BUDGET` for the given budget.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowBudgetSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+})
+
+// budgetResourceAttributes lists the attributes holding the objects monitored by the custom budget and their object types.
+var budgetResourceAttributes = []struct {
+	key        string
+	objectType sdk.ObjectType
+}{
+	{key: "warehouses", objectType: sdk.ObjectTypeWarehouse},
+	{key: "databases", objectType: sdk.ObjectTypeDatabase},
+	{key: "tasks", objectType: sdk.ObjectTypeTask},
+}
+
+func Budget() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.Budgets.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.BudgetResource), TrackingCreateWrapper(resources.Budget, CreateBudget)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.BudgetResource), TrackingReadWrapper(resources.Budget, ReadBudget)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.BudgetResource), TrackingUpdateWrapper(resources.Budget, UpdateBudget)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.BudgetResource), TrackingDeleteWrapper(resources.Budget, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage custom [budgets](https://docs.snowflake.com/en/user-guide/budgets) (instances of the `SNOWFLAKE.CORE.BUDGET` class) together with the warehouses, databases, and tasks they monitor.",
+			"To activate the account budget, use the `snowflake_account_budget` resource.",
+		),
+
+		Schema: budgetSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.Budget, ImportBudget),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportBudget(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](ctx, d, meta); err != nil {
+		return nil, err
+	}
+	if err := d.Set("mute_notifications", BooleanDefault); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateBudget(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	if err := client.Budgets.Create(ctx, id, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if err := createBudgetSettings(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, attribute := range budgetResourceAttributes {
+		budgetResources, err := expandBudgetResources(attribute.objectType, d.Get(attribute.key).(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, budgetResource := range budgetResources {
+			if err := client.Budgets.AddResource(ctx, id, budgetResource); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadBudget(ctx, d, meta)
+}
+
+func ReadBudget(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	budget, err := client.Budgets.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query budget. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Budget id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := readBudgetSettings(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	linkedResources, err := client.Budgets.GetLinkedResources(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, attribute := range budgetResourceAttributes {
+		if err := d.Set(attribute.key, flattenBudgetResources(attribute.objectType, linkedResources)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if errs := errors.Join(
+		d.Set("name", budget.Name),
+		d.Set("database", budget.DatabaseName),
+		d.Set("schema", budget.SchemaName),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.BudgetToSchema(budget)}),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	return nil
+}
+
+func UpdateBudget(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateBudgetSettings(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, attribute := range budgetResourceAttributes {
+		if !d.HasChange(attribute.key) {
+			continue
+		}
+		oldValue, newValue := d.GetChange(attribute.key)
+		oldResources, err := expandBudgetResources(attribute.objectType, oldValue.(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newResources, err := expandBudgetResources(attribute.objectType, newValue.(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		added, removed := ListDiff(oldResources, newResources)
+		for _, budgetResource := range removed {
+			if err := client.Budgets.RemoveResource(ctx, id, budgetResource); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, budgetResource := range added {
+			if err := client.Budgets.AddResource(ctx, id, budgetResource); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadBudget(ctx, d, meta)
+}
+
+func expandBudgetResources(objectType sdk.ObjectType, values []any) ([]sdk.BudgetResource, error) {
+	return collections.MapErr(expandStringList(values), func(value string) (sdk.BudgetResource, error) {
+		var id sdk.ObjectIdentifier
+		var err error
+		switch objectType {
+		case sdk.ObjectTypeTask:
+			id, err = sdk.ParseSchemaObjectIdentifier(value)
+		default:
+			id, err = sdk.ParseAccountObjectIdentifier(value)
+		}
+		if err != nil {
+			return sdk.BudgetResource{}, err
+		}
+		return sdk.BudgetResource{ObjectType: objectType, Id: id}, nil
+	})
+}
+
+func flattenBudgetResources(objectType sdk.ObjectType, linkedResources []sdk.BudgetLinkedResource) []string {
+	result := make([]string, 0)
+	for _, linkedResource := range linkedResources {
+		if linkedResource.Domain != objectType {
+			continue
+		}
+		switch objectType {
+		case sdk.ObjectTypeTask:
+			result = append(result, sdk.NewSchemaObjectIdentifier(linkedResource.DatabaseName, linkedResource.SchemaName, linkedResource.Name).FullyQualifiedName())
+		default:
+			result = append(result, sdk.NewAccountObjectIdentifier(linkedResource.Name).FullyQualifiedName())
+		}
+	}
+	return result
+}
+
+// createBudgetSettings sets the spending limit and the notifications of the newly created (or activated) budget.
+func createBudgetSettings(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	if err := client.Budgets.SetSpendingLimit(ctx, id, d.Get("spending_limit").(int)); err != nil {
+		return err
+	}
+	if emails := expandStringList(d.Get("notification_emails").(*schema.Set).List()); len(emails) > 0 {
+		if err := setBudgetEmailNotifications(ctx, client, id, d, emails); err != nil {
+			return err
+		}
+	}
+	if v := d.Get("mute_notifications").(string); v != BooleanDefault {
+		mute, err := booleanStringToBool(v)
+		if err != nil {
+			return err
+		}
+		if err := client.Budgets.SetNotificationMuteFlag(ctx, id, mute); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readBudgetSettings(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	spendingLimit, err := client.Budgets.GetSpendingLimit(ctx, id)
+	if err != nil {
+		return err
+	}
+	mute, err := client.Budgets.GetNotificationMuteFlag(ctx, id)
+	if err != nil {
+		return err
+	}
+	muteNotifications := d.Get("mute_notifications").(string)
+	if muteNotifications != BooleanDefault || mute {
+		muteNotifications = booleanStringFromBool(mute)
+	}
+	return errors.Join(
+		d.Set("spending_limit", spendingLimit),
+		d.Set("mute_notifications", muteNotifications),
+	)
+}
+
+func updateBudgetSettings(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	if d.HasChange("spending_limit") {
+		if err := client.Budgets.SetSpendingLimit(ctx, id, d.Get("spending_limit").(int)); err != nil {
+			return err
+		}
+	}
+	if d.HasChanges("notification_emails", "notification_integration") {
+		if err := setBudgetEmailNotifications(ctx, client, id, d, expandStringList(d.Get("notification_emails").(*schema.Set).List())); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("mute_notifications") {
+		// muting is disabled by default, so unsetting the value is the same as setting false
+		mute := false
+		if v := d.Get("mute_notifications").(string); v != BooleanDefault {
+			parsed, err := booleanStringToBool(v)
+			if err != nil {
+				return err
+			}
+			mute = parsed
+		}
+		if err := client.Budgets.SetNotificationMuteFlag(ctx, id, mute); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setBudgetEmailNotifications(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData, emails []string) error {
+	var integration *sdk.AccountObjectIdentifier
+	if v, ok := d.GetOk("notification_integration"); ok {
+		integrationId, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		if err != nil {
+			return err
+		}
+		integration = &integrationId
+	}
+	return client.Budgets.SetEmailNotifications(ctx, id, integration, emails)
+}
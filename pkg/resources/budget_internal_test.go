@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetResources(t *testing.T) {
+	t.Run("expand ignores the identifier quoting", func(t *testing.T) {
+		warehouses, err := expandBudgetResources(sdk.ObjectTypeWarehouse, []any{"WH", `"WH"`})
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.BudgetResource{
+			{ObjectType: sdk.ObjectTypeWarehouse, Id: sdk.NewAccountObjectIdentifier("WH")},
+			{ObjectType: sdk.ObjectTypeWarehouse, Id: sdk.NewAccountObjectIdentifier("WH")},
+		}, warehouses)
+	})
+
+	t.Run("expand tasks", func(t *testing.T) {
+		tasks, err := expandBudgetResources(sdk.ObjectTypeTask, []any{`"DB"."SCHEMA"."TASK"`})
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.BudgetResource{
+			{ObjectType: sdk.ObjectTypeTask, Id: sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "TASK")},
+		}, tasks)
+	})
+
+	t.Run("expand invalid identifier", func(t *testing.T) {
+		_, err := expandBudgetResources(sdk.ObjectTypeTask, []any{"TASK"})
+		require.Error(t, err)
+	})
+
+	t.Run("flatten filters by the object type", func(t *testing.T) {
+		linkedResources := []sdk.BudgetLinkedResource{
+			{Name: "WH", Domain: sdk.ObjectTypeWarehouse},
+			{Name: "DB", Domain: sdk.ObjectTypeDatabase},
+			{Name: "TASK", Domain: sdk.ObjectTypeTask, DatabaseName: "DB", SchemaName: "SCHEMA"},
+			{Name: "TABLE", Domain: sdk.ObjectTypeTable, DatabaseName: "DB", SchemaName: "SCHEMA"},
+		}
+
+		assert.Equal(t, []string{`"WH"`}, flattenBudgetResources(sdk.ObjectTypeWarehouse, linkedResources))
+		assert.Equal(t, []string{`"DB"`}, flattenBudgetResources(sdk.ObjectTypeDatabase, linkedResources))
+		assert.Equal(t, []string{`"DB"."SCHEMA"."TASK"`}, flattenBudgetResources(sdk.ObjectTypeTask, linkedResources))
+	})
+}
@@ -0,0 +1,455 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var catalogIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the catalog integration; must be unique in your account."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"catalog_source": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToCatalogIntegrationCatalogSource),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToCatalogIntegrationCatalogSource),
+		Description:      fmt.Sprintf("Specifies the type of catalog source. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllCatalogIntegrationCatalogSources)),
+	},
+	"table_format": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToCatalogIntegrationTableFormat),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToCatalogIntegrationTableFormat),
+		Description:      fmt.Sprintf("Specifies the table format supplied by the catalog. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllCatalogIntegrationTableFormats)),
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies whether the catalog integration is available to use for Iceberg tables. Due to Snowflake limitations, when value is changed, the resource is recreated.",
+	},
+	"catalog_namespace": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the default namespace (e.g. AWS Glue database name, or Snowflake Open Catalog namespace) for all Iceberg tables that you associate with the catalog integration.",
+	},
+	"glue_aws_role_arn": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		RequiredWith: []string{"glue_catalog_id"},
+		Description:  "Specifies the Amazon Resource Name (ARN) of the AWS IAM role to assume (`GLUE` catalog source only).",
+	},
+	"glue_catalog_id": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		RequiredWith: []string{"glue_aws_role_arn"},
+		Description:  "Specifies the ID of your AWS account (`GLUE` catalog source only).",
+	},
+	"glue_region": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the AWS Region of your AWS Glue Data Catalog (`GLUE` catalog source only).",
+	},
+	"rest_config": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Specifies information about the REST catalog (`POLARIS` and `ICEBERG_REST` catalog sources only).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"catalog_uri": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the endpoint URL for the catalog REST API.",
+				},
+				"catalog_api_type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: sdkValidation(sdk.ToCatalogIntegrationCatalogApiType),
+					DiffSuppressFunc: NormalizeAndCompare(sdk.ToCatalogIntegrationCatalogApiType),
+					Description:      fmt.Sprintf("Specifies the connection type for the catalog API. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllCatalogIntegrationCatalogApiTypes)),
+				},
+				"catalog_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the name of the catalog to use (`POLARIS` catalog source only).",
+				},
+				"warehouse": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the warehouse name or identifier to request from the remote catalog service (`ICEBERG_REST` catalog source only).",
+				},
+				"prefix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a prefix that Snowflake appends to all API routes.",
+				},
+				"access_delegation_mode": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: sdkValidation(sdk.ToCatalogIntegrationAccessDelegationMode),
+					DiffSuppressFunc: NormalizeAndCompare(sdk.ToCatalogIntegrationAccessDelegationMode),
+					Description:      fmt.Sprintf("Specifies the access delegation mode to use for accessing Iceberg table files in your external cloud storage. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllCatalogIntegrationAccessDelegationModes)),
+				},
+			},
+		},
+	},
+	"rest_authentication": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies information about how Snowflake authenticates with the REST catalog (`POLARIS` and `ICEBERG_REST` catalog sources only). Snowflake doesn't return the secrets, so external changes to them are not detected.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: sdkValidation(sdk.ToCatalogIntegrationRestAuthenticationType),
+					DiffSuppressFunc: NormalizeAndCompare(sdk.ToCatalogIntegrationRestAuthenticationType),
+					Description:      fmt.Sprintf("Specifies the type of authentication to use. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllCatalogIntegrationRestAuthenticationTypes)),
+				},
+				"oauth_token_uri": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the URL for the third-party identity provider.",
+				},
+				"oauth_client_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Specifies the client ID of the OAuth2 credential associated with your REST catalog service.",
+				},
+				"oauth_client_secret": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Specifies the secret for the OAuth2 credential associated with your REST catalog service.",
+				},
+				"oauth_allowed_scopes": {
+					Type:        schema.TypeSet,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Optional:    true,
+					Description: "Specifies one or more scopes for the OAuth token.",
+				},
+				"bearer_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Specifies the bearer token for the identity provider.",
+				},
+				"sigv4_iam_role": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the Amazon Resource Name (ARN) for an IAM role that has permission to access your REST API in API Gateway.",
+				},
+				"sigv4_signing_region": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the AWS Region associated with your API in API Gateway.",
+				},
+				"sigv4_external_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies an external ID that Snowflake uses to establish a trust relationship with AWS.",
+				},
+			},
+		},
+	},
+	"refresh_interval_seconds": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(30, 86400)),
+		Description:      "Specifies the number of seconds that Snowflake waits between attempts to poll the external Iceberg catalog for metadata updates for automated refresh. When unset, the Snowflake default (30 seconds) is used.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the catalog integration.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW CATALOG INTEGRATIONS` for the given catalog integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowCatalogIntegrationSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE CATALOG INTEGRATION` for the given catalog integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeCatalogIntegrationSchema,
+		},
+	},
+}
+
+func CatalogIntegration() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.CatalogIntegrations.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.CatalogIntegrationResource), TrackingCreateWrapper(resources.CatalogIntegration, CreateCatalogIntegration)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.CatalogIntegrationResource), TrackingReadWrapper(resources.CatalogIntegration, ReadCatalogIntegration)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.CatalogIntegrationResource), TrackingUpdateWrapper(resources.CatalogIntegration, UpdateCatalogIntegration)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.CatalogIntegrationResource), TrackingDeleteWrapper(resources.CatalogIntegration, deleteFunc)),
+		Description:   "Resource used to manage catalog integrations for Apache Iceberg™ tables. For more information, check [catalog integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-catalog-integration).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.CatalogIntegration, customdiff.All(
+			ComputedIfAnyAttributeChanged(catalogIntegrationSchema, ShowOutputAttributeName, "comment"),
+			ComputedIfAnyAttributeChanged(catalogIntegrationSchema, DescribeOutputAttributeName, "rest_authentication", "refresh_interval_seconds", "comment"),
+		)),
+
+		Schema: catalogIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.CatalogIntegration, ImportName[sdk.AccountObjectIdentifier]),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateCatalogIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	catalogSource, err := sdk.ToCatalogIntegrationCatalogSource(d.Get("catalog_source").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tableFormat, err := sdk.ToCatalogIntegrationTableFormat(d.Get("table_format").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateCatalogIntegrationRequest(id, catalogSource, tableFormat, d.Get("enabled").(bool))
+	errs := errors.Join(
+		stringAttributeCreateBuilder(d, "catalog_namespace", request.WithCatalogNamespace),
+		stringAttributeCreateBuilder(d, "glue_aws_role_arn", request.WithGlueAwsRoleArn),
+		stringAttributeCreateBuilder(d, "glue_catalog_id", request.WithGlueCatalogId),
+		stringAttributeCreateBuilder(d, "glue_region", request.WithGlueRegion),
+		intAttributeCreateBuilder(d, "refresh_interval_seconds", request.WithRefreshIntervalSeconds),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if v, ok := d.GetOk("rest_config"); ok {
+		restConfig, err := expandCatalogIntegrationRestConfig(v.([]any)[0].(map[string]any))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithRestConfig(*restConfig)
+	}
+	if v, ok := d.GetOk("rest_authentication"); ok {
+		restAuthentication, err := expandCatalogIntegrationRestAuthentication(v.([]any))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithRestAuthentication(*restAuthentication)
+	}
+
+	if err := client.CatalogIntegrations.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadCatalogIntegration(ctx, d, meta)
+}
+
+func expandCatalogIntegrationRestConfig(v map[string]any) (*sdk.CatalogIntegrationRestConfigRequest, error) {
+	restConfig := sdk.NewCatalogIntegrationRestConfigRequest(v["catalog_uri"].(string))
+	if apiType := v["catalog_api_type"].(string); apiType != "" {
+		parsed, err := sdk.ToCatalogIntegrationCatalogApiType(apiType)
+		if err != nil {
+			return nil, err
+		}
+		restConfig.WithCatalogApiType(parsed)
+	}
+	if catalogName := v["catalog_name"].(string); catalogName != "" {
+		restConfig.WithCatalogName(catalogName)
+	}
+	if warehouse := v["warehouse"].(string); warehouse != "" {
+		restConfig.WithWarehouse(warehouse)
+	}
+	if prefix := v["prefix"].(string); prefix != "" {
+		restConfig.WithPrefix(prefix)
+	}
+	if mode := v["access_delegation_mode"].(string); mode != "" {
+		parsed, err := sdk.ToCatalogIntegrationAccessDelegationMode(mode)
+		if err != nil {
+			return nil, err
+		}
+		restConfig.WithAccessDelegationMode(parsed)
+	}
+	return restConfig, nil
+}
+
+func expandCatalogIntegrationRestAuthentication(raw []any) (*sdk.CatalogIntegrationRestAuthenticationRequest, error) {
+	restAuthentication := sdk.NewCatalogIntegrationRestAuthenticationRequest()
+	if len(raw) == 0 || raw[0] == nil {
+		return restAuthentication, nil
+	}
+	v := raw[0].(map[string]any)
+	if authType := v["type"].(string); authType != "" {
+		parsed, err := sdk.ToCatalogIntegrationRestAuthenticationType(authType)
+		if err != nil {
+			return nil, err
+		}
+		restAuthentication.WithRestAuthenticationType(parsed)
+	}
+	if tokenUri := v["oauth_token_uri"].(string); tokenUri != "" {
+		restAuthentication.WithOauthTokenUri(tokenUri)
+	}
+	if clientId := v["oauth_client_id"].(string); clientId != "" {
+		restAuthentication.WithOauthClientId(clientId)
+	}
+	if clientSecret := v["oauth_client_secret"].(string); clientSecret != "" {
+		restAuthentication.WithOauthClientSecret(clientSecret)
+	}
+	if scopes := expandStringList(v["oauth_allowed_scopes"].(*schema.Set).List()); len(scopes) > 0 {
+		allowedScopes := make([]sdk.CatalogIntegrationOauthAllowedScope, len(scopes))
+		for i, scope := range scopes {
+			allowedScopes[i] = sdk.CatalogIntegrationOauthAllowedScope{Scope: scope}
+		}
+		restAuthentication.WithOauthAllowedScopes(allowedScopes)
+	}
+	if bearerToken := v["bearer_token"].(string); bearerToken != "" {
+		restAuthentication.WithBearerToken(bearerToken)
+	}
+	if iamRole := v["sigv4_iam_role"].(string); iamRole != "" {
+		restAuthentication.WithSigv4IamRole(iamRole)
+	}
+	if signingRegion := v["sigv4_signing_region"].(string); signingRegion != "" {
+		restAuthentication.WithSigv4SigningRegion(signingRegion)
+	}
+	if externalId := v["sigv4_external_id"].(string); externalId != "" {
+		restAuthentication.WithSigv4ExternalId(externalId)
+	}
+	return restAuthentication, nil
+}
+
+func ReadCatalogIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	catalogIntegration, err := client.CatalogIntegrations.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query catalog integration. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Catalog integration id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	properties, err := client.CatalogIntegrations.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	errs := errors.Join(
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.CatalogIntegrationToSchema(catalogIntegration)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.DescribeCatalogIntegrationToSchema(properties)}),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("enabled", catalogIntegration.Enabled),
+		d.Set("comment", catalogIntegration.Comment),
+	)
+	for _, property := range properties {
+		switch property.Name {
+		case "CATALOG_SOURCE":
+			errs = errors.Join(errs, d.Set("catalog_source", property.Value))
+		case "TABLE_FORMAT":
+			errs = errors.Join(errs, d.Set("table_format", property.Value))
+		case "REFRESH_INTERVAL_SECONDS":
+			if _, ok := d.GetOk("refresh_interval_seconds"); ok {
+				refreshIntervalSeconds, err := strconv.Atoi(property.Value)
+				if err != nil {
+					errs = errors.Join(errs, err)
+					continue
+				}
+				errs = errors.Join(errs, d.Set("refresh_interval_seconds", refreshIntervalSeconds))
+			}
+		}
+	}
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateCatalogIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, unset := sdk.NewCatalogIntegrationSetRequest(), sdk.NewCatalogIntegrationUnsetRequest()
+	errs := errors.Join(
+		// REFRESH_INTERVAL_SECONDS can't be unset, so the Snowflake default is used as a fallback
+		intAttributeUnsetFallbackUpdateWithZeroDefault(d, "refresh_interval_seconds", &set.RefreshIntervalSeconds, 30),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	if d.HasChange("rest_authentication") {
+		restAuthentication, err := expandCatalogIntegrationRestAuthentication(d.Get("rest_authentication").([]any))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		set.WithRestAuthentication(*restAuthentication)
+	}
+
+	if (*set != sdk.CatalogIntegrationSetRequest{}) {
+		if err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.CatalogIntegrationUnsetRequest{}) {
+		if err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadCatalogIntegration(ctx, d, meta)
+}
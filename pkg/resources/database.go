@@ -88,6 +88,7 @@ var databaseSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the database.",
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -109,7 +110,7 @@ func Database() *schema.Resource {
 
 		Schema: collections.MergeMaps(databaseSchema, databaseParametersSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.Database, ImportName[sdk.AccountObjectIdentifier]),
+			StateContext: TrackingImportWrapper(resources.Database, importWithTags(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, ImportName[sdk.AccountObjectIdentifier])),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.Database, customdiff.All(
@@ -145,6 +146,10 @@ func CreateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	if parametersCreateDiags := handleDatabaseParametersCreate(d, opts); len(parametersCreateDiags) > 0 {
 		return parametersCreateDiags
 	}
+	opts.Tag, err = getTagsForCreate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	err = client.Databases.Create(ctx, id, opts)
 	if err != nil {
@@ -257,6 +262,10 @@ func UpdateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		id = newId
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeDatabase); err != nil {
+		return diag.FromErr(err)
+	}
+
 	databaseSetRequest := new(sdk.DatabaseSet)
 	databaseUnsetRequest := new(sdk.DatabaseUnset)
 
@@ -404,6 +413,10 @@ func ReadDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		return diag.FromErr(err)
 	}
 
+	if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeDatabase); err != nil {
+		return diag.FromErr(err)
+	}
+
 	sessionDetails, err := client.ContextFunctions.CurrentSessionDetails(ctx)
 	if err != nil {
 		return diag.FromErr(err)
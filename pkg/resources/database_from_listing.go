@@ -0,0 +1,185 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var databaseFromListingSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the database; must be unique for your account."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"listing": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "Global name of the listing from which the database is created (the `global_name` column of the `SHOW AVAILABLE LISTINGS` or `SHOW LISTINGS` output). The listing has to be shared with the current account.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the database.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW DATABASES` for the given database.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowDatabaseSchema,
+		},
+	},
+}
+
+func DatabaseFromListing() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.Databases.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.DatabaseFromListingResource), TrackingCreateWrapper(resources.DatabaseFromListing, CreateDatabaseFromListing)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.DatabaseFromListingResource), TrackingReadWrapper(resources.DatabaseFromListing, ReadDatabaseFromListing)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.DatabaseFromListingResource), TrackingUpdateWrapper(resources.DatabaseFromListing, UpdateDatabaseFromListing)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.DatabaseFromListingResource), TrackingDeleteWrapper(resources.DatabaseFromListing, deleteFunc)),
+		Description:   "Resource used to consume a listing by creating a database from it. For more information, check [listing documentation](https://other-docs.snowflake.com/en/collaboration/consumer-listings-access).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.DatabaseFromListing, customdiff.All(
+			ComputedIfAnyAttributeChanged(databaseFromListingSchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(databaseFromListingSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+
+		Schema: databaseFromListingSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.DatabaseFromListing, ImportName[sdk.AccountObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateDatabaseFromListing(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.Databases.CreateFromListing(ctx, id, d.Get("listing").(string), &sdk.CreateDatabaseFromListingOptions{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	// the comment cannot be set in CREATE DATABASE ... FROM LISTING
+	if v, ok := d.GetOk("comment"); ok {
+		if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{
+			Set: &sdk.DatabaseSet{
+				Comment: sdk.String(v.(string)),
+			},
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadDatabaseFromListing(ctx, d, meta)
+}
+
+func UpdateDatabaseFromListing(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{
+			NewName: &newId,
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{
+				Set: &sdk.DatabaseSet{
+					Comment: &comment,
+				},
+			}); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{
+				Unset: &sdk.DatabaseUnset{
+					Comment: sdk.Bool(true),
+				},
+			}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadDatabaseFromListing(ctx, d, meta)
+}
+
+func ReadDatabaseFromListing(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	database, err := client.Databases.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query database created from listing. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Database id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	// the listing is not read, because SHOW DATABASES does not return the global name of the listing
+	if errs := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("comment", database.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.DatabaseToSchema(database)}),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	return nil
+}
@@ -45,6 +45,7 @@ var databaseRoleSchema = map[string]*schema.Schema{
 			Schema: schemas.ShowDatabaseRoleSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -68,7 +69,7 @@ func DatabaseRole() *schema.Resource {
 
 		Schema: databaseRoleSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.DatabaseRole, ImportName[sdk.DatabaseObjectIdentifier]),
+			StateContext: TrackingImportWrapper(resources.DatabaseRole, importWithTags(sdk.ObjectTypeDatabaseRole, sdk.ParseDatabaseObjectIdentifier, ImportName[sdk.DatabaseObjectIdentifier])),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.DatabaseRole, customdiff.All(
@@ -119,6 +120,10 @@ func ReadDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) dia
 		return diag.FromErr(err)
 	}
 
+	if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeDatabaseRole); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err = d.Set(ShowOutputAttributeName, []map[string]any{schemas.DatabaseRoleToSchema(databaseRole)}); err != nil {
 		return diag.FromErr(err)
 	}
@@ -145,6 +150,10 @@ func CreateDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) d
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
 
+	if err := handleTagsCreate(ctx, client, d, id, sdk.ObjectTypeDatabaseRole); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return ReadDatabaseRole(ctx, d, meta)
 }
 
@@ -168,6 +177,10 @@ func UpdateDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) d
 		id = newId
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeDatabaseRole); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("comment") {
 		newComment := d.Get("comment").(string)
 		err := client.DatabaseRoles.Alter(ctx, sdk.NewAlterDatabaseRoleRequest(id).WithSet(*sdk.NewDatabaseRoleSetRequest(newComment)))
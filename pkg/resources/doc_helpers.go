@@ -72,3 +72,8 @@ func joinWithSpace(parts ...string) string {
 func exampleSchemaObjectIdentifier(schemaObjectName string) string {
 	return fmt.Sprintf("Example: `\"\\\"<db_name>\\\".\\\"<schema_name>\\\".\\\"<%s_name>\\\"\"`.", schemaObjectName)
 }
+
+func writeOnlyFieldDescription(description string, versionKey string) string {
+	hashKey := writeOnlyHashKey(strings.TrimSuffix(versionKey, "_version"))
+	return fmt.Sprintf("%s This is a [write-only attribute](https://developer.hashicorp.com/terraform/plugin/sdkv2/resources/write-only-arguments): its value is never persisted in the Terraform plan or state, and it requires Terraform 1.11 or later. The value is sent to Snowflake on creation, whenever it changes (which is detected with `%s`), and whenever `%s` changes.", description, hashKey, versionKey)
+}
@@ -5,19 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
-	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -25,287 +25,441 @@ import (
 var refreshModePattern = regexp.MustCompile(`refresh_mode = '(\w+)'`)
 
 var dynamicTableSchema = map[string]*schema.Schema{
-	"or_replace": {
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Description: "Specifies whether to replace the dynamic table if it already exists.",
-		Default:     false,
-	},
 	"name": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "Specifies the identifier (i.e. name) for the dynamic table; must be unique for the schema in which the dynamic table is created.",
-		ForceNew:    true,
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the dynamic table; must be unique for the schema in which the dynamic table is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
 	},
 	"database": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The database in which to create the dynamic table.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the dynamic table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
 	},
 	"schema": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The schema in which to create the dynamic table.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the dynamic table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"transient": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		ForceNew:      true,
+		ConflictsWith: []string{"iceberg"},
+		Description:   externalChangesNotDetectedFieldDescription("Specifies that the dynamic table is transient. Transient dynamic tables don't have a Fail-safe period."),
+	},
+	"iceberg": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"transient"},
+		Description:   externalChangesNotDetectedFieldDescription("Specifies that the dynamic table is an Iceberg table managed by Snowflake. Changing any of the values recreates the dynamic table."),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"external_volume": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					Description:      relatedResourceDescription("Specifies the external volume for the Iceberg table. If not set, the EXTERNAL_VOLUME parameter of the schema, database, or account is used.", resources.ExternalVolume),
+				},
+				"catalog": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Specifies the catalog for the Iceberg table. Only `SNOWFLAKE` is supported for dynamic Iceberg tables. If not set, the CATALOG parameter of the schema, database, or account is used.",
+				},
+				"base_location": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Specifies the path to a directory where Snowflake writes the data and metadata files for the table, relative to the external volume location.",
+				},
+			},
+		},
 	},
 	"target_lag": {
 		Type:        schema.TypeList,
-		Required:    true,
+		Optional:    true,
 		MaxItems:    1,
-		Description: "Specifies the target lag time for the dynamic table.",
+		Description: "Specifies the target lag time for the dynamic table. If not set, `DOWNSTREAM` is used (removing it from the configuration resets the target lag to `DOWNSTREAM`). Can't be set when `scheduler` is set to `DISABLE`.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"maximum_duration": {
-					Type:          schema.TypeString,
-					Optional:      true,
-					ConflictsWith: []string{"target_lag.downstream"},
-					Description:   "Specifies the maximum target lag time for the dynamic table.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: []string{"target_lag.0.maximum_duration", "target_lag.0.downstream"},
+					Description:  "Specifies the maximum target lag time for the dynamic table, e.g. `5 minutes`.",
 				},
 				"downstream": {
-					Type:          schema.TypeBool,
-					Optional:      true,
-					ConflictsWith: []string{"target_lag.maximum_duration"},
-					Description:   "Specifies whether the target lag time is downstream.",
+					Type:         schema.TypeBool,
+					Optional:     true,
+					ExactlyOneOf: []string{"target_lag.0.maximum_duration", "target_lag.0.downstream"},
+					Description:  "Specifies whether the target lag time is downstream.",
 				},
 			},
 		},
 	},
+	"scheduler": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToDynamicTableScheduler),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToDynamicTableScheduler),
+		Description:      externalChangesNotDetectedFieldDescription(fmt.Sprintf("Specifies whether the dynamic table is refreshed by the scheduler. With the scheduler disabled, the dynamic table is refreshed only manually or by the downstream objects, and `target_lag` can't be set. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllDynamicTableSchedulers))),
+	},
+	"started": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies if the dynamic table should be refreshed (resumed) or suspended.",
+	},
 	"warehouse": {
 		Type:             schema.TypeString,
 		Required:         true,
-		Description:      "The warehouse in which to create the dynamic table.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the warehouse that provides the compute resources for refreshing the dynamic table.", resources.Warehouse),
+	},
+	"initialization_warehouse": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
 		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      externalChangesNotDetectedFieldDescription(relatedResourceDescription("Specifies the warehouse used for the initializations and reinitializations of the dynamic table. If not set, `warehouse` is used.", resources.Warehouse)),
 	},
 	"query": {
 		Type:             schema.TypeString,
 		Required:         true,
-		ForceNew:         true,
-		Description:      "Specifies the query to use to populate the dynamic table.",
+		Description:      diffSuppressStatementFieldDescription("Specifies the query whose results the dynamic table should contain. The changes are applied with `CREATE OR ALTER DYNAMIC TABLE`, so the dynamic table is not recreated; note that Snowflake may still reinitialize it depending on the change."),
 		DiffSuppressFunc: DiffSuppressStatement,
 	},
-	"comment": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Specifies a comment for the dynamic table.",
-	},
 	"refresh_mode": {
-		Type:         schema.TypeString,
-		Optional:     true,
-		Default:      sdk.DynamicTableRefreshModeAuto,
-		Description:  "INCREMENTAL to use incremental refreshes, FULL to recompute the whole table on every refresh, or AUTO to let Snowflake decide.",
-		ValidateFunc: validation.StringInSlice(sdk.AsStringList(sdk.AllDynamicRefreshModes), true),
-		ForceNew:     true,
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Default:          string(sdk.DynamicTableRefreshModeAuto),
+		ValidateDiagFunc: sdkValidation(sdk.ToDynamicTableRefreshMode),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToDynamicTableRefreshMode),
+		Description:      fmt.Sprintf("Specifies the refresh mode for the dynamic table. Can only be set on creation. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllDynamicRefreshModes)),
 	},
 	"initialize": {
-		Type:         schema.TypeString,
-		Optional:     true,
-		Default:      sdk.DynamicTableInitializeOnCreate,
-		Description:  "Initialize trigger for the dynamic table. Can only be set on creation. Available options are ON_CREATE and ON_SCHEDULE.",
-		ValidateFunc: validation.StringInSlice(sdk.AsStringList(sdk.AllDynamicTableInitializes), true),
-		ForceNew:     true,
-	},
-	"created_on": {
-		Type:        schema.TypeString,
-		Description: "Time when this dynamic table was created.",
-		Computed:    true,
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Default:          string(sdk.DynamicTableInitializeOnCreate),
+		ValidateDiagFunc: sdkValidation(sdk.ToDynamicTableInitialize),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToDynamicTableInitialize),
+		Description:      fmt.Sprintf("Specifies the behavior of the initial refresh of the dynamic table. Can only be set on creation. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllDynamicTableInitializes)),
 	},
 	"cluster_by": {
-		Type:        schema.TypeString,
-		Description: "The clustering key for the dynamic table.",
-		Computed:    true,
-	},
-	"rows": {
-		Type:        schema.TypeInt,
-		Description: "Number of rows in the table.",
-		Computed:    true,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: externalChangesNotDetectedFieldDescription("Specifies one or more columns or column expressions in the dynamic table as the clustering key. The current clustering key is available in `show_output`."),
 	},
-	"bytes": {
-		Type:        schema.TypeInt,
-		Description: "Number of bytes that will be scanned if the entire dynamic table is scanned in a query.",
-		Computed:    true,
+	strings.ToLower(string(sdk.DynamicTableParameterDataRetentionTimeInDays)): {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Computed:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 90)),
+		Description:      enrichWithReferenceToParameterDocs(sdk.DynamicTableParameterDataRetentionTimeInDays, "Specifies the retention period for the dynamic table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on its historical data."),
 	},
-	"owner": {
-		Type:        schema.TypeString,
-		Description: "Role that owns the dynamic table.",
-		Computed:    true,
+	strings.ToLower(string(sdk.DynamicTableParameterMaxDataExtensionTimeInDays)): {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Computed:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 90)),
+		Description:      enrichWithReferenceToParameterDocs(sdk.DynamicTableParameterMaxDataExtensionTimeInDays, "Specifies the maximum number of days for which Snowflake can extend the data retention period for the dynamic table to prevent streams on it from becoming stale."),
 	},
-	"refresh_mode_reason": {
+	"immutable_where": {
 		Type:        schema.TypeString,
-		Description: "Explanation for why FULL refresh mode was chosen. NULL if refresh mode is not FULL.",
-		Computed:    true,
-	},
-	"automatic_clustering": {
-		Type:        schema.TypeBool,
-		Description: "Whether auto-clustering is enabled on the dynamic table. Not currently supported for dynamic tables.",
-		Computed:    true,
-	},
-	"scheduling_state": {
-		Type:        schema.TypeString,
-		Description: "Displays ACTIVE for dynamic tables that are actively scheduling refreshes and SUSPENDED for suspended dynamic tables.",
-		Computed:    true,
+		Optional:    true,
+		Description: externalChangesNotDetectedFieldDescription("Specifies a condition that marks the rows of the dynamic table as immutable. The rows matching the condition are not updated by the subsequent refreshes, e.g. `ts < CURRENT_TIMESTAMP() - INTERVAL '1 day'`."),
 	},
-	"last_suspended_on": {
+	"comment": {
 		Type:        schema.TypeString,
-		Description: "Timestamp of last suspension.",
-		Computed:    true,
-	},
-	"is_clone": {
-		Type:        schema.TypeBool,
-		Description: "TRUE if the dynamic table has been cloned, else FALSE.",
-		Computed:    true,
+		Optional:    true,
+		Description: "Specifies a comment for the dynamic table.",
 	},
-	"is_replica": {
-		Type:        schema.TypeBool,
-		Description: "TRUE if the dynamic table is a replica. else FALSE.",
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
 		Computed:    true,
+		Description: "Outputs the result of `SHOW DYNAMIC TABLES` for the given dynamic table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowDynamicTableSchema,
+		},
 	},
-	"data_timestamp": {
-		Type:        schema.TypeString,
-		Description: "Timestamp of the data in the base object(s) that is included in the dynamic table.",
+	ParametersAttributeName: {
+		Type:        schema.TypeList,
 		Computed:    true,
+		Description: "Outputs the result of `SHOW PARAMETERS IN TABLE` for the given dynamic table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowDynamicTableParametersSchema,
+		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
+func dynamicTableParametersProvider(ctx context.Context, d ResourceIdProvider, meta any) ([]*sdk.Parameter, error) {
+	return parametersProvider(ctx, d, meta.(*provider.Context), dynamicTableParametersProviderFunc, sdk.ParseSchemaObjectIdentifier)
+}
+
+func dynamicTableParametersProviderFunc(c *sdk.Client) showParametersFunc[sdk.SchemaObjectIdentifier] {
+	return c.DynamicTables.ShowParameters
+}
+
 func DynamicTable() *schema.Resource {
 	deleteFunc := ResourceDeleteContextFunc(
-		helpers.DecodeSnowflakeIDErrLegacy[sdk.SchemaObjectIdentifier],
+		sdk.ParseSchemaObjectIdentifier,
 		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
 			return client.DynamicTables.DropSafely
 		},
 	)
 
 	return &schema.Resource{
+		SchemaVersion: 1,
+
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.DynamicTableResource), TrackingCreateWrapper(resources.DynamicTable, CreateDynamicTable)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.DynamicTableResource), TrackingReadWrapper(resources.DynamicTable, ReadDynamicTable)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.DynamicTableResource), TrackingUpdateWrapper(resources.DynamicTable, UpdateDynamicTable)),
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.DynamicTableResource), TrackingDeleteWrapper(resources.DynamicTable, deleteFunc)),
+		Description:   "Resource used to manage dynamic tables. For more information, check [dynamic tables documentation](https://docs.snowflake.com/en/sql-reference/sql/create-dynamic-table).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.DynamicTable, customdiff.All(
+			ComputedIfAnyAttributeChanged(dynamicTableSchema, ShowOutputAttributeName, "name", "target_lag", "started", "warehouse", "query", "cluster_by", "comment"),
+			ComputedIfAnyAttributeChanged(dynamicTableSchema, ParametersAttributeName, strings.ToLower(string(sdk.DynamicTableParameterDataRetentionTimeInDays)), strings.ToLower(string(sdk.DynamicTableParameterMaxDataExtensionTimeInDays))),
+			ComputedIfAnyAttributeChanged(dynamicTableSchema, FullyQualifiedNameAttributeName, "name"),
+			ParametersCustomDiff(
+				dynamicTableParametersProvider,
+				parameter[sdk.DynamicTableParameter]{sdk.DynamicTableParameterDataRetentionTimeInDays, valueTypeInt, sdk.ParameterTypeTable},
+				parameter[sdk.DynamicTableParameter]{sdk.DynamicTableParameterMaxDataExtensionTimeInDays, valueTypeInt, sdk.ParameterTypeTable},
+			),
+		)),
 
 		Schema: dynamicTableSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: TrackingImportWrapper(resources.DynamicTable, importWithTags(sdk.ObjectTypeDynamicTable, sdk.ParseSchemaObjectIdentifier, ImportDynamicTable)),
+		},
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting type to cty.EmptyObject is a bit hacky here but following https://developer.hashicorp.com/terraform/plugin/framework/migrating/resources/state-upgrade#sdkv2-1 would require lots of repetitive code; this should work with cty.EmptyObject
+				Type:    cty.EmptyObject,
+				Upgrade: v2_12_0_DynamicTableStateUpgrader,
+			},
 		},
 		Timeouts: defaultTimeouts,
 	}
 }
 
-// ReadDynamicTable implements schema.ReadFunc.
-func ReadDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func ImportDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
 
-	id := helpers.DecodeSnowflakeIDLegacy(d.Id()).(sdk.SchemaObjectIdentifier)
-	dynamicTable, err := client.DynamicTables.ShowByIDSafely(ctx, id)
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](ctx, d, meta); err != nil {
+		return nil, err
+	}
+
+	dynamicTable, err := client.DynamicTables.ShowByID(ctx, id)
 	if err != nil {
-		if errors.Is(err, sdk.ErrObjectNotFound) {
-			d.SetId("")
-			return diag.Diagnostics{
-				diag.Diagnostic{
-					Severity: diag.Warning,
-					Summary:  "Failed to query dynamic table. Marking the resource as removed.",
-					Detail:   fmt.Sprintf("Dynamic table id: %s, Err: %s", id.FullyQualifiedName(), err),
-				},
-			}
-		}
-		return diag.FromErr(err)
+		return nil, err
+	}
+	// The target lag is set during the import, because DOWNSTREAM is not read when the target lag is not in the state.
+	if err := d.Set("target_lag", targetLagToSchema(dynamicTable.TargetLag)); err != nil {
+		return nil, err
 	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
 
-	if err := d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()); err != nil {
+	request, err := dynamicTableCreateRequest(d, id)
+	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("name", dynamicTable.Name); err != nil {
+	if err := attributeMappedValueCreateBuilder(d, "initialize", request.WithInitialize, sdk.ToDynamicTableInitialize); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("database", dynamicTable.DatabaseName); err != nil {
+	tags, err := getTagsForCreate(d)
+	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("schema", dynamicTable.SchemaName); err != nil {
-		return diag.FromErr(err)
+	if len(tags) > 0 {
+		request.WithTag(tags)
 	}
-	if err := d.Set("comment", dynamicTable.Comment); err != nil {
+
+	if err := client.DynamicTables.Create(ctx, request); err != nil {
 		return diag.FromErr(err)
 	}
-	tl := map[string]interface{}{}
-	if dynamicTable.TargetLag == "DOWNSTREAM" {
-		tl["downstream"] = true
-		if err := d.Set("target_lag", []interface{}{tl}); err != nil {
-			return diag.FromErr(err)
-		}
-	} else {
-		tl["maximum_duration"] = dynamicTable.TargetLag
-		if err := d.Set("target_lag", []interface{}{tl}); err != nil {
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if !d.Get("started").(bool) {
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithSuspend(sdk.Bool(true))); err != nil {
 			return diag.FromErr(err)
 		}
 	}
-	if strings.Contains(dynamicTable.Text, "OR REPLACE") {
-		if err := d.Set("or_replace", true); err != nil {
-			return diag.FromErr(err)
-		}
-	} else {
-		if err := d.Set("or_replace", false); err != nil {
-			return diag.FromErr(err)
-		}
+
+	return ReadDynamicTable(ctx, d, meta)
+}
+
+// dynamicTableCreateRequest builds the create request with the properties that can be also passed to CREATE OR ALTER DYNAMIC TABLE.
+func dynamicTableCreateRequest(d *schema.ResourceData, id sdk.SchemaObjectIdentifier) (*sdk.CreateDynamicTableRequest, error) {
+	request := sdk.NewCreateDynamicTableRequest(id, sdk.NewAccountObjectIdentifier(d.Get("warehouse").(string)), d.Get("query").(string))
+
+	// CREATE OR ALTER has to keep the kind of the table, otherwise it fails or converts the table.
+	if d.Get("transient").(bool) {
+		request.WithTransient(true)
 	}
-	if strings.Contains(dynamicTable.Text, "initialize = 'ON_CREATE'") {
-		if err := d.Set("initialize", "ON_CREATE"); err != nil {
-			return diag.FromErr(err)
-		}
-	} else if strings.Contains(dynamicTable.Text, "initialize = 'ON_SCHEDULE'") {
-		if err := d.Set("initialize", "ON_SCHEDULE"); err != nil {
-			return diag.FromErr(err)
-		}
+	if targetLag := dynamicTableTargetLag(d); targetLag != nil {
+		request.WithTargetLag(*targetLag)
 	}
-	m := refreshModePattern.FindStringSubmatch(dynamicTable.Text)
-	if len(m) > 1 {
-		if err := d.Set("refresh_mode", m[1]); err != nil {
-			return diag.FromErr(err)
-		}
+	if v := d.Get("iceberg").([]any); len(v) > 0 {
+		request.WithIceberg(parseDynamicTableIceberg(v))
 	}
-	if err := d.Set("created_on", dynamicTable.CreatedOn.Format(time.RFC3339)); err != nil {
-		return diag.FromErr(err)
+	if v := d.Get("cluster_by").([]any); len(v) > 0 {
+		request.WithClusterBy(expandStringList(v))
 	}
-	if err := d.Set("cluster_by", dynamicTable.ClusterBy); err != nil {
-		return diag.FromErr(err)
+
+	errs := errors.Join(
+		attributeMappedValueCreateBuilder(d, "scheduler", request.WithScheduler, sdk.ToDynamicTableScheduler),
+		attributeMappedValueCreateBuilder(d, "initialization_warehouse", request.WithInitializationWarehouse, stringToAccountObjectIdentifier),
+		attributeMappedValueCreateBuilder(d, "refresh_mode", request.WithRefreshMode, sdk.ToDynamicTableRefreshMode),
+		stringAttributeCreateBuilder(d, "immutable_where", request.WithImmutableWhere),
+		stringAttributeCreateBuilder(d, "comment", func(comment string) *sdk.CreateDynamicTableRequest { return request.WithComment(&comment) }),
+	)
+	if errs != nil {
+		return nil, errs
 	}
-	if err := d.Set("rows", dynamicTable.Rows); err != nil {
-		return diag.FromErr(err)
+
+	var dataRetentionTimeInDays, maxDataExtensionTimeInDays *int
+	if diags := JoinDiags(
+		handleParameterCreate(d, sdk.DynamicTableParameterDataRetentionTimeInDays, &dataRetentionTimeInDays),
+		handleParameterCreate(d, sdk.DynamicTableParameterMaxDataExtensionTimeInDays, &maxDataExtensionTimeInDays),
+	); diags.HasError() {
+		return nil, fmt.Errorf("handling the dynamic table parameters failed: %v", diags)
 	}
-	if err := d.Set("bytes", dynamicTable.Bytes); err != nil {
-		return diag.FromErr(err)
+	if dataRetentionTimeInDays != nil {
+		request.WithDataRetentionTimeInDays(*dataRetentionTimeInDays)
 	}
-	if err := d.Set("owner", dynamicTable.Owner); err != nil {
-		return diag.FromErr(err)
+	if maxDataExtensionTimeInDays != nil {
+		request.WithMaxDataExtensionTimeInDays(*maxDataExtensionTimeInDays)
 	}
-	if err := d.Set("refresh_mode_reason", dynamicTable.RefreshModeReason); err != nil {
-		return diag.FromErr(err)
+	return request, nil
+}
+
+// dynamicTableTargetLag returns the target lag from the configuration. When it is not set, DOWNSTREAM is used,
+// unless the scheduler is disabled (then the target lag can't be set at all).
+func dynamicTableTargetLag(d *schema.ResourceData) *sdk.TargetLag {
+	if v := d.Get("target_lag").([]any); len(v) > 0 {
+		targetLag := parseTargetLag(v)
+		return &targetLag
 	}
-	if err := d.Set("automatic_clustering", dynamicTable.AutomaticClustering); err != nil {
-		return diag.FromErr(err)
+	if scheduler, err := sdk.ToDynamicTableScheduler(d.Get("scheduler").(string)); err == nil && scheduler == sdk.DynamicTableSchedulerDisable {
+		return nil
 	}
-	if err := d.Set("scheduling_state", string(dynamicTable.SchedulingState)); err != nil {
-		return diag.FromErr(err)
+	return &sdk.TargetLag{Downstream: sdk.Bool(true)}
+}
+
+func parseTargetLag(v []any) sdk.TargetLag {
+	var result sdk.TargetLag
+	tl, ok := v[0].(map[string]any)
+	if !ok {
+		return result
 	}
-	/*
-		guides on time formatting
-		https://docs.snowflake.com/en/user-guide/date-time-input-output
-		https://pkg.go.dev/time
-		note: format may depend on what the account parameter for TIMESTAMP_OUTPUT_FORMAT is set to. Perhaps we should return this as a string rather than a time.Time?
-	*/
-	if err := d.Set("last_suspended_on", dynamicTable.LastSuspendedOn.Format("2006-01-02T16:04:05.000 -0700")); err != nil {
-		return diag.FromErr(err)
+	if downstream, ok := tl["downstream"]; ok && downstream.(bool) {
+		result.Downstream = sdk.Bool(true)
+	} else if maximumDuration, ok := tl["maximum_duration"]; ok {
+		result.MaximumDuration = sdk.String(maximumDuration.(string))
+	}
+	return result
+}
+
+func parseDynamicTableIceberg(v []any) sdk.DynamicTableIcebergRequest {
+	request := sdk.NewDynamicTableIcebergRequest()
+	iceberg, ok := v[0].(map[string]any)
+	if !ok {
+		return *request
+	}
+	if externalVolume := iceberg["external_volume"].(string); externalVolume != "" {
+		request.WithExternalVolume(sdk.NewAccountObjectIdentifier(externalVolume))
+	}
+	if catalog := iceberg["catalog"].(string); catalog != "" {
+		request.WithCatalog(catalog)
+	}
+	if baseLocation := iceberg["base_location"].(string); baseLocation != "" {
+		request.WithBaseLocation(baseLocation)
 	}
-	if err := d.Set("is_clone", dynamicTable.IsClone); err != nil {
+	return *request
+}
+
+func ReadDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("is_replica", dynamicTable.IsReplica); err != nil {
+
+	dynamicTable, err := client.DynamicTables.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query dynamic table. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Dynamic table id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
 		return diag.FromErr(err)
 	}
-	if err := d.Set("data_timestamp", dynamicTable.DataTimestamp.Format("2006-01-02T16:04:05.000 -0700")); err != nil {
+
+	parameters, err := client.DynamicTables.ShowParameters(ctx, id)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("warehouse", dynamicTable.Warehouse); err != nil {
+	if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeDynamicTable); err != nil {
 		return diag.FromErr(err)
 	}
 
+	// When the scheduler is disabled, the target lag is not used, so it's not read to avoid differences with the configuration.
+	// DOWNSTREAM is used when the target lag is not set, so it's not read either when the target lag is not in the state.
+	if scheduler, err := sdk.ToDynamicTableScheduler(d.Get("scheduler").(string)); err != nil || scheduler != sdk.DynamicTableSchedulerDisable {
+		if len(d.Get("target_lag").([]any)) > 0 || !strings.EqualFold(dynamicTable.TargetLag, "DOWNSTREAM") {
+			if err := d.Set("target_lag", targetLagToSchema(dynamicTable.TargetLag)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("started", dynamicTable.SchedulingState == sdk.DynamicTableSchedulingStateActive),
+		d.Set("warehouse", dynamicTable.Warehouse),
+		d.Set("comment", dynamicTable.Comment),
+		handleDynamicTableParameterRead(d, parameters),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.DynamicTableToSchema(dynamicTable)}),
+		d.Set(ParametersAttributeName, []map[string]any{schemas.DynamicTableParametersToSchema(parameters)}),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
 	if dynamicTable.Text == "" {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -322,8 +476,22 @@ func ReadDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) dia
 		}
 	}
 
-	extractor := snowflake.NewViewSelectStatementExtractor(dynamicTable.Text)
-	query, err := extractor.ExtractDynamicTable()
+	if strings.Contains(dynamicTable.Text, "initialize = 'ON_CREATE'") {
+		if err := d.Set("initialize", string(sdk.DynamicTableInitializeOnCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if strings.Contains(dynamicTable.Text, "initialize = 'ON_SCHEDULE'") {
+		if err := d.Set("initialize", string(sdk.DynamicTableInitializeOnSchedule)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if m := refreshModePattern.FindStringSubmatch(dynamicTable.Text); len(m) > 1 {
+		if err := d.Set("refresh_mode", m[1]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	query, err := snowflake.NewViewSelectStatementExtractor(dynamicTable.Text).ExtractDynamicTable()
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -334,89 +502,186 @@ func ReadDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) dia
 	return nil
 }
 
-func parseTargetLag(v interface{}) sdk.TargetLag {
-	var result sdk.TargetLag
-	tl := v.([]interface{})[0].(map[string]interface{})
-	if v, ok := tl["maximum_duration"]; ok {
-		result.MaximumDuration = sdk.String(v.(string))
+func targetLagToSchema(targetLag string) []any {
+	switch {
+	case targetLag == "":
+		return nil
+	case strings.EqualFold(targetLag, "DOWNSTREAM"):
+		return []any{map[string]any{"downstream": true}}
+	default:
+		return []any{map[string]any{"maximum_duration": targetLag}}
 	}
-	if v, ok := tl["downstream"]; ok && v.(bool) {
-		result.MaximumDuration = nil
-		result.Downstream = sdk.Bool(v.(bool))
+}
+
+func handleDynamicTableParameterRead(d *schema.ResourceData, parameters []*sdk.Parameter) error {
+	for _, parameter := range parameters {
+		switch parameter.Key {
+		case
+			string(sdk.DynamicTableParameterDataRetentionTimeInDays),
+			string(sdk.DynamicTableParameterMaxDataExtensionTimeInDays):
+			value, err := strconv.Atoi(parameter.Value)
+			if err != nil {
+				return err
+			}
+			if err := d.Set(strings.ToLower(parameter.Key), value); err != nil {
+				return err
+			}
+		}
 	}
-	return result
+	return nil
 }
 
-// CreateDynamicTable implements schema.CreateFunc.
-func CreateDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func UpdateDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	databaseName := d.Get("database").(string)
-	schemaName := d.Get("schema").(string)
-	name := d.Get("name").(string)
-	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
 
-	warehouse := sdk.NewAccountObjectIdentifier(d.Get("warehouse").(string))
-	tl := parseTargetLag(d.Get("target_lag"))
-	query := d.Get("query").(string)
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming dynamic table %v err = %w", d.Id(), err))
+		}
 
-	request := sdk.NewCreateDynamicTableRequest(id, warehouse, tl, query)
-	if v, ok := d.GetOk("comment"); ok {
-		request.WithComment(sdk.String(v.(string)))
-	}
-	if v, ok := d.GetOk("or_replace"); ok && v.(bool) {
-		request.WithOrReplace(true)
-	}
-	if v, ok := d.GetOk("refresh_mode"); ok {
-		request.WithRefreshMode(sdk.DynamicTableRefreshMode(v.(string)))
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
 	}
-	if v, ok := d.GetOk("initialize"); ok {
-		request.WithInitialize(sdk.DynamicTableInitialize(v.(string)))
+
+	if d.HasChange("query") {
+		// CREATE OR ALTER applies the whole configuration at once, so the remaining properties don't have to be altered separately.
+		request, err := dynamicTableCreateRequest(d, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := client.DynamicTables.Create(ctx, request.WithOrAlter(true)); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if diags := updateDynamicTableProperties(ctx, client, d, id); diags != nil {
+		return diags
 	}
-	if err := client.DynamicTables.Create(ctx, request); err != nil {
+
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeDynamicTable); err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	if d.HasChange("started") {
+		request := sdk.NewAlterDynamicTableRequest(id)
+		if d.Get("started").(bool) {
+			request.WithResume(sdk.Bool(true))
+		} else {
+			request.WithSuspend(sdk.Bool(true))
+		}
+		if err := client.DynamicTables.Alter(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return ReadDynamicTable(ctx, d, meta)
 }
 
-// UpdateDynamicTable implements schema.UpdateFunc.
-func UpdateDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*provider.Context).Client
-	id := helpers.DecodeSnowflakeIDLegacy(d.Id()).(sdk.SchemaObjectIdentifier)
-	request := sdk.NewAlterDynamicTableRequest(id)
+func updateDynamicTableProperties(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier) diag.Diagnostics {
+	set, unset := sdk.NewDynamicTableSetRequest(), sdk.NewDynamicTableUnsetRequest()
+	runSet, runUnset := false, false
 
-	runSet := false
-	set := sdk.NewDynamicTableSetRequest()
 	if d.HasChange("target_lag") {
-		tl := parseTargetLag(d.Get("target_lag"))
-		set.WithTargetLag(tl)
+		// Removing the target lag from the configuration resets it to DOWNSTREAM (nothing is set when the scheduler is disabled).
+		if targetLag := dynamicTableTargetLag(d); targetLag != nil {
+			set.WithTargetLag(*targetLag)
+			runSet = true
+		}
+	}
+	if d.HasChange("scheduler") {
+		scheduler := sdk.DynamicTableSchedulerEnable
+		if v := d.Get("scheduler").(string); v != "" {
+			parsed, err := sdk.ToDynamicTableScheduler(v)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			scheduler = parsed
+		}
+		set.WithScheduler(scheduler)
 		runSet = true
 	}
-
 	if d.HasChange("warehouse") {
-		warehouseName := d.Get("warehouse").(string)
-		set.WithWarehouse(sdk.NewAccountObjectIdentifier(warehouseName))
+		set.WithWarehouse(sdk.NewAccountObjectIdentifier(d.Get("warehouse").(string)))
 		runSet = true
 	}
+	if d.HasChange("initialization_warehouse") {
+		if v := d.Get("initialization_warehouse").(string); v != "" {
+			set.WithInitializationWarehouse(sdk.NewAccountObjectIdentifier(v))
+			runSet = true
+		} else {
+			unset.WithInitializationWarehouse(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("immutable_where") {
+		if v := d.Get("immutable_where").(string); v != "" {
+			set.WithImmutableWhere(v)
+			runSet = true
+		} else {
+			unset.WithImmutableWhere(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); v != "" {
+			set.WithComment(v)
+			runSet = true
+		} else {
+			unset.WithComment(true)
+			runUnset = true
+		}
+	}
+
+	var dataRetentionTimeInDays, maxDataExtensionTimeInDays *int
+	var unsetDataRetentionTimeInDays, unsetMaxDataExtensionTimeInDays *bool
+	if diags := JoinDiags(
+		handleParameterUpdate(d, sdk.DynamicTableParameterDataRetentionTimeInDays, &dataRetentionTimeInDays, &unsetDataRetentionTimeInDays),
+		handleParameterUpdate(d, sdk.DynamicTableParameterMaxDataExtensionTimeInDays, &maxDataExtensionTimeInDays, &unsetMaxDataExtensionTimeInDays),
+	); diags.HasError() {
+		return diags
+	}
+	if dataRetentionTimeInDays != nil {
+		set.WithDataRetentionTimeInDays(*dataRetentionTimeInDays)
+		runSet = true
+	}
+	if maxDataExtensionTimeInDays != nil {
+		set.WithMaxDataExtensionTimeInDays(*maxDataExtensionTimeInDays)
+		runSet = true
+	}
+	if unsetDataRetentionTimeInDays != nil {
+		unset.WithDataRetentionTimeInDays(true)
+		runUnset = true
+	}
+	if unsetMaxDataExtensionTimeInDays != nil {
+		unset.WithMaxDataExtensionTimeInDays(true)
+		runUnset = true
+	}
 
 	if runSet {
-		request.WithSet(set)
-		if err := client.DynamicTables.Alter(ctx, request); err != nil {
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithUnset(unset)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	if d.HasChange("comment") {
-		err := client.Comments.Set(ctx, &sdk.SetCommentOptions{
-			ObjectType: sdk.ObjectTypeDynamicTable,
-			ObjectName: id,
-			Value:      sdk.String(d.Get("comment").(string)),
-		})
-		if err != nil {
+	if d.HasChange("cluster_by") {
+		request := sdk.NewAlterDynamicTableRequest(id)
+		if v := d.Get("cluster_by").([]any); len(v) > 0 {
+			request.WithClusterBy(expandStringList(v))
+		} else {
+			request.WithDropClusteringKey(true)
+		}
+		if err := client.DynamicTables.Alter(ctx, request); err != nil {
 			return diag.FromErr(err)
 		}
 	}
-	return ReadDynamicTable(ctx, d, meta)
+	return nil
 }
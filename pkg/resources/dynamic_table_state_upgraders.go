@@ -0,0 +1,19 @@
+package resources
+
+import (
+	"context"
+)
+
+func v2_12_0_DynamicTableStateUpgrader(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	delete(rawState, "or_replace")
+	for _, showOutputField := range []string{"created_on", "cluster_by", "rows", "bytes", "owner", "refresh_mode_reason", "automatic_clustering", "scheduling_state", "last_suspended_on", "is_clone", "is_replica", "data_timestamp"} {
+		delete(rawState, showOutputField)
+	}
+	rawState["started"] = true
+
+	return migratePipeSeparatedObjectIdentifierResourceIdToFullyQualifiedName(ctx, rawState, meta)
+}
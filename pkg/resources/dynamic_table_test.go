@@ -0,0 +1,111 @@
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_dynamicTableCreateRequest(t *testing.T) {
+	id := sdk.NewSchemaObjectIdentifier("database", "schema", "name")
+	warehouseId := sdk.NewAccountObjectIdentifier("warehouse")
+	query := "select 1"
+
+	t.Run("transient is kept for create or alter", func(t *testing.T) {
+		d := resourceDataWithRawConfig(t, dynamicTableSchema, map[string]any{
+			"warehouse": warehouseId.Name(),
+			"query":     query,
+			"transient": true,
+			"target_lag": []any{map[string]any{
+				"maximum_duration": "2 minutes",
+			}},
+		})
+
+		request, err := dynamicTableCreateRequest(d, id)
+		require.NoError(t, err)
+
+		expected := sdk.NewCreateDynamicTableRequest(id, warehouseId, query).
+			WithTransient(true).
+			WithTargetLag(sdk.TargetLag{MaximumDuration: sdk.String("2 minutes")}).
+			WithRefreshMode(sdk.DynamicTableRefreshModeAuto)
+		assert.Equal(t, expected, request)
+		assert.Equal(t, expected.WithOrAlter(true), request.WithOrAlter(true))
+	})
+
+	t.Run("not transient", func(t *testing.T) {
+		d := resourceDataWithRawConfig(t, dynamicTableSchema, map[string]any{
+			"warehouse": warehouseId.Name(),
+			"query":     query,
+			"target_lag": []any{map[string]any{
+				"downstream": true,
+			}},
+		})
+
+		request, err := dynamicTableCreateRequest(d, id)
+		require.NoError(t, err)
+
+		expected := sdk.NewCreateDynamicTableRequest(id, warehouseId, query).
+			WithTargetLag(sdk.TargetLag{Downstream: sdk.Bool(true)}).
+			WithRefreshMode(sdk.DynamicTableRefreshModeAuto)
+		assert.Equal(t, expected, request)
+	})
+}
+
+func Test_dynamicTableTargetLag(t *testing.T) {
+	t.Run("maximum duration", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, dynamicTableSchema, map[string]any{
+			"target_lag": []any{map[string]any{
+				"maximum_duration": "2 minutes",
+			}},
+		})
+
+		assert.Equal(t, &sdk.TargetLag{MaximumDuration: sdk.String("2 minutes")}, dynamicTableTargetLag(d))
+	})
+
+	t.Run("downstream", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, dynamicTableSchema, map[string]any{
+			"target_lag": []any{map[string]any{
+				"downstream": true,
+			}},
+		})
+
+		assert.Equal(t, &sdk.TargetLag{Downstream: sdk.Bool(true)}, dynamicTableTargetLag(d))
+	})
+
+	t.Run("removed target lag is reset to downstream", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, dynamicTableSchema, map[string]any{})
+
+		assert.Equal(t, &sdk.TargetLag{Downstream: sdk.Bool(true)}, dynamicTableTargetLag(d))
+	})
+
+	t.Run("removed target lag with the scheduler disabled", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, dynamicTableSchema, map[string]any{
+			"scheduler": "disable",
+		})
+
+		assert.Nil(t, dynamicTableTargetLag(d))
+	})
+}
+
+// resourceDataWithRawConfig works like schema.TestResourceDataRaw, but additionally sets up the raw config,
+// so the functions using GetRawConfig (e.g. the parameter handling) can be tested.
+func resourceDataWithRawConfig(t *testing.T, resourceSchema map[string]*schema.Schema, raw map[string]any) *schema.ResourceData {
+	t.Helper()
+	resource := &schema.Resource{Schema: resourceSchema}
+
+	rawJson, err := json.Marshal(raw)
+	require.NoError(t, err)
+	rawConfig, err := ctyjson.Unmarshal(rawJson, resource.CoreConfigSchema().ImpliedType())
+	require.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, raw)
+	d.SetId("id")
+	state := d.State()
+	state.RawConfig = rawConfig
+	return resource.Data(state)
+}
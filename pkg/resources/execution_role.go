@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const ExecutionRoleAttributeName = "execution_role"
+
+var executionRoleSchema = &schema.Schema{
+	Type:             schema.TypeString,
+	Optional:         true,
+	ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	DiffSuppressFunc: suppressIdentifierQuoting,
+	Description: joinWithSpace(
+		"Specifies the role used to run all the statements of this resource instead of the provider `role`, so that the object is created and owned by this role.",
+		"The role has to be granted to the provider user. The statements are run on a dedicated connection after `USE ROLE`, so the secondary roles of the session still apply.",
+		"Changing this field does not transfer the ownership of the existing object; use `snowflake_grant_ownership` or recreate the object for that.",
+		"The import is run with the provider `role` (the configuration is not available during the import), so the object has to be visible to it; the plans (including the custom diffs reading the object, e.g. its parameters) use the execution role.",
+	),
+}
+
+// WithExecutionRole adds the execution_role attribute to the given resource and runs its create, read, update, and delete operations,
+// its custom diff, and its import with a client bound to that role, when the attribute is set. The resource schema map is copied,
+// so the original resource definition is not changed.
+func WithExecutionRole(resource *schema.Resource) *schema.Resource {
+	resourceSchema := maps.Clone(resource.Schema)
+	resourceSchema[ExecutionRoleAttributeName] = executionRoleSchema
+	resource.Schema = resourceSchema
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = executionRoleWrapper(resource.CreateContext)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = executionRoleWrapper(resource.ReadContext)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = executionRoleWrapper(resource.UpdateContext)
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = executionRoleWrapper(resource.DeleteContext)
+	}
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = executionRoleCustomDiffWrapper(resource.CustomizeDiff)
+	}
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		importer := *resource.Importer
+		importer.StateContext = executionRoleImportWrapper(importer.StateContext)
+		resource.Importer = &importer
+	}
+	return resource
+}
+
+func executionRoleWrapper(implementation func(context.Context, *schema.ResourceData, any) diag.Diagnostics) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		roleMeta, release, err := executionRoleMeta(ctx, d.GetOk, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer release()
+		return implementation(ctx, d, roleMeta)
+	}
+}
+
+// executionRoleCustomDiffWrapper runs the custom diff (e.g. ParametersCustomDiff reading the current parameters) with the execution role.
+func executionRoleCustomDiffWrapper(customDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+		roleMeta, release, err := executionRoleMeta(ctx, diff.GetOk, meta)
+		if err != nil {
+			return err
+		}
+		defer release()
+		return customDiff(ctx, diff, roleMeta)
+	}
+}
+
+// executionRoleImportWrapper runs the import with the execution role. The configuration is not available during the import,
+// so the role is used only when the attribute is already present in the imported data.
+func executionRoleImportWrapper(importer schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		roleMeta, release, err := executionRoleMeta(ctx, d.GetOk, meta)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return importer(ctx, d, roleMeta)
+	}
+}
+
+// executionRoleClient returns the client bound to the given role; it is a variable, so it can be replaced in the tests.
+var executionRoleClient = func(ctx context.Context, client *sdk.Client, roleId sdk.AccountObjectIdentifier) (*sdk.Client, func(), error) {
+	return client.WithExecutionRole(ctx, roleId)
+}
+
+// executionRoleMeta returns the provider context with the client bound to the execution role, or the unchanged meta when the role is not set.
+// The returned function releases the role client and has to be always called.
+func executionRoleMeta(ctx context.Context, getOk func(string) (any, bool), meta any) (any, func(), error) {
+	executionRole, ok := getOk(ExecutionRoleAttributeName)
+	if !ok {
+		return meta, func() {}, nil
+	}
+	roleId, err := sdk.ParseAccountObjectIdentifier(executionRole.(string))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	providerCtx := meta.(*provider.Context)
+	roleClient, release, err := executionRoleClient(ctx, providerCtx.Client, roleId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error using execution role %s, err = %w", roleId.FullyQualifiedName(), err)
+	}
+
+	roleProviderCtx := *providerCtx
+	roleProviderCtx.Client = roleClient
+	return &roleProviderCtx, release, nil
+}
@@ -0,0 +1,123 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WithExecutionRole(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+	newResource := func(readImplementation schema.ReadContextFunc) *schema.Resource {
+		return &schema.Resource{
+			Schema:      resourceSchema,
+			ReadContext: readImplementation,
+		}
+	}
+
+	t.Run("execution role attribute is added without changing the original schema", func(t *testing.T) {
+		resource := WithExecutionRole(newResource(nil))
+
+		require.Contains(t, resource.Schema, ExecutionRoleAttributeName)
+		require.Contains(t, resource.Schema, "name")
+		require.NotContains(t, resourceSchema, ExecutionRoleAttributeName)
+		require.Nil(t, resource.ReadContext)
+		require.Nil(t, resource.CreateContext)
+	})
+
+	t.Run("meta is passed unchanged without execution role", func(t *testing.T) {
+		providerCtx := &provider.Context{}
+		var receivedMeta any
+		resource := WithExecutionRole(newResource(func(_ context.Context, _ *schema.ResourceData, meta any) diag.Diagnostics {
+			receivedMeta = meta
+			return nil
+		}))
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{"name": "test"})
+
+		diags := resource.ReadContext(context.Background(), d, providerCtx)
+
+		require.Empty(t, diags)
+		require.Same(t, providerCtx, receivedMeta)
+	})
+
+	t.Run("invalid execution role", func(t *testing.T) {
+		resource := WithExecutionRole(newResource(func(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+			t.Fatal("implementation should not be called")
+			return nil
+		}))
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{"name": "test", ExecutionRoleAttributeName: `"a"."b"`})
+
+		diags := resource.ReadContext(context.Background(), d, &provider.Context{})
+
+		require.True(t, diags.HasError())
+	})
+
+	t.Run("custom diff and import use the execution role", func(t *testing.T) {
+		providerCtx := &provider.Context{Client: &sdk.Client{}}
+		roleClient := &sdk.Client{}
+		var usedRoles []string
+		released := 0
+		originalExecutionRoleClient := executionRoleClient
+		t.Cleanup(func() { executionRoleClient = originalExecutionRoleClient })
+		executionRoleClient = func(_ context.Context, client *sdk.Client, roleId sdk.AccountObjectIdentifier) (*sdk.Client, func(), error) {
+			require.Same(t, providerCtx.Client, client)
+			usedRoles = append(usedRoles, roleId.Name())
+			return roleClient, func() { released++ }, nil
+		}
+
+		var customDiffClient, importClient *sdk.Client
+		resource := WithExecutionRole(&schema.Resource{
+			Schema: resourceSchema,
+			CustomizeDiff: func(_ context.Context, _ *schema.ResourceDiff, meta any) error {
+				customDiffClient = meta.(*provider.Context).Client
+				return nil
+			},
+			Importer: &schema.ResourceImporter{
+				StateContext: func(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+					importClient = meta.(*provider.Context).Client
+					return []*schema.ResourceData{d}, nil
+				},
+			},
+		})
+
+		_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{"name": "test", ExecutionRoleAttributeName: "diff_role"}), providerCtx)
+		require.NoError(t, err)
+		require.Same(t, roleClient, customDiffClient)
+
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{"name": "test", ExecutionRoleAttributeName: "import_role"})
+		_, err = resource.Importer.StateContext(context.Background(), d, providerCtx)
+		require.NoError(t, err)
+		require.Same(t, roleClient, importClient)
+
+		require.Equal(t, []string{"diff_role", "import_role"}, usedRoles)
+		require.Equal(t, 2, released)
+	})
+
+	t.Run("custom diff uses the provider client without execution role", func(t *testing.T) {
+		providerCtx := &provider.Context{Client: &sdk.Client{}}
+		var customDiffMeta any
+		resource := WithExecutionRole(&schema.Resource{
+			Schema: resourceSchema,
+			CustomizeDiff: func(_ context.Context, _ *schema.ResourceDiff, meta any) error {
+				customDiffMeta = meta
+				return nil
+			},
+		})
+
+		_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{"name": "test"}), providerCtx)
+
+		require.NoError(t, err)
+		require.Same(t, providerCtx, customDiffMeta)
+	})
+}
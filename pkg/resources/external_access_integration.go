@@ -0,0 +1,267 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalAccessIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the external access integration; must be unique in your account."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"allowed_network_rules": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Required:         true,
+		MinItems:         1,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_network_rules"),
+		Description:      relatedResourceDescription("Specifies the fully qualified names of the egress network rules that contain the network locations the external access integration allows access to.", resources.NetworkRule),
+	},
+	"allowed_api_authentication_integrations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_api_authentication_integrations"),
+		Description:      "Specifies the names of the API authentication security integrations whose OAuth flows can be used by the UDFs and procedures using the external access integration (e.g. `snowflake_api_authentication_integration_with_client_credentials`).",
+	},
+	"allowed_authentication_secrets": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_authentication_secrets"),
+		Description:      "Specifies the fully qualified names of the secrets that the UDFs and procedures using the external access integration can use (e.g. `snowflake_secret_with_generic_string`).",
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies whether the external access integration is enabled.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external access integration.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW EXTERNAL ACCESS INTEGRATIONS` for the given external access integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowExternalAccessIntegrationSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE EXTERNAL ACCESS INTEGRATION` for the given external access integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeExternalAccessIntegrationSchema,
+		},
+	},
+}
+
+func ExternalAccessIntegration() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.ExternalAccessIntegrations.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingCreateWrapper(resources.ExternalAccessIntegration, CreateExternalAccessIntegration)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingReadWrapper(resources.ExternalAccessIntegration, ReadExternalAccessIntegration)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingUpdateWrapper(resources.ExternalAccessIntegration, UpdateExternalAccessIntegration)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingDeleteWrapper(resources.ExternalAccessIntegration, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage external access integrations. For more information, check [external access integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration).",
+			"The integration can be referenced in the `external_access_integrations` field of the functions, procedures, streamlits, and services.",
+		),
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ExternalAccessIntegration, customdiff.All(
+			// The allowed_* sets are not listed for the same reasons as in the network policy resource (check the comment there).
+			ComputedIfAnyAttributeChanged(externalAccessIntegrationSchema, ShowOutputAttributeName, "enabled", "comment"),
+			ComputedIfAnyAttributeChanged(externalAccessIntegrationSchema, DescribeOutputAttributeName, "enabled", "comment"),
+		)),
+
+		Schema: externalAccessIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ExternalAccessIntegration, ImportName[sdk.AccountObjectIdentifier]),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	allowedNetworkRules, err := parseSchemaObjectIdentifierSet(d.Get("allowed_network_rules"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateExternalAccessIntegrationRequest(id, allowedNetworkRules, d.Get("enabled").(bool))
+	if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+		allowedApiAuthenticationIntegrations, err := collections.MapErr(expandStringList(v.(*schema.Set).List()), sdk.ParseAccountObjectIdentifier)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithAllowedApiAuthenticationIntegrations(allowedApiAuthenticationIntegrations)
+	}
+	if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+		allowedAuthenticationSecrets, err := parseSchemaObjectIdentifierSet(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithAllowedAuthenticationSecrets(allowedAuthenticationSecrets)
+	}
+	if err := stringAttributeCreateBuilder(d, "comment", request.WithComment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.ExternalAccessIntegrations.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating external access integration %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadExternalAccessIntegration(ctx, d, meta)
+}
+
+func ReadExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	externalAccessIntegration, err := client.ExternalAccessIntegrations.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query external access integration. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("External access integration id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	properties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	details, err := sdk.ParseExternalAccessIntegrationDetails(properties)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if errs := errors.Join(
+		d.Set("name", externalAccessIntegration.Name),
+		d.Set("allowed_network_rules", collections.Map(details.AllowedNetworkRules, sdk.SchemaObjectIdentifier.FullyQualifiedName)),
+		d.Set("allowed_api_authentication_integrations", collections.Map(details.AllowedApiAuthenticationIntegrations, sdk.AccountObjectIdentifier.FullyQualifiedName)),
+		d.Set("allowed_authentication_secrets", collections.Map(details.AllowedAuthenticationSecrets, sdk.SchemaObjectIdentifier.FullyQualifiedName)),
+		d.Set("enabled", externalAccessIntegration.Enabled),
+		d.Set("comment", externalAccessIntegration.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ExternalAccessIntegrationToSchema(externalAccessIntegration)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.DescribeExternalAccessIntegrationToSchema(properties)}),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, unset := sdk.NewExternalAccessIntegrationSetRequest(), sdk.NewExternalAccessIntegrationUnsetRequest()
+
+	if d.HasChange("allowed_network_rules") {
+		allowedNetworkRules, err := parseSchemaObjectIdentifierSet(d.Get("allowed_network_rules"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		set.WithAllowedNetworkRules(allowedNetworkRules)
+	}
+
+	if d.HasChange("allowed_api_authentication_integrations") {
+		if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+			allowedApiAuthenticationIntegrations, err := collections.MapErr(expandStringList(v.(*schema.Set).List()), sdk.ParseAccountObjectIdentifier)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			set.WithAllowedApiAuthenticationIntegrations(allowedApiAuthenticationIntegrations)
+		} else {
+			unset.WithAllowedApiAuthenticationIntegrations(true)
+		}
+	}
+
+	if d.HasChange("allowed_authentication_secrets") {
+		if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+			allowedAuthenticationSecrets, err := parseSchemaObjectIdentifierSet(v)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			set.WithAllowedAuthenticationSecrets(allowedAuthenticationSecrets)
+		} else {
+			unset.WithAllowedAuthenticationSecrets(true)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		set.WithEnabled(d.Get("enabled").(bool))
+	}
+
+	if err := stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !reflect.DeepEqual(*set, sdk.ExternalAccessIntegrationSetRequest{}) {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for external access integration %s, err = %w", d.Id(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unset, sdk.ExternalAccessIntegrationUnsetRequest{}) {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for external access integration %s, err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadExternalAccessIntegration(ctx, d, meta)
+}
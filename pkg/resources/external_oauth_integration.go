@@ -151,6 +151,7 @@ var externalOauthIntegrationSchema = map[string]*schema.Schema{
 			Schema: schemas.ShowExternalOauthParametersSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -176,7 +177,7 @@ func ExternalOauthIntegration() *schema.Resource {
 				"comment"),
 		)),
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.ExternalOauthSecurityIntegration, ImportExternalOauthIntegration),
+			StateContext: TrackingImportWrapper(resources.ExternalOauthSecurityIntegration, importWithTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ImportExternalOauthIntegration)),
 		},
 
 		SchemaVersion: 1,
@@ -402,6 +403,9 @@ func CreateContextExternalOauthIntegration(ctx context.Context, d *schema.Resour
 	}
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
+	if err := handleTagsCreate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return ReadContextExternalOauthIntegration(false)(ctx, d, meta)
 }
@@ -564,6 +568,10 @@ func ReadContextExternalOauthIntegration(withExternalChangesMarking bool) schema
 		if err = d.Set(RelatedParametersAttributeName, []map[string]any{schemas.ExternalOauthParametersToSchema([]*sdk.Parameter{param})}); err != nil {
 			return diag.FromErr(err)
 		}
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+			return diag.FromErr(err)
+		}
+
 		return nil
 	}
 }
@@ -575,6 +583,10 @@ func UpdateContextExternalOauthIntegration(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
+
 	set, unset := sdk.NewExternalOauthIntegrationSetRequest(), sdk.NewExternalOauthIntegrationUnsetRequest()
 
 	errs := errors.Join(
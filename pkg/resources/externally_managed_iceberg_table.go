@@ -0,0 +1,199 @@
+package resources
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externallyManagedIcebergTableSourceAttributes = []string{"catalog_table_name", "metadata_file_path", "base_location"}
+
+var externallyManagedIcebergTableSchema = func() map[string]*schema.Schema {
+	icebergTable := map[string]*schema.Schema{
+		"catalog": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+			DiffSuppressFunc: suppressIdentifierQuoting,
+			Description:      "Specifies the identifier (name) of the catalog integration for this table (e.g. for AWS Glue, Snowflake Open Catalog, a remote Iceberg REST catalog, or object storage).",
+		},
+		"catalog_table_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: externallyManagedIcebergTableSourceAttributes,
+			Description:  "Specifies the table name as recognized by the external catalog (AWS Glue, Snowflake Open Catalog, or a remote Iceberg REST catalog).",
+		},
+		"catalog_namespace": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Specifies the catalog namespace for the table; overrides the default namespace set in the catalog integration.",
+		},
+		"metadata_file_path": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: externallyManagedIcebergTableSourceAttributes,
+			Description:  "Specifies the relative path of the Iceberg metadata file to use for column definitions (object storage catalogs only). Changing the path refreshes the table metadata with `ALTER ICEBERG TABLE ... REFRESH '<metadata_file_path>'`.",
+		},
+		"base_location": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: externallyManagedIcebergTableSourceAttributes,
+			Description:  "Specifies the path to a directory where Snowflake can access the Delta table files (object storage catalogs with the DELTA table format only).",
+		},
+		"replace_invalid_characters": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          BooleanDefault,
+			ValidateDiagFunc: validateBooleanString,
+			Description:      booleanStringFieldDescription("Specifies whether to replace invalid UTF-8 characters with the Unicode replacement character (�) in query results."),
+		},
+		"auto_refresh": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          BooleanDefault,
+			ValidateDiagFunc: validateBooleanString,
+			Description:      booleanStringFieldDescription("Specifies whether Snowflake should automatically poll the external catalog for metadata updates. The polling interval is defined by `refresh_interval_seconds` on the catalog integration."),
+		},
+		"refresh_trigger": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Arbitrary value; changing it refreshes the table metadata with `ALTER ICEBERG TABLE ... REFRESH`. It's not used during table creation.",
+		},
+	}
+	for k, v := range icebergTableCommonSchema() {
+		icebergTable[k] = v
+	}
+	return icebergTable
+}()
+
+func ExternallyManagedIcebergTable() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.IcebergTables.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ExternallyManagedIcebergTableResource), TrackingCreateWrapper(resources.ExternallyManagedIcebergTable, CreateExternallyManagedIcebergTable)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ExternallyManagedIcebergTableResource), TrackingReadWrapper(resources.ExternallyManagedIcebergTable, ReadExternallyManagedIcebergTable)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ExternallyManagedIcebergTableResource), TrackingUpdateWrapper(resources.ExternallyManagedIcebergTable, UpdateExternallyManagedIcebergTable)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ExternallyManagedIcebergTableResource), TrackingDeleteWrapper(resources.ExternallyManagedIcebergTable, deleteFunc)),
+		Description:   "Resource used to manage Apache Iceberg™ tables that use an external catalog (AWS Glue, Snowflake Open Catalog, a remote Iceberg REST catalog, or object storage) through a catalog integration. For more information, check [iceberg tables documentation](https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ExternallyManagedIcebergTable, customdiff.All(
+			ComputedIfAnyAttributeChanged(externallyManagedIcebergTableSchema, ShowOutputAttributeName, "comment", "auto_refresh", "refresh_trigger", "metadata_file_path"),
+		)),
+
+		Schema: externallyManagedIcebergTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ExternallyManagedIcebergTable, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateExternallyManagedIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	catalog := sdk.NewAccountObjectIdentifier(d.Get("catalog").(string))
+
+	request := sdk.NewCreateFromCatalogIcebergTableRequest(id, catalog)
+	errs := errors.Join(
+		accountObjectIdentifierAttributeCreate(d, "external_volume", &request.ExternalVolume),
+		stringAttributeCreateBuilder(d, "catalog_table_name", request.WithCatalogTableName),
+		stringAttributeCreateBuilder(d, "catalog_namespace", request.WithCatalogNamespace),
+		stringAttributeCreateBuilder(d, "metadata_file_path", request.WithMetadataFilePath),
+		stringAttributeCreateBuilder(d, "base_location", request.WithBaseLocation),
+		booleanStringAttributeCreateBuilder(d, "replace_invalid_characters", request.WithReplaceInvalidCharacters),
+		booleanStringAttributeCreateBuilder(d, "auto_refresh", request.WithAutoRefresh),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.IcebergTables.CreateFromCatalog(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadExternallyManagedIcebergTable(ctx, d, meta)
+}
+
+func ReadExternallyManagedIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	icebergTable, diags := readIcebergTableCommon(ctx, d, meta)
+	if icebergTable == nil {
+		return diags
+	}
+
+	var catalog string
+	if icebergTable.CatalogName != nil {
+		catalog = sdk.NewAccountObjectIdentifier(*icebergTable.CatalogName).FullyQualifiedName()
+	}
+	if err := d.Set("catalog", catalog); err != nil {
+		return diag.FromErr(err)
+	}
+	if _, ok := d.GetOk("catalog_table_name"); ok && icebergTable.CatalogTableName != nil {
+		if err := d.Set("catalog_table_name", *icebergTable.CatalogTableName); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func UpdateExternallyManagedIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	switch {
+	case d.HasChange("metadata_file_path"):
+		refresh := sdk.NewIcebergTableRefreshRequest().WithRelativePath(d.Get("metadata_file_path").(string))
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithRefresh(*refresh)); err != nil {
+			return diag.FromErr(err)
+		}
+	case d.HasChange("refresh_trigger"):
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithRefresh(*sdk.NewIcebergTableRefreshRequest())); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	set, unset := sdk.NewIcebergTableSetRequest(), sdk.NewIcebergTableUnsetRequest()
+	errs := errors.Join(
+		booleanStringAttributeUpdate(d, "replace_invalid_characters", &set.ReplaceInvalidCharacters, &unset.ReplaceInvalidCharacters),
+		// AUTO_REFRESH can't be unset, so false is used as a fallback
+		booleanStringAttributeUnsetFallbackUpdate(d, "auto_refresh", &set.AutoRefresh, false),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if (*set != sdk.IcebergTableSetRequest{}) {
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.IcebergTableUnsetRequest{}) {
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadExternallyManagedIcebergTable(ctx, d, meta)
+}
@@ -0,0 +1,554 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// hybridTableSystemIndexPrefix is the prefix of indexes created by Snowflake for the primary, unique, and foreign keys.
+const hybridTableSystemIndexPrefix = "SYS_INDEX_"
+
+var hybridTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the hybrid table; must be unique for the schema in which the hybrid table is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the hybrid table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the hybrid table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"column": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MinItems:    1,
+		Description: "Definitions of columns to create in the hybrid table. Minimum one required. Changing any of the columns recreates the table.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: caseSensitiveFieldDoubleQuotes("Column name."),
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      dataTypeFieldDescription("Column type, e.g. NUMBER."),
+					ValidateDiagFunc: IsDataTypeValid,
+					DiffSuppressFunc: DiffSuppressDataTypes,
+				},
+				"not_null": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     false,
+					Description: "Specifies whether the column can contain NULL values. Columns used in the primary key are always NOT NULL.",
+				},
+				"default": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: externalChangesNotDetectedFieldDescription("Specifies an expression used as the default value of the column, e.g. `'unknown'` or `CURRENT_TIMESTAMP()`. Cannot be used together with `autoincrement`."),
+				},
+				"autoincrement": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     false,
+					Description: externalChangesNotDetectedFieldDescription("Specifies whether the column values are generated automatically with an auto-incremented sequence. Cannot be used together with `default`."),
+				},
+				"collate": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: externalChangesNotDetectedFieldDescription("Specifies the collation to use for the column (text columns only)."),
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Specifies a comment for the column.",
+				},
+			},
+		},
+	},
+	"primary_key": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: externalChangesNotDetectedFieldDescription("Definition of the primary key of the hybrid table. Every hybrid table requires a primary key."),
+		Elem: &schema.Resource{
+			Schema: hybridTableConstraintSchema("primary key"),
+		},
+	},
+	"unique_key": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: externalChangesNotDetectedFieldDescription("Definitions of unique constraints of the hybrid table."),
+		Elem: &schema.Resource{
+			Schema: hybridTableConstraintSchema("unique key"),
+		},
+	},
+	"foreign_key": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: externalChangesNotDetectedFieldDescription("Definitions of foreign key constraints of the hybrid table. The referenced table has to be a hybrid table with a primary or unique key on the referenced columns."),
+		Elem: &schema.Resource{
+			Schema: func() map[string]*schema.Schema {
+				foreignKey := hybridTableConstraintSchema("foreign key")
+				foreignKey["references_table"] = &schema.Schema{
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					Description:      relatedResourceDescription("Fully qualified name of the referenced hybrid table.", resources.HybridTable),
+				}
+				foreignKey["references_columns"] = &schema.Schema{
+					Type:        schema.TypeList,
+					Required:    true,
+					ForceNew:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: caseSensitiveListItemDoubleQuotes("Columns of the referenced table.", "Column names"),
+				}
+				return foreignKey
+			}(),
+		},
+	},
+	"index": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Definitions of secondary indexes of the hybrid table. Indexes are matched by name: added indexes are created with `CREATE INDEX`, removed indexes are dropped with `DROP INDEX`, and changed indexes are dropped and created again. Indexes created by Snowflake for the primary, unique, and foreign keys are not listed.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: caseSensitiveFieldDoubleQuotes("Specifies the identifier for the index; must be unique for the table."),
+				},
+				"columns": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: caseSensitiveListItemDoubleQuotes("Columns on which the index is created.", "Column names"),
+				},
+				"include_columns": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: caseSensitiveListItemDoubleQuotes("Additional columns stored in the index to speed up queries that select them.", "Column names"),
+				},
+			},
+		},
+	},
+	"data_retention_time_in_days": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(-1, 90)),
+		Description:      "Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. The default value for this field is -1, which is a fallback to use Snowflake default - in this case the parent schema value.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the hybrid table.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW HYBRID TABLES` for the given hybrid table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowHybridTableSchema,
+		},
+	},
+}
+
+func hybridTableConstraintSchema(constraintKind string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: caseSensitiveFieldDoubleQuotes(fmt.Sprintf("Specifies the identifier for the %s constraint.", constraintKind)),
+		},
+		"columns": {
+			Type:        schema.TypeList,
+			Required:    true,
+			ForceNew:    true,
+			MinItems:    1,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: caseSensitiveListItemDoubleQuotes(fmt.Sprintf("Columns of the %s.", constraintKind), "Column names"),
+		},
+	}
+}
+
+func HybridTable() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.HybridTables.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.HybridTableResource), TrackingCreateWrapper(resources.HybridTable, CreateHybridTable)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.HybridTableResource), TrackingReadWrapper(resources.HybridTable, ReadHybridTable)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.HybridTableResource), TrackingUpdateWrapper(resources.HybridTable, UpdateHybridTable)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.HybridTableResource), TrackingDeleteWrapper(resources.HybridTable, deleteFunc)),
+		Description:   "Resource used to manage hybrid tables. For more information, check [hybrid tables documentation](https://docs.snowflake.com/en/sql-reference/sql/create-hybrid-table).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.HybridTable, customdiff.All(
+			ComputedIfAnyAttributeChanged(hybridTableSchema, ShowOutputAttributeName, "comment"),
+		)),
+
+		Schema: hybridTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.HybridTable, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	columnsConstraintsAndIndexes, err := hybridTableColumnsConstraintsAndIndexesFromConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateHybridTableRequest(id, *columnsConstraintsAndIndexes)
+	errs := errors.Join(
+		intAttributeWithSpecialDefaultCreateBuilder(d, "data_retention_time_in_days", request.WithDataRetentionTimeInDays),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.HybridTables.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadHybridTable(ctx, d, meta)
+}
+
+func ReadHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hybridTable, err := client.HybridTables.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query hybrid table. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Hybrid table id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := readHybridTableColumns(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := readHybridTableIndexes(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var comment string
+	if hybridTable.Comment != nil {
+		comment = *hybridTable.Comment
+	}
+	errs := errors.Join(
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.HybridTableToSchema(hybridTable)}),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("comment", comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateHybridTableIndexes(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, unset := sdk.NewHybridTableSetRequest(), sdk.NewHybridTableUnsetRequest()
+	errs := errors.Join(
+		intAttributeWithSpecialDefaultUpdate(d, "data_retention_time_in_days", &set.DataRetentionTimeInDays, &unset.DataRetentionTimeInDays),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if (*set != sdk.HybridTableSetRequest{}) {
+		if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.HybridTableUnsetRequest{}) {
+		if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadHybridTable(ctx, d, meta)
+}
+
+// quoteHybridTableColumns wraps column names in double quotes, as the columns are created with quoted (case-sensitive) names.
+func quoteHybridTableColumns(columns []string) []string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = fmt.Sprintf(`"%s"`, strings.ReplaceAll(column, `"`, `""`))
+	}
+	return quoted
+}
+
+func hybridTableColumnsConstraintsAndIndexesFromConfig(d *schema.ResourceData) (*sdk.HybridTableColumnsConstraintsAndIndexesRequest, error) {
+	columns := make([]sdk.HybridTableColumnRequest, 0)
+	for _, c := range d.Get("column").([]any) {
+		column := c.(map[string]any)
+		dataType, err := datatypes.ParseDataType(column["type"].(string))
+		if err != nil {
+			return nil, err
+		}
+		request := sdk.NewHybridTableColumnRequest(column["name"].(string), sdk.DataType(dataType.ToSql()))
+		if v := column["collate"].(string); v != "" {
+			request.WithCollate(v)
+		}
+		if v := column["comment"].(string); v != "" {
+			request.WithComment(v)
+		}
+		if v := column["default"].(string); v != "" {
+			request.WithDefaultExpression(v)
+		}
+		if column["autoincrement"].(bool) {
+			request.WithAutoincrement(true)
+		}
+		if column["not_null"].(bool) {
+			request.WithNotNull(true)
+		}
+		columns = append(columns, *request)
+	}
+
+	constraints := make([]sdk.HybridTableOutOfLineConstraintRequest, 0)
+	addConstraint := func(raw map[string]any, constraintType sdk.HybridTableConstraintType) *sdk.HybridTableOutOfLineConstraintRequest {
+		request := sdk.NewHybridTableOutOfLineConstraintRequest(constraintType, quoteHybridTableColumns(expandStringList(raw["columns"].([]any))))
+		if v := raw["name"].(string); v != "" {
+			request.WithName(v)
+		}
+		return request
+	}
+	for _, pk := range d.Get("primary_key").([]any) {
+		constraints = append(constraints, *addConstraint(pk.(map[string]any), sdk.HybridTableConstraintTypePrimaryKey))
+	}
+	for _, uk := range d.Get("unique_key").([]any) {
+		constraints = append(constraints, *addConstraint(uk.(map[string]any), sdk.HybridTableConstraintTypeUnique))
+	}
+	for _, fk := range d.Get("foreign_key").([]any) {
+		foreignKey := fk.(map[string]any)
+		referencedTableId, err := sdk.ParseSchemaObjectIdentifier(foreignKey["references_table"].(string))
+		if err != nil {
+			return nil, err
+		}
+		request := addConstraint(foreignKey, sdk.HybridTableConstraintTypeForeignKey).
+			WithReferences(*sdk.NewHybridTableForeignKeyReferenceRequest(referencedTableId, quoteHybridTableColumns(expandStringList(foreignKey["references_columns"].([]any)))))
+		constraints = append(constraints, *request)
+	}
+
+	indexes := make([]sdk.HybridTableIndexDefinitionRequest, 0)
+	for _, index := range hybridTableIndexesFromRaw(d.Get("index").([]any)) {
+		request := sdk.NewHybridTableIndexDefinitionRequest(index.name, quoteHybridTableColumns(index.columns))
+		if len(index.includeColumns) > 0 {
+			request.WithIncludeColumns(quoteHybridTableColumns(index.includeColumns))
+		}
+		indexes = append(indexes, *request)
+	}
+
+	return sdk.NewHybridTableColumnsConstraintsAndIndexesRequest(columns).
+		WithOutOfLineConstraints(constraints).
+		WithIndexes(indexes), nil
+}
+
+type hybridTableIndex struct {
+	name           string
+	columns        []string
+	includeColumns []string
+}
+
+func (i hybridTableIndex) equals(other hybridTableIndex) bool {
+	return i.name == other.name && slices.Equal(i.columns, other.columns) && slices.Equal(i.includeColumns, other.includeColumns)
+}
+
+func hybridTableIndexesFromRaw(raw []any) []hybridTableIndex {
+	indexes := make([]hybridTableIndex, 0, len(raw))
+	for _, i := range raw {
+		index := i.(map[string]any)
+		indexes = append(indexes, hybridTableIndex{
+			name:           index["name"].(string),
+			columns:        expandStringList(index["columns"].([]any)),
+			includeColumns: expandStringList(index["include_columns"].([]any)),
+		})
+	}
+	return indexes
+}
+
+func findHybridTableIndex(indexes []hybridTableIndex, name string) (hybridTableIndex, bool) {
+	for _, index := range indexes {
+		if index.name == name {
+			return index, true
+		}
+	}
+	return hybridTableIndex{}, false
+}
+
+func updateHybridTableIndexes(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	if !d.HasChange("index") {
+		return nil
+	}
+	o, n := d.GetChange("index")
+	oldIndexes, newIndexes := hybridTableIndexesFromRaw(o.([]any)), hybridTableIndexesFromRaw(n.([]any))
+
+	for _, oldIndex := range oldIndexes {
+		if newIndex, ok := findHybridTableIndex(newIndexes, oldIndex.name); !ok || !newIndex.equals(oldIndex) {
+			indexId := sdk.NewTableColumnIdentifier(id.DatabaseName(), id.SchemaName(), id.Name(), oldIndex.name)
+			if err := client.HybridTables.DropIndex(ctx, sdk.NewDropIndexHybridTableRequest(indexId).WithIfExists(true)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, newIndex := range newIndexes {
+		if oldIndex, ok := findHybridTableIndex(oldIndexes, newIndex.name); !ok || !oldIndex.equals(newIndex) {
+			request := sdk.NewCreateIndexHybridTableRequest(newIndex.name, id, quoteHybridTableColumns(newIndex.columns))
+			if len(newIndex.includeColumns) > 0 {
+				request.WithIncludeColumns(quoteHybridTableColumns(newIndex.includeColumns))
+			}
+			if err := client.HybridTables.CreateIndex(ctx, request); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readHybridTableIndexes(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	indexes, err := client.HybridTables.ShowIndexes(ctx, sdk.NewShowIndexesHybridTableRequest().WithIn(id))
+	if err != nil {
+		return err
+	}
+	result := make([]map[string]any, 0, len(indexes))
+	for _, index := range indexes {
+		if strings.HasPrefix(index.Name, hybridTableSystemIndexPrefix) {
+			continue
+		}
+		result = append(result, map[string]any{
+			"name":            index.Name,
+			"columns":         index.Columns,
+			"include_columns": index.IncludedColumns,
+		})
+	}
+	return d.Set("index", result)
+}
+
+func readHybridTableColumns(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	columnDetails, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+	if err != nil {
+		return err
+	}
+	currentColumns := make(map[string]map[string]any)
+	for _, c := range d.Get("column").([]any) {
+		column := c.(map[string]any)
+		currentColumns[column["name"].(string)] = column
+	}
+	primaryKeyColumns := make([]string, 0)
+	for _, pk := range d.Get("primary_key").([]any) {
+		primaryKeyColumns = append(primaryKeyColumns, expandStringList(pk.(map[string]any)["columns"].([]any))...)
+	}
+	columns := make([]map[string]any, 0, len(columnDetails))
+	for _, c := range columnDetails {
+		column := map[string]any{
+			"name":          c.Name,
+			"type":          string(c.Type),
+			"not_null":      !c.IsNullable,
+			"default":       "",
+			"autoincrement": false,
+			"collate":       "",
+			"comment":       "",
+		}
+		if c.Comment != nil {
+			column["comment"] = *c.Comment
+		}
+		if current, ok := currentColumns[c.Name]; ok {
+			// keep the configured data type when it's semantically the same as the one returned by Snowflake
+			currentDataType, currentErr := datatypes.ParseDataType(current["type"].(string))
+			externalDataType, externalErr := datatypes.ParseDataType(string(c.Type))
+			if currentErr == nil && externalErr == nil && datatypes.AreTheSame(currentDataType, externalDataType) {
+				column["type"] = current["type"]
+			}
+			// primary key columns are always NOT NULL in Snowflake, so the configured value is kept for them
+			if slices.Contains(primaryKeyColumns, c.Name) {
+				column["not_null"] = current["not_null"]
+			}
+			column["default"] = current["default"]
+			column["autoincrement"] = current["autoincrement"]
+			column["collate"] = current["collate"]
+		}
+		columns = append(columns, column)
+	}
+	return d.Set("column", columns)
+}
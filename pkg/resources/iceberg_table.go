@@ -0,0 +1,216 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var icebergTableSchema = func() map[string]*schema.Schema {
+	icebergTable := map[string]*schema.Schema{
+		"column": icebergTableColumnsSchema,
+		"cluster_by": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			ForceNew:    true,
+			Description: "A list of one or more table columns/expressions to be used as clustering key(s) for the iceberg table.",
+		},
+		"base_location": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Specifies the path relative to the external volume location where Snowflake writes table data and metadata. If not set, Snowflake generates the base location from the table name.",
+		},
+		"catalog_sync": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies the name of a catalog integration configured for Snowflake Open Catalog. If specified, Snowflake syncs the table with an external catalog in your Snowflake Open Catalog account.",
+		},
+		"storage_serialization_policy": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: sdkValidation(sdk.ToStorageSerializationPolicy),
+			DiffSuppressFunc: NormalizeAndCompare(sdk.ToStorageSerializationPolicy),
+			Description:      fmt.Sprintf("Specifies the storage serialization policy for the table. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllStorageSerializationPolicies)),
+		},
+		"data_retention_time_in_days": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          IntDefault,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(-1, 90)),
+			Description:      "Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. The default value for this field is -1, which is a fallback to use Snowflake default - in this case the parent schema value.",
+		},
+		"max_data_extension_time_in_days": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          IntDefault,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(-1, 90)),
+			Description:      "Specifies the maximum number of days for which Snowflake can extend the data retention period for the table to prevent streams on the table from becoming stale. The default value for this field is -1, which is a fallback to use Snowflake default.",
+		},
+		"change_tracking": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          BooleanDefault,
+			ValidateDiagFunc: validateBooleanString,
+			Description:      booleanStringFieldDescription("Specifies whether to enable change tracking on the table."),
+		},
+		"default_ddl_collation": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies a default collation specification for the columns in the table, including columns added to the table in the future.",
+		},
+		"target_file_size": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies a target Parquet file size for the table, e.g. `AUTO` or `16MB`.",
+		},
+	}
+	for k, v := range icebergTableCommonSchema() {
+		icebergTable[k] = v
+	}
+	return icebergTable
+}()
+
+func IcebergTable() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.IcebergTables.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.IcebergTableResource), TrackingCreateWrapper(resources.IcebergTable, CreateIcebergTable)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.IcebergTableResource), TrackingReadWrapper(resources.IcebergTable, ReadIcebergTable)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.IcebergTableResource), TrackingUpdateWrapper(resources.IcebergTable, UpdateIcebergTable)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.IcebergTableResource), TrackingDeleteWrapper(resources.IcebergTable, deleteFunc)),
+		Description:   "Resource used to manage Apache Iceberg™ tables that use Snowflake as the catalog. For more information, check [iceberg tables documentation](https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table-snowflake).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.IcebergTable, customdiff.All(
+			icebergTableColumnsForceNew,
+			ComputedIfAnyAttributeChanged(icebergTableSchema, ShowOutputAttributeName, "comment", "catalog_sync"),
+		)),
+
+		Schema: icebergTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.IcebergTable, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	columns := make([]sdk.IcebergTableColumnRequest, 0)
+	for _, column := range icebergTableColumnsFromRaw(d.Get("column").([]any)) {
+		request, err := column.toRequest()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		columns = append(columns, *request)
+	}
+
+	request := sdk.NewCreateIcebergTableRequest(id, columns).WithCatalog("SNOWFLAKE")
+	errs := errors.Join(
+		accountObjectIdentifierAttributeCreate(d, "external_volume", &request.ExternalVolume),
+		stringAttributeCreateBuilder(d, "base_location", request.WithBaseLocation),
+		stringAttributeCreateBuilder(d, "catalog_sync", request.WithCatalogSync),
+		attributeMappedValueCreateBuilder(d, "storage_serialization_policy", request.WithStorageSerializationPolicy, sdk.ToStorageSerializationPolicy),
+		intAttributeWithSpecialDefaultCreateBuilder(d, "data_retention_time_in_days", request.WithDataRetentionTimeInDays),
+		intAttributeWithSpecialDefaultCreateBuilder(d, "max_data_extension_time_in_days", request.WithMaxDataExtensionTimeInDays),
+		booleanStringAttributeCreateBuilder(d, "change_tracking", request.WithChangeTracking),
+		stringAttributeCreateBuilder(d, "default_ddl_collation", request.WithDefaultDdlCollation),
+		stringAttributeCreateBuilder(d, "target_file_size", request.WithTargetFileSize),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	if v, ok := d.GetOk("cluster_by"); ok {
+		request.WithClusterBy(expandStringList(v.([]any)))
+	}
+
+	if err := client.IcebergTables.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadIcebergTable(ctx, d, meta)
+}
+
+func ReadIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	icebergTable, diags := readIcebergTableCommon(ctx, d, meta)
+	if icebergTable == nil {
+		return diags
+	}
+	id := icebergTable.ID()
+
+	if err := readIcebergTableColumns(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var baseLocation string
+	if icebergTable.BaseLocation != nil {
+		baseLocation = *icebergTable.BaseLocation
+	}
+	if _, ok := d.GetOk("base_location"); ok {
+		if err := d.Set("base_location", baseLocation); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func UpdateIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateIcebergTableColumns(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, unset := sdk.NewIcebergTableSetRequest(), sdk.NewIcebergTableUnsetRequest()
+	errs := errors.Join(
+		stringAttributeUpdate(d, "catalog_sync", &set.CatalogSync, &unset.CatalogSync),
+		intAttributeWithSpecialDefaultUpdate(d, "data_retention_time_in_days", &set.DataRetentionTimeInDays, &unset.DataRetentionTimeInDays),
+		intAttributeWithSpecialDefaultUpdate(d, "max_data_extension_time_in_days", &set.MaxDataExtensionTimeInDays, &unset.MaxDataExtensionTimeInDays),
+		booleanStringAttributeUpdate(d, "change_tracking", &set.ChangeTracking, &unset.ChangeTracking),
+		stringAttributeUpdate(d, "default_ddl_collation", &set.DefaultDdlCollation, &unset.DefaultDdlCollation),
+		stringAttributeUpdate(d, "target_file_size", &set.TargetFileSize, &unset.TargetFileSize),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if (*set != sdk.IcebergTableSetRequest{}) {
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.IcebergTableUnsetRequest{}) {
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadIcebergTable(ctx, d, meta)
+}
@@ -0,0 +1,288 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// icebergTableCommonSchema contains attributes shared by both Snowflake-managed and externally managed iceberg tables.
+func icebergTableCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the iceberg table; must be unique for the schema in which the iceberg table is created."),
+			DiffSuppressFunc: suppressIdentifierQuoting,
+		},
+		"database": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      blocklistedCharactersFieldDescription("The database in which to create the iceberg table."),
+			DiffSuppressFunc: suppressIdentifierQuoting,
+		},
+		"schema": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      blocklistedCharactersFieldDescription("The schema in which to create the iceberg table."),
+			DiffSuppressFunc: suppressIdentifierQuoting,
+		},
+		"external_volume": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+			DiffSuppressFunc: suppressIdentifierQuoting,
+			Description:      "Specifies the identifier (name) for the external volume where the iceberg table stores its metadata files and data in Parquet format. If you don't specify this parameter, the iceberg table defaults to the external volume for the schema, database, or account.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies a comment for the iceberg table.",
+		},
+		FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+		ShowOutputAttributeName: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Outputs the result of `SHOW ICEBERG TABLES` for the given iceberg table.",
+			Elem: &schema.Resource{
+				Schema: schemas.ShowIcebergTableSchema,
+			},
+		},
+	}
+}
+
+func readIcebergTableCommon(ctx context.Context, d *schema.ResourceData, meta any) (*sdk.IcebergTable, diag.Diagnostics) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	icebergTable, err := client.IcebergTables.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return nil, diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query iceberg table. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Iceberg table id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return nil, diag.FromErr(err)
+	}
+
+	var externalVolume string
+	if icebergTable.ExternalVolumeName != nil {
+		externalVolume = sdk.NewAccountObjectIdentifier(*icebergTable.ExternalVolumeName).FullyQualifiedName()
+	}
+
+	errs := errors.Join(
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.IcebergTableToSchema(icebergTable)}),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("comment", icebergTable.Comment),
+	)
+	if _, ok := d.GetOk("external_volume"); ok {
+		errs = errors.Join(errs, d.Set("external_volume", externalVolume))
+	}
+	if errs != nil {
+		return nil, diag.FromErr(errs)
+	}
+	return icebergTable, nil
+}
+
+// icebergTableColumnsSchema is used only by Snowflake-managed iceberg tables, as the columns of externally managed tables are derived from the catalog.
+var icebergTableColumnsSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Required:    true,
+	MinItems:    1,
+	Description: "Definitions of columns to create in the iceberg table. Minimum one required. Columns are matched by name: added columns are created with `ALTER ICEBERG TABLE ... ADD COLUMN`, removed columns are dropped, and changes in comments are altered in place. Changing a column's type or nullability recreates the table.",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Column name.",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      dataTypeFieldDescription("Column type, e.g. NUMBER. For a full list of supported types, see [Data types for Apache Iceberg™ tables](https://docs.snowflake.com/en/user-guide/tables-iceberg-data-types)."),
+				ValidateDiagFunc: IsDataTypeValid,
+				DiffSuppressFunc: DiffSuppressDataTypes,
+			},
+			"not_null": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies whether the column can contain NULL values.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies a comment for the column.",
+			},
+		},
+	},
+}
+
+type icebergTableColumn struct {
+	name     string
+	dataType string
+	notNull  bool
+	comment  string
+}
+
+func icebergTableColumnsFromRaw(raw []any) []icebergTableColumn {
+	columns := make([]icebergTableColumn, 0, len(raw))
+	for _, c := range raw {
+		column := c.(map[string]any)
+		columns = append(columns, icebergTableColumn{
+			name:     column["name"].(string),
+			dataType: column["type"].(string),
+			notNull:  column["not_null"].(bool),
+			comment:  column["comment"].(string),
+		})
+	}
+	return columns
+}
+
+func (c icebergTableColumn) toRequest() (*sdk.IcebergTableColumnRequest, error) {
+	dataType, err := datatypes.ParseDataType(c.dataType)
+	if err != nil {
+		return nil, err
+	}
+	request := sdk.NewIcebergTableColumnRequest(c.name, sdk.DataType(dataType.ToSql()))
+	if c.notNull {
+		request.WithNotNull(true)
+	}
+	if c.comment != "" {
+		request.WithComment(c.comment)
+	}
+	return request, nil
+}
+
+func findIcebergTableColumn(columns []icebergTableColumn, name string) (icebergTableColumn, bool) {
+	for _, c := range columns {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return icebergTableColumn{}, false
+}
+
+// icebergTableColumnsForceNew recreates the table when a column with the same name changes its type or nullability, as it can't be altered in place.
+func icebergTableColumnsForceNew(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" || !d.HasChange("column") {
+		return nil
+	}
+	o, n := d.GetChange("column")
+	oldColumns, newColumns := icebergTableColumnsFromRaw(o.([]any)), icebergTableColumnsFromRaw(n.([]any))
+	for _, newColumn := range newColumns {
+		oldColumn, ok := findIcebergTableColumn(oldColumns, newColumn.name)
+		if !ok {
+			continue
+		}
+		oldDataType, err := datatypes.ParseDataType(oldColumn.dataType)
+		if err != nil {
+			return err
+		}
+		newDataType, err := datatypes.ParseDataType(newColumn.dataType)
+		if err != nil {
+			return err
+		}
+		if !datatypes.AreTheSame(oldDataType, newDataType) || oldColumn.notNull != newColumn.notNull {
+			return d.ForceNew("column")
+		}
+	}
+	return nil
+}
+
+func updateIcebergTableColumns(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	if !d.HasChange("column") {
+		return nil
+	}
+	o, n := d.GetChange("column")
+	oldColumns, newColumns := icebergTableColumnsFromRaw(o.([]any)), icebergTableColumnsFromRaw(n.([]any))
+
+	columnsToDrop := make([]string, 0)
+	for _, oldColumn := range oldColumns {
+		if _, ok := findIcebergTableColumn(newColumns, oldColumn.name); !ok {
+			columnsToDrop = append(columnsToDrop, fmt.Sprintf(`"%s"`, strings.ReplaceAll(oldColumn.name, `"`, `""`)))
+		}
+	}
+	if len(columnsToDrop) > 0 {
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithDropColumns(columnsToDrop)); err != nil {
+			return err
+		}
+	}
+
+	for _, newColumn := range newColumns {
+		oldColumn, ok := findIcebergTableColumn(oldColumns, newColumn.name)
+		switch {
+		case !ok:
+			request, err := newColumn.toRequest()
+			if err != nil {
+				return err
+			}
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithAddColumn(*request)); err != nil {
+				return err
+			}
+		case oldColumn.comment != newColumn.comment:
+			commentRequest := sdk.NewIcebergTableAlterColumnCommentRequest(newColumn.name)
+			if newColumn.comment != "" {
+				commentRequest.WithComment(newColumn.comment)
+			} else {
+				commentRequest.WithUnsetComment(true)
+			}
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithAlterColumnComment(*commentRequest)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readIcebergTableColumns(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	columnDetails, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+	if err != nil {
+		return err
+	}
+	currentColumns := icebergTableColumnsFromRaw(d.Get("column").([]any))
+	columns := make([]map[string]any, 0, len(columnDetails))
+	for _, c := range columnDetails {
+		dataType := string(c.Type)
+		// keep the configured data type when it's semantically the same as the one returned by Snowflake
+		if current, ok := findIcebergTableColumn(currentColumns, c.Name); ok {
+			currentDataType, currentErr := datatypes.ParseDataType(current.dataType)
+			externalDataType, externalErr := datatypes.ParseDataType(dataType)
+			if currentErr == nil && externalErr == nil && datatypes.AreTheSame(currentDataType, externalDataType) {
+				dataType = current.dataType
+			}
+		}
+		column := map[string]any{
+			"name":     c.Name,
+			"type":     dataType,
+			"not_null": !c.IsNullable,
+			"comment":  "",
+		}
+		if c.Comment != nil {
+			column["comment"] = *c.Comment
+		}
+		columns = append(columns, column)
+	}
+	return d.Set("column", columns)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
@@ -11,6 +12,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -33,14 +35,14 @@ var listingSchema = map[string]*schema.Schema{
 					Type:         schema.TypeString,
 					Optional:     true,
 					Description:  "Manifest provided as a string. Wrapping `$$` signs are added by the provider automatically; do not include them. For more information on manifest syntax, see [Listing manifest reference](https://docs.snowflake.com/en/progaccess/listing-manifest-reference). Also, the [multiline string syntax](https://developer.hashicorp.com/terraform/language/expressions/strings#heredoc-strings) is a must here. A proper YAML indentation (2 spaces) is required.",
-					ExactlyOneOf: []string{"manifest.0.from_string", "manifest.0.from_stage"},
+					ExactlyOneOf: []string{"manifest.0.from_string", "manifest.0.from_stage", "manifest.0.structured"},
 				},
 				"from_stage": {
 					Type:         schema.TypeList,
 					Optional:     true,
 					MaxItems:     1,
 					Description:  "Manifest provided from a given stage. If the manifest file is in the root, only stage needs to be passed. For more information on manifest syntax, see [Listing manifest reference](https://docs.snowflake.com/en/progaccess/listing-manifest-reference). A proper YAML indentation (2 spaces) is required.",
-					ExactlyOneOf: []string{"manifest.0.from_string", "manifest.0.from_stage"},
+					ExactlyOneOf: []string{"manifest.0.from_string", "manifest.0.from_stage", "manifest.0.structured"},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"stage": {
@@ -77,6 +79,16 @@ var listingSchema = map[string]*schema.Schema{
 						},
 					},
 				},
+				"structured": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					Description:  "Manifest provided as a structure. The structure is validated during the plan and rendered to YAML by the provider. Only the most common manifest fields are supported; use `from_string` or `from_stage` for the other fields. For more information on manifest fields, see [Listing manifest reference](https://docs.snowflake.com/en/progaccess/listing-manifest-reference).",
+					ExactlyOneOf: []string{"manifest.0.from_string", "manifest.0.from_stage", "manifest.0.structured"},
+					Elem: &schema.Resource{
+						Schema: listingManifestStructuredSchema,
+					},
+				},
 			},
 		},
 	},
@@ -109,6 +121,14 @@ var listingSchema = map[string]*schema.Schema{
 		Description: "Specifies a comment for the listing.",
 		Optional:    true,
 	},
+	"versions": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW VERSIONS IN LISTING` for the given listing.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowListingVersionSchema,
+		},
+	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 	ShowOutputAttributeName: {
 		Type:        schema.TypeList,
@@ -136,6 +156,11 @@ func Listing() *schema.Resource {
 		UpdateContext: TrackingUpdateWrapper(resources.Listing, UpdateListing),
 		DeleteContext: TrackingDeleteWrapper(resources.Listing, deleteFunc),
 
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.Listing, customdiff.All(
+			validateListingManifestStructured,
+			ComputedIfAnyAttributeChanged(listingSchema, "versions", "manifest"),
+		)),
+
 		Schema: listingSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.Listing, ImportName[sdk.AccountObjectIdentifier]),
@@ -156,8 +181,15 @@ func CreateListing(ctx context.Context, d *schema.ResourceData, meta any) diag.D
 	req := sdk.NewCreateListingRequest(id)
 	withReq := sdk.NewListingWithRequest()
 
+	manifest, ok, err := listingManifestAsString(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if ok {
+		req.WithAs(manifest)
+	}
+
 	if errs := errors.Join(
-		stringAttributeCreateBuilder(d, "manifest.0.from_string", req.WithAs),
 		stringAttributeCreateBuilder(d, "comment", req.WithComment),
 
 		attributeMappedValueCreateBuilder(d, "share", withReq.WithShare, sdk.ParseAccountObjectIdentifier),
@@ -237,8 +269,13 @@ func UpdateListing(ctx context.Context, d *schema.ResourceData, meta any) diag.D
 	}
 
 	if d.HasChange("manifest") {
-		if d.HasChange("manifest.0.from_string") {
-			if manifest := d.Get("manifest.0.from_string").(string); manifest != "" {
+		if d.HasChanges("manifest.0.from_string", "manifest.0.structured") {
+			manifest, ok, err := listingManifestAsString(d)
+			if err != nil {
+				d.Partial(true)
+				return diag.FromErr(err)
+			}
+			if ok {
 				req := sdk.NewAlterListingAsRequest(manifest)
 
 				if err := booleanStringAttributeCreate(d, "publish", &req.Publish); err != nil {
@@ -353,11 +390,25 @@ func ReadListing(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 		return diag.FromErr(err)
 	}
 
+	listingVersions, err := client.Listings.ShowVersions(ctx, sdk.NewShowVersionsListingRequest(id))
+	if err != nil {
+		// listings created with an inlined manifest are not versioned until a manifest is added from a stage
+		if !strings.Contains(err.Error(), "Attached stage not exists") {
+			return diag.FromErr(err)
+		}
+		listingVersions = nil
+	}
+	versions := make([]map[string]any, len(listingVersions))
+	for i, listingVersion := range listingVersions {
+		versions[i] = schemas.ListingVersionToSchema(&listingVersion)
+	}
+
 	if errs := errors.Join(
 		setOptionalValueWithMapping(d, "share", listingDetails.Share, (*sdk.AccountObjectIdentifier).FullyQualifiedName),
 		setOptionalValueWithMapping(d, "application_package", listingDetails.ApplicationPackage, (*sdk.AccountObjectIdentifier).FullyQualifiedName),
 		d.Set("publish", booleanStringFromBool(listing.State == sdk.ListingStatePublished)),
 		d.Set("comment", listing.Comment),
+		d.Set("versions", versions),
 		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ListingToSchema(listing)}),
 		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
 	); errs != nil {
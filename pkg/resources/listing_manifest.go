@@ -0,0 +1,293 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+type listingTermsType string
+
+const (
+	listingTermsTypeStandard listingTermsType = "STANDARD"
+	listingTermsTypeOffline  listingTermsType = "OFFLINE"
+	listingTermsTypeCustom   listingTermsType = "CUSTOM"
+)
+
+var allListingTermsTypes = []listingTermsType{
+	listingTermsTypeStandard,
+	listingTermsTypeOffline,
+	listingTermsTypeCustom,
+}
+
+type listingRefreshType string
+
+const (
+	listingRefreshTypeSubDatabase                   listingRefreshType = "SUB_DATABASE"
+	listingRefreshTypeFullDatabase                  listingRefreshType = "FULL_DATABASE"
+	listingRefreshTypeSubDatabaseWithReferenceUsage listingRefreshType = "SUB_DATABASE_WITH_REFERENCE_USAGE"
+)
+
+var allListingRefreshTypes = []listingRefreshType{
+	listingRefreshTypeSubDatabase,
+	listingRefreshTypeFullDatabase,
+	listingRefreshTypeSubDatabaseWithReferenceUsage,
+}
+
+var (
+	listingTargetAccountRegex   = regexp.MustCompile(`^[A-Za-z0-9_]+\.[A-Za-z0-9_]+$`)
+	listingRefreshScheduleRegex = regexp.MustCompile(`^(\d+ (MINUTE|HOUR|DAY)|USING CRON .+)$`)
+)
+
+var listingManifestStructuredSchema = map[string]*schema.Schema{
+	"title": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 110),
+		Description:  "Title of the listing (up to 110 characters).",
+	},
+	"subtitle": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 110),
+		Description:  "Subtitle of the listing (up to 110 characters).",
+	},
+	"description": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 7500),
+		Description:  "Description of the listing (up to 7500 characters). Markdown is supported.",
+	},
+	"listing_terms": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Terms of use of the listing.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: StringInSlice(sdk.AsStringList(allListingTermsTypes), false),
+					Description:      fmt.Sprintf("Type of the listing terms. Valid values are (case-sensitive): %s.", possibleValuesListed(allListingTermsTypes)),
+				},
+				"link": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description:  fmt.Sprintf("Link to the terms of use. Required when `type` is `%s`.", listingTermsTypeCustom),
+				},
+			},
+		},
+	},
+	"targets": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Consumers the listing is shared with.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"accounts": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringMatch(listingTargetAccountRegex, "the account must be in the `<organization_name>.<account_name>` format"),
+					},
+					Description: "Accounts the listing is shared with, in the `<organization_name>.<account_name>` format.",
+				},
+				"regions": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Regions the listing is available in, e.g. `PUBLIC.AWS_US_WEST_2`.",
+				},
+			},
+		},
+	},
+	"auto_fulfillment": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Cross-Cloud Auto-Fulfillment settings of the listing, required when the listing is available in other regions.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"refresh_type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: StringInSlice(sdk.AsStringList(allListingRefreshTypes), false),
+					Description:      fmt.Sprintf("Specifies how the data product is replicated to other regions. Valid values are (case-sensitive): %s.", possibleValuesListed(allListingRefreshTypes)),
+				},
+				"refresh_schedule": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringMatch(listingRefreshScheduleRegex, "the refresh schedule must be in the `<num> { MINUTE | HOUR | DAY }` or `USING CRON <expression> <time_zone>` format"),
+					Description:  "Specifies how often the data product is refreshed in the other regions, in the `<num> { MINUTE | HOUR | DAY }` or `USING CRON <expression> <time_zone>` format.",
+				},
+			},
+		},
+	},
+	"usage_examples": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    10,
+		Description: "Sample queries presented to the consumers of the listing.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"title": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 110),
+					Description:  "Title of the usage example (up to 110 characters).",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Description of the usage example.",
+				},
+				"query": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "SQL query of the usage example.",
+				},
+			},
+		},
+	},
+}
+
+type listingManifest struct {
+	Title           string                          `yaml:"title"`
+	Subtitle        string                          `yaml:"subtitle,omitempty"`
+	Description     string                          `yaml:"description,omitempty"`
+	ListingTerms    *listingManifestListingTerms    `yaml:"listing_terms,omitempty"`
+	Targets         *listingManifestTargets         `yaml:"targets,omitempty"`
+	AutoFulfillment *listingManifestAutoFulfillment `yaml:"auto_fulfillment,omitempty"`
+	UsageExamples   []listingManifestUsageExample   `yaml:"usage_examples,omitempty"`
+}
+
+type listingManifestListingTerms struct {
+	Type string `yaml:"type"`
+	Link string `yaml:"link,omitempty"`
+}
+
+type listingManifestTargets struct {
+	Accounts []string `yaml:"accounts,omitempty"`
+	Regions  []string `yaml:"regions,omitempty"`
+}
+
+type listingManifestAutoFulfillment struct {
+	RefreshType     string `yaml:"refresh_type"`
+	RefreshSchedule string `yaml:"refresh_schedule,omitempty"`
+}
+
+type listingManifestUsageExample struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description,omitempty"`
+	Query       string `yaml:"query"`
+}
+
+// listingManifestFromStructured maps the structured manifest block (manifest.0.structured.0) to the manifest.
+func listingManifestFromStructured(structured map[string]any) listingManifest {
+	manifest := listingManifest{
+		Title:       structured["title"].(string),
+		Subtitle:    structured["subtitle"].(string),
+		Description: structured["description"].(string),
+	}
+	if v, ok := structured["listing_terms"].([]any); ok && len(v) == 1 && v[0] != nil {
+		listingTerms := v[0].(map[string]any)
+		manifest.ListingTerms = &listingManifestListingTerms{
+			Type: listingTerms["type"].(string),
+			Link: listingTerms["link"].(string),
+		}
+	}
+	if v, ok := structured["targets"].([]any); ok && len(v) == 1 && v[0] != nil {
+		targets := v[0].(map[string]any)
+		manifest.Targets = &listingManifestTargets{
+			Accounts: expandStringListAllowEmpty(targets["accounts"].(*schema.Set).List()),
+			Regions:  expandStringListAllowEmpty(targets["regions"].(*schema.Set).List()),
+		}
+		// sets are sorted to render the same manifest for the same configuration
+		slices.Sort(manifest.Targets.Accounts)
+		slices.Sort(manifest.Targets.Regions)
+	}
+	if v, ok := structured["auto_fulfillment"].([]any); ok && len(v) == 1 && v[0] != nil {
+		autoFulfillment := v[0].(map[string]any)
+		manifest.AutoFulfillment = &listingManifestAutoFulfillment{
+			RefreshType:     autoFulfillment["refresh_type"].(string),
+			RefreshSchedule: autoFulfillment["refresh_schedule"].(string),
+		}
+	}
+	if v, ok := structured["usage_examples"].([]any); ok {
+		for _, usageExampleRaw := range v {
+			if usageExampleRaw == nil {
+				continue
+			}
+			usageExample := usageExampleRaw.(map[string]any)
+			manifest.UsageExamples = append(manifest.UsageExamples, listingManifestUsageExample{
+				Title:       usageExample["title"].(string),
+				Description: usageExample["description"].(string),
+				Query:       usageExample["query"].(string),
+			})
+		}
+	}
+	return manifest
+}
+
+func (m listingManifest) validate() error {
+	if m.ListingTerms != nil && listingTermsType(m.ListingTerms.Type) == listingTermsTypeCustom && m.ListingTerms.Link == "" {
+		return fmt.Errorf("listing_terms.link is required when listing_terms.type is %s", listingTermsTypeCustom)
+	}
+	if m.Targets != nil && len(m.Targets.Accounts) == 0 && len(m.Targets.Regions) == 0 {
+		return fmt.Errorf("at least one of targets.accounts or targets.regions has to be set")
+	}
+	return nil
+}
+
+// toYaml renders the manifest in the YAML format with the 2-space indentation required by Snowflake.
+func (m listingManifest) toYaml() (string, error) {
+	if err := m.validate(); err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(m); err != nil {
+		return "", fmt.Errorf("rendering listing manifest: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("rendering listing manifest: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// listingManifestAsString returns the manifest provided as a string or rendered from the structured manifest.
+// The second value is false when the manifest is provided from a stage.
+func listingManifestAsString(d *schema.ResourceData) (string, bool, error) {
+	if v, ok := d.GetOk("manifest.0.from_string"); ok {
+		return v.(string), true, nil
+	}
+	if v, ok := d.GetOk("manifest.0.structured"); ok && len(v.([]any)) == 1 {
+		manifest, err := listingManifestFromStructured(v.([]any)[0].(map[string]any)).toYaml()
+		return manifest, err == nil, err
+	}
+	return "", false, nil
+}
+
+// validateListingManifestStructured validates the structured manifest during the plan, so that the errors do not surface only during the apply.
+func validateListingManifestStructured(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if !diff.NewValueKnown("manifest.0.structured") {
+		return nil
+	}
+	v, ok := diff.GetOk("manifest.0.structured")
+	if !ok || len(v.([]any)) != 1 || v.([]any)[0] == nil {
+		return nil
+	}
+	return listingManifestFromStructured(v.([]any)[0].(map[string]any)).validate()
+}
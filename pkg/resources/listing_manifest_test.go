@@ -0,0 +1,144 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listingManifestFromStructured(t *testing.T) {
+	structuredManifest := func(t *testing.T, raw map[string]any) map[string]any {
+		t.Helper()
+		d := schema.TestResourceDataRaw(t, listingSchema, map[string]any{
+			"name": "LISTING",
+			"manifest": []any{
+				map[string]any{
+					"structured": []any{raw},
+				},
+			},
+		})
+		return d.Get("manifest.0.structured.0").(map[string]any)
+	}
+
+	t.Run("basic", func(t *testing.T) {
+		manifest := listingManifestFromStructured(structuredManifest(t, map[string]any{
+			"title": "title",
+		}))
+
+		rendered, err := manifest.toYaml()
+		require.NoError(t, err)
+		assert.Equal(t, "title: title\n", rendered)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		manifest := listingManifestFromStructured(structuredManifest(t, map[string]any{
+			"title":       "title",
+			"subtitle":    "subtitle",
+			"description": "description",
+			"listing_terms": []any{
+				map[string]any{
+					"type": "CUSTOM",
+					"link": "https://example.com/terms",
+				},
+			},
+			"targets": []any{
+				map[string]any{
+					"accounts": []any{"ORG.ACCOUNT_B", "ORG.ACCOUNT_A"},
+					"regions":  []any{"PUBLIC.AWS_US_WEST_2"},
+				},
+			},
+			"auto_fulfillment": []any{
+				map[string]any{
+					"refresh_type":     "SUB_DATABASE",
+					"refresh_schedule": "10 MINUTE",
+				},
+			},
+			"usage_examples": []any{
+				map[string]any{
+					"title":       "example",
+					"description": "example description",
+					"query":       "SELECT 1",
+				},
+			},
+		}))
+
+		rendered, err := manifest.toYaml()
+		require.NoError(t, err)
+		assert.Equal(t, `title: title
+subtitle: subtitle
+description: description
+listing_terms:
+  type: CUSTOM
+  link: https://example.com/terms
+targets:
+  accounts:
+    - ORG.ACCOUNT_A
+    - ORG.ACCOUNT_B
+  regions:
+    - PUBLIC.AWS_US_WEST_2
+auto_fulfillment:
+  refresh_type: SUB_DATABASE
+  refresh_schedule: 10 MINUTE
+usage_examples:
+  - title: example
+    description: example description
+    query: SELECT 1
+`, rendered)
+	})
+}
+
+func Test_listingManifest_validate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		manifest      listingManifest
+		expectedError string
+	}{
+		{
+			name:     "valid: basic",
+			manifest: listingManifest{Title: "title"},
+		},
+		{
+			name: "valid: custom terms with link",
+			manifest: listingManifest{
+				Title:        "title",
+				ListingTerms: &listingManifestListingTerms{Type: "CUSTOM", Link: "https://example.com/terms"},
+			},
+		},
+		{
+			name: "valid: standard terms without link",
+			manifest: listingManifest{
+				Title:        "title",
+				ListingTerms: &listingManifestListingTerms{Type: "STANDARD"},
+			},
+		},
+		{
+			name: "invalid: custom terms without link",
+			manifest: listingManifest{
+				Title:        "title",
+				ListingTerms: &listingManifestListingTerms{Type: "CUSTOM"},
+			},
+			expectedError: "listing_terms.link is required when listing_terms.type is CUSTOM",
+		},
+		{
+			name: "invalid: empty targets",
+			manifest: listingManifest{
+				Title:   "title",
+				Targets: &listingManifestTargets{},
+			},
+			expectedError: "at least one of targets.accounts or targets.regions has to be set",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.manifest.validate()
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -38,11 +38,20 @@ var managedAccountSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 	},
 	"admin_password": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "Password for the initial user in the managed account. Check [Snowflake-provided password policy](https://docs.snowflake.com/en/user-guide/admin-user-management#snowflake-provided-password-policy).",
-		ForceNew:    true,
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		ExactlyOneOf: []string{"admin_password", "admin_password_wo"},
+		Description:  "Password for the initial user in the managed account. Check [Snowflake-provided password policy](https://docs.snowflake.com/en/user-guide/admin-user-management#snowflake-provided-password-policy). Exactly one of `admin_password` or `admin_password_wo` has to be specified.",
+		ForceNew:     true,
+	},
+	"admin_password_wo": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		WriteOnly:    true,
+		Sensitive:    true,
+		ExactlyOneOf: []string{"admin_password", "admin_password_wo"},
+		Description:  "Password for the initial user in the managed account. This is a [write-only attribute](https://developer.hashicorp.com/terraform/plugin/sdkv2/resources/write-only-arguments): its value is never persisted in the Terraform plan or state, and it requires Terraform 1.11 or later. The value is used only during the managed account creation, so it has no version companion field. Check [Snowflake-provided password policy](https://docs.snowflake.com/en/user-guide/admin-user-management#snowflake-provided-password-policy). Exactly one of `admin_password` or `admin_password_wo` has to be specified.",
 	},
 	"type": {
 		Type:         schema.TypeString,
@@ -101,6 +110,9 @@ func ManagedAccount() *schema.Resource {
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ManagedAccountResource), TrackingDeleteWrapper(resources.ManagedAccount, deleteFunc)),
 
 		Schema: managedAccountSchema,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			preferWriteOnlyAttribute("admin_password", "admin_password_wo"),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -116,7 +128,10 @@ func CreateManagedAccount(ctx context.Context, d *schema.ResourceData, meta any)
 	id := sdk.NewAccountObjectIdentifier(name)
 
 	adminName := d.Get("admin_name").(string)
-	adminPassword := d.Get("admin_password").(string)
+	adminPassword, err := getStringOrWriteOnlyString(d, "admin_password", "admin_password_wo")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	createParams := sdk.NewCreateManagedAccountParamsRequest(adminName, adminPassword)
 
 	if v, ok := d.GetOk("comment"); ok {
@@ -125,7 +140,7 @@ func CreateManagedAccount(ctx context.Context, d *schema.ResourceData, meta any)
 
 	createRequest := sdk.NewCreateManagedAccountRequest(id, *createParams)
 
-	err := client.ManagedAccounts.Create(ctx, createRequest)
+	err = client.ManagedAccounts.Create(ctx, createRequest)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -80,6 +80,7 @@ var networkPolicySchema = map[string]*schema.Schema{
 			Schema: schemas.DescribeNetworkPolicySchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -128,7 +129,7 @@ func NetworkPolicy() *schema.Resource {
 		)),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.NetworkPolicy, ImportName[sdk.AccountObjectIdentifier]),
+			StateContext: TrackingImportWrapper(resources.NetworkPolicy, importWithTags(sdk.ObjectTypeNetworkPolicy, sdk.ParseAccountObjectIdentifier, ImportName[sdk.AccountObjectIdentifier])),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -184,6 +185,10 @@ func CreateContextNetworkPolicy(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
 
+	if err := handleTagsCreate(ctx, client, d, id, sdk.ObjectTypeNetworkPolicy); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return ReadContextNetworkPolicy(ctx, d, meta)
 }
 
@@ -222,6 +227,10 @@ func ReadContextNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeNetworkPolicy); err != nil {
+		return diag.FromErr(err)
+	}
+
 	policyProperties, err := client.NetworkPolicies.Describe(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
@@ -314,6 +323,10 @@ func UpdateContextNetworkPolicy(ctx context.Context, d *schema.ResourceData, met
 		id = newId
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeNetworkPolicy); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("comment") {
 		if v, ok := d.GetOk("comment"); ok {
 			set.WithComment(v.(string))
@@ -160,6 +160,7 @@ var oauthIntegrationForCustomClientsSchema = map[string]*schema.Schema{
 			Schema: schemas.ShowOauthForCustomClientsParametersSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -200,7 +201,7 @@ func OauthIntegrationForCustomClients() *schema.Resource {
 		)),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.OauthIntegrationForCustomClients, ImportOauthForCustomClientsIntegration),
+			StateContext: TrackingImportWrapper(resources.OauthIntegrationForCustomClients, importWithTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ImportOauthForCustomClientsIntegration)),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -375,6 +376,9 @@ func CreateContextOauthIntegrationForCustomClients(ctx context.Context, d *schem
 	}
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
+	if err := handleTagsCreate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return ReadContextOauthIntegrationForCustomClients(false)(ctx, d, meta)
 }
@@ -556,6 +560,10 @@ func ReadContextOauthIntegrationForCustomClients(withExternalChangesMarking bool
 			return diag.FromErr(err)
 		}
 
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+			return diag.FromErr(err)
+		}
+
 		return nil
 	}
 }
@@ -567,6 +575,10 @@ func UpdateContextOauthIntegrationForCustomClients(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
+
 	set, unset := sdk.NewOauthForCustomClientsIntegrationSetRequest(), sdk.NewOauthForCustomClientsIntegrationUnsetRequest()
 
 	if d.HasChange("oauth_redirect_uri") {
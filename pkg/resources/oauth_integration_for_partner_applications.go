@@ -117,6 +117,7 @@ var oauthIntegrationForPartnerApplicationsSchema = map[string]*schema.Schema{
 			Schema: schemas.ShowOauthForPartnerApplicationsParametersSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -151,7 +152,7 @@ func OauthIntegrationForPartnerApplications() *schema.Resource {
 		)),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.OauthIntegrationForPartnerApplications, ImportOauthForPartnerApplicationIntegration),
+			StateContext: TrackingImportWrapper(resources.OauthIntegrationForPartnerApplications, importWithTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ImportOauthForPartnerApplicationIntegration)),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -297,6 +298,9 @@ func CreateContextOauthIntegrationForPartnerApplications(ctx context.Context, d
 	}
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
+	if err := handleTagsCreate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return ReadContextOauthIntegrationForPartnerApplications(false)(ctx, d, meta)
 }
@@ -431,6 +435,10 @@ func ReadContextOauthIntegrationForPartnerApplications(withExternalChangesMarkin
 		if err = d.Set(RelatedParametersAttributeName, []map[string]any{schemas.OauthForPartnerApplicationsParametersToSchema([]*sdk.Parameter{param})}); err != nil {
 			return diag.FromErr(err)
 		}
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+			return diag.FromErr(err)
+		}
+
 		return nil
 	}
 }
@@ -442,6 +450,10 @@ func UpdateContextOauthIntegrationForPartnerApplications(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
+
 	set, unset := sdk.NewOauthForPartnerApplicationsIntegrationSetRequest(), sdk.NewOauthForPartnerApplicationsIntegrationUnsetRequest()
 
 	if d.HasChange("blocked_roles_list") {
@@ -0,0 +1,317 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// organizationListingManifestAttributes are rendered to the manifest of the organization listing.
+var organizationListingManifestAttributes = []string{
+	"title",
+	"description",
+	"organization_profile",
+	"discovery_targets",
+	"access_targets",
+	"access_regions",
+	"auto_fulfillment",
+}
+
+var organizationListingSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ValidateDiagFunc: IsValidListingName,
+		Description:      "Specifies the listing identifier (name). It must be unique within the organization, regardless of which Snowflake region the account is located in. Must start with an alphabetic character and cannot contain spaces or special characters except for underscores.",
+	},
+	"share": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "Specifies the identifier for the share to attach to the listing.",
+		ExactlyOneOf:     []string{"share", "application_package"},
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"application_package": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "Specifies the application package attached to the listing.",
+		ExactlyOneOf:     []string{"share", "application_package"},
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"title": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 110),
+		Description:  "Title of the listing (up to 110 characters).",
+	},
+	"description": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 7500),
+		Description:  externalChangesNotDetectedFieldDescription("Description of the listing (up to 7500 characters). Markdown is supported."),
+	},
+	"organization_profile": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  externalChangesNotDetectedFieldDescription("Name of the organization profile presented as the provider of the listing. If not set, Snowflake uses the `INTERNAL` profile."),
+	},
+	"discovery_targets": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: externalChangesNotDetectedFieldDescription("Accounts and roles in the organization that can discover the listing in the internal marketplace."),
+		Elem: &schema.Resource{
+			Schema: organizationListingTargetSchema,
+		},
+	},
+	"access_targets": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: externalChangesNotDetectedFieldDescription("Accounts and roles in the organization that can access (get) the listing data product."),
+		Elem: &schema.Resource{
+			Schema: organizationListingTargetSchema,
+		},
+	},
+	"access_regions": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: externalChangesNotDetectedFieldDescription("Regions in which the listing is accessible, e.g. `PUBLIC.AWS_US_WEST_2`. Use `ALL` to make the listing accessible in all regions of the organization."),
+	},
+	"auto_fulfillment": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: externalChangesNotDetectedFieldDescription("Cross-Cloud Auto-Fulfillment settings of the listing, required when the listing is accessible in regions other than the region of the provider account."),
+		Elem:        listingManifestStructuredSchema["auto_fulfillment"].Elem,
+	},
+	"publish": {
+		Type:        schema.TypeString,
+		Default:     BooleanDefault,
+		Optional:    true,
+		Description: "Determines if the listing should be published.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Description: "Specifies a comment for the listing.",
+		Optional:    true,
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW LISTINGS` for the given listing.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowListingSchema,
+		},
+	},
+}
+
+func OrganizationListing() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.Listings.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		Description: "Resource used to manage organization listings, which share data products between accounts of the same organization through the internal marketplace. For more information, check [organization listing documentation](https://docs.snowflake.com/en/user-guide/collaboration/listings/organizational/org-listing-about).",
+
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.OrganizationListingResource), TrackingCreateWrapper(resources.OrganizationListing, CreateOrganizationListing)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.OrganizationListingResource), TrackingReadWrapper(resources.OrganizationListing, ReadOrganizationListing)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.OrganizationListingResource), TrackingUpdateWrapper(resources.OrganizationListing, UpdateOrganizationListing)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.OrganizationListingResource), TrackingDeleteWrapper(resources.OrganizationListing, deleteFunc)),
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.OrganizationListing, customdiff.All(
+			validateOrganizationListingManifest,
+			ComputedIfAnyAttributeChanged(organizationListingSchema, ShowOutputAttributeName, "name", "title", "publish", "comment"),
+			ComputedIfAnyAttributeChanged(organizationListingSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+
+		Schema: organizationListingSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.OrganizationListing, ImportName[sdk.AccountObjectIdentifier]),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateOrganizationListing(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	manifest, err := organizationListingManifestFromResourceData(d.Get).toYaml()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := sdk.NewCreateOrganizationListingRequest(id, manifest)
+	withReq := sdk.NewOrganizationListingWithRequest()
+
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "comment", req.WithComment),
+		booleanStringAttributeCreateBuilder(d, "publish", req.WithPublish),
+
+		attributeMappedValueCreateBuilder(d, "share", withReq.WithShare, sdk.ParseAccountObjectIdentifier),
+		attributeMappedValueCreateBuilder(d, "application_package", withReq.WithApplicationPackage, sdk.ParseAccountObjectIdentifier),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	req.WithWith(*withReq)
+
+	if err := client.OrganizationListings.Create(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	return ReadOrganizationListing(ctx, d, meta)
+}
+
+func UpdateOrganizationListing(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+
+		if err := client.Listings.Alter(ctx, sdk.NewAlterListingRequest(id).WithRenameTo(newId)); err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChanges(organizationListingManifestAttributes...) {
+		manifest, err := organizationListingManifestFromResourceData(d.Get).toYaml()
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+
+		req := sdk.NewAlterListingAsRequest(manifest)
+		if err := booleanStringAttributeCreate(d, "publish", &req.Publish); err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+
+		if err := client.Listings.Alter(ctx, sdk.NewAlterListingRequest(id).WithAlterListingAs(*req)); err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("publish") {
+		if publishString := d.Get("publish").(string); publishString != BooleanDefault {
+			publish, err := booleanStringToBool(publishString)
+			if err != nil {
+				d.Partial(true)
+				return diag.FromErr(err)
+			}
+
+			if publish {
+				if err := client.Listings.Alter(ctx, sdk.NewAlterListingRequest(id).WithPublish(true)); err != nil {
+					d.Partial(true)
+					return diag.FromErr(err)
+				}
+			} else {
+				if err := client.Listings.Alter(ctx, sdk.NewAlterListingRequest(id).WithUnpublish(true)); err != nil {
+					d.Partial(true)
+					return diag.FromErr(err)
+				}
+			}
+		}
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			if err := client.Listings.Alter(ctx, sdk.NewAlterListingRequest(id).WithSet(*sdk.NewListingSetRequest().WithComment(comment))); err != nil {
+				d.Partial(true)
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := client.Listings.Alter(ctx, sdk.NewAlterListingRequest(id).WithUnset(*sdk.NewListingUnsetRequest().WithComment(true))); err != nil {
+				d.Partial(true)
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadOrganizationListing(ctx, d, meta)
+}
+
+func ReadOrganizationListing(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listing, err := client.Listings.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query organization listing. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Listing id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	listingDetails, err := client.Listings.Describe(ctx, sdk.NewDescribeListingRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// only the title is read from the manifest; the other manifest attributes are not read, because DESCRIBE LISTING returns them in a different shape
+	if errs := errors.Join(
+		setOptionalValueWithMapping(d, "share", listingDetails.Share, (*sdk.AccountObjectIdentifier).FullyQualifiedName),
+		setOptionalValueWithMapping(d, "application_package", listingDetails.ApplicationPackage, (*sdk.AccountObjectIdentifier).FullyQualifiedName),
+		d.Set("title", listing.Title),
+		d.Set("publish", booleanStringFromBool(listing.State == sdk.ListingStatePublished)),
+		d.Set("comment", listing.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ListingToSchema(listing)}),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	return nil
+}
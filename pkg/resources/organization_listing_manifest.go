@@ -0,0 +1,162 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+var organizationListingTargetSchema = map[string]*schema.Schema{
+	"all_internal_accounts": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Targets all accounts in the organization. Cannot be combined with `account` and `roles`.",
+	},
+	"account": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "Name of the account in the organization (without the organization name prefix) that is targeted.",
+	},
+	"roles": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Roles in the targeted `account` that are targeted. If not set, all roles in the account are targeted.",
+	},
+}
+
+type organizationListingManifest struct {
+	Title               string                                `yaml:"title"`
+	Description         string                                `yaml:"description,omitempty"`
+	OrganizationProfile string                                `yaml:"organization_profile,omitempty"`
+	OrganizationTargets *organizationListingManifestTargets   `yaml:"organization_targets,omitempty"`
+	Locations           *organizationListingManifestLocations `yaml:"locations,omitempty"`
+	AutoFulfillment     *listingManifestAutoFulfillment       `yaml:"auto_fulfillment,omitempty"`
+}
+
+type organizationListingManifestTargets struct {
+	Discovery []organizationListingManifestTarget `yaml:"discovery,omitempty"`
+	Access    []organizationListingManifestTarget `yaml:"access,omitempty"`
+}
+
+type organizationListingManifestTarget struct {
+	AllInternalAccounts bool     `yaml:"all_internal_accounts,omitempty"`
+	Account             string   `yaml:"account,omitempty"`
+	Roles               []string `yaml:"roles,omitempty"`
+}
+
+type organizationListingManifestLocations struct {
+	AccessRegions []organizationListingManifestRegion `yaml:"access_regions"`
+}
+
+type organizationListingManifestRegion struct {
+	Name string `yaml:"name"`
+}
+
+func organizationListingManifestTargetsFromRaw(raw any) []organizationListingManifestTarget {
+	var targets []organizationListingManifestTarget
+	for _, targetRaw := range raw.([]any) {
+		if targetRaw == nil {
+			continue
+		}
+		target := targetRaw.(map[string]any)
+		manifestTarget := organizationListingManifestTarget{
+			AllInternalAccounts: target["all_internal_accounts"].(bool),
+			Account:             target["account"].(string),
+			Roles:               expandStringListAllowEmpty(target["roles"].(*schema.Set).List()),
+		}
+		// sets are sorted to render the same manifest for the same configuration
+		slices.Sort(manifestTarget.Roles)
+		targets = append(targets, manifestTarget)
+	}
+	return targets
+}
+
+// organizationListingManifestFromResourceData builds the manifest from the top-level attributes of the organization listing.
+// The getter is either (*schema.ResourceData).Get or (*schema.ResourceDiff).Get.
+func organizationListingManifestFromResourceData(get func(string) any) organizationListingManifest {
+	manifest := organizationListingManifest{
+		Title:               get("title").(string),
+		Description:         get("description").(string),
+		OrganizationProfile: get("organization_profile").(string),
+	}
+	discovery := organizationListingManifestTargetsFromRaw(get("discovery_targets"))
+	access := organizationListingManifestTargetsFromRaw(get("access_targets"))
+	if len(discovery) > 0 || len(access) > 0 {
+		manifest.OrganizationTargets = &organizationListingManifestTargets{
+			Discovery: discovery,
+			Access:    access,
+		}
+	}
+	if regions := expandStringListAllowEmpty(get("access_regions").(*schema.Set).List()); len(regions) > 0 {
+		slices.Sort(regions)
+		manifest.Locations = &organizationListingManifestLocations{}
+		for _, region := range regions {
+			manifest.Locations.AccessRegions = append(manifest.Locations.AccessRegions, organizationListingManifestRegion{Name: region})
+		}
+	}
+	if v, ok := get("auto_fulfillment").([]any); ok && len(v) == 1 && v[0] != nil {
+		autoFulfillment := v[0].(map[string]any)
+		manifest.AutoFulfillment = &listingManifestAutoFulfillment{
+			RefreshType:     autoFulfillment["refresh_type"].(string),
+			RefreshSchedule: autoFulfillment["refresh_schedule"].(string),
+		}
+	}
+	return manifest
+}
+
+func (t organizationListingManifestTarget) validate(kind string) error {
+	switch {
+	case t.AllInternalAccounts && (t.Account != "" || len(t.Roles) > 0):
+		return fmt.Errorf("%s: all_internal_accounts cannot be combined with account and roles", kind)
+	case !t.AllInternalAccounts && t.Account == "":
+		return fmt.Errorf("%s: exactly one of all_internal_accounts or account has to be set", kind)
+	}
+	return nil
+}
+
+func (m organizationListingManifest) validate() error {
+	if m.OrganizationTargets == nil {
+		return nil
+	}
+	var errs []error
+	for _, target := range m.OrganizationTargets.Discovery {
+		errs = append(errs, target.validate("discovery_targets"))
+	}
+	for _, target := range m.OrganizationTargets.Access {
+		errs = append(errs, target.validate("access_targets"))
+	}
+	return errors.Join(errs...)
+}
+
+// toYaml renders the manifest in the YAML format with the 2-space indentation required by Snowflake.
+func (m organizationListingManifest) toYaml() (string, error) {
+	if err := m.validate(); err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(m); err != nil {
+		return "", fmt.Errorf("rendering organization listing manifest: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("rendering organization listing manifest: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// validateOrganizationListingManifest validates the manifest during the plan, so that the errors do not surface only during the apply.
+func validateOrganizationListingManifest(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if !diff.NewValueKnown("discovery_targets") || !diff.NewValueKnown("access_targets") {
+		return nil
+	}
+	return organizationListingManifestFromResourceData(diff.Get).validate()
+}
@@ -0,0 +1,144 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_organizationListingManifestFromResourceData(t *testing.T) {
+	resourceData := func(t *testing.T, raw map[string]any) *schema.ResourceData {
+		t.Helper()
+		raw["name"] = "LISTING"
+		raw["share"] = "SHARE"
+		return schema.TestResourceDataRaw(t, organizationListingSchema, raw)
+	}
+
+	t.Run("basic", func(t *testing.T) {
+		manifest := organizationListingManifestFromResourceData(resourceData(t, map[string]any{
+			"title": "title",
+		}).Get)
+
+		rendered, err := manifest.toYaml()
+		require.NoError(t, err)
+		assert.Equal(t, "title: title\n", rendered)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		manifest := organizationListingManifestFromResourceData(resourceData(t, map[string]any{
+			"title":                "title",
+			"description":          "description",
+			"organization_profile": "PROFILE",
+			"discovery_targets": []any{
+				map[string]any{
+					"all_internal_accounts": true,
+				},
+			},
+			"access_targets": []any{
+				map[string]any{
+					"account": "ACCOUNT_A",
+					"roles":   []any{"ROLE_B", "ROLE_A"},
+				},
+				map[string]any{
+					"account": "ACCOUNT_B",
+				},
+			},
+			"access_regions": []any{"PUBLIC.AWS_US_WEST_2", "PUBLIC.AWS_EU_CENTRAL_1"},
+			"auto_fulfillment": []any{
+				map[string]any{
+					"refresh_type":     "SUB_DATABASE",
+					"refresh_schedule": "10 MINUTE",
+				},
+			},
+		}).Get)
+
+		rendered, err := manifest.toYaml()
+		require.NoError(t, err)
+		assert.Equal(t, `title: title
+description: description
+organization_profile: PROFILE
+organization_targets:
+  discovery:
+    - all_internal_accounts: true
+  access:
+    - account: ACCOUNT_A
+      roles:
+        - ROLE_A
+        - ROLE_B
+    - account: ACCOUNT_B
+locations:
+  access_regions:
+    - name: PUBLIC.AWS_EU_CENTRAL_1
+    - name: PUBLIC.AWS_US_WEST_2
+auto_fulfillment:
+  refresh_type: SUB_DATABASE
+  refresh_schedule: 10 MINUTE
+`, rendered)
+	})
+}
+
+func Test_organizationListingManifest_validate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		manifest      organizationListingManifest
+		expectedError string
+	}{
+		{
+			name:     "valid: basic",
+			manifest: organizationListingManifest{Title: "title"},
+		},
+		{
+			name: "valid: all internal accounts and account with roles",
+			manifest: organizationListingManifest{
+				Title: "title",
+				OrganizationTargets: &organizationListingManifestTargets{
+					Discovery: []organizationListingManifestTarget{{AllInternalAccounts: true}},
+					Access:    []organizationListingManifestTarget{{Account: "ACCOUNT", Roles: []string{"ROLE"}}},
+				},
+			},
+		},
+		{
+			name: "invalid: all internal accounts with account",
+			manifest: organizationListingManifest{
+				Title: "title",
+				OrganizationTargets: &organizationListingManifestTargets{
+					Discovery: []organizationListingManifestTarget{{AllInternalAccounts: true, Account: "ACCOUNT"}},
+				},
+			},
+			expectedError: "discovery_targets: all_internal_accounts cannot be combined with account and roles",
+		},
+		{
+			name: "invalid: all internal accounts with roles",
+			manifest: organizationListingManifest{
+				Title: "title",
+				OrganizationTargets: &organizationListingManifestTargets{
+					Access: []organizationListingManifestTarget{{AllInternalAccounts: true, Roles: []string{"ROLE"}}},
+				},
+			},
+			expectedError: "access_targets: all_internal_accounts cannot be combined with account and roles",
+		},
+		{
+			name: "invalid: empty target",
+			manifest: organizationListingManifest{
+				Title: "title",
+				OrganizationTargets: &organizationListingManifestTargets{
+					Access: []organizationListingManifestTarget{{}},
+				},
+			},
+			expectedError: "access_targets: exactly one of all_internal_accounts or account has to be set",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.manifest.validate()
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var packagesPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the packages policy; must be unique for the database and schema in which the packages policy is created. Packages policies cannot be renamed, so changing this field recreates the packages policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the packages policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the packages policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"allowlist": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Specifies the package specs (e.g. `numpy` or `pandas==2.2.*`) of the Anaconda packages that are allowed. When not set, Snowflake allows all packages (`*`).",
+	},
+	"blocklist": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Specifies the package specs of the Anaconda packages that are blocked. The blocklist takes precedence over the allowlist.",
+	},
+	"additional_creation_blocklist": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Specifies the package specs of the Anaconda packages that are blocked only at the creation time of functions and procedures; the already existing objects using them continue to run.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the packages policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW PACKAGES POLICIES` for the given packages policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowPackagesPolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE PACKAGES POLICY` for the given packages policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.PackagesPolicyDescribeSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+// PackagesPolicy returns a pointer to the resource representing a packages policy.
+func PackagesPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.PackagesPolicies.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.PackagesPolicyResource), TrackingCreateWrapper(resources.PackagesPolicy, CreatePackagesPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.PackagesPolicyResource), TrackingReadWrapper(resources.PackagesPolicy, ReadPackagesPolicy)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.PackagesPolicyResource), TrackingUpdateWrapper(resources.PackagesPolicy, UpdatePackagesPolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.PackagesPolicyResource), TrackingDeleteWrapper(resources.PackagesPolicy, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage packages policy objects. A packages policy governs the Anaconda packages that can be used by Python functions and procedures. For more information, check [packages policy documentation](https://docs.snowflake.com/en/developer-guide/udf/python/packages-policy).",
+			"To set the policy on the current account, use the `packages_policy` field in the `snowflake_current_account` resource.",
+		),
+
+		Schema: packagesPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.PackagesPolicy, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.PackagesPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(packagesPolicySchema, ShowOutputAttributeName, "comment"),
+			ComputedIfAnyAttributeChanged(packagesPolicySchema, DescribeOutputAttributeName, "allowlist", "blocklist", "additional_creation_blocklist", "comment"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreatePackagesPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreatePackagesPolicyRequest(id)
+	if v, ok := d.GetOk("allowlist"); ok {
+		request.WithAllowlist(packageSpecsFromSet(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("blocklist"); ok {
+		request.WithBlocklist(packageSpecsFromSet(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("additional_creation_blocklist"); ok {
+		request.WithAdditionalCreationBlocklist(packageSpecsFromSet(v.(*schema.Set)))
+	}
+	if err := stringAttributeCreate(d, "comment", &request.Comment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.PackagesPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating packages policy %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	return ReadPackagesPolicy(ctx, d, meta)
+}
+
+func ReadPackagesPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	packagesPolicy, err := client.PackagesPolicies.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query packages policy. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Packages policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	description, err := client.PackagesPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Snowflake returns ['*'] when the allowlist is not set, so it is kept in the state only if it was configured explicitly.
+	allowlist := description.Allowlist
+	if slices.Equal(allowlist, []string{"*"}) && !slices.Contains(expandStringList(d.Get("allowlist").(*schema.Set).List()), "*") {
+		allowlist = make([]string, 0)
+	}
+
+	if errs := errors.Join(
+		d.Set("name", packagesPolicy.Name),
+		d.Set("database", packagesPolicy.DatabaseName),
+		d.Set("schema", packagesPolicy.SchemaName),
+		d.Set("allowlist", allowlist),
+		d.Set("blocklist", description.Blocklist),
+		d.Set("additional_creation_blocklist", description.AdditionalCreationBlocklist),
+		d.Set("comment", packagesPolicy.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.PackagesPolicyToSchema(packagesPolicy)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.PackagesPolicyDescriptionToSchema(*description)}),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdatePackagesPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, unset := sdk.NewPackagesPolicySetRequest(), sdk.NewPackagesPolicyUnsetRequest()
+	packageSpecsUpdate(d, "allowlist", &set.Allowlist, &unset.Allowlist)
+	packageSpecsUpdate(d, "blocklist", &set.Blocklist, &unset.Blocklist)
+	packageSpecsUpdate(d, "additional_creation_blocklist", &set.AdditionalCreationBlocklist, &unset.AdditionalCreationBlocklist)
+	if err := stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !reflect.DeepEqual(*set, *sdk.NewPackagesPolicySetRequest()) {
+		if err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for packages policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unset, *sdk.NewPackagesPolicyUnsetRequest()) {
+		if err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for packages policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadPackagesPolicy(ctx, d, meta)
+}
+
+func packageSpecsFromSet(packageSpecs *schema.Set) []sdk.StringListItemWrapper {
+	return collections.Map(expandStringList(packageSpecs.List()), func(packageSpec string) sdk.StringListItemWrapper {
+		return sdk.StringListItemWrapper{Value: packageSpec}
+	})
+}
+
+func packageSpecsUpdate(d *schema.ResourceData, key string, setField *[]sdk.StringListItemWrapper, unsetField **bool) {
+	if !d.HasChange(key) {
+		return
+	}
+	if packageSpecs := d.Get(key).(*schema.Set); packageSpecs.Len() > 0 {
+		*setField = packageSpecsFromSet(packageSpecs)
+	} else {
+		*unsetField = sdk.Bool(true)
+	}
+}
@@ -5,139 +5,148 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var passwordPolicySchema = map[string]*schema.Schema{
 	"database": {
-		Type:        schema.TypeString,
-		Required:    true,
-		ForceNew:    true,
-		Description: "The database this password policy belongs to.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the password policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
 	},
 	"schema": {
-		Type:        schema.TypeString,
-		Required:    true,
-		ForceNew:    true,
-		Description: "The schema this password policy belongs to.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the password policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
 	},
 	"name": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "Identifier for the password policy; must be unique for your account.",
-	},
-	"or_replace": {
-		Type:                  schema.TypeBool,
-		Optional:              true,
-		Default:               false,
-		Description:           "Whether to override a previous password policy with the same name.",
-		DiffSuppressOnRefresh: true,
-		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-			return old != new
-		},
-	},
-	"if_not_exists": {
-		Type:                  schema.TypeBool,
-		Optional:              true,
-		Default:               false,
-		Description:           "Prevent overwriting a previous password policy with the same name.",
-		DiffSuppressOnRefresh: true,
-		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-			return old != new
-		},
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the password policy; must be unique for the schema in which the password policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
 	},
 	"min_length": {
-		Type:         schema.TypeInt,
-		Optional:     true,
-		Default:      8,
-		Description:  "Specifies the minimum number of characters the password must contain. Supported range: 8 to 256, inclusive. Default: 8",
-		ValidateFunc: validation.IntBetween(8, 256),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(8, 256)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("password_min_length"),
+		Description:      "Specifies the minimum number of characters the password must contain. Supported range: 8 to 256, inclusive.",
 	},
 	"max_length": {
-		Type:         schema.TypeInt,
-		Optional:     true,
-		Default:      256,
-		Description:  "Specifies the maximum number of characters the password must contain. This number must be greater than or equal to the sum of PASSWORD_MIN_LENGTH, PASSWORD_MIN_UPPER_CASE_CHARS, and PASSWORD_MIN_LOWER_CASE_CHARS. Supported range: 8 to 256, inclusive. Default: 256",
-		ValidateFunc: validation.IntBetween(8, 256),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(8, 256)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("password_max_length"),
+		Description:      "Specifies the maximum number of characters the password must contain. This number must be greater than or equal to the sum of `min_length`, `min_upper_case_chars`, and `min_lower_case_chars`. Supported range: 8 to 256, inclusive.",
 	},
 	"min_upper_case_chars": {
-		Type:         schema.TypeInt,
-		Optional:     true,
-		Default:      1,
-		Description:  "Specifies the minimum number of uppercase characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1",
-		ValidateFunc: validation.IntBetween(0, 256),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 256)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("password_min_upper_case_chars"),
+		Description:      "Specifies the minimum number of uppercase characters the password must contain. Supported range: 0 to 256, inclusive.",
 	},
 	"min_lower_case_chars": {
-		Type:         schema.TypeInt,
-		Optional:     true,
-		Default:      1,
-		Description:  "Specifies the minimum number of lowercase characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1",
-		ValidateFunc: validation.IntBetween(0, 256),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 256)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("password_min_lower_case_chars"),
+		Description:      "Specifies the minimum number of lowercase characters the password must contain. Supported range: 0 to 256, inclusive.",
 	},
 	"min_numeric_chars": {
-		Type:         schema.TypeInt,
-		Optional:     true,
-		Default:      1,
-		Description:  "Specifies the minimum number of numeric characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1",
-		ValidateFunc: validation.IntBetween(0, 256),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 256)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("password_min_numeric_chars"),
+		Description:      "Specifies the minimum number of numeric characters the password must contain. Supported range: 0 to 256, inclusive.",
 	},
 	"min_special_chars": {
-		Type:         schema.TypeInt,
-		Optional:     true,
-		Default:      1,
-		Description:  "Specifies the minimum number of special characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1",
-		ValidateFunc: validation.IntBetween(0, 256),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 256)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("password_min_special_chars"),
+		Description:      "Specifies the minimum number of special characters the password must contain. Supported range: 0 to 256, inclusive.",
 	},
 	"min_age_days": {
-		Type:         schema.TypeInt,
-		Optional:     true,
-		Default:      0,
-		Description:  "Specifies the number of days the user must wait before a recently changed password can be changed again. Supported range: 0 to 999, inclusive. Default: 0",
-		ValidateFunc: validation.IntBetween(0, 999),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 999)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("password_min_age_days"),
+		Description:      "Specifies the number of days the user must wait before a recently changed password can be changed again. Supported range: 0 to 999, inclusive.",
 	},
 	"max_age_days": {
-		Type:         schema.TypeInt,
-		Optional:     true,
-		Default:      90,
-		Description:  "Specifies the maximum number of days before the password must be changed. Supported range: 0 to 999, inclusive. A value of zero (i.e. 0) indicates that the password does not need to be changed. Snowflake does not recommend choosing this value for a default account-level password policy or for any user-level policy. Instead, choose a value that meets your internal security guidelines. Default: 90, which means the password must be changed every 90 days.",
-		ValidateFunc: validation.IntBetween(0, 999),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 999)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("password_max_age_days"),
+		Description:      "Specifies the maximum number of days before the password must be changed. Supported range: 0 to 999, inclusive. A value of zero (i.e. 0) indicates that the password does not need to be changed. Snowflake does not recommend choosing this value for a default account-level password policy or for any user-level policy. Instead, choose a value that meets your internal security guidelines.",
 	},
 	"max_retries": {
-		Type:         schema.TypeInt,
-		Optional:     true,
-		Default:      5,
-		Description:  "Specifies the maximum number of attempts to enter a password before being locked out. Supported range: 1 to 10, inclusive. Default: 5",
-		ValidateFunc: validation.IntBetween(1, 10),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 10)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("password_max_retries"),
+		Description:      "Specifies the maximum number of attempts to enter a password before being locked out. Supported range: 1 to 10, inclusive.",
 	},
 	"lockout_time_mins": {
-		Type:         schema.TypeInt,
-		Optional:     true,
-		Default:      15,
-		Description:  "Specifies the number of minutes the user account will be locked after exhausting the designated number of password retries (i.e. PASSWORD_MAX_RETRIES). Supported range: 1 to 999, inclusive. Default: 15",
-		ValidateFunc: validation.IntBetween(1, 999),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 999)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("password_lockout_time_mins"),
+		Description:      "Specifies the number of minutes the user account will be locked after exhausting the designated number of password retries (i.e. `max_retries`). Supported range: 1 to 999, inclusive.",
 	},
 	"history": {
-		Type:         schema.TypeInt,
-		Optional:     true,
-		Default:      0,
-		Description:  "Specifies the number of the most recent passwords that Snowflake stores. These stored passwords cannot be repeated when a user updates their password value. The current password value does not count towards the history. When you increase the history value, Snowflake saves the previous values. When you decrease the value, Snowflake saves the stored values up to that value that is set. For example, if the history value is 8 and you change the history value to 3, Snowflake stores the most recent 3 passwords and deletes the 5 older password values from the history. Default: 0 Max: 24",
-		ValidateFunc: validation.IntBetween(0, 24),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 24)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("password_history"),
+		Description:      "Specifies the number of the most recent passwords that Snowflake stores. These stored passwords cannot be repeated when a user updates their password value. The current password value does not count towards the history. Supported range: 0 to 24, inclusive.",
 	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Adds a comment or overwrites an existing comment for the password policy.",
+		Description: "Specifies a comment for the password policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW PASSWORD POLICIES` for the given password policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowPasswordPolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE PASSWORD POLICY` for the given password policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.PasswordPolicyDescribeSchema,
+		},
 	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
@@ -145,311 +154,246 @@ var passwordPolicySchema = map[string]*schema.Schema{
 func PasswordPolicy() *schema.Resource {
 	// TODO(SNOW-1818849): unassign policies before dropping
 	deleteFunc := ResourceDeleteContextFunc(
-		helpers.DecodeSnowflakeIDErrLegacy[sdk.SchemaObjectIdentifier],
+		sdk.ParseSchemaObjectIdentifier,
 		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
 			return client.PasswordPolicies.DropSafely
 		},
 	)
 
 	return &schema.Resource{
-		Description:   "A password policy specifies the requirements that must be met to create and reset a password to authenticate to Snowflake.",
+		SchemaVersion: 1,
+
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.PasswordPolicyResource), TrackingCreateWrapper(resources.PasswordPolicy, CreatePasswordPolicy)),
-		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.PasswordPolicyResource), TrackingReadWrapper(resources.PasswordPolicy, ReadPasswordPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.PasswordPolicyResource), TrackingReadWrapper(resources.PasswordPolicy, ReadPasswordPolicyFunc(true))),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.PasswordPolicyResource), TrackingUpdateWrapper(resources.PasswordPolicy, UpdatePasswordPolicy)),
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.PasswordPolicyResource), TrackingDeleteWrapper(resources.PasswordPolicy, deleteFunc)),
+		Description:   "Resource used to manage password policy objects. A password policy specifies the requirements that must be met to create and reset a password to authenticate to Snowflake. For more information, check [password policy documentation](https://docs.snowflake.com/en/sql-reference/sql/create-password-policy).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.PasswordPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(passwordPolicySchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(passwordPolicySchema, DescribeOutputAttributeName, "name", "comment", "min_length", "max_length", "min_upper_case_chars", "min_lower_case_chars", "min_numeric_chars", "min_special_chars", "min_age_days", "max_age_days", "max_retries", "lockout_time_mins", "history"),
 			ComputedIfAnyAttributeChanged(passwordPolicySchema, FullyQualifiedNameAttributeName, "name"),
 		)),
 
 		Schema: passwordPolicySchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: TrackingImportWrapper(resources.PasswordPolicy, ImportPasswordPolicy),
 		},
 		Timeouts: defaultTimeouts,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting type to cty.EmptyObject is a bit hacky here but following https://developer.hashicorp.com/terraform/plugin/framework/migrating/resources/state-upgrade#sdkv2-1 would require lots of repetitive code; this should work with cty.EmptyObject
+				Type:    cty.EmptyObject,
+				Upgrade: v2_12_0_PasswordPolicyStateUpgrader,
+			},
+		},
 	}
 }
 
-// CreatePasswordPolicy implements schema.CreateFunc.
-func CreatePasswordPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func ImportPasswordPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	client := meta.(*provider.Context).Client
-
-	name := d.Get("name").(string)
-	database := d.Get("database").(string)
-	schema := d.Get("schema").(string)
-	objectIdentifier := sdk.NewSchemaObjectIdentifier(database, schema, name)
-
-	createOptions := &sdk.CreatePasswordPolicyOptions{
-		OrReplace:                 sdk.Bool(d.Get("or_replace").(bool)),
-		IfNotExists:               sdk.Bool(d.Get("if_not_exists").(bool)),
-		PasswordMinLength:         sdk.Int(d.Get("min_length").(int)),
-		PasswordMaxLength:         sdk.Int(d.Get("max_length").(int)),
-		PasswordMinUpperCaseChars: sdk.Int(d.Get("min_upper_case_chars").(int)),
-		PasswordMinLowerCaseChars: sdk.Int(d.Get("min_lower_case_chars").(int)),
-		PasswordMinNumericChars:   sdk.Int(d.Get("min_numeric_chars").(int)),
-		PasswordMinSpecialChars:   sdk.Int(d.Get("min_special_chars").(int)),
-		PasswordMinAgeDays:        sdk.Int(d.Get("min_age_days").(int)),
-		PasswordMaxAgeDays:        sdk.Int(d.Get("max_age_days").(int)),
-		PasswordMaxRetries:        sdk.Int(d.Get("max_retries").(int)),
-		PasswordLockoutTimeMins:   sdk.Int(d.Get("lockout_time_mins").(int)),
-		PasswordHistory:           sdk.Int(d.Get("history").(int)),
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
-	if v, ok := d.GetOk("comment"); ok {
-		createOptions.Comment = sdk.String(v.(string))
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](context.Background(), d, nil); err != nil {
+		return nil, err
 	}
 
-	err := client.PasswordPolicies.Create(ctx, objectIdentifier, createOptions)
+	details, err := client.PasswordPolicies.Describe(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
-	}
-	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
-	return ReadPasswordPolicy(ctx, d, meta)
+		return nil, err
+	}
+
+	if err := errors.Join(
+		setFromIntProperty(d, "min_length", details.PasswordMinLength),
+		setFromIntProperty(d, "max_length", details.PasswordMaxLength),
+		setFromIntProperty(d, "min_upper_case_chars", details.PasswordMinUpperCaseChars),
+		setFromIntProperty(d, "min_lower_case_chars", details.PasswordMinLowerCaseChars),
+		setFromIntProperty(d, "min_numeric_chars", details.PasswordMinNumericChars),
+		setFromIntProperty(d, "min_special_chars", details.PasswordMinSpecialChars),
+		setFromIntProperty(d, "min_age_days", details.PasswordMinAgeDays),
+		setFromIntProperty(d, "max_age_days", details.PasswordMaxAgeDays),
+		setFromIntProperty(d, "max_retries", details.PasswordMaxRetries),
+		setFromIntProperty(d, "lockout_time_mins", details.PasswordLockoutTimeMins),
+		setFromIntProperty(d, "history", details.PasswordHistory),
+	); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
 }
 
-// ReadPasswordPolicy implements schema.ReadFunc.
-func ReadPasswordPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func CreatePasswordPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-
-	id := helpers.DecodeSnowflakeIDLegacy(d.Id()).(sdk.SchemaObjectIdentifier)
-
-	passwordPolicy, err := client.PasswordPolicies.ShowByIDSafely(ctx, id)
-	if err != nil {
-		if errors.Is(err, sdk.ErrObjectNotFound) {
-			d.SetId("")
-			return diag.Diagnostics{
-				diag.Diagnostic{
-					Severity: diag.Warning,
-					Summary:  "Failed to query password policy. Marking the resource as removed.",
-					Detail:   fmt.Sprintf("Password policy id: %s, Err: %s", id.FullyQualifiedName(), err),
-				},
-			}
-		}
-		return diag.FromErr(err)
-	}
-	if err := d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()); err != nil {
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	opts := &sdk.CreatePasswordPolicyOptions{}
+	if err := errors.Join(
+		intAttributeWithSpecialDefaultCreate(d, "min_length", &opts.PasswordMinLength),
+		intAttributeWithSpecialDefaultCreate(d, "max_length", &opts.PasswordMaxLength),
+		intAttributeWithSpecialDefaultCreate(d, "min_upper_case_chars", &opts.PasswordMinUpperCaseChars),
+		intAttributeWithSpecialDefaultCreate(d, "min_lower_case_chars", &opts.PasswordMinLowerCaseChars),
+		intAttributeWithSpecialDefaultCreate(d, "min_numeric_chars", &opts.PasswordMinNumericChars),
+		intAttributeWithSpecialDefaultCreate(d, "min_special_chars", &opts.PasswordMinSpecialChars),
+		intAttributeWithSpecialDefaultCreate(d, "min_age_days", &opts.PasswordMinAgeDays),
+		intAttributeWithSpecialDefaultCreate(d, "max_age_days", &opts.PasswordMaxAgeDays),
+		intAttributeWithSpecialDefaultCreate(d, "max_retries", &opts.PasswordMaxRetries),
+		intAttributeWithSpecialDefaultCreate(d, "lockout_time_mins", &opts.PasswordLockoutTimeMins),
+		intAttributeWithSpecialDefaultCreate(d, "history", &opts.PasswordHistory),
+		stringAttributeCreate(d, "comment", &opts.Comment),
+	); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("database", passwordPolicy.DatabaseName); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("schema", passwordPolicy.SchemaName); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("name", passwordPolicy.Name); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("comment", passwordPolicy.Comment); err != nil {
-		return diag.FromErr(err)
-	}
-	passwordPolicyDetails, err := client.PasswordPolicies.Describe(ctx, id)
-	if err != nil {
+	if err := client.PasswordPolicies.Create(ctx, id, opts); err != nil {
 		return diag.FromErr(err)
 	}
+	d.SetId(helpers.EncodeResourceIdentifier(id))
 
-	if err := setFromIntProperty(d, "min_length", passwordPolicyDetails.PasswordMinLength); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := setFromIntProperty(d, "max_length", passwordPolicyDetails.PasswordMaxLength); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := setFromIntProperty(d, "min_upper_case_chars", passwordPolicyDetails.PasswordMinUpperCaseChars); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := setFromIntProperty(d, "min_lower_case_chars", passwordPolicyDetails.PasswordMinLowerCaseChars); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := setFromIntProperty(d, "min_numeric_chars", passwordPolicyDetails.PasswordMinNumericChars); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := setFromIntProperty(d, "min_special_chars", passwordPolicyDetails.PasswordMinSpecialChars); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := setFromIntProperty(d, "min_age_days", passwordPolicyDetails.PasswordMinAgeDays); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := setFromIntProperty(d, "max_age_days", passwordPolicyDetails.PasswordMaxAgeDays); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := setFromIntProperty(d, "max_retries", passwordPolicyDetails.PasswordMaxRetries); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := setFromIntProperty(d, "lockout_time_mins", passwordPolicyDetails.PasswordLockoutTimeMins); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := setFromIntProperty(d, "history", passwordPolicyDetails.PasswordHistory); err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
+	return ReadPasswordPolicyFunc(false)(ctx, d, meta)
 }
 
-// UpdatePasswordPolicy implements schema.UpdateFunc.
-func UpdatePasswordPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*provider.Context).Client
-
-	objectIdentifier := helpers.DecodeSnowflakeIDLegacy(d.Id()).(sdk.SchemaObjectIdentifier)
-
-	if d.HasChange("name") {
-		newId := sdk.NewSchemaObjectIdentifierInSchema(objectIdentifier.SchemaId(), d.Get("name").(string))
-
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, &sdk.AlterPasswordPolicyOptions{
-			NewName: &newId,
-		})
+func ReadPasswordPolicyFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		d.SetId(helpers.EncodeSnowflakeID(newId))
-		objectIdentifier = newId
-	}
-
-	if d.HasChange("min_length") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{
-			Set: &sdk.PasswordPolicySet{
-				PasswordMinLength: sdk.Int(d.Get("min_length").(int)),
-			},
-		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange("max_length") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{
-			Set: &sdk.PasswordPolicySet{
-				PasswordMaxLength: sdk.Int(d.Get("max_length").(int)),
-			},
-		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange("min_upper_case_chars") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{
-			Set: &sdk.PasswordPolicySet{
-				PasswordMinUpperCaseChars: sdk.Int(d.Get("min_upper_case_chars").(int)),
-			},
-		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange("min_lower_case_chars") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{
-			Set: &sdk.PasswordPolicySet{
-				PasswordMinLowerCaseChars: sdk.Int(d.Get("min_lower_case_chars").(int)),
-			},
-		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
+		passwordPolicy, err := client.PasswordPolicies.ShowByIDSafely(ctx, id)
 		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query password policy. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Password policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
 			return diag.FromErr(err)
 		}
-	}
 
-	if d.HasChange("min_numeric_chars") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{
-			Set: &sdk.PasswordPolicySet{
-				PasswordMinNumericChars: sdk.Int(d.Get("min_numeric_chars").(int)),
-			},
-		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
+		details, err := client.PasswordPolicies.Describe(ctx, id)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-	}
 
-	if d.HasChange("min_special_chars") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{
-			Set: &sdk.PasswordPolicySet{
-				PasswordMinSpecialChars: sdk.Int(d.Get("min_special_chars").(int)),
-			},
+		if withExternalChangesMarking {
+			if err = handleExternalChangesToObjectInFlatDescribe(d,
+				passwordPolicyOutputMapping("password_min_length", "min_length", details.PasswordMinLength),
+				passwordPolicyOutputMapping("password_max_length", "max_length", details.PasswordMaxLength),
+				passwordPolicyOutputMapping("password_min_upper_case_chars", "min_upper_case_chars", details.PasswordMinUpperCaseChars),
+				passwordPolicyOutputMapping("password_min_lower_case_chars", "min_lower_case_chars", details.PasswordMinLowerCaseChars),
+				passwordPolicyOutputMapping("password_min_numeric_chars", "min_numeric_chars", details.PasswordMinNumericChars),
+				passwordPolicyOutputMapping("password_min_special_chars", "min_special_chars", details.PasswordMinSpecialChars),
+				passwordPolicyOutputMapping("password_min_age_days", "min_age_days", details.PasswordMinAgeDays),
+				passwordPolicyOutputMapping("password_max_age_days", "max_age_days", details.PasswordMaxAgeDays),
+				passwordPolicyOutputMapping("password_max_retries", "max_retries", details.PasswordMaxRetries),
+				passwordPolicyOutputMapping("password_lockout_time_mins", "lockout_time_mins", details.PasswordLockoutTimeMins),
+				passwordPolicyOutputMapping("password_history", "history", details.PasswordHistory),
+			); err != nil {
+				return diag.FromErr(err)
+			}
 		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
+
+		if err = setStateToValuesFromConfig(d, passwordPolicySchema, []string{
+			"min_length",
+			"max_length",
+			"min_upper_case_chars",
+			"min_lower_case_chars",
+			"min_numeric_chars",
+			"min_special_chars",
+			"min_age_days",
+			"max_age_days",
+			"max_retries",
+			"lockout_time_mins",
+			"history",
+		}); err != nil {
 			return diag.FromErr(err)
 		}
-	}
 
-	if d.HasChange("min_age_days") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{
-			Set: &sdk.PasswordPolicySet{
-				PasswordMinAgeDays: sdk.Int(d.Get("min_age_days").(int)),
-			},
-		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
+		if err := errors.Join(
+			d.Set("comment", passwordPolicy.Comment),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.PasswordPolicyToSchema(passwordPolicy)}),
+			d.Set(DescribeOutputAttributeName, []map[string]any{schemas.PasswordPolicyDetailsToSchema(details)}),
+		); err != nil {
 			return diag.FromErr(err)
 		}
+
+		return nil
 	}
+}
 
-	if d.HasChange("max_age_days") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{
-			Set: &sdk.PasswordPolicySet{
-				PasswordMaxAgeDays: sdk.Int(d.Get("max_age_days").(int)),
-			},
-		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+// passwordPolicyOutputMapping builds the mapping between DESCRIBE PASSWORD POLICY property and the corresponding int attribute.
+func passwordPolicyOutputMapping(nameInOutput string, nameInConfig string, property *sdk.IntProperty) outputMapping {
+	var value int
+	if property != nil && property.Value != nil {
+		value = *property.Value
 	}
+	return outputMapping{nameInOutput, nameInConfig, value, value, nil}
+}
 
-	if d.HasChange("max_retries") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{
-			Set: &sdk.PasswordPolicySet{
-				PasswordMaxRetries: sdk.Int(d.Get("max_retries").(int)),
-			},
-		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+func UpdatePasswordPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if d.HasChange("lockout_time_mins") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{
-			Set: &sdk.PasswordPolicySet{
-				PasswordLockoutTimeMins: sdk.Int(d.Get("lockout_time_mins").(int)),
-			},
-		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		err = client.PasswordPolicies.Alter(ctx, id, &sdk.AlterPasswordPolicyOptions{
+			NewName: &newId,
+		})
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	set, unset := &sdk.PasswordPolicySet{}, &sdk.PasswordPolicyUnset{}
+	if err := errors.Join(
+		intAttributeWithSpecialDefaultUpdate(d, "min_length", &set.PasswordMinLength, &unset.PasswordMinLength),
+		intAttributeWithSpecialDefaultUpdate(d, "max_length", &set.PasswordMaxLength, &unset.PasswordMaxLength),
+		intAttributeWithSpecialDefaultUpdate(d, "min_upper_case_chars", &set.PasswordMinUpperCaseChars, &unset.PasswordMinUpperCaseChars),
+		intAttributeWithSpecialDefaultUpdate(d, "min_lower_case_chars", &set.PasswordMinLowerCaseChars, &unset.PasswordMinLowerCaseChars),
+		intAttributeWithSpecialDefaultUpdate(d, "min_numeric_chars", &set.PasswordMinNumericChars, &unset.PasswordMinNumericChars),
+		intAttributeWithSpecialDefaultUpdate(d, "min_special_chars", &set.PasswordMinSpecialChars, &unset.PasswordMinSpecialChars),
+		intAttributeWithSpecialDefaultUpdate(d, "min_age_days", &set.PasswordMinAgeDays, &unset.PasswordMinAgeDays),
+		intAttributeWithSpecialDefaultUpdate(d, "max_age_days", &set.PasswordMaxAgeDays, &unset.PasswordMaxAgeDays),
+		intAttributeWithSpecialDefaultUpdate(d, "max_retries", &set.PasswordMaxRetries, &unset.PasswordMaxRetries),
+		intAttributeWithSpecialDefaultUpdate(d, "lockout_time_mins", &set.PasswordLockoutTimeMins, &unset.PasswordLockoutTimeMins),
+		intAttributeWithSpecialDefaultUpdate(d, "history", &set.PasswordHistory, &unset.PasswordHistory),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	); err != nil {
+		return diag.FromErr(err)
 	}
 
-	if d.HasChange("history") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{
-			Set: &sdk.PasswordPolicySet{
-				PasswordHistory: sdk.Int(d.Get("history").(int)),
-			},
-		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
+	if (*set != sdk.PasswordPolicySet{}) {
+		if err := client.PasswordPolicies.Alter(ctx, id, &sdk.AlterPasswordPolicyOptions{Set: set}); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	if d.HasChange("comment") {
-		alterOptions := &sdk.AlterPasswordPolicyOptions{}
-		if v, ok := d.GetOk("comment"); ok {
-			alterOptions.Set = &sdk.PasswordPolicySet{
-				Comment: sdk.String(v.(string)),
-			}
-		} else {
-			alterOptions.Unset = &sdk.PasswordPolicyUnset{
-				Comment: sdk.Bool(true),
-			}
-		}
-		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
+	if (*unset != sdk.PasswordPolicyUnset{}) {
+		if err := client.PasswordPolicies.Alter(ctx, id, &sdk.AlterPasswordPolicyOptions{Unset: unset}); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	return ReadPasswordPolicy(ctx, d, meta)
+	return ReadPasswordPolicyFunc(false)(ctx, d, meta)
 }
@@ -0,0 +1,16 @@
+package resources
+
+import (
+	"context"
+)
+
+func v2_12_0_PasswordPolicyStateUpgrader(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	delete(rawState, "or_replace")
+	delete(rawState, "if_not_exists")
+
+	return migratePipeSeparatedObjectIdentifierResourceIdToFullyQualifiedName(ctx, rawState, meta)
+}
@@ -0,0 +1,228 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var privacyPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the privacy policy; must be unique for the database and schema in which the privacy policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the privacy policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the privacy policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"body": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      diffSuppressStatementFieldDescription("Specifies the SQL expression returning the privacy budget, e.g. `NO_PRIVACY_POLICY()` or `PRIVACY_BUDGET(BUDGET_NAME => 'analysts')`. For more information, check [privacy policy body documentation](https://docs.snowflake.com/en/sql-reference/sql/create-privacy-policy#required-parameters)."),
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the privacy policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW PRIVACY POLICIES` for the given privacy policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowPrivacyPolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE PRIVACY POLICY` for the given privacy policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.PrivacyPolicyDescribeSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+// PrivacyPolicy returns a pointer to the resource representing a privacy policy.
+func PrivacyPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.PrivacyPolicies.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.PrivacyPolicyResource), TrackingCreateWrapper(resources.PrivacyPolicy, CreatePrivacyPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.PrivacyPolicyResource), TrackingReadWrapper(resources.PrivacyPolicy, ReadPrivacyPolicy)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.PrivacyPolicyResource), TrackingUpdateWrapper(resources.PrivacyPolicy, UpdatePrivacyPolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.PrivacyPolicyResource), TrackingDeleteWrapper(resources.PrivacyPolicy, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage privacy policy objects. For more information, check [privacy policy documentation](https://docs.snowflake.com/en/user-guide/diff-privacy/differential-privacy-overview).",
+			"To attach the policy to a table or a view, use the `snowflake_privacy_policy_attachment` resource.",
+		),
+
+		Schema: privacyPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.PrivacyPolicy, ImportPrivacyPolicy),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.PrivacyPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(privacyPolicySchema, ShowOutputAttributeName, "comment", "name"),
+			ComputedIfAnyAttributeChanged(privacyPolicySchema, DescribeOutputAttributeName, "body", "name"),
+			ComputedIfAnyAttributeChanged(privacyPolicySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportPrivacyPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := client.PrivacyPolicies.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	policyDescription, err := client.PrivacyPolicies.Describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("database", id.DatabaseName()),
+		d.Set("schema", id.SchemaName()),
+		d.Set("comment", policy.Comment),
+		d.Set("body", policyDescription.Body),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreatePrivacyPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreatePrivacyPolicyRequest(id, d.Get("body").(string))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.PrivacyPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating privacy policy %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	return ReadPrivacyPolicy(ctx, d, meta)
+}
+
+func ReadPrivacyPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privacyPolicy, err := client.PrivacyPolicies.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query privacy policy. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Privacy policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	privacyPolicyDescription, err := client.PrivacyPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if errs := errors.Join(
+		d.Set("name", privacyPolicy.Name),
+		d.Set("database", privacyPolicy.DatabaseName),
+		d.Set("schema", privacyPolicy.SchemaName),
+		d.Set("comment", privacyPolicy.Comment),
+		d.Set("body", privacyPolicyDescription.Body),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.PrivacyPolicyToSchema(privacyPolicy)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.PrivacyPolicyDescriptionToSchema(*privacyPolicyDescription)}),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdatePrivacyPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.PrivacyPolicies.Alter(ctx, sdk.NewAlterPrivacyPolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming privacy policy %s, err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment == "" {
+			if err := client.PrivacyPolicies.Alter(ctx, sdk.NewAlterPrivacyPolicyRequest(id).WithUnsetComment(true)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting comment for privacy policy %s, err = %w", d.Id(), err))
+			}
+		} else {
+			if err := client.PrivacyPolicies.Alter(ctx, sdk.NewAlterPrivacyPolicyRequest(id).WithSetComment(comment)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting comment for privacy policy %s, err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("body") {
+		if err := client.PrivacyPolicies.Alter(ctx, sdk.NewAlterPrivacyPolicyRequest(id).WithSetBody(d.Get("body").(string))); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting body for privacy policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadPrivacyPolicy(ctx, d, meta)
+}
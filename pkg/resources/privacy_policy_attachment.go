@@ -0,0 +1,223 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var privacyPolicyAttachmentSchema = map[string]*schema.Schema{
+	"privacy_policy": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Fully qualified name of the privacy policy to attach.", resources.PrivacyPolicy),
+	},
+	"table": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     []string{"table", "view"},
+		Description:      relatedResourceDescription("Fully qualified name of the table to which the privacy policy is attached.", resources.Table),
+	},
+	"view": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     []string{"table", "view"},
+		Description:      relatedResourceDescription("Fully qualified name of the view to which the privacy policy is attached.", resources.View),
+	},
+	"entity_key": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		ForceNew:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Specifies the columns identifying the entities (e.g. individuals) protected by the privacy policy. When specified, the privacy is protected at the entity level instead of the row level.",
+	},
+}
+
+// PrivacyPolicyAttachment returns a pointer to the resource representing a privacy policy attachment.
+func PrivacyPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.PrivacyPolicyAttachmentResource), TrackingCreateWrapper(resources.PrivacyPolicyAttachment, CreatePrivacyPolicyAttachment)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.PrivacyPolicyAttachmentResource), TrackingReadWrapper(resources.PrivacyPolicyAttachment, ReadPrivacyPolicyAttachment)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.PrivacyPolicyAttachmentResource), TrackingDeleteWrapper(resources.PrivacyPolicyAttachment, DeletePrivacyPolicyAttachment)),
+		Description:   "Resource used to attach a privacy policy to a table or a view. Only one privacy policy can be attached to a given table or view.",
+
+		Schema: privacyPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.PrivacyPolicyAttachment, ImportPrivacyPolicyAttachment),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func parsePrivacyPolicyAttachmentId(id string) (sdk.PolicyEntityDomain, sdk.SchemaObjectIdentifier, sdk.SchemaObjectIdentifier, error) {
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) != 3 {
+		return "", sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, fmt.Errorf("required id format 'TABLE|<table_name>|<privacy_policy_name>' or 'VIEW|<view_name>|<privacy_policy_name>', but got: '%s'", id)
+	}
+	domain := sdk.PolicyEntityDomain(parts[0])
+	if domain != sdk.PolicyEntityDomainTable && domain != sdk.PolicyEntityDomainView {
+		return "", sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, fmt.Errorf("invalid object type %s, expected one of: %s, %s", parts[0], sdk.PolicyEntityDomainTable, sdk.PolicyEntityDomainView)
+	}
+	objectId, err := sdk.ParseSchemaObjectIdentifier(parts[1])
+	if err != nil {
+		return "", sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, err
+	}
+	policyId, err := sdk.ParseSchemaObjectIdentifier(parts[2])
+	if err != nil {
+		return "", sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, err
+	}
+	return domain, objectId, policyId, nil
+}
+
+func ImportPrivacyPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	domain, objectId, _, err := parsePrivacyPolicyAttachmentId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	switch domain {
+	case sdk.PolicyEntityDomainTable:
+		err = d.Set("table", objectId.FullyQualifiedName())
+	case sdk.PolicyEntityDomainView:
+		err = d.Set("view", objectId.FullyQualifiedName())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreatePrivacyPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	policyId, err := sdk.ParseSchemaObjectIdentifier(d.Get("privacy_policy").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var entityKey []sdk.Column
+	if v, ok := d.GetOk("entity_key"); ok {
+		entityKey = collections.Map(expandStringList(v.(*schema.Set).List()), func(column string) sdk.Column { return sdk.Column{Value: column} })
+	}
+
+	var domain sdk.PolicyEntityDomain
+	var objectId sdk.SchemaObjectIdentifier
+	if v, ok := d.GetOk("table"); ok {
+		domain = sdk.PolicyEntityDomainTable
+		if objectId, err = sdk.ParseSchemaObjectIdentifier(v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+		request := sdk.NewTableAddPrivacyPolicyRequest(policyId)
+		if len(entityKey) > 0 {
+			request.WithEntityKey(entityKey)
+		}
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(objectId).WithAddPrivacyPolicy(request))
+	} else {
+		domain = sdk.PolicyEntityDomainView
+		if objectId, err = sdk.ParseSchemaObjectIdentifier(d.Get("view").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+		request := sdk.NewViewAddPrivacyPolicyRequest(policyId)
+		if len(entityKey) > 0 {
+			request.WithEntityKey(entityKey)
+		}
+		err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(objectId).WithAddPrivacyPolicy(*request))
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error attaching privacy policy %s to %s %s, err = %w", policyId.FullyQualifiedName(), domain, objectId.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(string(domain), objectId.FullyQualifiedName(), policyId.FullyQualifiedName()))
+
+	return ReadPrivacyPolicyAttachment(ctx, d, meta)
+}
+
+func ReadPrivacyPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	domain, objectId, _, err := parsePrivacyPolicyAttachmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Note: there is no alphanumeric id for an attachment, so we retrieve the privacy policies attached to a certain object.
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(objectId, domain))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to get object policies. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Object type: %s, object id: %s, Err: %s", domain, objectId.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	privacyPolicyReference, err := collections.FindFirst(policyReferences, func(reference sdk.PolicyReference) bool {
+		return reference.PolicyKind == sdk.PolicyKindPrivacyPolicy
+	})
+	// Note: this means the attachment has been removed outside of Terraform.
+	if err != nil {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to find the privacy policy attached to the object. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Object type: %s, object id: %s", domain, objectId.FullyQualifiedName()),
+			},
+		}
+	}
+
+	policyId := sdk.NewSchemaObjectIdentifier(*privacyPolicyReference.PolicyDb, *privacyPolicyReference.PolicySchema, privacyPolicyReference.PolicyName)
+	entityKey := make([]string, 0)
+	if privacyPolicyReference.RefArgColumnNames != nil {
+		entityKey = sdk.ParseCommaSeparatedStringArray(*privacyPolicyReference.RefArgColumnNames, true)
+	}
+
+	if errs := errors.Join(
+		d.Set("privacy_policy", policyId.FullyQualifiedName()),
+		d.Set("entity_key", entityKey),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func DeletePrivacyPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	domain, objectId, policyId, err := parsePrivacyPolicyAttachmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	switch domain {
+	case sdk.PolicyEntityDomainTable:
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(objectId).WithDropPrivacyPolicy(sdk.NewTableDropPrivacyPolicyRequest(policyId)))
+	case sdk.PolicyEntityDomainView:
+		err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(objectId).WithDropPrivacyPolicy(*sdk.NewViewDropPrivacyPolicyRequest(policyId)))
+	}
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return diag.FromErr(fmt.Errorf("error detaching privacy policy %s from %s %s, err = %w", policyId.FullyQualifiedName(), domain, objectId.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -149,6 +149,7 @@ var saml2IntegrationSchema = map[string]*schema.Schema{
 			Schema: schemas.DescribeSaml2IntegrationSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -162,7 +163,7 @@ func SAML2Integration() *schema.Resource {
 
 		Schema: saml2IntegrationSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.Saml2SecurityIntegration, ImportSaml2Integration),
+			StateContext: TrackingImportWrapper(resources.Saml2SecurityIntegration, importWithTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ImportSaml2Integration)),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.Saml2SecurityIntegration, customdiff.All(
@@ -454,6 +455,9 @@ func CreateContextSAML2Integration(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
+	if err := handleTagsCreate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return ReadContextSAML2Integration(false)(ctx, d, meta)
 }
@@ -641,6 +645,10 @@ func ReadContextSAML2Integration(withExternalChangesMarking bool) schema.ReadCon
 			return diag.FromErr(err)
 		}
 
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+			return diag.FromErr(err)
+		}
+
 		return nil
 	}
 }
@@ -652,6 +660,10 @@ func UpdateContextSAML2Integration(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
+
 	set, unset := sdk.NewSaml2IntegrationSetRequest(), sdk.NewSaml2IntegrationUnsetRequest()
 
 	if d.HasChange("enabled") {
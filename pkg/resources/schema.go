@@ -85,6 +85,7 @@ var schemaSchema = map[string]*schema.Schema{
 			Schema: schemas.ShowSchemaParametersSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -116,7 +117,7 @@ func Schema() *schema.Resource {
 
 		Schema: collections.MergeMaps(schemaSchema, schemaParametersSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.Schema, ImportSchema),
+			StateContext: TrackingImportWrapper(resources.Schema, importWithTags(sdk.ObjectTypeSchema, sdk.ParseDatabaseObjectIdentifier, ImportSchema)),
 		},
 
 		StateUpgraders: []schema.StateUpgrader{
@@ -211,6 +212,11 @@ func CreateContextSchema(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 		opts.WithManagedAccess = sdk.Bool(parsed)
 	}
+	tags, err := getTagsForCreate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opts.Tag = tags
 	if err := client.Schemas.Create(ctx, id, opts); err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -257,6 +263,10 @@ func ReadContextSchema(withExternalChangesMarking bool) schema.ReadContextFunc {
 			return diag.FromErr(err)
 		}
 
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeSchema); err != nil {
+			return diag.FromErr(err)
+		}
+
 		schemaParameters, err := client.Schemas.ShowParameters(ctx, id)
 		if err != nil {
 			return diag.FromErr(err)
@@ -325,6 +335,10 @@ func UpdateContextSchema(ctx context.Context, d *schema.ResourceData, meta any)
 		id = newId
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeSchema); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("with_managed_access") {
 		if v := d.Get("with_managed_access").(string); v != BooleanDefault {
 			var err error
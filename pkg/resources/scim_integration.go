@@ -86,6 +86,7 @@ var scimIntegrationSchema = map[string]*schema.Schema{
 			Schema: schemas.DescribeScimSecurityIntegrationSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -99,7 +100,7 @@ func SCIMIntegration() *schema.Resource {
 
 		Schema: scimIntegrationSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.ScimSecurityIntegration, ImportScimIntegration),
+			StateContext: TrackingImportWrapper(resources.ScimSecurityIntegration, importWithTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ImportScimIntegration)),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.ScimSecurityIntegration, customdiff.All(
@@ -235,6 +236,9 @@ func CreateContextSCIMIntegration(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
+	if err := handleTagsCreate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return ReadContextSCIMIntegration(false)(ctx, d, meta)
 }
@@ -350,6 +354,10 @@ func ReadContextSCIMIntegration(withExternalChangesMarking bool) schema.ReadCont
 			return diag.FromErr(err)
 		}
 
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+			return diag.FromErr(err)
+		}
+
 		return nil
 	}
 }
@@ -361,6 +369,10 @@ func UpdateContextSCIMIntegration(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeIntegration); err != nil {
+		return diag.FromErr(err)
+	}
+
 	set, unset := sdk.NewScimIntegrationSetRequest(), sdk.NewScimIntegrationUnsetRequest()
 
 	if d.HasChange("enabled") {
@@ -25,11 +25,22 @@ var secretBasicAuthenticationSchema = func() map[string]*schema.Schema {
 			Description: "Specifies the username value to store in the secret.",
 		},
 		"password": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Sensitive:   true,
-			Description: externalChangesNotDetectedFieldDescription("Specifies the password value to store in the secret."),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ExactlyOneOf: []string{"password", "password_wo"},
+			Description:  externalChangesNotDetectedFieldDescription("Specifies the password value to store in the secret. Exactly one of `password` or `password_wo` has to be specified."),
+		},
+		"password_wo": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			WriteOnly:    true,
+			Sensitive:    true,
+			ExactlyOneOf: []string{"password", "password_wo"},
+			Description:  externalChangesNotDetectedFieldDescription(writeOnlyFieldDescription("Specifies the password value to store in the secret. Exactly one of `password` or `password_wo` has to be specified.", "password_wo_version")),
 		},
+		"password_wo_version": writeOnlyVersionSchema("password_wo"),
+		"password_wo_hash":    writeOnlyHashSchema("password_wo"),
 	}
 	return collections.MergeMaps(secretCommonSchema, secretBasicAuthentication)
 }()
@@ -43,12 +54,16 @@ func SecretWithBasicAuthentication() *schema.Resource {
 		Description:   "Resource used to manage secret objects with Basic Authentication. For more information, check [secret documentation](https://docs.snowflake.com/en/sql-reference/sql/create-secret).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.SecretWithBasicAuthentication, customdiff.All(
+			writeOnlyValueChangedCustomDiff("password_wo"),
 			ComputedIfAnyAttributeChanged(secretBasicAuthenticationSchema, ShowOutputAttributeName, "comment"),
 			ComputedIfAnyAttributeChanged(secretBasicAuthenticationSchema, DescribeOutputAttributeName, "username", "comment"),
 			RecreateWhenSecretTypeChangedExternally(sdk.SecretTypePassword),
 		)),
 
 		Schema: secretBasicAuthenticationSchema,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			preferWriteOnlyAttribute("password", "password_wo"),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.SecretWithBasicAuthentication, ImportSecretWithBasicAuthentication),
 		},
@@ -85,20 +100,27 @@ func CreateContextSecretWithBasicAuthentication(ctx context.Context, d *schema.R
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
 	usernameString := d.Get("username").(string)
-	passwordString := d.Get("password").(string)
+	passwordString, err := getStringOrWriteOnlyString(d, "password", "password_wo")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	request := sdk.NewCreateWithBasicAuthenticationSecretRequest(id, usernameString, passwordString)
 	if v, ok := d.GetOk("comment"); ok {
 		request.WithComment(v.(string))
 	}
 
-	err := client.Secrets.CreateWithBasicAuthentication(ctx, request)
+	err = client.Secrets.CreateWithBasicAuthentication(ctx, request)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
 
+	if err := setWriteOnlyHash(d, "password_wo"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return ReadContextSecretWithBasicAuthentication(ctx, d, meta)
 }
 
@@ -161,9 +183,18 @@ func UpdateContextSecretWithBasicAuthentication(ctx context.Context, d *schema.R
 		setForBasicAuthentication.WithUsername(username)
 	}
 
+	// the password can't be unset, so only the new value of password or password_wo is set
+	var password *string
 	if d.HasChange("password") {
-		password := d.Get("password").(string)
-		setForBasicAuthentication.WithPassword(password)
+		if v, ok := d.GetOk("password"); ok {
+			password = sdk.String(v.(string))
+		}
+	}
+	if err := writeOnlyStringAttributeUpdate(d, "password_wo", "password_wo_version", &password, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	if password != nil {
+		setForBasicAuthentication.WithPassword(*password)
 	}
 
 	if !reflect.DeepEqual(*setForBasicAuthentication, sdk.SetForBasicAuthenticationRequest{}) {
@@ -19,11 +19,22 @@ import (
 var secretGenericStringSchema = func() map[string]*schema.Schema {
 	secretGenericString := map[string]*schema.Schema{
 		"secret_string": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Sensitive:   true,
-			Description: externalChangesNotDetectedFieldDescription("Specifies the string to store in the secret. The string can be an API token or a string of sensitive value that can be used in the handler code of a UDF or stored procedure. For details, see [Creating and using an external access integration](https://docs.snowflake.com/en/developer-guide/external-network-access/creating-using-external-network-access). You should not use this property to store any kind of OAuth token; use one of the other secret types for your OAuth use cases."),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ExactlyOneOf: []string{"secret_string", "secret_string_wo"},
+			Description:  externalChangesNotDetectedFieldDescription("Specifies the string to store in the secret. The string can be an API token or a string of sensitive value that can be used in the handler code of a UDF or stored procedure. For details, see [Creating and using an external access integration](https://docs.snowflake.com/en/developer-guide/external-network-access/creating-using-external-network-access). You should not use this property to store any kind of OAuth token; use one of the other secret types for your OAuth use cases. Exactly one of `secret_string` or `secret_string_wo` has to be specified."),
 		},
+		"secret_string_wo": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			WriteOnly:    true,
+			Sensitive:    true,
+			ExactlyOneOf: []string{"secret_string", "secret_string_wo"},
+			Description:  externalChangesNotDetectedFieldDescription(writeOnlyFieldDescription("Specifies the string to store in the secret. Exactly one of `secret_string` or `secret_string_wo` has to be specified.", "secret_string_wo_version")),
+		},
+		"secret_string_wo_version": writeOnlyVersionSchema("secret_string_wo"),
+		"secret_string_wo_hash":    writeOnlyHashSchema("secret_string_wo"),
 	}
 	return collections.MergeMaps(secretCommonSchema, secretGenericString)
 }()
@@ -37,12 +48,16 @@ func SecretWithGenericString() *schema.Resource {
 		Description:   "Resource used to manage secret objects with Generic String. For more information, check [secret documentation](https://docs.snowflake.com/en/sql-reference/sql/create-secret).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.SecretWithGenericString, customdiff.All(
+			writeOnlyValueChangedCustomDiff("secret_string_wo"),
 			ComputedIfAnyAttributeChanged(secretGenericStringSchema, ShowOutputAttributeName, "comment"),
 			ComputedIfAnyAttributeChanged(secretGenericStringSchema, DescribeOutputAttributeName, "comment"),
 			RecreateWhenSecretTypeChangedExternally(sdk.SecretTypeGenericString),
 		)),
 
 		Schema: secretGenericStringSchema,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			preferWriteOnlyAttribute("secret_string", "secret_string_wo"),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.SecretWithGenericString, ImportSecretWithGenericString),
 		},
@@ -68,20 +83,27 @@ func CreateContextSecretWithGenericString(ctx context.Context, d *schema.Resourc
 	databaseName, schemaName, name := d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string)
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
-	secretSting := d.Get("secret_string").(string)
+	secretString, err := getStringOrWriteOnlyString(d, "secret_string", "secret_string_wo")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	request := sdk.NewCreateWithGenericStringSecretRequest(id, secretSting)
+	request := sdk.NewCreateWithGenericStringSecretRequest(id, secretString)
 	if v, ok := d.GetOk("comment"); ok {
 		request.WithComment(v.(string))
 	}
 
-	err := client.Secrets.CreateWithGenericString(ctx, request)
+	err = client.Secrets.CreateWithGenericString(ctx, request)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
 
+	if err := setWriteOnlyHash(d, "secret_string_wo"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return ReadContextSecretWithGenericString(ctx, d, meta)
 }
 
@@ -135,9 +157,18 @@ func UpdateContextSecretWithGenericString(ctx context.Context, d *schema.Resourc
 	handleSecretUpdate(d, set, unset)
 	setForGenericString := &sdk.SetForGenericStringRequest{}
 
+	// the secret string can't be unset, so only the new value of secret_string or secret_string_wo is set
+	var secretString *string
 	if d.HasChange("secret_string") {
-		secretString := d.Get("secret_string").(string)
-		setForGenericString.WithSecretString(secretString)
+		if v, ok := d.GetOk("secret_string"); ok {
+			secretString = sdk.String(v.(string))
+		}
+	}
+	if err := writeOnlyStringAttributeUpdate(d, "secret_string_wo", "secret_string_wo_version", &secretString, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	if secretString != nil {
+		setForGenericString.WithSecretString(*secretString)
 	}
 
 	if !reflect.DeepEqual(*setForGenericString, sdk.SetForGenericStringRequest{}) {
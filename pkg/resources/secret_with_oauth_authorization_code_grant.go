@@ -19,11 +19,22 @@ import (
 var secretAuthorizationCodeGrantSchema = func() map[string]*schema.Schema {
 	secretAuthorizationCodeGrant := map[string]*schema.Schema{
 		"oauth_refresh_token": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Sensitive:   true,
-			Description: externalChangesNotDetectedFieldDescription("Specifies the token as a string that is used to obtain a new access token from the OAuth authorization server when the access token expires."),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ExactlyOneOf: []string{"oauth_refresh_token", "oauth_refresh_token_wo"},
+			Description:  externalChangesNotDetectedFieldDescription("Specifies the token as a string that is used to obtain a new access token from the OAuth authorization server when the access token expires. Exactly one of `oauth_refresh_token` or `oauth_refresh_token_wo` has to be specified."),
 		},
+		"oauth_refresh_token_wo": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			WriteOnly:    true,
+			Sensitive:    true,
+			ExactlyOneOf: []string{"oauth_refresh_token", "oauth_refresh_token_wo"},
+			Description:  externalChangesNotDetectedFieldDescription(writeOnlyFieldDescription("Specifies the token as a string that is used to obtain a new access token from the OAuth authorization server when the access token expires. Exactly one of `oauth_refresh_token` or `oauth_refresh_token_wo` has to be specified.", "oauth_refresh_token_wo_version")),
+		},
+		"oauth_refresh_token_wo_version": writeOnlyVersionSchema("oauth_refresh_token_wo"),
+		"oauth_refresh_token_wo_hash":    writeOnlyHashSchema("oauth_refresh_token_wo"),
 		"oauth_refresh_token_expiry_time": {
 			Type:             schema.TypeString,
 			Required:         true,
@@ -50,11 +61,15 @@ func SecretWithAuthorizationCodeGrant() *schema.Resource {
 		Description:   "Resource used to manage secret objects with OAuth Authorization Code Grant. For more information, check [secret documentation](https://docs.snowflake.com/en/sql-reference/sql/create-secret).",
 
 		Schema: secretAuthorizationCodeGrantSchema,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			preferWriteOnlyAttribute("oauth_refresh_token", "oauth_refresh_token_wo"),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.SecretWithAuthorizationCodeGrant, ImportSecretWithAuthorizationCodeGrant),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.SecretWithAuthorizationCodeGrant, customdiff.All(
+			writeOnlyValueChangedCustomDiff("oauth_refresh_token_wo"),
 			ComputedIfAnyAttributeChanged(secretAuthorizationCodeGrantSchema, ShowOutputAttributeName, "comment"),
 			ComputedIfAnyAttributeChanged(secretAuthorizationCodeGrantSchema, DescribeOutputAttributeName, "oauth_refresh_token_expiry_time", "api_authentication", "comment"),
 			RecreateWhenSecretTypeChangedExternally(sdk.SecretTypeOAuth2AuthorizationCodeGrant),
@@ -96,7 +111,10 @@ func CreateContextSecretWithAuthorizationCodeGrant(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	refreshToken := d.Get("oauth_refresh_token").(string)
+	refreshToken, err := getStringOrWriteOnlyString(d, "oauth_refresh_token", "oauth_refresh_token_wo")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	refreshTokenExpiryTime := d.Get("oauth_refresh_token_expiry_time").(string)
 
 	request := sdk.NewCreateWithOAuthAuthorizationCodeFlowSecretRequest(id, refreshToken, refreshTokenExpiryTime, apiIntegration)
@@ -111,6 +129,10 @@ func CreateContextSecretWithAuthorizationCodeGrant(ctx context.Context, d *schem
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
 
+	if err := setWriteOnlyHash(d, "oauth_refresh_token_wo"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return ReadContextSecretWithAuthorizationCodeGrant(false)(ctx, d, meta)
 }
 
@@ -178,9 +200,14 @@ func UpdateContextSecretWithAuthorizationCodeGrant(ctx context.Context, d *schem
 	handleSecretUpdate(d, set, unset)
 	setForOAuthAuthorization := &sdk.SetForOAuthAuthorizationRequest{}
 
+	// the refresh token can't be unset, so only the new value of oauth_refresh_token or oauth_refresh_token_wo is set
 	if d.HasChange("oauth_refresh_token") {
-		refreshToken := d.Get("oauth_refresh_token").(string)
-		setForOAuthAuthorization.WithOauthRefreshToken(refreshToken)
+		if v, ok := d.GetOk("oauth_refresh_token"); ok {
+			setForOAuthAuthorization.WithOauthRefreshToken(v.(string))
+		}
+	}
+	if err := writeOnlyStringAttributeUpdate(d, "oauth_refresh_token_wo", "oauth_refresh_token_wo_version", &setForOAuthAuthorization.OauthRefreshToken, nil); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("oauth_refresh_token_expiry_time") {
@@ -44,6 +44,7 @@ var sharedDatabaseSchema = map[string]*schema.Schema{
 	//	ForceNew:    true,
 	//	Description: "Specifies the database as transient. Transient databases do not have a Fail-safe period so they do not incur additional storage costs once they leave Time Travel; however, this means they are also not protected by Fail-safe in the event of a data loss.",
 	// },
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -69,7 +70,7 @@ func SharedDatabase() *schema.Resource {
 
 		Schema: collections.MergeMaps(sharedDatabaseSchema, sharedDatabaseParametersSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.SharedDatabase, ImportName[sdk.AccountObjectIdentifier]),
+			StateContext: TrackingImportWrapper(resources.SharedDatabase, importWithTags(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, ImportName[sdk.AccountObjectIdentifier])),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -96,6 +97,10 @@ func CreateSharedDatabase(ctx context.Context, d *schema.ResourceData, meta any)
 	if parametersCreateDiags := handleSharedDatabaseParametersCreate(d, opts); len(parametersCreateDiags) > 0 {
 		return parametersCreateDiags
 	}
+	opts.Tag, err = getTagsForCreate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	err = client.Databases.CreateShared(ctx, id, externalShareId, opts)
 	if err != nil {
@@ -131,6 +136,10 @@ func UpdateSharedDatabase(ctx context.Context, d *schema.ResourceData, meta any)
 		id = newId
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeDatabase); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("comment") {
 		comment := d.Get("comment").(string)
 		if len(comment) > 0 {
@@ -197,6 +206,10 @@ func ReadSharedDatabase(ctx context.Context, d *schema.ResourceData, meta any) d
 		return diag.FromErr(err)
 	}
 
+	if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeDatabase); err != nil {
+		return diag.FromErr(err)
+	}
+
 	databaseParameters, err := client.Databases.ShowParameters(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,238 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var snapshotPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the snapshot policy; must be unique for the database and schema in which the snapshot policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the snapshot policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the snapshot policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"retention_lock": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies whether the snapshots created with this policy are protected by a retention lock. Snapshots under a retention lock cannot be deleted before they expire, even by a privileged role. The retention lock cannot be removed from an existing policy, so changing this field recreates the snapshot policy.",
+	},
+	"schedule": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the schedule for creating snapshots, either as an interval in minutes (e.g. `60 MINUTE`) or as a cron expression (e.g. `USING CRON 0 0 * * * UTC`).",
+	},
+	"expire_after_days": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "Specifies the number of days after which the snapshots created with this policy expire.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the snapshot policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW SNAPSHOT POLICIES` for the given snapshot policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowSnapshotPolicySchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+// SnapshotPolicy returns a pointer to the resource representing a snapshot policy.
+func SnapshotPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.SnapshotPolicies.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.SnapshotPolicyResource), TrackingCreateWrapper(resources.SnapshotPolicy, CreateSnapshotPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.SnapshotPolicyResource), TrackingReadWrapper(resources.SnapshotPolicy, ReadSnapshotPolicy)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.SnapshotPolicyResource), TrackingUpdateWrapper(resources.SnapshotPolicy, UpdateSnapshotPolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.SnapshotPolicyResource), TrackingDeleteWrapper(resources.SnapshotPolicy, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage snapshot policy objects. For more information, check [snapshot policy documentation](https://docs.snowflake.com/en/sql-reference/sql/create-snapshot-policy).",
+			"To apply the policy to a database, a schema or a table, use it in the `snowflake_snapshot_set` resource.",
+		),
+
+		Schema: snapshotPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.SnapshotPolicy, ImportSnapshotPolicy),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.SnapshotPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(snapshotPolicySchema, ShowOutputAttributeName, "name", "schedule", "expire_after_days", "comment"),
+			ComputedIfAnyAttributeChanged(snapshotPolicySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportSnapshotPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotPolicy, err := client.SnapshotPolicies.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("database", id.DatabaseName()),
+		d.Set("schema", id.SchemaName()),
+		d.Set("retention_lock", snapshotPolicy.HasRetentionLock != nil && *snapshotPolicy.HasRetentionLock),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateSnapshotPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateSnapshotPolicyRequest(id)
+	if d.Get("retention_lock").(bool) {
+		request.WithWithRetentionLock(true)
+	}
+	if errs := errors.Join(
+		stringAttributeCreate(d, "schedule", &request.Schedule),
+		intAttributeCreate(d, "expire_after_days", &request.ExpireAfterDays),
+		stringAttributeCreate(d, "comment", &request.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.SnapshotPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating snapshot policy %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	return ReadSnapshotPolicy(ctx, d, meta)
+}
+
+func ReadSnapshotPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshotPolicy, err := client.SnapshotPolicies.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query snapshot policy. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Snapshot policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if snapshotPolicy.HasRetentionLock != nil {
+		if err := d.Set("retention_lock", *snapshotPolicy.HasRetentionLock); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if errs := errors.Join(
+		d.Set("name", snapshotPolicy.Name),
+		d.Set("database", snapshotPolicy.DatabaseName),
+		d.Set("schema", snapshotPolicy.SchemaName),
+		setOptionalValueWithMapping(d, "schedule", snapshotPolicy.Schedule, func(v *string) string { return *v }),
+		setOptionalValueWithMapping(d, "expire_after_days", snapshotPolicy.ExpireAfterDays, func(v *int) int { return *v }),
+		d.Set("comment", snapshotPolicy.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.SnapshotPolicyToSchema(snapshotPolicy)}),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateSnapshotPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.SnapshotPolicies.Alter(ctx, sdk.NewAlterSnapshotPolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming snapshot policy %s, err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	set, unset := sdk.NewSnapshotPolicySetRequest(), sdk.NewSnapshotPolicyUnsetRequest()
+	if errs := errors.Join(
+		stringAttributeUpdate(d, "schedule", &set.Schedule, &unset.Schedule),
+		intAttributeUpdate(d, "expire_after_days", &set.ExpireAfterDays, &unset.ExpireAfterDays),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if (*set != sdk.SnapshotPolicySetRequest{}) {
+		if err := client.SnapshotPolicies.Alter(ctx, sdk.NewAlterSnapshotPolicyRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for snapshot policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	if (*unset != sdk.SnapshotPolicyUnsetRequest{}) {
+		if err := client.SnapshotPolicies.Alter(ctx, sdk.NewAlterSnapshotPolicyRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for snapshot policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadSnapshotPolicy(ctx, d, meta)
+}
@@ -0,0 +1,267 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var snapshotSetTargetFields = []string{"for_database", "for_schema", "for_table"}
+
+var snapshotSetSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the snapshot set; must be unique for the database and schema in which the snapshot set is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the snapshot set."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the snapshot set."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"for_database": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     snapshotSetTargetFields,
+		Description:      relatedResourceDescription("The name of the database for which the snapshots are taken.", resources.Database),
+	},
+	"for_schema": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     snapshotSetTargetFields,
+		Description:      relatedResourceDescription("The fully qualified name (`database.schema`) of the schema for which the snapshots are taken.", resources.Schema),
+	},
+	"for_table": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     snapshotSetTargetFields,
+		Description:      relatedResourceDescription("The fully qualified name (`database.schema.table`) of the table for which the snapshots are taken.", resources.Table),
+	},
+	"snapshot_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("The fully qualified name (`database.schema.policy`) of the snapshot policy applied to the snapshot set. Snowflake does not allow removing the policy from a snapshot set, so removing this field recreates the snapshot set.", resources.SnapshotPolicy),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the snapshot set.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW SNAPSHOT SETS` for the given snapshot set.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowSnapshotSetSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+// SnapshotSet returns a pointer to the resource representing a snapshot set.
+func SnapshotSet() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.SnapshotSets.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.SnapshotSetResource), TrackingCreateWrapper(resources.SnapshotSet, CreateSnapshotSet)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.SnapshotSetResource), TrackingReadWrapper(resources.SnapshotSet, ReadSnapshotSet)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.SnapshotSetResource), TrackingUpdateWrapper(resources.SnapshotSet, UpdateSnapshotSet)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.SnapshotSetResource), TrackingDeleteWrapper(resources.SnapshotSet, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage snapshot set objects. A snapshot set holds the immutable backups (snapshots) of a database, a schema or a table. For more information, check [snapshot set documentation](https://docs.snowflake.com/en/sql-reference/sql/create-snapshot-set).",
+			"The snapshots themselves are created by the applied snapshot policy and can be listed with the `snowflake_snapshots` data source.",
+		),
+
+		Schema: snapshotSetSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.SnapshotSet, ImportSnapshotSet),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.SnapshotSet, customdiff.All(
+			ForceNewIfChangeToEmptyString("snapshot_policy"),
+			ComputedIfAnyAttributeChanged(snapshotSetSchema, ShowOutputAttributeName, "snapshot_policy", "comment"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportSnapshotSet(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotSet, err := client.SnapshotSets.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("database", id.DatabaseName()),
+		d.Set("schema", id.SchemaName()),
+	); err != nil {
+		return nil, err
+	}
+
+	switch snapshotSet.ObjectKind {
+	case "DATABASE":
+		err = d.Set("for_database", sdk.NewAccountObjectIdentifier(snapshotSet.ObjectName).FullyQualifiedName())
+	case "SCHEMA":
+		if snapshotSet.ObjectDatabaseName == nil {
+			return nil, fmt.Errorf("could not determine the database of the schema %s for snapshot set %s", snapshotSet.ObjectName, id.FullyQualifiedName())
+		}
+		err = d.Set("for_schema", sdk.NewDatabaseObjectIdentifier(*snapshotSet.ObjectDatabaseName, snapshotSet.ObjectName).FullyQualifiedName())
+	case "TABLE":
+		if snapshotSet.ObjectDatabaseName == nil || snapshotSet.ObjectSchemaName == nil {
+			return nil, fmt.Errorf("could not determine the schema of the table %s for snapshot set %s", snapshotSet.ObjectName, id.FullyQualifiedName())
+		}
+		err = d.Set("for_table", sdk.NewSchemaObjectIdentifier(*snapshotSet.ObjectDatabaseName, *snapshotSet.ObjectSchemaName, snapshotSet.ObjectName).FullyQualifiedName())
+	default:
+		err = fmt.Errorf("unsupported object kind %s for snapshot set %s", snapshotSet.ObjectKind, id.FullyQualifiedName())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateSnapshotSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	target := sdk.NewSnapshotSetTargetRequest()
+	if errs := errors.Join(
+		accountObjectIdentifierAttributeCreate(d, "for_database", &target.Database),
+		attributeMappedValueCreate(d, "for_schema", &target.Schema, func(value any) (*sdk.DatabaseObjectIdentifier, error) {
+			schemaId, err := sdk.ParseDatabaseObjectIdentifier(value.(string))
+			return &schemaId, err
+		}),
+		schemaObjectIdentifierAttributeCreate(d, "for_table", &target.Table),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	request := sdk.NewCreateSnapshotSetRequest(id, *target)
+	if errs := errors.Join(
+		schemaObjectIdentifierAttributeCreate(d, "snapshot_policy", &request.SnapshotPolicy),
+		stringAttributeCreate(d, "comment", &request.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.SnapshotSets.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating snapshot set %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	return ReadSnapshotSet(ctx, d, meta)
+}
+
+func ReadSnapshotSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshotSet, err := client.SnapshotSets.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query snapshot set. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Snapshot set id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if errs := errors.Join(
+		d.Set("name", snapshotSet.Name),
+		d.Set("database", snapshotSet.DatabaseName),
+		d.Set("schema", snapshotSet.SchemaName),
+		setOptionalValueWithMapping(d, "snapshot_policy", snapshotSet.SnapshotPolicy, func(v *sdk.SchemaObjectIdentifier) string { return v.FullyQualifiedName() }),
+		d.Set("comment", snapshotSet.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.SnapshotSetToSchema(snapshotSet)}),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateSnapshotSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Removing the policy is handled by ForceNewIfChangeToEmptyString, so only applying a new one is possible here.
+	if d.HasChange("snapshot_policy") {
+		snapshotPolicyId, err := sdk.ParseSchemaObjectIdentifier(d.Get("snapshot_policy").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := client.SnapshotSets.Alter(ctx, sdk.NewAlterSnapshotSetRequest(id).WithApplySnapshotPolicy(snapshotPolicyId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error applying snapshot policy for snapshot set %s, err = %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment == "" {
+			if err := client.SnapshotSets.Alter(ctx, sdk.NewAlterSnapshotSetRequest(id).WithUnsetComment(true)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting comment for snapshot set %s, err = %w", d.Id(), err))
+			}
+		} else {
+			if err := client.SnapshotSets.Alter(ctx, sdk.NewAlterSnapshotSetRequest(id).WithSetComment(comment)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting comment for snapshot set %s, err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	return ReadSnapshotSet(ctx, d, meta)
+}
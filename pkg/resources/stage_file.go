@@ -0,0 +1,376 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var stageFileSchema = map[string]*schema.Schema{
+	"stage": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription(blocklistedCharactersFieldDescription("Fully qualified name of the internal stage to which the files are uploaded."), resources.Stage),
+	},
+	"path": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Default:          "",
+		ValidateDiagFunc: isValidStageFilePath,
+		Description:      "Path on the stage (without the leading and trailing `/`) under which the files are uploaded, e.g. `functions/handlers`. By default, the files are uploaded to the stage root.",
+	},
+	"source": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Path to the local file or directory to upload. Only the files directly in the directory are uploaded (subdirectories are skipped). When the source does not exist during the plan (e.g. it is created by another resource), the upload is planned and the source is read during the apply. The paths of the uploaded files cannot contain `*`, `?`, or `'`.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Glob pattern (e.g. `*.py`) selecting the files to upload when `source` is a directory. The pattern is matched against the file names. By default, all the files in the directory are uploaded. Cannot be used when `source` is a file.",
+	},
+	"source_hash": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "SHA-256 hash of the names and contents of the uploaded local files. It is used to detect changes of the local files. It is cleared when the files on the stage are changed or removed externally, so that they are uploaded again.",
+	},
+	"files": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Files uploaded to the stage, as returned by `LIST` after the upload.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"path": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Path of the file relative to the stage root.",
+				},
+				"size": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Size of the file on the stage in bytes.",
+				},
+				"md5": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "MD5 hash of the file on the stage.",
+				},
+			},
+		},
+	},
+}
+
+func StageFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StageFileResource), TrackingCreateWrapper(resources.StageFile, CreateStageFile)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StageFileResource), TrackingReadWrapper(resources.StageFile, ReadStageFile)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.StageFileResource), TrackingUpdateWrapper(resources.StageFile, UpdateStageFile)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StageFileResource), TrackingDeleteWrapper(resources.StageFile, DeleteStageFile)),
+		Description:   "Resource used to upload local files to an internal stage. The files are uploaded with [PUT](https://docs.snowflake.com/en/sql-reference/sql/put) (without compression and with overwriting the existing files), and removed from the stage on destroy. Changes of the local files are detected with `source_hash`; changes and removals of the files on the stage are detected with `LIST`.",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.StageFile, stageFileSourceHashCustomDiff),
+
+		Schema: stageFileSchema,
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+// stageFileSourceHashCustomDiff plans an upload whenever the local files differ from the last uploaded ones.
+func stageFileSourceHashCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if !diff.NewValueKnown("source") || !diff.NewValueKnown("pattern") {
+		return errors.Join(diff.SetNewComputed("source_hash"), diff.SetNewComputed("files"))
+	}
+	localFiles, err := stageFileLocalFiles(diff.Get("source").(string), diff.Get("pattern").(string))
+	if err != nil {
+		// the source can be created during the apply (e.g. by another resource), so it is checked again before the upload
+		if errors.Is(err, fs.ErrNotExist) {
+			return errors.Join(diff.SetNewComputed("source_hash"), diff.SetNewComputed("files"))
+		}
+		return err
+	}
+	hash, err := stageFileSourceHash(localFiles)
+	if err != nil {
+		return err
+	}
+	if diff.Get("source_hash").(string) != hash || diff.HasChanges("source", "pattern") {
+		return errors.Join(diff.SetNew("source_hash", hash), diff.SetNewComputed("files"))
+	}
+	return nil
+}
+
+func CreateStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	stageId, err := sdk.ParseSchemaObjectIdentifier(d.Get("stage").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	stagePath := d.Get("path").(string)
+
+	if err := uploadStageFiles(ctx, d, meta, stageId, stagePath, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(stageId.FullyQualifiedName(), stagePath))
+	return ReadStageFile(ctx, d, meta)
+}
+
+func ReadStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	stageId, err := sdk.ParseSchemaObjectIdentifier(d.Get("stage").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	stagePath := d.Get("path").(string)
+
+	stageFiles, err := client.StageFiles.List(ctx, sdk.NewStageLocation(stageId, stagePath), nil)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to list the files on stage. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Stage id: %s, Err: %s", stageId.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	stageFilesByPath := make(map[string]sdk.StageFile, len(stageFiles))
+	for _, stageFile := range stageFiles {
+		stageFilesByPath[stageFile.PathOnStage()] = stageFile
+	}
+	for _, file := range d.Get("files").([]any) {
+		file := file.(map[string]any)
+		stageFile, ok := stageFilesByPath[file["path"].(string)]
+		if !ok || stageFile.Md5 != file["md5"].(string) || stageFile.Size != int64(file["size"].(int)) {
+			// Clearing the hash makes the next plan upload the files again.
+			if err := d.Set("source_hash", ""); err != nil {
+				return diag.FromErr(err)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+func UpdateStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	stageId, err := sdk.ParseSchemaObjectIdentifier(d.Get("stage").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("source", "pattern", "source_hash") {
+		oldFiles, _ := d.GetChange("files")
+		if err := uploadStageFiles(ctx, d, meta, stageId, d.Get("path").(string), stageFilePaths(oldFiles.([]any))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadStageFile(ctx, d, meta)
+}
+
+func DeleteStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	stageId, err := sdk.ParseSchemaObjectIdentifier(d.Get("stage").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := removeStageFiles(ctx, client, stageId, stageFilePaths(d.Get("files").([]any))); err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// uploadStageFiles uploads the local files, removes the previously uploaded files that are no longer present locally,
+// and saves the hash of the local files and the uploaded files listed on the stage.
+func uploadStageFiles(ctx context.Context, d *schema.ResourceData, meta any, stageId sdk.SchemaObjectIdentifier, stagePath string, previousPaths []string) error {
+	client := meta.(*provider.Context).Client
+	location := sdk.NewStageLocation(stageId, stagePath)
+
+	localFiles, err := stageFileLocalFiles(d.Get("source").(string), d.Get("pattern").(string))
+	if err != nil {
+		return err
+	}
+	hash, err := stageFileSourceHash(localFiles)
+	if err != nil {
+		return err
+	}
+
+	uploadedPaths := make([]string, len(localFiles))
+	for i, localFile := range localFiles {
+		_, err := client.StageFiles.Put(ctx, localFile, location, &sdk.PutStageFilesOptions{
+			AutoCompress: sdk.Bool(false),
+			Overwrite:    sdk.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("uploading file %s to %s: %w", localFile, location.ToSql(), err)
+		}
+		uploadedPaths[i] = path.Join(stagePath, filepath.Base(localFile))
+	}
+
+	stalePaths := slices.DeleteFunc(previousPaths, func(previousPath string) bool {
+		return slices.Contains(uploadedPaths, previousPath)
+	})
+	if err := removeStageFiles(ctx, client, stageId, stalePaths); err != nil {
+		return err
+	}
+
+	stageFiles, err := client.StageFiles.List(ctx, location, nil)
+	if err != nil {
+		return err
+	}
+	files := make([]map[string]any, 0, len(uploadedPaths))
+	for _, stageFile := range stageFiles {
+		if slices.Contains(uploadedPaths, stageFile.PathOnStage()) {
+			files = append(files, map[string]any{
+				"path": stageFile.PathOnStage(),
+				"size": int(stageFile.Size),
+				"md5":  stageFile.Md5,
+			})
+		}
+	}
+
+	return errors.Join(
+		d.Set("source_hash", hash),
+		d.Set("files", files),
+	)
+}
+
+// removeStageFiles removes the exact files from the stage. REMOVE matches the given path as a prefix,
+// so the pattern (matched against the `<stage name>/<path>` file names) is used to skip other files with the same prefix.
+func removeStageFiles(ctx context.Context, client *sdk.Client, stageId sdk.SchemaObjectIdentifier, paths []string) error {
+	errs := make([]error, 0)
+	for _, p := range paths {
+		err := client.StageFiles.Remove(ctx, sdk.NewStageLocation(stageId, p), &sdk.RemoveStageFilesOptions{
+			Pattern: sdk.String(`[^/]+/` + regexp.QuoteMeta(p)),
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("removing file %s from stage %s: %w", p, stageId.FullyQualifiedName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func stageFilePaths(files []any) []string {
+	paths := make([]string, len(files))
+	for i, file := range files {
+		paths[i] = file.(map[string]any)["path"].(string)
+	}
+	return paths
+}
+
+// stageFileLocalFiles returns the sorted paths of the local files selected by the source and the pattern.
+func stageFileLocalFiles(source string, pattern string) ([]string, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("reading source %s: %w", source, err)
+	}
+	if !info.IsDir() {
+		if pattern != "" {
+			return nil, fmt.Errorf("pattern can only be used when source is a directory, got file %s", source)
+		}
+		if err := validateStageFileLocalPath(source); err != nil {
+			return nil, err
+		}
+		return []string{source}, nil
+	}
+
+	entries, err := os.ReadDir(source)
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory %s: %w", source, err)
+	}
+	files := make([]string, 0)
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		if pattern != "" {
+			matched, err := filepath.Match(pattern, entry.Name())
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %s: %w", pattern, err)
+			}
+			if !matched {
+				continue
+			}
+		}
+		file := filepath.Join(source, entry.Name())
+		if err := validateStageFileLocalPath(file); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files to upload found in directory %s", source)
+	}
+	return files, nil
+}
+
+// validateStageFileLocalPath rejects the paths that PUT would not treat as a single file:
+// `*` and `?` are wildcards in the PUT source, and `'` would end the quoted source.
+func validateStageFileLocalPath(file string) error {
+	if strings.ContainsAny(file, "*?'") {
+		return fmt.Errorf("file %s cannot be uploaded, because its path contains one of the unsupported characters: *, ?, '", file)
+	}
+	return nil
+}
+
+// stageFileSourceHash returns the SHA-256 hash of the names and contents of the given files.
+func stageFileSourceHash(files []string) (string, error) {
+	hash := sha256.New()
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return "", fmt.Errorf("reading file %s: %w", file, err)
+		}
+		_, _ = hash.Write([]byte(filepath.Base(file) + "\x00"))
+		_, err = io.Copy(hash, f)
+		_ = f.Close()
+		if err != nil {
+			return "", fmt.Errorf("reading file %s: %w", file, err)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func isValidStageFilePath(value any, attributePath cty.Path) diag.Diagnostics {
+	p := value.(string)
+	if strings.HasPrefix(p, "/") || strings.HasSuffix(p, "/") {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid stage path",
+				Detail:        fmt.Sprintf("Path %s cannot start or end with `/`.", p),
+				AttributePath: attributePath,
+			},
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package resources
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStageFileLocalFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.py"), []byte("b"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.py"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.txt"), []byte("c"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "nested.py"), 0o700))
+
+	t.Run("file", func(t *testing.T) {
+		files, err := stageFileLocalFiles(filepath.Join(dir, "c.txt"), "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "c.txt")}, files)
+	})
+
+	t.Run("file with pattern", func(t *testing.T) {
+		_, err := stageFileLocalFiles(filepath.Join(dir, "c.txt"), "*.txt")
+		require.ErrorContains(t, err, "pattern can only be used when source is a directory")
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		files, err := stageFileLocalFiles(dir, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "a.py"), filepath.Join(dir, "b.py"), filepath.Join(dir, "c.txt")}, files)
+	})
+
+	t.Run("directory with pattern", func(t *testing.T) {
+		files, err := stageFileLocalFiles(dir, "*.py")
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "a.py"), filepath.Join(dir, "b.py")}, files)
+	})
+
+	t.Run("directory with pattern matching nothing", func(t *testing.T) {
+		_, err := stageFileLocalFiles(dir, "*.java")
+		require.ErrorContains(t, err, "no files to upload found")
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := stageFileLocalFiles(dir, "[")
+		require.ErrorContains(t, err, "invalid pattern")
+	})
+
+	t.Run("missing source", func(t *testing.T) {
+		_, err := stageFileLocalFiles(filepath.Join(dir, "missing"), "")
+		require.ErrorContains(t, err, "reading source")
+		require.ErrorIs(t, err, fs.ErrNotExist)
+	})
+}
+
+func TestStageFileLocalFiles_unsupportedCharacters(t *testing.T) {
+	for _, name := range []string{"a*.py", "a?.py", "a'.py"} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			file := filepath.Join(dir, name)
+			require.NoError(t, os.WriteFile(file, []byte("a"), 0o600))
+
+			_, err := stageFileLocalFiles(file, "")
+			require.ErrorContains(t, err, "contains one of the unsupported characters")
+
+			_, err = stageFileLocalFiles(dir, "")
+			require.ErrorContains(t, err, "contains one of the unsupported characters")
+		})
+	}
+}
+
+func TestStageFileSourceHash(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.py")
+	b := filepath.Join(dir, "b.py")
+	require.NoError(t, os.WriteFile(a, []byte("content"), 0o600))
+	require.NoError(t, os.WriteFile(b, []byte("content"), 0o600))
+
+	hashA, err := stageFileSourceHash([]string{a})
+	require.NoError(t, err)
+	hashB, err := stageFileSourceHash([]string{b})
+	require.NoError(t, err)
+	hashAB, err := stageFileSourceHash([]string{a, b})
+	require.NoError(t, err)
+
+	assert.Len(t, hashA, 64)
+	assert.NotEqual(t, hashA, hashB, "the file name should be a part of the hash")
+	assert.NotEqual(t, hashA, hashAB)
+
+	require.NoError(t, os.WriteFile(a, []byte("changed"), 0o600))
+	changedHashA, err := stageFileSourceHash([]string{a})
+	require.NoError(t, err)
+	assert.NotEqual(t, hashA, changedHashA, "the file content should be a part of the hash")
+}
+
+func TestIsValidStageFilePath(t *testing.T) {
+	for _, p := range []string{"", "functions", "functions/handlers", "my functions/it's"} {
+		t.Run(p, func(t *testing.T) {
+			assert.Empty(t, isValidStageFilePath(p, cty.GetAttrPath("path")))
+		})
+	}
+
+	for _, p := range []string{"/functions", "functions/", "/"} {
+		t.Run(p, func(t *testing.T) {
+			diags := isValidStageFilePath(p, cty.GetAttrPath("path"))
+			require.Len(t, diags, 1)
+			assert.Contains(t, diags[0].Detail, "cannot start or end with `/`")
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var storageLifecyclePolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the storage lifecycle policy; must be unique for the database and schema in which the storage lifecycle policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the storage lifecycle policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the storage lifecycle policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"argument": {
+		Type:     schema.TypeList,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The argument name.",
+					ForceNew:    true,
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      dataTypeFieldDescription("The argument type. VECTOR data types are not yet supported."),
+					DiffSuppressFunc: DiffSuppressDataTypes,
+					ValidateDiagFunc: IsDataTypeValid,
+					StateFunc:        DataTypeStateFunc,
+					ForceNew:         true,
+				},
+			},
+		},
+		Required:    true,
+		ForceNew:    true,
+		Description: "List of the arguments for the storage lifecycle policy. The attribute values come from the columns of the table the policy is attached to and are used in the condition body to decide which rows are archived or expired. If any argument name or type is changed, the resource is recreated.",
+	},
+	"body": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      diffSuppressStatementFieldDescription("Specifies the condition for the rows to which the policy applies. The expression can be any boolean-valued SQL expression using the policy arguments."),
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"archive_tier": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToStorageLifecyclePolicyArchiveTier),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToStorageLifecyclePolicyArchiveTier),
+		Description:      fmt.Sprintf("Specifies the storage tier to which the matching rows are archived. When not set, the matching rows are expired instead of being archived. Valid values are (case-insensitive): %s. The archive tier cannot be changed on an existing policy, so changing this field recreates the storage lifecycle policy.", possibleValuesListed(sdk.AllStorageLifecyclePolicyArchiveTiers)),
+	},
+	"archive_for_days": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "Specifies the number of days the matching rows are retained in the archive tier before they are expired.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the storage lifecycle policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW STORAGE LIFECYCLE POLICIES` for the given storage lifecycle policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowStorageLifecyclePolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE STORAGE LIFECYCLE POLICY` for the given storage lifecycle policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.StorageLifecyclePolicyDescribeSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+// StorageLifecyclePolicy returns a pointer to the resource representing a storage lifecycle policy.
+func StorageLifecyclePolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.StorageLifecyclePolicies.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StorageLifecyclePolicyResource), TrackingCreateWrapper(resources.StorageLifecyclePolicy, CreateStorageLifecyclePolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StorageLifecyclePolicyResource), TrackingReadWrapper(resources.StorageLifecyclePolicy, ReadStorageLifecyclePolicy)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.StorageLifecyclePolicyResource), TrackingUpdateWrapper(resources.StorageLifecyclePolicy, UpdateStorageLifecyclePolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StorageLifecyclePolicyResource), TrackingDeleteWrapper(resources.StorageLifecyclePolicy, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage storage lifecycle policy objects. For more information, check [storage lifecycle policy documentation](https://docs.snowflake.com/en/sql-reference/sql/create-storage-lifecycle-policy).",
+			"To attach the policy to a table, use the `snowflake_storage_lifecycle_policy_attachment` resource.",
+		),
+
+		Schema: storageLifecyclePolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.StorageLifecyclePolicy, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.StorageLifecyclePolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(storageLifecyclePolicySchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(storageLifecyclePolicySchema, DescribeOutputAttributeName, "name", "body", "archive_for_days"),
+			ComputedIfAnyAttributeChanged(storageLifecyclePolicySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateStorageLifecyclePolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	args, err := HandleNestedDataTypeCreate(d, "argument", "type", func(v map[string]any, dataType datatypes.DataType) (sdk.StorageLifecyclePolicyArgRequest, error) {
+		return *sdk.NewStorageLifecyclePolicyArgRequest(v["name"].(string), dataType), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateStorageLifecyclePolicyRequest(id, args, d.Get("body").(string))
+	if errs := errors.Join(
+		attributeMappedValueCreate(d, "archive_tier", &request.ArchiveTier, func(value any) (*sdk.StorageLifecyclePolicyArchiveTier, error) {
+			archiveTier, err := sdk.ToStorageLifecyclePolicyArchiveTier(value.(string))
+			if err != nil {
+				return nil, err
+			}
+			return &archiveTier, nil
+		}),
+		intAttributeCreate(d, "archive_for_days", &request.ArchiveForDays),
+		stringAttributeCreate(d, "comment", &request.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.StorageLifecyclePolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating storage lifecycle policy %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	return ReadStorageLifecyclePolicy(ctx, d, meta)
+}
+
+func ReadStorageLifecyclePolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	storageLifecyclePolicy, err := client.StorageLifecyclePolicies.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query storage lifecycle policy. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Storage lifecycle policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	description, err := client.StorageLifecyclePolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := HandleNestedDataTypeSet(d, "argument", "type", description.Signature,
+		func(signature sdk.TableColumnSignature) datatypes.DataType { return signature.Type },
+		func(signature sdk.TableColumnSignature, arg map[string]any) { arg["name"] = signature.Name },
+	); err != nil {
+		return diag.FromErr(err)
+	}
+
+	archiveTier := ""
+	if description.ArchiveTier != nil {
+		archiveTier = string(*description.ArchiveTier)
+	}
+	archiveForDays := 0
+	if description.ArchiveForDays != nil {
+		archiveForDays = *description.ArchiveForDays
+	}
+
+	if errs := errors.Join(
+		d.Set("name", storageLifecyclePolicy.Name),
+		d.Set("database", storageLifecyclePolicy.DatabaseName),
+		d.Set("schema", storageLifecyclePolicy.SchemaName),
+		d.Set("body", description.Body),
+		d.Set("archive_tier", archiveTier),
+		d.Set("archive_for_days", archiveForDays),
+		d.Set("comment", storageLifecyclePolicy.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.StorageLifecyclePolicyToSchema(storageLifecyclePolicy)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.StorageLifecyclePolicyDescriptionToSchema(*description)}),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateStorageLifecyclePolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming storage lifecycle policy %s, err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("body") {
+		if err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(id).WithSetBody(d.Get("body").(string))); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating body of storage lifecycle policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	set, unset := sdk.NewStorageLifecyclePolicySetRequest(), sdk.NewStorageLifecyclePolicyUnsetRequest()
+	if errs := errors.Join(
+		intAttributeUpdate(d, "archive_for_days", &set.ArchiveForDays, &unset.ArchiveForDays),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if (*set != sdk.StorageLifecyclePolicySetRequest{}) {
+		if err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for storage lifecycle policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	if (*unset != sdk.StorageLifecyclePolicyUnsetRequest{}) {
+		if err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for storage lifecycle policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	// argument and archive_tier are handled by ForceNew
+
+	return ReadStorageLifecyclePolicy(ctx, d, meta)
+}
@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var storageLifecyclePolicyAttachmentSchema = map[string]*schema.Schema{
+	"storage_lifecycle_policy": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Fully qualified name of the storage lifecycle policy to attach.", resources.StorageLifecyclePolicy),
+	},
+	"table": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Fully qualified name of the table to which the storage lifecycle policy is attached.", resources.Table),
+	},
+	"on": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MinItems:    1,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Specifies the table columns passed to the storage lifecycle policy arguments, in the order of the policy signature.",
+	},
+}
+
+// StorageLifecyclePolicyAttachment returns a pointer to the resource representing a storage lifecycle policy attachment.
+func StorageLifecyclePolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StorageLifecyclePolicyAttachmentResource), TrackingCreateWrapper(resources.StorageLifecyclePolicyAttachment, CreateStorageLifecyclePolicyAttachment)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StorageLifecyclePolicyAttachmentResource), TrackingReadWrapper(resources.StorageLifecyclePolicyAttachment, ReadStorageLifecyclePolicyAttachment)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StorageLifecyclePolicyAttachmentResource), TrackingDeleteWrapper(resources.StorageLifecyclePolicyAttachment, DeleteStorageLifecyclePolicyAttachment)),
+		Description:   "Resource used to attach a storage lifecycle policy to a table. Only one storage lifecycle policy can be attached to a given table.",
+
+		Schema: storageLifecyclePolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.StorageLifecyclePolicyAttachment, ImportStorageLifecyclePolicyAttachment),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func parseStorageLifecyclePolicyAttachmentId(id string) (sdk.SchemaObjectIdentifier, sdk.SchemaObjectIdentifier, error) {
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) != 2 {
+		return sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, fmt.Errorf("required id format '<table_name>|<storage_lifecycle_policy_name>', but got: '%s'", id)
+	}
+	tableId, err := sdk.ParseSchemaObjectIdentifier(parts[0])
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, err
+	}
+	policyId, err := sdk.ParseSchemaObjectIdentifier(parts[1])
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, err
+	}
+	return tableId, policyId, nil
+}
+
+func ImportStorageLifecyclePolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	tableId, _, err := parseStorageLifecyclePolicyAttachmentId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("table", tableId.FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateStorageLifecyclePolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	policyId, err := sdk.ParseSchemaObjectIdentifier(d.Get("storage_lifecycle_policy").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tableId, err := sdk.ParseSchemaObjectIdentifier(d.Get("table").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	on := expandStringList(d.Get("on").([]any))
+
+	if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(tableId).WithAddStorageLifecyclePolicy(sdk.NewTableAddStorageLifecyclePolicyRequest(policyId, on))); err != nil {
+		return diag.FromErr(fmt.Errorf("error attaching storage lifecycle policy %s to table %s, err = %w", policyId.FullyQualifiedName(), tableId.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(tableId.FullyQualifiedName(), policyId.FullyQualifiedName()))
+
+	return ReadStorageLifecyclePolicyAttachment(ctx, d, meta)
+}
+
+func ReadStorageLifecyclePolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	tableId, _, err := parseStorageLifecyclePolicyAttachmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Note: there is no alphanumeric id for an attachment, so we retrieve the storage lifecycle policy attached to a certain table.
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(tableId, sdk.PolicyEntityDomainTable))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to get table policies. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Table id: %s, Err: %s", tableId.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	storageLifecyclePolicyReference, err := collections.FindFirst(policyReferences, func(reference sdk.PolicyReference) bool {
+		return reference.PolicyKind == sdk.PolicyKindStorageLifecyclePolicy
+	})
+	// Note: this means the attachment has been removed outside of Terraform.
+	if err != nil {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to find the storage lifecycle policy attached to the table. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Table id: %s", tableId.FullyQualifiedName()),
+			},
+		}
+	}
+
+	policyId := sdk.NewSchemaObjectIdentifier(*storageLifecyclePolicyReference.PolicyDb, *storageLifecyclePolicyReference.PolicySchema, storageLifecyclePolicyReference.PolicyName)
+	on := make([]string, 0)
+	if storageLifecyclePolicyReference.RefArgColumnNames != nil {
+		on = sdk.ParseCommaSeparatedStringArray(*storageLifecyclePolicyReference.RefArgColumnNames, true)
+	}
+
+	if errs := errors.Join(
+		d.Set("storage_lifecycle_policy", policyId.FullyQualifiedName()),
+		d.Set("on", on),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func DeleteStorageLifecyclePolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	tableId, policyId, err := parseStorageLifecyclePolicyAttachmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(tableId).WithDropStorageLifecyclePolicy(sdk.Bool(true)))
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return diag.FromErr(fmt.Errorf("error detaching storage lifecycle policy %s from table %s, err = %w", policyId.FullyQualifiedName(), tableId.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -69,6 +69,7 @@ var streamCommonSchema = map[string]*schema.Schema{
 			Schema: schemas.DescribeStreamSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
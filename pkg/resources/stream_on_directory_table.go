@@ -50,7 +50,7 @@ func StreamOnDirectoryTable() *schema.Resource {
 		Schema: streamOnDirectoryTableSchema,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.StreamOnDirectoryTable, ImportName[sdk.SchemaObjectIdentifier]),
+			StateContext: TrackingImportWrapper(resources.StreamOnDirectoryTable, importWithTags(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ImportName[sdk.SchemaObjectIdentifier])),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -80,6 +80,12 @@ func CreateStreamOnDirectoryTable(orReplace bool) schema.CreateContextFunc {
 			return diag.FromErr(errs)
 		}
 
+		tags, err := getTagsForCreate(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.Tag = tags
+
 		err = client.Streams.CreateOnDirectoryTable(ctx, req)
 		if err != nil {
 			return diag.FromErr(err)
@@ -132,6 +138,9 @@ func ReadStreamOnDirectoryTable(withDirectoryChangesMarking bool) schema.ReadCon
 		if err := handleStreamRead(d, id, stream, streamDescription); err != nil {
 			return diag.FromErr(err)
 		}
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeStream); err != nil {
+			return diag.FromErr(err)
+		}
 
 		return nil
 	}
@@ -151,6 +160,10 @@ func UpdateStreamOnDirectoryTable(ctx context.Context, d *schema.ResourceData, m
 		return CreateStreamOnDirectoryTable(true)(ctx, d, meta)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeStream); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("comment") {
 		comment := d.Get("comment").(string)
 		if comment == "" {
@@ -61,7 +61,7 @@ func StreamOnExternalTable() *schema.Resource {
 		Schema: streamOnExternalTableSchema,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.StreamOnExternalTable, ImportStreamOnExternalTable),
+			StateContext: TrackingImportWrapper(resources.StreamOnExternalTable, importWithTags(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ImportStreamOnExternalTable)),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -118,6 +118,12 @@ func CreateStreamOnExternalTable(orReplace bool) schema.CreateContextFunc {
 			req.WithOn(*streamTimeTravelReq)
 		}
 
+		tags, err := getTagsForCreate(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.Tag = tags
+
 		err = client.Streams.CreateOnExternalTable(ctx, req)
 		if err != nil {
 			return diag.FromErr(err)
@@ -171,6 +177,9 @@ func ReadStreamOnExternalTable(withExternalChangesMarking bool) schema.ReadConte
 		if err := handleStreamRead(d, id, stream, streamDescription); err != nil {
 			return diag.FromErr(err)
 		}
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeStream); err != nil {
+			return diag.FromErr(err)
+		}
 		if withExternalChangesMarking {
 			var mode sdk.StreamMode
 			if stream.Mode != nil {
@@ -207,6 +216,10 @@ func UpdateStreamOnExternalTable(ctx context.Context, d *schema.ResourceData, me
 		return CreateStreamOnExternalTable(true)(ctx, d, meta)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeStream); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("comment") {
 		comment := d.Get("comment").(string)
 		if comment == "" {
@@ -68,7 +68,7 @@ func StreamOnTable() *schema.Resource {
 		Schema: streamOnTableSchema,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.StreamOnTable, ImportStreamOnTable),
+			StateContext: TrackingImportWrapper(resources.StreamOnTable, importWithTags(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ImportStreamOnTable)),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -126,6 +126,12 @@ func CreateStreamOnTable(orReplace bool) schema.CreateContextFunc {
 			req.WithOn(*streamTimeTravelReq)
 		}
 
+		tags, err := getTagsForCreate(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.Tag = tags
+
 		err = client.Streams.CreateOnTable(ctx, req)
 		if err != nil {
 			return diag.FromErr(err)
@@ -179,6 +185,9 @@ func ReadStreamOnTable(withExternalChangesMarking bool) schema.ReadContextFunc {
 		if err := handleStreamRead(d, id, stream, streamDescription); err != nil {
 			return diag.FromErr(err)
 		}
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeStream); err != nil {
+			return diag.FromErr(err)
+		}
 		if withExternalChangesMarking {
 			var mode sdk.StreamMode
 			if stream.Mode != nil {
@@ -215,6 +224,10 @@ func UpdateStreamOnTable(ctx context.Context, d *schema.ResourceData, meta any)
 		return CreateStreamOnTable(true)(ctx, d, meta)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeStream); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("comment") {
 		comment := d.Get("comment").(string)
 		if comment == "" {
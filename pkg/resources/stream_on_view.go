@@ -68,7 +68,7 @@ func StreamOnView() *schema.Resource {
 		Schema: StreamOnViewSchema,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.StreamOnView, ImportStreamOnView),
+			StateContext: TrackingImportWrapper(resources.StreamOnView, importWithTags(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ImportStreamOnView)),
 		},
 		Timeouts: defaultTimeouts,
 	}
@@ -126,6 +126,12 @@ func CreateStreamOnView(orReplace bool) schema.CreateContextFunc {
 			req.WithOn(*streamTimeTravelReq)
 		}
 
+		tags, err := getTagsForCreate(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.Tag = tags
+
 		err = client.Streams.CreateOnView(ctx, req)
 		if err != nil {
 			return diag.FromErr(err)
@@ -179,6 +185,9 @@ func ReadStreamOnView(withExternalChangesMarking bool) schema.ReadContextFunc {
 		if err := handleStreamRead(d, id, stream, streamDescription); err != nil {
 			return diag.FromErr(err)
 		}
+		if err := handleTagsRead(ctx, client, d, id, sdk.ObjectTypeStream); err != nil {
+			return diag.FromErr(err)
+		}
 		if withExternalChangesMarking {
 			var mode sdk.StreamMode
 			if stream.Mode != nil {
@@ -214,6 +223,10 @@ func UpdateStreamOnView(ctx context.Context, d *schema.ResourceData, meta any) d
 		return CreateStreamOnView(true)(ctx, d, meta)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeStream); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("comment") {
 		comment := d.Get("comment").(string)
 		if comment == "" {
@@ -0,0 +1,180 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var tableColumnPrivacyDomainSchema = map[string]*schema.Schema{
+	"table": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("The fully qualified name (`database.schema.table`) of the table containing the column.", resources.Table),
+	},
+	"column": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		Description:      "The column for which the privacy domain is set. The name is used without quoting, so it is matched case-insensitively.",
+	},
+	"values": {
+		Type:         schema.TypeSet,
+		Optional:     true,
+		ForceNew:     true,
+		MinItems:     1,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		ExactlyOneOf: []string{"values", "range"},
+		Description:  "Specifies the list of the possible values of a categorical column (`SET PRIVACY DOMAIN IN (...)`).",
+	},
+	"range": {
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"lower": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies the lower bound of the range. The value is used as is, so string and date values have to be wrapped in single quotes.",
+				},
+				"upper": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies the upper bound of the range. The value is used as is, so string and date values have to be wrapped in single quotes.",
+				},
+			},
+		},
+		ExactlyOneOf: []string{"values", "range"},
+		Description:  "Specifies the range of the possible values of a numerical or a date column (`SET PRIVACY DOMAIN BETWEEN (...)`).",
+	},
+}
+
+// TableColumnPrivacyDomain returns a pointer to the resource representing a privacy domain of a table column.
+func TableColumnPrivacyDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.TableColumnPrivacyDomainResource), TrackingCreateWrapper(resources.TableColumnPrivacyDomain, CreateTableColumnPrivacyDomain)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.TableColumnPrivacyDomainResource), TrackingReadWrapper(resources.TableColumnPrivacyDomain, ReadTableColumnPrivacyDomain)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.TableColumnPrivacyDomainResource), TrackingDeleteWrapper(resources.TableColumnPrivacyDomain, DeleteTableColumnPrivacyDomain)),
+		Description: joinWithSpace(
+			"Resource used to set a [privacy domain](https://docs.snowflake.com/en/user-guide/diff-privacy/differential-privacy-domains) of a table column protected by a privacy policy.",
+			"Snowflake does not return the configured values, so only the removal of the privacy domain outside of Terraform is detected. Because of that, the resource cannot be imported.",
+		),
+
+		Schema:   tableColumnPrivacyDomainSchema,
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateTableColumnPrivacyDomain(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	tableId, err := sdk.ParseSchemaObjectIdentifier(d.Get("table").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	column := d.Get("column").(string)
+
+	request := sdk.NewTableColumnAlterSetPrivacyDomainActionRequest(column)
+	if v, ok := d.GetOk("values"); ok {
+		request.WithIn(expandStringList(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("range"); ok {
+		privacyDomainRange := v.([]any)[0].(map[string]any)
+		request.WithBetween(&sdk.PrivacyDomainBetween{
+			Lower: privacyDomainRange["lower"].(string),
+			Upper: privacyDomainRange["upper"].(string),
+		})
+	}
+
+	if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(tableId).WithColumnAction(sdk.NewTableColumnActionRequest().WithSetPrivacyDomain(request))); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting privacy domain for column %s of table %s, err = %w", column, tableId.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(tableId.FullyQualifiedName(), column))
+
+	return ReadTableColumnPrivacyDomain(ctx, d, meta)
+}
+
+func parseTableColumnPrivacyDomainId(id string) (sdk.SchemaObjectIdentifier, string, error) {
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) != 2 {
+		return sdk.SchemaObjectIdentifier{}, "", fmt.Errorf("required id format 'table_name|column_name', but got: '%s'", id)
+	}
+	tableId, err := sdk.ParseSchemaObjectIdentifier(parts[0])
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, "", err
+	}
+	return tableId, parts[1], nil
+}
+
+func ReadTableColumnPrivacyDomain(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	tableId, column, err := parseTableColumnPrivacyDomainId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	columns, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(tableId))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to describe table columns. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Table id: %s, Err: %s", tableId.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	columnDetails, err := collections.FindFirst(columns, func(c sdk.TableColumnDetails) bool { return strings.EqualFold(c.Name, column) })
+	// Note: this means the column or its privacy domain has been removed outside of Terraform.
+	if err != nil || columnDetails.PrivacyDomain == nil {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to find the privacy domain of the column. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Table id: %s, column: %s", tableId.FullyQualifiedName(), column),
+			},
+		}
+	}
+
+	return nil
+}
+
+func DeleteTableColumnPrivacyDomain(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	tableId, column, err := parseTableColumnPrivacyDomainId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(tableId).WithColumnAction(sdk.NewTableColumnActionRequest().WithUnsetPrivacyDomain(sdk.NewTableColumnAlterUnsetPrivacyDomainActionRequest(column))))
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return diag.FromErr(fmt.Errorf("error unsetting privacy domain for column %s of table %s, err = %w", column, tableId.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
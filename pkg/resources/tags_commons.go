@@ -0,0 +1,225 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const tagsAttributeName = "tags"
+
+var tagsSchema = &schema.Schema{
+	Type:             schema.TypeMap,
+	Optional:         true,
+	Elem:             &schema.Schema{Type: schema.TypeString},
+	ValidateDiagFunc: isValidTagsMap,
+	Description: joinWithSpace(
+		"Specifies the tags associated with the object. The key is the fully qualified name of the tag, e.g. `\"<database_name>\".\"<schema_name>\".\"<tag_name>\"`, and the value is the tag value.",
+		"Only the tags listed in this map are managed by the resource; tags associated with the object in any other way are ignored. All the tags set directly on the object are read on import.",
+		relatedResourceDescription("Do not manage the same tag on the same object here and with the tag association resource at the same time.", resources.TagAssociation),
+	),
+}
+
+func isValidTagsMap(value any, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for key := range value.(map[string]any) {
+		if _, err := sdk.ParseSchemaObjectIdentifier(key); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid tag identifier",
+				Detail:        fmt.Sprintf("Unable to parse the tag identifier: %s. The key must be a fully qualified name of the tag. Original error: %v", key, err),
+				AttributePath: path,
+			})
+		}
+	}
+	return diags
+}
+
+// tagsFromMap converts the map from the tags attribute to the map of fully qualified tag names and their values.
+func tagsFromMap(tags map[string]any) (map[string]sdk.TagAssociation, error) {
+	result := make(map[string]sdk.TagAssociation, len(tags))
+	for key, value := range tags {
+		tagId, err := sdk.ParseSchemaObjectIdentifier(key)
+		if err != nil {
+			return nil, err
+		}
+		result[tagId.FullyQualifiedName()] = sdk.TagAssociation{
+			Name:  tagId,
+			Value: value.(string),
+		}
+	}
+	return result, nil
+}
+
+// getTagsForCreate returns the tag associations from the tags attribute, so they can be passed directly to the create request.
+func getTagsForCreate(d *schema.ResourceData) ([]sdk.TagAssociation, error) {
+	tags, err := tagsFromMap(d.Get(tagsAttributeName).(map[string]any))
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	return sortedTagAssociations(tags), nil
+}
+
+// handleTagsCreate sets the tags on the freshly created object. It should be used for objects that do not accept tags in the create statement.
+func handleTagsCreate(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.ObjectIdentifier, objectType sdk.ObjectType) error {
+	tags, err := getTagsForCreate(d)
+	if err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	return client.Tags.Set(ctx, sdk.NewSetTagRequest(objectType, id).WithSetTags(tags))
+}
+
+// handleTagsUpdate unsets the tags removed from the configuration, and sets the added or changed ones.
+func handleTagsUpdate(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.ObjectIdentifier, objectType sdk.ObjectType) error {
+	if !d.HasChange(tagsAttributeName) {
+		return nil
+	}
+	before, after := d.GetChange(tagsAttributeName)
+	beforeTags, err := tagsFromMap(before.(map[string]any))
+	if err != nil {
+		return err
+	}
+	afterTags, err := tagsFromMap(after.(map[string]any))
+	if err != nil {
+		return err
+	}
+
+	unsetTags := make([]sdk.ObjectIdentifier, 0)
+	for fullyQualifiedName, tag := range beforeTags {
+		if _, ok := afterTags[fullyQualifiedName]; !ok {
+			unsetTags = append(unsetTags, tag.Name)
+		}
+	}
+	setTags := make(map[string]sdk.TagAssociation)
+	for fullyQualifiedName, tag := range afterTags {
+		if beforeTag, ok := beforeTags[fullyQualifiedName]; !ok || beforeTag.Value != tag.Value {
+			setTags[fullyQualifiedName] = tag
+		}
+	}
+
+	if len(unsetTags) > 0 {
+		slices.SortFunc(unsetTags, func(a, b sdk.ObjectIdentifier) int {
+			return strings.Compare(a.FullyQualifiedName(), b.FullyQualifiedName())
+		})
+		if err := client.Tags.Unset(ctx, sdk.NewUnsetTagRequest(objectType, id).WithUnsetTags(unsetTags)); err != nil {
+			return err
+		}
+	}
+	if len(setTags) > 0 {
+		if err := client.Tags.Set(ctx, sdk.NewSetTagRequest(objectType, id).WithSetTags(sortedTagAssociations(setTags))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleTagsRead refreshes the values of the tags managed by the resource using the TAG_REFERENCES table function.
+// Only the tags present in the state are managed, so the tags associated with the object in other ways (e.g. with the tag association resource) are ignored.
+func handleTagsRead(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.ObjectIdentifier, objectType sdk.ObjectType) error {
+	currentTags := d.Get(tagsAttributeName).(map[string]any)
+	if len(currentTags) == 0 {
+		return nil
+	}
+	tagValues, err := getDirectTagValues(ctx, client, id, objectType)
+	if err != nil {
+		return err
+	}
+	tags, err := managedTagsFromValues(tagValues, currentTags)
+	if err != nil {
+		return err
+	}
+	return d.Set(tagsAttributeName, tags)
+}
+
+// importWithTags wraps the import implementation of the resource, so all the tags set directly on the imported object are read into the state.
+func importWithTags[T sdk.ObjectIdentifier](objectType sdk.ObjectType, parseId func(string) (T, error), importImplementation schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		result, err := importImplementation(ctx, d, meta)
+		if err != nil {
+			return nil, err
+		}
+		id, err := parseId(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		tagValues, err := getDirectTagValues(ctx, meta.(*provider.Context).Client, id, objectType)
+		if err != nil {
+			return nil, err
+		}
+		tags := make(map[string]any, len(tagValues))
+		for fullyQualifiedName, value := range tagValues {
+			tags[fullyQualifiedName] = value
+		}
+		if err := d.Set(tagsAttributeName, tags); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+}
+
+// getDirectTagValues returns the values of the tags set directly on the object (inherited and column tags are skipped) by the fully qualified names of the tags.
+func getDirectTagValues(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier, objectType sdk.ObjectType) (map[string]string, error) {
+	domain, err := sdk.TagReferenceObjectDomainForObjectType(objectType)
+	if err != nil {
+		return nil, err
+	}
+	tagReferences, err := client.TagReferences.GetForEntity(ctx, sdk.NewGetForEntityTagReferenceRequest(id, domain))
+	if err != nil {
+		return nil, err
+	}
+	return directTagValues(tagReferences), nil
+}
+
+func directTagValues(tagReferences []sdk.TagReference) map[string]string {
+	tagValues := make(map[string]string)
+	for _, tagReference := range tagReferences {
+		if tagReference.ColumnName != nil || !strings.EqualFold(tagReference.Level, tagReference.Domain) {
+			continue
+		}
+		tagValues[tagReference.TagId().FullyQualifiedName()] = tagReference.TagValue
+	}
+	return tagValues
+}
+
+// managedTagsFromValues returns the current values of the tags present in the state; the tags no longer set on the object are dropped.
+// The keys are kept as they are to avoid differences caused only by the identifier quoting.
+func managedTagsFromValues(tagValues map[string]string, currentTags map[string]any) (map[string]any, error) {
+	tags := make(map[string]any, len(currentTags))
+	for key := range currentTags {
+		tagId, err := sdk.ParseSchemaObjectIdentifier(key)
+		if err != nil {
+			return nil, err
+		}
+		if value, ok := tagValues[tagId.FullyQualifiedName()]; ok {
+			tags[key] = value
+		}
+	}
+	return tags, nil
+}
+
+func sortedTagAssociations(tags map[string]sdk.TagAssociation) []sdk.TagAssociation {
+	fullyQualifiedNames := make([]string, 0, len(tags))
+	for fullyQualifiedName := range tags {
+		fullyQualifiedNames = append(fullyQualifiedNames, fullyQualifiedName)
+	}
+	slices.Sort(fullyQualifiedNames)
+	result := make([]sdk.TagAssociation, len(fullyQualifiedNames))
+	for i, fullyQualifiedName := range fullyQualifiedNames {
+		result[i] = tags[fullyQualifiedName]
+	}
+	return result
+}
@@ -0,0 +1,146 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_isValidTagsMap(t *testing.T) {
+	t.Run("valid tag identifiers", func(t *testing.T) {
+		diags := isValidTagsMap(map[string]any{
+			`"a"."b"."c"`: "value",
+			`a.b.d`:       "other",
+		}, cty.GetAttrPath("tags"))
+		require.Empty(t, diags)
+	})
+
+	t.Run("invalid tag identifiers", func(t *testing.T) {
+		diags := isValidTagsMap(map[string]any{
+			`"a"."b"."c"`: "value",
+			`a.b`:         "other",
+			`a.b.c.d`:     "another",
+		}, cty.GetAttrPath("tags"))
+		require.Len(t, diags, 2)
+		for _, d := range diags {
+			assert.Equal(t, "Invalid tag identifier", d.Summary)
+		}
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		diags := isValidTagsMap(map[string]any{}, cty.GetAttrPath("tags"))
+		require.Empty(t, diags)
+	})
+}
+
+func Test_tagsFromMap(t *testing.T) {
+	t.Run("keys are normalized to fully qualified names", func(t *testing.T) {
+		tags, err := tagsFromMap(map[string]any{
+			`a.b.c`:             "value",
+			`"a"."b"."Quoted"`:  "other",
+			`"a"."b"."d.e"`:     "another",
+			`"x"."y"."z"`:       "",
+			`"x"."y"."z_other"`: "last",
+		})
+		require.NoError(t, err)
+		require.Len(t, tags, 5)
+
+		assert.Equal(t, sdk.TagAssociation{Name: sdk.NewSchemaObjectIdentifier("a", "b", "c"), Value: "value"}, tags[`"a"."b"."c"`])
+		assert.Equal(t, sdk.TagAssociation{Name: sdk.NewSchemaObjectIdentifier("a", "b", "Quoted"), Value: "other"}, tags[`"a"."b"."Quoted"`])
+		assert.Equal(t, sdk.TagAssociation{Name: sdk.NewSchemaObjectIdentifier("a", "b", "d.e"), Value: "another"}, tags[`"a"."b"."d.e"`])
+		assert.Equal(t, sdk.TagAssociation{Name: sdk.NewSchemaObjectIdentifier("x", "y", "z"), Value: ""}, tags[`"x"."y"."z"`])
+	})
+
+	t.Run("invalid identifier", func(t *testing.T) {
+		_, err := tagsFromMap(map[string]any{
+			`a.b`: "value",
+		})
+		require.Error(t, err)
+	})
+}
+
+func Test_sortedTagAssociations(t *testing.T) {
+	first := sdk.TagAssociation{Name: sdk.NewSchemaObjectIdentifier("a", "b", "a"), Value: "1"}
+	second := sdk.TagAssociation{Name: sdk.NewSchemaObjectIdentifier("a", "b", "b"), Value: "2"}
+	third := sdk.TagAssociation{Name: sdk.NewSchemaObjectIdentifier("b", "a", "a"), Value: "3"}
+
+	result := sortedTagAssociations(map[string]sdk.TagAssociation{
+		third.Name.FullyQualifiedName():  third,
+		first.Name.FullyQualifiedName():  first,
+		second.Name.FullyQualifiedName(): second,
+	})
+
+	assert.Equal(t, []sdk.TagAssociation{first, second, third}, result)
+}
+
+func Test_directTagValues(t *testing.T) {
+	tagReference := func(database, schema, name, value, level, domain string) sdk.TagReference {
+		return sdk.TagReference{TagDatabase: database, TagSchema: schema, TagName: name, TagValue: value, Level: level, Domain: domain}
+	}
+
+	t.Run("tags set directly on the object", func(t *testing.T) {
+		tagValues := directTagValues([]sdk.TagReference{
+			tagReference("a", "b", "c", "value", "DATABASE", "DATABASE"),
+			tagReference("a", "b", "d", "other", "DATABASE", "DATABASE"),
+		})
+
+		assert.Equal(t, map[string]string{
+			`"a"."b"."c"`: "value",
+			`"a"."b"."d"`: "other",
+		}, tagValues)
+	})
+
+	t.Run("inherited and column tags are skipped", func(t *testing.T) {
+		columnName := "column"
+		columnTag := tagReference("a", "b", "e", "column", "TABLE", "TABLE")
+		columnTag.ColumnName = &columnName
+
+		tagValues := directTagValues([]sdk.TagReference{
+			tagReference("a", "b", "c", "value", "SCHEMA", "SCHEMA"),
+			tagReference("a", "b", "d", "inherited", "DATABASE", "SCHEMA"),
+			columnTag,
+		})
+
+		assert.Equal(t, map[string]string{
+			`"a"."b"."c"`: "value",
+		}, tagValues)
+	})
+}
+
+func Test_managedTagsFromValues(t *testing.T) {
+	t.Run("keys from the state are kept and removed tags are dropped", func(t *testing.T) {
+		tags, err := managedTagsFromValues(map[string]string{
+			`"a"."b"."c"`: "changed",
+		}, map[string]any{
+			`a.b.c`: "value",
+			`a.b.d`: "other",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			`a.b.c`: "changed",
+		}, tags)
+	})
+
+	t.Run("tags not present in the state are ignored", func(t *testing.T) {
+		tags, err := managedTagsFromValues(map[string]string{
+			`"a"."b"."c"`: "value",
+			`"a"."b"."d"`: "set with the tag association",
+		}, map[string]any{
+			`"a"."b"."c"`: "value",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			`"a"."b"."c"`: "value",
+		}, tags)
+	})
+
+	t.Run("invalid key in the state", func(t *testing.T) {
+		_, err := managedTagsFromValues(nil, map[string]any{`a.b`: "value"})
+		require.Error(t, err)
+	})
+}
@@ -169,6 +169,7 @@ var taskSchema = map[string]*schema.Schema{
 		DiffSuppressFunc: SuppressIfAny(DiffSuppressStatement, IgnoreChangeToCurrentSnowflakeValueInShow("definition")),
 		Description:      "Any single SQL statement, or a call to a stored procedure, executed when the task runs.",
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 	ShowOutputAttributeName: {
 		Type:        schema.TypeList,
@@ -198,7 +199,7 @@ func Task() *schema.Resource {
 
 		Schema: collections.MergeMaps(taskSchema, taskParametersSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.Task, ImportTask),
+			StateContext: TrackingImportWrapper(resources.Task, importWithTags(sdk.ObjectTypeTask, sdk.ParseSchemaObjectIdentifier, ImportTask)),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.Task, customdiff.All(
@@ -255,6 +256,14 @@ func CreateTask(ctx context.Context, d *schema.ResourceData, meta any) (diags di
 	req := sdk.NewCreateTaskRequest(id, d.Get("sql_statement").(string))
 	tasksToResume := make([]sdk.SchemaObjectIdentifier, 0)
 
+	tags, err := getTagsForCreate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(tags) > 0 {
+		req.WithTag(tags)
+	}
+
 	if errs := errors.Join(
 		attributeMappedValueCreate(d, "warehouse", &req.Warehouse, func(v any) (*sdk.CreateTaskWarehouseRequest, error) {
 			warehouseId, err := sdk.ParseAccountObjectIdentifier(v.(string))
@@ -264,22 +273,7 @@ func CreateTask(ctx context.Context, d *schema.ResourceData, meta any) (diags di
 			return sdk.NewCreateTaskWarehouseRequest().WithWarehouse(warehouseId), nil
 		}),
 		attributeMappedValueCreate(d, "schedule", &req.Schedule, func(v any) (*string, error) {
-			if len(v.([]any)) > 0 {
-				if seconds, ok := d.GetOk("schedule.0.seconds"); ok {
-					return sdk.String(fmt.Sprintf("%d SECOND", seconds)), nil
-				}
-				if minutes, ok := d.GetOk("schedule.0.minutes"); ok {
-					return sdk.String(fmt.Sprintf("%d MINUTE", minutes)), nil
-				}
-				if hours, ok := d.GetOk("schedule.0.hours"); ok {
-					return sdk.String(fmt.Sprintf("%d HOUR", hours)), nil
-				}
-				if cron, ok := d.GetOk("schedule.0.using_cron"); ok {
-					return sdk.String(fmt.Sprintf("USING CRON %s", cron)), nil
-				}
-				return nil, fmt.Errorf("when setting a schedule one of seconds, minutes, hours, or using_cron field should be set")
-			}
-			return nil, nil
+			return taskScheduleFromConfig(d)
 		}),
 		stringAttributeCreate(d, "config", &req.Config),
 		booleanStringAttributeCreate(d, "allow_overlapping_execution", &req.AllowOverlappingExecution),
@@ -390,6 +384,10 @@ func UpdateTask(ctx context.Context, d *schema.ResourceData, meta any) (diags di
 		}
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeTask); err != nil {
+		return diag.FromErr(err)
+	}
+
 	unset := sdk.NewTaskUnsetRequest()
 	set := sdk.NewTaskSetRequest()
 
@@ -615,42 +613,7 @@ func ReadTask(withExternalChangesMarking bool) schema.ReadContextFunc {
 			attributeMappedValueReadOrDefault(d, "warehouse", task.Warehouse, func(warehouse *sdk.AccountObjectIdentifier) (string, error) {
 				return warehouse.Name(), nil
 			}, nil),
-			func() error {
-				if len(task.Schedule) > 0 {
-					taskSchedule, err := sdk.ParseTaskSchedule(task.Schedule)
-					if err != nil {
-						return err
-					}
-					switch {
-					case len(taskSchedule.Cron) > 0:
-						if err := d.Set("schedule", []any{map[string]any{
-							"using_cron": taskSchedule.Cron,
-						}}); err != nil {
-							return err
-						}
-					case taskSchedule.Seconds > 0:
-						if err := d.Set("schedule", []any{map[string]any{
-							"seconds": taskSchedule.Seconds,
-						}}); err != nil {
-							return err
-						}
-					case taskSchedule.Minutes > 0:
-						if err := d.Set("schedule", []any{map[string]any{
-							"minutes": taskSchedule.Minutes,
-						}}); err != nil {
-							return err
-						}
-					case taskSchedule.Hours > 0:
-						if err := d.Set("schedule", []any{map[string]any{
-							"hours": taskSchedule.Hours,
-						}}); err != nil {
-							return err
-						}
-					}
-					return nil
-				}
-				return d.Set("schedule", nil)
-			}(),
+			setTaskScheduleInState(d, task.Schedule),
 			d.Set("started", task.IsStarted()),
 			d.Set("when", task.Condition),
 			d.Set("config", task.Config),
@@ -658,6 +621,7 @@ func ReadTask(withExternalChangesMarking bool) schema.ReadContextFunc {
 			d.Set("sql_statement", task.Definition),
 			d.Set("after", collections.Map(task.TaskRelations.Predecessors, sdk.SchemaObjectIdentifier.FullyQualifiedName)),
 			handleTaskParameterRead(d, taskParameters),
+			handleTagsRead(ctx, client, d, id, sdk.ObjectTypeTask),
 			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
 			d.Set(ShowOutputAttributeName, []map[string]any{schemas.TaskToSchema(task)}),
 			d.Set(ParametersAttributeName, []map[string]any{schemas.TaskParametersToSchema(taskParameters)}),
@@ -694,6 +658,56 @@ func DeleteTask(ctx context.Context, d *schema.ResourceData, meta any) (diags di
 	return diags
 }
 
+// taskScheduleFromConfig returns the schedule from the `schedule` block in the format accepted by Snowflake, or nil if the block is not set.
+func taskScheduleFromConfig(d *schema.ResourceData) (*string, error) {
+	if len(d.Get("schedule").([]any)) == 0 {
+		return nil, nil
+	}
+	if seconds, ok := d.GetOk("schedule.0.seconds"); ok {
+		return sdk.String(fmt.Sprintf("%d SECOND", seconds)), nil
+	}
+	if minutes, ok := d.GetOk("schedule.0.minutes"); ok {
+		return sdk.String(fmt.Sprintf("%d MINUTE", minutes)), nil
+	}
+	if hours, ok := d.GetOk("schedule.0.hours"); ok {
+		return sdk.String(fmt.Sprintf("%d HOUR", hours)), nil
+	}
+	if cron, ok := d.GetOk("schedule.0.using_cron"); ok {
+		return sdk.String(fmt.Sprintf("USING CRON %s", cron)), nil
+	}
+	return nil, fmt.Errorf("when setting a schedule one of seconds, minutes, hours, or using_cron field should be set")
+}
+
+// setTaskScheduleInState sets the `schedule` block based on the schedule returned by SHOW TASKS.
+func setTaskScheduleInState(d *schema.ResourceData, schedule string) error {
+	if len(schedule) == 0 {
+		return d.Set("schedule", nil)
+	}
+	taskSchedule, err := sdk.ParseTaskSchedule(schedule)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(taskSchedule.Cron) > 0:
+		return d.Set("schedule", []any{map[string]any{
+			"using_cron": taskSchedule.Cron,
+		}})
+	case taskSchedule.Seconds > 0:
+		return d.Set("schedule", []any{map[string]any{
+			"seconds": taskSchedule.Seconds,
+		}})
+	case taskSchedule.Minutes > 0:
+		return d.Set("schedule", []any{map[string]any{
+			"minutes": taskSchedule.Minutes,
+		}})
+	case taskSchedule.Hours > 0:
+		return d.Set("schedule", []any{map[string]any{
+			"hours": taskSchedule.Hours,
+		}})
+	}
+	return nil
+}
+
 func resumeTaskErrorDiag(id sdk.SchemaObjectIdentifier, operation string, originalErr error) diag.Diagnostic {
 	return diag.Diagnostic{
 		Severity: diag.Warning,
@@ -0,0 +1,812 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// taskGraphTaskSchema is the common schema of the root, child, and finalizer tasks in the task graph.
+var taskGraphTaskSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the task; must be unique in the task graph. The task is created in the database and schema of the task graph."),
+	},
+	"sql_statement": {
+		Type:             schema.TypeString,
+		Required:         true,
+		DiffSuppressFunc: DiffSuppressStatement,
+		Description:      "Any single SQL statement, or a call to a stored procedure, executed when the task runs.",
+	},
+	"warehouse": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("The warehouse the task will use. Omit this parameter to use Snowflake-managed compute resources for runs of this task.", resources.Warehouse),
+	},
+	"when": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: DiffSuppressStatement,
+		Description:      "Specifies a Boolean SQL expression. When the task is triggered, it validates the conditions of the expression to determine whether to execute. If the conditions of the expression are not met, then the task skips the current run.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the task.",
+	},
+}
+
+var taskGraphSchema = map[string]*schema.Schema{
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the tasks of the task graph."),
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the tasks of the task graph."),
+	},
+	"started": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies if the task graph should be started or suspended. When the task graph is started, all of its tasks are resumed.",
+	},
+	"root": {
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Description: joinWithSpace(
+			"The root task of the task graph. The schedule and the graph-level settings (`schedule`, `config`, `allow_overlapping_execution`, and `error_integration`) are set on this task.",
+			"Changing the name of the root task recreates the whole task graph.",
+		),
+		Elem: &schema.Resource{
+			Schema: collections.MergeMaps(taskGraphTaskSchema, map[string]*schema.Schema{
+				"name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the root task; must be unique in the task graph."),
+				},
+			}),
+		},
+	},
+	"task": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Child tasks of the task graph. The tasks are created in the order that satisfies the dependencies declared in `after`; cycles are rejected during the plan.",
+		Elem: &schema.Resource{
+			Schema: collections.MergeMaps(taskGraphTaskSchema, map[string]*schema.Schema{
+				"after": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Names of the predecessor tasks. Each of them has to be the root or one of the child tasks declared in this task graph.",
+				},
+			}),
+		},
+	},
+	"finalizer": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The finalizer task of the task graph. It runs after all other tasks in the task graph run to completion. For more information, see [Release and cleanup of task graphs](https://docs.snowflake.com/en/user-guide/tasks-graphs.html#label-finalizer-task).",
+		Elem: &schema.Resource{
+			Schema: taskGraphTaskSchema,
+		},
+	},
+	"schedule": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The schedule for periodically running the task graph. This can be a cron or interval in seconds, minutes, or hours. When set, one of the sub-fields `seconds`, `minutes`, `hours`, or `using_cron` should be set. Without a schedule, the task graph only runs if manually executed using [EXECUTE TASK](https://docs.snowflake.com/en/sql-reference/sql/execute-task).",
+		Elem:        taskSchema["schedule"].Elem,
+	},
+	"config": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a string representation of key value pairs that can be accessed by all tasks in the task graph. Must be in JSON format.",
+	},
+	"allow_overlapping_execution": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("By default, Snowflake ensures that only one instance of a particular task graph is allowed to run at a time, setting the parameter value to TRUE permits task graph runs to overlap."),
+	},
+	"error_integration": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription(blocklistedCharactersFieldDescription("Specifies the name of the notification integration used for error notifications."), resources.NotificationIntegration),
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func TaskGraph() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.TaskGraphResource), TrackingCreateWrapper(resources.TaskGraph, CreateTaskGraph)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.TaskGraphResource), TrackingReadWrapper(resources.TaskGraph, ReadTaskGraph)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.TaskGraphResource), TrackingUpdateWrapper(resources.TaskGraph, UpdateTaskGraph)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.TaskGraphResource), TrackingDeleteWrapper(resources.TaskGraph, DeleteTaskGraph)),
+		Description: joinWithSpace(
+			"Resource used to manage a whole [task graph](https://docs.snowflake.com/en/user-guide/tasks-graphs) (the root task, its child tasks, and the finalizer task) in a single resource.",
+			"During each apply, the root task is suspended once, all the changes are applied, and the task graph is resumed once.",
+			"All the tasks of the task graph are created in the same database and schema. Tasks depending on the tasks of the graph that are not declared in the configuration are treated as a part of the graph, so they are removed during the next apply.",
+			"Do not manage the same tasks with the `snowflake_task` resource.",
+		),
+
+		Schema: taskGraphSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.TaskGraph, ImportTaskGraph),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.TaskGraph, taskGraphCustomDiff),
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+// taskGraphTask is a single task of the task graph, as declared in the configuration.
+type taskGraphTask struct {
+	name         string
+	sqlStatement string
+	warehouse    string
+	when         string
+	comment      string
+	after        []string
+}
+
+func expandTaskGraphTask(raw any) taskGraphTask {
+	taskConfig := raw.(map[string]any)
+	task := taskGraphTask{
+		name:         taskConfig["name"].(string),
+		sqlStatement: taskConfig["sql_statement"].(string),
+		warehouse:    taskConfig["warehouse"].(string),
+		when:         taskConfig["when"].(string),
+		comment:      taskConfig["comment"].(string),
+	}
+	if after, ok := taskConfig["after"]; ok && after != nil {
+		task.after = expandStringList(after.(*schema.Set).List())
+		slices.Sort(task.after)
+	}
+	return task
+}
+
+// expandTaskGraph returns the root task, the child tasks, and the optional finalizer from the raw values of the `root`, `task`, and `finalizer` attributes.
+func expandTaskGraph(rawRoot any, rawTasks any, rawFinalizer any) (*taskGraphTask, []taskGraphTask, *taskGraphTask) {
+	var root, finalizer *taskGraphTask
+	if rootList := rawRoot.([]any); len(rootList) > 0 && rootList[0] != nil {
+		root = sdk.Pointer(expandTaskGraphTask(rootList[0]))
+	}
+	if finalizerList := rawFinalizer.([]any); len(finalizerList) > 0 && finalizerList[0] != nil {
+		finalizer = sdk.Pointer(expandTaskGraphTask(finalizerList[0]))
+	}
+	tasks := make([]taskGraphTask, 0)
+	for _, rawTask := range rawTasks.([]any) {
+		if rawTask != nil {
+			tasks = append(tasks, expandTaskGraphTask(rawTask))
+		}
+	}
+	return root, tasks, finalizer
+}
+
+func (t taskGraphTask) toSchema() map[string]any {
+	taskSchema := map[string]any{
+		"name":          t.name,
+		"sql_statement": t.sqlStatement,
+		"warehouse":     t.warehouse,
+		"when":          t.when,
+		"comment":       t.comment,
+	}
+	if t.after != nil {
+		taskSchema["after"] = t.after
+	}
+	return taskSchema
+}
+
+// taskGraphTaskFromSnowflake maps the task returned by SHOW TASKS to the task graph task. Predecessors from the same schema are referenced by their names.
+func taskGraphTaskFromSnowflake(task sdk.Task, withAfter bool) taskGraphTask {
+	graphTask := taskGraphTask{
+		name:         task.Name,
+		sqlStatement: task.Definition,
+		when:         task.Condition,
+		comment:      task.Comment,
+	}
+	if task.Warehouse != nil {
+		graphTask.warehouse = task.Warehouse.Name()
+	}
+	if withAfter {
+		graphTask.after = make([]string, len(task.Predecessors))
+		for i, predecessor := range task.Predecessors {
+			if predecessor.DatabaseName() == task.DatabaseName && predecessor.SchemaName() == task.SchemaName {
+				graphTask.after[i] = predecessor.Name()
+			} else {
+				graphTask.after[i] = predecessor.FullyQualifiedName()
+			}
+		}
+		slices.Sort(graphTask.after)
+	}
+	return graphTask
+}
+
+// taskGraphOrder validates the task graph and returns the child tasks in the order in which they can be created, i.e., each task is preceded by all of its predecessors.
+// Tasks without mutual dependencies are kept in the order from the configuration.
+func taskGraphOrder(root taskGraphTask, tasks []taskGraphTask, finalizer *taskGraphTask) ([]taskGraphTask, error) {
+	names := []string{root.name}
+	for _, task := range tasks {
+		if slices.Contains(names, task.name) {
+			return nil, fmt.Errorf("task names in the task graph have to be unique, got duplicated name %s", task.name)
+		}
+		names = append(names, task.name)
+	}
+	if finalizer != nil && slices.Contains(names, finalizer.name) {
+		return nil, fmt.Errorf("task names in the task graph have to be unique, got duplicated name %s", finalizer.name)
+	}
+
+	for _, task := range tasks {
+		if len(task.after) == 0 {
+			return nil, fmt.Errorf("task %s has to have at least one predecessor in after", task.name)
+		}
+		for _, predecessor := range task.after {
+			switch {
+			case predecessor == task.name:
+				return nil, fmt.Errorf("task %s cannot be its own predecessor", task.name)
+			case finalizer != nil && predecessor == finalizer.name:
+				return nil, fmt.Errorf("finalizer task %s cannot be a predecessor of task %s", predecessor, task.name)
+			case !slices.Contains(names, predecessor):
+				return nil, fmt.Errorf("predecessor %s of task %s is not declared in the task graph", predecessor, task.name)
+			}
+		}
+	}
+
+	ordered := make([]taskGraphTask, 0, len(tasks))
+	created := []string{root.name}
+	remaining := slices.Clone(tasks)
+	for len(remaining) > 0 {
+		index := slices.IndexFunc(remaining, func(task taskGraphTask) bool {
+			return !slices.ContainsFunc(task.after, func(predecessor string) bool {
+				return !slices.Contains(created, predecessor)
+			})
+		})
+		if index == -1 {
+			return nil, fmt.Errorf("task graph contains a cycle between tasks: %s", strings.Join(collections.Map(remaining, func(task taskGraphTask) string { return task.name }), ", "))
+		}
+		ordered = append(ordered, remaining[index])
+		created = append(created, remaining[index].name)
+		remaining = slices.Delete(remaining, index, index+1)
+	}
+	return ordered, nil
+}
+
+func taskGraphCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	// the graph can be validated only when all the names are known
+	if !diff.NewValueKnown("root") || !diff.NewValueKnown("task") || !diff.NewValueKnown("finalizer") {
+		return nil
+	}
+	root, tasks, finalizer := expandTaskGraph(diff.Get("root"), diff.Get("task"), diff.Get("finalizer"))
+	if root == nil {
+		return nil
+	}
+	_, err := taskGraphOrder(*root, tasks, finalizer)
+	return err
+}
+
+func ImportTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := errors.Join(
+		d.Set("database", id.DatabaseName()),
+		d.Set("schema", id.SchemaName()),
+		d.Set("allow_overlapping_execution", BooleanDefault),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	schemaId := sdk.NewDatabaseObjectIdentifier(d.Get("database").(string), d.Get("schema").(string))
+	root, tasks, finalizer := expandTaskGraph(d.Get("root"), d.Get("task"), d.Get("finalizer"))
+	orderedTasks, err := taskGraphOrder(*root, tasks, finalizer)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rootId := sdk.NewSchemaObjectIdentifierInSchema(schemaId, root.name)
+
+	req, err := root.createRequest(rootId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if errs := errors.Join(
+		attributeMappedValueCreate(d, "schedule", &req.Schedule, func(v any) (*string, error) {
+			return taskScheduleFromConfig(d)
+		}),
+		stringAttributeCreate(d, "config", &req.Config),
+		booleanStringAttributeCreate(d, "allow_overlapping_execution", &req.AllowOverlappingExecution),
+		accountObjectIdentifierAttributeCreate(d, "error_integration", &req.ErrorIntegration),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	if err := client.Tasks.Create(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(rootId))
+
+	for _, task := range orderedTasks {
+		if err := createTaskGraphChildTask(ctx, client, schemaId, task); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if finalizer != nil {
+		if err := createTaskGraphFinalizer(ctx, client, schemaId, rootId, *finalizer); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// tasks are created as suspended (https://docs.snowflake.com/en/sql-reference/sql/create-task; "important" section)
+	if d.Get("started").(bool) {
+		if err := startTaskGraph(ctx, client, rootId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadTaskGraph(ctx, d, meta)
+}
+
+func ReadTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	rootId, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rootTask, tasks, finalizer, err := showTaskGraph(ctx, client, rootId)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query the root task of the task graph. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Task id: %s, Err: %s", rootId.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	// the tasks already in the state keep their positions; other tasks are appended in the graph order
+	_, stateTasks, _ := expandTaskGraph(d.Get("root"), d.Get("task"), d.Get("finalizer"))
+	stateTaskNames := collections.Map(stateTasks, func(task taskGraphTask) string { return task.name })
+	slices.SortStableFunc(tasks, func(a, b sdk.Task) int {
+		aIndex, bIndex := slices.Index(stateTaskNames, a.Name), slices.Index(stateTaskNames, b.Name)
+		switch {
+		case aIndex == -1 && bIndex == -1:
+			return 0
+		case aIndex == -1:
+			return 1
+		case bIndex == -1:
+			return -1
+		default:
+			return aIndex - bIndex
+		}
+	})
+
+	finalizerSchema := make([]map[string]any, 0)
+	if finalizer != nil {
+		finalizerSchema = append(finalizerSchema, taskGraphTaskFromSnowflake(*finalizer, false).toSchema())
+	}
+
+	allowOverlappingExecution := d.Get("allow_overlapping_execution").(string)
+	if allowOverlappingExecution != BooleanDefault || rootTask.AllowOverlappingExecution {
+		allowOverlappingExecution = booleanStringFromBool(rootTask.AllowOverlappingExecution)
+	}
+
+	if errs := errors.Join(
+		d.Set("database", rootId.DatabaseName()),
+		d.Set("schema", rootId.SchemaName()),
+		d.Set("started", rootTask.IsStarted()),
+		d.Set("root", []map[string]any{taskGraphTaskFromSnowflake(*rootTask, false).toSchema()}),
+		d.Set("task", collections.Map(tasks, func(task sdk.Task) map[string]any {
+			return taskGraphTaskFromSnowflake(task, true).toSchema()
+		})),
+		d.Set("finalizer", finalizerSchema),
+		setTaskScheduleInState(d, rootTask.Schedule),
+		d.Set("config", rootTask.Config),
+		d.Set("allow_overlapping_execution", allowOverlappingExecution),
+		attributeMappedValueReadOrDefault(d, "error_integration", rootTask.ErrorIntegration, func(errorIntegration *sdk.AccountObjectIdentifier) (string, error) {
+			return errorIntegration.Name(), nil
+		}, nil),
+		d.Set(FullyQualifiedNameAttributeName, rootId.FullyQualifiedName()),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	return nil
+}
+
+func UpdateTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
+	client := meta.(*provider.Context).Client
+	rootId, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	schemaId := rootId.SchemaId()
+
+	rootTask, err := client.Tasks.ShowByID(ctx, rootId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the root task is suspended only once for all the changes in the task graph
+	if rootTask.IsStarted() {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(rootId).WithSuspend(true)); err != nil {
+			return diag.FromErr(err)
+		}
+		defer func() {
+			if diags.HasError() {
+				if err := client.Tasks.ResumeTasks(ctx, []sdk.SchemaObjectIdentifier{rootId}); err != nil {
+					diags = append(diags, resumeTaskErrorDiag(rootId, "update", err))
+				}
+			}
+		}()
+	}
+
+	oldRawRoot, _ := d.GetChange("root")
+	oldRawTasks, _ := d.GetChange("task")
+	oldRawFinalizer, _ := d.GetChange("finalizer")
+	oldRoot, oldTasks, oldFinalizer := expandTaskGraph(oldRawRoot, oldRawTasks, oldRawFinalizer)
+	newRoot, newTasks, newFinalizer := expandTaskGraph(d.Get("root"), d.Get("task"), d.Get("finalizer"))
+	orderedNewTasks, err := taskGraphOrder(*newRoot, newTasks, newFinalizer)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	unset := sdk.NewTaskUnsetRequest()
+	set := sdk.NewTaskSetRequest()
+	if err := errors.Join(
+		stringAttributeUpdate(d, "config", &set.Config, &unset.Config),
+		booleanStringAttributeUpdate(d, "allow_overlapping_execution", &set.AllowOverlappingExecution, &unset.AllowOverlappingExecution),
+		accountObjectIdentifierAttributeUpdate(d, "error_integration", &set.ErrorIntegration, &unset.ErrorIntegration),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("schedule") {
+		schedule, err := taskScheduleFromConfig(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if schedule != nil {
+			set.Schedule = schedule
+		} else {
+			unset.Schedule = sdk.Bool(true)
+		}
+	}
+	if *unset != (sdk.TaskUnsetRequest{}) {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(rootId).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if *set != (sdk.TaskSetRequest{}) {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(rootId).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if oldRoot != nil {
+		if err := alterTaskGraphTask(ctx, client, rootId, *oldRoot, *newRoot); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := updateTaskGraphChildTasks(ctx, client, schemaId, oldTasks, orderedNewTasks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	switch {
+	case oldFinalizer != nil && newFinalizer != nil && oldFinalizer.name == newFinalizer.name:
+		if err := alterTaskGraphTask(ctx, client, sdk.NewSchemaObjectIdentifierInSchema(schemaId, newFinalizer.name), *oldFinalizer, *newFinalizer); err != nil {
+			return diag.FromErr(err)
+		}
+	default:
+		if oldFinalizer != nil {
+			if err := client.Tasks.DropSafely(ctx, sdk.NewSchemaObjectIdentifierInSchema(schemaId, oldFinalizer.name)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if newFinalizer != nil {
+			if err := createTaskGraphFinalizer(ctx, client, schemaId, rootId, *newFinalizer); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.Get("started").(bool) {
+		if err := startTaskGraph(ctx, client, rootId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	// We don't process the else case, because the root task was already suspended at the beginning of the Update method.
+
+	return append(diags, ReadTaskGraph(ctx, d, meta)...)
+}
+
+// updateTaskGraphChildTasks applies the changes to the child tasks. The predecessors that are no longer needed are removed first,
+// so that the new dependencies cannot create a temporary cycle; the tasks removed from the graph are dropped at the end.
+func updateTaskGraphChildTasks(ctx context.Context, client *sdk.Client, schemaId sdk.DatabaseObjectIdentifier, oldTasks []taskGraphTask, orderedNewTasks []taskGraphTask) error {
+	oldTaskByName := make(map[string]taskGraphTask)
+	for _, task := range oldTasks {
+		oldTaskByName[task.name] = task
+	}
+	newTaskNames := collections.Map(orderedNewTasks, func(task taskGraphTask) string { return task.name })
+
+	for _, newTask := range orderedNewTasks {
+		oldTask, ok := oldTaskByName[newTask.name]
+		if !ok {
+			continue
+		}
+		_, removedPredecessors := ListDiff(oldTask.after, newTask.after)
+		if len(removedPredecessors) > 0 {
+			id := sdk.NewSchemaObjectIdentifierInSchema(schemaId, newTask.name)
+			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithRemoveAfter(taskGraphPredecessorIds(schemaId, removedPredecessors))); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, newTask := range orderedNewTasks {
+		oldTask, ok := oldTaskByName[newTask.name]
+		if !ok {
+			if err := createTaskGraphChildTask(ctx, client, schemaId, newTask); err != nil {
+				return err
+			}
+			continue
+		}
+		id := sdk.NewSchemaObjectIdentifierInSchema(schemaId, newTask.name)
+		addedPredecessors, _ := ListDiff(oldTask.after, newTask.after)
+		if len(addedPredecessors) > 0 {
+			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithAddAfter(taskGraphPredecessorIds(schemaId, addedPredecessors))); err != nil {
+				return err
+			}
+		}
+		if err := alterTaskGraphTask(ctx, client, id, oldTask, newTask); err != nil {
+			return err
+		}
+	}
+
+	for i := len(oldTasks) - 1; i >= 0; i-- {
+		if !slices.Contains(newTaskNames, oldTasks[i].name) {
+			if err := client.Tasks.DropSafely(ctx, sdk.NewSchemaObjectIdentifierInSchema(schemaId, oldTasks[i].name)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func DeleteTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	rootId, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rootTask, tasks, finalizer, err := showTaskGraph(ctx, client, rootId)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if rootTask.IsStarted() {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(rootId).WithSuspend(true)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if finalizer != nil {
+		if err := client.Tasks.DropSafely(ctx, finalizer.ID()); err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting finalizer task %s err = %w", finalizer.ID().FullyQualifiedName(), err))
+		}
+	}
+	// the tasks are dropped from the last ones in the graph
+	for i := len(tasks) - 1; i >= 0; i-- {
+		if err := client.Tasks.DropSafely(ctx, tasks[i].ID()); err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting task %s err = %w", tasks[i].ID().FullyQualifiedName(), err))
+		}
+	}
+	if err := client.Tasks.DropSafely(ctx, rootId); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting task %s err = %w", rootId.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// showTaskGraph returns the root task, all the tasks depending on it (directly or indirectly) in the schema of the root task in the graph order, and the finalizer task.
+func showTaskGraph(ctx context.Context, client *sdk.Client, rootId sdk.SchemaObjectIdentifier) (*sdk.Task, []sdk.Task, *sdk.Task, error) {
+	schemaTasks, err := client.Tasks.Show(ctx, sdk.NewShowTaskRequest().WithIn(sdk.ExtendedIn{In: sdk.In{Schema: rootId.SchemaId()}}))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	slices.SortFunc(schemaTasks, func(a, b sdk.Task) int { return strings.Compare(a.Name, b.Name) })
+
+	rootIndex := slices.IndexFunc(schemaTasks, func(task sdk.Task) bool { return task.Name == rootId.Name() })
+	if rootIndex == -1 {
+		return nil, nil, nil, errors.Join(sdk.ErrObjectNotFound, fmt.Errorf("root task %s not found", rootId.FullyQualifiedName()))
+	}
+	rootTask := schemaTasks[rootIndex]
+
+	var finalizer *sdk.Task
+	if rootTask.TaskRelations.FinalizerTask != nil {
+		finalizerId := *rootTask.TaskRelations.FinalizerTask
+		if finalizerIndex := slices.IndexFunc(schemaTasks, func(task sdk.Task) bool {
+			return task.ID().FullyQualifiedName() == finalizerId.FullyQualifiedName()
+		}); finalizerIndex != -1 {
+			finalizer = &schemaTasks[finalizerIndex]
+		}
+	}
+
+	graphTaskNames := []string{rootTask.Name}
+	tasks := make([]sdk.Task, 0)
+	for i := 0; i < len(graphTaskNames); i++ {
+		predecessorId := sdk.NewSchemaObjectIdentifierInSchema(rootId.SchemaId(), graphTaskNames[i])
+		for _, task := range schemaTasks {
+			if slices.Contains(graphTaskNames, task.Name) {
+				continue
+			}
+			if slices.ContainsFunc(task.Predecessors, func(predecessor sdk.SchemaObjectIdentifier) bool {
+				return predecessor.FullyQualifiedName() == predecessorId.FullyQualifiedName()
+			}) {
+				graphTaskNames = append(graphTaskNames, task.Name)
+				tasks = append(tasks, task)
+			}
+		}
+	}
+
+	return &rootTask, tasks, finalizer, nil
+}
+
+// startTaskGraph resumes all the suspended tasks of the task graph; the root task is resumed as the last one.
+func startTaskGraph(ctx context.Context, client *sdk.Client, rootId sdk.SchemaObjectIdentifier) error {
+	_, tasks, finalizer, err := showTaskGraph(ctx, client, rootId)
+	if err != nil {
+		return err
+	}
+	if finalizer != nil {
+		tasks = append(tasks, *finalizer)
+	}
+	for _, task := range tasks {
+		if !task.IsStarted() {
+			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(task.ID()).WithResume(true)); err != nil {
+				return fmt.Errorf("error starting task %s err = %w", task.ID().FullyQualifiedName(), err)
+			}
+		}
+	}
+	return waitForTaskStart(ctx, client, rootId)
+}
+
+func (t taskGraphTask) createRequest(id sdk.SchemaObjectIdentifier) (*sdk.CreateTaskRequest, error) {
+	req := sdk.NewCreateTaskRequest(id, t.sqlStatement)
+	if t.warehouse != "" {
+		warehouseId, err := sdk.ParseAccountObjectIdentifier(t.warehouse)
+		if err != nil {
+			return nil, err
+		}
+		req.WithWarehouse(*sdk.NewCreateTaskWarehouseRequest().WithWarehouse(warehouseId))
+	}
+	if t.when != "" {
+		req.WithWhen(t.when)
+	}
+	if t.comment != "" {
+		req.WithComment(t.comment)
+	}
+	return req, nil
+}
+
+func createTaskGraphChildTask(ctx context.Context, client *sdk.Client, schemaId sdk.DatabaseObjectIdentifier, task taskGraphTask) error {
+	req, err := task.createRequest(sdk.NewSchemaObjectIdentifierInSchema(schemaId, task.name))
+	if err != nil {
+		return err
+	}
+	return client.Tasks.Create(ctx, req.WithAfter(taskGraphPredecessorIds(schemaId, task.after)))
+}
+
+func createTaskGraphFinalizer(ctx context.Context, client *sdk.Client, schemaId sdk.DatabaseObjectIdentifier, rootId sdk.SchemaObjectIdentifier, task taskGraphTask) error {
+	req, err := task.createRequest(sdk.NewSchemaObjectIdentifierInSchema(schemaId, task.name))
+	if err != nil {
+		return err
+	}
+	return client.Tasks.Create(ctx, req.WithFinalize(rootId))
+}
+
+// alterTaskGraphTask applies the changes of all the task attributes except for the predecessors.
+func alterTaskGraphTask(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, oldTask taskGraphTask, newTask taskGraphTask) error {
+	unset := sdk.NewTaskUnsetRequest()
+	set := sdk.NewTaskSetRequest()
+
+	if oldTask.warehouse != newTask.warehouse {
+		if newTask.warehouse != "" {
+			warehouseId, err := sdk.ParseAccountObjectIdentifier(newTask.warehouse)
+			if err != nil {
+				return err
+			}
+			set.Warehouse = &warehouseId
+		} else {
+			unset.Warehouse = sdk.Bool(true)
+		}
+	}
+	if oldTask.comment != newTask.comment {
+		if newTask.comment != "" {
+			set.Comment = sdk.String(newTask.comment)
+		} else {
+			unset.Comment = sdk.Bool(true)
+		}
+	}
+
+	if *unset != (sdk.TaskUnsetRequest{}) {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithUnset(*unset)); err != nil {
+			return err
+		}
+	}
+	if *set != (sdk.TaskSetRequest{}) {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithSet(*set)); err != nil {
+			return err
+		}
+	}
+
+	if oldTask.when != newTask.when {
+		if newTask.when != "" {
+			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithModifyWhen(newTask.when)); err != nil {
+				return err
+			}
+		} else {
+			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithRemoveWhen(true)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if oldTask.sqlStatement != newTask.sqlStatement {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithModifyAs(newTask.sqlStatement)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func taskGraphPredecessorIds(schemaId sdk.DatabaseObjectIdentifier, names []string) []sdk.SchemaObjectIdentifier {
+	return collections.Map(names, func(name string) sdk.SchemaObjectIdentifier {
+		return sdk.NewSchemaObjectIdentifierInSchema(schemaId, name)
+	})
+}
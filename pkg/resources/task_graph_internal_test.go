@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskGraphOrder(t *testing.T) {
+	root := taskGraphTask{name: "ROOT"}
+	task := func(name string, after ...string) taskGraphTask {
+		return taskGraphTask{name: name, after: after}
+	}
+	names := func(tasks []taskGraphTask) []string {
+		return collections.Map(tasks, func(task taskGraphTask) string { return task.name })
+	}
+
+	t.Run("no child tasks", func(t *testing.T) {
+		ordered, err := taskGraphOrder(root, nil, nil)
+		require.NoError(t, err)
+		assert.Empty(t, ordered)
+	})
+
+	t.Run("tasks declared before their predecessors", func(t *testing.T) {
+		ordered, err := taskGraphOrder(root, []taskGraphTask{
+			task("D", "B", "C"),
+			task("C", "A"),
+			task("B", "A"),
+			task("A", "ROOT"),
+		}, &taskGraphTask{name: "FINALIZER"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"A", "C", "B", "D"}, names(ordered))
+	})
+
+	t.Run("independent tasks keep the configuration order", func(t *testing.T) {
+		ordered, err := taskGraphOrder(root, []taskGraphTask{
+			task("B", "ROOT"),
+			task("A", "ROOT"),
+			task("C", "ROOT"),
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"B", "A", "C"}, names(ordered))
+	})
+
+	invalid := []struct {
+		name      string
+		tasks     []taskGraphTask
+		finalizer *taskGraphTask
+		err       string
+	}{
+		{name: "duplicated child task", tasks: []taskGraphTask{task("A", "ROOT"), task("A", "ROOT")}, err: "got duplicated name A"},
+		{name: "child task with the root name", tasks: []taskGraphTask{task("ROOT", "ROOT")}, err: "got duplicated name ROOT"},
+		{name: "finalizer with the child task name", tasks: []taskGraphTask{task("A", "ROOT")}, finalizer: &taskGraphTask{name: "A"}, err: "got duplicated name A"},
+		{name: "no predecessors", tasks: []taskGraphTask{task("A")}, err: "task A has to have at least one predecessor"},
+		{name: "self dependency", tasks: []taskGraphTask{task("A", "A")}, err: "task A cannot be its own predecessor"},
+		{name: "finalizer as predecessor", tasks: []taskGraphTask{task("A", "FINALIZER")}, finalizer: &taskGraphTask{name: "FINALIZER"}, err: "finalizer task FINALIZER cannot be a predecessor of task A"},
+		{name: "undeclared predecessor", tasks: []taskGraphTask{task("A", "OTHER")}, err: "predecessor OTHER of task A is not declared in the task graph"},
+		{name: "cycle", tasks: []taskGraphTask{task("A", "ROOT"), task("B", "A", "D"), task("C", "B"), task("D", "C")}, err: "task graph contains a cycle between tasks: B, C, D"},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := taskGraphOrder(root, tc.tasks, tc.finalizer)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -31,8 +32,18 @@ var userSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
 		Sensitive:   true,
-		Description: externalChangesNotDetectedFieldDescription("Password for the user. **WARNING:** this will put the password in the terraform state file. Use carefully."),
+		Description: externalChangesNotDetectedFieldDescription("Password for the user. **WARNING:** this will put the password in the terraform state file. Use carefully. Consider using `password_wo` instead."),
 	},
+	"password_wo": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		WriteOnly:     true,
+		Sensitive:     true,
+		ConflictsWith: []string{"password"},
+		Description:   externalChangesNotDetectedFieldDescription(writeOnlyFieldDescription("Password for the user.", "password_wo_version")),
+	},
+	"password_wo_version": writeOnlyVersionSchema("password_wo"),
+	"password_wo_hash":    writeOnlyHashSchema("password_wo"),
 	"login_name": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -178,6 +189,7 @@ var userSchema = map[string]*schema.Schema{
 			Schema: schemas.ShowUserParametersSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -193,10 +205,14 @@ func User() *schema.Resource {
 
 		Schema: collections.MergeMaps(userSchema, userParametersSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.User, GetImportUserFunc(sdk.UserTypePerson)),
+			StateContext: TrackingImportWrapper(resources.User, importWithTags(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, GetImportUserFunc(sdk.UserTypePerson))),
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			preferWriteOnlyAttribute("password", "password_wo"),
+		},
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.User, customdiff.All(
+			writeOnlyValueChangedCustomDiff("password_wo"),
 			ComputedIfAnyAttributeChanged(userSchema, ShowOutputAttributeName, userExternalChangesAttributes...),
 			ComputedIfAnyAttributeChanged(userParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllUserParameters), strings.ToLower)...),
 			ComputedIfAnyAttributeChanged(userSchema, FullyQualifiedNameAttributeName, "name"),
@@ -226,11 +242,12 @@ func ServiceUser() *schema.Resource {
 
 		Schema: collections.MergeMaps(serviceUserSchema, userParametersSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.ServiceUser, GetImportUserFunc(sdk.UserTypeService)),
+			StateContext: TrackingImportWrapper(resources.ServiceUser, importWithTags(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, GetImportUserFunc(sdk.UserTypeService))),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.ServiceUser, customdiff.All(
 			ComputedIfAnyAttributeChanged(userSchema, ShowOutputAttributeName, serviceUserExternalChangesAttributes...),
+			ComputedIfAnyAttributeChanged(serviceUserSchema, DescribeOutputAttributeName, slices.Concat(serviceUserExternalChangesAttributes, []string{"workload_identity"})...),
 			ComputedIfAnyAttributeChanged(userParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllUserParameters), strings.ToLower)...),
 			ComputedIfAnyAttributeChanged(userSchema, FullyQualifiedNameAttributeName, "name"),
 			userParametersCustomDiff,
@@ -249,10 +266,14 @@ func LegacyServiceUser() *schema.Resource {
 
 		Schema: collections.MergeMaps(legacyServiceUserSchema, userParametersSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.LegacyServiceUser, GetImportUserFunc(sdk.UserTypeLegacyService)),
+			StateContext: TrackingImportWrapper(resources.LegacyServiceUser, importWithTags(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, GetImportUserFunc(sdk.UserTypeLegacyService))),
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			preferWriteOnlyAttribute("password", "password_wo"),
+		},
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.LegacyServiceUser, customdiff.All(
+			writeOnlyValueChangedCustomDiff("password_wo"),
 			ComputedIfAnyAttributeChanged(userSchema, ShowOutputAttributeName, legacyServiceUserExternalChangesAttributes...),
 			ComputedIfAnyAttributeChanged(userParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllUserParameters), strings.ToLower)...),
 			ComputedIfAnyAttributeChanged(userSchema, FullyQualifiedNameAttributeName, "name"),
@@ -360,6 +381,7 @@ func GetCreateUserFunc(userType sdk.UserType) func(ctx context.Context, d *schem
 		case sdk.UserTypePerson:
 			userTypeSpecificFieldsErrs = errors.Join(
 				stringAttributeCreate(d, "password", &opts.ObjectProperties.Password),
+				writeOnlyStringAttributeCreate(d, "password_wo", &opts.ObjectProperties.Password),
 				stringAttributeCreate(d, "first_name", &opts.ObjectProperties.FirstName),
 				stringAttributeCreate(d, "middle_name", &opts.ObjectProperties.MiddleName),
 				stringAttributeCreate(d, "last_name", &opts.ObjectProperties.LastName),
@@ -369,11 +391,15 @@ func GetCreateUserFunc(userType sdk.UserType) func(ctx context.Context, d *schem
 		case sdk.UserTypeLegacyService:
 			userTypeSpecificFieldsErrs = errors.Join(
 				stringAttributeCreate(d, "password", &opts.ObjectProperties.Password),
+				writeOnlyStringAttributeCreate(d, "password_wo", &opts.ObjectProperties.Password),
 				booleanStringAttributeCreate(d, "must_change_password", &opts.ObjectProperties.MustChangePassword),
 			)
 			opts.ObjectProperties.Type = sdk.Pointer(sdk.UserTypeLegacyService)
 		case sdk.UserTypeService:
 			opts.ObjectProperties.Type = sdk.Pointer(sdk.UserTypeService)
+			workloadIdentity, err := getWorkloadIdentity(d)
+			userTypeSpecificFieldsErrs = err
+			opts.ObjectProperties.WorkloadIdentity = workloadIdentity
 		}
 		if userTypeSpecificFieldsErrs != nil {
 			return diag.FromErr(userTypeSpecificFieldsErrs)
@@ -382,13 +408,24 @@ func GetCreateUserFunc(userType sdk.UserType) func(ctx context.Context, d *schem
 		if parametersCreateDiags := handleUserParametersCreate(d, opts); len(parametersCreateDiags) > 0 {
 			return parametersCreateDiags
 		}
+		tags, err := getTagsForCreate(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		opts.Tags = tags
 
-		err := client.Users.Create(ctx, id, opts)
+		err = client.Users.Create(ctx, id, opts)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 		d.SetId(helpers.EncodeResourceIdentifier(id))
 
+		if userType != sdk.UserTypeService {
+			if err := setWriteOnlyHash(d, "password_wo"); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
 		var diags diag.Diagnostics
 		if userType == sdk.UserTypePerson {
 			// disable mfa cannot be set in create, we need to alter if set in config
@@ -459,6 +496,19 @@ func GetReadUserFunc(userType sdk.UserType, withExternalChangesMarking bool) sch
 			return diag.FromErr(err)
 		}
 
+		if userType == sdk.UserTypeService {
+			workloadIdentityMethods, err := client.Users.ShowWorkloadIdentityAuthenticationMethods(ctx, id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := errors.Join(
+				handleWorkloadIdentityRead(d, workloadIdentityMethods),
+				d.Set(DescribeOutputAttributeName, schemas.ServiceUserDescriptionToSchema(*userDetails, workloadIdentityMethods)),
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
 		if withExternalChangesMarking {
 			showMappings := []outputMapping{
 				{"login_name", "login_name", u.LoginName, u.LoginName, nil},
@@ -525,6 +575,7 @@ func GetReadUserFunc(userType sdk.UserType, withExternalChangesMarking bool) sch
 			}(d, userDetails),
 
 			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			handleTagsRead(ctx, client, d, id, sdk.ObjectTypeUser),
 			handleUserParameterRead(d, userParameters),
 			d.Set(ShowOutputAttributeName, []map[string]any{schemas.UserToSchema(u)}),
 			d.Set(ParametersAttributeName, []map[string]any{schemas.UserParametersToSchema(userParameters)}),
@@ -559,6 +610,10 @@ func GetUpdateUserFunc(userType sdk.UserType) func(ctx context.Context, d *schem
 			id = newID
 		}
 
+		if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeUser); err != nil {
+			return diag.FromErr(err)
+		}
+
 		setObjectProperties := sdk.UserAlterObjectProperties{}
 		unsetObjectProperties := sdk.UserObjectPropertiesUnset{}
 		errs := errors.Join(
@@ -618,6 +673,10 @@ func GetUpdateUserFunc(userType sdk.UserType) func(ctx context.Context, d *schem
 			userTypeSpecificFieldsErrs = errors.Join(
 				booleanStringAttributeUpdate(d, "must_change_password", &setObjectProperties.MustChangePassword, &unsetObjectProperties.MustChangePassword),
 			)
+		case sdk.UserTypeService:
+			userTypeSpecificFieldsErrs = errors.Join(
+				workloadIdentityAttributeUpdate(d, &setObjectProperties.WorkloadIdentity, &unsetObjectProperties.WorkloadIdentity),
+			)
 		}
 		if userTypeSpecificFieldsErrs != nil {
 			return diag.FromErr(userTypeSpecificFieldsErrs)
@@ -688,7 +747,10 @@ func handlePasswordUpdate(ctx context.Context, id sdk.AccountObjectIdentifier, u
 	if userType == sdk.UserTypePerson || userType == sdk.UserTypeLegacyService {
 		setPassword := sdk.UserAlterObjectProperties{}
 		unsetPassword := sdk.UserObjectPropertiesUnset{}
-		if err := stringAttributeUpdate(d, "password", &setPassword.Password, &unsetPassword.Password); err != nil {
+		if err := errors.Join(
+			stringAttributeUpdate(d, "password", &setPassword.Password, &unsetPassword.Password),
+			writeOnlyStringAttributeUpdate(d, "password_wo", "password_wo_version", &setPassword.Password, &unsetPassword.Password),
+		); err != nil {
 			return err
 		}
 		if (setPassword != sdk.UserAlterObjectProperties{}) {
@@ -1,13 +1,21 @@
 package resources
 
 import (
+	"encoding/json"
+	"fmt"
 	"slices"
+	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var serviceUserNotApplicableAttributes = []string{
 	"password",
+	"password_wo",
+	"password_wo_version",
+	"password_wo_hash",
 	"first_name",
 	"middle_name",
 	"last_name",
@@ -26,6 +34,8 @@ var legacyServiceUserNotApplicableAttributes = []string{
 
 var userExternalChangesAttributes = []string{
 	"password",
+	"password_wo_version",
+	"password_wo_hash",
 	"login_name",
 	"display_name",
 	"first_name",
@@ -46,6 +56,115 @@ var userExternalChangesAttributes = []string{
 	"disable_mfa",
 }
 
+var workloadIdentityTypes = []string{
+	"workload_identity.0.aws",
+	"workload_identity.0.gcp",
+	"workload_identity.0.azure",
+	"workload_identity.0.oidc",
+}
+
+// serviceUserOnlySchema contains attributes applicable only to the service users.
+var serviceUserOnlySchema = map[string]*schema.Schema{
+	"workload_identity": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies the [workload identity federation](https://docs.snowflake.com/en/user-guide/workload-identity-federation) configuration, allowing the service user to authenticate without secrets. External changes to the type of the workload identity, or its removal, are detected through `describe_output`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"aws": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: workloadIdentityTypes,
+					Description:  "Configures the AWS workload identity (TYPE = AWS).",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"arn": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the ARN of the AWS IAM user or role that is allowed to authenticate as the service user.",
+							},
+						},
+					},
+				},
+				"gcp": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: workloadIdentityTypes,
+					Description:  "Configures the Google Cloud workload identity (TYPE = GCP).",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"subject": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the unique ID of the Google Cloud service account that is allowed to authenticate as the service user.",
+							},
+						},
+					},
+				},
+				"azure": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: workloadIdentityTypes,
+					Description:  "Configures the Microsoft Azure workload identity (TYPE = AZURE).",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"issuer": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the Microsoft Entra ID authorization server, e.g. `https://login.microsoftonline.com/<tenant_id>/v2.0`.",
+							},
+							"subject": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the object (principal) ID of the managed identity or application that is allowed to authenticate as the service user.",
+							},
+						},
+					},
+				},
+				"oidc": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: workloadIdentityTypes,
+					Description:  "Configures the generic OpenID Connect workload identity (TYPE = OIDC).",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"issuer": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the URL of the OpenID Connect issuer.",
+							},
+							"subject": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the subject of the tokens that are allowed to authenticate as the service user.",
+							},
+							"oidc_audience_list": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Specifies the allowed values of the `aud` claim of the token. If not set, Snowflake expects `snowflakecomputing.com`.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE USER` for the given user, together with the result of `SHOW USER WORKLOAD IDENTITY AUTHENTICATION METHODS`.",
+		Elem: &schema.Resource{
+			Schema: schemas.ServiceUserDescribeSchema,
+		},
+	},
+}
+
 var (
 	serviceUserSchema       = make(map[string]*schema.Schema)
 	legacyServiceUserSchema = make(map[string]*schema.Schema)
@@ -63,6 +182,9 @@ func init() {
 			legacyServiceUserSchema[k] = v
 		}
 	}
+	for k, v := range serviceUserOnlySchema {
+		serviceUserSchema[k] = v
+	}
 	for _, attr := range userExternalChangesAttributes {
 		if !slices.Contains(serviceUserNotApplicableAttributes, attr) {
 			serviceUserExternalChangesAttributes = append(serviceUserExternalChangesAttributes, attr)
@@ -72,3 +194,121 @@ func init() {
 		}
 	}
 }
+
+func getWorkloadIdentity(d *schema.ResourceData) (*sdk.UserWorkloadIdentity, error) {
+	workloadIdentityConfig, ok := d.GetOk("workload_identity")
+	if !ok || len(workloadIdentityConfig.([]any)) == 0 || workloadIdentityConfig.([]any)[0] == nil {
+		return nil, nil
+	}
+	workloadIdentity := workloadIdentityConfig.([]any)[0].(map[string]any)
+	switch {
+	case len(workloadIdentity["aws"].([]any)) > 0:
+		aws := workloadIdentity["aws"].([]any)[0].(map[string]any)
+		return &sdk.UserWorkloadIdentity{Aws: &sdk.UserWorkloadIdentityAws{Arn: aws["arn"].(string)}}, nil
+	case len(workloadIdentity["gcp"].([]any)) > 0:
+		gcp := workloadIdentity["gcp"].([]any)[0].(map[string]any)
+		return &sdk.UserWorkloadIdentity{Gcp: &sdk.UserWorkloadIdentityGcp{Subject: gcp["subject"].(string)}}, nil
+	case len(workloadIdentity["azure"].([]any)) > 0:
+		azure := workloadIdentity["azure"].([]any)[0].(map[string]any)
+		return &sdk.UserWorkloadIdentity{Azure: &sdk.UserWorkloadIdentityAzure{Issuer: azure["issuer"].(string), Subject: azure["subject"].(string)}}, nil
+	case len(workloadIdentity["oidc"].([]any)) > 0:
+		oidc := workloadIdentity["oidc"].([]any)[0].(map[string]any)
+		oidcWorkloadIdentity := &sdk.UserWorkloadIdentityOidc{Issuer: oidc["issuer"].(string), Subject: oidc["subject"].(string)}
+		for _, audience := range expandStringList(oidc["oidc_audience_list"].(*schema.Set).List()) {
+			oidcWorkloadIdentity.OidcAudienceList = append(oidcWorkloadIdentity.OidcAudienceList, sdk.StringListItemWrapper{Value: audience})
+		}
+		return &sdk.UserWorkloadIdentity{Oidc: oidcWorkloadIdentity}, nil
+	default:
+		return nil, fmt.Errorf("exactly one of %v must be set in workload_identity", workloadIdentityTypes)
+	}
+}
+
+func workloadIdentityAttributeUpdate(d *schema.ResourceData, setField **sdk.UserWorkloadIdentity, unsetField **bool) error {
+	if !d.HasChange("workload_identity") {
+		return nil
+	}
+	workloadIdentity, err := getWorkloadIdentity(d)
+	if err != nil {
+		return err
+	}
+	if workloadIdentity != nil {
+		*setField = workloadIdentity
+	} else {
+		*unsetField = sdk.Bool(true)
+	}
+	return nil
+}
+
+// handleWorkloadIdentityRead removes the workload_identity from the state when it was changed externally, so the difference is shown in the plan.
+// Snowflake returns the workload identity details in the additional_info column, so all the configured values (e.g. the AWS ARN, or the OIDC issuer and subject) are compared with it.
+func handleWorkloadIdentityRead(d *schema.ResourceData, methods []sdk.UserWorkloadIdentityAuthenticationMethod) error {
+	workloadIdentity, err := getWorkloadIdentity(d)
+	if err != nil || workloadIdentity == nil {
+		return err
+	}
+	if !slices.ContainsFunc(methods, func(method sdk.UserWorkloadIdentityAuthenticationMethod) bool {
+		return workloadIdentityMatchesMethod(workloadIdentity, method)
+	}) {
+		return d.Set("workload_identity", nil)
+	}
+	return nil
+}
+
+func workloadIdentityMatchesMethod(workloadIdentity *sdk.UserWorkloadIdentity, method sdk.UserWorkloadIdentityAuthenticationMethod) bool {
+	var expectedType string
+	var expectedValues []string
+	switch {
+	case workloadIdentity.Aws != nil:
+		expectedType = "AWS"
+		expectedValues = []string{workloadIdentity.Aws.Arn}
+	case workloadIdentity.Gcp != nil:
+		expectedType = "GCP"
+		expectedValues = []string{workloadIdentity.Gcp.Subject}
+	case workloadIdentity.Azure != nil:
+		expectedType = "AZURE"
+		expectedValues = []string{workloadIdentity.Azure.Issuer, workloadIdentity.Azure.Subject}
+	case workloadIdentity.Oidc != nil:
+		expectedType = "OIDC"
+		expectedValues = []string{workloadIdentity.Oidc.Issuer, workloadIdentity.Oidc.Subject}
+		for _, audience := range workloadIdentity.Oidc.OidcAudienceList {
+			expectedValues = append(expectedValues, audience.Value)
+		}
+	}
+	if !strings.EqualFold(method.Type, expectedType) {
+		return false
+	}
+	additionalInfoValues, isJson := workloadIdentityAdditionalInfoValues(method.AdditionalInfo)
+	return !slices.ContainsFunc(expectedValues, func(expectedValue string) bool {
+		if isJson {
+			return !slices.Contains(additionalInfoValues, expectedValue)
+		}
+		return !strings.Contains(method.AdditionalInfo, expectedValue)
+	})
+}
+
+// workloadIdentityAdditionalInfoValues returns all the string values from the additional_info column (it holds a JSON object).
+// The second returned value is false when the column is not a valid JSON.
+func workloadIdentityAdditionalInfoValues(additionalInfo string) ([]string, bool) {
+	var parsed any
+	if err := json.Unmarshal([]byte(additionalInfo), &parsed); err != nil {
+		return nil, false
+	}
+	values := make([]string, 0)
+	var collect func(value any)
+	collect = func(value any) {
+		switch v := value.(type) {
+		case string:
+			values = append(values, v)
+		case []any:
+			for _, item := range v {
+				collect(item)
+			}
+		case map[string]any:
+			for _, item := range v {
+				collect(item)
+			}
+		}
+	}
+	collect(parsed)
+	return values, true
+}
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_workloadIdentityMatchesMethod(t *testing.T) {
+	oidc := &sdk.UserWorkloadIdentity{Oidc: &sdk.UserWorkloadIdentityOidc{
+		Issuer:           "https://token.actions.githubusercontent.com",
+		Subject:          "repo:org/repo:ref:refs/heads/main",
+		OidcAudienceList: []sdk.StringListItemWrapper{{Value: "snowflakecomputing.com"}},
+	}}
+	aws := &sdk.UserWorkloadIdentity{Aws: &sdk.UserWorkloadIdentityAws{Arn: "arn:aws:iam::123456789012:role/role"}}
+	gcp := &sdk.UserWorkloadIdentity{Gcp: &sdk.UserWorkloadIdentityGcp{Subject: "123"}}
+	azure := &sdk.UserWorkloadIdentity{Azure: &sdk.UserWorkloadIdentityAzure{Issuer: "https://login.microsoftonline.com/tenant/v2.0", Subject: "subject"}}
+
+	testCases := []struct {
+		name             string
+		workloadIdentity *sdk.UserWorkloadIdentity
+		method           sdk.UserWorkloadIdentityAuthenticationMethod
+		expected         bool
+	}{
+		{
+			name:             "oidc matching",
+			workloadIdentity: oidc,
+			method:           sdk.UserWorkloadIdentityAuthenticationMethod{Type: "OIDC", AdditionalInfo: `{"issuer":"https://token.actions.githubusercontent.com","subject":"repo:org/repo:ref:refs/heads/main","audienceList":["snowflakecomputing.com"]}`},
+			expected:         true,
+		},
+		{
+			name:             "oidc with changed subject",
+			workloadIdentity: oidc,
+			method:           sdk.UserWorkloadIdentityAuthenticationMethod{Type: "OIDC", AdditionalInfo: `{"issuer":"https://token.actions.githubusercontent.com","subject":"repo:org/repo:ref:refs/heads/other","audienceList":["snowflakecomputing.com"]}`},
+			expected:         false,
+		},
+		{
+			name:             "oidc with changed audience",
+			workloadIdentity: oidc,
+			method:           sdk.UserWorkloadIdentityAuthenticationMethod{Type: "OIDC", AdditionalInfo: `{"issuer":"https://token.actions.githubusercontent.com","subject":"repo:org/repo:ref:refs/heads/main","audienceList":["other"]}`},
+			expected:         false,
+		},
+		{
+			name:             "aws matching",
+			workloadIdentity: aws,
+			method:           sdk.UserWorkloadIdentityAuthenticationMethod{Type: "AWS", AdditionalInfo: `{"iamRole":"arn:aws:iam::123456789012:role/role","accountId":"123456789012"}`},
+			expected:         true,
+		},
+		{
+			name:             "aws with changed arn",
+			workloadIdentity: aws,
+			method:           sdk.UserWorkloadIdentityAuthenticationMethod{Type: "AWS", AdditionalInfo: `{"iamRole":"arn:aws:iam::123456789012:role/other"}`},
+			expected:         false,
+		},
+		{
+			name:             "gcp with subject being only a part of the returned value",
+			workloadIdentity: gcp,
+			method:           sdk.UserWorkloadIdentityAuthenticationMethod{Type: "GCP", AdditionalInfo: `{"subject":"1234"}`},
+			expected:         false,
+		},
+		{
+			name:             "azure matching",
+			workloadIdentity: azure,
+			method:           sdk.UserWorkloadIdentityAuthenticationMethod{Type: "AZURE", AdditionalInfo: `{"issuer":"https://login.microsoftonline.com/tenant/v2.0","subject":"subject"}`},
+			expected:         true,
+		},
+		{
+			name:             "changed type",
+			workloadIdentity: gcp,
+			method:           sdk.UserWorkloadIdentityAuthenticationMethod{Type: "AWS", AdditionalInfo: `{"subject":"123"}`},
+			expected:         false,
+		},
+		{
+			name:             "not a json - matching",
+			workloadIdentity: gcp,
+			method:           sdk.UserWorkloadIdentityAuthenticationMethod{Type: "GCP", AdditionalInfo: `subject: 123`},
+			expected:         true,
+		},
+		{
+			name:             "not a json - changed",
+			workloadIdentity: gcp,
+			method:           sdk.UserWorkloadIdentityAuthenticationMethod{Type: "GCP", AdditionalInfo: `subject: 456`},
+			expected:         false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, workloadIdentityMatchesMethod(tc.workloadIdentity, tc.method))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
@@ -80,6 +81,16 @@ func ReadUserPasswordPolicyAttachment(ctx context.Context, d *schema.ResourceDat
 	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[0])
 	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(userName, sdk.PolicyEntityDomainUser))
 	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to get user policies. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("User id: %s, Err: %s", userName.Name(), err),
+				},
+			}
+		}
 		return diag.FromErr(err)
 	}
 
@@ -98,7 +109,13 @@ func ReadUserPasswordPolicyAttachment(ctx context.Context, d *schema.ResourceDat
 	// Note: this means the resource has been deleted outside of Terraform.
 	if len(passwordPolicyReferences) == 0 {
 		d.SetId("")
-		return nil
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to find user's password policy. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("User id: %s", userName.Name()),
+			},
+		}
 	}
 
 	if err := d.Set("user_name", userName.Name()); err != nil {
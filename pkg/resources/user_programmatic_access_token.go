@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
@@ -64,7 +65,7 @@ var userProgrammaticAccessTokenSchema = map[string]*schema.Schema{
 	"expire_rotated_token_after_hours": {
 		Type:             schema.TypeInt,
 		Optional:         true,
-		Description:      "This field is only used when the token is rotated by changing the `keeper` field. Sets the expiration time of the existing token secret to expire after the specified number of hours. You can set this to a value of 0 to expire the current token secret immediately.",
+		Description:      "This field is only used when the token is rotated by changing the `keeper` field or because of `rotate_before_expiry_days`. Sets the expiration time of the existing token secret to expire after the specified number of hours. You can set this to a value of 0 to expire the current token secret immediately.",
 		Default:          IntDefault,
 		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 	},
@@ -73,6 +74,12 @@ var userProgrammaticAccessTokenSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Arbitrary string that, if and only if, changed from a non-empty to a different non-empty value (or known after apply), will trigger a key to be rotated. When you add this field to the configuration, or remove it from the configuration, the rotation is not triggered. When the token is rotated, the `token` and `rotated_token_name` fields are marked as computed.",
 	},
+	"rotate_before_expiry_days": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Description:      "Number of days before the token expiration (`expires_at` in `show_output`) in which the token is rotated. When the token enters this renewal window, the plan shows the rotation (the `token` and `rotated_token_name` fields are marked as computed), and the token is rotated on apply. The rotated token gets a new expiration time, and the previous token secret stays valid for `expire_rotated_token_after_hours`. Note that the rotation happens only when Terraform is run, so run it more often than the renewal window.",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -113,19 +120,20 @@ func UserProgrammaticAccessToken() *schema.Resource {
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.UserProgrammaticAccessToken, customdiff.All(
 			ComputedIfAnyAttributeChanged(userProgrammaticAccessTokenSchema, ShowOutputAttributeName, "disabled", "mins_to_bypass_network_policy_requirement", "comment"),
-			func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+			func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+				expiresAt, err := userProgrammaticAccessTokenExpiresAt(ctx, diff.Id(), diff.Get("rotate_before_expiry_days").(int), meta)
+				if err != nil {
+					return err
+				}
 				o, n := diff.GetChange("keeper")
-				// If the key is being rotated, mark the `token` and `rotated_token_name` as computed to inform that these values will change.
-				if shouldRotateToken(o.(string), n.(string), diff.GetRawPlan().AsValueMap()["keeper"].IsKnown()) {
-					errs := errors.Join(
+				// If the token is being rotated, mark the `token` and `rotated_token_name` as computed to inform that these values will change.
+				if shouldRotateUserProgrammaticAccessToken(o.(string), n.(string), diff.GetRawPlan().AsValueMap()["keeper"].IsKnown(), expiresAt, diff.Get("rotate_before_expiry_days").(int), time.Now()) {
+					return errors.Join(
 						diff.SetNewComputed("token"),
 						diff.SetNewComputed("rotated_token_name"),
+						diff.SetNewComputed(ShowOutputAttributeName),
 					)
-					if errs != nil {
-						return errs
-					}
 				}
-
 				return nil
 			},
 		),
@@ -146,6 +154,41 @@ func shouldRotateToken(old, new string, isKnown bool) bool {
 	return old != "" && (new != "" && old != new || !isKnown)
 }
 
+// shouldRotateTokenBeforeExpiry checks if the token expires within rotateBeforeExpiryDays.
+// The token is not rotated when the rotation is disabled (rotateBeforeExpiryDays is 0) or the expiration time is unknown.
+func shouldRotateTokenBeforeExpiry(expiresAt time.Time, rotateBeforeExpiryDays int, now time.Time) bool {
+	if rotateBeforeExpiryDays <= 0 || expiresAt.IsZero() {
+		return false
+	}
+	return !now.Before(expiresAt.Add(-time.Duration(rotateBeforeExpiryDays) * 24 * time.Hour))
+}
+
+// shouldRotateUserProgrammaticAccessToken decides if the token should be rotated because of the keeper change or the renewal window.
+// It is used both in the plan and in the update.
+func shouldRotateUserProgrammaticAccessToken(oldKeeper, newKeeper string, isKeeperKnown bool, expiresAt time.Time, rotateBeforeExpiryDays int, now time.Time) bool {
+	return shouldRotateToken(oldKeeper, newKeeper, isKeeperKnown) || shouldRotateTokenBeforeExpiry(expiresAt, rotateBeforeExpiryDays, now)
+}
+
+// userProgrammaticAccessTokenExpiresAt returns the expiration time of the existing token. It is queried only when rotate_before_expiry_days is set;
+// otherwise, or when the token does not exist, the zero time is returned.
+func userProgrammaticAccessTokenExpiresAt(ctx context.Context, id string, rotateBeforeExpiryDays int, meta any) (time.Time, error) {
+	if id == "" || rotateBeforeExpiryDays <= 0 {
+		return time.Time{}, nil
+	}
+	resourceId, err := parseUserProgrammaticAccessTokenId(id)
+	if err != nil {
+		return time.Time{}, err
+	}
+	token, err := meta.(*provider.Context).Client.Users.ShowProgrammaticAccessTokenByNameSafely(ctx, resourceId.userName, resourceId.tokenName)
+	if err != nil {
+		if errors.Is(err, sdk.ErrPatNotFound) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	return token.ExpiresAt, nil
+}
+
 func ImportUserProgrammaticAccessToken(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	client := meta.(*provider.Context).Client
 	id, err := userProgrammaticAccessTokenIdFromData(d)
@@ -314,8 +357,15 @@ func UpdateUserProgrammaticAccessToken(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
-	o, n := d.GetChange("keeper")
-	if shouldRotateToken(o.(string), n.(string), d.GetRawPlan().AsValueMap()["keeper"].IsKnown()) {
+	expiresAt, err := userProgrammaticAccessTokenExpiresAt(ctx, d.Id(), d.Get("rotate_before_expiry_days").(int), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	oldKeeper, newKeeper := d.GetChange("keeper")
+	// The same decision as in CustomizeDiff. The token has to be planned as unknown as well, because the renewal window
+	// may be entered after the plan was made (e.g. for a saved plan), and rotating an unplanned token would produce an inconsistent result.
+	if shouldRotateUserProgrammaticAccessToken(oldKeeper.(string), newKeeper.(string), d.GetRawPlan().GetAttr("keeper").IsKnown(), expiresAt, d.Get("rotate_before_expiry_days").(int), time.Now()) &&
+		!d.GetRawPlan().GetAttr("token").IsKnown() {
 		request := sdk.NewRotateUserProgrammaticAccessTokenRequest(resourceId.userName, resourceId.tokenName)
 		if v := d.Get("expire_rotated_token_after_hours").(int); v != IntDefault {
 			request.WithExpireRotatedTokenAfterHours(v)
@@ -363,9 +413,13 @@ func (id *userProgrammaticAccessTokenId) String() string {
 }
 
 func userProgrammaticAccessTokenIdFromData(d *schema.ResourceData) (userProgrammaticAccessTokenId, error) {
-	idRaw := helpers.ParseResourceIdentifier(d.Id())
+	return parseUserProgrammaticAccessTokenId(d.Id())
+}
+
+func parseUserProgrammaticAccessTokenId(id string) (userProgrammaticAccessTokenId, error) {
+	idRaw := helpers.ParseResourceIdentifier(id)
 	if len(idRaw) != 2 {
-		return userProgrammaticAccessTokenId{}, fmt.Errorf("invalid resource id: %s", d.Id())
+		return userProgrammaticAccessTokenId{}, fmt.Errorf("invalid resource id: %s", id)
 	}
 	return userProgrammaticAccessTokenId{
 		userName:  sdk.NewAccountObjectIdentifier(idRaw[0]),
@@ -1,9 +1,12 @@
 package resources
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestShouldRotateToken(t *testing.T) {
@@ -101,3 +104,89 @@ func TestShouldRotateToken(t *testing.T) {
 		})
 	}
 }
+
+func TestShouldRotateTokenBeforeExpiry(t *testing.T) {
+	expiresAt := time.Date(2025, 7, 22, 12, 0, 0, 0, time.FixedZone("", -7*60*60))
+
+	tests := []struct {
+		name                   string
+		expiresAt              time.Time
+		rotateBeforeExpiryDays int
+		now                    time.Time
+		expected               bool
+	}{
+		{
+			name:                   "rotation disabled",
+			expiresAt:              expiresAt,
+			rotateBeforeExpiryDays: 0,
+			now:                    expiresAt,
+			expected:               false,
+		},
+		{
+			name:                   "before the renewal window",
+			expiresAt:              expiresAt,
+			rotateBeforeExpiryDays: 7,
+			now:                    expiresAt.Add(-8 * 24 * time.Hour),
+			expected:               false,
+		},
+		{
+			name:                   "at the start of the renewal window",
+			expiresAt:              expiresAt,
+			rotateBeforeExpiryDays: 7,
+			now:                    expiresAt.Add(-7 * 24 * time.Hour),
+			expected:               true,
+		},
+		{
+			name:                   "inside the renewal window, different time zone",
+			expiresAt:              expiresAt.UTC(),
+			rotateBeforeExpiryDays: 7,
+			now:                    expiresAt.Add(-time.Hour),
+			expected:               true,
+		},
+		{
+			name:                   "after the expiration",
+			expiresAt:              expiresAt,
+			rotateBeforeExpiryDays: 1,
+			now:                    expiresAt.Add(time.Hour),
+			expected:               true,
+		},
+		{
+			name:                   "unknown expiration time",
+			expiresAt:              time.Time{},
+			rotateBeforeExpiryDays: 7,
+			now:                    expiresAt,
+			expected:               false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := shouldRotateTokenBeforeExpiry(tt.expiresAt, tt.rotateBeforeExpiryDays, tt.now)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestShouldRotateUserProgrammaticAccessToken(t *testing.T) {
+	now := time.Date(2025, 7, 22, 12, 0, 0, 0, time.UTC)
+	farExpiresAt := now.Add(30 * 24 * time.Hour)
+	closeExpiresAt := now.Add(24 * time.Hour)
+
+	assert.False(t, shouldRotateUserProgrammaticAccessToken("a", "a", true, farExpiresAt, 7, now))
+	assert.True(t, shouldRotateUserProgrammaticAccessToken("a", "b", true, farExpiresAt, 7, now))
+	assert.True(t, shouldRotateUserProgrammaticAccessToken("a", "", false, farExpiresAt, 7, now))
+	assert.False(t, shouldRotateUserProgrammaticAccessToken("", "b", true, farExpiresAt, 7, now))
+	assert.True(t, shouldRotateUserProgrammaticAccessToken("a", "a", true, closeExpiresAt, 7, now))
+	assert.False(t, shouldRotateUserProgrammaticAccessToken("a", "a", true, closeExpiresAt, 0, now))
+}
+
+func TestUserProgrammaticAccessTokenExpiresAt_notQueried(t *testing.T) {
+	// without rotate_before_expiry_days or for a new token, the client (nil meta here) is not used
+	expiresAt, err := userProgrammaticAccessTokenExpiresAt(context.Background(), `"user"|"token"`, 0, nil)
+	require.NoError(t, err)
+	assert.True(t, expiresAt.IsZero())
+
+	expiresAt, err = userProgrammaticAccessTokenExpiresAt(context.Background(), "", 7, nil)
+	require.NoError(t, err)
+	assert.True(t, expiresAt.IsZero())
+}
@@ -0,0 +1,533 @@
+package resources
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/youmark/pkcs8"
+)
+
+const (
+	userRsaPublicKeySlot  = "RSA_PUBLIC_KEY"
+	userRsaPublicKey2Slot = "RSA_PUBLIC_KEY_2"
+)
+
+var userRsaKeyPairRotatedAttributes = []string{
+	"active_slot",
+	"public_key",
+	"public_key_fingerprint",
+	"previous_public_key_fingerprint",
+	"rotated_on",
+}
+
+var userRsaKeyPairSchema = map[string]*schema.Schema{
+	"user": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Name of the user the key pair is assigned to."),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"private_key_file": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "Path of the file the generated private key is written to (in the PEM format, PKCS#8, with the `0600` permissions), on the machine running Terraform. The directory has to exist. The file is replaced on every rotation, only after the new public key is assigned to the user. The private key is never stored in the plan or state. Changing this field rotates the key pair.",
+	},
+	"key_size": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      2048,
+		ValidateFunc: validation.IntInSlice([]int{2048, 3072, 4096}),
+		Description:  "Size of the generated RSA key in bits. Valid values are: 2048, 3072, and 4096. Changing this field rotates the key pair.",
+	},
+	"private_key_passphrase_wo": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		WriteOnly:   true,
+		Sensitive:   true,
+		Description: "Passphrase used to encrypt the generated private key (encrypted PKCS#8, AES-256-CBC). This is a [write-only attribute](https://developer.hashicorp.com/terraform/plugin/sdkv2/resources/write-only-arguments): its value is never persisted in the Terraform plan or state, and it requires Terraform 1.11 or later. The passphrase is used only by the provider and it is never sent to Snowflake. Changing the passphrase (which is detected with `private_key_passphrase_wo_hash`) rotates the key pair.",
+	},
+	"private_key_passphrase_wo_version": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		RequiredWith: []string{"private_key_passphrase_wo"},
+		Description:  "Version of the `private_key_passphrase_wo` value. Changes of `private_key_passphrase_wo` are detected without it; change it (e.g. increment it) to rotate the key pair with the current passphrase.",
+	},
+	"private_key_passphrase_wo_hash": writeOnlyHashSchema("private_key_passphrase_wo"),
+	"rotation_period_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Number of days after which the key pair is rotated. The rotation happens on the first `terraform apply` after the period elapses (counted from `rotated_on`); Terraform does not run in the background.",
+	},
+	"keeper": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Arbitrary string that, if and only if, changed from a non-empty to a different non-empty value (or known after apply), will trigger the key pair to be rotated. When you add this field to the configuration, or remove it from the configuration, the rotation is not triggered. Use it to rotate the key pair on demand, e.g. when the private key file was lost.",
+	},
+	"retain_previous_key": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "When true, the previous public key stays assigned to the user after the rotation, so the clients using the previous private key keep working until the next rotation replaces it. When false, the previous public key is unset right after the new one is set.",
+	},
+	"active_slot": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: fmt.Sprintf("The user property holding the current public key. It is either `%s` or `%s`.", userRsaPublicKeySlot, userRsaPublicKey2Slot),
+	},
+	"public_key": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The current public key (on one line, without the header and trailer).",
+	},
+	"public_key_fingerprint": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The fingerprint of the current public key as returned by Snowflake in `DESCRIBE USER` (`RSA_PUBLIC_KEY_FP` or `RSA_PUBLIC_KEY_2_FP`). When the key is changed outside of Terraform, the key pair is rotated.",
+	},
+	"previous_public_key_fingerprint": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The fingerprint of the previous public key, if it is still assigned to the user (see `retain_previous_key`).",
+	},
+	"rotated_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time (RFC 3339) of the last creation or rotation of the key pair.",
+	},
+}
+
+func UserRsaKeyPair() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.UserRsaKeyPairResource), TrackingCreateWrapper(resources.UserRsaKeyPair, CreateUserRsaKeyPair)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.UserRsaKeyPairResource), TrackingReadWrapper(resources.UserRsaKeyPair, ReadUserRsaKeyPair)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.UserRsaKeyPairResource), TrackingUpdateWrapper(resources.UserRsaKeyPair, UpdateUserRsaKeyPair)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.UserRsaKeyPairResource), TrackingDeleteWrapper(resources.UserRsaKeyPair, DeleteUserRsaKeyPair)),
+		Description: joinWithSpace(
+			"Resource used to generate an RSA key pair and assign its public key to a user for the [key-pair authentication](https://docs.snowflake.com/en/user-guide/key-pair-auth).",
+			"The private key is written only to the local file set in `private_key_file` (optionally encrypted with `private_key_passphrase_wo`), and it never reaches the Terraform plan or state.",
+			"The key pair is rotated using both `RSA_PUBLIC_KEY` and `RSA_PUBLIC_KEY_2` user properties: the new public key is set in the free property before the previous one is retired, so the clients can switch to the new private key without downtime.",
+			"The resource only touches the properties holding the keys it generated, and fails if both properties hold keys set outside of it.",
+			"Do not manage the same user keys with `snowflake_user_public_keys` or the `rsa_public_key` fields of the user resources; when the user is managed by Terraform, add `rsa_public_key` and `rsa_public_key_2` to `ignore_changes` of the user resource.",
+		),
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.UserRsaKeyPair, customdiff.All(
+			writeOnlyValueChangedCustomDiff("private_key_passphrase_wo"),
+			func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+				if diff.Id() == "" {
+					return nil
+				}
+				oldKeeper, newKeeper := diff.GetChange("keeper")
+				if shouldRotateUserRsaKeyPair(
+					diff.HasChanges("private_key_file", "key_size", "private_key_passphrase_wo_version", "private_key_passphrase_wo_hash"),
+					oldKeeper.(string), newKeeper.(string), diff.GetRawPlan().AsValueMap()["keeper"].IsKnown(),
+					diff.Get("rotated_on").(string), diff.Get("rotation_period_days").(int), time.Now(),
+					diff.Get("public_key").(string), diff.Get("public_key_fingerprint").(string),
+				) {
+					errs := make([]error, 0, len(userRsaKeyPairRotatedAttributes))
+					for _, key := range userRsaKeyPairRotatedAttributes {
+						errs = append(errs, diff.SetNewComputed(key))
+					}
+					return errors.Join(errs...)
+				}
+				if !diff.Get("retain_previous_key").(bool) && diff.Get("previous_public_key_fingerprint").(string) != "" {
+					return diff.SetNewComputed("previous_public_key_fingerprint")
+				}
+				return nil
+			},
+		)),
+
+		Schema:   userRsaKeyPairSchema,
+		Timeouts: defaultTimeouts,
+	}
+}
+
+// shouldRotateUserRsaKeyPair decides if the key pair should be rotated: when the fields used to generate or write the key pair change,
+// when the keeper changes, when the rotation period elapses, or when the public key was changed or unset outside of Terraform.
+// It is used both in the plan and in the update, based on the same prior state.
+func shouldRotateUserRsaKeyPair(
+	configChanged bool,
+	oldKeeper, newKeeper string,
+	isKeeperKnown bool,
+	rotatedOn string,
+	rotationPeriodDays int,
+	now time.Time,
+	publicKey string,
+	publicKeyFingerprint string,
+) bool {
+	return configChanged ||
+		shouldRotateToken(oldKeeper, newKeeper, isKeeperKnown) ||
+		userRsaKeyPairRotationPeriodElapsed(rotatedOn, rotationPeriodDays, now) ||
+		userRsaPublicKeyFingerprint(publicKey) != publicKeyFingerprint
+}
+
+// userRsaKeyPairRotationPeriodElapsed checks if rotationPeriodDays elapsed since rotatedOn. The rotation is disabled when rotationPeriodDays is 0.
+func userRsaKeyPairRotationPeriodElapsed(rotatedOn string, rotationPeriodDays int, now time.Time) bool {
+	if rotationPeriodDays <= 0 {
+		return false
+	}
+	rotatedOnTime, err := time.Parse(time.RFC3339, rotatedOn)
+	if err != nil {
+		return true
+	}
+	return !now.Before(rotatedOnTime.Add(time.Duration(rotationPeriodDays) * 24 * time.Hour))
+}
+
+type userRsaKeyPair struct {
+	privateKey string
+	publicKey  string
+}
+
+// generateUserRsaKeyPair generates a new RSA key pair. The private key is encrypted with the passphrase, if it is not empty.
+func generateUserRsaKeyPair(keySize int, passphrase string) (*userRsaKeyPair, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("generating RSA key: %w", err)
+	}
+
+	publicKeyDer, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling RSA public key: %w", err)
+	}
+
+	var privateKeyBlock *pem.Block
+	if passphrase == "" {
+		privateKeyDer, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling RSA private key: %w", err)
+		}
+		privateKeyBlock = &pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyDer}
+	} else {
+		privateKeyDer, err := pkcs8.MarshalPrivateKey(privateKey, []byte(passphrase), &pkcs8.Opts{
+			Cipher: pkcs8.AES256CBC,
+			KDFOpts: pkcs8.PBKDF2Opts{
+				SaltSize:       16,
+				IterationCount: 2048,
+				HMACHash:       crypto.SHA256,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encrypting RSA private key: %w", err)
+		}
+		privateKeyBlock = &pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: privateKeyDer}
+	}
+
+	return &userRsaKeyPair{
+		privateKey: string(pem.EncodeToMemory(privateKeyBlock)),
+		publicKey:  base64.StdEncoding.EncodeToString(publicKeyDer),
+	}, nil
+}
+
+// generateUserRsaKeyPairFromConfig generates the key pair with the key size and passphrase from the configuration.
+func generateUserRsaKeyPairFromConfig(d *schema.ResourceData) (*userRsaKeyPair, error) {
+	passphrase, _, err := getWriteOnlyString(d, "private_key_passphrase_wo")
+	if err != nil {
+		return nil, err
+	}
+	return generateUserRsaKeyPair(d.Get("key_size").(int), passphrase)
+}
+
+// writeUserRsaPrivateKeyTempFile writes the private key to a new temporary file in the directory of path (os.CreateTemp makes it readable only by the owner).
+// The file is moved to path with os.Rename after the public key is assigned to the user, so path always holds a working private key.
+func writeUserRsaPrivateKeyTempFile(path string, privateKey string) (string, error) {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("creating a temporary file for the private key: %w", err)
+	}
+	_, writeErr := file.WriteString(privateKey)
+	if err := errors.Join(writeErr, file.Close()); err != nil {
+		return "", errors.Join(fmt.Errorf("writing the private key to %s: %w", file.Name(), err), os.Remove(file.Name()))
+	}
+	return file.Name(), nil
+}
+
+// assignUserRsaKeyPair sets the public key in the given slot and moves the private key to private_key_file.
+// The private key is written before the public key is set, so a failure never leaves the user with a key nobody holds.
+func assignUserRsaKeyPair(ctx context.Context, client *sdk.Client, userId sdk.AccountObjectIdentifier, slot string, privateKeyFile string, keyPair *userRsaKeyPair) error {
+	tempFile, err := writeUserRsaPrivateKeyTempFile(privateKeyFile, keyPair.privateKey)
+	if err != nil {
+		return err
+	}
+	if err := setUserRsaPublicKeyInSlot(ctx, client, userId, slot, keyPair.publicKey); err != nil {
+		return errors.Join(fmt.Errorf("error setting %s for user %s, err = %w", slot, userId.FullyQualifiedName(), err), os.Remove(tempFile))
+	}
+	if err := os.Rename(tempFile, privateKeyFile); err != nil {
+		// the public key is useless without the private key, so it is unset to keep the slot free
+		return errors.Join(
+			fmt.Errorf("writing the private key to %s: %w", privateKeyFile, err),
+			unsetUserRsaPublicKeyInSlot(ctx, client, userId, slot),
+			os.Remove(tempFile),
+		)
+	}
+	return nil
+}
+
+// userRsaPublicKeyFingerprint returns the fingerprint of the public key in the same format as Snowflake (SHA-256 digest of the DER-encoded public key).
+func userRsaPublicKeyFingerprint(publicKey string) string {
+	publicKeyDer, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || publicKey == "" {
+		return ""
+	}
+	digest := sha256.Sum256(publicKeyDer)
+	return "SHA256:" + base64.StdEncoding.EncodeToString(digest[:])
+}
+
+func otherUserRsaPublicKeySlot(slot string) string {
+	if slot == userRsaPublicKeySlot {
+		return userRsaPublicKey2Slot
+	}
+	return userRsaPublicKeySlot
+}
+
+func userRsaPublicKeyFingerprintInSlot(userDetails *sdk.UserDetails, slot string) string {
+	property := userDetails.RsaPublicKeyFp
+	if slot == userRsaPublicKey2Slot {
+		property = userDetails.RsaPublicKey2Fp
+	}
+	if property == nil {
+		return ""
+	}
+	return property.Value
+}
+
+func setUserRsaPublicKeyInSlot(ctx context.Context, client *sdk.Client, userId sdk.AccountObjectIdentifier, slot string, publicKey string) error {
+	properties := &sdk.UserAlterObjectProperties{}
+	if slot == userRsaPublicKeySlot {
+		properties.RSAPublicKey = sdk.String(publicKey)
+	} else {
+		properties.RSAPublicKey2 = sdk.String(publicKey)
+	}
+	return client.Users.Alter(ctx, userId, &sdk.AlterUserOptions{Set: &sdk.UserSet{ObjectProperties: properties}})
+}
+
+func unsetUserRsaPublicKeyInSlot(ctx context.Context, client *sdk.Client, userId sdk.AccountObjectIdentifier, slot string) error {
+	properties := &sdk.UserObjectPropertiesUnset{}
+	if slot == userRsaPublicKeySlot {
+		properties.RSAPublicKey = sdk.Bool(true)
+	} else {
+		properties.RSAPublicKey2 = sdk.Bool(true)
+	}
+	return client.Users.Alter(ctx, userId, &sdk.AlterUserOptions{Unset: &sdk.UserUnset{ObjectProperties: properties}})
+}
+
+func setUserRsaKeyPairInState(d *schema.ResourceData, slot string, publicKey string, previousPublicKeyFingerprint string) error {
+	return errors.Join(
+		d.Set("active_slot", slot),
+		d.Set("public_key", publicKey),
+		d.Set("public_key_fingerprint", userRsaPublicKeyFingerprint(publicKey)),
+		d.Set("previous_public_key_fingerprint", previousPublicKeyFingerprint),
+		d.Set("rotated_on", time.Now().UTC().Format(time.RFC3339)),
+	)
+}
+
+func CreateUserRsaKeyPair(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	userId, err := sdk.ParseAccountObjectIdentifier(d.Get("user").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := client.Users.Describe(ctx, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var slot string
+	switch {
+	case userRsaPublicKeyFingerprintInSlot(userDetails, userRsaPublicKeySlot) == "":
+		slot = userRsaPublicKeySlot
+	case userRsaPublicKeyFingerprintInSlot(userDetails, userRsaPublicKey2Slot) == "":
+		slot = userRsaPublicKey2Slot
+	default:
+		return diag.FromErr(fmt.Errorf("both %s and %s are already set for user %s; unset one of them to let the resource manage the key pair", userRsaPublicKeySlot, userRsaPublicKey2Slot, userId.FullyQualifiedName()))
+	}
+
+	keyPair, err := generateUserRsaKeyPairFromConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := assignUserRsaKeyPair(ctx, client, userId, slot, d.Get("private_key_file").(string), keyPair); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(userId))
+
+	if err := errors.Join(
+		setUserRsaKeyPairInState(d, slot, keyPair.publicKey, ""),
+		setWriteOnlyHash(d, "private_key_passphrase_wo"),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+	return ReadUserRsaKeyPair(ctx, d, meta)
+}
+
+func ReadUserRsaKeyPair(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	userId, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := client.Users.Describe(ctx, userId)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query user. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("User id: %s, Err: %s", userId.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	slot := d.Get("active_slot").(string)
+	previousPublicKeyFingerprint := d.Get("previous_public_key_fingerprint").(string)
+	// the previous key was removed outside of Terraform, so the slot is free for the next rotation
+	if previousPublicKeyFingerprint != "" && userRsaPublicKeyFingerprintInSlot(userDetails, otherUserRsaPublicKeySlot(slot)) == "" {
+		previousPublicKeyFingerprint = ""
+	}
+
+	if errs := errors.Join(
+		d.Set("user", userId.Name()),
+		// a different value than the fingerprint of public_key means that the key was changed outside of Terraform, which triggers the rotation
+		d.Set("public_key_fingerprint", userRsaPublicKeyFingerprintInSlot(userDetails, slot)),
+		d.Set("previous_public_key_fingerprint", previousPublicKeyFingerprint),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateUserRsaKeyPair(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	userId, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	activeSlot := d.Get("active_slot").(string)
+	retainPreviousKey := d.Get("retain_previous_key").(bool)
+
+	oldKeeper, newKeeper := d.GetChange("keeper")
+	oldRotatedOn, _ := d.GetChange("rotated_on")
+	oldPublicKey, _ := d.GetChange("public_key")
+	oldFingerprint, _ := d.GetChange("public_key_fingerprint")
+	// the same decision as in CustomizeDiff, based on the prior state; the time-based decision is applied only when it was planned
+	// (the public key is unknown in the plan), so a saved plan applied after the rotation period elapses does not rotate unexpectedly
+	if shouldRotateUserRsaKeyPair(
+		d.HasChanges("private_key_file", "key_size", "private_key_passphrase_wo_version", "private_key_passphrase_wo_hash"),
+		oldKeeper.(string), newKeeper.(string), d.GetRawPlan().GetAttr("keeper").IsKnown(),
+		oldRotatedOn.(string), d.Get("rotation_period_days").(int), time.Now(),
+		oldPublicKey.(string), oldFingerprint.(string),
+	) && !d.GetRawPlan().GetAttr("public_key").IsKnown() {
+		oldSlot, _ := d.GetChange("active_slot")
+		oldPreviousFingerprint, _ := d.GetChange("previous_public_key_fingerprint")
+		activeSlot = oldSlot.(string)
+		freeSlot := otherUserRsaPublicKeySlot(activeSlot)
+
+		userDetails, err := client.Users.Describe(ctx, userId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if freeSlotFingerprint := userRsaPublicKeyFingerprintInSlot(userDetails, freeSlot); freeSlotFingerprint != "" && freeSlotFingerprint != oldPreviousFingerprint.(string) {
+			return diag.FromErr(fmt.Errorf("%s of user %s holds a key not managed by this resource; unset it to let the resource rotate the key pair", freeSlot, userId.FullyQualifiedName()))
+		}
+
+		keyPair, err := generateUserRsaKeyPairFromConfig(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// the new key is set before the previous one is retired, so there is always a valid key assigned to the user
+		if err := assignUserRsaKeyPair(ctx, client, userId, freeSlot, d.Get("private_key_file").(string), keyPair); err != nil {
+			return diag.FromErr(err)
+		}
+		// the private key file already holds the new key, so the state is updated even when retiring the previous key fails
+		if err := errors.Join(
+			setUserRsaKeyPairInState(d, freeSlot, keyPair.publicKey, oldFingerprint.(string)),
+			setWriteOnlyHash(d, "private_key_passphrase_wo"),
+		); err != nil {
+			return diag.FromErr(err)
+		}
+		if !retainPreviousKey && oldFingerprint.(string) != "" {
+			if err := unsetUserRsaPublicKeyInSlot(ctx, client, userId, activeSlot); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting %s for user %s, err = %w", activeSlot, userId.FullyQualifiedName(), err))
+			}
+			if err := d.Set("previous_public_key_fingerprint", ""); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return ReadUserRsaKeyPair(ctx, d, meta)
+	}
+
+	if oldPreviousFingerprint, _ := d.GetChange("previous_public_key_fingerprint"); !retainPreviousKey && oldPreviousFingerprint.(string) != "" {
+		if err := unsetUserRsaPublicKeyInSlot(ctx, client, userId, otherUserRsaPublicKeySlot(activeSlot)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting %s for user %s, err = %w", otherUserRsaPublicKeySlot(activeSlot), userId.FullyQualifiedName(), err))
+		}
+		if err := d.Set("previous_public_key_fingerprint", ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadUserRsaKeyPair(ctx, d, meta)
+}
+
+func DeleteUserRsaKeyPair(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	userId, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := client.Users.Describe(ctx, userId)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// only the keys generated by the resource are unset
+	activeSlot := d.Get("active_slot").(string)
+	if fingerprint := d.Get("public_key_fingerprint").(string); fingerprint != "" && userRsaPublicKeyFingerprintInSlot(userDetails, activeSlot) == fingerprint {
+		if err := unsetUserRsaPublicKeyInSlot(ctx, client, userId, activeSlot); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting %s for user %s, err = %w", activeSlot, userId.FullyQualifiedName(), err))
+		}
+	}
+	previousSlot := otherUserRsaPublicKeySlot(activeSlot)
+	if fingerprint := d.Get("previous_public_key_fingerprint").(string); fingerprint != "" && userRsaPublicKeyFingerprintInSlot(userDetails, previousSlot) == fingerprint {
+		if err := unsetUserRsaPublicKeyInSlot(ctx, client, userId, previousSlot); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting %s for user %s, err = %w", previousSlot, userId.FullyQualifiedName(), err))
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,128 @@
+package resources
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/youmark/pkcs8"
+)
+
+func Test_generateUserRsaKeyPair(t *testing.T) {
+	assertPublicKeyMatches := func(t *testing.T, keyPair *userRsaKeyPair, privateKey *rsa.PrivateKey) {
+		t.Helper()
+		publicKeyDer, err := base64.StdEncoding.DecodeString(keyPair.publicKey)
+		require.NoError(t, err)
+		publicKey, err := x509.ParsePKIXPublicKey(publicKeyDer)
+		require.NoError(t, err)
+		assert.True(t, privateKey.PublicKey.Equal(publicKey))
+	}
+
+	t.Run("without passphrase", func(t *testing.T) {
+		keyPair, err := generateUserRsaKeyPair(2048, "")
+		require.NoError(t, err)
+
+		block, _ := pem.Decode([]byte(keyPair.privateKey))
+		require.NotNil(t, block)
+		assert.Equal(t, "PRIVATE KEY", block.Type)
+		privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		require.NoError(t, err)
+		require.IsType(t, &rsa.PrivateKey{}, privateKey)
+		assert.Equal(t, 2048, privateKey.(*rsa.PrivateKey).N.BitLen())
+		assertPublicKeyMatches(t, keyPair, privateKey.(*rsa.PrivateKey))
+	})
+
+	t.Run("with passphrase", func(t *testing.T) {
+		keyPair, err := generateUserRsaKeyPair(3072, "secret passphrase")
+		require.NoError(t, err)
+
+		block, _ := pem.Decode([]byte(keyPair.privateKey))
+		require.NotNil(t, block)
+		assert.Equal(t, "ENCRYPTED PRIVATE KEY", block.Type)
+
+		_, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		require.Error(t, err)
+		_, _, err = pkcs8.ParsePrivateKey(block.Bytes, []byte("wrong passphrase"))
+		require.Error(t, err)
+
+		privateKey, err := pkcs8.ParsePKCS8PrivateKeyRSA(block.Bytes, []byte("secret passphrase"))
+		require.NoError(t, err)
+		assert.Equal(t, 3072, privateKey.N.BitLen())
+		assertPublicKeyMatches(t, keyPair, privateKey)
+	})
+}
+
+func Test_userRsaKeyPairRotationPeriodElapsed(t *testing.T) {
+	rotatedOn := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.False(t, userRsaKeyPairRotationPeriodElapsed(rotatedOn.Format(time.RFC3339), 0, rotatedOn.Add(1000*24*time.Hour)))
+	assert.False(t, userRsaKeyPairRotationPeriodElapsed(rotatedOn.Format(time.RFC3339), 30, rotatedOn.Add(29*24*time.Hour)))
+	assert.True(t, userRsaKeyPairRotationPeriodElapsed(rotatedOn.Format(time.RFC3339), 30, rotatedOn.Add(30*24*time.Hour)))
+	assert.True(t, userRsaKeyPairRotationPeriodElapsed(rotatedOn.Format(time.RFC3339), 30, rotatedOn.Add(31*24*time.Hour)))
+	assert.True(t, userRsaKeyPairRotationPeriodElapsed("", 30, rotatedOn))
+}
+
+func Test_shouldRotateUserRsaKeyPair(t *testing.T) {
+	publicKey := base64.StdEncoding.EncodeToString([]byte("hello"))
+	fingerprint := userRsaPublicKeyFingerprint(publicKey)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	rotatedOn := now.Add(-10 * 24 * time.Hour).Format(time.RFC3339)
+
+	assert.False(t, shouldRotateUserRsaKeyPair(false, "", "", true, rotatedOn, 0, now, publicKey, fingerprint))
+	assert.True(t, shouldRotateUserRsaKeyPair(true, "", "", true, rotatedOn, 0, now, publicKey, fingerprint))
+
+	// keeper
+	assert.False(t, shouldRotateUserRsaKeyPair(false, "", "a", true, rotatedOn, 0, now, publicKey, fingerprint))
+	assert.False(t, shouldRotateUserRsaKeyPair(false, "a", "", true, rotatedOn, 0, now, publicKey, fingerprint))
+	assert.True(t, shouldRotateUserRsaKeyPair(false, "a", "b", true, rotatedOn, 0, now, publicKey, fingerprint))
+	assert.True(t, shouldRotateUserRsaKeyPair(false, "a", "", false, rotatedOn, 0, now, publicKey, fingerprint))
+
+	// rotation period
+	assert.False(t, shouldRotateUserRsaKeyPair(false, "", "", true, rotatedOn, 11, now, publicKey, fingerprint))
+	assert.True(t, shouldRotateUserRsaKeyPair(false, "", "", true, rotatedOn, 10, now, publicKey, fingerprint))
+
+	// the key was changed or unset outside of Terraform
+	assert.True(t, shouldRotateUserRsaKeyPair(false, "", "", true, rotatedOn, 0, now, publicKey, "SHA256:changed externally"))
+	assert.True(t, shouldRotateUserRsaKeyPair(false, "", "", true, rotatedOn, 0, now, publicKey, ""))
+}
+
+func Test_writeUserRsaPrivateKeyTempFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rsa_key.p8")
+
+	tempFile, err := writeUserRsaPrivateKeyTempFile(path, "private key")
+	require.NoError(t, err)
+	assert.Equal(t, dir, filepath.Dir(tempFile))
+	assert.NoFileExists(t, path)
+
+	content, err := os.ReadFile(tempFile)
+	require.NoError(t, err)
+	assert.Equal(t, "private key", string(content))
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(tempFile)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	_, err = writeUserRsaPrivateKeyTempFile(filepath.Join(dir, "missing", "rsa_key.p8"), "private key")
+	require.ErrorContains(t, err, "creating a temporary file for the private key")
+}
+
+func Test_userRsaPublicKeyFingerprint(t *testing.T) {
+	assert.Empty(t, userRsaPublicKeyFingerprint(""))
+	assert.Empty(t, userRsaPublicKeyFingerprint("not base64!"))
+	assert.Equal(t, "SHA256:LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=", userRsaPublicKeyFingerprint(base64.StdEncoding.EncodeToString([]byte("hello"))))
+}
+
+func Test_otherUserRsaPublicKeySlot(t *testing.T) {
+	assert.Equal(t, userRsaPublicKey2Slot, otherUserRsaPublicKeySlot(userRsaPublicKeySlot))
+	assert.Equal(t, userRsaPublicKeySlot, otherUserRsaPublicKeySlot(userRsaPublicKey2Slot))
+}
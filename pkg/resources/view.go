@@ -278,6 +278,7 @@ var viewSchema = map[string]*schema.Schema{
 			Schema: schemas.ViewDescribeSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -303,7 +304,7 @@ func View() *schema.Resource {
 
 		Schema: viewSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.View, ImportView),
+			StateContext: TrackingImportWrapper(resources.View, importWithTags(sdk.ObjectTypeView, sdk.ParseSchemaObjectIdentifier, ImportView)),
 		},
 
 		StateUpgraders: []schema.StateUpgrader{
@@ -428,7 +429,15 @@ func CreateView(orReplace bool) schema.CreateContextFunc {
 			req.WithAggregationPolicy(*aggregationPolicyReq)
 		}
 
-		err := client.Views.Create(ctx, req)
+		tags, err := getTagsForCreate(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(tags) > 0 {
+			req.WithTag(tags)
+		}
+
+		err = client.Views.Create(ctx, req)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error creating view %v err = %w", id.Name(), err))
 		}
@@ -621,6 +630,9 @@ func ReadView(withExternalChangesMarking bool) schema.ReadContextFunc {
 		if err = d.Set("comment", view.Comment); err != nil {
 			return diag.FromErr(err)
 		}
+		if err = handleTagsRead(ctx, client, d, id, sdk.ObjectTypeView); err != nil {
+			return diag.FromErr(err)
+		}
 		if withExternalChangesMarking {
 			if err = handleExternalChangesToObjectInShow(d,
 				outputMapping{"is_secure", "is_secure", view.IsSecure, booleanStringFromBool(view.IsSecure), nil},
@@ -886,6 +898,10 @@ func UpdateView(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 		return CreateView(true)(ctx, d, meta)
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeView); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("comment") {
 		if comment := d.Get("comment").(string); comment == "" {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithUnsetComment(true))
@@ -166,6 +166,7 @@ var warehouseSchema = map[string]*schema.Schema{
 			Schema: schemas.ShowWarehouseParametersSchema,
 		},
 	},
+	tagsAttributeName:               tagsSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -224,7 +225,7 @@ func Warehouse() *schema.Resource {
 
 		Schema: warehouseSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.Warehouse, ImportWarehouse),
+			StateContext: TrackingImportWrapper(resources.Warehouse, importWithTags(sdk.ObjectTypeWarehouse, sdk.ParseAccountObjectIdentifier, ImportWarehouse)),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.Warehouse, customdiff.All(
@@ -417,8 +418,13 @@ func CreateWarehouse(ctx context.Context, d *schema.ResourceData, meta any) diag
 	if v := GetConfigPropertyAsPointerAllowingZeroValue[int](d, "statement_timeout_in_seconds"); v != nil {
 		createOptions.StatementTimeoutInSeconds = v
 	}
+	tags, err := getTagsForCreate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	createOptions.Tag = tags
 
-	err := client.Warehouses.Create(ctx, id, createOptions)
+	err = client.Warehouses.Create(ctx, id, createOptions)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -494,6 +500,9 @@ func GetReadWarehouseFunc(withExternalChangesMarking bool) schema.ReadContextFun
 		if err = d.Set("comment", w.Comment); err != nil {
 			return diag.FromErr(err)
 		}
+		if err = handleTagsRead(ctx, client, d, id, sdk.ObjectTypeWarehouse); err != nil {
+			return diag.FromErr(err)
+		}
 
 		if err = setStateToValuesFromConfig(d, warehouseSchema, []string{
 			"warehouse_type",
@@ -551,6 +560,10 @@ func UpdateWarehouse(ctx context.Context, d *schema.ResourceData, meta any) diag
 		id = newId
 	}
 
+	if err := handleTagsUpdate(ctx, client, d, id, sdk.ObjectTypeWarehouse); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Batch SET operations and UNSET operations
 	set := sdk.WarehouseSet{}
 	unset := sdk.WarehouseUnset{}
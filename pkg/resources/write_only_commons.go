@@ -0,0 +1,157 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// writeOnlyVersionSchema returns the schema of the companion attribute of the given write-only attribute.
+// Write-only values are never stored in the state; their changes are detected with the hash attribute (see writeOnlyHashSchema),
+// and changing the version allows sending the same value to Snowflake again.
+func writeOnlyVersionSchema(writeOnlyKey string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		RequiredWith: []string{writeOnlyKey},
+		Description:  fmt.Sprintf("Version of the `%s` value. Changes of `%[1]s` are detected without it; change it (e.g. increment it) to send the current value of `%[1]s` to Snowflake again (e.g. after it was changed outside of Terraform).", writeOnlyKey),
+	}
+}
+
+// writeOnlyHashKey returns the name of the attribute holding the hash of the given write-only attribute.
+func writeOnlyHashKey(writeOnlyKey string) string {
+	return writeOnlyKey + "_hash"
+}
+
+// writeOnlyHashSchema returns the schema of the attribute holding the hash of the given write-only attribute.
+// The hash (bcrypt of the SHA-256 digest of the value) allows detecting the changes of the value without storing the value in the state.
+func writeOnlyHashSchema(writeOnlyKey string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: fmt.Sprintf("Hash of the `%s` value (bcrypt of its SHA-256 digest). It is used to detect the changes of `%[1]s` without storing the value in the state.", writeOnlyKey),
+	}
+}
+
+func hashWriteOnlyValue(value string) (string, error) {
+	digest := sha256.Sum256([]byte(value))
+	hash, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(digest[:])), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func writeOnlyValueMatchesHash(value string, hash string) bool {
+	digest := sha256.Sum256([]byte(value))
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(hex.EncodeToString(digest[:]))) == nil
+}
+
+// writeOnlyValueChangedCustomDiff marks the hash of the given write-only attribute as changed whenever the value in the configuration
+// does not match the hash from the state, so the update sends the new value to Snowflake.
+func writeOnlyValueChangedCustomDiff(writeOnlyKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+		rawConfig := diff.GetRawConfig()
+		if rawConfig.IsNull() || !rawConfig.IsKnown() {
+			return nil
+		}
+		hashKey := writeOnlyHashKey(writeOnlyKey)
+		currentHash := diff.Get(hashKey).(string)
+		value := rawConfig.GetAttr(writeOnlyKey)
+		switch {
+		case !value.IsKnown():
+			return diff.SetNewComputed(hashKey)
+		case value.IsNull():
+			if currentHash != "" {
+				return diff.SetNew(hashKey, "")
+			}
+		case currentHash == "" || !writeOnlyValueMatchesHash(value.AsString(), currentHash):
+			return diff.SetNewComputed(hashKey)
+		}
+		return nil
+	}
+}
+
+// setWriteOnlyHash sets the hash of the current value of the given write-only attribute (or clears it when the value is not set).
+func setWriteOnlyHash(d *schema.ResourceData, writeOnlyKey string) error {
+	v, ok, err := getWriteOnlyString(d, writeOnlyKey)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return d.Set(writeOnlyHashKey(writeOnlyKey), "")
+	}
+	hash, err := hashWriteOnlyValue(v)
+	if err != nil {
+		return err
+	}
+	return d.Set(writeOnlyHashKey(writeOnlyKey), hash)
+}
+
+// preferWriteOnlyAttribute warns the users of Terraform 1.11 or later that they can use the write-only alternative of the given attribute.
+func preferWriteOnlyAttribute(key string, writeOnlyKey string) schema.ValidateRawResourceConfigFunc {
+	return validation.PreferWriteOnlyAttribute(cty.GetAttrPath(key), cty.GetAttrPath(writeOnlyKey))
+}
+
+// getWriteOnlyString returns the value of the top-level write-only attribute. Write-only values are available only in the raw config.
+func getWriteOnlyString(d *schema.ResourceData, key string) (string, bool, error) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() {
+		return "", false, fmt.Errorf("unable to read the write-only attribute %s: %v", key, diags)
+	}
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return "", false, nil
+	}
+	return value.AsString(), true, nil
+}
+
+// getStringOrWriteOnlyString returns the value of the given attribute or its write-only alternative; one of them is expected to be set.
+func getStringOrWriteOnlyString(d *schema.ResourceData, key string, writeOnlyKey string) (string, error) {
+	if v, ok := d.GetOk(key); ok {
+		return v.(string), nil
+	}
+	v, _, err := getWriteOnlyString(d, writeOnlyKey)
+	return v, err
+}
+
+func writeOnlyStringAttributeCreate(d *schema.ResourceData, key string, createField **string) error {
+	v, ok, err := getWriteOnlyString(d, key)
+	if err != nil {
+		return err
+	}
+	if ok {
+		*createField = sdk.String(v)
+	}
+	return nil
+}
+
+// writeOnlyStringAttributeUpdate sends the write-only value when it changes (which is detected with its hash) or when its version changes.
+// It should be called after the update of the non-write-only alternative: the value is unset only when both attributes are not set,
+// so switching between the two attributes never unsets the value.
+func writeOnlyStringAttributeUpdate(d *schema.ResourceData, key string, versionKey string, setField **string, unsetField **bool) error {
+	v, ok, err := getWriteOnlyString(d, key)
+	if err != nil {
+		return err
+	}
+	if ok && unsetField != nil {
+		*unsetField = nil
+	}
+	if !d.HasChange(versionKey) && !d.HasChange(writeOnlyHashKey(key)) {
+		return nil
+	}
+	switch {
+	case ok:
+		*setField = sdk.String(v)
+	case *setField == nil && unsetField != nil:
+		*unsetField = sdk.Bool(true)
+	}
+	return setWriteOnlyHash(d, key)
+}
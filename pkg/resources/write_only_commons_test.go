@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_writeOnlyValueMatchesHash(t *testing.T) {
+	hash, err := hashWriteOnlyValue("secret")
+	require.NoError(t, err)
+
+	assert.NotContains(t, hash, "secret")
+	assert.True(t, writeOnlyValueMatchesHash("secret", hash))
+	assert.False(t, writeOnlyValueMatchesHash("other secret", hash))
+	assert.False(t, writeOnlyValueMatchesHash("secret", ""))
+	assert.False(t, writeOnlyValueMatchesHash("secret", "invalid hash"))
+}
+
+func Test_writeOnlyValueMatchesHash_longValues(t *testing.T) {
+	// bcrypt alone considers only the first 72 bytes, so values differing after them have to be distinguished too
+	prefix := string(make([]byte, 100))
+	hash, err := hashWriteOnlyValue(prefix + "a")
+	require.NoError(t, err)
+
+	assert.True(t, writeOnlyValueMatchesHash(prefix+"a", hash))
+	assert.False(t, writeOnlyValueMatchesHash(prefix+"b", hash))
+}
+
+func Test_setWriteOnlyHash(t *testing.T) {
+	t.Run("value set", func(t *testing.T) {
+		d := resourceDataWithRawConfig(t, secretGenericStringSchema, map[string]any{
+			"secret_string_wo": "secret",
+		})
+
+		require.NoError(t, setWriteOnlyHash(d, "secret_string_wo"))
+
+		assert.True(t, writeOnlyValueMatchesHash("secret", d.Get("secret_string_wo_hash").(string)))
+	})
+
+	t.Run("value not set", func(t *testing.T) {
+		d := resourceDataWithRawConfig(t, secretGenericStringSchema, map[string]any{
+			"secret_string": "secret",
+		})
+
+		require.NoError(t, setWriteOnlyHash(d, "secret_string_wo"))
+
+		assert.Empty(t, d.Get("secret_string_wo_hash").(string))
+	})
+}
+
+func Test_getStringOrWriteOnlyString(t *testing.T) {
+	t.Run("regular attribute", func(t *testing.T) {
+		d := resourceDataWithRawConfig(t, secretGenericStringSchema, map[string]any{
+			"secret_string": "secret",
+		})
+
+		v, err := getStringOrWriteOnlyString(d, "secret_string", "secret_string_wo")
+
+		require.NoError(t, err)
+		assert.Equal(t, "secret", v)
+	})
+
+	t.Run("write-only attribute", func(t *testing.T) {
+		d := resourceDataWithRawConfig(t, secretGenericStringSchema, map[string]any{
+			"secret_string_wo": "secret",
+		})
+
+		v, err := getStringOrWriteOnlyString(d, "secret_string", "secret_string_wo")
+
+		require.NoError(t, err)
+		assert.Equal(t, "secret", v)
+	})
+}
@@ -0,0 +1,49 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AccountConnectionSchema represents the details needed to connect to the given account (e.g. to configure a provider for it).
+var AccountConnectionSchema = map[string]*schema.Schema{
+	"organization_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"account_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"account_url": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"account_locator": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"account_locator_url": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func AccountToConnectionSchema(account *sdk.Account) map[string]any {
+	accountConnectionSchema := make(map[string]any)
+	accountConnectionSchema["organization_name"] = account.OrganizationName
+	accountConnectionSchema["account_name"] = account.AccountName
+	if account.AccountURL != nil {
+		accountConnectionSchema["account_url"] = account.AccountURL
+	}
+	accountConnectionSchema["account_locator"] = account.AccountLocator
+	if account.AccountLocatorUrl != nil {
+		accountConnectionSchema["account_locator_url"] = account.AccountLocatorUrl
+	}
+	accountConnectionSchema["region"] = account.SnowflakeRegion
+	return accountConnectionSchema
+}
@@ -0,0 +1,50 @@
+package schemas
+
+import (
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeApiIntegrationSchema represents output of DESCRIBE query for the single ApiIntegration.
+// API_KEY is omitted on purpose, so that the key does not end up in the state.
+var DescribeApiIntegrationSchema = map[string]*schema.Schema{
+	"enabled":                     DescribePropertyListSchema,
+	"api_provider":                DescribePropertyListSchema,
+	"api_allowed_prefixes":        DescribePropertyListSchema,
+	"api_blocked_prefixes":        DescribePropertyListSchema,
+	"api_aws_iam_user_arn":        DescribePropertyListSchema,
+	"api_aws_role_arn":            DescribePropertyListSchema,
+	"api_aws_external_id":         DescribePropertyListSchema,
+	"azure_tenant_id":             DescribePropertyListSchema,
+	"azure_ad_application_id":     DescribePropertyListSchema,
+	"azure_multi_tenant_app_name": DescribePropertyListSchema,
+	"azure_consent_url":           DescribePropertyListSchema,
+	"google_audience":             DescribePropertyListSchema,
+	"api_gcp_service_account":     DescribePropertyListSchema,
+	"comment":                     DescribePropertyListSchema,
+}
+
+var _ = DescribeApiIntegrationSchema
+
+func DescribeApiIntegrationToSchema(integrationProperties []sdk.ApiIntegrationProperty) map[string]any {
+	propsSchema := make(map[string]any)
+	for _, property := range integrationProperties {
+		propertyName := strings.ToLower(property.Name)
+		if _, ok := DescribeApiIntegrationSchema[propertyName]; ok {
+			propsSchema[propertyName] = []map[string]any{{
+				"name":    property.Name,
+				"type":    property.Type,
+				"value":   property.Value,
+				"default": property.Default,
+			}}
+		} else {
+			log.Printf("[DEBUG] Unknown api integration property %s", propertyName)
+		}
+	}
+	return propsSchema
+}
+
+var _ = DescribeApiIntegrationToSchema
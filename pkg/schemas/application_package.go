@@ -0,0 +1,33 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ApplicationPackageDescribeSchema represents a single property returned by DESCRIBE APPLICATION PACKAGE.
+var ApplicationPackageDescribeSchema = map[string]*schema.Schema{
+	"property": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"value": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ApplicationPackageDescribeSchema
+
+func ApplicationPackagePropertiesToSchema(properties []sdk.ApplicationPackageProperty) []map[string]any {
+	propertiesSchema := make([]map[string]any, len(properties))
+	for i, property := range properties {
+		propertiesSchema[i] = map[string]any{
+			"property": property.Property,
+			"value":    property.Value,
+		}
+	}
+	return propertiesSchema
+}
+
+var _ = ApplicationPackagePropertiesToSchema
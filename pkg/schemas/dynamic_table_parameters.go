@@ -0,0 +1,33 @@
+package schemas
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ShowDynamicTableParametersSchema = make(map[string]*schema.Schema)
+	dynamicTableParameters           = []sdk.DynamicTableParameter{
+		sdk.DynamicTableParameterDataRetentionTimeInDays,
+		sdk.DynamicTableParameterMaxDataExtensionTimeInDays,
+	}
+)
+
+func init() {
+	for _, param := range dynamicTableParameters {
+		ShowDynamicTableParametersSchema[strings.ToLower(string(param))] = ParameterListSchema
+	}
+}
+
+func DynamicTableParametersToSchema(parameters []*sdk.Parameter) map[string]any {
+	dynamicTableParametersValue := make(map[string]any)
+	for _, param := range parameters {
+		if slices.Contains(dynamicTableParameters, sdk.DynamicTableParameter(param.Key)) {
+			dynamicTableParametersValue[strings.ToLower(param.Key)] = []map[string]any{ParameterToSchema(param)}
+		}
+	}
+	return dynamicTableParametersValue
+}
@@ -5,11 +5,14 @@ import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 var SdkShowResultStructs = []any{
 	sdk.Account{},
 	sdk.Alert{},
+	sdk.AlertDetails{},
 	sdk.ApiIntegration{},
 	sdk.ApplicationPackage{},
 	sdk.ApplicationRole{},
 	sdk.Application{},
 	sdk.AuthenticationPolicy{},
+	sdk.Budget{},
+	sdk.CatalogIntegration{},
 	sdk.ComputePool{},
 	sdk.Connection{},
 	sdk.DatabaseRole{},
@@ -17,6 +20,7 @@ var SdkShowResultStructs = []any{
 	sdk.DynamicTable{},
 	sdk.EventTable{},
 	sdk.ExternalFunction{},
+	sdk.ExternalAccessIntegration{},
 	sdk.ExternalTable{},
 	sdk.ExternalVolume{},
 	sdk.FailoverGroup{},
@@ -24,8 +28,11 @@ var SdkShowResultStructs = []any{
 	sdk.Function{},
 	sdk.GitRepository{},
 	sdk.Grant{},
+	sdk.HybridTable{},
+	sdk.IcebergTable{},
 	sdk.ImageRepository{},
 	sdk.Listing{},
+	sdk.ListingVersion{},
 	sdk.ManagedAccount{},
 	sdk.MaskingPolicy{},
 	sdk.MaterializedView{},
@@ -34,10 +41,13 @@ var SdkShowResultStructs = []any{
 	sdk.Notebook{},
 	sdk.NotificationIntegration{},
 	sdk.OrganizationAccount{},
+	sdk.OrganizationListing{},
+	sdk.PackagesPolicy{},
 	sdk.Parameter{},
 	sdk.PasswordPolicy{},
 	sdk.Pipe{},
 	sdk.PolicyReference{},
+	sdk.PrivacyPolicy{},
 	sdk.Procedure{},
 	sdk.ReplicationAccount{},
 	sdk.ReplicationDatabase{},
@@ -52,8 +62,12 @@ var SdkShowResultStructs = []any{
 	sdk.Sequence{},
 	sdk.SessionPolicy{},
 	sdk.Share{},
+	sdk.SnapshotPolicy{},
+	sdk.SnapshotSet{},
+	sdk.Snapshot{},
 	sdk.Stage{},
 	sdk.StorageIntegration{},
+	sdk.StorageLifecyclePolicy{},
 	sdk.Streamlit{},
 	sdk.Stream{},
 	sdk.Table{},
@@ -68,5 +82,8 @@ var SdkShowResultStructs = []any{
 // TODO [SNOW-1501905]: currently all this structs have the "Show" added to the schema, while these are not show outputs
 // TODO [SNOW-1501905]: temporary struct, may be refactored with addition to generation of describe results; for now used to some structs needing a schema representation
 var AdditionalStructs = []any{
+	sdk.EventTableDetails{},
+	sdk.ListingDetails{},
+	sdk.NetworkRuleDetails{},
 	sdk.SecurityIntegrationProperty{},
 }
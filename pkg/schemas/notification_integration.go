@@ -0,0 +1,54 @@
+package schemas
+
+import (
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeNotificationIntegrationSchema represents output of DESCRIBE query for the single NotificationIntegration.
+var DescribeNotificationIntegrationSchema = map[string]*schema.Schema{
+	"enabled":                         DescribePropertyListSchema,
+	"type":                            DescribePropertyListSchema,
+	"notification_provider":           DescribePropertyListSchema,
+	"direction":                       DescribePropertyListSchema,
+	"aws_sns_topic_arn":               DescribePropertyListSchema,
+	"aws_sns_role_arn":                DescribePropertyListSchema,
+	"sf_aws_iam_user_arn":             DescribePropertyListSchema,
+	"sf_aws_external_id":              DescribePropertyListSchema,
+	"gcp_pubsub_subscription_name":    DescribePropertyListSchema,
+	"gcp_pubsub_topic_name":           DescribePropertyListSchema,
+	"gcp_pubsub_service_account":      DescribePropertyListSchema,
+	"azure_storage_queue_primary_uri": DescribePropertyListSchema,
+	"azure_tenant_id":                 DescribePropertyListSchema,
+	"azure_consent_url":               DescribePropertyListSchema,
+	"azure_multi_tenant_app_name":     DescribePropertyListSchema,
+	"allowed_recipients":              DescribePropertyListSchema,
+	"default_recipients":              DescribePropertyListSchema,
+	"default_subject":                 DescribePropertyListSchema,
+	"comment":                         DescribePropertyListSchema,
+}
+
+var _ = DescribeNotificationIntegrationSchema
+
+func DescribeNotificationIntegrationToSchema(integrationProperties []sdk.NotificationIntegrationProperty) map[string]any {
+	propsSchema := make(map[string]any)
+	for _, property := range integrationProperties {
+		propertyName := strings.ToLower(property.Name)
+		if _, ok := DescribeNotificationIntegrationSchema[propertyName]; ok {
+			propsSchema[propertyName] = []map[string]any{{
+				"name":    property.Name,
+				"type":    property.Type,
+				"value":   property.Value,
+				"default": property.Default,
+			}}
+		} else {
+			log.Printf("[DEBUG] Unknown notification integration property %s", propertyName)
+		}
+	}
+	return propsSchema
+}
+
+var _ = DescribeNotificationIntegrationToSchema
@@ -0,0 +1,47 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var PackagesPolicyDescribeSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"language": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"allowlist": {
+		Type:     schema.TypeList,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Computed: true,
+	},
+	"blocklist": {
+		Type:     schema.TypeList,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Computed: true,
+	},
+	"additional_creation_blocklist": {
+		Type:     schema.TypeList,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func PackagesPolicyDescriptionToSchema(description sdk.PackagesPolicyDescription) map[string]any {
+	return map[string]any{
+		"name":                          description.Name,
+		"language":                      description.Language,
+		"allowlist":                     description.Allowlist,
+		"blocklist":                     description.Blocklist,
+		"additional_creation_blocklist": description.AdditionalCreationBlocklist,
+		"comment":                       description.Comment,
+	}
+}
@@ -0,0 +1,101 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PasswordPolicyDescribeSchema represents output of DESCRIBE query for the single PasswordPolicy.
+var PasswordPolicyDescribeSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"password_min_length": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"password_max_length": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"password_min_upper_case_chars": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"password_min_lower_case_chars": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"password_min_numeric_chars": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"password_min_special_chars": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"password_min_age_days": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"password_max_age_days": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"password_max_retries": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"password_lockout_time_mins": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"password_history": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+}
+
+var _ = PasswordPolicyDescribeSchema
+
+func PasswordPolicyDetailsToSchema(details *sdk.PasswordPolicyDetails) map[string]any {
+	passwordPolicySchema := make(map[string]any)
+	for name, property := range map[string]*sdk.StringProperty{
+		"name":    details.Name,
+		"owner":   details.Owner,
+		"comment": details.Comment,
+	} {
+		if property != nil {
+			passwordPolicySchema[name] = property.Value
+		}
+	}
+	for name, property := range map[string]*sdk.IntProperty{
+		"password_min_length":           details.PasswordMinLength,
+		"password_max_length":           details.PasswordMaxLength,
+		"password_min_upper_case_chars": details.PasswordMinUpperCaseChars,
+		"password_min_lower_case_chars": details.PasswordMinLowerCaseChars,
+		"password_min_numeric_chars":    details.PasswordMinNumericChars,
+		"password_min_special_chars":    details.PasswordMinSpecialChars,
+		"password_min_age_days":         details.PasswordMinAgeDays,
+		"password_max_age_days":         details.PasswordMaxAgeDays,
+		"password_max_retries":          details.PasswordMaxRetries,
+		"password_lockout_time_mins":    details.PasswordLockoutTimeMins,
+		"password_history":              details.PasswordHistory,
+	} {
+		if property != nil && property.Value != nil {
+			passwordPolicySchema[name] = *property.Value
+		}
+	}
+	return passwordPolicySchema
+}
+
+var _ = PasswordPolicyDetailsToSchema
@@ -0,0 +1,68 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var StorageLifecyclePolicyDescribeSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"signature": {
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+		Computed: true,
+	},
+	"return_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"body": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"archive_tier": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"archive_for_days": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+}
+
+func StorageLifecyclePolicyDescriptionToSchema(description sdk.StorageLifecyclePolicyDescription) map[string]any {
+	signatureElem := make([]map[string]any, len(description.Signature))
+	for i, v := range description.Signature {
+		signatureElem[i] = map[string]any{
+			"name": v.Name,
+			"type": v.Type.ToSql(),
+		}
+	}
+	storageLifecyclePolicySchema := map[string]any{
+		"name":        description.Name,
+		"signature":   signatureElem,
+		"return_type": description.ReturnType,
+		"body":        description.Body,
+	}
+	if description.ArchiveTier != nil {
+		storageLifecyclePolicySchema["archive_tier"] = string(*description.ArchiveTier)
+	}
+	if description.ArchiveForDays != nil {
+		storageLifecyclePolicySchema["archive_for_days"] = *description.ArchiveForDays
+	}
+	return storageLifecyclePolicySchema
+}
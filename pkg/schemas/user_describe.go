@@ -1,6 +1,7 @@
 package schemas
 
 import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -236,3 +237,61 @@ func UserDescriptionToSchema(userDetails sdk.UserDetails) []map[string]any {
 }
 
 var _ = UserDescriptionToSchema
+
+// UserWorkloadIdentityAuthenticationMethodSchema represents output of SHOW USER WORKLOAD IDENTITY AUTHENTICATION METHODS query for the single UserWorkloadIdentityAuthenticationMethod.
+var UserWorkloadIdentityAuthenticationMethodSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"last_used": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"additional_info": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+// ServiceUserDescribeSchema extends UserDescribeSchema with the workload identity configured for the service user.
+var ServiceUserDescribeSchema = collections.MergeMaps(UserDescribeSchema, map[string]*schema.Schema{
+	"workload_identity": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: UserWorkloadIdentityAuthenticationMethodSchema,
+		},
+	},
+})
+
+func UserWorkloadIdentityAuthenticationMethodToSchema(method sdk.UserWorkloadIdentityAuthenticationMethod) map[string]any {
+	methodSchema := make(map[string]any)
+	methodSchema["name"] = method.Name
+	methodSchema["type"] = method.Type
+	methodSchema["comment"] = method.Comment
+	if method.LastUsed != nil {
+		methodSchema["last_used"] = method.LastUsed.String()
+	}
+	methodSchema["created_on"] = method.CreatedOn.String()
+	methodSchema["additional_info"] = method.AdditionalInfo
+	return methodSchema
+}
+
+func ServiceUserDescriptionToSchema(userDetails sdk.UserDetails, workloadIdentityMethods []sdk.UserWorkloadIdentityAuthenticationMethod) []map[string]any {
+	userDetailsSchema := UserDescriptionToSchema(userDetails)
+	userDetailsSchema[0]["workload_identity"] = collections.Map(workloadIdentityMethods, UserWorkloadIdentityAuthenticationMethodToSchema)
+	return userDetailsSchema
+}
@@ -23,7 +23,7 @@ var (
 )
 
 type Alerts interface {
-	Create(ctx context.Context, id SchemaObjectIdentifier, warehouse AccountObjectIdentifier, schedule string, condition string, action string, opts *CreateAlertOptions) error
+	Create(ctx context.Context, id SchemaObjectIdentifier, condition string, action string, opts *CreateAlertOptions) error
 	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterAlertOptions) error
 	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropAlertOptions) error
 	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
@@ -45,12 +45,12 @@ type CreateAlertOptions struct {
 	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
 	name        SchemaObjectIdentifier `ddl:"identifier"`
 
-	// required
-	warehouse AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
-	schedule  string                  `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
-
 	// optional
-	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	// Warehouse can be omitted to create a serverless alert.
+	Warehouse *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	// Schedule can be omitted to create an alert on new data.
+	Schedule *string `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
+	Comment  *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
 
 	// required
 	condition []AlertCondition `ddl:"keyword,parentheses,no_comma"   sql:"IF"`
@@ -71,14 +71,11 @@ func (opts *CreateAlertOptions) validate() error {
 	return nil
 }
 
-func (v *alerts) Create(ctx context.Context, id SchemaObjectIdentifier, warehouse AccountObjectIdentifier, schedule string, condition string, action string, opts *CreateAlertOptions) error {
+func (v *alerts) Create(ctx context.Context, id SchemaObjectIdentifier, condition string, action string, opts *CreateAlertOptions) error {
 	if opts == nil {
 		opts = &CreateAlertOptions{}
 	}
 	opts.name = id
-	opts.warehouse = warehouse
-	opts.schedule = schedule
-	opts.name = id
 	opts.condition = []AlertCondition{{Condition: []string{condition}}}
 	opts.action = action
 	if err := opts.validate(); err != nil {
@@ -118,7 +115,7 @@ type AlterAlertOptions struct {
 	// One of
 	Action          *AlertAction `ddl:"keyword"`
 	Set             *AlertSet    `ddl:"keyword" sql:"SET"`
-	Unset           *AlertUnset  `ddl:"keyword" sql:"UNSET"`
+	Unset           *AlertUnset  `ddl:"list,no_parentheses" sql:"UNSET"`
 	ModifyCondition *[]string    `ddl:"keyword,parentheses,no_comma" sql:"MODIFY CONDITION EXISTS"`
 	ModifyAction    *string      `ddl:"parameter,no_equals" sql:"MODIFY ACTION"`
 }
@@ -214,10 +211,10 @@ type ShowAlertOptions struct {
 	alerts bool  `ddl:"static" sql:"ALERTS"`
 
 	// optional
-	Like       *Like   `ddl:"keyword" sql:"LIKE"`
-	In         *In     `ddl:"keyword" sql:"IN"`
-	StartsWith *string `ddl:"parameter,no_equals,single_quotes" sql:"STARTS WITH"`
-	Limit      *int    `ddl:"parameter,no_equals" sql:"LIMIT"`
+	Like       *Like      `ddl:"keyword" sql:"LIKE"`
+	In         *In        `ddl:"keyword" sql:"IN"`
+	StartsWith *string    `ddl:"parameter,no_equals,single_quotes" sql:"STARTS WITH"`
+	Limit      *LimitFrom `ddl:"keyword" sql:"LIMIT"`
 }
 
 func (v *Alert) ID() SchemaObjectIdentifier {
@@ -228,6 +225,10 @@ func (v *Alert) ObjectType() ObjectType {
 	return ObjectTypeAlert
 }
 
+func (v *Alert) IsStarted() bool {
+	return v.State == AlertStateStarted
+}
+
 type Alert struct {
 	CreatedOn     time.Time
 	Name          string
@@ -250,8 +251,8 @@ type alertDBRow struct {
 	SchemaName    string         `db:"schema_name"`
 	Owner         string         `db:"owner"`
 	Comment       *string        `db:"comment"`
-	Warehouse     string         `db:"warehouse"`
-	Schedule      string         `db:"schedule"`
+	Warehouse     sql.NullString `db:"warehouse"`
+	Schedule      sql.NullString `db:"schedule"`
 	State         string         `db:"state"` // suspended, started
 	Condition     string         `db:"condition"`
 	Action        string         `db:"action"`
@@ -266,12 +267,16 @@ func (row alertDBRow) convert() (*Alert, error) {
 		SchemaName:   row.SchemaName,
 		Owner:        row.Owner,
 		Comment:      row.Comment,
-		Warehouse:    row.Warehouse,
-		Schedule:     row.Schedule,
 		State:        AlertState(row.State),
 		Condition:    row.Condition,
 		Action:       row.Action,
 	}
+	if row.Warehouse.Valid {
+		alert.Warehouse = row.Warehouse.String
+	}
+	if row.Schedule.Valid {
+		alert.Schedule = row.Schedule.String
+	}
 	if row.OwnerRoleType.Valid {
 		alert.OwnerRoleType = row.OwnerRoleType.String
 	}
@@ -349,19 +354,24 @@ type AlertDetails struct {
 }
 
 func (row alertDBRow) toAlertDetails() (*AlertDetails, error) {
-	return &AlertDetails{
+	details := &AlertDetails{
 		CreatedOn:    row.CreatedOn,
 		Name:         row.Name,
 		DatabaseName: row.DatabaseName,
 		SchemaName:   row.SchemaName,
 		Owner:        row.Owner,
 		Comment:      row.Comment,
-		Warehouse:    row.Warehouse,
-		Schedule:     row.Schedule,
 		State:        row.State,
 		Condition:    row.Condition,
 		Action:       row.Action,
-	}, nil
+	}
+	if row.Warehouse.Valid {
+		details.Warehouse = row.Warehouse.String
+	}
+	if row.Schedule.Valid {
+		details.Schedule = row.Schedule.String
+	}
+	return details, nil
 }
 
 func (v *alerts) Describe(ctx context.Context, id SchemaObjectIdentifier) (*AlertDetails, error) {
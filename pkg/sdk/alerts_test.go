@@ -19,8 +19,8 @@ func TestAlertCreate(t *testing.T) {
 
 		opts := &CreateAlertOptions{
 			name:      id,
-			warehouse: warehouse,
-			schedule:  schedule,
+			Warehouse: &warehouse,
+			Schedule:  String(schedule),
 			condition: []AlertCondition{condition},
 			action:    action,
 			Comment:   String(newComment),
@@ -28,6 +28,19 @@ func TestAlertCreate(t *testing.T) {
 
 		assertOptsValidAndSQLEquals(t, opts, `CREATE ALERT %s WAREHOUSE = "%s" SCHEDULE = '%s' COMMENT = '%s' IF (EXISTS (%s)) THEN %s`, id.FullyQualifiedName(), warehouse.name, schedule, newComment, existsCondition, action)
 	})
+
+	t.Run("serverless alert on new data", func(t *testing.T) {
+		existsCondition := "SELECT * FROM FOO"
+		action := "INSERT INTO BAR VALUES (1)"
+
+		opts := &CreateAlertOptions{
+			name:      id,
+			condition: []AlertCondition{{[]string{existsCondition}}},
+			action:    action,
+		}
+
+		assertOptsValidAndSQLEquals(t, opts, `CREATE ALERT %s IF (EXISTS (%s)) THEN %s`, id.FullyQualifiedName(), existsCondition, action)
+	})
 }
 
 func TestAlertAlter(t *testing.T) {
@@ -93,6 +106,18 @@ func TestAlertAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER ALERT %s UNSET COMMENT", id.FullyQualifiedName())
 	})
 
+	t.Run("with unset warehouse and schedule", func(t *testing.T) {
+		opts := &AlterAlertOptions{
+			name: id,
+			Unset: &AlertUnset{
+				Warehouse: Bool(true),
+				Schedule:  Bool(true),
+			},
+		}
+
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ALERT %s UNSET WAREHOUSE, SCHEDULE", id.FullyQualifiedName())
+	})
+
 	t.Run("with modify condition", func(t *testing.T) {
 		modifyCondition := "SELECT * FROM FOO"
 		opts := &AlterAlertOptions{
@@ -205,10 +230,17 @@ func TestAlertShow(t *testing.T) {
 
 	t.Run("with limit", func(t *testing.T) {
 		opts := &ShowAlertOptions{
-			Limit: Int(10),
+			Limit: &LimitFrom{Rows: Int(10)},
 		}
 		assertOptsValidAndSQLEquals(t, opts, "SHOW ALERTS LIMIT 10")
 	})
+
+	t.Run("with limit from", func(t *testing.T) {
+		opts := &ShowAlertOptions{
+			Limit: &LimitFrom{Rows: Int(10), From: String("FOO")},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW ALERTS LIMIT 10 FROM 'FOO'")
+	})
 }
 
 func TestAlertDescribe(t *testing.T) {
@@ -164,4 +164,18 @@ var ApplicationPackagesDef = g.NewInterface(
 		OptionalLimit(),
 ).ShowByIdOperationWithFiltering(
 	g.ShowByIDLikeFiltering,
+).DescribeOperation(
+	g.DescriptionMappingKindSlice,
+	"https://docs.snowflake.com/en/sql-reference/sql/desc-application-package",
+	g.DbStruct("applicationPackagePropertyRow").
+		Field("property", "string").
+		Field("value", "sql.NullString"),
+	g.PlainStruct("ApplicationPackageProperty").
+		Field("Property", "string").
+		Field("Value", "string"),
+	g.NewQueryStruct("DescribeApplicationPackage").
+		Describe().
+		SQL("APPLICATION PACKAGE").
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
 )
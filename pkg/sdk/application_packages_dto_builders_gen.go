@@ -290,3 +290,11 @@ func (s *ShowApplicationPackageRequest) WithLimit(limit LimitFrom) *ShowApplicat
 	s.Limit = &limit
 	return s
 }
+
+func NewDescribeApplicationPackageRequest(
+	name AccountObjectIdentifier,
+) *DescribeApplicationPackageRequest {
+	s := DescribeApplicationPackageRequest{}
+	s.name = name
+	return &s
+}
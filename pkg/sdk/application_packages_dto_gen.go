@@ -3,10 +3,11 @@
 package sdk
 
 var (
-	_ optionsProvider[CreateApplicationPackageOptions] = new(CreateApplicationPackageRequest)
-	_ optionsProvider[AlterApplicationPackageOptions]  = new(AlterApplicationPackageRequest)
-	_ optionsProvider[DropApplicationPackageOptions]   = new(DropApplicationPackageRequest)
-	_ optionsProvider[ShowApplicationPackageOptions]   = new(ShowApplicationPackageRequest)
+	_ optionsProvider[CreateApplicationPackageOptions]   = new(CreateApplicationPackageRequest)
+	_ optionsProvider[AlterApplicationPackageOptions]    = new(AlterApplicationPackageRequest)
+	_ optionsProvider[DropApplicationPackageOptions]     = new(DropApplicationPackageRequest)
+	_ optionsProvider[ShowApplicationPackageOptions]     = new(ShowApplicationPackageRequest)
+	_ optionsProvider[DescribeApplicationPackageOptions] = new(DescribeApplicationPackageRequest)
 )
 
 type CreateApplicationPackageRequest struct {
@@ -100,3 +101,7 @@ type ShowApplicationPackageRequest struct {
 	StartsWith *string
 	Limit      *LimitFrom
 }
+
+type DescribeApplicationPackageRequest struct {
+	name AccountObjectIdentifier // required
+}
@@ -15,6 +15,7 @@ type ApplicationPackages interface {
 	Show(ctx context.Context, request *ShowApplicationPackageRequest) ([]ApplicationPackage, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error)
 	ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error)
+	Describe(ctx context.Context, id AccountObjectIdentifier) ([]ApplicationPackageProperty, error)
 }
 
 // CreateApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-application-package.
@@ -156,3 +157,20 @@ func (v *ApplicationPackage) ID() AccountObjectIdentifier {
 func (v *ApplicationPackage) ObjectType() ObjectType {
 	return ObjectTypeApplicationPackage
 }
+
+// DescribeApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-application-package.
+type DescribeApplicationPackageOptions struct {
+	describe           bool                    `ddl:"static" sql:"DESCRIBE"`
+	applicationPackage bool                    `ddl:"static" sql:"APPLICATION PACKAGE"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+}
+
+type applicationPackagePropertyRow struct {
+	Property string         `db:"property"`
+	Value    sql.NullString `db:"value"`
+}
+
+type ApplicationPackageProperty struct {
+	Property string
+	Value    string
+}
@@ -281,3 +281,29 @@ func TestApplicationPackages_Show(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `SHOW APPLICATION PACKAGES LIKE 'pattern' STARTS WITH 'A' LIMIT 1 FROM 'B'`)
 	})
 }
+
+func TestApplicationPackages_Describe(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+	// Minimal valid DescribeApplicationPackageOptions
+	defaultOpts := func() *DescribeApplicationPackageOptions {
+		return &DescribeApplicationPackageOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*DescribeApplicationPackageOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE APPLICATION PACKAGE %s`, id.FullyQualifiedName())
+	})
+}
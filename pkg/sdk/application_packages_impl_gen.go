@@ -11,6 +11,7 @@ import (
 var _ ApplicationPackages = (*applicationPackages)(nil)
 
 var _ convertibleRow[ApplicationPackage] = new(applicationPackageRow)
+var _ convertibleRow[ApplicationPackageProperty] = new(applicationPackagePropertyRow)
 
 type applicationPackages struct {
 	client *Client
@@ -58,6 +59,17 @@ func (v *applicationPackages) ShowByIDSafely(ctx context.Context, id AccountObje
 	return SafeShowById(v.client, v.ShowByID, ctx, id)
 }
 
+func (v *applicationPackages) Describe(ctx context.Context, id AccountObjectIdentifier) ([]ApplicationPackageProperty, error) {
+	opts := &DescribeApplicationPackageOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[applicationPackagePropertyRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[applicationPackagePropertyRow, ApplicationPackageProperty](rows)
+}
+
 func (r *CreateApplicationPackageRequest) toOpts() *CreateApplicationPackageOptions {
 	opts := &CreateApplicationPackageOptions{
 		IfNotExists:                r.IfNotExists,
@@ -184,3 +196,21 @@ func (r applicationPackageRow) convert() (*ApplicationPackage, error) {
 	}
 	return e, nil
 }
+
+func (r *DescribeApplicationPackageRequest) toOpts() *DescribeApplicationPackageOptions {
+	opts := &DescribeApplicationPackageOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r applicationPackagePropertyRow) convert() (*ApplicationPackageProperty, error) {
+	// Manually added
+	e := &ApplicationPackageProperty{
+		Property: r.Property,
+	}
+	if r.Value.Valid {
+		e.Value = r.Value.String
+	}
+	return e, nil
+}
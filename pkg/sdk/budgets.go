@@ -0,0 +1,339 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ Budgets = (*budgets)(nil)
+
+var (
+	_ validatable = new(CreateBudgetOptions)
+	_ validatable = new(DropBudgetOptions)
+	_ validatable = new(ShowBudgetOptions)
+
+	_ convertibleRow[Budget]               = new(budgetRow)
+	_ convertibleRow[BudgetLinkedResource] = new(budgetLinkedResourceRow)
+)
+
+// AccountRootBudgetId is the identifier of the account budget that exists in every account and has to be activated before use.
+var AccountRootBudgetId = NewSchemaObjectIdentifier("SNOWFLAKE", "LOCAL", "ACCOUNT_ROOT_BUDGET")
+
+// Budgets manages the instances of the SNOWFLAKE.CORE.BUDGET class. The budget is configured by calling
+// the methods of the instance (CALL <budget>!<method>(...)), which is why most of the operations are not expressed as options structs.
+// Read more in https://docs.snowflake.com/en/user-guide/budgets.
+type Budgets interface {
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateBudgetOptions) error
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropBudgetOptions) error
+	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
+	Show(ctx context.Context, opts *ShowBudgetOptions) ([]Budget, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error)
+	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error)
+
+	SetSpendingLimit(ctx context.Context, id SchemaObjectIdentifier, spendingLimit int) error
+	GetSpendingLimit(ctx context.Context, id SchemaObjectIdentifier) (int, error)
+	// SetEmailNotifications sets the emails (and optionally the email notification integration) used to notify about the projected overspending.
+	SetEmailNotifications(ctx context.Context, id SchemaObjectIdentifier, integration *AccountObjectIdentifier, emails []string) error
+	SetNotificationMuteFlag(ctx context.Context, id SchemaObjectIdentifier, mute bool) error
+	GetNotificationMuteFlag(ctx context.Context, id SchemaObjectIdentifier) (bool, error)
+	AddResource(ctx context.Context, id SchemaObjectIdentifier, resource BudgetResource) error
+	RemoveResource(ctx context.Context, id SchemaObjectIdentifier, resource BudgetResource) error
+	GetLinkedResources(ctx context.Context, id SchemaObjectIdentifier) ([]BudgetLinkedResource, error)
+
+	// ActivateAccountBudget activates the account budget (AccountRootBudgetId). Activating the already active budget is a no-op.
+	ActivateAccountBudget(ctx context.Context) error
+	// DeactivateAccountBudget deactivates the account budget and removes its configuration (spending limit, notifications).
+	DeactivateAccountBudget(ctx context.Context) error
+}
+
+type budgets struct {
+	client *Client
+}
+
+// CreateBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/commands/create-budget.
+type CreateBudgetOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	budget      bool                   `ddl:"static" sql:"SNOWFLAKE.CORE.BUDGET"`
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+	arguments   bool                   `ddl:"static" sql:"()"`
+}
+
+func (opts *CreateBudgetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateBudgetOptions", "OrReplace", "IfNotExists"))
+	}
+	return errors.Join(errs...)
+}
+
+func (v *budgets) Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateBudgetOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	return validateAndExec(v.client, ctx, opts)
+}
+
+// DropBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/commands/drop-budget.
+type DropBudgetOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`
+	budget   bool                   `ddl:"static" sql:"SNOWFLAKE.CORE.BUDGET"`
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropBudgetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *budgets) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropBudgetOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *budgets) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, id, &DropBudgetOptions{IfExists: Bool(true)}) }, ctx, id)
+}
+
+// ShowBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/commands/show-budget.
+type ShowBudgetOptions struct {
+	show    bool  `ddl:"static" sql:"SHOW"`
+	budgets bool  `ddl:"static" sql:"SNOWFLAKE.CORE.BUDGET"`
+	Like    *Like `ddl:"keyword" sql:"LIKE"`
+	In      *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *ShowBudgetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	return nil
+}
+
+type budgetRow struct {
+	CreatedOn      time.Time      `db:"created_on"`
+	Name           string         `db:"name"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	CurrentVersion sql.NullString `db:"current_version"`
+	Comment        sql.NullString `db:"comment"`
+	Owner          string         `db:"owner"`
+	OwnerRoleType  sql.NullString `db:"owner_role_type"`
+}
+
+type Budget struct {
+	CreatedOn      time.Time
+	Name           string
+	DatabaseName   string
+	SchemaName     string
+	CurrentVersion string
+	Comment        string
+	Owner          string
+	OwnerRoleType  string
+}
+
+func (row budgetRow) convert() (*Budget, error) {
+	budget := &Budget{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		DatabaseName: row.DatabaseName,
+		SchemaName:   row.SchemaName,
+		Owner:        row.Owner,
+	}
+	if row.CurrentVersion.Valid {
+		budget.CurrentVersion = row.CurrentVersion.String
+	}
+	if row.Comment.Valid {
+		budget.Comment = row.Comment.String
+	}
+	if row.OwnerRoleType.Valid {
+		budget.OwnerRoleType = row.OwnerRoleType.String
+	}
+	return budget, nil
+}
+
+func (v *Budget) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Budget) ObjectType() ObjectType {
+	return ObjectTypeBudget
+}
+
+func (v *budgets) Show(ctx context.Context, opts *ShowBudgetOptions) ([]Budget, error) {
+	opts = createIfNil(opts)
+	rows, err := validateAndQuery[budgetRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[budgetRow, Budget](rows)
+}
+
+func (v *budgets) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error) {
+	budgets, err := v.Show(ctx, &ShowBudgetOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: id.SchemaId(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(budgets, func(budget Budget) bool {
+		return budget.ID().FullyQualifiedName() == id.FullyQualifiedName()
+	})
+}
+
+func (v *budgets) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+// BudgetResource is an object that can be added to the custom budget.
+type BudgetResource struct {
+	ObjectType ObjectType
+	Id         ObjectIdentifier
+}
+
+type budgetLinkedResourceRow struct {
+	ResourceId   sql.NullString `db:"RESOURCE_ID"`
+	Name         string         `db:"NAME"`
+	Domain       string         `db:"DOMAIN"`
+	SchemaName   sql.NullString `db:"SCHEMA_NAME"`
+	DatabaseName sql.NullString `db:"DATABASE_NAME"`
+}
+
+// BudgetLinkedResource is an object added to the budget. Domain holds its object type (e.g. WAREHOUSE, DATABASE, TASK).
+type BudgetLinkedResource struct {
+	ResourceId   string
+	Name         string
+	Domain       ObjectType
+	SchemaName   string
+	DatabaseName string
+}
+
+func (row budgetLinkedResourceRow) convert() (*BudgetLinkedResource, error) {
+	resource := &BudgetLinkedResource{
+		Name:   row.Name,
+		Domain: ObjectType(strings.ToUpper(row.Domain)),
+	}
+	if row.ResourceId.Valid {
+		resource.ResourceId = row.ResourceId.String
+	}
+	if row.SchemaName.Valid {
+		resource.SchemaName = row.SchemaName.String
+	}
+	if row.DatabaseName.Valid {
+		resource.DatabaseName = row.DatabaseName.String
+	}
+	return resource, nil
+}
+
+func (v *budgets) SetSpendingLimit(ctx context.Context, id SchemaObjectIdentifier, spendingLimit int) error {
+	_, err := v.client.exec(ctx, budgetMethodCall(id, "SET_SPENDING_LIMIT", strconv.Itoa(spendingLimit)))
+	return err
+}
+
+func (v *budgets) GetSpendingLimit(ctx context.Context, id SchemaObjectIdentifier) (int, error) {
+	var spendingLimit sql.NullString
+	if err := v.client.queryOne(ctx, &spendingLimit, budgetMethodCall(id, "GET_SPENDING_LIMIT")); err != nil {
+		return 0, err
+	}
+	if !spendingLimit.Valid {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(spendingLimit.String, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing spending limit %s of budget %s: %w", spendingLimit.String, id.FullyQualifiedName(), err)
+	}
+	return int(value), nil
+}
+
+func (v *budgets) SetEmailNotifications(ctx context.Context, id SchemaObjectIdentifier, integration *AccountObjectIdentifier, emails []string) error {
+	_, err := v.client.exec(ctx, budgetSetEmailNotificationsCall(id, integration, emails))
+	return err
+}
+
+func (v *budgets) SetNotificationMuteFlag(ctx context.Context, id SchemaObjectIdentifier, mute bool) error {
+	_, err := v.client.exec(ctx, budgetMethodCall(id, "SET_NOTIFICATION_MUTE_FLAG", strings.ToUpper(strconv.FormatBool(mute))))
+	return err
+}
+
+func (v *budgets) GetNotificationMuteFlag(ctx context.Context, id SchemaObjectIdentifier) (bool, error) {
+	var mute sql.NullString
+	if err := v.client.queryOne(ctx, &mute, budgetMethodCall(id, "GET_NOTIFICATION_MUTE_FLAG")); err != nil {
+		return false, err
+	}
+	if !mute.Valid {
+		return false, nil
+	}
+	return strconv.ParseBool(mute.String)
+}
+
+func (v *budgets) AddResource(ctx context.Context, id SchemaObjectIdentifier, resource BudgetResource) error {
+	_, err := v.client.exec(ctx, budgetResourceCall(id, "ADD_RESOURCE", resource))
+	return err
+}
+
+func (v *budgets) RemoveResource(ctx context.Context, id SchemaObjectIdentifier, resource BudgetResource) error {
+	_, err := v.client.exec(ctx, budgetResourceCall(id, "REMOVE_RESOURCE", resource))
+	return err
+}
+
+func (v *budgets) GetLinkedResources(ctx context.Context, id SchemaObjectIdentifier) ([]BudgetLinkedResource, error) {
+	var rows []budgetLinkedResourceRow
+	if err := v.client.query(ctx, &rows, budgetMethodCall(id, "GET_LINKED_RESOURCES")); err != nil {
+		return nil, err
+	}
+	return convertRows[budgetLinkedResourceRow, BudgetLinkedResource](rows)
+}
+
+func (v *budgets) ActivateAccountBudget(ctx context.Context) error {
+	_, err := v.client.exec(ctx, budgetMethodCall(AccountRootBudgetId, "ACTIVATE"))
+	return err
+}
+
+func (v *budgets) DeactivateAccountBudget(ctx context.Context) error {
+	_, err := v.client.exec(ctx, budgetMethodCall(AccountRootBudgetId, "DEACTIVATE"))
+	return err
+}
+
+// budgetMethodCall builds the call of the budget instance method. The structToSQL is not used, because it would separate the budget identifier and the method name with a space.
+// The string arguments have to be already quoted and escaped (e.g. with SingleQuotes.Modify).
+func budgetMethodCall(id SchemaObjectIdentifier, method string, arguments ...string) string {
+	return fmt.Sprintf("CALL %s!%s(%s)", id.FullyQualifiedName(), method, strings.Join(arguments, ", "))
+}
+
+func budgetSetEmailNotificationsCall(id SchemaObjectIdentifier, integration *AccountObjectIdentifier, emails []string) string {
+	arguments := make([]string, 0, 2)
+	if integration != nil {
+		arguments = append(arguments, SingleQuotes.Modify(integration.FullyQualifiedName()))
+	}
+	arguments = append(arguments, SingleQuotes.Modify(strings.Join(emails, ", ")))
+	return budgetMethodCall(id, "SET_EMAIL_NOTIFICATIONS", arguments...)
+}
+
+func budgetResourceCall(id SchemaObjectIdentifier, method string, resource BudgetResource) string {
+	return budgetMethodCall(id, method, fmt.Sprintf("SELECT SYSTEM$REFERENCE(%s, %s, 'SESSION', 'APPLYBUDGET')", SingleQuotes.Modify(resource.ObjectType), SingleQuotes.Modify(resource.Id.FullyQualifiedName())))
+}
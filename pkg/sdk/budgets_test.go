@@ -0,0 +1,163 @@
+package sdk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudgets_Create(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateBudgetOptions
+	defaultOpts := func() *CreateBudgetOptions {
+		return &CreateBudgetOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateBudgetOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: [opts.OrReplace] and [opts.IfNotExists] both set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateBudgetOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SNOWFLAKE.CORE.BUDGET %s ()", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SNOWFLAKE.CORE.BUDGET %s ()", id.FullyQualifiedName())
+	})
+}
+
+func TestBudgets_Drop(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropBudgetOptions
+	defaultOpts := func() *DropBudgetOptions {
+		return &DropBudgetOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropBudgetOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP SNOWFLAKE.CORE.BUDGET IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestBudgets_Show(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid ShowBudgetOptions
+	defaultOpts := func() *ShowBudgetOptions {
+		return &ShowBudgetOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowBudgetOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SNOWFLAKE.CORE.BUDGET")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String(id.Name())}
+		opts.In = &In{Schema: id.SchemaId()}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SNOWFLAKE.CORE.BUDGET LIKE '%s' IN SCHEMA %s", id.Name(), id.SchemaId().FullyQualifiedName())
+	})
+}
+
+func TestBudgets_MethodCalls(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	integrationId := randomAccountObjectIdentifier()
+	warehouseId := randomAccountObjectIdentifier()
+	taskId := randomSchemaObjectIdentifier()
+	// the identifiers are passed in single-quoted strings, so their double quotes are escaped
+	escapedIdentifier := func(id ObjectIdentifier) string {
+		return strings.ReplaceAll(id.FullyQualifiedName(), `"`, `\"`)
+	}
+
+	t.Run("method without arguments", func(t *testing.T) {
+		assert.Equal(t, "CALL \"SNOWFLAKE\".\"LOCAL\".\"ACCOUNT_ROOT_BUDGET\"!ACTIVATE()", budgetMethodCall(AccountRootBudgetId, "ACTIVATE"))
+	})
+
+	t.Run("method with arguments", func(t *testing.T) {
+		assert.Equal(t, "CALL "+id.FullyQualifiedName()+"!SET_SPENDING_LIMIT(100)", budgetMethodCall(id, "SET_SPENDING_LIMIT", "100"))
+	})
+
+	t.Run("email notifications", func(t *testing.T) {
+		assert.Equal(t,
+			"CALL "+id.FullyQualifiedName()+"!SET_EMAIL_NOTIFICATIONS('first@example.com, second@example.com')",
+			budgetSetEmailNotificationsCall(id, nil, []string{"first@example.com", "second@example.com"}),
+		)
+	})
+
+	t.Run("email notifications with integration", func(t *testing.T) {
+		assert.Equal(t,
+			"CALL "+id.FullyQualifiedName()+"!SET_EMAIL_NOTIFICATIONS('"+escapedIdentifier(integrationId)+"', 'first@example.com')",
+			budgetSetEmailNotificationsCall(id, &integrationId, []string{"first@example.com"}),
+		)
+	})
+
+	t.Run("add resource", func(t *testing.T) {
+		assert.Equal(t,
+			"CALL "+id.FullyQualifiedName()+"!ADD_RESOURCE(SELECT SYSTEM$REFERENCE('WAREHOUSE', '"+escapedIdentifier(warehouseId)+"', 'SESSION', 'APPLYBUDGET'))",
+			budgetResourceCall(id, "ADD_RESOURCE", BudgetResource{ObjectType: ObjectTypeWarehouse, Id: warehouseId}),
+		)
+	})
+
+	t.Run("remove resource", func(t *testing.T) {
+		assert.Equal(t,
+			"CALL "+id.FullyQualifiedName()+"!REMOVE_RESOURCE(SELECT SYSTEM$REFERENCE('TASK', '"+escapedIdentifier(taskId)+"', 'SESSION', 'APPLYBUDGET'))",
+			budgetResourceCall(id, "REMOVE_RESOURCE", BudgetResource{ObjectType: ObjectTypeTask, Id: taskId}),
+		)
+	})
+	t.Run("email notifications with quotes", func(t *testing.T) {
+		assert.Equal(t,
+			"CALL "+id.FullyQualifiedName()+`!SET_EMAIL_NOTIFICATIONS('first@example.com\'), SELECT 1; --')`,
+			budgetSetEmailNotificationsCall(id, nil, []string{"first@example.com'), SELECT 1; --"}),
+		)
+	})
+
+	t.Run("resource with quotes in the identifier", func(t *testing.T) {
+		warehouseWithQuoteId := NewAccountObjectIdentifier("wh', 'x")
+		assert.Equal(t,
+			"CALL "+id.FullyQualifiedName()+`!ADD_RESOURCE(SELECT SYSTEM$REFERENCE('WAREHOUSE', '\"wh\', \'x\"', 'SESSION', 'APPLYBUDGET'))`,
+			budgetResourceCall(id, "ADD_RESOURCE", BudgetResource{ObjectType: ObjectTypeWarehouse, Id: warehouseWithQuoteId}),
+		)
+	})
+}
@@ -0,0 +1,125 @@
+package sdk
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+func (r *CreateCatalogIntegrationRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
+
+type CatalogIntegrationCatalogSource string
+
+const (
+	CatalogIntegrationCatalogSourceGlue        CatalogIntegrationCatalogSource = "GLUE"
+	CatalogIntegrationCatalogSourceObjectStore CatalogIntegrationCatalogSource = "OBJECT_STORE"
+	CatalogIntegrationCatalogSourcePolaris     CatalogIntegrationCatalogSource = "POLARIS"
+	CatalogIntegrationCatalogSourceIcebergRest CatalogIntegrationCatalogSource = "ICEBERG_REST"
+)
+
+var AllCatalogIntegrationCatalogSources = []CatalogIntegrationCatalogSource{
+	CatalogIntegrationCatalogSourceGlue,
+	CatalogIntegrationCatalogSourceObjectStore,
+	CatalogIntegrationCatalogSourcePolaris,
+	CatalogIntegrationCatalogSourceIcebergRest,
+}
+
+func ToCatalogIntegrationCatalogSource(s string) (CatalogIntegrationCatalogSource, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllCatalogIntegrationCatalogSources, CatalogIntegrationCatalogSource(s)) {
+		return "", fmt.Errorf("invalid catalog integration catalog source: %s", s)
+	}
+	return CatalogIntegrationCatalogSource(s), nil
+}
+
+type CatalogIntegrationTableFormat string
+
+const (
+	CatalogIntegrationTableFormatIceberg CatalogIntegrationTableFormat = "ICEBERG"
+	CatalogIntegrationTableFormatDelta   CatalogIntegrationTableFormat = "DELTA"
+)
+
+var AllCatalogIntegrationTableFormats = []CatalogIntegrationTableFormat{
+	CatalogIntegrationTableFormatIceberg,
+	CatalogIntegrationTableFormatDelta,
+}
+
+func ToCatalogIntegrationTableFormat(s string) (CatalogIntegrationTableFormat, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllCatalogIntegrationTableFormats, CatalogIntegrationTableFormat(s)) {
+		return "", fmt.Errorf("invalid catalog integration table format: %s", s)
+	}
+	return CatalogIntegrationTableFormat(s), nil
+}
+
+type CatalogIntegrationCatalogApiType string
+
+const (
+	CatalogIntegrationCatalogApiTypePublic                CatalogIntegrationCatalogApiType = "PUBLIC"
+	CatalogIntegrationCatalogApiTypeAwsApiGateway         CatalogIntegrationCatalogApiType = "AWS_API_GATEWAY"
+	CatalogIntegrationCatalogApiTypeAwsPrivateApiGateway  CatalogIntegrationCatalogApiType = "AWS_PRIVATE_API_GATEWAY"
+	CatalogIntegrationCatalogApiTypeAwsGlue               CatalogIntegrationCatalogApiType = "AWS_GLUE"
+	CatalogIntegrationCatalogApiTypeAwsPrivateGlue        CatalogIntegrationCatalogApiType = "AWS_PRIVATE_GLUE"
+	CatalogIntegrationCatalogApiTypeAwsPrivateLinkGateway CatalogIntegrationCatalogApiType = "AWS_PRIVATELINK_GATEWAY"
+)
+
+var AllCatalogIntegrationCatalogApiTypes = []CatalogIntegrationCatalogApiType{
+	CatalogIntegrationCatalogApiTypePublic,
+	CatalogIntegrationCatalogApiTypeAwsApiGateway,
+	CatalogIntegrationCatalogApiTypeAwsPrivateApiGateway,
+	CatalogIntegrationCatalogApiTypeAwsGlue,
+	CatalogIntegrationCatalogApiTypeAwsPrivateGlue,
+	CatalogIntegrationCatalogApiTypeAwsPrivateLinkGateway,
+}
+
+func ToCatalogIntegrationCatalogApiType(s string) (CatalogIntegrationCatalogApiType, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllCatalogIntegrationCatalogApiTypes, CatalogIntegrationCatalogApiType(s)) {
+		return "", fmt.Errorf("invalid catalog integration catalog api type: %s", s)
+	}
+	return CatalogIntegrationCatalogApiType(s), nil
+}
+
+type CatalogIntegrationAccessDelegationMode string
+
+const (
+	CatalogIntegrationAccessDelegationModeVendedCredentials         CatalogIntegrationAccessDelegationMode = "VENDED_CREDENTIALS"
+	CatalogIntegrationAccessDelegationModeExternalVolumeCredentials CatalogIntegrationAccessDelegationMode = "EXTERNAL_VOLUME_CREDENTIALS"
+)
+
+var AllCatalogIntegrationAccessDelegationModes = []CatalogIntegrationAccessDelegationMode{
+	CatalogIntegrationAccessDelegationModeVendedCredentials,
+	CatalogIntegrationAccessDelegationModeExternalVolumeCredentials,
+}
+
+func ToCatalogIntegrationAccessDelegationMode(s string) (CatalogIntegrationAccessDelegationMode, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllCatalogIntegrationAccessDelegationModes, CatalogIntegrationAccessDelegationMode(s)) {
+		return "", fmt.Errorf("invalid catalog integration access delegation mode: %s", s)
+	}
+	return CatalogIntegrationAccessDelegationMode(s), nil
+}
+
+type CatalogIntegrationRestAuthenticationType string
+
+const (
+	CatalogIntegrationRestAuthenticationTypeOAuth  CatalogIntegrationRestAuthenticationType = "OAUTH"
+	CatalogIntegrationRestAuthenticationTypeBearer CatalogIntegrationRestAuthenticationType = "BEARER"
+	CatalogIntegrationRestAuthenticationTypeSigV4  CatalogIntegrationRestAuthenticationType = "SIGV4"
+)
+
+var AllCatalogIntegrationRestAuthenticationTypes = []CatalogIntegrationRestAuthenticationType{
+	CatalogIntegrationRestAuthenticationTypeOAuth,
+	CatalogIntegrationRestAuthenticationTypeBearer,
+	CatalogIntegrationRestAuthenticationTypeSigV4,
+}
+
+func ToCatalogIntegrationRestAuthenticationType(s string) (CatalogIntegrationRestAuthenticationType, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllCatalogIntegrationRestAuthenticationTypes, CatalogIntegrationRestAuthenticationType(s)) {
+		return "", fmt.Errorf("invalid catalog integration rest authentication type: %s", s)
+	}
+	return CatalogIntegrationRestAuthenticationType(s), nil
+}
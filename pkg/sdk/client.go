@@ -11,9 +11,24 @@ import (
 	"github.com/snowflakedb/gosnowflake"
 )
 
+// queryExecutor is implemented both by the connection pool and by a single connection taken from it.
+type queryExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+}
+
+var (
+	_ queryExecutor = (*sqlx.DB)(nil)
+	_ queryExecutor = (*sqlx.Conn)(nil)
+)
+
 type Client struct {
-	config         *gosnowflake.Config
-	db             *sqlx.DB
+	config *gosnowflake.Config
+	db     *sqlx.DB
+	// executor runs the statements; it is the db pool, unless the client is bound to a dedicated connection (see WithExecutionRole).
+	executor       queryExecutor
 	sessionID      string
 	accountLocator string
 
@@ -30,6 +45,8 @@ type Client struct {
 	ApplicationRoles             ApplicationRoles
 	Applications                 Applications
 	AuthenticationPolicies       AuthenticationPolicies
+	Budgets                      Budgets
+	CatalogIntegrations          CatalogIntegrations
 	Comments                     Comments
 	ComputePools                 ComputePools
 	Connections                  Connections
@@ -38,6 +55,7 @@ type Client struct {
 	Databases                    Databases
 	DataMetricFunctionReferences DataMetricFunctionReferences
 	DynamicTables                DynamicTables
+	ExternalAccessIntegrations   ExternalAccessIntegrationsClient
 	ExternalFunctions            ExternalFunctions
 	ExternalVolumes              ExternalVolumes
 	ExternalTables               ExternalTables
@@ -47,6 +65,8 @@ type Client struct {
 	Functions                    Functions
 	GitRepositories              GitRepositories
 	Grants                       Grants
+	HybridTables                 HybridTables
+	IcebergTables                IcebergTables
 	ImageRepositories            ImageRepositories
 	Listings                     Listings
 	ManagedAccounts              ManagedAccounts
@@ -57,10 +77,14 @@ type Client struct {
 	Notebooks                    Notebooks
 	NotificationIntegrations     NotificationIntegrations
 	OrganizationAccounts         OrganizationAccounts
+	OrganizationListings         OrganizationListings
+	OrganizationProfiles         OrganizationProfiles
+	PackagesPolicies             PackagesPolicies
 	Parameters                   Parameters
 	PasswordPolicies             PasswordPolicies
 	Pipes                        Pipes
 	PolicyReferences             PolicyReferences
+	PrivacyPolicies              PrivacyPolicies
 	Procedures                   Procedures
 	ResourceMonitors             ResourceMonitors
 	Roles                        Roles
@@ -74,12 +98,17 @@ type Client struct {
 	SessionPolicies              SessionPolicies
 	Sessions                     Sessions
 	Shares                       Shares
+	SnapshotPolicies             SnapshotPolicies
+	SnapshotSets                 SnapshotSets
 	Stages                       Stages
+	StageFiles                   StageFiles
 	StorageIntegrations          StorageIntegrations
+	StorageLifecyclePolicies     StorageLifecyclePolicies
 	Streamlits                   Streamlits
 	Streams                      Streams
 	Tables                       Tables
 	Tags                         Tags
+	TagReferences                TagReferences
 	Tasks                        Tasks
 	Users                        Users
 	UserProgrammaticAccessTokens UserProgrammaticAccessTokens
@@ -125,10 +154,12 @@ func NewClient(cfg *gosnowflake.Config, opts ...func(*FileReaderConfig)) (*Clien
 		return nil, fmt.Errorf("open snowflake connection: %w", err)
 	}
 
+	// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
+	unsafeDb := db.Unsafe()
 	client := &Client{
-		// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
-		db:     db.Unsafe(),
-		config: cfg,
+		db:       unsafeDb,
+		executor: unsafeDb,
+		config:   cfg,
 	}
 	client.initialize()
 
@@ -161,6 +192,8 @@ func (c *Client) initialize() {
 	c.ApplicationRoles = &applicationRoles{client: c}
 	c.Applications = &applications{client: c}
 	c.AuthenticationPolicies = &authenticationPolicies{client: c}
+	c.Budgets = &budgets{client: c}
+	c.CatalogIntegrations = &catalogIntegrations{client: c}
 	c.Comments = &comments{client: c}
 	c.ComputePools = &computePools{client: c}
 	c.Connections = &connections{client: c}
@@ -170,6 +203,7 @@ func (c *Client) initialize() {
 	c.Databases = &databases{client: c}
 	c.DataMetricFunctionReferences = &dataMetricFunctionReferences{client: c}
 	c.DynamicTables = &dynamicTables{client: c}
+	c.ExternalAccessIntegrations = &externalAccessIntegrationsClient{client: c}
 	c.ExternalFunctions = &externalFunctions{client: c}
 	c.ExternalVolumes = &externalVolumes{client: c}
 	c.ExternalTables = &externalTables{client: c}
@@ -179,6 +213,8 @@ func (c *Client) initialize() {
 	c.Functions = &functions{client: c}
 	c.GitRepositories = &gitRepositories{client: c}
 	c.Grants = &grants{client: c}
+	c.HybridTables = &hybridTables{client: c}
+	c.IcebergTables = &icebergTables{client: c}
 	c.ImageRepositories = &imageRepositories{client: c}
 	c.Listings = &listings{client: c}
 	c.ManagedAccounts = &managedAccounts{client: c}
@@ -189,10 +225,14 @@ func (c *Client) initialize() {
 	c.Notebooks = &notebooks{client: c}
 	c.NotificationIntegrations = &notificationIntegrations{client: c}
 	c.OrganizationAccounts = &organizationAccounts{client: c}
+	c.OrganizationListings = &organizationListings{client: c}
+	c.OrganizationProfiles = &organizationProfiles{client: c}
+	c.PackagesPolicies = &packagesPolicies{client: c}
 	c.Parameters = &parameters{client: c}
 	c.PasswordPolicies = &passwordPolicies{client: c}
 	c.Pipes = &pipes{client: c}
 	c.PolicyReferences = &policyReference{client: c}
+	c.PrivacyPolicies = &privacyPolicies{client: c}
 	c.Procedures = &procedures{client: c}
 	c.ReplicationFunctions = &replicationFunctions{client: c}
 	c.ResourceMonitors = &resourceMonitors{client: c}
@@ -207,13 +247,18 @@ func (c *Client) initialize() {
 	c.SessionPolicies = &sessionPolicies{client: c}
 	c.Sessions = &sessions{client: c}
 	c.Shares = &shares{client: c}
+	c.SnapshotPolicies = &snapshotPolicies{client: c}
+	c.SnapshotSets = &snapshotSets{client: c}
 	c.Stages = &stages{client: c}
+	c.StageFiles = &stageFiles{client: c}
 	c.StorageIntegrations = &storageIntegrations{client: c}
+	c.StorageLifecyclePolicies = &storageLifecyclePolicies{client: c}
 	c.Streamlits = &streamlits{client: c}
 	c.Streams = &streams{client: c}
 	c.SystemFunctions = &systemFunctions{client: c}
 	c.Tables = &tables{client: c}
 	c.Tags = &tags{client: c}
+	c.TagReferences = &tagReference{client: c}
 	c.Tasks = &tasks{client: c}
 	c.Users = &users{client: c}
 	c.UserProgrammaticAccessTokens = &userProgrammaticAccessTokens{client: c}
@@ -221,6 +266,23 @@ func (c *Client) initialize() {
 	c.Warehouses = &warehouses{client: c}
 }
 
+// EnableRestApiBackend switches the warehouses, databases, and schemas to the Snowflake REST API implementations.
+// The SQL implementations are still used for the operations not covered by the REST API.
+func (c *Client) EnableRestApiBackend() error {
+	restClient, err := newRestApiClient(c.config)
+	if err != nil {
+		return fmt.Errorf("creating REST API client: %w", err)
+	}
+	c.useRestApiBackend(restClient)
+	return nil
+}
+
+func (c *Client) useRestApiBackend(restClient *restApiClient) {
+	c.Databases = &restApiDatabases{Databases: &databases{client: c}, client: restClient}
+	c.Schemas = &restApiSchemas{Schemas: &schemas{client: c}, client: restClient}
+	c.Warehouses = &restApiWarehouses{Warehouses: &warehouses{client: c}, client: restClient}
+}
+
 func (c *Client) Ping() error {
 	return c.db.Ping()
 }
@@ -240,7 +302,7 @@ var snowflakeAccountLocatorContextKey accountLocatorContextKey
 func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
 	sql = appendQueryMetadata(ctx, sql)
-	result, err := c.db.ExecContext(ctx, sql)
+	result, err := c.executor.ExecContext(ctx, sql)
 	return result, decodeDriverError(err)
 }
 
@@ -248,14 +310,14 @@ func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
 func (c *Client) query(ctx context.Context, dest interface{}, sql string) error {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
 	sql = appendQueryMetadata(ctx, sql)
-	return decodeDriverError(c.db.SelectContext(ctx, dest, sql))
+	return decodeDriverError(c.executor.SelectContext(ctx, dest, sql))
 }
 
 // queryOne runs a query and returns one row. dest is expected to be a pointer to a struct.
 func (c *Client) queryOne(ctx context.Context, dest interface{}, sql string) error {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
 	sql = appendQueryMetadata(ctx, sql)
-	return decodeDriverError(c.db.GetContext(ctx, dest, sql))
+	return decodeDriverError(c.executor.GetContext(ctx, dest, sql))
 }
 
 func appendQueryMetadata(ctx context.Context, sql string) string {
@@ -0,0 +1,51 @@
+package sdk
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// WithExecutionRole returns a client running all its statements under the given role. The statements are run on a dedicated connection
+// taken from the pool, so the other operations running in parallel are not affected by the role change. The returned release function
+// restores the previous role on the connection and gives the connection back to the pool; it must be called after the client is no longer used.
+//
+// The REST API backend is not used by the returned client, as the REST API calls do not share the session with the connection.
+func (c *Client) WithExecutionRole(ctx context.Context, role AccountObjectIdentifier) (*Client, func(), error) {
+	conn, err := c.db.Connx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting a dedicated connection for the execution role %s: %w", role.FullyQualifiedName(), err)
+	}
+
+	roleClient := &Client{
+		config:         c.config,
+		db:             c.db,
+		executor:       conn,
+		sessionID:      c.sessionID,
+		accountLocator: c.accountLocator,
+	}
+	roleClient.initialize()
+
+	previousRole, err := roleClient.ContextFunctions.CurrentRole(ctx)
+	if err != nil {
+		return nil, nil, errors.Join(fmt.Errorf("getting the current role of the dedicated connection: %w", err), conn.Close())
+	}
+	if err := roleClient.Sessions.UseRole(ctx, role); err != nil {
+		return nil, nil, errors.Join(fmt.Errorf("using the execution role %s: %w", role.FullyQualifiedName(), err), conn.Close())
+	}
+
+	release := func() {
+		// The original context may be already canceled at this point, and the role has to be restored anyway.
+		if err := roleClient.Sessions.UseRole(context.Background(), previousRole); err != nil {
+			log.Printf("[DEBUG] failed to restore the role %s after using the execution role %s, discarding the connection: %v", previousRole.FullyQualifiedName(), role.FullyQualifiedName(), err)
+			// Returning driver.ErrBadConn makes the pool close the connection instead of reusing it with the execution role still in use.
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		if err := conn.Close(); err != nil {
+			log.Printf("[DEBUG] failed to return the dedicated connection of the execution role %s to the pool: %v", role.FullyQualifiedName(), err)
+		}
+	}
+	return roleClient, release, nil
+}
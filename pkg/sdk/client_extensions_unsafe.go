@@ -16,7 +16,7 @@ func (c *Client) ExecUnsafe(ctx context.Context, sql string) (sql.Result, error)
 //
 // Therefore, only single resultSet is processed.
 func (c *Client) QueryUnsafe(ctx context.Context, sql string) ([]map[string]*any, error) {
-	rows, err := c.db.QueryContext(ctx, sql)
+	rows, err := c.executor.QueryContext(ctx, sql)
 	if err != nil {
 		return nil, err
 	}
@@ -63,3 +63,54 @@ func unsafeExecuteProcessRow(rows *sql.Rows, columnNames []string) (map[string]*
 	}
 	return row, nil
 }
+
+// UnsafeQueryColumn holds the column metadata returned by the driver. The optional fields are nil when the driver does not report them for the column type.
+type UnsafeQueryColumn struct {
+	Name         string
+	DatabaseType string
+	Nullable     *bool
+	Precision    *int64
+	Scale        *int64
+	Length       *int64
+}
+
+// QueryUnsafeWithColumns works like QueryUnsafe, but additionally passes the bind arguments (for the `?` placeholders) to the driver
+// and returns the metadata of the result columns. Only a single statement is allowed.
+func (c *Client) QueryUnsafeWithColumns(ctx context.Context, sql string, args ...any) ([]map[string]*any, []UnsafeQueryColumn, error) {
+	rows, err := c.executor.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, nil, decodeDriverError(err)
+	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		_ = rows.Close()
+		return nil, nil, err
+	}
+	columns := make([]UnsafeQueryColumn, len(columnTypes))
+	for i, columnType := range columnTypes {
+		columns[i] = unsafeQueryColumn(columnType)
+	}
+	allRows, err := unsafeExecuteProcessRows(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	return allRows, columns, nil
+}
+
+func unsafeQueryColumn(columnType *sql.ColumnType) UnsafeQueryColumn {
+	column := UnsafeQueryColumn{
+		Name:         columnType.Name(),
+		DatabaseType: columnType.DatabaseTypeName(),
+	}
+	if nullable, ok := columnType.Nullable(); ok {
+		column.Nullable = Bool(nullable)
+	}
+	if precision, scale, ok := columnType.DecimalSize(); ok {
+		column.Precision = Pointer(precision)
+		column.Scale = Pointer(scale)
+	}
+	if length, ok := columnType.Length(); ok {
+		column.Length = Pointer(length)
+	}
+	return column
+}
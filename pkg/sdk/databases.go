@@ -16,6 +16,7 @@ var (
 	_ validatable = new(CreateDatabaseOptions)
 	_ validatable = new(CreateSharedDatabaseOptions)
 	_ validatable = new(CreateSecondaryDatabaseOptions)
+	_ validatable = new(CreateDatabaseFromListingOptions)
 	_ validatable = new(AlterDatabaseOptions)
 	_ validatable = new(AlterDatabaseReplicationOptions)
 	_ validatable = new(AlterDatabaseFailoverOptions)
@@ -31,6 +32,7 @@ type Databases interface {
 	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateDatabaseOptions) error
 	CreateShared(ctx context.Context, id AccountObjectIdentifier, shareID ExternalObjectIdentifier, opts *CreateSharedDatabaseOptions) error
 	CreateSecondary(ctx context.Context, id AccountObjectIdentifier, primaryID ExternalObjectIdentifier, opts *CreateSecondaryDatabaseOptions) error
+	CreateFromListing(ctx context.Context, id AccountObjectIdentifier, listingGlobalName string, opts *CreateDatabaseFromListingOptions) error
 	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterDatabaseOptions) error
 	AlterReplication(ctx context.Context, id AccountObjectIdentifier, opts *AlterDatabaseReplicationOptions) error
 	AlterFailover(ctx context.Context, id AccountObjectIdentifier, opts *AlterDatabaseFailoverOptions) error
@@ -318,6 +320,52 @@ func (v *databases) CreateShared(ctx context.Context, id AccountObjectIdentifier
 	return err
 }
 
+// CreateDatabaseFromListingOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-database.
+type CreateDatabaseFromListingOptions struct {
+	create      bool                    `ddl:"static" sql:"CREATE"`
+	OrReplace   *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	database    bool                    `ddl:"static" sql:"DATABASE"`
+	IfNotExists *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+	fromListing string                  `ddl:"parameter,single_quotes,no_equals" sql:"FROM LISTING"`
+}
+
+func (opts *CreateDatabaseFromListingOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateDatabaseFromListingOptions", "OrReplace", "IfNotExists"))
+	}
+	if opts.fromListing == "" {
+		errs = append(errs, errNotSet("CreateDatabaseFromListingOptions", "fromListing"))
+	}
+	return errors.Join(errs...)
+}
+
+func (v *databases) CreateFromListing(ctx context.Context, id AccountObjectIdentifier, listingGlobalName string, opts *CreateDatabaseFromListingOptions) error {
+	if opts == nil {
+		opts = &CreateDatabaseFromListingOptions{}
+	}
+
+	opts.name = id
+	opts.fromListing = listingGlobalName
+
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
 // CreateSecondaryDatabaseOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-database.
 type CreateSecondaryDatabaseOptions struct {
 	create          bool                     `ddl:"static" sql:"CREATE"`
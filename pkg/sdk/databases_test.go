@@ -179,6 +179,45 @@ func TestDatabasesCreateShared(t *testing.T) {
 	})
 }
 
+func TestDatabasesCreateFromListing(t *testing.T) {
+	defaultOpts := func() *CreateDatabaseFromListingOptions {
+		return &CreateDatabaseFromListingOptions{
+			name:        randomAccountObjectIdentifier(),
+			fromListing: "ORGDATACLOUD$INTERNAL$LISTING",
+		}
+	}
+
+	t.Run("validation: invalid name", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: listing not set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.fromListing = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateDatabaseFromListingOptions", "fromListing"))
+	})
+
+	t.Run("validation: or replace and if not exists set at once", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateDatabaseFromListingOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE DATABASE %s FROM LISTING 'ORGDATACLOUD$INTERNAL$LISTING'`, opts.name.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE DATABASE IF NOT EXISTS %s FROM LISTING 'ORGDATACLOUD$INTERNAL$LISTING'`, opts.name.FullyQualifiedName())
+	})
+}
+
 func TestDatabasesCreateSecondary(t *testing.T) {
 	defaultOpts := func() *CreateSecondaryDatabaseOptions {
 		return &CreateSecondaryDatabaseOptions{
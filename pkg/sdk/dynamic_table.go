@@ -3,6 +3,9 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
@@ -18,20 +21,37 @@ type DynamicTables interface {
 	Show(ctx context.Context, request *ShowDynamicTableRequest) ([]DynamicTable, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*DynamicTable, error)
 	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*DynamicTable, error)
+	ShowParameters(ctx context.Context, id SchemaObjectIdentifier) ([]*Parameter, error)
 }
 
 // createDynamicTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-dynamic-table
+// and https://docs.snowflake.com/en/sql-reference/sql/create-dynamic-iceberg-table for the iceberg variant.
 type createDynamicTableOptions struct {
-	create       bool                     `ddl:"static" sql:"CREATE"`
-	OrReplace    *bool                    `ddl:"keyword" sql:"OR REPLACE"`
-	dynamicTable bool                     `ddl:"static" sql:"DYNAMIC TABLE"`
-	name         SchemaObjectIdentifier   `ddl:"identifier"`
-	targetLag    TargetLag                `ddl:"parameter,no_quotes" sql:"TARGET_LAG"`
-	Initialize   *DynamicTableInitialize  `ddl:"parameter,no_quotes" sql:"INITIALIZE"`
-	RefreshMode  *DynamicTableRefreshMode `ddl:"parameter,no_quotes" sql:"REFRESH_MODE"`
-	warehouse    AccountObjectIdentifier  `ddl:"identifier,equals" sql:"WAREHOUSE"`
-	Comment      *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
-	query        string                   `ddl:"parameter,no_equals,no_quotes" sql:"AS"`
+	create                     bool                        `ddl:"static" sql:"CREATE"`
+	OrReplace                  *bool                       `ddl:"keyword" sql:"OR REPLACE"`
+	OrAlter                    *bool                       `ddl:"keyword" sql:"OR ALTER"`
+	Transient                  *bool                       `ddl:"keyword" sql:"TRANSIENT"`
+	dynamic                    bool                        `ddl:"static" sql:"DYNAMIC"`
+	Iceberg                    *bool                       `ddl:"keyword" sql:"ICEBERG"`
+	table                      bool                        `ddl:"static" sql:"TABLE"`
+	name                       SchemaObjectIdentifier      `ddl:"identifier"`
+	TargetLag                  *TargetLag                  `ddl:"parameter,no_quotes" sql:"TARGET_LAG"`
+	Scheduler                  *DynamicTableScheduler      `ddl:"parameter,no_quotes" sql:"SCHEDULER"`
+	warehouse                  AccountObjectIdentifier     `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	InitializationWarehouse    *AccountObjectIdentifier    `ddl:"identifier,equals" sql:"INITIALIZATION_WAREHOUSE"`
+	ExternalVolume             *AccountObjectIdentifier    `ddl:"identifier,equals" sql:"EXTERNAL_VOLUME"`
+	Catalog                    *string                     `ddl:"parameter,single_quotes" sql:"CATALOG"`
+	BaseLocation               *string                     `ddl:"parameter,single_quotes" sql:"BASE_LOCATION"`
+	RefreshMode                *DynamicTableRefreshMode    `ddl:"parameter,no_quotes" sql:"REFRESH_MODE"`
+	Initialize                 *DynamicTableInitialize     `ddl:"parameter,no_quotes" sql:"INITIALIZE"`
+	ClusterBy                  []string                    `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	DataRetentionTimeInDays    *int                        `ddl:"parameter,no_quotes" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int                        `ddl:"parameter,no_quotes" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                    *string                     `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	CopyGrants                 *bool                       `ddl:"keyword" sql:"COPY GRANTS"`
+	Tag                        []TagAssociation            `ddl:"keyword,parentheses" sql:"TAG"`
+	ImmutableWhere             *DynamicTableImmutableWhere `ddl:"list,parentheses,no_comma" sql:"IMMUTABLE WHERE"`
+	query                      string                      `ddl:"parameter,no_equals,no_quotes" sql:"AS"`
 }
 
 type TargetLag struct {
@@ -39,9 +59,28 @@ type TargetLag struct {
 	Downstream      *bool   `ddl:"keyword" sql:"DOWNSTREAM"`
 }
 
+// DynamicTableImmutableWhere holds the predicate marking the rows of the dynamic table as immutable.
+type DynamicTableImmutableWhere struct {
+	Expression string `ddl:"keyword"`
+}
+
 type DynamicTableSet struct {
-	TargetLag *TargetLag               `ddl:"parameter,no_quotes" sql:"TARGET_LAG"`
-	Warehouse *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	TargetLag                  *TargetLag                  `ddl:"parameter,no_quotes" sql:"TARGET_LAG"`
+	Scheduler                  *DynamicTableScheduler      `ddl:"parameter,no_quotes" sql:"SCHEDULER"`
+	Warehouse                  *AccountObjectIdentifier    `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	InitializationWarehouse    *AccountObjectIdentifier    `ddl:"identifier,equals" sql:"INITIALIZATION_WAREHOUSE"`
+	DataRetentionTimeInDays    *int                        `ddl:"parameter,no_quotes" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int                        `ddl:"parameter,no_quotes" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                    *string                     `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	ImmutableWhere             *DynamicTableImmutableWhere `ddl:"list,parentheses,no_comma" sql:"IMMUTABLE WHERE"`
+}
+
+type DynamicTableUnset struct {
+	InitializationWarehouse    *bool `ddl:"keyword" sql:"INITIALIZATION_WAREHOUSE"`
+	DataRetentionTimeInDays    *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+	ImmutableWhere             *bool `ddl:"keyword" sql:"IMMUTABLE WHERE"`
 }
 
 // alterDynamicTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-dynamic-table
@@ -50,10 +89,14 @@ type alterDynamicTableOptions struct {
 	dynamicTable bool                   `ddl:"static" sql:"DYNAMIC TABLE"`
 	name         SchemaObjectIdentifier `ddl:"identifier"`
 
-	Suspend *bool            `ddl:"keyword" sql:"SUSPEND"`
-	Resume  *bool            `ddl:"keyword" sql:"RESUME"`
-	Refresh *bool            `ddl:"keyword" sql:"REFRESH"`
-	Set     *DynamicTableSet `ddl:"keyword" sql:"SET"`
+	Suspend           *bool                   `ddl:"keyword" sql:"SUSPEND"`
+	Resume            *bool                   `ddl:"keyword" sql:"RESUME"`
+	Refresh           *bool                   `ddl:"keyword" sql:"REFRESH"`
+	RenameTo          *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	ClusterBy         []string                `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	DropClusteringKey *bool                   `ddl:"keyword" sql:"DROP CLUSTERING KEY"`
+	Set               *DynamicTableSet        `ddl:"keyword" sql:"SET"`
+	Unset             *DynamicTableUnset      `ddl:"list,no_parentheses" sql:"UNSET"`
 }
 
 // dropDynamicTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-dynamic-table
@@ -101,6 +144,39 @@ func (d DynamicTableInitialize) ToPointer() *DynamicTableInitialize {
 
 var AllDynamicTableInitializes = []DynamicTableInitialize{DynamicTableInitializeOnCreate, DynamicTableInitializeOnSchedule}
 
+func ToDynamicTableRefreshMode(s string) (DynamicTableRefreshMode, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllDynamicRefreshModes, DynamicTableRefreshMode(s)) {
+		return "", fmt.Errorf("invalid dynamic table refresh mode: %s", s)
+	}
+	return DynamicTableRefreshMode(s), nil
+}
+
+func ToDynamicTableInitialize(s string) (DynamicTableInitialize, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllDynamicTableInitializes, DynamicTableInitialize(s)) {
+		return "", fmt.Errorf("invalid dynamic table initialize: %s", s)
+	}
+	return DynamicTableInitialize(s), nil
+}
+
+type DynamicTableScheduler string
+
+const (
+	DynamicTableSchedulerEnable  DynamicTableScheduler = "ENABLE"
+	DynamicTableSchedulerDisable DynamicTableScheduler = "DISABLE"
+)
+
+var AllDynamicTableSchedulers = []DynamicTableScheduler{DynamicTableSchedulerEnable, DynamicTableSchedulerDisable}
+
+func ToDynamicTableScheduler(s string) (DynamicTableScheduler, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllDynamicTableSchedulers, DynamicTableScheduler(s)) {
+		return "", fmt.Errorf("invalid dynamic table scheduler: %s", s)
+	}
+	return DynamicTableScheduler(s), nil
+}
+
 type DynamicTableSchedulingState string
 
 const (
@@ -11,30 +11,65 @@ var (
 
 type CreateDynamicTableRequest struct {
 	orReplace bool
+	orAlter   bool
+	transient bool
 
 	name      SchemaObjectIdentifier  // required
 	warehouse AccountObjectIdentifier // required
-	targetLag TargetLag               // required
 	query     string                  // required
 
-	comment     *string
-	refreshMode *DynamicTableRefreshMode
-	initialize  *DynamicTableInitialize
+	targetLag                  *TargetLag
+	scheduler                  *DynamicTableScheduler
+	initializationWarehouse    *AccountObjectIdentifier
+	iceberg                    *DynamicTableIcebergRequest
+	comment                    *string
+	refreshMode                *DynamicTableRefreshMode
+	initialize                 *DynamicTableInitialize
+	clusterBy                  []string
+	dataRetentionTimeInDays    *int
+	maxDataExtensionTimeInDays *int
+	copyGrants                 bool
+	tag                        []TagAssociation
+	immutableWhere             *string
+}
+
+type DynamicTableIcebergRequest struct {
+	externalVolume *AccountObjectIdentifier
+	catalog        *string
+	baseLocation   *string
 }
 
 type AlterDynamicTableRequest struct {
 	name SchemaObjectIdentifier // required
 
 	// One of
-	suspend *bool
-	resume  *bool
-	refresh *bool
-	set     *DynamicTableSetRequest
+	suspend           *bool
+	resume            *bool
+	refresh           *bool
+	renameTo          *SchemaObjectIdentifier
+	clusterBy         []string
+	dropClusteringKey *bool
+	set               *DynamicTableSetRequest
+	unset             *DynamicTableUnsetRequest
 }
 
 type DynamicTableSetRequest struct {
-	targetLag *TargetLag
-	warehouse *AccountObjectIdentifier
+	targetLag                  *TargetLag
+	scheduler                  *DynamicTableScheduler
+	warehouse                  *AccountObjectIdentifier
+	initializationWarehouse    *AccountObjectIdentifier
+	dataRetentionTimeInDays    *int
+	maxDataExtensionTimeInDays *int
+	comment                    *string
+	immutableWhere             *string
+}
+
+type DynamicTableUnsetRequest struct {
+	initializationWarehouse    *bool
+	dataRetentionTimeInDays    *bool
+	maxDataExtensionTimeInDays *bool
+	comment                    *bool
+	immutableWhere             *bool
 }
 
 type DropDynamicTableRequest struct {
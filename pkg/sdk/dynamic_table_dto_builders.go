@@ -3,13 +3,11 @@ package sdk
 func NewCreateDynamicTableRequest(
 	name SchemaObjectIdentifier,
 	warehouse AccountObjectIdentifier,
-	targetLag TargetLag,
 	query string,
 ) *CreateDynamicTableRequest {
 	s := CreateDynamicTableRequest{}
 	s.name = name
 	s.warehouse = warehouse
-	s.targetLag = targetLag
 	s.query = query
 	return &s
 }
@@ -19,6 +17,36 @@ func (s *CreateDynamicTableRequest) WithOrReplace(orReplace bool) *CreateDynamic
 	return s
 }
 
+func (s *CreateDynamicTableRequest) WithOrAlter(orAlter bool) *CreateDynamicTableRequest {
+	s.orAlter = orAlter
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithTransient(transient bool) *CreateDynamicTableRequest {
+	s.transient = transient
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithTargetLag(targetLag TargetLag) *CreateDynamicTableRequest {
+	s.targetLag = &targetLag
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithScheduler(scheduler DynamicTableScheduler) *CreateDynamicTableRequest {
+	s.scheduler = &scheduler
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithInitializationWarehouse(initializationWarehouse AccountObjectIdentifier) *CreateDynamicTableRequest {
+	s.initializationWarehouse = &initializationWarehouse
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithIceberg(iceberg DynamicTableIcebergRequest) *CreateDynamicTableRequest {
+	s.iceberg = &iceberg
+	return s
+}
+
 func (s *CreateDynamicTableRequest) WithComment(comment *string) *CreateDynamicTableRequest {
 	s.comment = comment
 	return s
@@ -34,6 +62,55 @@ func (s *CreateDynamicTableRequest) WithInitialize(initialize DynamicTableInitia
 	return s
 }
 
+func (s *CreateDynamicTableRequest) WithClusterBy(clusterBy []string) *CreateDynamicTableRequest {
+	s.clusterBy = clusterBy
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithDataRetentionTimeInDays(dataRetentionTimeInDays int) *CreateDynamicTableRequest {
+	s.dataRetentionTimeInDays = &dataRetentionTimeInDays
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays int) *CreateDynamicTableRequest {
+	s.maxDataExtensionTimeInDays = &maxDataExtensionTimeInDays
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithCopyGrants(copyGrants bool) *CreateDynamicTableRequest {
+	s.copyGrants = copyGrants
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithTag(tag []TagAssociation) *CreateDynamicTableRequest {
+	s.tag = tag
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithImmutableWhere(immutableWhere string) *CreateDynamicTableRequest {
+	s.immutableWhere = &immutableWhere
+	return s
+}
+
+func NewDynamicTableIcebergRequest() *DynamicTableIcebergRequest {
+	return &DynamicTableIcebergRequest{}
+}
+
+func (s *DynamicTableIcebergRequest) WithExternalVolume(externalVolume AccountObjectIdentifier) *DynamicTableIcebergRequest {
+	s.externalVolume = &externalVolume
+	return s
+}
+
+func (s *DynamicTableIcebergRequest) WithCatalog(catalog string) *DynamicTableIcebergRequest {
+	s.catalog = &catalog
+	return s
+}
+
+func (s *DynamicTableIcebergRequest) WithBaseLocation(baseLocation string) *DynamicTableIcebergRequest {
+	s.baseLocation = &baseLocation
+	return s
+}
+
 func NewAlterDynamicTableRequest(
 	name SchemaObjectIdentifier,
 ) *AlterDynamicTableRequest {
@@ -57,11 +134,31 @@ func (s *AlterDynamicTableRequest) WithRefresh(refresh *bool) *AlterDynamicTable
 	return s
 }
 
+func (s *AlterDynamicTableRequest) WithRenameTo(renameTo SchemaObjectIdentifier) *AlterDynamicTableRequest {
+	s.renameTo = &renameTo
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithClusterBy(clusterBy []string) *AlterDynamicTableRequest {
+	s.clusterBy = clusterBy
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithDropClusteringKey(dropClusteringKey bool) *AlterDynamicTableRequest {
+	s.dropClusteringKey = &dropClusteringKey
+	return s
+}
+
 func (s *AlterDynamicTableRequest) WithSet(set *DynamicTableSetRequest) *AlterDynamicTableRequest {
 	s.set = set
 	return s
 }
 
+func (s *AlterDynamicTableRequest) WithUnset(unset *DynamicTableUnsetRequest) *AlterDynamicTableRequest {
+	s.unset = unset
+	return s
+}
+
 func NewDynamicTableSetRequest() *DynamicTableSetRequest {
 	return &DynamicTableSetRequest{}
 }
@@ -71,11 +168,70 @@ func (s *DynamicTableSetRequest) WithTargetLag(targetLag TargetLag) *DynamicTabl
 	return s
 }
 
+func (s *DynamicTableSetRequest) WithScheduler(scheduler DynamicTableScheduler) *DynamicTableSetRequest {
+	s.scheduler = &scheduler
+	return s
+}
+
 func (s *DynamicTableSetRequest) WithWarehouse(warehouse AccountObjectIdentifier) *DynamicTableSetRequest {
 	s.warehouse = &warehouse
 	return s
 }
 
+func (s *DynamicTableSetRequest) WithInitializationWarehouse(initializationWarehouse AccountObjectIdentifier) *DynamicTableSetRequest {
+	s.initializationWarehouse = &initializationWarehouse
+	return s
+}
+
+func (s *DynamicTableSetRequest) WithDataRetentionTimeInDays(dataRetentionTimeInDays int) *DynamicTableSetRequest {
+	s.dataRetentionTimeInDays = &dataRetentionTimeInDays
+	return s
+}
+
+func (s *DynamicTableSetRequest) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays int) *DynamicTableSetRequest {
+	s.maxDataExtensionTimeInDays = &maxDataExtensionTimeInDays
+	return s
+}
+
+func (s *DynamicTableSetRequest) WithComment(comment string) *DynamicTableSetRequest {
+	s.comment = &comment
+	return s
+}
+
+func (s *DynamicTableSetRequest) WithImmutableWhere(immutableWhere string) *DynamicTableSetRequest {
+	s.immutableWhere = &immutableWhere
+	return s
+}
+
+func NewDynamicTableUnsetRequest() *DynamicTableUnsetRequest {
+	return &DynamicTableUnsetRequest{}
+}
+
+func (s *DynamicTableUnsetRequest) WithInitializationWarehouse(initializationWarehouse bool) *DynamicTableUnsetRequest {
+	s.initializationWarehouse = &initializationWarehouse
+	return s
+}
+
+func (s *DynamicTableUnsetRequest) WithDataRetentionTimeInDays(dataRetentionTimeInDays bool) *DynamicTableUnsetRequest {
+	s.dataRetentionTimeInDays = &dataRetentionTimeInDays
+	return s
+}
+
+func (s *DynamicTableUnsetRequest) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays bool) *DynamicTableUnsetRequest {
+	s.maxDataExtensionTimeInDays = &maxDataExtensionTimeInDays
+	return s
+}
+
+func (s *DynamicTableUnsetRequest) WithComment(comment bool) *DynamicTableUnsetRequest {
+	s.comment = &comment
+	return s
+}
+
+func (s *DynamicTableUnsetRequest) WithImmutableWhere(immutableWhere bool) *DynamicTableUnsetRequest {
+	s.immutableWhere = &immutableWhere
+	return s
+}
+
 func NewDropDynamicTableRequest(
 	name SchemaObjectIdentifier,
 ) *DropDynamicTableRequest {
@@ -66,34 +66,78 @@ func (v *dynamicTables) ShowByIDSafely(ctx context.Context, id SchemaObjectIdent
 	return SafeShowById(v.client, v.ShowByID, ctx, id)
 }
 
+func (v *dynamicTables) ShowParameters(ctx context.Context, id SchemaObjectIdentifier) ([]*Parameter, error) {
+	return v.client.Parameters.ShowParameters(ctx, &ShowParametersOptions{
+		In: &ParametersIn{
+			Table: id,
+		},
+	})
+}
+
 func (s *CreateDynamicTableRequest) toOpts() *createDynamicTableOptions {
-	return &createDynamicTableOptions{
-		OrReplace:   Bool(s.orReplace),
-		name:        s.name,
-		warehouse:   s.warehouse,
-		targetLag:   s.targetLag,
-		query:       s.query,
-		Comment:     s.comment,
-		RefreshMode: s.refreshMode,
-		Initialize:  s.initialize,
+	opts := &createDynamicTableOptions{
+		OrReplace:                  Bool(s.orReplace),
+		OrAlter:                    Bool(s.orAlter),
+		Transient:                  Bool(s.transient),
+		name:                       s.name,
+		warehouse:                  s.warehouse,
+		TargetLag:                  s.targetLag,
+		Scheduler:                  s.scheduler,
+		InitializationWarehouse:    s.initializationWarehouse,
+		query:                      s.query,
+		Comment:                    s.comment,
+		RefreshMode:                s.refreshMode,
+		Initialize:                 s.initialize,
+		ClusterBy:                  s.clusterBy,
+		DataRetentionTimeInDays:    s.dataRetentionTimeInDays,
+		MaxDataExtensionTimeInDays: s.maxDataExtensionTimeInDays,
+		CopyGrants:                 Bool(s.copyGrants),
+		Tag:                        s.tag,
+	}
+	if s.iceberg != nil {
+		opts.Iceberg = Bool(true)
+		opts.ExternalVolume = s.iceberg.externalVolume
+		opts.Catalog = s.iceberg.catalog
+		opts.BaseLocation = s.iceberg.baseLocation
 	}
+	if s.immutableWhere != nil {
+		opts.ImmutableWhere = &DynamicTableImmutableWhere{Expression: *s.immutableWhere}
+	}
+	return opts
 }
 
 func (s *AlterDynamicTableRequest) toOpts() *alterDynamicTableOptions {
 	opts := alterDynamicTableOptions{
-		name: s.name,
-	}
-	if s.suspend != nil {
-		opts.Suspend = s.suspend
-	}
-	if s.resume != nil {
-		opts.Resume = s.resume
-	}
-	if s.refresh != nil {
-		opts.Refresh = s.refresh
+		name:              s.name,
+		Suspend:           s.suspend,
+		Resume:            s.resume,
+		Refresh:           s.refresh,
+		RenameTo:          s.renameTo,
+		ClusterBy:         s.clusterBy,
+		DropClusteringKey: s.dropClusteringKey,
 	}
 	if s.set != nil {
-		opts.Set = &DynamicTableSet{s.set.targetLag, s.set.warehouse}
+		opts.Set = &DynamicTableSet{
+			TargetLag:                  s.set.targetLag,
+			Scheduler:                  s.set.scheduler,
+			Warehouse:                  s.set.warehouse,
+			InitializationWarehouse:    s.set.initializationWarehouse,
+			DataRetentionTimeInDays:    s.set.dataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: s.set.maxDataExtensionTimeInDays,
+			Comment:                    s.set.comment,
+		}
+		if s.set.immutableWhere != nil {
+			opts.Set.ImmutableWhere = &DynamicTableImmutableWhere{Expression: *s.set.immutableWhere}
+		}
+	}
+	if s.unset != nil {
+		opts.Unset = &DynamicTableUnset{
+			InitializationWarehouse:    s.unset.initializationWarehouse,
+			DataRetentionTimeInDays:    s.unset.dataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: s.unset.maxDataExtensionTimeInDays,
+			Comment:                    s.unset.comment,
+			ImmutableWhere:             s.unset.immutableWhere,
+		}
 	}
 	return &opts
 }
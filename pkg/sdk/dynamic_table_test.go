@@ -9,7 +9,7 @@ func TestDynamicTableCreate(t *testing.T) {
 	defaultOpts := func() *createDynamicTableOptions {
 		return &createDynamicTableOptions{
 			name: id,
-			targetLag: TargetLag{
+			TargetLag: &TargetLag{
 				MaximumDuration: String("1 minutes"),
 			},
 			warehouse: AccountObjectIdentifier{
@@ -29,19 +29,96 @@ func TestDynamicTableCreate(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
+	t.Run("validation: invalid warehouses", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.warehouse = emptyAccountObjectIdentifier
+		opts.InitializationWarehouse = Pointer(emptyAccountObjectIdentifier)
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("createDynamicTableOptions", "warehouse"), errInvalidIdentifier("createDynamicTableOptions", "InitializationWarehouse"))
+	})
+
+	t.Run("validation: or replace and or alter", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.OrAlter = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("createDynamicTableOptions", "OrReplace", "OrAlter"))
+	})
+
+	t.Run("validation: transient and iceberg", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Transient = Bool(true)
+		opts.Iceberg = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("createDynamicTableOptions", "Transient", "Iceberg"))
+	})
+
+	t.Run("validation: iceberg options for non-iceberg table", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.BaseLocation = String("path/")
+		assertOptsInvalidJoinedErrors(t, opts, NewError("ExternalVolume, Catalog, and BaseLocation can be set only for iceberg dynamic tables"))
+	})
+
+	t.Run("validation: both target lag options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.TargetLag.Downstream = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("TargetLag", "MaximumDuration", "Downstream"))
+	})
+
+	t.Run("validation: target lag with disabled scheduler", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Scheduler = Pointer(DynamicTableSchedulerDisable)
+		assertOptsInvalidJoinedErrors(t, opts, NewError("TargetLag cannot be set when the Scheduler is disabled"))
+	})
+
+	t.Run("validation: no target lag with enabled scheduler", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.TargetLag = nil
+		assertOptsInvalidJoinedErrors(t, opts, NewError("TargetLag is required unless the Scheduler is disabled"))
+	})
+
 	t.Run("basic", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.OrReplace = Bool(true)
 		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE DYNAMIC TABLE %s TARGET_LAG = '1 minutes' WAREHOUSE = "warehouse_name" AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
 	})
 
+	t.Run("or alter", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrAlter = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR ALTER DYNAMIC TABLE %s TARGET_LAG = '1 minutes' WAREHOUSE = "warehouse_name" AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
+	})
+
+	t.Run("disabled scheduler", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.TargetLag = nil
+		opts.Scheduler = Pointer(DynamicTableSchedulerDisable)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE DYNAMIC TABLE %s SCHEDULER = DISABLE WAREHOUSE = "warehouse_name" AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
+	})
+
 	t.Run("all optional", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
 		opts := defaultOpts()
 		opts.OrReplace = Bool(true)
+		opts.Transient = Bool(true)
+		opts.Scheduler = Pointer(DynamicTableSchedulerEnable)
+		opts.InitializationWarehouse = Pointer(NewAccountObjectIdentifier("init_warehouse"))
 		opts.Comment = String("comment")
 		opts.RefreshMode = DynamicTableRefreshModeFull.ToPointer()
 		opts.Initialize = DynamicTableInitializeOnSchedule.ToPointer()
-		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE DYNAMIC TABLE %s TARGET_LAG = '1 minutes' INITIALIZE = ON_SCHEDULE REFRESH_MODE = FULL WAREHOUSE = "warehouse_name" COMMENT = 'comment' AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
+		opts.ClusterBy = []string{"a", "b"}
+		opts.DataRetentionTimeInDays = Int(1)
+		opts.MaxDataExtensionTimeInDays = Int(10)
+		opts.CopyGrants = Bool(true)
+		opts.Tag = []TagAssociation{{Name: tagId, Value: "v1"}}
+		opts.ImmutableWhere = &DynamicTableImmutableWhere{Expression: "ts < CURRENT_TIMESTAMP() - INTERVAL '1 day'"}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TRANSIENT DYNAMIC TABLE %s TARGET_LAG = '1 minutes' SCHEDULER = ENABLE WAREHOUSE = "warehouse_name" INITIALIZATION_WAREHOUSE = "init_warehouse" REFRESH_MODE = FULL INITIALIZE = ON_SCHEDULE CLUSTER BY (a, b) DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 10 COMMENT = 'comment' COPY GRANTS TAG (%s = 'v1') IMMUTABLE WHERE (ts < CURRENT_TIMESTAMP() - INTERVAL '1 day') AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+
+	t.Run("iceberg", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Iceberg = Bool(true)
+		opts.ExternalVolume = Pointer(NewAccountObjectIdentifier("external_volume"))
+		opts.Catalog = String("SNOWFLAKE")
+		opts.BaseLocation = String("path/")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE DYNAMIC ICEBERG TABLE %s TARGET_LAG = '1 minutes' WAREHOUSE = "warehouse_name" EXTERNAL_VOLUME = "external_volume" CATALOG = 'SNOWFLAKE' BASE_LOCATION = 'path/' AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
 	})
 }
 
@@ -66,19 +143,32 @@ func TestDynamicTableAlter(t *testing.T) {
 
 	t.Run("validation: no alter action", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "RenameTo", "ClusterBy", "DropClusteringKey", "Set", "Unset"))
 	})
 
 	t.Run("validation: multiple alter actions", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Resume = Bool(true)
 		opts.Suspend = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "RenameTo", "ClusterBy", "DropClusteringKey", "Set", "Unset"))
+	})
+
+	t.Run("validation: no property to set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DynamicTableSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("DynamicTableSet", "TargetLag", "Scheduler", "Warehouse", "InitializationWarehouse", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment", "ImmutableWhere"))
 	})
 
 	t.Run("validation: no property to unset", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		opts.Unset = &DynamicTableUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("DynamicTableUnset", "InitializationWarehouse", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment", "ImmutableWhere"))
+	})
+
+	t.Run("validation: invalid new name", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RenameTo = Pointer(emptySchemaObjectIdentifier)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
 	t.Run("suspend", func(t *testing.T) {
@@ -105,6 +195,54 @@ func TestDynamicTableAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s SET TARGET_LAG = '1 minutes' WAREHOUSE = "warehouse_name"`, id.FullyQualifiedName())
 	})
+
+	t.Run("set all", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DynamicTableSet{
+			TargetLag: &TargetLag{
+				Downstream: Bool(true),
+			},
+			Scheduler:                  Pointer(DynamicTableSchedulerEnable),
+			Warehouse:                  Pointer(NewAccountObjectIdentifier("warehouse_name")),
+			InitializationWarehouse:    Pointer(NewAccountObjectIdentifier("init_warehouse")),
+			DataRetentionTimeInDays:    Int(1),
+			MaxDataExtensionTimeInDays: Int(10),
+			Comment:                    String("comment"),
+			ImmutableWhere:             &DynamicTableImmutableWhere{Expression: "id < 100"},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s SET TARGET_LAG = DOWNSTREAM SCHEDULER = ENABLE WAREHOUSE = "warehouse_name" INITIALIZATION_WAREHOUSE = "init_warehouse" DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 10 COMMENT = 'comment' IMMUTABLE WHERE (id < 100)`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset all", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &DynamicTableUnset{
+			InitializationWarehouse:    Bool(true),
+			DataRetentionTimeInDays:    Bool(true),
+			MaxDataExtensionTimeInDays: Bool(true),
+			Comment:                    Bool(true),
+			ImmutableWhere:             Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s UNSET INITIALIZATION_WAREHOUSE, DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, COMMENT, IMMUTABLE WHERE`, id.FullyQualifiedName())
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s RENAME TO %s`, id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("cluster by", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ClusterBy = []string{"a", "b"}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s CLUSTER BY (a, b)`, id.FullyQualifiedName())
+	})
+
+	t.Run("drop clustering key", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropClusteringKey = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s DROP CLUSTERING KEY`, id.FullyQualifiedName())
+	})
 }
 
 func TestDynamicTableDrop(t *testing.T) {
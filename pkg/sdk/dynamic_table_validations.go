@@ -7,6 +7,7 @@ var (
 	_ validatable = new(showDynamicTableOptions)
 	_ validatable = new(describeDynamicTableOptions)
 	_ validatable = new(DynamicTableSet)
+	_ validatable = new(DynamicTableUnset)
 )
 
 func (tl *TargetLag) validate() error {
@@ -30,6 +31,31 @@ func (opts *createDynamicTableOptions) validate() error {
 	if !ValidObjectIdentifier(opts.warehouse) {
 		errs = append(errs, errInvalidIdentifier("createDynamicTableOptions", "warehouse"))
 	}
+	if opts.InitializationWarehouse != nil && !ValidObjectIdentifier(*opts.InitializationWarehouse) {
+		errs = append(errs, errInvalidIdentifier("createDynamicTableOptions", "InitializationWarehouse"))
+	}
+	if opts.ExternalVolume != nil && !ValidObjectIdentifier(*opts.ExternalVolume) {
+		errs = append(errs, errInvalidIdentifier("createDynamicTableOptions", "ExternalVolume"))
+	}
+	if everyValueSet(opts.OrReplace, opts.OrAlter) && *opts.OrReplace && *opts.OrAlter {
+		errs = append(errs, errOneOf("createDynamicTableOptions", "OrReplace", "OrAlter"))
+	}
+	if everyValueSet(opts.Transient, opts.Iceberg) && *opts.Transient && *opts.Iceberg {
+		errs = append(errs, errOneOf("createDynamicTableOptions", "Transient", "Iceberg"))
+	}
+	if !(opts.Iceberg != nil && *opts.Iceberg) && anyValueSet(opts.ExternalVolume, opts.Catalog, opts.BaseLocation) {
+		errs = append(errs, NewError("ExternalVolume, Catalog, and BaseLocation can be set only for iceberg dynamic tables"))
+	}
+	if valueSet(opts.TargetLag) {
+		errs = append(errs, opts.TargetLag.validate())
+	}
+	schedulerDisabled := opts.Scheduler != nil && *opts.Scheduler == DynamicTableSchedulerDisable
+	if schedulerDisabled && valueSet(opts.TargetLag) {
+		errs = append(errs, NewError("TargetLag cannot be set when the Scheduler is disabled"))
+	}
+	if !schedulerDisabled && !valueSet(opts.TargetLag) {
+		errs = append(errs, NewError("TargetLag is required unless the Scheduler is disabled"))
+	}
 	return JoinErrors(errs...)
 }
 
@@ -41,9 +67,22 @@ func (dts *DynamicTableSet) validate() error {
 	if dts.Warehouse != nil && !ValidObjectIdentifier(*dts.Warehouse) {
 		errs = append(errs, errInvalidIdentifier("DynamicTableSet", "Warehouse"))
 	}
+	if dts.InitializationWarehouse != nil && !ValidObjectIdentifier(*dts.InitializationWarehouse) {
+		errs = append(errs, errInvalidIdentifier("DynamicTableSet", "InitializationWarehouse"))
+	}
+	if !anyValueSet(dts.TargetLag, dts.Scheduler, dts.Warehouse, dts.InitializationWarehouse, dts.DataRetentionTimeInDays, dts.MaxDataExtensionTimeInDays, dts.Comment, dts.ImmutableWhere) {
+		errs = append(errs, errAtLeastOneOf("DynamicTableSet", "TargetLag", "Scheduler", "Warehouse", "InitializationWarehouse", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment", "ImmutableWhere"))
+	}
 	return JoinErrors(errs...)
 }
 
+func (dtu *DynamicTableUnset) validate() error {
+	if !anyValueSet(dtu.InitializationWarehouse, dtu.DataRetentionTimeInDays, dtu.MaxDataExtensionTimeInDays, dtu.Comment, dtu.ImmutableWhere) {
+		return errAtLeastOneOf("DynamicTableUnset", "InitializationWarehouse", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment", "ImmutableWhere")
+	}
+	return nil
+}
+
 func (opts *alterDynamicTableOptions) validate() error {
 	if opts == nil {
 		return ErrNilOptions
@@ -52,11 +91,17 @@ func (opts *alterDynamicTableOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if ok := exactlyOneValueSet(opts.Suspend, opts.Resume, opts.Refresh, opts.Set); !ok {
-		errs = append(errs, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+	if ok := exactlyOneValueSet(opts.Suspend, opts.Resume, opts.Refresh, opts.RenameTo, opts.ClusterBy, opts.DropClusteringKey, opts.Set, opts.Unset); !ok {
+		errs = append(errs, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "RenameTo", "ClusterBy", "DropClusteringKey", "Set", "Unset"))
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(*opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if valueSet(opts.Set) {
+		errs = append(errs, opts.Set.validate())
 	}
-	if valueSet(opts.Set) && valueSet(opts.Set.TargetLag) {
-		errs = append(errs, opts.Set.TargetLag.validate())
+	if valueSet(opts.Unset) {
+		errs = append(errs, opts.Unset.validate())
 	}
 	return JoinErrors(errs...)
 }
@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	"errors"
+	"strconv"
+)
+
+func (r *CreateExternalAccessIntegrationRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
+
+// ExternalAccessIntegrationDetails contains the parsed output of DESCRIBE EXTERNAL ACCESS INTEGRATION.
+type ExternalAccessIntegrationDetails struct {
+	Enabled                              bool
+	AllowedNetworkRules                  []SchemaObjectIdentifier
+	AllowedApiAuthenticationIntegrations []AccountObjectIdentifier
+	AllowedAuthenticationSecrets         []SchemaObjectIdentifier
+	Comment                              string
+}
+
+func ParseExternalAccessIntegrationDetails(properties []ExternalAccessIntegrationProperty) (*ExternalAccessIntegrationDetails, error) {
+	details := &ExternalAccessIntegrationDetails{
+		AllowedNetworkRules:                  make([]SchemaObjectIdentifier, 0),
+		AllowedApiAuthenticationIntegrations: make([]AccountObjectIdentifier, 0),
+		AllowedAuthenticationSecrets:         make([]SchemaObjectIdentifier, 0),
+	}
+	var errs []error
+	for _, property := range properties {
+		var err error
+		switch property.Name {
+		case "ENABLED":
+			details.Enabled, err = strconv.ParseBool(property.Value)
+		case "ALLOWED_NETWORK_RULES":
+			details.AllowedNetworkRules, err = ParseCommaSeparatedSchemaObjectIdentifierArray(property.Value)
+		case "ALLOWED_API_AUTHENTICATION_INTEGRATIONS":
+			details.AllowedApiAuthenticationIntegrations, err = ParseCommaSeparatedAccountObjectIdentifierArray(property.Value)
+		case "ALLOWED_AUTHENTICATION_SECRETS":
+			details.AllowedAuthenticationSecrets, err = ParseCommaSeparatedSchemaObjectIdentifierArray(property.Value)
+		case "COMMENT":
+			details.Comment = property.Value
+		}
+		errs = append(errs, err)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
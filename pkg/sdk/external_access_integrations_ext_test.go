@@ -0,0 +1,50 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseExternalAccessIntegrationDetails(t *testing.T) {
+	t.Run("all properties", func(t *testing.T) {
+		details, err := ParseExternalAccessIntegrationDetails([]ExternalAccessIntegrationProperty{
+			{Name: "ENABLED", Type: "Boolean", Value: "true", Default: "false"},
+			{Name: "ALLOWED_NETWORK_RULES", Type: "List", Value: "DB.SCHEMA.RULE_1,DB.SCHEMA.RULE_2", Default: "[]"},
+			{Name: "ALLOWED_API_AUTHENTICATION_INTEGRATIONS", Type: "List", Value: "INTEGRATION", Default: "[]"},
+			{Name: "ALLOWED_AUTHENTICATION_SECRETS", Type: "List", Value: `"db"."schema"."secret"`, Default: "[]"},
+			{Name: "COMMENT", Type: "String", Value: "comment", Default: ""},
+		})
+		require.NoError(t, err)
+
+		assert.True(t, details.Enabled)
+		assert.Equal(t, []SchemaObjectIdentifier{NewSchemaObjectIdentifier("DB", "SCHEMA", "RULE_1"), NewSchemaObjectIdentifier("DB", "SCHEMA", "RULE_2")}, details.AllowedNetworkRules)
+		assert.Equal(t, []AccountObjectIdentifier{NewAccountObjectIdentifier("INTEGRATION")}, details.AllowedApiAuthenticationIntegrations)
+		assert.Equal(t, []SchemaObjectIdentifier{NewSchemaObjectIdentifier("db", "schema", "secret")}, details.AllowedAuthenticationSecrets)
+		assert.Equal(t, "comment", details.Comment)
+	})
+
+	t.Run("empty lists", func(t *testing.T) {
+		details, err := ParseExternalAccessIntegrationDetails([]ExternalAccessIntegrationProperty{
+			{Name: "ENABLED", Type: "Boolean", Value: "false", Default: "false"},
+			{Name: "ALLOWED_NETWORK_RULES", Type: "List", Value: "[]", Default: "[]"},
+			{Name: "ALLOWED_API_AUTHENTICATION_INTEGRATIONS", Type: "List", Value: "", Default: "[]"},
+		})
+		require.NoError(t, err)
+
+		assert.False(t, details.Enabled)
+		assert.Empty(t, details.AllowedNetworkRules)
+		assert.Empty(t, details.AllowedApiAuthenticationIntegrations)
+		assert.Empty(t, details.AllowedAuthenticationSecrets)
+		assert.Empty(t, details.Comment)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		_, err := ParseExternalAccessIntegrationDetails([]ExternalAccessIntegrationProperty{
+			{Name: "ENABLED", Type: "Boolean", Value: "maybe"},
+			{Name: "ALLOWED_NETWORK_RULES", Type: "List", Value: "RULE"},
+		})
+		require.Error(t, err)
+	})
+}
@@ -10,8 +10,19 @@ import (
 
 func init() {
 	gen.AllSdkObjectDefinitions = append(gen.AllSdkObjectDefinitions,
+		CatalogIntegrationsDef,
+		ExternalAccessIntegrationsDef,
+		HybridTablesDef,
+		IcebergTablesDef,
+		OrganizationListingsDef,
+		OrganizationProfilesDef,
+		PackagesPoliciesDef,
+		PrivacyPoliciesDef,
 		SemanticViewsDef,
 		SequencesDef,
+		SnapshotPoliciesDef,
+		SnapshotSetsDef,
+		StorageLifecyclePoliciesDef,
 	)
 	fmt.Println("SDK object definitions:")
 	for _, def := range gen.AllSdkObjectDefinitions {
@@ -0,0 +1,144 @@
+//go:build sdk_generation
+
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var catalogIntegrationRestConfig = g.NewQueryStruct("CatalogIntegrationRestConfig").
+	TextAssignment("CATALOG_URI", g.ParameterOptions().SingleQuotes().Required()).
+	OptionalAssignment("CATALOG_API_TYPE", "CatalogIntegrationCatalogApiType", g.ParameterOptions().NoQuotes()).
+	OptionalTextAssignment("CATALOG_NAME", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("WAREHOUSE", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("PREFIX", g.ParameterOptions().SingleQuotes()).
+	OptionalAssignment("ACCESS_DELEGATION_MODE", "CatalogIntegrationAccessDelegationMode", g.ParameterOptions().NoQuotes())
+
+var catalogIntegrationOauthAllowedScope = g.NewQueryStruct("CatalogIntegrationOauthAllowedScope").
+	Text("Scope", g.KeywordOptions().SingleQuotes().Required())
+
+var catalogIntegrationRestAuthentication = g.NewQueryStruct("CatalogIntegrationRestAuthentication").
+	OptionalAssignmentWithFieldName("TYPE", "CatalogIntegrationRestAuthenticationType", g.ParameterOptions().NoQuotes(), "RestAuthenticationType").
+	OptionalTextAssignment("OAUTH_TOKEN_URI", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("OAUTH_CLIENT_ID", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("OAUTH_CLIENT_SECRET", g.ParameterOptions().SingleQuotes()).
+	ListAssignment("OAUTH_ALLOWED_SCOPES", "CatalogIntegrationOauthAllowedScope", g.ParameterOptions().Parentheses()).
+	OptionalTextAssignment("BEARER_TOKEN", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("SIGV4_IAM_ROLE", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("SIGV4_SIGNING_REGION", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("SIGV4_EXTERNAL_ID", g.ParameterOptions().SingleQuotes())
+
+var catalogIntegrationSet = g.NewQueryStruct("CatalogIntegrationSet").
+	OptionalQueryStructField("RestAuthentication", catalogIntegrationRestAuthentication, g.ListOptions().Parentheses().NoComma().SQL("REST_AUTHENTICATION =")).
+	OptionalNumberAssignment("REFRESH_INTERVAL_SECONDS", g.ParameterOptions().NoQuotes()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "RestAuthentication", "RefreshIntervalSeconds", "Comment")
+
+var catalogIntegrationUnset = g.NewQueryStruct("CatalogIntegrationUnset").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Comment")
+
+var catalogIntegrationDbRow = g.DbStruct("catalogIntegrationRow").
+	Text("name").
+	Text("type").
+	Text("category").
+	Bool("enabled").
+	OptionalText("comment").
+	Time("created_on")
+
+var catalogIntegration = g.PlainStruct("CatalogIntegration").
+	Text("Name").
+	Text("Type").
+	Text("Category").
+	Bool("Enabled").
+	Text("Comment").
+	Time("CreatedOn")
+
+var catalogIntegrationDetailsDbRow = g.DbStruct("catalogIntegrationDetailsRow").
+	Text("property").
+	Text("property_type").
+	Text("property_value").
+	Text("property_default")
+
+var catalogIntegrationProperty = g.PlainStruct("CatalogIntegrationProperty").
+	Text("Name").
+	Text("Type").
+	Text("Value").
+	Text("Default")
+
+var CatalogIntegrationsDef = g.NewInterface(
+	"CatalogIntegrations",
+	"CatalogIntegration",
+	g.KindOfT[sdkcommons.AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-catalog-integration",
+		g.NewQueryStruct("CreateCatalogIntegration").
+			Create().
+			OrReplace().
+			SQL("CATALOG INTEGRATION").
+			IfNotExists().
+			Name().
+			Assignment("CATALOG_SOURCE", "CatalogIntegrationCatalogSource", g.ParameterOptions().NoQuotes().Required()).
+			Assignment("TABLE_FORMAT", "CatalogIntegrationTableFormat", g.ParameterOptions().NoQuotes().Required()).
+			OptionalTextAssignment("CATALOG_NAMESPACE", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("GLUE_AWS_ROLE_ARN", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("GLUE_CATALOG_ID", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("GLUE_REGION", g.ParameterOptions().SingleQuotes()).
+			OptionalQueryStructField("RestConfig", catalogIntegrationRestConfig, g.ListOptions().Parentheses().NoComma().SQL("REST_CONFIG =")).
+			OptionalQueryStructField("RestAuthentication", catalogIntegrationRestAuthentication, g.ListOptions().Parentheses().NoComma().SQL("REST_AUTHENTICATION =")).
+			BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+			OptionalNumberAssignment("REFRESH_INTERVAL_SECONDS", g.ParameterOptions().NoQuotes()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+		catalogIntegrationRestConfig,
+		catalogIntegrationRestAuthentication,
+		catalogIntegrationOauthAllowedScope,
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-catalog-integration",
+		g.NewQueryStruct("AlterCatalogIntegration").
+			Alter().
+			SQL("CATALOG INTEGRATION").
+			IfExists().
+			Name().
+			OptionalQueryStructField("Set", catalogIntegrationSet, g.KeywordOptions().SQL("SET")).
+			OptionalQueryStructField("Unset", catalogIntegrationUnset, g.ListOptions().NoParentheses().SQL("UNSET")).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-catalog-integration",
+		g.NewQueryStruct("DropCatalogIntegration").
+			Drop().
+			SQL("CATALOG INTEGRATION").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-catalog-integrations",
+		catalogIntegrationDbRow,
+		catalogIntegration,
+		g.NewQueryStruct("ShowCatalogIntegrations").
+			Show().
+			SQL("CATALOG INTEGRATIONS").
+			OptionalLike(),
+	).
+	ShowByIdOperationWithFiltering(g.ShowByIDLikeFiltering).
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-catalog-integration",
+		catalogIntegrationDetailsDbRow,
+		catalogIntegrationProperty,
+		g.NewQueryStruct("DescribeCatalogIntegration").
+			Describe().
+			SQL("CATALOG INTEGRATION").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
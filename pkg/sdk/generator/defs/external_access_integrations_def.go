@@ -0,0 +1,120 @@
+//go:build sdk_generation
+
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var externalAccessIntegrationSet = g.NewQueryStruct("ExternalAccessIntegrationSet").
+	ListAssignment("ALLOWED_NETWORK_RULES", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+	ListAssignment("ALLOWED_API_AUTHENTICATION_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+	ListAssignment("ALLOWED_AUTHENTICATION_SECRETS", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "AllowedNetworkRules", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Enabled", "Comment")
+
+var externalAccessIntegrationUnset = g.NewQueryStruct("ExternalAccessIntegrationUnset").
+	OptionalSQL("ALLOWED_API_AUTHENTICATION_INTEGRATIONS").
+	OptionalSQL("ALLOWED_AUTHENTICATION_SECRETS").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Comment")
+
+var externalAccessIntegrationDbRow = g.DbStruct("externalAccessIntegrationRow").
+	Text("name").
+	Text("type").
+	Text("category").
+	Bool("enabled").
+	OptionalText("comment").
+	Time("created_on")
+
+var externalAccessIntegration = g.PlainStruct("ExternalAccessIntegration").
+	Text("Name").
+	Text("Type").
+	Text("Category").
+	Bool("Enabled").
+	Text("Comment").
+	Time("CreatedOn")
+
+var externalAccessIntegrationDetailsDbRow = g.DbStruct("externalAccessIntegrationDetailsRow").
+	Text("property").
+	Text("property_type").
+	Text("property_value").
+	Text("property_default")
+
+var externalAccessIntegrationProperty = g.PlainStruct("ExternalAccessIntegrationProperty").
+	Text("Name").
+	Text("Type").
+	Text("Value").
+	Text("Default")
+
+// The generated ExternalAccessIntegrations interface and its implementation are renamed manually (with the Client suffix),
+// because the names are already used by the streamlits SDK.
+var ExternalAccessIntegrationsDef = g.NewInterface(
+	"ExternalAccessIntegrations",
+	"ExternalAccessIntegration",
+	g.KindOfT[sdkcommons.AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration",
+		g.NewQueryStruct("CreateExternalAccessIntegration").
+			Create().
+			OrReplace().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			IfNotExists().
+			Name().
+			ListAssignment("ALLOWED_NETWORK_RULES", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses().Required()).
+			ListAssignment("ALLOWED_API_AUTHENTICATION_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+			ListAssignment("ALLOWED_AUTHENTICATION_SECRETS", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+			BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "AllowedNetworkRules").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-external-access-integration",
+		g.NewQueryStruct("AlterExternalAccessIntegration").
+			Alter().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			IfExists().
+			Name().
+			OptionalQueryStructField("Set", externalAccessIntegrationSet, g.KeywordOptions().SQL("SET")).
+			OptionalQueryStructField("Unset", externalAccessIntegrationUnset, g.ListOptions().NoParentheses().SQL("UNSET")).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-integration",
+		g.NewQueryStruct("DropExternalAccessIntegration").
+			Drop().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-external-access-integrations",
+		externalAccessIntegrationDbRow,
+		externalAccessIntegration,
+		g.NewQueryStruct("ShowExternalAccessIntegrations").
+			Show().
+			SQL("EXTERNAL ACCESS INTEGRATIONS").
+			OptionalLike(),
+	).
+	ShowByIdOperationWithFiltering(g.ShowByIDLikeFiltering).
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-external-access-integration",
+		externalAccessIntegrationDetailsDbRow,
+		externalAccessIntegrationProperty,
+		g.NewQueryStruct("DescribeExternalAccessIntegration").
+			Describe().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
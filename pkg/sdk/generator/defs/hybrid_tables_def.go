@@ -0,0 +1,204 @@
+//go:build sdk_generation
+
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var hybridTableColumn = g.NewQueryStruct("HybridTableColumn").
+	Text("Name", g.KeywordOptions().DoubleQuotes().Required()).
+	PredefinedQueryStructField("DataType", "DataType", g.KeywordOptions().Required()).
+	OptionalTextAssignment("COLLATE", g.ParameterOptions().NoEquals().SingleQuotes()).
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().NoEquals().SingleQuotes()).
+	OptionalAssignmentWithFieldName("DEFAULT", "string", g.ParameterOptions().NoEquals(), "DefaultExpression").
+	OptionalSQL("AUTOINCREMENT").
+	OptionalSQL("NOT NULL").
+	WithValidation(g.ConflictingFields, "DefaultExpression", "Autoincrement")
+
+var hybridTableForeignKeyReference = g.NewQueryStruct("HybridTableForeignKeyReference").
+	Identifier("TableName", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
+	PredefinedQueryStructField("Columns", "[]string", g.KeywordOptions().Parentheses().Required()).
+	WithValidation(g.ValidIdentifier, "TableName")
+
+var hybridTableOutOfLineConstraint = g.NewQueryStruct("HybridTableOutOfLineConstraint").
+	OptionalAssignmentWithFieldName("CONSTRAINT", "string", g.ParameterOptions().NoEquals().DoubleQuotes(), "Name").
+	PredefinedQueryStructField("ConstraintType", "HybridTableConstraintType", g.KeywordOptions().Required()).
+	PredefinedQueryStructField("Columns", "[]string", g.KeywordOptions().Parentheses().Required()).
+	OptionalQueryStructField("References", hybridTableForeignKeyReference, g.KeywordOptions().SQL("REFERENCES"))
+
+var hybridTableIndex = g.NewQueryStruct("HybridTableIndexDefinition").
+	SQL("INDEX").
+	Text("Name", g.KeywordOptions().DoubleQuotes().Required()).
+	PredefinedQueryStructField("Columns", "[]string", g.KeywordOptions().Parentheses().Required()).
+	PredefinedQueryStructField("IncludeColumns", "[]string", g.KeywordOptions().Parentheses().SQL("INCLUDE"))
+
+var hybridTableColumnsConstraintsAndIndexes = g.NewQueryStruct("HybridTableColumnsConstraintsAndIndexes").
+	ListQueryStructField("Columns", hybridTableColumn, g.KeywordOptions().Required()).
+	ListQueryStructField("OutOfLineConstraints", hybridTableOutOfLineConstraint, g.ListOptions().NoParentheses()).
+	ListQueryStructField("Indexes", hybridTableIndex, g.ListOptions().NoParentheses())
+
+var hybridTableSet = g.NewQueryStruct("HybridTableSet").
+	OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions().NoQuotes()).
+	OptionalNumberAssignment("MAX_DATA_EXTENSION_TIME_IN_DAYS", g.ParameterOptions().NoQuotes()).
+	OptionalTextAssignment("DEFAULT_DDL_COLLATION", g.ParameterOptions().SingleQuotes()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "DefaultDdlCollation", "Comment")
+
+var hybridTableUnset = g.NewQueryStruct("HybridTableUnset").
+	OptionalSQL("DATA_RETENTION_TIME_IN_DAYS").
+	OptionalSQL("MAX_DATA_EXTENSION_TIME_IN_DAYS").
+	OptionalSQL("DEFAULT_DDL_COLLATION").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "DefaultDdlCollation", "Comment")
+
+var hybridTableDbRow = g.DbStruct("hybridTableRow").
+	Time("created_on").
+	Text("name").
+	Text("database_name").
+	Text("schema_name").
+	Text("owner").
+	OptionalNumber("rows").
+	OptionalNumber("bytes").
+	OptionalText("comment").
+	OptionalText("owner_role_type")
+
+var hybridTable = g.PlainStruct("HybridTable").
+	Time("CreatedOn").
+	Text("Name").
+	Text("DatabaseName").
+	Text("SchemaName").
+	Text("Owner").
+	OptionalNumber("Rows").
+	OptionalNumber("Bytes").
+	OptionalText("Comment").
+	OptionalText("OwnerRoleType")
+
+var hybridTableIndexDbRow = g.DbStruct("hybridTableIndexRow").
+	Time("created_on").
+	Text("name").
+	Bool("is_unique").
+	Text("columns").
+	OptionalText("included_columns").
+	Text("table").
+	Text("database_name").
+	Text("schema_name").
+	OptionalText("owner").
+	OptionalText("owner_role_type")
+
+var hybridTableIndexPlain = g.PlainStruct("HybridTableIndex").
+	Time("CreatedOn").
+	Text("Name").
+	Bool("IsUnique").
+	Field("Columns", "[]string").
+	Field("IncludedColumns", "[]string").
+	Text("TableName").
+	Text("DatabaseName").
+	Text("SchemaName").
+	Text("Owner").
+	Text("OwnerRoleType")
+
+var HybridTablesDef = g.NewInterface(
+	"HybridTables",
+	"HybridTable",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-hybrid-table",
+		g.NewQueryStruct("CreateHybridTable").
+			Create().
+			OrReplace().
+			SQL("HYBRID TABLE").
+			IfNotExists().
+			Name().
+			QueryStructField("ColumnsConstraintsAndIndexes", hybridTableColumnsConstraintsAndIndexes, g.ListOptions().Parentheses().NoEquals().Required()).
+			OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions().NoQuotes()).
+			OptionalNumberAssignment("MAX_DATA_EXTENSION_TIME_IN_DAYS", g.ParameterOptions().NoQuotes()).
+			OptionalTextAssignment("DEFAULT_DDL_COLLATION", g.ParameterOptions().SingleQuotes()).
+			OptionalCopyGrants().
+			OptionalComment().
+			OptionalTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+		hybridTableColumn,
+		hybridTableOutOfLineConstraint,
+		hybridTableIndex,
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-table",
+		g.NewQueryStruct("AlterHybridTable").
+			Alter().
+			SQL("TABLE").
+			IfExists().
+			Name().
+			OptionalQueryStructField("Set", hybridTableSet, g.ListOptions().NoParentheses().SQL("SET")).
+			OptionalQueryStructField("Unset", hybridTableUnset, g.ListOptions().NoParentheses().SQL("UNSET")).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			OptionalIdentifier("RenameTo", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags", "RenameTo"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-hybrid-table",
+		g.NewQueryStruct("DropHybridTable").
+			Drop().
+			SQL("HYBRID TABLE").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-hybrid-tables",
+		hybridTableDbRow,
+		hybridTable,
+		g.NewQueryStruct("ShowHybridTables").
+			Show().
+			SQL("HYBRID TABLES").
+			OptionalLike().
+			OptionalIn().
+			OptionalStartsWith().
+			OptionalLimitFrom(),
+	).
+	ShowByIdOperationWithFiltering(g.ShowByIDInFiltering, g.ShowByIDLikeFiltering).
+	CustomOperation(
+		"CreateIndex",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-index",
+		g.NewQueryStruct("CreateHybridTableIndex").
+			Create().
+			OrReplace().
+			SQL("INDEX").
+			IfNotExists().
+			Text("IndexName", g.KeywordOptions().DoubleQuotes().Required()).
+			Identifier("TableName", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("ON").Required()).
+			PredefinedQueryStructField("Columns", "[]string", g.KeywordOptions().Parentheses().Required()).
+			PredefinedQueryStructField("IncludeColumns", "[]string", g.KeywordOptions().Parentheses().SQL("INCLUDE")).
+			WithValidation(g.ValidIdentifier, "TableName").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	CustomOperation(
+		"DropIndex",
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-index",
+		g.NewQueryStruct("DropHybridTableIndex").
+			Drop().
+			SQL("INDEX").
+			IfExists().
+			Identifier("Index", "TableColumnIdentifier", g.IdentifierOptions().Required()).
+			WithValidation(g.ValidIdentifier, "Index"),
+	).
+	CustomShowOperation(
+		"ShowIndexes",
+		g.ShowMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/show-indexes",
+		hybridTableIndexDbRow,
+		hybridTableIndexPlain,
+		g.NewQueryStruct("ShowHybridTableIndexes").
+			Show().
+			SQL("INDEXES").
+			OptionalLike().
+			OptionalIdentifier("In", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("IN TABLE")).
+			WithValidation(g.ValidIdentifierIfSet, "In"),
+	)
@@ -0,0 +1,187 @@
+//go:build sdk_generation
+
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var icebergTableColumn = g.NewQueryStruct("IcebergTableColumn").
+	Text("Name", g.KeywordOptions().DoubleQuotes().Required()).
+	PredefinedQueryStructField("DataType", "DataType", g.KeywordOptions().Required()).
+	OptionalSQL("NOT NULL").
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().NoEquals().SingleQuotes())
+
+var icebergTableRenameColumn = g.NewQueryStruct("IcebergTableRenameColumn").
+	Text("OldName", g.KeywordOptions().DoubleQuotes().Required()).
+	SQL("TO").
+	Text("NewName", g.KeywordOptions().DoubleQuotes().Required())
+
+var icebergTableAlterColumnComment = g.NewQueryStruct("IcebergTableAlterColumnComment").
+	Text("Name", g.KeywordOptions().DoubleQuotes().Required()).
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().NoEquals().SingleQuotes()).
+	OptionalSQL("UNSET COMMENT").
+	WithValidation(g.ExactlyOneValueSet, "Comment", "UnsetComment")
+
+var icebergTableSet = g.NewQueryStruct("IcebergTableSet").
+	OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions().NoQuotes()).
+	OptionalNumberAssignment("MAX_DATA_EXTENSION_TIME_IN_DAYS", g.ParameterOptions().NoQuotes()).
+	OptionalBooleanAssignment("CHANGE_TRACKING", g.ParameterOptions()).
+	OptionalTextAssignment("DEFAULT_DDL_COLLATION", g.ParameterOptions().SingleQuotes()).
+	OptionalBooleanAssignment("REPLACE_INVALID_CHARACTERS", g.ParameterOptions()).
+	OptionalBooleanAssignment("AUTO_REFRESH", g.ParameterOptions()).
+	OptionalTextAssignment("CATALOG_SYNC", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("TARGET_FILE_SIZE", g.ParameterOptions().SingleQuotes()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "ReplaceInvalidCharacters", "AutoRefresh", "CatalogSync", "TargetFileSize", "Comment")
+
+var icebergTableUnset = g.NewQueryStruct("IcebergTableUnset").
+	OptionalSQL("DATA_RETENTION_TIME_IN_DAYS").
+	OptionalSQL("MAX_DATA_EXTENSION_TIME_IN_DAYS").
+	OptionalSQL("CHANGE_TRACKING").
+	OptionalSQL("DEFAULT_DDL_COLLATION").
+	OptionalSQL("REPLACE_INVALID_CHARACTERS").
+	OptionalSQL("CATALOG_SYNC").
+	OptionalSQL("TARGET_FILE_SIZE").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "ReplaceInvalidCharacters", "CatalogSync", "TargetFileSize", "Comment")
+
+var icebergTableRefresh = g.NewQueryStruct("IcebergTableRefresh").
+	OptionalText("RelativePath", g.KeywordOptions().SingleQuotes())
+
+var icebergTableDbRow = g.DbStruct("icebergTableRow").
+	Time("created_on").
+	Text("name").
+	Text("database_name").
+	Text("schema_name").
+	Text("owner").
+	OptionalText("external_volume_name").
+	OptionalText("catalog_name").
+	OptionalText("iceberg_table_type").
+	OptionalText("catalog_table_name").
+	OptionalText("catalog_namespace").
+	OptionalText("base_location").
+	OptionalText("comment").
+	OptionalText("owner_role_type").
+	OptionalText("auto_refresh_status")
+
+var icebergTable = g.PlainStruct("IcebergTable").
+	Time("CreatedOn").
+	Text("Name").
+	Text("DatabaseName").
+	Text("SchemaName").
+	Text("Owner").
+	OptionalText("ExternalVolumeName").
+	OptionalText("CatalogName").
+	OptionalText("IcebergTableType").
+	OptionalText("CatalogTableName").
+	OptionalText("CatalogNamespace").
+	OptionalText("BaseLocation").
+	OptionalText("Comment").
+	OptionalText("OwnerRoleType").
+	OptionalText("AutoRefreshStatus")
+
+var IcebergTablesDef = g.NewInterface(
+	"IcebergTables",
+	"IcebergTable",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table-snowflake",
+		g.NewQueryStruct("CreateIcebergTable").
+			Create().
+			OrReplace().
+			SQL("ICEBERG TABLE").
+			IfNotExists().
+			Name().
+			ListQueryStructField("Columns", icebergTableColumn, g.ListOptions().Parentheses().NoEquals().Required()).
+			PredefinedQueryStructField("ClusterBy", "[]string", g.KeywordOptions().Parentheses().SQL("CLUSTER BY")).
+			OptionalIdentifier("ExternalVolume", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("EXTERNAL_VOLUME")).
+			OptionalTextAssignment("CATALOG", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("BASE_LOCATION", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("CATALOG_SYNC", g.ParameterOptions().SingleQuotes()).
+			OptionalAssignment("STORAGE_SERIALIZATION_POLICY", "StorageSerializationPolicy", g.ParameterOptions().NoQuotes()).
+			OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions().NoQuotes()).
+			OptionalNumberAssignment("MAX_DATA_EXTENSION_TIME_IN_DAYS", g.ParameterOptions().NoQuotes()).
+			OptionalBooleanAssignment("CHANGE_TRACKING", g.ParameterOptions()).
+			OptionalTextAssignment("DEFAULT_DDL_COLLATION", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("TARGET_FILE_SIZE", g.ParameterOptions().SingleQuotes()).
+			OptionalCopyGrants().
+			OptionalComment().
+			OptionalTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "ExternalVolume").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+		icebergTableColumn,
+	).
+	CustomOperation(
+		"CreateFromCatalog",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table",
+		g.NewQueryStruct("CreateIcebergTableFromCatalog").
+			Create().
+			OrReplace().
+			SQL("ICEBERG TABLE").
+			IfNotExists().
+			Name().
+			OptionalIdentifier("ExternalVolume", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("EXTERNAL_VOLUME")).
+			Identifier("Catalog", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("CATALOG").Required()).
+			OptionalTextAssignment("CATALOG_TABLE_NAME", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("CATALOG_NAMESPACE", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("METADATA_FILE_PATH", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("BASE_LOCATION", g.ParameterOptions().SingleQuotes()).
+			OptionalBooleanAssignment("REPLACE_INVALID_CHARACTERS", g.ParameterOptions()).
+			OptionalBooleanAssignment("AUTO_REFRESH", g.ParameterOptions()).
+			OptionalCopyGrants().
+			OptionalComment().
+			OptionalTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "ExternalVolume").
+			WithValidation(g.ValidIdentifier, "Catalog").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+			WithValidation(g.ExactlyOneValueSet, "CatalogTableName", "MetadataFilePath", "BaseLocation"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-iceberg-table",
+		g.NewQueryStruct("AlterIcebergTable").
+			Alter().
+			SQL("ICEBERG TABLE").
+			IfExists().
+			Name().
+			OptionalQueryStructField("Refresh", icebergTableRefresh, g.KeywordOptions().SQL("REFRESH")).
+			OptionalQueryStructField("Set", icebergTableSet, g.ListOptions().NoParentheses().SQL("SET")).
+			OptionalQueryStructField("Unset", icebergTableUnset, g.ListOptions().NoParentheses().SQL("UNSET")).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			OptionalQueryStructField("AddColumn", icebergTableColumn, g.KeywordOptions().SQL("ADD COLUMN")).
+			PredefinedQueryStructField("DropColumns", "[]string", g.KeywordOptions().SQL("DROP COLUMN")).
+			OptionalQueryStructField("RenameColumn", icebergTableRenameColumn, g.KeywordOptions().SQL("RENAME COLUMN")).
+			OptionalQueryStructField("AlterColumnComment", icebergTableAlterColumnComment, g.KeywordOptions().SQL("ALTER COLUMN")).
+			OptionalIdentifier("RenameTo", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "Refresh", "Set", "Unset", "SetTags", "UnsetTags", "AddColumn", "DropColumns", "RenameColumn", "AlterColumnComment", "RenameTo"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-iceberg-table",
+		g.NewQueryStruct("DropIcebergTable").
+			Drop().
+			SQL("ICEBERG TABLE").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-iceberg-tables",
+		icebergTableDbRow,
+		icebergTable,
+		g.NewQueryStruct("ShowIcebergTables").
+			Show().
+			SQL("ICEBERG TABLES").
+			OptionalLike().
+			OptionalIn().
+			OptionalStartsWith().
+			OptionalLimitFrom(),
+	).
+	ShowByIdOperationWithFiltering(g.ShowByIDInFiltering, g.ShowByIDLikeFiltering)
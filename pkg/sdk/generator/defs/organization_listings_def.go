@@ -0,0 +1,77 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var organizationListingWith = g.NewQueryStruct("OrganizationListingWith").
+	OptionalIdentifier("Share", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.IdentifierOptions().SQL("SHARE")).
+	OptionalIdentifier("ApplicationPackage", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.IdentifierOptions().SQL("APPLICATION PACKAGE")).
+	WithValidation(g.ExactlyOneValueSet, "Share", "ApplicationPackage")
+
+// There are more fields listed than in https://docs.snowflake.com/en/sql-reference/sql/show-available-listings.
+// They are mapped straight from the SHOW AVAILABLE LISTINGS IS_ORGANIZATION = TRUE output.
+var organizationListingDbRow = g.DbStruct("organizationListingDBRow").
+	Text("global_name").
+	Text("title").
+	OptionalText("subtitle").
+	OptionalText("profile").
+	OptionalText("created_on").
+	OptionalText("description").
+	OptionalBool("is_imported").
+	OptionalBool("is_ready_for_import").
+	OptionalBool("is_by_request").
+	OptionalBool("is_targeted").
+	OptionalBool("is_mountless_queryable").
+	OptionalText("organization_profile_name").
+	OptionalText("uniform_listing_locator")
+
+var organizationListing = g.PlainStruct("OrganizationListing").
+	Text("GlobalName").
+	Text("Title").
+	OptionalText("Subtitle").
+	OptionalText("Profile").
+	OptionalText("CreatedOn").
+	OptionalText("Description").
+	OptionalBool("IsImported").
+	OptionalBool("IsReadyForImport").
+	OptionalBool("IsByRequest").
+	OptionalBool("IsTargeted").
+	OptionalBool("IsMountlessQueryable").
+	OptionalText("OrganizationProfileName").
+	OptionalText("UniformListingLocator")
+
+// OrganizationListingsDef covers only the organization-specific operations.
+// Organization listings are altered, described, and dropped like any other listing (through the Listings interface).
+var OrganizationListingsDef = g.NewInterface(
+	"OrganizationListings",
+	"OrganizationListing",
+	g.KindOfT[sdkcommons.AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-organization-listing",
+		g.NewQueryStruct("CreateOrganizationListing").
+			Create().
+			SQL("ORGANIZATION LISTING").
+			IfNotExists().
+			Name().
+			OptionalQueryStructField("With", organizationListingWith, g.KeywordOptions()).
+			TextAssignment("AS", g.ParameterOptions().NoEquals().DoubleDollarQuotes().Required()).
+			OptionalBooleanAssignment("PUBLISH", g.ParameterOptions()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-available-listings",
+		organizationListingDbRow,
+		organizationListing,
+		g.NewQueryStruct("ShowOrganizationListings").
+			Show().
+			SQL("AVAILABLE LISTINGS").
+			OptionalLike().
+			OptionalStartsWith().
+			OptionalLimitFrom().
+			SQL("IS_ORGANIZATION = TRUE"),
+	)
@@ -0,0 +1,76 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var organizationProfileDbRow = g.DbStruct("organizationProfileDBRow").
+	Text("name").
+	Time("created_on").
+	OptionalText("title").
+	OptionalText("updated_on").
+	OptionalText("published_on").
+	OptionalText("owner").
+	OptionalText("owner_role_type")
+
+var organizationProfile = g.PlainStruct("OrganizationProfile").
+	Text("Name").
+	Time("CreatedOn").
+	OptionalText("Title").
+	OptionalText("UpdatedOn").
+	OptionalText("PublishedOn").
+	OptionalText("Owner").
+	OptionalText("OwnerRoleType")
+
+var OrganizationProfilesDef = g.NewInterface(
+	"OrganizationProfiles",
+	"OrganizationProfile",
+	g.KindOfT[sdkcommons.AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-organization-profile",
+		g.NewQueryStruct("CreateOrganizationProfile").
+			Create().
+			SQL("ORGANIZATION PROFILE").
+			IfNotExists().
+			Name().
+			TextAssignment("AS", g.ParameterOptions().NoEquals().DoubleDollarQuotes().Required()).
+			OptionalBooleanAssignment("PUBLISH", g.ParameterOptions()).
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-organization-profile",
+		g.NewQueryStruct("AlterOrganizationProfile").
+			Alter().
+			SQL("ORGANIZATION PROFILE").
+			IfExists().
+			Name().
+			OptionalTextAssignment("AS", g.ParameterOptions().NoEquals().DoubleDollarQuotes()).
+			OptionalSQL("PUBLISH").
+			OptionalSQL("UNPUBLISH").
+			OptionalIdentifier("RenameTo", g.KindOfTPointer[sdkcommons.AccountObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "As", "Publish", "Unpublish", "RenameTo"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-organization-profile",
+		g.NewQueryStruct("DropOrganizationProfile").
+			Drop().
+			SQL("ORGANIZATION PROFILE").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-organization-profiles",
+		organizationProfileDbRow,
+		organizationProfile,
+		g.NewQueryStruct("ShowOrganizationProfiles").
+			Show().
+			SQL("ORGANIZATION PROFILES").
+			OptionalLike(),
+	).
+	ShowByIdOperationWithFiltering(g.ShowByIDLikeFiltering)
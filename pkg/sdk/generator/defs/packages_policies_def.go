@@ -0,0 +1,120 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var packagesPolicySet = g.NewQueryStruct("PackagesPolicySet").
+	ListAssignment("ALLOWLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+	ListAssignment("BLOCKLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+	ListAssignment("ADDITIONAL_CREATION_BLOCKLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment")
+
+var packagesPolicyUnset = g.NewQueryStruct("PackagesPolicyUnset").
+	OptionalSQL("ALLOWLIST").
+	OptionalSQL("BLOCKLIST").
+	OptionalSQL("ADDITIONAL_CREATION_BLOCKLIST").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment")
+
+var packagesPolicyDbRow = g.DbStruct("packagesPolicyDBRow").
+	Time("created_on").
+	Text("name").
+	Text("database_name").
+	Text("schema_name").
+	Text("kind").
+	Text("owner").
+	OptionalText("comment").
+	OptionalText("options").
+	OptionalText("owner_role_type")
+
+var packagesPolicy = g.PlainStruct("PackagesPolicy").
+	Time("CreatedOn").
+	Text("Name").
+	Text("DatabaseName").
+	Text("SchemaName").
+	Text("Kind").
+	Text("Owner").
+	Text("Comment").
+	Text("Options").
+	Text("OwnerRoleType")
+
+var PackagesPoliciesDef = g.NewInterface(
+	"PackagesPolicies",
+	"PackagesPolicy",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-packages-policy",
+		g.NewQueryStruct("CreatePackagesPolicy").
+			Create().
+			OrReplace().
+			SQL("PACKAGES POLICY").
+			IfNotExists().
+			Name().
+			SQL("LANGUAGE PYTHON").
+			ListAssignment("ALLOWLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+			ListAssignment("BLOCKLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+			ListAssignment("ADDITIONAL_CREATION_BLOCKLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-packages-policy",
+		g.NewQueryStruct("AlterPackagesPolicy").
+			Alter().
+			SQL("PACKAGES POLICY").
+			IfExists().
+			Name().
+			OptionalQueryStructField("Set", packagesPolicySet, g.ListOptions().NoParentheses().SQL("SET")).
+			OptionalQueryStructField("Unset", packagesPolicyUnset, g.ListOptions().NoParentheses().SQL("UNSET")).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-packages-policy",
+		g.NewQueryStruct("DropPackagesPolicy").
+			Drop().
+			SQL("PACKAGES POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-packages-policies",
+		packagesPolicyDbRow,
+		packagesPolicy,
+		g.NewQueryStruct("ShowPackagesPolicies").
+			Show().
+			SQL("PACKAGES POLICIES").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperationWithFiltering(g.ShowByIDInFiltering, g.ShowByIDLikeFiltering).
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-packages-policy",
+		g.DbStruct("describePackagesPolicyDBRow").
+			Text("name").
+			Text("language").
+			OptionalText("allowlist").
+			OptionalText("blocklist").
+			OptionalText("additional_creation_blocklist").
+			OptionalText("comment"),
+		g.PlainStruct("PackagesPolicyDescription").
+			Text("Name").
+			Text("Language").
+			Field("Allowlist", "[]string").
+			Field("Blocklist", "[]string").
+			Field("AdditionalCreationBlocklist", "[]string").
+			Text("Comment"),
+		g.NewQueryStruct("DescribePackagesPolicy").
+			Describe().
+			SQL("PACKAGES POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
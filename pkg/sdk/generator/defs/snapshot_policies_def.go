@@ -0,0 +1,98 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var snapshotPolicySet = g.NewQueryStruct("SnapshotPolicySet").
+	OptionalTextAssignment("SCHEDULE", g.ParameterOptions().SingleQuotes()).
+	OptionalNumberAssignment("EXPIRE_AFTER_DAYS", g.ParameterOptions().NoQuotes()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "Schedule", "ExpireAfterDays", "Comment")
+
+var snapshotPolicyUnset = g.NewQueryStruct("SnapshotPolicyUnset").
+	OptionalSQL("SCHEDULE").
+	OptionalSQL("EXPIRE_AFTER_DAYS").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Schedule", "ExpireAfterDays", "Comment")
+
+var snapshotPolicyDbRow = g.DbStruct("snapshotPolicyRow").
+	Time("created_on").
+	Text("name").
+	Text("database_name").
+	Text("schema_name").
+	Text("owner").
+	OptionalText("comment").
+	OptionalText("schedule").
+	OptionalNumber("expire_after_days").
+	OptionalBool("has_retention_lock").
+	OptionalText("owner_role_type")
+
+var snapshotPolicy = g.PlainStruct("SnapshotPolicy").
+	Time("CreatedOn").
+	Text("Name").
+	Text("DatabaseName").
+	Text("SchemaName").
+	Text("Owner").
+	Text("Comment").
+	OptionalText("Schedule").
+	OptionalNumber("ExpireAfterDays").
+	OptionalBool("HasRetentionLock").
+	Text("OwnerRoleType")
+
+var SnapshotPoliciesDef = g.NewInterface(
+	"SnapshotPolicies",
+	"SnapshotPolicy",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-snapshot-policy",
+		g.NewQueryStruct("CreateSnapshotPolicy").
+			Create().
+			OrReplace().
+			SQL("SNAPSHOT POLICY").
+			IfNotExists().
+			Name().
+			OptionalSQL("WITH RETENTION LOCK").
+			OptionalTextAssignment("SCHEDULE", g.ParameterOptions().SingleQuotes()).
+			OptionalNumberAssignment("EXPIRE_AFTER_DAYS", g.ParameterOptions().NoQuotes()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-snapshot-policy",
+		g.NewQueryStruct("AlterSnapshotPolicy").
+			Alter().
+			SQL("SNAPSHOT POLICY").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalQueryStructField("Set", snapshotPolicySet, g.ListOptions().NoParentheses().SQL("SET")).
+			OptionalQueryStructField("Unset", snapshotPolicyUnset, g.ListOptions().NoParentheses().SQL("UNSET")).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "Unset"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-snapshot-policy",
+		g.NewQueryStruct("DropSnapshotPolicy").
+			Drop().
+			SQL("SNAPSHOT POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-snapshot-policies",
+		snapshotPolicyDbRow,
+		snapshotPolicy,
+		g.NewQueryStruct("ShowSnapshotPolicies").
+			Show().
+			SQL("SNAPSHOT POLICIES").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperationWithFiltering(g.ShowByIDInFiltering, g.ShowByIDLikeFiltering)
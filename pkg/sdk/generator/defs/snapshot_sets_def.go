@@ -0,0 +1,138 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var snapshotSetTarget = g.NewQueryStruct("SnapshotSetTarget").
+	OptionalIdentifier("Database", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.IdentifierOptions().SQL("DATABASE")).
+	OptionalIdentifier("Schema", g.KindOfT[sdkcommons.DatabaseObjectIdentifier](), g.IdentifierOptions().SQL("SCHEMA")).
+	OptionalIdentifier("Table", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("TABLE")).
+	WithValidation(g.ValidIdentifierIfSet, "Database").
+	WithValidation(g.ValidIdentifierIfSet, "Schema").
+	WithValidation(g.ValidIdentifierIfSet, "Table").
+	WithValidation(g.ExactlyOneValueSet, "Database", "Schema", "Table")
+
+var snapshotSetDbRow = g.DbStruct("snapshotSetRow").
+	Time("created_on").
+	Text("name").
+	Text("database_name").
+	Text("schema_name").
+	Text("object_kind").
+	Text("object_name").
+	OptionalText("object_database_name").
+	OptionalText("object_schema_name").
+	OptionalText("snapshot_policy_name").
+	OptionalText("snapshot_policy_database_name").
+	OptionalText("snapshot_policy_schema_name").
+	OptionalText("snapshot_policy_status").
+	Text("owner").
+	OptionalText("comment").
+	OptionalText("owner_role_type")
+
+var snapshotSet = g.PlainStruct("SnapshotSet").
+	Time("CreatedOn").
+	Text("Name").
+	Text("DatabaseName").
+	Text("SchemaName").
+	Text("ObjectKind").
+	Text("ObjectName").
+	OptionalText("ObjectDatabaseName").
+	OptionalText("ObjectSchemaName").
+	Field("SnapshotPolicy", "*SchemaObjectIdentifier").
+	OptionalText("SnapshotPolicyStatus").
+	Text("Owner").
+	Text("Comment").
+	Text("OwnerRoleType")
+
+var snapshotDbRow = g.DbStruct("snapshotRow").
+	Time("created_on").
+	Text("snapshot_id").
+	Text("snapshot_set_name").
+	Text("database_name").
+	Text("schema_name").
+	OptionalTime("expire_on").
+	OptionalBool("is_under_legal_hold")
+
+var snapshotPlain = g.PlainStruct("Snapshot").
+	Time("CreatedOn").
+	Text("SnapshotId").
+	Text("SnapshotSetName").
+	Text("DatabaseName").
+	Text("SchemaName").
+	OptionalTime("ExpireOn").
+	OptionalBool("IsUnderLegalHold")
+
+var SnapshotSetsDef = g.NewInterface(
+	"SnapshotSets",
+	"SnapshotSet",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-snapshot-set",
+		g.NewQueryStruct("CreateSnapshotSet").
+			Create().
+			OrReplace().
+			SQL("SNAPSHOT SET").
+			IfNotExists().
+			Name().
+			QueryStructField("Target", snapshotSetTarget, g.KeywordOptions().SQL("FOR").Required()).
+			OptionalIdentifier("SnapshotPolicy", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("WITH SNAPSHOT POLICY")).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "SnapshotPolicy").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-snapshot-set",
+		g.NewQueryStruct("AlterSnapshotSet").
+			Alter().
+			SQL("SNAPSHOT SET").
+			IfExists().
+			Name().
+			OptionalIdentifier("ApplySnapshotPolicy", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("APPLY SNAPSHOT POLICY")).
+			OptionalSQL("SUSPEND SNAPSHOT POLICY").
+			OptionalSQL("RESUME SNAPSHOT POLICY").
+			OptionalSQL("ADD SNAPSHOT").
+			OptionalTextAssignment("DELETE SNAPSHOT IDENTIFIER", g.ParameterOptions().NoEquals().SingleQuotes()).
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "ApplySnapshotPolicy").
+			WithValidation(g.ExactlyOneValueSet, "ApplySnapshotPolicy", "SuspendSnapshotPolicy", "ResumeSnapshotPolicy", "AddSnapshot", "DeleteSnapshotIdentifier", "SetComment", "UnsetComment"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-snapshot-set",
+		g.NewQueryStruct("DropSnapshotSet").
+			Drop().
+			SQL("SNAPSHOT SET").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-snapshot-sets",
+		snapshotSetDbRow,
+		snapshotSet,
+		g.NewQueryStruct("ShowSnapshotSets").
+			Show().
+			SQL("SNAPSHOT SETS").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperationWithFiltering(g.ShowByIDInFiltering, g.ShowByIDLikeFiltering).
+	CustomShowOperation(
+		"ShowSnapshots",
+		g.ShowMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/show-snapshots",
+		snapshotDbRow,
+		snapshotPlain,
+		g.NewQueryStruct("ShowSnapshots").
+			Show().
+			SQL("SNAPSHOTS").
+			OptionalLike().
+			OptionalIdentifier("In", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("IN SNAPSHOT SET")).
+			WithValidation(g.ValidIdentifierIfSet, "In"),
+	)
@@ -0,0 +1,131 @@
+//go:build sdk_generation
+
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var storageLifecyclePolicyArg = g.NewQueryStruct("StorageLifecyclePolicyArg").
+	Text("Name", g.KeywordOptions().DoubleQuotes().Required()).
+	PredefinedQueryStructField("DataType", "datatypes.DataType", g.ParameterOptions().NoEquals().Required())
+
+var storageLifecyclePolicySet = g.NewQueryStruct("StorageLifecyclePolicySet").
+	OptionalNumberAssignment("ARCHIVE_FOR_DAYS", g.ParameterOptions().NoQuotes()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "ArchiveForDays", "Comment")
+
+var storageLifecyclePolicyUnset = g.NewQueryStruct("StorageLifecyclePolicyUnset").
+	OptionalSQL("ARCHIVE_FOR_DAYS").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "ArchiveForDays", "Comment")
+
+var storageLifecyclePolicyDbRow = g.DbStruct("storageLifecyclePolicyDBRow").
+	Time("created_on").
+	Text("name").
+	Text("database_name").
+	Text("schema_name").
+	Text("kind").
+	Text("owner").
+	OptionalText("comment").
+	Text("options").
+	OptionalText("owner_role_type")
+
+var storageLifecyclePolicy = g.PlainStruct("StorageLifecyclePolicy").
+	Time("CreatedOn").
+	Text("Name").
+	Text("DatabaseName").
+	Text("SchemaName").
+	Text("Kind").
+	Text("Owner").
+	Text("Comment").
+	Text("Options").
+	Text("OwnerRoleType")
+
+var StorageLifecyclePoliciesDef = g.NewInterface(
+	"StorageLifecyclePolicies",
+	"StorageLifecyclePolicy",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-storage-lifecycle-policy",
+		g.NewQueryStruct("CreateStorageLifecyclePolicy").
+			Create().
+			OrReplace().
+			SQL("STORAGE LIFECYCLE POLICY").
+			IfNotExists().
+			Name().
+			SQL("AS").
+			ListQueryStructField("Args", storageLifecyclePolicyArg, g.ParameterOptions().Parentheses().NoEquals().Required()).
+			SQL("RETURNS BOOLEAN").
+			BodyWithPrecedingArrow().
+			OptionalAssignment("ARCHIVE_TIER", "StorageLifecyclePolicyArchiveTier", g.ParameterOptions().NoQuotes()).
+			OptionalNumberAssignment("ARCHIVE_FOR_DAYS", g.ParameterOptions().NoQuotes()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "Args").
+			WithValidation(g.ValidateValueSet, "body").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-storage-lifecycle-policy",
+		g.NewQueryStruct("AlterStorageLifecyclePolicy").
+			Alter().
+			SQL("STORAGE LIFECYCLE POLICY").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalSetBodyWithPrecedingArrow().
+			OptionalQueryStructField("Set", storageLifecyclePolicySet, g.ListOptions().NoParentheses().SQL("SET")).
+			OptionalQueryStructField("Unset", storageLifecyclePolicyUnset, g.ListOptions().NoParentheses().SQL("UNSET")).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetBody", "Set", "Unset", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-storage-lifecycle-policy",
+		g.NewQueryStruct("DropStorageLifecyclePolicy").
+			Drop().
+			SQL("STORAGE LIFECYCLE POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-storage-lifecycle-policies",
+		storageLifecyclePolicyDbRow,
+		storageLifecyclePolicy,
+		g.NewQueryStruct("ShowStorageLifecyclePolicies").
+			Show().
+			SQL("STORAGE LIFECYCLE POLICIES").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperationWithFiltering(g.ShowByIDInFiltering, g.ShowByIDLikeFiltering).
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-storage-lifecycle-policy",
+		g.DbStruct("describeStorageLifecyclePolicyDBRow").
+			Text("name").
+			Text("signature").
+			Text("return_type").
+			Text("body").
+			OptionalText("archive_tier").
+			OptionalNumber("archive_for_days"),
+		g.PlainStruct("StorageLifecyclePolicyDescription").
+			Text("Name").
+			Field("Signature", "[]TableColumnSignature").
+			Text("ReturnType").
+			Text("Body").
+			Field("ArchiveTier", "*StorageLifecyclePolicyArchiveTier").
+			OptionalNumber("ArchiveForDays"),
+		g.NewQueryStruct("DescribeStorageLifecyclePolicy").
+			Describe().
+			SQL("STORAGE LIFECYCLE POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,37 @@
+package sdk
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+func (r *CreateHybridTableRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+type HybridTableConstraintType string
+
+const (
+	HybridTableConstraintTypePrimaryKey HybridTableConstraintType = "PRIMARY KEY"
+	HybridTableConstraintTypeUnique     HybridTableConstraintType = "UNIQUE"
+	HybridTableConstraintTypeForeignKey HybridTableConstraintType = "FOREIGN KEY"
+)
+
+var AllHybridTableConstraintTypes = []HybridTableConstraintType{
+	HybridTableConstraintTypePrimaryKey,
+	HybridTableConstraintTypeUnique,
+	HybridTableConstraintTypeForeignKey,
+}
+
+func ToHybridTableConstraintType(s string) (HybridTableConstraintType, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllHybridTableConstraintTypes, HybridTableConstraintType(s)) {
+		return "", fmt.Errorf("invalid hybrid table constraint type: %s", s)
+	}
+	return HybridTableConstraintType(s), nil
+}
+
+func (v *HybridTableIndex) ID() TableColumnIdentifier {
+	return NewTableColumnIdentifier(v.DatabaseName, v.SchemaName, v.TableName, v.Name)
+}
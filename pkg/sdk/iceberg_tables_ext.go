@@ -0,0 +1,9 @@
+package sdk
+
+func (r *CreateIcebergTableRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+func (r *CreateFromCatalogIcebergTableRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
@@ -86,7 +86,10 @@ const (
 	ObjectTypeModelMonitor           ObjectType = "MODEL MONITOR"
 	ObjectTypeService                ObjectType = "SERVICE"
 	ObjectTypeStorageIntegration     ObjectType = "STORAGE INTEGRATION"
+	ObjectTypeCatalogIntegration     ObjectType = "CATALOG INTEGRATION"
 	ObjectTypeListing                ObjectType = "LISTING"
+	ObjectTypeOrganizationListing    ObjectType = "ORGANIZATION LISTING"
+	ObjectTypeOrganizationProfile    ObjectType = "ORGANIZATION PROFILE"
 	ObjectTypeSemanticView           ObjectType = "SEMANTIC VIEW"
 	ObjectTypeOnlineFeatureTable     ObjectType = "ONLINE FEATURE TABLE"
 	ObjectTypeExperiment             ObjectType = "EXPERIMENT"
@@ -98,6 +101,9 @@ const (
 	// ObjectTypeSecurityIntegration is a pseudo-object, only used in object and invoke action assertions.
 	// For actual Snowflake operations where object type is needed, ObjectTypeIntegration should be used.
 	ObjectTypeSecurityIntegration ObjectType = "SECURITY INTEGRATION"
+	// ObjectTypeExternalAccessIntegration is a pseudo-object, similarly to ObjectTypeSecurityIntegration.
+	// For actual Snowflake operations where object type is needed, ObjectTypeIntegration should be used.
+	ObjectTypeExternalAccessIntegration ObjectType = "EXTERNAL ACCESS INTEGRATION"
 )
 
 func (o ObjectType) String() string {
@@ -183,6 +189,7 @@ var allObjectTypes = []ObjectType{
 	ObjectTypeService,
 	ObjectTypeListing,
 	ObjectTypeStorageIntegration,
+	ObjectTypeCatalogIntegration,
 	ObjectTypeStorageLifecyclePolicy,
 	ObjectTypeWorkspace,
 	ObjectTypeProgrammaticAccessToken,
@@ -272,6 +279,7 @@ func objectTypeSingularToPluralMap() map[ObjectType]PluralObjectType {
 		ObjectTypeService:                 PluralObjectTypeServices,
 		ObjectTypeProgrammaticAccessToken: PluralObjectTypeProgrammaticAccessTokens,
 		ObjectTypeStorageIntegration:      PluralObjectTypeStorageIntegrations,
+		ObjectTypeCatalogIntegration:      PluralObjectTypeCatalogIntegrations,
 		ObjectTypeStorageLifecyclePolicy:  PluralObjectTypeStorageLifecyclePolicies,
 		ObjectTypeWorkspace:               PluralObjectTypeWorkspaces,
 	}
@@ -395,6 +403,7 @@ const (
 	PluralObjectTypeServices                 PluralObjectType = "SERVICES"
 	PluralObjectTypeProgrammaticAccessTokens PluralObjectType = "PROGRAMMATIC ACCESS TOKENS" //nolint:gosec
 	PluralObjectTypeStorageIntegrations      PluralObjectType = "STORAGE INTEGRATIONS"
+	PluralObjectTypeCatalogIntegrations      PluralObjectType = "CATALOG INTEGRATIONS"
 	PluralObjectTypeWorkspaces               PluralObjectType = "WORKSPACES"
 	PluralObjectTypeStorageLifecyclePolicies PluralObjectType = "STORAGE LIFECYCLE POLICIES"
 )
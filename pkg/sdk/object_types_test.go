@@ -84,6 +84,7 @@ func Test_ToObjectType(t *testing.T) {
 		{input: "PROGRAMMATIC ACCESS TOKEN", want: ObjectTypeProgrammaticAccessToken},
 		{input: "SEMANTIC VIEW", want: ObjectTypeSemanticView},
 		{input: "STORAGE INTEGRATION", want: ObjectTypeStorageIntegration},
+		{input: "CATALOG INTEGRATION", want: ObjectTypeCatalogIntegration},
 		{input: "LISTING", want: ObjectTypeListing},
 		{input: "ONLINE FEATURE TABLE", want: ObjectTypeOnlineFeatureTable},
 		{input: "EXPERIMENT", want: ObjectTypeExperiment},
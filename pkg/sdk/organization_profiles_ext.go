@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateOrganizationProfileRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
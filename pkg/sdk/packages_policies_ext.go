@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreatePackagesPolicyRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
@@ -980,6 +980,18 @@ const (
 	WarehouseParameterStatementTimeoutInSeconds       WarehouseParameter = "STATEMENT_TIMEOUT_IN_SECONDS"
 )
 
+type DynamicTableParameter string
+
+const (
+	DynamicTableParameterDataRetentionTimeInDays    DynamicTableParameter = "DATA_RETENTION_TIME_IN_DAYS"
+	DynamicTableParameterMaxDataExtensionTimeInDays DynamicTableParameter = "MAX_DATA_EXTENSION_TIME_IN_DAYS"
+)
+
+var AllDynamicTableParameters = []DynamicTableParameter{
+	DynamicTableParameterDataRetentionTimeInDays,
+	DynamicTableParameterMaxDataExtensionTimeInDays,
+}
+
 var AllSchemaParameters = []ObjectParameter{
 	ObjectParameterDataRetentionTimeInDays,
 	ObjectParameterMaxDataExtensionTimeInDays,
@@ -2003,6 +2015,7 @@ const (
 	ParameterTypeDatabase         ParameterType = "DATABASE"
 	ParameterTypeSchema           ParameterType = "SCHEMA"
 	ParameterTypeTask             ParameterType = "TASK"
+	ParameterTypeTable            ParameterType = "TABLE"
 	ParameterTypeFunction         ParameterType = "FUNCTION"
 	ParameterTypeProcedure        ParameterType = "PROCEDURE"
 )
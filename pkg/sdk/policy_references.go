@@ -69,15 +69,17 @@ type policyReferenceFunctionArguments struct {
 type PolicyKind string
 
 const (
-	PolicyKindAggregationPolicy    PolicyKind = "AGGREGATION_POLICY"
-	PolicyKindAuthenticationPolicy PolicyKind = "AUTHENTICATION_POLICY"
-	PolicyKindFeaturePolicy        PolicyKind = "FEATURE_POLICY"
-	PolicyKindMaskingPolicy        PolicyKind = "MASKING_POLICY"
-	PolicyKindPackagesPolicy       PolicyKind = "PACKAGES_POLICY"
-	PolicyKindPasswordPolicy       PolicyKind = "PASSWORD_POLICY"
-	PolicyKindProjectionPolicy     PolicyKind = "PROJECTION_POLICY"
-	PolicyKindRowAccessPolicy      PolicyKind = "ROW_ACCESS_POLICY"
-	PolicyKindSessionPolicy        PolicyKind = "SESSION_POLICY"
+	PolicyKindAggregationPolicy      PolicyKind = "AGGREGATION_POLICY"
+	PolicyKindAuthenticationPolicy   PolicyKind = "AUTHENTICATION_POLICY"
+	PolicyKindFeaturePolicy          PolicyKind = "FEATURE_POLICY"
+	PolicyKindMaskingPolicy          PolicyKind = "MASKING_POLICY"
+	PolicyKindPackagesPolicy         PolicyKind = "PACKAGES_POLICY"
+	PolicyKindPasswordPolicy         PolicyKind = "PASSWORD_POLICY"
+	PolicyKindPrivacyPolicy          PolicyKind = "PRIVACY_POLICY"
+	PolicyKindProjectionPolicy       PolicyKind = "PROJECTION_POLICY"
+	PolicyKindRowAccessPolicy        PolicyKind = "ROW_ACCESS_POLICY"
+	PolicyKindSessionPolicy          PolicyKind = "SESSION_POLICY"
+	PolicyKindStorageLifecyclePolicy PolicyKind = "STORAGE_LIFECYCLE_POLICY"
 )
 
 type PolicyReference struct {
@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreatePrivacyPolicyRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
@@ -28,6 +28,7 @@ var (
 	dataTypeChar_100, _                   = datatypes.ParseDataType("CHAR(100)")
 	dataTypeDoublePrecision, _            = datatypes.ParseDataType("DOUBLE PRECISION")
 	dataTypeTimestampWithoutTimeZone_5, _ = datatypes.ParseDataType("TIMESTAMP WITHOUT TIME ZONE(5)")
+	dataTypeTimestampNTZ, _               = datatypes.ParseDataType("TIMESTAMP_NTZ")
 )
 
 func randomSchemaObjectIdentifierWithArguments(argumentDataTypes ...DataType) SchemaObjectIdentifierWithArguments {
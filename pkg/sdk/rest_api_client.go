@@ -0,0 +1,365 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+const (
+	restApiTokenTypeOAuth                   = "OAUTH"
+	restApiTokenTypeKeyPairJwt              = "KEYPAIR_JWT"
+	restApiTokenTypeProgrammaticAccessToken = "PROGRAMMATIC_ACCESS_TOKEN"
+
+	restApiJwtLifetime        = time.Hour
+	restApiJwtRefreshInterval = 5 * time.Minute
+)
+
+// restApiClient is a thin client for the Snowflake REST API (https://docs.snowflake.com/en/developer-guide/snowflake-rest-api/snowflake-rest-api).
+// It reuses the authentication configured for the driver; only the key-pair, OAuth, and programmatic access token authenticators are supported.
+type restApiClient struct {
+	httpClient    *http.Client
+	baseUrl       *url.URL
+	authenticator restApiAuthenticator
+}
+
+type restApiAuthenticator interface {
+	// token returns the token and its type passed in the X-Snowflake-Authorization-Token-Type header.
+	token() (string, string, error)
+}
+
+func newRestApiClient(cfg *gosnowflake.Config) (*restApiClient, error) {
+	if cfg == nil {
+		return nil, errors.Join(ErrNilOptions)
+	}
+	authenticator, err := newRestApiAuthenticator(cfg)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := &http.Client{}
+	if cfg.Transporter != nil {
+		httpClient.Transport = cfg.Transporter
+	}
+	return newRestApiClientForUrl(restApiBaseUrl(cfg), authenticator, httpClient)
+}
+
+func newRestApiClientForUrl(baseUrl string, authenticator restApiAuthenticator, httpClient *http.Client) (*restApiClient, error) {
+	parsedUrl, err := url.Parse(baseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid REST API url %s: %w", baseUrl, err)
+	}
+	return &restApiClient{
+		httpClient:    httpClient,
+		baseUrl:       parsedUrl.JoinPath("api", "v2"),
+		authenticator: authenticator,
+	}, nil
+}
+
+func restApiBaseUrl(cfg *gosnowflake.Config) string {
+	protocol := cfg.Protocol
+	if protocol == "" {
+		protocol = "https"
+	}
+	host := cfg.Host
+	if host == "" {
+		host = fmt.Sprintf("%s.snowflakecomputing.com", cfg.Account)
+	}
+	if cfg.Port != 0 && cfg.Port != 443 {
+		host = fmt.Sprintf("%s:%d", host, cfg.Port)
+	}
+	return fmt.Sprintf("%s://%s", protocol, host)
+}
+
+func newRestApiAuthenticator(cfg *gosnowflake.Config) (restApiAuthenticator, error) {
+	switch cfg.Authenticator {
+	case gosnowflake.AuthTypeOAuth:
+		return &restApiTokenAuthenticator{value: cfg.Token, tokenType: restApiTokenTypeOAuth}, nil
+	case gosnowflake.AuthTypePat:
+		return &restApiTokenAuthenticator{value: cfg.Token, tokenType: restApiTokenTypeProgrammaticAccessToken}, nil
+	case gosnowflake.AuthTypeJwt:
+		if cfg.PrivateKey == nil {
+			return nil, errors.New("key-pair authentication for the REST API requires a private key")
+		}
+		return &restApiKeyPairAuthenticator{account: cfg.Account, user: cfg.User, privateKey: cfg.PrivateKey}, nil
+	default:
+		return nil, fmt.Errorf("authenticator %s is not supported by the REST API, use one of: %s, %s, %s", cfg.Authenticator, gosnowflake.AuthTypeJwt, gosnowflake.AuthTypeOAuth, gosnowflake.AuthTypePat)
+	}
+}
+
+type restApiTokenAuthenticator struct {
+	value     string
+	tokenType string
+}
+
+func (a *restApiTokenAuthenticator) token() (string, string, error) {
+	if a.value == "" {
+		return "", "", errors.New("token for the REST API is empty")
+	}
+	return a.value, a.tokenType, nil
+}
+
+// restApiKeyPairAuthenticator generates JWTs the same way the driver does for the SNOWFLAKE_JWT authenticator (https://docs.snowflake.com/en/developer-guide/sql-api/authenticating#using-key-pair-authentication).
+type restApiKeyPairAuthenticator struct {
+	account    string
+	user       string
+	privateKey *rsa.PrivateKey
+	now        func() time.Time
+
+	mu        sync.Mutex
+	cached    string
+	expiresAt time.Time
+}
+
+func (a *restApiKeyPairAuthenticator) token() (string, string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now().UTC()
+	if a.now != nil {
+		now = a.now().UTC()
+	}
+	if a.cached != "" && now.Add(restApiJwtRefreshInterval).Before(a.expiresAt) {
+		return a.cached, restApiTokenTypeKeyPairJwt, nil
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(a.privateKey.Public())
+	if err != nil {
+		return "", "", err
+	}
+	fingerprint := sha256.Sum256(publicKey)
+
+	qualifiedUsername := fmt.Sprintf("%s.%s", restApiJwtAccountName(a.account), strings.ToUpper(a.user))
+	expiresAt := now.Add(restApiJwtLifetime)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss": fmt.Sprintf("%s.SHA256:%s", qualifiedUsername, base64.StdEncoding.EncodeToString(fingerprint[:])),
+		"sub": qualifiedUsername,
+		"iat": now.Unix(),
+		"exp": expiresAt.Unix(),
+	}).SignedString(a.privateKey)
+	if err != nil {
+		return "", "", fmt.Errorf("signing JWT for the REST API: %w", err)
+	}
+	a.cached = token
+	a.expiresAt = expiresAt
+	return token, restApiTokenTypeKeyPairJwt, nil
+}
+
+// restApiJwtAccountName drops the region and cloud parts of the legacy account locator, e.g. xy12345.us-east-2.aws -> XY12345.
+func restApiJwtAccountName(account string) string {
+	account, _, _ = strings.Cut(account, ".")
+	return strings.ToUpper(account)
+}
+
+// RestApiError is returned for non-successful responses of the REST API. It is based on the ErrorResponse schema.
+type RestApiError struct {
+	StatusCode int
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	RequestId  string `json:"request_id"`
+}
+
+func (e *RestApiError) Error() string {
+	return fmt.Sprintf("REST API request failed with status %d (code: %s, request id: %s): %s", e.StatusCode, e.Code, e.RequestId, e.Message)
+}
+
+// Is makes the not found responses interchangeable with the errors returned by the driver for missing objects.
+func (e *RestApiError) Is(target error) bool {
+	return e.StatusCode == http.StatusNotFound && errors.Is(target, ErrObjectNotExistOrAuthorized)
+}
+
+type restApiSuccessResponse struct {
+	Status string `json:"status"`
+}
+
+func (c *restApiClient) get(ctx context.Context, path []string, query url.Values, result any) error {
+	return c.do(ctx, http.MethodGet, path, query, nil, result)
+}
+
+func (c *restApiClient) post(ctx context.Context, path []string, query url.Values, body any) error {
+	return c.do(ctx, http.MethodPost, path, query, body, &restApiSuccessResponse{})
+}
+
+func (c *restApiClient) delete(ctx context.Context, path []string, query url.Values) error {
+	return c.do(ctx, http.MethodDelete, path, query, nil, &restApiSuccessResponse{})
+}
+
+func (c *restApiClient) do(ctx context.Context, method string, path []string, query url.Values, body any, result any) error {
+	requestUrl := c.resourceUrl(path...)
+	if len(query) > 0 {
+		requestUrl.RawQuery = query.Encode()
+	}
+
+	var requestBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding REST API request body: %w", err)
+		}
+		requestBody = bytes.NewReader(encoded)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, requestUrl.String(), requestBody)
+	if err != nil {
+		return err
+	}
+	token, tokenType, err := c.authenticator.token()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	request.Header.Set("X-Snowflake-Authorization-Token-Type", tokenType)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+
+	log.Printf("[DEBUG] REST API request: %s %s", method, requestUrl.Redacted())
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("sending REST API request: %w", err)
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("reading REST API response: %w", err)
+	}
+	switch {
+	case response.StatusCode == http.StatusAccepted:
+		// TODO [next PRs]: poll the returned location for long-running operations
+		return fmt.Errorf("REST API request %s %s was accepted but did not complete synchronously; asynchronous results are not supported yet", method, requestUrl.Path)
+	case response.StatusCode < 200 || response.StatusCode > 299:
+		apiErr := &RestApiError{StatusCode: response.StatusCode}
+		if err := json.Unmarshal(responseBody, apiErr); err != nil {
+			apiErr.Message = strings.TrimSpace(string(responseBody))
+		}
+		return apiErr
+	}
+	if result == nil || len(responseBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(responseBody, result); err != nil {
+		return fmt.Errorf("decoding REST API response: %w", err)
+	}
+	return nil
+}
+
+// resourceUrl escapes every path segment separately, so that the quoted identifiers containing special characters (like a slash) are passed as a single segment.
+func (c *restApiClient) resourceUrl(path ...string) *url.URL {
+	resourceUrl := *c.baseUrl
+	escaped := make([]string, len(path))
+	for i, segment := range path {
+		escaped[i] = url.PathEscape(segment)
+	}
+	resourceUrl.Path = strings.Join(append([]string{c.baseUrl.Path}, path...), "/")
+	resourceUrl.RawPath = strings.Join(append([]string{c.baseUrl.EscapedPath()}, escaped...), "/")
+	return &resourceUrl
+}
+
+// restApiIdentifier returns the name in the form expected by the Identifier schema of the REST API.
+// Names are always quoted, so they are treated case-sensitively the same way as in the SQL.
+func restApiIdentifier(name string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(name, `"`, `""`))
+}
+
+// restApiParseIdentifier reverts restApiIdentifier; unquoted names are returned as-is.
+func restApiParseIdentifier(name string) string {
+	if len(name) >= 2 && strings.HasPrefix(name, `"`) && strings.HasSuffix(name, `"`) {
+		return strings.ReplaceAll(name[1:len(name)-1], `""`, `"`)
+	}
+	return name
+}
+
+func restApiIsNotFound(err error) bool {
+	var apiErr *RestApiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// restApiNotFoundToObjectNotFound aligns the errors of fetching a single object with the ShowByID contract of the SQL implementations.
+func restApiNotFoundToObjectNotFound(err error) error {
+	if restApiIsNotFound(err) {
+		return errors.Join(ErrObjectNotFound, err)
+	}
+	return err
+}
+
+func restApiValue[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+func restApiString[T ~string](v *T) *string {
+	if v == nil {
+		return nil
+	}
+	return String(string(*v))
+}
+
+// restApiBool handles the boolean properties that are represented as "true"/"false" strings in the REST API.
+func restApiBool(v *bool) *string {
+	if v == nil {
+		return nil
+	}
+	return String(fmt.Sprintf("%t", *v))
+}
+
+func restApiParseBool(v *string) bool {
+	return v != nil && strings.EqualFold(*v, "true")
+}
+
+func restApiCreateMode(orReplace *bool, ifNotExists *bool) url.Values {
+	query := url.Values{}
+	switch {
+	case orReplace != nil && *orReplace:
+		query.Set("createMode", "orReplace")
+	case ifNotExists != nil && *ifNotExists:
+		query.Set("createMode", "ifNotExists")
+	default:
+		query.Set("createMode", "errorIfExists")
+	}
+	return query
+}
+
+func restApiIfExists(ifExists *bool) url.Values {
+	query := url.Values{}
+	if ifExists != nil && *ifExists {
+		query.Set("ifExists", "true")
+	}
+	return query
+}
+
+// restApiShowQuery translates the common SHOW filters into the query parameters of the list endpoints.
+func restApiShowQuery(like *Like, startsWith *string, limitFrom *LimitFrom) url.Values {
+	query := url.Values{}
+	if like != nil && like.Pattern != nil {
+		query.Set("like", *like.Pattern)
+	}
+	if startsWith != nil {
+		query.Set("startsWith", *startsWith)
+	}
+	if limitFrom != nil {
+		if limitFrom.Rows != nil {
+			query.Set("showLimit", fmt.Sprintf("%d", *limitFrom.Rows))
+		}
+		if limitFrom.From != nil {
+			query.Set("fromName", *limitFrom.From)
+		}
+	}
+	return query
+}
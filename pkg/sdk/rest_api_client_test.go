@@ -0,0 +1,225 @@
+package sdk
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_restApiBaseUrl(t *testing.T) {
+	testCases := []struct {
+		config   *gosnowflake.Config
+		expected string
+	}{
+		{config: &gosnowflake.Config{Account: "org-account"}, expected: "https://org-account.snowflakecomputing.com"},
+		{config: &gosnowflake.Config{Account: "org-account", Host: "org-account.privatelink.snowflakecomputing.com"}, expected: "https://org-account.privatelink.snowflakecomputing.com"},
+		{config: &gosnowflake.Config{Account: "org-account", Host: "localhost", Port: 8080, Protocol: "http"}, expected: "http://localhost:8080"},
+		{config: &gosnowflake.Config{Account: "org-account", Port: 443}, expected: "https://org-account.snowflakecomputing.com"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			assert.Equal(t, tc.expected, restApiBaseUrl(tc.config))
+		})
+	}
+}
+
+func Test_newRestApiAuthenticator(t *testing.T) {
+	privateKey := generateRestApiTestPrivateKey(t)
+
+	t.Run("oauth", func(t *testing.T) {
+		authenticator, err := newRestApiAuthenticator(&gosnowflake.Config{Authenticator: gosnowflake.AuthTypeOAuth, Token: "oauth-token"})
+		require.NoError(t, err)
+
+		token, tokenType, err := authenticator.token()
+		require.NoError(t, err)
+		assert.Equal(t, "oauth-token", token)
+		assert.Equal(t, "OAUTH", tokenType)
+	})
+
+	t.Run("programmatic access token", func(t *testing.T) {
+		authenticator, err := newRestApiAuthenticator(&gosnowflake.Config{Authenticator: gosnowflake.AuthTypePat, Token: "pat"})
+		require.NoError(t, err)
+
+		token, tokenType, err := authenticator.token()
+		require.NoError(t, err)
+		assert.Equal(t, "pat", token)
+		assert.Equal(t, "PROGRAMMATIC_ACCESS_TOKEN", tokenType)
+	})
+
+	t.Run("key-pair", func(t *testing.T) {
+		authenticator, err := newRestApiAuthenticator(&gosnowflake.Config{Authenticator: gosnowflake.AuthTypeJwt, Account: "org-account", User: "user", PrivateKey: privateKey})
+		require.NoError(t, err)
+
+		_, tokenType, err := authenticator.token()
+		require.NoError(t, err)
+		assert.Equal(t, "KEYPAIR_JWT", tokenType)
+	})
+
+	t.Run("validation: empty token", func(t *testing.T) {
+		authenticator, err := newRestApiAuthenticator(&gosnowflake.Config{Authenticator: gosnowflake.AuthTypeOAuth})
+		require.NoError(t, err)
+
+		_, _, err = authenticator.token()
+		require.ErrorContains(t, err, "token for the REST API is empty")
+	})
+
+	t.Run("validation: key-pair without private key", func(t *testing.T) {
+		_, err := newRestApiAuthenticator(&gosnowflake.Config{Authenticator: gosnowflake.AuthTypeJwt, Account: "org-account", User: "user"})
+		require.ErrorContains(t, err, "key-pair authentication for the REST API requires a private key")
+	})
+
+	t.Run("validation: unsupported authenticator", func(t *testing.T) {
+		_, err := newRestApiAuthenticator(&gosnowflake.Config{Authenticator: gosnowflake.AuthTypeSnowflake})
+		require.ErrorContains(t, err, "authenticator SNOWFLAKE is not supported by the REST API")
+	})
+}
+
+func Test_restApiKeyPairAuthenticator(t *testing.T) {
+	privateKey := generateRestApiTestPrivateKey(t)
+	publicKey, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	require.NoError(t, err)
+	fingerprint := sha256.Sum256(publicKey)
+
+	now := time.Now()
+	authenticator := &restApiKeyPairAuthenticator{
+		account:    "xy12345.us-east-2.aws",
+		user:       "test_user",
+		privateKey: privateKey,
+		now:        func() time.Time { return now },
+	}
+
+	token, _, err := authenticator.token()
+	require.NoError(t, err)
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (any, error) { return privateKey.Public(), nil }, jwt.WithValidMethods([]string{"RS256"}))
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("XY12345.TEST_USER.SHA256:%s", base64.StdEncoding.EncodeToString(fingerprint[:])), claims["iss"])
+	assert.Equal(t, "XY12345.TEST_USER", claims["sub"])
+	assert.Equal(t, float64(now.Unix()), claims["iat"])
+	assert.Equal(t, float64(now.Add(time.Hour).Unix()), claims["exp"])
+
+	t.Run("token is reused before it expires", func(t *testing.T) {
+		now = now.Add(30 * time.Minute)
+
+		cachedToken, _, err := authenticator.token()
+		require.NoError(t, err)
+		assert.Equal(t, token, cachedToken)
+	})
+
+	t.Run("token is refreshed close to the expiration", func(t *testing.T) {
+		now = now.Add(26 * time.Minute)
+
+		refreshedToken, _, err := authenticator.token()
+		require.NoError(t, err)
+		assert.NotEqual(t, token, refreshedToken)
+	})
+}
+
+func Test_restApiClient(t *testing.T) {
+	standIn := newRestApiStandIn(t, "warehouse.yaml")
+	client := standIn.client()
+	ctx := context.Background()
+
+	t.Run("sends authorization headers", func(t *testing.T) {
+		_, err := client.Warehouses.Show(ctx, nil)
+		require.NoError(t, err)
+
+		request := standIn.lastRequest()
+		assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+		assert.Equal(t, "PROGRAMMATIC_ACCESS_TOKEN", request.Header.Get("X-Snowflake-Authorization-Token-Type"))
+		assert.Equal(t, "application/json", request.Header.Get("Accept"))
+		assert.Equal(t, "/api/v2/warehouses", request.URL.Path)
+	})
+
+	t.Run("maps the error response", func(t *testing.T) {
+		err := client.Warehouses.Drop(ctx, randomAccountObjectIdentifier(), nil)
+
+		var apiErr *RestApiError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+		assert.Equal(t, "404", apiErr.Code)
+		assert.NotEmpty(t, apiErr.RequestId)
+		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		assert.NotErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("escapes the identifiers in path", func(t *testing.T) {
+		id := NewAccountObjectIdentifier(`wh/with "special" chars:rename`)
+		require.NoError(t, client.Warehouses.Create(ctx, id, nil))
+
+		warehouse, err := client.Warehouses.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), warehouse.Name)
+		assert.Equal(t, `/api/v2/warehouses/%22wh%2Fwith%20%22%22special%22%22%20chars:rename%22`, standIn.lastRequest().URL.EscapedPath())
+	})
+}
+
+// Test_restApiModelsMatchSpecs makes sure the REST API models do not drift from the OpenAPI specifications.
+func Test_restApiModelsMatchSpecs(t *testing.T) {
+	common := loadRestApiSpec(t, "common.yaml")
+	testCases := []struct {
+		spec   string
+		schema string
+		model  any
+	}{
+		{spec: "warehouse.yaml", schema: "Warehouse", model: restApiWarehouse{}},
+		{spec: "database.yaml", schema: "Database", model: restApiDatabase{}},
+		{spec: "schema.yaml", schema: "Schema", model: restApiSchema{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.schema, func(t *testing.T) {
+			spec := loadRestApiSpec(t, tc.spec)
+			schema := parseRestApiSpecSchema(t, map[string]any{"$ref": "#/components/schemas/" + tc.schema}, spec, common)
+
+			modelType := reflect.TypeOf(tc.model)
+			for i := 0; i < modelType.NumField(); i++ {
+				name, _, _ := strings.Cut(modelType.Field(i).Tag.Get("json"), ",")
+				assert.Contains(t, schema.Properties, name, "property %s of %s is missing in %s", name, modelType.Name(), tc.spec)
+			}
+		})
+	}
+}
+
+func Test_restApiIdentifier(t *testing.T) {
+	for _, name := range []string{"NAME", "name", `na"me`, "na.me", `""`} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, name, restApiParseIdentifier(restApiIdentifier(name)))
+		})
+	}
+	assert.Equal(t, "NAME", restApiParseIdentifier("NAME"))
+}
+
+func Test_restApiNotFoundToObjectNotFound(t *testing.T) {
+	notFound := restApiNotFoundToObjectNotFound(&RestApiError{StatusCode: http.StatusNotFound})
+	assert.ErrorIs(t, notFound, ErrObjectNotFound)
+	assert.ErrorIs(t, notFound, ErrObjectNotExistOrAuthorized)
+
+	conflict := restApiNotFoundToObjectNotFound(&RestApiError{StatusCode: http.StatusConflict})
+	assert.NotErrorIs(t, conflict, ErrObjectNotFound)
+
+	other := errors.New("other")
+	assert.Equal(t, other, restApiNotFoundToObjectNotFound(other))
+}
+
+func generateRestApiTestPrivateKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return privateKey
+}
@@ -0,0 +1,194 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+var _ Databases = (*restApiDatabases)(nil)
+
+// restApiDatabases implements Databases using the database REST API (https://docs.snowflake.com/en/developer-guide/snowflake-rest-api/reference/database).
+// The operations (and options) not covered by the REST API are delegated to the embedded SQL implementation.
+type restApiDatabases struct {
+	Databases
+	client *restApiClient
+}
+
+const (
+	restApiKindPermanent = "PERMANENT"
+	restApiKindTransient = "TRANSIENT"
+)
+
+// restApiDatabase is based on the Database schema from the OpenAPI specification.
+type restApiDatabase struct {
+	Name                                string     `json:"name"`
+	Kind                                *string    `json:"kind,omitempty"`
+	Comment                             *string    `json:"comment,omitempty"`
+	DataRetentionTimeInDays             *int       `json:"data_retention_time_in_days,omitempty"`
+	MaxDataExtensionTimeInDays          *int       `json:"max_data_extension_time_in_days,omitempty"`
+	ExternalVolume                      *string    `json:"external_volume,omitempty"`
+	Catalog                             *string    `json:"catalog,omitempty"`
+	ReplaceInvalidCharacters            *bool      `json:"replace_invalid_characters,omitempty"`
+	DefaultDdlCollation                 *string    `json:"default_ddl_collation,omitempty"`
+	StorageSerializationPolicy          *string    `json:"storage_serialization_policy,omitempty"`
+	LogLevel                            *string    `json:"log_level,omitempty"`
+	TraceLevel                          *string    `json:"trace_level,omitempty"`
+	SuspendTaskAfterNumFailures         *int       `json:"suspend_task_after_num_failures,omitempty"`
+	UserTaskManagedInitialWarehouseSize *string    `json:"user_task_managed_initial_warehouse_size,omitempty"`
+	UserTaskTimeoutMs                   *int       `json:"user_task_timeout_ms,omitempty"`
+	CreatedOn                           *time.Time `json:"created_on,omitempty"`
+	DroppedOn                           *time.Time `json:"dropped_on,omitempty"`
+	IsDefault                           *bool      `json:"is_default,omitempty"`
+	IsCurrent                           *bool      `json:"is_current,omitempty"`
+	Origin                              *string    `json:"origin,omitempty"`
+	Owner                               *string    `json:"owner,omitempty"`
+	OwnerRoleType                       *string    `json:"owner_role_type,omitempty"`
+}
+
+func (d restApiDatabase) convert() (*Database, error) {
+	database := &Database{
+		Name:          restApiParseIdentifier(d.Name),
+		IsDefault:     d.IsDefault != nil && *d.IsDefault,
+		IsCurrent:     d.IsCurrent != nil && *d.IsCurrent,
+		Owner:         restApiValue(d.Owner),
+		Comment:       restApiValue(d.Comment),
+		RetentionTime: restApiValue(d.DataRetentionTimeInDays),
+		OwnerRoleType: restApiValue(d.OwnerRoleType),
+	}
+	if d.CreatedOn != nil {
+		database.CreatedOn = *d.CreatedOn
+	}
+	if d.DroppedOn != nil {
+		database.DroppedOn = *d.DroppedOn
+	}
+	if origin := restApiValue(d.Origin); origin != "" && origin != "<revoked>" {
+		originId, err := ParseObjectIdentifierString(origin)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse origin ID: %w", err)
+		}
+		database.Origin = originId
+	}
+	// The REST API reports the transient databases with a separate kind, while SHOW DATABASES lists them in the options.
+	switch kind := restApiValue(d.Kind); kind {
+	case restApiKindTransient:
+		database.Transient = true
+		database.Options = "TRANSIENT"
+		database.Kind = "STANDARD"
+	case restApiKindPermanent, "":
+		database.Kind = "STANDARD"
+	default:
+		database.Kind = kind
+	}
+	return database, nil
+}
+
+func (v *restApiDatabases) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateDatabaseOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	// The REST API does not support these properties yet.
+	if anyValueSet(opts.Clone, opts.TaskAutoRetryAttempts, opts.UserTaskMinimumTriggerIntervalInSeconds, opts.QuotedIdentifiersIgnoreCase, opts.EnableConsoleOutput) || len(opts.Tag) > 0 {
+		return v.Databases.Create(ctx, id, opts)
+	}
+	body := restApiDatabase{
+		Name:                                restApiIdentifier(id.Name()),
+		Kind:                                String(restApiKindPermanent),
+		Comment:                             opts.Comment,
+		DataRetentionTimeInDays:             opts.DataRetentionTimeInDays,
+		MaxDataExtensionTimeInDays:          opts.MaxDataExtensionTimeInDays,
+		ReplaceInvalidCharacters:            opts.ReplaceInvalidCharacters,
+		DefaultDdlCollation:                 opts.DefaultDDLCollation,
+		StorageSerializationPolicy:          restApiString(opts.StorageSerializationPolicy),
+		LogLevel:                            restApiString(opts.LogLevel),
+		TraceLevel:                          restApiString(opts.TraceLevel),
+		SuspendTaskAfterNumFailures:         opts.SuspendTaskAfterNumFailures,
+		UserTaskManagedInitialWarehouseSize: restApiString(opts.UserTaskManagedInitialWarehouseSize),
+		UserTaskTimeoutMs:                   opts.UserTaskTimeoutMs,
+	}
+	if opts.Transient != nil && *opts.Transient {
+		body.Kind = String(restApiKindTransient)
+	}
+	if opts.ExternalVolume != nil {
+		body.ExternalVolume = String(restApiIdentifier(opts.ExternalVolume.Name()))
+	}
+	if opts.Catalog != nil {
+		body.Catalog = String(restApiIdentifier(opts.Catalog.Name()))
+	}
+	return v.client.post(ctx, []string{"databases"}, restApiCreateMode(opts.OrReplace, opts.IfNotExists), body)
+}
+
+func (v *restApiDatabases) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterDatabaseOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	if opts.NewName == nil {
+		return v.Databases.Alter(ctx, id, opts)
+	}
+	query := restApiIfExists(opts.IfExists)
+	query.Set("targetName", restApiIdentifier(opts.NewName.Name()))
+	return v.client.post(ctx, []string{"databases", restApiIdentifier(id.Name()) + ":rename"}, query, nil)
+}
+
+func (v *restApiDatabases) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropDatabaseOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	query := restApiIfExists(opts.IfExists)
+	if opts.Restrict != nil && *opts.Restrict {
+		query.Set("restrict", "true")
+	}
+	return v.client.delete(ctx, []string{"databases", restApiIdentifier(id.Name())}, query)
+}
+
+func (v *restApiDatabases) DropSafely(ctx context.Context, id AccountObjectIdentifier) error {
+	return v.Drop(ctx, id, &DropDatabaseOptions{IfExists: Bool(true)})
+}
+
+func (v *restApiDatabases) Undrop(ctx context.Context, id AccountObjectIdentifier) error {
+	if !ValidObjectIdentifier(id) {
+		return ErrInvalidObjectIdentifier
+	}
+	return v.client.post(ctx, []string{"databases", restApiIdentifier(id.Name()) + ":undrop"}, nil, nil)
+}
+
+func (v *restApiDatabases) Show(ctx context.Context, opts *ShowDatabasesOptions) ([]Database, error) {
+	opts = createIfNil(opts)
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	// The REST API always returns the full output.
+	if opts.Terse != nil && *opts.Terse {
+		return v.Databases.Show(ctx, opts)
+	}
+	query := restApiShowQuery(opts.Like, opts.StartsWith, opts.LimitFrom)
+	if opts.History != nil && *opts.History {
+		query.Set("history", "true")
+	}
+	var rows []restApiDatabase
+	if err := v.client.get(ctx, []string{"databases"}, query, &rows); err != nil {
+		return nil, err
+	}
+	return convertRows[restApiDatabase, Database](rows)
+}
+
+func (v *restApiDatabases) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Database, error) {
+	if !ValidObjectIdentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	var row restApiDatabase
+	if err := v.client.get(ctx, []string{"databases", restApiIdentifier(id.Name())}, nil, &row); err != nil {
+		return nil, restApiNotFoundToObjectNotFound(err)
+	}
+	return row.convert()
+}
+
+func (v *restApiDatabases) ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*Database, error) {
+	return v.ShowByID(ctx, id)
+}
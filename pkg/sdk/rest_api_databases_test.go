@@ -0,0 +1,142 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestApiDatabases(t *testing.T) {
+	standIn := newRestApiStandIn(t, "database.yaml")
+	client := standIn.client()
+	ctx := context.Background()
+
+	t.Run("create with defaults", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+
+		require.NoError(t, client.Databases.Create(ctx, id, nil))
+
+		database, err := client.Databases.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), database.Name)
+		assert.Equal(t, "STANDARD", database.Kind)
+		assert.False(t, database.Transient)
+		assert.Empty(t, database.Options)
+		assert.Equal(t, 1, database.RetentionTime)
+		assert.Equal(t, "ACCOUNTADMIN", database.Owner)
+		assert.Equal(t, "ROLE", database.OwnerRoleType)
+		assert.False(t, database.CreatedOn.IsZero())
+		assert.True(t, database.DroppedOn.IsZero())
+	})
+
+	t.Run("create with all the REST API options", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+		externalVolumeId := randomAccountObjectIdentifier()
+		catalogId := randomAccountObjectIdentifier()
+
+		err := client.Databases.Create(ctx, id, &CreateDatabaseOptions{
+			OrReplace:                           Bool(true),
+			Transient:                           Bool(true),
+			DataRetentionTimeInDays:             Int(0),
+			MaxDataExtensionTimeInDays:          Int(10),
+			ExternalVolume:                      &externalVolumeId,
+			Catalog:                             &catalogId,
+			ReplaceInvalidCharacters:            Bool(true),
+			DefaultDDLCollation:                 String("en_US"),
+			StorageSerializationPolicy:          Pointer(StorageSerializationPolicyCompatible),
+			LogLevel:                            Pointer(LogLevelInfo),
+			TraceLevel:                          Pointer(TraceLevelOnEvent),
+			SuspendTaskAfterNumFailures:         Int(5),
+			UserTaskManagedInitialWarehouseSize: Pointer(WarehouseSizeSmall),
+			UserTaskTimeoutMs:                   Int(1000),
+			Comment:                             String("comment"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "createMode=orReplace", standIn.lastRequest().URL.RawQuery)
+
+		database, err := client.Databases.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, database.Transient)
+		assert.Equal(t, "TRANSIENT", database.Options)
+		assert.Equal(t, "STANDARD", database.Kind)
+		assert.Equal(t, 0, database.RetentionTime)
+		assert.Equal(t, "comment", database.Comment)
+	})
+
+	t.Run("show", func(t *testing.T) {
+		prefix := random.StringN(12) + "_"
+		id1 := NewAccountObjectIdentifier(prefix + "A")
+		id2 := NewAccountObjectIdentifier(prefix + "B")
+		require.NoError(t, client.Databases.Create(ctx, id1, nil))
+		require.NoError(t, client.Databases.Create(ctx, id2, nil))
+
+		databases, err := client.Databases.Show(ctx, &ShowDatabasesOptions{Like: &Like{Pattern: String(prefix + "%")}})
+		require.NoError(t, err)
+		require.Len(t, databases, 2)
+		assert.Equal(t, id1.Name(), databases[0].Name)
+		assert.Equal(t, id2.Name(), databases[1].Name)
+
+		databases, err = client.Databases.Show(ctx, &ShowDatabasesOptions{StartsWith: String(prefix), LimitFrom: &LimitFrom{Rows: Int(1), From: String(id1.Name())}})
+		require.NoError(t, err)
+		require.Len(t, databases, 1)
+		assert.Equal(t, id2.Name(), databases[0].Name)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+		newId := randomAccountObjectIdentifier()
+		require.NoError(t, client.Databases.Create(ctx, id, nil))
+
+		require.NoError(t, client.Databases.Alter(ctx, id, &AlterDatabaseOptions{NewName: &newId}))
+
+		_, err := client.Databases.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+		database, err := client.Databases.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assert.Equal(t, newId.Name(), database.Name)
+	})
+
+	t.Run("drop and undrop", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+		require.NoError(t, client.Databases.Create(ctx, id, nil))
+
+		require.NoError(t, client.Databases.Drop(ctx, id, &DropDatabaseOptions{Restrict: Bool(true)}))
+		assert.Equal(t, "restrict=true", standIn.lastRequest().URL.RawQuery)
+
+		_, err := client.Databases.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+
+		databases, err := client.Databases.Show(ctx, &ShowDatabasesOptions{History: Bool(true), Like: &Like{Pattern: String(id.Name())}})
+		require.NoError(t, err)
+		require.Len(t, databases, 1)
+		assert.False(t, databases[0].DroppedOn.IsZero())
+
+		require.NoError(t, client.Databases.Undrop(ctx, id))
+
+		database, err := client.Databases.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, database.DroppedOn.IsZero())
+	})
+
+	t.Run("drop safely: missing database", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+
+		require.ErrorIs(t, client.Databases.Drop(ctx, id, nil), ErrObjectNotExistOrAuthorized)
+		require.NoError(t, client.Databases.DropSafely(ctx, id))
+	})
+
+	t.Run("show by id safely: missing database", func(t *testing.T) {
+		_, err := client.Databases.ShowByIDSafely(ctx, randomAccountObjectIdentifier())
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		_, err := client.Databases.ShowByID(ctx, emptyAccountObjectIdentifier)
+		require.ErrorIs(t, err, ErrInvalidObjectIdentifier)
+
+		require.ErrorIs(t, client.Databases.Undrop(ctx, emptyAccountObjectIdentifier), ErrInvalidObjectIdentifier)
+	})
+}
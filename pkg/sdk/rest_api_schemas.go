@@ -0,0 +1,212 @@
+package sdk
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var _ Schemas = (*restApiSchemas)(nil)
+
+// restApiSchemas implements Schemas using the schema REST API (https://docs.snowflake.com/en/developer-guide/snowflake-rest-api/reference/schema).
+// The operations (and options) not covered by the REST API are delegated to the embedded SQL implementation.
+type restApiSchemas struct {
+	Schemas
+	client *restApiClient
+}
+
+// restApiSchema is based on the Schema schema from the OpenAPI specification.
+type restApiSchema struct {
+	Name                                string     `json:"name"`
+	Kind                                *string    `json:"kind,omitempty"`
+	ManagedAccess                       *bool      `json:"managed_access,omitempty"`
+	Comment                             *string    `json:"comment,omitempty"`
+	DataRetentionTimeInDays             *int       `json:"data_retention_time_in_days,omitempty"`
+	MaxDataExtensionTimeInDays          *int       `json:"max_data_extension_time_in_days,omitempty"`
+	ExternalVolume                      *string    `json:"external_volume,omitempty"`
+	Catalog                             *string    `json:"catalog,omitempty"`
+	PipeExecutionPaused                 *bool      `json:"pipe_execution_paused,omitempty"`
+	ReplaceInvalidCharacters            *bool      `json:"replace_invalid_characters,omitempty"`
+	DefaultDdlCollation                 *string    `json:"default_ddl_collation,omitempty"`
+	StorageSerializationPolicy          *string    `json:"storage_serialization_policy,omitempty"`
+	LogLevel                            *string    `json:"log_level,omitempty"`
+	TraceLevel                          *string    `json:"trace_level,omitempty"`
+	SuspendTaskAfterNumFailures         *int       `json:"suspend_task_after_num_failures,omitempty"`
+	UserTaskManagedInitialWarehouseSize *string    `json:"user_task_managed_initial_warehouse_size,omitempty"`
+	UserTaskTimeoutMs                   *int       `json:"user_task_timeout_ms,omitempty"`
+	CreatedOn                           *time.Time `json:"created_on,omitempty"`
+	DroppedOn                           *time.Time `json:"dropped_on,omitempty"`
+	IsDefault                           *bool      `json:"is_default,omitempty"`
+	IsCurrent                           *bool      `json:"is_current,omitempty"`
+	DatabaseName                        *string    `json:"database_name,omitempty"`
+	Owner                               *string    `json:"owner,omitempty"`
+	OwnerRoleType                       *string    `json:"owner_role_type,omitempty"`
+}
+
+func (s restApiSchema) convert() (*Schema, error) {
+	schema := &Schema{
+		Name:          restApiParseIdentifier(s.Name),
+		IsDefault:     s.IsDefault != nil && *s.IsDefault,
+		IsCurrent:     s.IsCurrent != nil && *s.IsCurrent,
+		DatabaseName:  restApiParseIdentifier(restApiValue(s.DatabaseName)),
+		Owner:         restApiValue(s.Owner),
+		Comment:       restApiValue(s.Comment),
+		RetentionTime: strconv.Itoa(restApiValue(s.DataRetentionTimeInDays)),
+		OwnerRoleType: restApiValue(s.OwnerRoleType),
+	}
+	if s.CreatedOn != nil {
+		schema.CreatedOn = *s.CreatedOn
+	}
+	if s.DroppedOn != nil {
+		schema.DroppedOn = *s.DroppedOn
+	}
+	// The REST API reports the options as separate fields, while SHOW SCHEMAS joins them in a single column.
+	var options []string
+	if restApiValue(s.Kind) == restApiKindTransient {
+		options = append(options, "TRANSIENT")
+	}
+	if s.ManagedAccess != nil && *s.ManagedAccess {
+		options = append(options, "MANAGED ACCESS")
+	}
+	if len(options) > 0 {
+		schema.Options = String(strings.Join(options, ", "))
+	}
+	return schema, nil
+}
+
+func (v *restApiSchemas) Create(ctx context.Context, id DatabaseObjectIdentifier, opts *CreateSchemaOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	// The REST API does not support these properties yet.
+	if anyValueSet(opts.Clone, opts.TaskAutoRetryAttempts, opts.UserTaskMinimumTriggerIntervalInSeconds, opts.QuotedIdentifiersIgnoreCase, opts.EnableConsoleOutput) || len(opts.Tag) > 0 {
+		return v.Schemas.Create(ctx, id, opts)
+	}
+	body := restApiSchema{
+		Name:                                restApiIdentifier(id.Name()),
+		Kind:                                String(restApiKindPermanent),
+		ManagedAccess:                       opts.WithManagedAccess,
+		Comment:                             opts.Comment,
+		DataRetentionTimeInDays:             opts.DataRetentionTimeInDays,
+		MaxDataExtensionTimeInDays:          opts.MaxDataExtensionTimeInDays,
+		PipeExecutionPaused:                 opts.PipeExecutionPaused,
+		ReplaceInvalidCharacters:            opts.ReplaceInvalidCharacters,
+		StorageSerializationPolicy:          restApiString(opts.StorageSerializationPolicy),
+		LogLevel:                            restApiString(opts.LogLevel),
+		TraceLevel:                          restApiString(opts.TraceLevel),
+		SuspendTaskAfterNumFailures:         opts.SuspendTaskAfterNumFailures,
+		UserTaskManagedInitialWarehouseSize: restApiString(opts.UserTaskManagedInitialWarehouseSize),
+		UserTaskTimeoutMs:                   opts.UserTaskTimeoutMs,
+	}
+	if opts.Transient != nil && *opts.Transient {
+		body.Kind = String(restApiKindTransient)
+	}
+	if opts.DefaultDDLCollation != nil {
+		body.DefaultDdlCollation = String(opts.DefaultDDLCollation.Value)
+	}
+	if opts.ExternalVolume != nil {
+		body.ExternalVolume = String(restApiIdentifier(opts.ExternalVolume.Name()))
+	}
+	if opts.Catalog != nil {
+		body.Catalog = String(restApiIdentifier(opts.Catalog.Name()))
+	}
+	return v.client.post(ctx, restApiSchemasPath(id.DatabaseId()), restApiCreateMode(opts.OrReplace, opts.IfNotExists), body)
+}
+
+func (v *restApiSchemas) Alter(ctx context.Context, id DatabaseObjectIdentifier, opts *AlterSchemaOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	if opts.NewName == nil {
+		return v.Schemas.Alter(ctx, id, opts)
+	}
+	query := restApiIfExists(opts.IfExists)
+	query.Set("targetDatabase", restApiIdentifier(opts.NewName.DatabaseName()))
+	query.Set("targetName", restApiIdentifier(opts.NewName.Name()))
+	return v.client.post(ctx, restApiSchemaPath(id, ":rename"), query, nil)
+}
+
+func (v *restApiSchemas) Drop(ctx context.Context, id DatabaseObjectIdentifier, opts *DropSchemaOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	query := restApiIfExists(opts.IfExists)
+	if opts.Restrict != nil && *opts.Restrict {
+		query.Set("restrict", "true")
+	}
+	return v.client.delete(ctx, restApiSchemaPath(id, ""), query)
+}
+
+// DropSafely does not have to check the database existence like SafeDrop, because the REST API responds with not found for both cases.
+func (v *restApiSchemas) DropSafely(ctx context.Context, id DatabaseObjectIdentifier) error {
+	err := v.Drop(ctx, id, &DropSchemaOptions{IfExists: Bool(true)})
+	if restApiIsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (v *restApiSchemas) Undrop(ctx context.Context, id DatabaseObjectIdentifier) error {
+	if !ValidObjectIdentifier(id) {
+		return ErrInvalidObjectIdentifier
+	}
+	return v.client.post(ctx, restApiSchemaPath(id, ":undrop"), nil, nil)
+}
+
+func (v *restApiSchemas) Show(ctx context.Context, opts *ShowSchemaOptions) ([]Schema, error) {
+	opts = createIfNil(opts)
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	// The REST API lists schemas only in a given database and always returns the full output.
+	if opts.In == nil || opts.In.Database == nil || !*opts.In.Database || (opts.Terse != nil && *opts.Terse) {
+		return v.Schemas.Show(ctx, opts)
+	}
+	query := restApiShowQuery(opts.Like, opts.StartsWith, opts.LimitFrom)
+	if opts.History != nil && *opts.History {
+		query.Set("history", "true")
+	}
+	var rows []restApiSchema
+	if err := v.client.get(ctx, restApiSchemasPath(opts.In.Name), query, &rows); err != nil {
+		return nil, err
+	}
+	return convertRows[restApiSchema, Schema](rows)
+}
+
+func (v *restApiSchemas) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*Schema, error) {
+	if !ValidObjectIdentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	var row restApiSchema
+	if err := v.client.get(ctx, restApiSchemaPath(id, ""), nil, &row); err != nil {
+		return nil, restApiNotFoundToObjectNotFound(err)
+	}
+	schema, err := row.convert()
+	if err != nil {
+		return nil, err
+	}
+	if schema.DatabaseName == "" {
+		schema.DatabaseName = id.DatabaseName()
+	}
+	return schema, nil
+}
+
+// ShowByIDSafely does not have to check the database existence like SafeShowById, because the REST API responds with not found for both cases.
+func (v *restApiSchemas) ShowByIDSafely(ctx context.Context, id DatabaseObjectIdentifier) (*Schema, error) {
+	return v.ShowByID(ctx, id)
+}
+
+func restApiSchemasPath(databaseId AccountObjectIdentifier) []string {
+	return []string{"databases", restApiIdentifier(databaseId.Name()), "schemas"}
+}
+
+func restApiSchemaPath(id DatabaseObjectIdentifier, action string) []string {
+	return append(restApiSchemasPath(id.DatabaseId()), restApiIdentifier(id.Name())+action)
+}
@@ -0,0 +1,155 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestApiSchemas(t *testing.T) {
+	standIn := newRestApiStandIn(t, "database.yaml", "schema.yaml")
+	client := standIn.client()
+	ctx := context.Background()
+
+	databaseId := randomAccountObjectIdentifier()
+	require.NoError(t, client.Databases.Create(ctx, databaseId, nil))
+
+	t.Run("create with defaults", func(t *testing.T) {
+		id := randomDatabaseObjectIdentifierInDatabase(databaseId)
+
+		require.NoError(t, client.Schemas.Create(ctx, id, nil))
+
+		schema, err := client.Schemas.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, schema.ID())
+		assert.Nil(t, schema.Options)
+		assert.False(t, schema.IsTransient())
+		assert.False(t, schema.IsManagedAccess())
+		assert.Equal(t, "1", schema.RetentionTime)
+		assert.Equal(t, "ACCOUNTADMIN", schema.Owner)
+		assert.False(t, schema.CreatedOn.IsZero())
+	})
+
+	t.Run("create with all the REST API options", func(t *testing.T) {
+		id := randomDatabaseObjectIdentifierInDatabase(databaseId)
+		externalVolumeId := randomAccountObjectIdentifier()
+		catalogId := randomAccountObjectIdentifier()
+
+		err := client.Schemas.Create(ctx, id, &CreateSchemaOptions{
+			IfNotExists:                         Bool(true),
+			Transient:                           Bool(true),
+			WithManagedAccess:                   Bool(true),
+			DataRetentionTimeInDays:             Int(0),
+			MaxDataExtensionTimeInDays:          Int(10),
+			ExternalVolume:                      &externalVolumeId,
+			Catalog:                             &catalogId,
+			PipeExecutionPaused:                 Bool(true),
+			ReplaceInvalidCharacters:            Bool(true),
+			DefaultDDLCollation:                 &StringAllowEmpty{Value: "en_US"},
+			StorageSerializationPolicy:          Pointer(StorageSerializationPolicyOptimized),
+			LogLevel:                            Pointer(LogLevelInfo),
+			TraceLevel:                          Pointer(TraceLevelOnEvent),
+			SuspendTaskAfterNumFailures:         Int(5),
+			UserTaskManagedInitialWarehouseSize: Pointer(WarehouseSizeSmall),
+			UserTaskTimeoutMs:                   Int(1000),
+			Comment:                             String("comment"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "createMode=ifNotExists", standIn.lastRequest().URL.RawQuery)
+
+		schema, err := client.Schemas.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "TRANSIENT, MANAGED ACCESS", *schema.Options)
+		assert.True(t, schema.IsTransient())
+		assert.True(t, schema.IsManagedAccess())
+		assert.Equal(t, "0", schema.RetentionTime)
+		assert.Equal(t, "comment", schema.Comment)
+	})
+
+	t.Run("show in database", func(t *testing.T) {
+		prefix := random.StringN(12) + "_"
+		id1 := NewDatabaseObjectIdentifier(databaseId.Name(), prefix+"A")
+		id2 := NewDatabaseObjectIdentifier(databaseId.Name(), prefix+"B")
+		require.NoError(t, client.Schemas.Create(ctx, id1, nil))
+		require.NoError(t, client.Schemas.Create(ctx, id2, nil))
+
+		schemas, err := client.Schemas.Show(ctx, &ShowSchemaOptions{
+			In:   &SchemaIn{Database: Bool(true), Name: databaseId},
+			Like: &Like{Pattern: String(prefix + "%")},
+		})
+		require.NoError(t, err)
+		require.Len(t, schemas, 2)
+		assert.Equal(t, id1, schemas[0].ID())
+		assert.Equal(t, id2, schemas[1].ID())
+
+		schemas, err = client.Schemas.Show(ctx, &ShowSchemaOptions{
+			In:         &SchemaIn{Database: Bool(true), Name: databaseId},
+			StartsWith: String(prefix),
+			LimitFrom:  &LimitFrom{Rows: Int(1)},
+		})
+		require.NoError(t, err)
+		require.Len(t, schemas, 1)
+		assert.Equal(t, id1, schemas[0].ID())
+	})
+
+	t.Run("alter: rename to another database", func(t *testing.T) {
+		otherDatabaseId := randomAccountObjectIdentifier()
+		require.NoError(t, client.Databases.Create(ctx, otherDatabaseId, nil))
+		id := randomDatabaseObjectIdentifierInDatabase(databaseId)
+		newId := randomDatabaseObjectIdentifierInDatabase(otherDatabaseId)
+		require.NoError(t, client.Schemas.Create(ctx, id, nil))
+
+		require.NoError(t, client.Schemas.Alter(ctx, id, &AlterSchemaOptions{NewName: &newId}))
+
+		_, err := client.Schemas.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+		schema, err := client.Schemas.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assert.Equal(t, newId, schema.ID())
+	})
+
+	t.Run("drop and undrop", func(t *testing.T) {
+		id := randomDatabaseObjectIdentifierInDatabase(databaseId)
+		require.NoError(t, client.Schemas.Create(ctx, id, nil))
+
+		require.NoError(t, client.Schemas.Drop(ctx, id, nil))
+
+		_, err := client.Schemas.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+
+		require.NoError(t, client.Schemas.Undrop(ctx, id))
+
+		schema, err := client.Schemas.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, schema.ID())
+	})
+
+	t.Run("drop safely and show by id safely: missing database", func(t *testing.T) {
+		id := randomDatabaseObjectIdentifier()
+
+		require.ErrorIs(t, client.Schemas.Drop(ctx, id, &DropSchemaOptions{IfExists: Bool(true)}), ErrObjectNotExistOrAuthorized)
+		require.NoError(t, client.Schemas.DropSafely(ctx, id))
+
+		_, err := client.Schemas.ShowByIDSafely(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("drop safely and show by id safely: missing schema", func(t *testing.T) {
+		id := randomDatabaseObjectIdentifierInDatabase(databaseId)
+
+		require.NoError(t, client.Schemas.DropSafely(ctx, id))
+
+		_, err := client.Schemas.ShowByIDSafely(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		_, err := client.Schemas.ShowByID(ctx, emptyDatabaseObjectIdentifier)
+		require.ErrorIs(t, err, ErrInvalidObjectIdentifier)
+
+		require.ErrorIs(t, client.Schemas.Undrop(ctx, emptyDatabaseObjectIdentifier), ErrInvalidObjectIdentifier)
+	})
+}
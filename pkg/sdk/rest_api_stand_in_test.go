@@ -0,0 +1,739 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const restApiSpecsDirectory = "../testfunctional/testdata/openapi"
+
+// restApiStandIn is a local stand-in for the Snowflake REST API driven by the OpenAPI specifications.
+// It routes the requests using the paths and operation ids from the specifications, validates the query parameters
+// and the request bodies against them, and keeps the created objects in memory.
+type restApiStandIn struct {
+	t          *testing.T
+	server     *httptest.Server
+	operations []restApiSpecOperation
+
+	mu       sync.Mutex
+	objects  map[string]map[string]map[string]any
+	dropped  map[string]map[string]map[string]any
+	requests []*http.Request
+}
+
+type restApiSpecOperation struct {
+	method      string
+	segments    []string
+	operationId string
+	parameters  map[string]restApiSpecSchema
+	required    []string
+	body        *restApiSpecSchema
+}
+
+type restApiSpecSchema struct {
+	Type       string
+	Enum       []string
+	ReadOnly   bool
+	Properties map[string]restApiSpecSchema
+	Required   []string
+}
+
+func newRestApiStandIn(t *testing.T, specs ...string) *restApiStandIn {
+	t.Helper()
+	standIn := &restApiStandIn{
+		t:       t,
+		objects: make(map[string]map[string]map[string]any),
+		dropped: make(map[string]map[string]map[string]any),
+	}
+	common := loadRestApiSpec(t, "common.yaml")
+	for _, spec := range specs {
+		standIn.operations = append(standIn.operations, parseRestApiSpecOperations(t, loadRestApiSpec(t, spec), common)...)
+	}
+	standIn.server = httptest.NewServer(http.HandlerFunc(standIn.handle))
+	t.Cleanup(standIn.server.Close)
+	return standIn
+}
+
+// client returns the SDK client with the REST API implementations pointing to the stand-in.
+func (s *restApiStandIn) client() *Client {
+	s.t.Helper()
+	restClient, err := newRestApiClientForUrl(s.server.URL, &restApiTokenAuthenticator{value: "token", tokenType: restApiTokenTypeProgrammaticAccessToken}, s.server.Client())
+	require.NoError(s.t, err)
+	client := &Client{executor: &restApiStandInSqlExecutor{standIn: s}}
+	client.useRestApiBackend(restClient)
+	return client
+}
+
+func (s *restApiStandIn) lastRequest() *http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	require.NotEmpty(s.t, s.requests)
+	return s.requests[len(s.requests)-1]
+}
+
+func loadRestApiSpec(t *testing.T, name string) map[string]any {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join(restApiSpecsDirectory, name))
+	require.NoError(t, err)
+	var spec map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &spec))
+	return spec
+}
+
+func parseRestApiSpecOperations(t *testing.T, spec map[string]any, common map[string]any) []restApiSpecOperation {
+	t.Helper()
+	var operations []restApiSpecOperation
+	for path, pathItem := range spec["paths"].(map[string]any) {
+		for method, rawOperation := range pathItem.(map[string]any) {
+			operation := rawOperation.(map[string]any)
+			parsed := restApiSpecOperation{
+				method:      strings.ToUpper(method),
+				segments:    strings.Split(strings.TrimPrefix(path, "/"), "/"),
+				operationId: operation["operationId"].(string),
+				parameters:  make(map[string]restApiSpecSchema),
+			}
+			parameters, _ := operation["parameters"].([]any)
+			for _, rawParameter := range parameters {
+				parameter := resolveRestApiSpecRef(t, rawParameter.(map[string]any), spec, common)
+				if parameter["in"] != "query" {
+					continue
+				}
+				name := parameter["name"].(string)
+				parsed.parameters[name] = parseRestApiSpecSchema(t, parameter["schema"].(map[string]any), spec, common)
+				if required, _ := parameter["required"].(bool); required {
+					parsed.required = append(parsed.required, name)
+				}
+			}
+			if requestBody, ok := operation["requestBody"].(map[string]any); ok {
+				content := requestBody["content"].(map[string]any)["application/json"].(map[string]any)
+				body := parseRestApiSpecSchema(t, content["schema"].(map[string]any), spec, common)
+				parsed.body = &body
+			}
+			operations = append(operations, parsed)
+		}
+	}
+	return operations
+}
+
+func resolveRestApiSpecRef(t *testing.T, node map[string]any, spec map[string]any, common map[string]any) map[string]any {
+	t.Helper()
+	ref, ok := node["$ref"].(string)
+	if !ok {
+		return node
+	}
+	file, pointer, found := strings.Cut(ref, "#/")
+	require.True(t, found, "unsupported reference %s", ref)
+	isCommon := strings.TrimPrefix(file, "./") == "common.yaml"
+	document := spec
+	if isCommon {
+		document = common
+	}
+	var resolved any = document
+	for _, part := range strings.Split(pointer, "/") {
+		resolved = resolved.(map[string]any)[part]
+	}
+	require.NotNil(t, resolved, "unresolved reference %s", ref)
+	// The references to the common document are resolved within it.
+	if isCommon {
+		return resolveRestApiSpecRef(t, resolved.(map[string]any), common, common)
+	}
+	return resolveRestApiSpecRef(t, resolved.(map[string]any), spec, common)
+}
+
+func parseRestApiSpecSchema(t *testing.T, node map[string]any, spec map[string]any, common map[string]any) restApiSpecSchema {
+	t.Helper()
+	readOnly, _ := node["readOnly"].(bool)
+	resolved := resolveRestApiSpecRef(t, node, spec, common)
+	if resolvedReadOnly, _ := resolved["readOnly"].(bool); resolvedReadOnly {
+		readOnly = true
+	}
+	schema := restApiSpecSchema{ReadOnly: readOnly}
+	schema.Type, _ = resolved["type"].(string)
+	if enum, ok := resolved["enum"].([]any); ok {
+		for _, value := range enum {
+			schema.Enum = append(schema.Enum, fmt.Sprintf("%v", value))
+		}
+	}
+	if required, ok := resolved["required"].([]any); ok {
+		for _, value := range required {
+			schema.Required = append(schema.Required, value.(string))
+		}
+	}
+	if properties, ok := resolved["properties"].(map[string]any); ok {
+		schema.Properties = make(map[string]restApiSpecSchema)
+		for name, property := range properties {
+			schema.Properties[name] = parseRestApiSpecSchema(t, property.(map[string]any), spec, common)
+		}
+	}
+	return schema
+}
+
+type restApiStandInError struct {
+	status  int
+	message string
+}
+
+func (s *restApiStandIn) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, r)
+
+	response, err := s.serve(r)
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		w.WriteHeader(err.status)
+		_ = json.NewEncoder(w).Encode(map[string]string{"message": err.message, "code": strconv.Itoa(err.status), "request_id": fmt.Sprintf("request-%d", len(s.requests))})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (s *restApiStandIn) serve(r *http.Request) (any, *restApiStandInError) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") || r.Header.Get("X-Snowflake-Authorization-Token-Type") == "" {
+		return nil, &restApiStandInError{http.StatusUnauthorized, "missing authorization"}
+	}
+	operation, pathParameters, err := s.route(r)
+	if err != nil {
+		return nil, err
+	}
+	query := r.URL.Query()
+	if err := validateRestApiQuery(operation, query); err != nil {
+		return nil, err
+	}
+	var body map[string]any
+	if operation.body != nil {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return nil, &restApiStandInError{http.StatusBadRequest, fmt.Sprintf("invalid body: %v", err)}
+		}
+		if err := validateRestApiBody(*operation.body, body); err != nil {
+			return nil, err
+		}
+	}
+
+	collection := "warehouses"
+	if database, ok := pathParameters["database"]; ok {
+		if _, exists := s.objects["databases"][database]; !exists {
+			return nil, &restApiStandInError{http.StatusNotFound, fmt.Sprintf("database %s does not exist", database)}
+		}
+		collection = fmt.Sprintf("databases/%s/schemas", database)
+	} else if operation.segments[2] == "databases" {
+		collection = "databases"
+	}
+	name := pathParameters["name"]
+
+	switch {
+	case strings.HasPrefix(operation.operationId, "create"):
+		return s.create(collection, pathParameters, query, body)
+	case strings.HasPrefix(operation.operationId, "list"):
+		return s.list(collection, query), nil
+	case strings.HasPrefix(operation.operationId, "fetch"):
+		object, err := s.existing(collection, name, false)
+		if err != nil {
+			return nil, err
+		}
+		return object, nil
+	case strings.HasPrefix(operation.operationId, "delete"):
+		object, err := s.existing(collection, name, query.Get("ifExists") == "true")
+		if err != nil || object == nil {
+			return restApiSuccessResponse{Status: "successful"}, err
+		}
+		delete(s.objects[collection], name)
+		object["dropped_on"] = time.Now().UTC().Format(time.RFC3339)
+		s.collection(s.dropped, collection)[name] = object
+	case strings.HasPrefix(operation.operationId, "undrop"):
+		object, ok := s.dropped[collection][name]
+		if !ok {
+			return nil, &restApiStandInError{http.StatusNotFound, fmt.Sprintf("dropped object %s does not exist", name)}
+		}
+		if _, exists := s.objects[collection][name]; exists {
+			return nil, &restApiStandInError{http.StatusConflict, fmt.Sprintf("object %s already exists", name)}
+		}
+		delete(s.dropped[collection], name)
+		delete(object, "dropped_on")
+		s.objects[collection][name] = object
+	case strings.HasPrefix(operation.operationId, "rename"):
+		object, err := s.existing(collection, name, query.Get("ifExists") == "true")
+		if err != nil || object == nil {
+			return restApiSuccessResponse{Status: "successful"}, err
+		}
+		targetCollection, targetName := collection, ""
+		if body != nil {
+			targetName = normalizeRestApiIdentifier(body["name"].(string))
+		} else {
+			targetName = normalizeRestApiIdentifier(query.Get("targetName"))
+		}
+		if targetDatabase := query.Get("targetDatabase"); targetDatabase != "" {
+			targetCollection = fmt.Sprintf("databases/%s/schemas", normalizeRestApiIdentifier(targetDatabase))
+			object["database_name"] = normalizeRestApiIdentifier(targetDatabase)
+		}
+		if _, exists := s.objects[targetCollection][targetName]; exists {
+			return nil, &restApiStandInError{http.StatusConflict, fmt.Sprintf("object %s already exists", targetName)}
+		}
+		delete(s.objects[collection], name)
+		object["name"] = targetName
+		s.collection(s.objects, targetCollection)[targetName] = object
+	case strings.HasPrefix(operation.operationId, "suspend"), strings.HasPrefix(operation.operationId, "resume"), strings.HasPrefix(operation.operationId, "abort"):
+		object, err := s.existing(collection, name, query.Get("ifExists") == "true")
+		if err != nil || object == nil {
+			return restApiSuccessResponse{Status: "successful"}, err
+		}
+		switch {
+		case strings.HasPrefix(operation.operationId, "suspend"):
+			object["state"] = string(WarehouseStateSuspended)
+		case strings.HasPrefix(operation.operationId, "resume"):
+			object["state"] = string(WarehouseStateStarted)
+			object["resumed_on"] = time.Now().UTC().Format(time.RFC3339)
+		}
+	default:
+		return nil, &restApiStandInError{http.StatusNotImplemented, fmt.Sprintf("operation %s is not supported by the stand-in", operation.operationId)}
+	}
+	return restApiSuccessResponse{Status: "successful"}, nil
+}
+
+// route matches the escaped request path to the path templates; the path segments are unescaped separately, so that the quoted identifiers may contain slashes.
+func (s *restApiStandIn) route(r *http.Request) (restApiSpecOperation, map[string]string, *restApiStandInError) {
+	rawSegments := strings.Split(strings.TrimPrefix(r.URL.EscapedPath(), "/"), "/")
+	segments := make([]string, len(rawSegments))
+	for i, rawSegment := range rawSegments {
+		segment, err := url.PathUnescape(rawSegment)
+		if err != nil {
+			return restApiSpecOperation{}, nil, &restApiStandInError{http.StatusBadRequest, err.Error()}
+		}
+		segments[i] = segment
+	}
+	pathMatched := false
+	for _, operation := range s.operations {
+		pathParameters, ok := matchRestApiPath(operation.segments, segments)
+		if !ok {
+			continue
+		}
+		pathMatched = true
+		if operation.method == r.Method {
+			return operation, pathParameters, nil
+		}
+	}
+	if pathMatched {
+		return restApiSpecOperation{}, nil, &restApiStandInError{http.StatusMethodNotAllowed, fmt.Sprintf("method %s is not allowed", r.Method)}
+	}
+	return restApiSpecOperation{}, nil, &restApiStandInError{http.StatusNotFound, fmt.Sprintf("path %s is not supported", r.URL.Path)}
+}
+
+var restApiPathParameterRegex = regexp.MustCompile(`^\{(\w+)\}(.*)$`)
+
+func matchRestApiPath(template []string, segments []string) (map[string]string, bool) {
+	if len(template) != len(segments) {
+		return nil, false
+	}
+	parameters := make(map[string]string)
+	for i, templateSegment := range template {
+		match := restApiPathParameterRegex.FindStringSubmatch(templateSegment)
+		if match == nil {
+			if templateSegment != segments[i] {
+				return nil, false
+			}
+			continue
+		}
+		identifier, rest, ok := cutRestApiIdentifier(segments[i])
+		if !ok || rest != match[2] {
+			return nil, false
+		}
+		parameters[match[1]] = normalizeRestApiIdentifier(identifier)
+	}
+	return parameters, true
+}
+
+// cutRestApiIdentifier splits the path segment into the identifier and the action suffix (e.g. :rename).
+func cutRestApiIdentifier(segment string) (string, string, bool) {
+	if !strings.HasPrefix(segment, `"`) {
+		identifier, action, found := strings.Cut(segment, ":")
+		if found {
+			action = ":" + action
+		}
+		return identifier, action, identifier != ""
+	}
+	for i := 1; i < len(segment); i++ {
+		if segment[i] != '"' {
+			continue
+		}
+		if i+1 < len(segment) && segment[i+1] == '"' {
+			i++
+			continue
+		}
+		return segment[:i+1], segment[i+1:], true
+	}
+	return "", "", false
+}
+
+// normalizeRestApiIdentifier mimics Snowflake: quoted identifiers are case-sensitive, unquoted ones are uppercased.
+func normalizeRestApiIdentifier(identifier string) string {
+	if strings.HasPrefix(identifier, `"`) {
+		return restApiParseIdentifier(identifier)
+	}
+	return strings.ToUpper(identifier)
+}
+
+func validateRestApiQuery(operation restApiSpecOperation, query url.Values) *restApiStandInError {
+	for name, values := range query {
+		parameter, ok := operation.parameters[name]
+		if !ok {
+			return &restApiStandInError{http.StatusBadRequest, fmt.Sprintf("unknown query parameter %s for %s", name, operation.operationId)}
+		}
+		if err := validateRestApiValue(name, parameter, values[0]); err != nil {
+			return err
+		}
+	}
+	for _, name := range operation.required {
+		if !query.Has(name) {
+			return &restApiStandInError{http.StatusBadRequest, fmt.Sprintf("missing required query parameter %s", name)}
+		}
+	}
+	return nil
+}
+
+func validateRestApiBody(schema restApiSpecSchema, body map[string]any) *restApiStandInError {
+	for name, value := range body {
+		property, ok := schema.Properties[name]
+		switch {
+		case !ok:
+			return &restApiStandInError{http.StatusBadRequest, fmt.Sprintf("unknown property %s", name)}
+		case property.ReadOnly:
+			return &restApiStandInError{http.StatusBadRequest, fmt.Sprintf("property %s is read-only", name)}
+		}
+		if err := validateRestApiValue(name, property, value); err != nil {
+			return err
+		}
+	}
+	for _, name := range schema.Required {
+		if _, ok := body[name]; !ok {
+			return &restApiStandInError{http.StatusBadRequest, fmt.Sprintf("missing required property %s", name)}
+		}
+	}
+	return nil
+}
+
+// validateRestApiValue checks the JSON values, or the raw strings for the query parameters.
+func validateRestApiValue(name string, schema restApiSpecSchema, value any) *restApiStandInError {
+	raw, isString := value.(string)
+	valid := true
+	switch schema.Type {
+	case "integer":
+		if isString {
+			_, err := strconv.Atoi(raw)
+			valid = err == nil
+		} else {
+			number, ok := value.(float64)
+			valid = ok && number == float64(int64(number))
+		}
+	case "boolean":
+		if isString {
+			valid = raw == "true" || raw == "false"
+		} else {
+			_, valid = value.(bool)
+		}
+	case "string", "":
+		valid = isString
+	}
+	if !valid {
+		return &restApiStandInError{http.StatusBadRequest, fmt.Sprintf("invalid value %v of %s, expected %s", value, name, schema.Type)}
+	}
+	if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, fmt.Sprintf("%v", value)) {
+		return &restApiStandInError{http.StatusBadRequest, fmt.Sprintf("invalid value %v of %s, expected one of %v", value, name, schema.Enum)}
+	}
+	return nil
+}
+
+func (s *restApiStandIn) collection(objects map[string]map[string]map[string]any, collection string) map[string]map[string]any {
+	if _, ok := objects[collection]; !ok {
+		objects[collection] = make(map[string]map[string]any)
+	}
+	return objects[collection]
+}
+
+func (s *restApiStandIn) existing(collection string, name string, ifExists bool) (map[string]any, *restApiStandInError) {
+	object, ok := s.objects[collection][name]
+	switch {
+	case ok:
+		return object, nil
+	case ifExists:
+		return nil, nil
+	default:
+		return nil, &restApiStandInError{http.StatusNotFound, fmt.Sprintf("object %s does not exist or not authorized", name)}
+	}
+}
+
+func (s *restApiStandIn) create(collection string, pathParameters map[string]string, query url.Values, body map[string]any) (any, *restApiStandInError) {
+	name := normalizeRestApiIdentifier(body["name"].(string))
+	if _, exists := s.objects[collection][name]; exists {
+		switch query.Get("createMode") {
+		case "ifNotExists":
+			return restApiSuccessResponse{Status: "successful"}, nil
+		case "orReplace":
+		default:
+			return nil, &restApiStandInError{http.StatusConflict, fmt.Sprintf("object %s already exists", name)}
+		}
+	}
+
+	object := map[string]any{
+		"created_on":      time.Now().UTC().Format(time.RFC3339),
+		"owner":           "ACCOUNTADMIN",
+		"owner_role_type": "ROLE",
+		"is_default":      false,
+		"is_current":      false,
+	}
+	switch {
+	case collection == "warehouses":
+		object["state"] = string(WarehouseStateStarted)
+		if body["initially_suspended"] == "true" {
+			object["state"] = string(WarehouseStateSuspended)
+		}
+		for property, value := range map[string]any{
+			"warehouse_type": string(WarehouseTypeStandard), "warehouse_size": string(WarehouseSizeXSmall), "min_cluster_count": 1, "max_cluster_count": 1,
+			"scaling_policy": string(ScalingPolicyStandard), "auto_suspend": 600, "auto_resume": "true", "resource_monitor": "null",
+			"enable_query_acceleration": "false", "query_acceleration_max_scale_factor": 8, "started_clusters": 0, "running": 0, "queued": 0,
+			"available": "", "provisioning": "", "quiescing": "", "other": "", "resumed_on": object["created_on"], "updated_on": object["created_on"],
+		} {
+			object[property] = value
+		}
+	default:
+		object["kind"] = restApiKindPermanent
+		object["data_retention_time_in_days"] = 1
+		if database, ok := pathParameters["database"]; ok {
+			object["database_name"] = database
+		}
+	}
+	for property, value := range body {
+		object[property] = value
+	}
+	object["name"] = name
+	s.collection(s.objects, collection)[name] = object
+	return restApiSuccessResponse{Status: "successful"}, nil
+}
+
+func (s *restApiStandIn) list(collection string, query url.Values) []map[string]any {
+	objects := make([]map[string]any, 0)
+	sources := []map[string]map[string]any{s.objects[collection]}
+	if query.Get("history") == "true" {
+		sources = append(sources, s.dropped[collection])
+	}
+	var likeRegex *regexp.Regexp
+	if like := query.Get("like"); like != "" {
+		pattern := regexp.QuoteMeta(like)
+		pattern = strings.NewReplacer("%", ".*", "_", ".").Replace(pattern)
+		likeRegex = regexp.MustCompile(`(?i)^` + pattern + `$`)
+	}
+	for _, source := range sources {
+		for name, object := range source {
+			if likeRegex != nil && !likeRegex.MatchString(name) {
+				continue
+			}
+			if startsWith := query.Get("startsWith"); startsWith != "" && !strings.HasPrefix(name, startsWith) {
+				continue
+			}
+			if fromName := query.Get("fromName"); fromName != "" && name <= fromName {
+				continue
+			}
+			objects = append(objects, object)
+		}
+	}
+	slices.SortFunc(objects, func(a, b map[string]any) int { return strings.Compare(a["name"].(string), b["name"].(string)) })
+	if showLimit := query.Get("showLimit"); showLimit != "" {
+		limit, _ := strconv.Atoi(showLimit)
+		objects = objects[:min(limit, len(objects))]
+	}
+	return objects
+}
+
+// restApiStandInSqlExecutor answers the SQL statements the REST API implementations delegate to (for the properties not covered by the REST API)
+// with the same objects as the REST API stand-in. Only the warehouse statements used by the SDK are supported.
+type restApiStandInSqlExecutor struct {
+	standIn *restApiStandIn
+}
+
+var (
+	_ queryExecutor = (*restApiStandInSqlExecutor)(nil)
+
+	restApiStandInSqlWarehouseStatementRegex = regexp.MustCompile(`^(CREATE(?: OR REPLACE)? WAREHOUSE(?: IF NOT EXISTS)?|ALTER WAREHOUSE(?: IF EXISTS)?) ("(?:[^"]|"")*")(.*)$`)
+	restApiStandInSqlWarehousePropertyRegex  = regexp.MustCompile(`(WAREHOUSE_TYPE|RESOURCE_CONSTRAINT|GENERATION) = '([^']*)'`)
+	restApiStandInSqlShowWarehousesRegex     = regexp.MustCompile(`^SHOW WAREHOUSES LIKE '((?:[^'\\]|\\.)*)'$`)
+)
+
+func (e *restApiStandInSqlExecutor) ExecContext(_ context.Context, query string, _ ...any) (sql.Result, error) {
+	e.standIn.mu.Lock()
+	defer e.standIn.mu.Unlock()
+
+	match := restApiStandInSqlWarehouseStatementRegex.FindStringSubmatch(query)
+	if match == nil {
+		return nil, fmt.Errorf("statement %s is not supported by the stand-in", query)
+	}
+	properties := make(map[string]any)
+	for _, property := range restApiStandInSqlWarehousePropertyRegex.FindAllStringSubmatch(match[3], -1) {
+		properties[strings.ToLower(property[1])] = property[2]
+	}
+	if strings.HasPrefix(match[1], "CREATE") {
+		properties["name"] = match[2]
+		createMode := url.Values{}
+		if strings.Contains(match[1], "IF NOT EXISTS") {
+			createMode.Set("createMode", "ifNotExists")
+		}
+		if _, err := e.standIn.create("warehouses", nil, createMode, properties); err != nil {
+			return nil, errors.New(err.message)
+		}
+		return driver.RowsAffected(0), nil
+	}
+	object, err := e.standIn.existing("warehouses", normalizeRestApiIdentifier(match[2]), strings.HasSuffix(match[1], "IF EXISTS"))
+	if err != nil {
+		return nil, errors.New(err.message)
+	}
+	for property, value := range properties {
+		object[property] = value
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (e *restApiStandInSqlExecutor) SelectContext(_ context.Context, dest any, query string, _ ...any) error {
+	e.standIn.mu.Lock()
+	defer e.standIn.mu.Unlock()
+
+	match := restApiStandInSqlShowWarehousesRegex.FindStringSubmatch(query)
+	if match == nil {
+		return fmt.Errorf("query %s is not supported by the stand-in", query)
+	}
+	rows := dest.(*[]warehouseDBRow)
+	for _, object := range e.standIn.list("warehouses", url.Values{"like": {strings.ReplaceAll(match[1], `\'`, "'")}}) {
+		*rows = append(*rows, restApiStandInWarehouseDBRow(object))
+	}
+	return nil
+}
+
+func (e *restApiStandInSqlExecutor) QueryContext(_ context.Context, query string, _ ...any) (*sql.Rows, error) {
+	return nil, fmt.Errorf("query %s is not supported by the stand-in", query)
+}
+
+func (e *restApiStandInSqlExecutor) GetContext(_ context.Context, _ any, query string, _ ...any) error {
+	return fmt.Errorf("query %s is not supported by the stand-in", query)
+}
+
+// restApiStandInWarehouseDBRow returns the SHOW WAREHOUSES row of the stand-in object.
+func restApiStandInWarehouseDBRow(object map[string]any) warehouseDBRow {
+	stringValue := func(property string) string {
+		value, _ := object[property].(string)
+		return value
+	}
+	intValue := func(property string) int {
+		switch value := object[property].(type) {
+		case int:
+			return value
+		case float64:
+			return int(value)
+		}
+		return 0
+	}
+	timeValue := func(property string) time.Time {
+		value, _ := time.Parse(time.RFC3339, stringValue(property))
+		return value
+	}
+	nullString := func(property string) sql.NullString {
+		value, ok := object[property].(string)
+		return sql.NullString{String: value, Valid: ok}
+	}
+	return warehouseDBRow{
+		Name:                            stringValue("name"),
+		State:                           stringValue("state"),
+		Type:                            stringValue("warehouse_type"),
+		Size:                            stringValue("warehouse_size"),
+		MinClusterCount:                 intValue("min_cluster_count"),
+		MaxClusterCount:                 intValue("max_cluster_count"),
+		AutoSuspend:                     sql.NullInt64{Int64: int64(intValue("auto_suspend")), Valid: true},
+		AutoResume:                      stringValue("auto_resume") == "true",
+		CreatedOn:                       timeValue("created_on"),
+		ResumedOn:                       timeValue("resumed_on"),
+		UpdatedOn:                       timeValue("updated_on"),
+		Owner:                           stringValue("owner"),
+		Comment:                         stringValue("comment"),
+		EnableQueryAcceleration:         stringValue("enable_query_acceleration") == "true",
+		QueryAccelerationMaxScaleFactor: intValue("query_acceleration_max_scale_factor"),
+		ResourceMonitor:                 stringValue("resource_monitor"),
+		ScalingPolicy:                   stringValue("scaling_policy"),
+		OwnerRoleType:                   nullString("owner_role_type"),
+		ResourceConstraint:              nullString("resource_constraint"),
+		Generation:                      nullString("generation"),
+	}
+}
+
+// TestRestApiStandIn_WarehouseGenerationAndResourceConstraint makes sure the warehouse properties not returned by the REST API
+// are read with SHOW WAREHOUSES, so they round-trip with the REST API backend.
+func TestRestApiStandIn_WarehouseGenerationAndResourceConstraint(t *testing.T) {
+	standIn := newRestApiStandIn(t, "warehouse.yaml")
+	client := standIn.client()
+	ctx := context.Background()
+
+	// for the standard warehouses, only the generation is returned, like with the SQL backend
+	t.Run("standard warehouse", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+		require.NoError(t, client.Warehouses.Create(ctx, id, &CreateWarehouseOptions{
+			Generation: Pointer(WarehouseGenerationStandardGen2),
+		}))
+
+		warehouse, err := client.Warehouses.ShowByID(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, warehouse.Generation)
+		assert.Equal(t, WarehouseGenerationStandardGen2, *warehouse.Generation)
+		assert.Nil(t, warehouse.ResourceConstraint)
+
+		require.NoError(t, client.Warehouses.Alter(ctx, id, &AlterWarehouseOptions{Set: &WarehouseSet{
+			Generation: Pointer(WarehouseGenerationStandardGen1),
+		}}))
+
+		warehouses, err := client.Warehouses.Show(ctx, &ShowWarehouseOptions{Like: &Like{Pattern: String(id.Name())}})
+		require.NoError(t, err)
+		require.Len(t, warehouses, 1)
+		require.NotNil(t, warehouses[0].Generation)
+		assert.Equal(t, WarehouseGenerationStandardGen1, *warehouses[0].Generation)
+		assert.Nil(t, warehouses[0].ResourceConstraint)
+	})
+
+	t.Run("snowpark-optimized warehouse", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+		require.NoError(t, client.Warehouses.Create(ctx, id, &CreateWarehouseOptions{
+			WarehouseType:      Pointer(WarehouseTypeSnowparkOptimized),
+			ResourceConstraint: Pointer(WarehouseResourceConstraintMemory16X),
+		}))
+
+		warehouse, err := client.Warehouses.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, WarehouseTypeSnowparkOptimized, warehouse.Type)
+		assert.Nil(t, warehouse.Generation)
+		require.NotNil(t, warehouse.ResourceConstraint)
+		assert.Equal(t, WarehouseResourceConstraintMemory16X, *warehouse.ResourceConstraint)
+	})
+
+	t.Run("warehouse without the properties", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+		require.NoError(t, client.Warehouses.Create(ctx, id, nil))
+
+		warehouse, err := client.Warehouses.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Nil(t, warehouse.Generation)
+		assert.Nil(t, warehouse.ResourceConstraint)
+	})
+}
@@ -0,0 +1,257 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ Warehouses = (*restApiWarehouses)(nil)
+
+// restApiWarehouses implements Warehouses using the warehouse REST API (https://docs.snowflake.com/en/developer-guide/snowflake-rest-api/reference/warehouse).
+// The operations (and options) not covered by the REST API are delegated to the embedded SQL implementation.
+type restApiWarehouses struct {
+	Warehouses
+	client *restApiClient
+}
+
+// restApiWarehouse is based on the Warehouse schema from the OpenAPI specification.
+type restApiWarehouse struct {
+	Name                            string     `json:"name"`
+	WarehouseType                   *string    `json:"warehouse_type,omitempty"`
+	WarehouseSize                   *string    `json:"warehouse_size,omitempty"`
+	MaxClusterCount                 *int       `json:"max_cluster_count,omitempty"`
+	MinClusterCount                 *int       `json:"min_cluster_count,omitempty"`
+	ScalingPolicy                   *string    `json:"scaling_policy,omitempty"`
+	AutoSuspend                     *int       `json:"auto_suspend,omitempty"`
+	AutoResume                      *string    `json:"auto_resume,omitempty"`
+	InitiallySuspended              *string    `json:"initially_suspended,omitempty"`
+	ResourceMonitor                 *string    `json:"resource_monitor,omitempty"`
+	Comment                         *string    `json:"comment,omitempty"`
+	EnableQueryAcceleration         *string    `json:"enable_query_acceleration,omitempty"`
+	QueryAccelerationMaxScaleFactor *int       `json:"query_acceleration_max_scale_factor,omitempty"`
+	MaxConcurrencyLevel             *int       `json:"max_concurrency_level,omitempty"`
+	StatementQueuedTimeoutInSeconds *int       `json:"statement_queued_timeout_in_seconds,omitempty"`
+	StatementTimeoutInSeconds       *int       `json:"statement_timeout_in_seconds,omitempty"`
+	State                           *string    `json:"state,omitempty"`
+	StartedClusters                 *int       `json:"started_clusters,omitempty"`
+	Running                         *int       `json:"running,omitempty"`
+	Queued                          *int       `json:"queued,omitempty"`
+	IsDefault                       *bool      `json:"is_default,omitempty"`
+	IsCurrent                       *bool      `json:"is_current,omitempty"`
+	Available                       *string    `json:"available,omitempty"`
+	Provisioning                    *string    `json:"provisioning,omitempty"`
+	Quiescing                       *string    `json:"quiescing,omitempty"`
+	Other                           *string    `json:"other,omitempty"`
+	CreatedOn                       *time.Time `json:"created_on,omitempty"`
+	ResumedOn                       *time.Time `json:"resumed_on,omitempty"`
+	UpdatedOn                       *time.Time `json:"updated_on,omitempty"`
+	Owner                           *string    `json:"owner,omitempty"`
+	OwnerRoleType                   *string    `json:"owner_role_type,omitempty"`
+}
+
+func (w restApiWarehouse) convert() (*Warehouse, error) {
+	wh := &Warehouse{
+		Name:                            restApiParseIdentifier(w.Name),
+		State:                           WarehouseState(restApiValue(w.State)),
+		Type:                            WarehouseType(restApiValue(w.WarehouseType)),
+		MinClusterCount:                 restApiValue(w.MinClusterCount),
+		MaxClusterCount:                 restApiValue(w.MaxClusterCount),
+		StartedClusters:                 restApiValue(w.StartedClusters),
+		Running:                         restApiValue(w.Running),
+		Queued:                          restApiValue(w.Queued),
+		IsDefault:                       w.IsDefault != nil && *w.IsDefault,
+		IsCurrent:                       w.IsCurrent != nil && *w.IsCurrent,
+		AutoSuspend:                     restApiValue(w.AutoSuspend),
+		AutoResume:                      restApiParseBool(w.AutoResume),
+		Owner:                           restApiValue(w.Owner),
+		Comment:                         restApiValue(w.Comment),
+		EnableQueryAcceleration:         restApiParseBool(w.EnableQueryAcceleration),
+		QueryAccelerationMaxScaleFactor: restApiValue(w.QueryAccelerationMaxScaleFactor),
+		ScalingPolicy:                   ScalingPolicy(restApiValue(w.ScalingPolicy)),
+		OwnerRoleType:                   restApiValue(w.OwnerRoleType),
+	}
+	if w.WarehouseSize != nil {
+		size, err := ToWarehouseSize(*w.WarehouseSize)
+		if err != nil {
+			return nil, err
+		}
+		wh.Size = size
+	}
+	for _, percentage := range []struct {
+		name   string
+		value  *string
+		target *float64
+	}{
+		{"available", w.Available, &wh.Available},
+		{"provisioning", w.Provisioning, &wh.Provisioning},
+		{"quiescing", w.Quiescing, &wh.Quiescing},
+		{"other", w.Other, &wh.Other},
+	} {
+		if value := strings.TrimSpace(restApiValue(percentage.value)); value != "" {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf(`field '%s' has incorrect value '%s', %w`, percentage.name, value, err)
+			}
+			*percentage.target = parsed
+		}
+	}
+	if w.CreatedOn != nil {
+		wh.CreatedOn = *w.CreatedOn
+	}
+	if w.ResumedOn != nil {
+		wh.ResumedOn = *w.ResumedOn
+	}
+	if w.UpdatedOn != nil {
+		wh.UpdatedOn = *w.UpdatedOn
+	}
+	if w.ResourceMonitor != nil && *w.ResourceMonitor != "" && *w.ResourceMonitor != "null" {
+		wh.ResourceMonitor = NewAccountObjectIdentifier(restApiParseIdentifier(*w.ResourceMonitor))
+	}
+	return wh, nil
+}
+
+func (c *restApiWarehouses) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateWarehouseOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	// The REST API does not support these properties yet.
+	if anyValueSet(opts.ResourceConstraint, opts.Generation) || len(opts.Tag) > 0 {
+		return c.Warehouses.Create(ctx, id, opts)
+	}
+	body := restApiWarehouse{
+		Name:                            restApiIdentifier(id.Name()),
+		WarehouseType:                   restApiString(opts.WarehouseType),
+		WarehouseSize:                   restApiString(opts.WarehouseSize),
+		ScalingPolicy:                   restApiString(opts.ScalingPolicy),
+		MaxClusterCount:                 opts.MaxClusterCount,
+		MinClusterCount:                 opts.MinClusterCount,
+		AutoSuspend:                     opts.AutoSuspend,
+		AutoResume:                      restApiBool(opts.AutoResume),
+		InitiallySuspended:              restApiBool(opts.InitiallySuspended),
+		Comment:                         opts.Comment,
+		EnableQueryAcceleration:         restApiBool(opts.EnableQueryAcceleration),
+		QueryAccelerationMaxScaleFactor: opts.QueryAccelerationMaxScaleFactor,
+		MaxConcurrencyLevel:             opts.MaxConcurrencyLevel,
+		StatementQueuedTimeoutInSeconds: opts.StatementQueuedTimeoutInSeconds,
+		StatementTimeoutInSeconds:       opts.StatementTimeoutInSeconds,
+	}
+	if opts.ResourceMonitor != nil {
+		body.ResourceMonitor = String(restApiIdentifier(opts.ResourceMonitor.Name()))
+	}
+	return c.client.post(ctx, []string{"warehouses"}, restApiCreateMode(opts.OrReplace, opts.IfNotExists), body)
+}
+
+func (c *restApiWarehouses) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterWarehouseOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	name := restApiIdentifier(id.Name())
+	query := restApiIfExists(opts.IfExists)
+	switch {
+	case opts.NewName != nil:
+		return c.client.post(ctx, []string{"warehouses", name + ":rename"}, query, restApiWarehouse{Name: restApiIdentifier(opts.NewName.Name())})
+	case opts.Suspend != nil && *opts.Suspend:
+		return c.client.post(ctx, []string{"warehouses", name + ":suspend"}, query, nil)
+	// RESUME IF SUSPENDED has no REST API equivalent.
+	case opts.Resume != nil && *opts.Resume && (opts.IfSuspended == nil || !*opts.IfSuspended):
+		return c.client.post(ctx, []string{"warehouses", name + ":resume"}, query, nil)
+	case opts.AbortAllQueries != nil && *opts.AbortAllQueries:
+		return c.client.post(ctx, []string{"warehouses", name + ":abort"}, query, nil)
+	default:
+		return c.Warehouses.Alter(ctx, id, opts)
+	}
+}
+
+func (c *restApiWarehouses) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropWarehouseOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	return c.client.delete(ctx, []string{"warehouses", restApiIdentifier(id.Name())}, restApiIfExists(opts.IfExists))
+}
+
+func (c *restApiWarehouses) DropSafely(ctx context.Context, id AccountObjectIdentifier) error {
+	return c.Drop(ctx, id, &DropWarehouseOptions{IfExists: Bool(true)})
+}
+
+func (c *restApiWarehouses) Show(ctx context.Context, opts *ShowWarehouseOptions) ([]Warehouse, error) {
+	opts = createIfNil(opts)
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	// The warehouse REST API supports only the like filter.
+	if anyValueSet(opts.StartsWith, opts.LimitFrom) {
+		return c.Warehouses.Show(ctx, opts)
+	}
+	var rows []restApiWarehouse
+	if err := c.client.get(ctx, []string{"warehouses"}, restApiShowQuery(opts.Like, nil, nil), &rows); err != nil {
+		return nil, err
+	}
+	warehouses, err := convertRows[restApiWarehouse, Warehouse](rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.setGenerationAndResourceConstraint(ctx, opts.Like, warehouses); err != nil {
+		return nil, err
+	}
+	return warehouses, nil
+}
+
+func (c *restApiWarehouses) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error) {
+	if !ValidObjectIdentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	var row restApiWarehouse
+	if err := c.client.get(ctx, []string{"warehouses", restApiIdentifier(id.Name())}, nil, &row); err != nil {
+		return nil, restApiNotFoundToObjectNotFound(err)
+	}
+	warehouses, err := convertRows[restApiWarehouse, Warehouse]([]restApiWarehouse{row})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.setGenerationAndResourceConstraint(ctx, &Like{Pattern: String(id.Name())}, warehouses); err != nil {
+		return nil, err
+	}
+	return &warehouses[0], nil
+}
+
+// setGenerationAndResourceConstraint fills the warehouse properties not returned by the REST API (GENERATION and RESOURCE_CONSTRAINT)
+// with the values from SHOW WAREHOUSES; otherwise, they would always differ from the configuration.
+func (c *restApiWarehouses) setGenerationAndResourceConstraint(ctx context.Context, like *Like, warehouses []Warehouse) error {
+	if len(warehouses) == 0 {
+		return nil
+	}
+	sqlWarehouses, err := c.Warehouses.Show(ctx, &ShowWarehouseOptions{Like: like})
+	if err != nil {
+		return err
+	}
+	for i := range warehouses {
+		if sqlWarehouse, err := collections.FindFirst(sqlWarehouses, func(w Warehouse) bool { return w.Name == warehouses[i].Name }); err == nil {
+			warehouses[i].Generation = sqlWarehouse.Generation
+			warehouses[i].ResourceConstraint = sqlWarehouse.ResourceConstraint
+		}
+	}
+	return nil
+}
+
+func (c *restApiWarehouses) ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error) {
+	return c.ShowByID(ctx, id)
+}
+
+func (c *restApiWarehouses) ShowByIDExperimental(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error) {
+	return c.ShowByID(ctx, id)
+}
+
+func (c *restApiWarehouses) ShowByIDExperimentalSafely(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error) {
+	return c.ShowByID(ctx, id)
+}
@@ -0,0 +1,171 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestApiWarehouses(t *testing.T) {
+	standIn := newRestApiStandIn(t, "warehouse.yaml")
+	client := standIn.client()
+	ctx := context.Background()
+
+	t.Run("create with defaults", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+
+		require.NoError(t, client.Warehouses.Create(ctx, id, nil))
+		assert.Equal(t, "createMode=errorIfExists", standIn.lastRequest().URL.RawQuery)
+
+		warehouse, err := client.Warehouses.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), warehouse.Name)
+		assert.Equal(t, WarehouseStateStarted, warehouse.State)
+		assert.Equal(t, WarehouseTypeStandard, warehouse.Type)
+		assert.Equal(t, WarehouseSizeXSmall, warehouse.Size)
+		assert.Equal(t, 600, warehouse.AutoSuspend)
+		assert.True(t, warehouse.AutoResume)
+		assert.Empty(t, warehouse.ResourceMonitor.Name())
+		assert.Equal(t, "ACCOUNTADMIN", warehouse.Owner)
+		assert.Equal(t, "ROLE", warehouse.OwnerRoleType)
+		assert.False(t, warehouse.CreatedOn.IsZero())
+	})
+
+	t.Run("create with all the REST API options", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+		resourceMonitorId := randomAccountObjectIdentifier()
+
+		err := client.Warehouses.Create(ctx, id, &CreateWarehouseOptions{
+			OrReplace:                       Bool(true),
+			WarehouseType:                   Pointer(WarehouseTypeSnowparkOptimized),
+			WarehouseSize:                   Pointer(WarehouseSizeMedium),
+			MaxClusterCount:                 Int(3),
+			MinClusterCount:                 Int(2),
+			ScalingPolicy:                   Pointer(ScalingPolicyEconomy),
+			AutoSuspend:                     Int(1200),
+			AutoResume:                      Bool(false),
+			InitiallySuspended:              Bool(true),
+			ResourceMonitor:                 &resourceMonitorId,
+			Comment:                         String("comment"),
+			EnableQueryAcceleration:         Bool(true),
+			QueryAccelerationMaxScaleFactor: Int(4),
+			MaxConcurrencyLevel:             Int(10),
+			StatementQueuedTimeoutInSeconds: Int(20),
+			StatementTimeoutInSeconds:       Int(30),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "createMode=orReplace", standIn.lastRequest().URL.RawQuery)
+
+		warehouse, err := client.Warehouses.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, WarehouseStateSuspended, warehouse.State)
+		assert.Equal(t, WarehouseTypeSnowparkOptimized, warehouse.Type)
+		assert.Equal(t, WarehouseSizeMedium, warehouse.Size)
+		assert.Equal(t, 3, warehouse.MaxClusterCount)
+		assert.Equal(t, 2, warehouse.MinClusterCount)
+		assert.Equal(t, ScalingPolicyEconomy, warehouse.ScalingPolicy)
+		assert.Equal(t, 1200, warehouse.AutoSuspend)
+		assert.False(t, warehouse.AutoResume)
+		assert.Equal(t, resourceMonitorId, warehouse.ResourceMonitor)
+		assert.Equal(t, "comment", warehouse.Comment)
+		assert.True(t, warehouse.EnableQueryAcceleration)
+		assert.Equal(t, 4, warehouse.QueryAccelerationMaxScaleFactor)
+	})
+
+	t.Run("create if not exists", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+		require.NoError(t, client.Warehouses.Create(ctx, id, &CreateWarehouseOptions{Comment: String("first")}))
+
+		err := client.Warehouses.Create(ctx, id, &CreateWarehouseOptions{Comment: String("second")})
+		var apiErr *RestApiError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusConflict, apiErr.StatusCode)
+
+		require.NoError(t, client.Warehouses.Create(ctx, id, &CreateWarehouseOptions{IfNotExists: Bool(true), Comment: String("second")}))
+		warehouse, err := client.Warehouses.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "first", warehouse.Comment)
+	})
+
+	t.Run("show", func(t *testing.T) {
+		prefix := random.StringN(12) + "_"
+		id1 := NewAccountObjectIdentifier(prefix + "A")
+		id2 := NewAccountObjectIdentifier(prefix + "B")
+		require.NoError(t, client.Warehouses.Create(ctx, id1, nil))
+		require.NoError(t, client.Warehouses.Create(ctx, id2, nil))
+
+		warehouses, err := client.Warehouses.Show(ctx, &ShowWarehouseOptions{Like: &Like{Pattern: String(prefix + "%")}})
+		require.NoError(t, err)
+		require.Len(t, warehouses, 2)
+		assert.Equal(t, id1.Name(), warehouses[0].Name)
+		assert.Equal(t, id2.Name(), warehouses[1].Name)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+		newId := randomAccountObjectIdentifier()
+		require.NoError(t, client.Warehouses.Create(ctx, id, nil))
+
+		require.NoError(t, client.Warehouses.Alter(ctx, id, &AlterWarehouseOptions{NewName: &newId}))
+
+		_, err := client.Warehouses.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+		warehouse, err := client.Warehouses.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assert.Equal(t, newId.Name(), warehouse.Name)
+	})
+
+	t.Run("alter: suspend and resume", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+		require.NoError(t, client.Warehouses.Create(ctx, id, nil))
+
+		require.NoError(t, client.Warehouses.Alter(ctx, id, &AlterWarehouseOptions{Suspend: Bool(true)}))
+		warehouse, err := client.Warehouses.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, WarehouseStateSuspended, warehouse.State)
+
+		require.NoError(t, client.Warehouses.Alter(ctx, id, &AlterWarehouseOptions{Resume: Bool(true)}))
+		warehouse, err = client.Warehouses.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, WarehouseStateStarted, warehouse.State)
+
+		require.NoError(t, client.Warehouses.Alter(ctx, id, &AlterWarehouseOptions{AbortAllQueries: Bool(true)}))
+	})
+
+	t.Run("alter: if exists", func(t *testing.T) {
+		require.NoError(t, client.Warehouses.Alter(ctx, randomAccountObjectIdentifier(), &AlterWarehouseOptions{IfExists: Bool(true), Suspend: Bool(true)}))
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		id := randomAccountObjectIdentifier()
+		require.NoError(t, client.Warehouses.Create(ctx, id, nil))
+
+		require.NoError(t, client.Warehouses.Drop(ctx, id, nil))
+
+		_, err := client.Warehouses.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+		require.ErrorIs(t, client.Warehouses.Drop(ctx, id, nil), ErrObjectNotExistOrAuthorized)
+		require.NoError(t, client.Warehouses.Drop(ctx, id, &DropWarehouseOptions{IfExists: Bool(true)}))
+		require.NoError(t, client.Warehouses.DropSafely(ctx, id))
+	})
+
+	t.Run("show by id safely: missing warehouse", func(t *testing.T) {
+		_, err := client.Warehouses.ShowByIDSafely(ctx, randomAccountObjectIdentifier())
+		require.ErrorIs(t, err, ErrObjectNotFound)
+
+		_, err = client.Warehouses.ShowByIDExperimentalSafely(ctx, randomAccountObjectIdentifier())
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		_, err := client.Warehouses.ShowByID(ctx, emptyAccountObjectIdentifier)
+		require.ErrorIs(t, err, ErrInvalidObjectIdentifier)
+
+		err = client.Warehouses.Create(ctx, emptyAccountObjectIdentifier, nil)
+		require.ErrorIs(t, err, ErrInvalidObjectIdentifier)
+	})
+}
@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateSnapshotPolicyRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateSnapshotSetRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
@@ -0,0 +1,191 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+var _ StageFiles = (*stageFiles)(nil)
+
+var (
+	_ validatable = new(PutStageFilesOptions)
+	_ validatable = new(ListStageFilesOptions)
+	_ validatable = new(RemoveStageFilesOptions)
+)
+
+var (
+	_ convertibleRow[StageFileUpload] = new(stageFileUploadRow)
+	_ convertibleRow[StageFile]       = new(stageFileRow)
+)
+
+// StageFiles manages the files on the internal stages. The files are uploaded with the file transfer of the driver.
+type StageFiles interface {
+	Put(ctx context.Context, source string, location StageLocation, opts *PutStageFilesOptions) ([]StageFileUpload, error)
+	List(ctx context.Context, location StageLocation, opts *ListStageFilesOptions) ([]StageFile, error)
+	Remove(ctx context.Context, location StageLocation, opts *RemoveStageFilesOptions) error
+}
+
+type stageFiles struct {
+	client *Client
+}
+
+// PutStageFilesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/put.
+type PutStageFilesOptions struct {
+	put          bool     `ddl:"static" sql:"PUT"`
+	source       string   `ddl:"keyword,single_quotes"`
+	location     Location `ddl:"parameter,single_quotes,no_equals"`
+	Parallel     *int     `ddl:"parameter" sql:"PARALLEL"`
+	AutoCompress *bool    `ddl:"parameter" sql:"AUTO_COMPRESS"`
+	Overwrite    *bool    `ddl:"parameter" sql:"OVERWRITE"`
+}
+
+func (opts *PutStageFilesOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !validStageLocation(opts.location) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.source == "" {
+		errs = append(errs, errNotSet("PutStageFilesOptions", "source"))
+	}
+	if opts.Parallel != nil && !validateIntInRangeInclusive(*opts.Parallel, 1, 99) {
+		errs = append(errs, errIntBetween("PutStageFilesOptions", "Parallel", 1, 99))
+	}
+	return errors.Join(errs...)
+}
+
+type stageFileUploadRow struct {
+	Source            string `db:"source"`
+	Target            string `db:"target"`
+	SourceSize        int64  `db:"source_size"`
+	TargetSize        int64  `db:"target_size"`
+	SourceCompression string `db:"source_compression"`
+	TargetCompression string `db:"target_compression"`
+	Status            string `db:"status"`
+	Message           string `db:"message"`
+}
+
+type StageFileUpload struct {
+	Source     string
+	Target     string
+	SourceSize int64
+	TargetSize int64
+	Status     string
+	Message    string
+}
+
+func (row stageFileUploadRow) convert() (*StageFileUpload, error) {
+	return &StageFileUpload{
+		Source:     row.Source,
+		Target:     row.Target,
+		SourceSize: row.SourceSize,
+		TargetSize: row.TargetSize,
+		Status:     row.Status,
+		Message:    row.Message,
+	}, nil
+}
+
+// Put uploads the local file (or the files matching the wildcard) to the given stage location.
+// The source is a local path, the file:// prefix is added automatically.
+func (v *stageFiles) Put(ctx context.Context, source string, location StageLocation, opts *PutStageFilesOptions) ([]StageFileUpload, error) {
+	opts = createIfNil(opts)
+	opts.source = stageFileSource(source)
+	opts.location = location
+	rows, err := validateAndQuery[stageFileUploadRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[stageFileUploadRow, StageFileUpload](rows)
+}
+
+// ListStageFilesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/list.
+type ListStageFilesOptions struct {
+	list     bool     `ddl:"static" sql:"LIST"`
+	location Location `ddl:"parameter,single_quotes,no_equals"`
+	Pattern  *string  `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+func (opts *ListStageFilesOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	if !validStageLocation(opts.location) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type stageFileRow struct {
+	Name         string `db:"name"`
+	Size         int64  `db:"size"`
+	Md5          string `db:"md5"`
+	LastModified string `db:"last_modified"`
+}
+
+type StageFile struct {
+	// Name is returned by Snowflake in the <lowercase stage name>/<path> format.
+	Name         string
+	Size         int64
+	Md5          string
+	LastModified string
+}
+
+func (row stageFileRow) convert() (*StageFile, error) {
+	return &StageFile{
+		Name:         row.Name,
+		Size:         row.Size,
+		Md5:          row.Md5,
+		LastModified: row.LastModified,
+	}, nil
+}
+
+// PathOnStage returns the path of the file relative to the stage root.
+func (v *StageFile) PathOnStage() string {
+	_, path, _ := strings.Cut(v.Name, "/")
+	return path
+}
+
+func (v *stageFiles) List(ctx context.Context, location StageLocation, opts *ListStageFilesOptions) ([]StageFile, error) {
+	opts = createIfNil(opts)
+	opts.location = location
+	rows, err := validateAndQuery[stageFileRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[stageFileRow, StageFile](rows)
+}
+
+// RemoveStageFilesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/remove.
+type RemoveStageFilesOptions struct {
+	remove   bool     `ddl:"static" sql:"REMOVE"`
+	location Location `ddl:"parameter,single_quotes,no_equals"`
+	Pattern  *string  `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+func (opts *RemoveStageFilesOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	if !validStageLocation(opts.location) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *stageFiles) Remove(ctx context.Context, location StageLocation, opts *RemoveStageFilesOptions) error {
+	opts = createIfNil(opts)
+	opts.location = location
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func validStageLocation(location Location) bool {
+	stageLocation, ok := location.(StageLocation)
+	return ok && ValidObjectIdentifier(stageLocation.stage)
+}
+
+func stageFileSource(source string) string {
+	return "file://" + strings.ReplaceAll(source, `\`, "/")
+}
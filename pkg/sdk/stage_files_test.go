@@ -0,0 +1,153 @@
+package sdk
+
+import "testing"
+
+func TestStageFiles_Put(t *testing.T) {
+	stageId := randomSchemaObjectIdentifier()
+
+	// Minimal valid PutStageFilesOptions
+	defaultOpts := func() *PutStageFilesOptions {
+		return &PutStageFilesOptions{
+			source:   stageFileSource("/tmp/file.py"),
+			location: NewStageLocation(stageId, ""),
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *PutStageFilesOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.location]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.location = NewStageLocation(emptySchemaObjectIdentifier, "path")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: [opts.source] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.source = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("PutStageFilesOptions", "source"))
+	})
+
+	t.Run("validation: [opts.Parallel] should be between 1 and 99", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Parallel = Int(100)
+		assertOptsInvalidJoinedErrors(t, opts, errIntBetween("PutStageFilesOptions", "Parallel", 1, 99))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file:///tmp/file.py' '@\"%s\".\"%s\".\"%s\"'`, stageId.DatabaseName(), stageId.SchemaName(), stageId.Name())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.source = stageFileSource(`C:\tmp\dir\*.py`)
+		opts.location = NewStageLocation(stageId, "some/path")
+		opts.Parallel = Int(4)
+		opts.AutoCompress = Bool(false)
+		opts.Overwrite = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file://C:/tmp/dir/*.py' '@\"%s\".\"%s\".\"%s\"/some/path' PARALLEL = 4 AUTO_COMPRESS = false OVERWRITE = true`, stageId.DatabaseName(), stageId.SchemaName(), stageId.Name())
+	})
+
+	t.Run("path with a space and a quote", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.location = NewStageLocation(stageId, "my dir/it's")
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file:///tmp/file.py' '@\"%s\".\"%s\".\"%s\"/my dir/it\'s'`, stageId.DatabaseName(), stageId.SchemaName(), stageId.Name())
+	})
+}
+
+func TestStageFiles_List(t *testing.T) {
+	stageId := randomSchemaObjectIdentifier()
+
+	// Minimal valid ListStageFilesOptions
+	defaultOpts := func() *ListStageFilesOptions {
+		return &ListStageFilesOptions{
+			location: NewStageLocation(stageId, ""),
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ListStageFilesOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.location]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.location = NewStageLocation(emptySchemaObjectIdentifier, "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `LIST '@\"%s\".\"%s\".\"%s\"'`, stageId.DatabaseName(), stageId.SchemaName(), stageId.Name())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.location = NewStageLocation(stageId, "path")
+		opts.Pattern = String(`.*\.py`)
+		assertOptsValidAndSQLEquals(t, opts, `LIST '@\"%s\".\"%s\".\"%s\"/path' PATTERN = '.*\\.py'`, stageId.DatabaseName(), stageId.SchemaName(), stageId.Name())
+	})
+
+	t.Run("path with a space and a quote", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.location = NewStageLocation(stageId, "my dir/it's")
+		assertOptsValidAndSQLEquals(t, opts, `LIST '@\"%s\".\"%s\".\"%s\"/my dir/it\'s'`, stageId.DatabaseName(), stageId.SchemaName(), stageId.Name())
+	})
+}
+
+func TestStageFiles_Remove(t *testing.T) {
+	stageId := randomSchemaObjectIdentifier()
+
+	// Minimal valid RemoveStageFilesOptions
+	defaultOpts := func() *RemoveStageFilesOptions {
+		return &RemoveStageFilesOptions{
+			location: NewStageLocation(stageId, "path/file.py"),
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *RemoveStageFilesOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.location]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.location = NewStageLocation(emptySchemaObjectIdentifier, "path")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `REMOVE '@\"%s\".\"%s\".\"%s\"/path/file.py'`, stageId.DatabaseName(), stageId.SchemaName(), stageId.Name())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.location = NewStageLocation(stageId, "path")
+		opts.Pattern = String(`.*\.py`)
+		assertOptsValidAndSQLEquals(t, opts, `REMOVE '@\"%s\".\"%s\".\"%s\"/path' PATTERN = '.*\\.py'`, stageId.DatabaseName(), stageId.SchemaName(), stageId.Name())
+	})
+
+	t.Run("path with a space and a quote", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.location = NewStageLocation(stageId, "my dir/it's.py")
+		assertOptsValidAndSQLEquals(t, opts, `REMOVE '@\"%s\".\"%s\".\"%s\"/my dir/it\'s.py'`, stageId.DatabaseName(), stageId.SchemaName(), stageId.Name())
+	})
+}
+
+func TestStageFile_PathOnStage(t *testing.T) {
+	for name, expected := range map[string]string{
+		"my_stage/file.py":           "file.py",
+		"my_stage/some/path/file.py": "some/path/file.py",
+		"my_stage":                   "",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if actual := (&StageFile{Name: name}).PathOnStage(); actual != expected {
+				t.Errorf("expected %s, got %s", expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package sdk
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+func (r *CreateStorageLifecyclePolicyRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+type StorageLifecyclePolicyArchiveTier string
+
+const (
+	StorageLifecyclePolicyArchiveTierCool StorageLifecyclePolicyArchiveTier = "COOL"
+	StorageLifecyclePolicyArchiveTierCold StorageLifecyclePolicyArchiveTier = "COLD"
+)
+
+var AllStorageLifecyclePolicyArchiveTiers = []StorageLifecyclePolicyArchiveTier{
+	StorageLifecyclePolicyArchiveTierCool,
+	StorageLifecyclePolicyArchiveTierCold,
+}
+
+func ToStorageLifecyclePolicyArchiveTier(s string) (StorageLifecyclePolicyArchiveTier, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllStorageLifecyclePolicyArchiveTiers, StorageLifecyclePolicyArchiveTier(s)) {
+		return "", fmt.Errorf("invalid storage lifecycle policy archive tier: %s", s)
+	}
+	return StorageLifecyclePolicyArchiveTier(s), nil
+}
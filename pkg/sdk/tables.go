@@ -208,21 +208,25 @@ type alterTableOptions struct {
 	name     SchemaObjectIdentifier `ddl:"identifier"`
 
 	// One of
-	NewName                   *SchemaObjectIdentifier         `ddl:"identifier" sql:"RENAME TO"`
-	SwapWith                  *SchemaObjectIdentifier         `ddl:"identifier" sql:"SWAP WITH"`
-	ClusteringAction          *TableClusteringAction          `ddl:"keyword"`
-	ColumnAction              *TableColumnAction              `ddl:"keyword"`
-	ConstraintAction          *TableConstraintAction          `ddl:"keyword"`
-	ExternalTableAction       *TableExternalTableAction       `ddl:"keyword"`
-	SearchOptimizationAction  *TableSearchOptimizationAction  `ddl:"keyword"`
-	Set                       *TableSet                       `ddl:"keyword" sql:"SET"`
-	SetTags                   []TagAssociation                `ddl:"parameter,no_equals" sql:"SET TAG"`
-	UnsetTags                 []ObjectIdentifier              `ddl:"keyword" sql:"UNSET TAG"`
-	Unset                     *TableUnset                     `ddl:"keyword" sql:"UNSET"`
-	AddRowAccessPolicy        *TableAddRowAccessPolicy        `ddl:"keyword"`
-	DropRowAccessPolicy       *TableDropRowAccessPolicy       `ddl:"keyword"`
-	DropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicy `ddl:"list,no_parentheses"`
-	DropAllAccessRowPolicies  *bool                           `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
+	NewName                    *SchemaObjectIdentifier         `ddl:"identifier" sql:"RENAME TO"`
+	SwapWith                   *SchemaObjectIdentifier         `ddl:"identifier" sql:"SWAP WITH"`
+	ClusteringAction           *TableClusteringAction          `ddl:"keyword"`
+	ColumnAction               *TableColumnAction              `ddl:"keyword"`
+	ConstraintAction           *TableConstraintAction          `ddl:"keyword"`
+	ExternalTableAction        *TableExternalTableAction       `ddl:"keyword"`
+	SearchOptimizationAction   *TableSearchOptimizationAction  `ddl:"keyword"`
+	Set                        *TableSet                       `ddl:"keyword" sql:"SET"`
+	SetTags                    []TagAssociation                `ddl:"parameter,no_equals" sql:"SET TAG"`
+	UnsetTags                  []ObjectIdentifier              `ddl:"keyword" sql:"UNSET TAG"`
+	Unset                      *TableUnset                     `ddl:"keyword" sql:"UNSET"`
+	AddRowAccessPolicy         *TableAddRowAccessPolicy        `ddl:"keyword"`
+	DropRowAccessPolicy        *TableDropRowAccessPolicy       `ddl:"keyword"`
+	DropAndAddRowAccessPolicy  *TableDropAndAddRowAccessPolicy `ddl:"list,no_parentheses"`
+	DropAllAccessRowPolicies   *bool                           `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
+	AddPrivacyPolicy           *TableAddPrivacyPolicy          `ddl:"keyword"`
+	DropPrivacyPolicy          *TableDropPrivacyPolicy         `ddl:"keyword"`
+	AddStorageLifecyclePolicy  *TableAddStorageLifecyclePolicy `ddl:"keyword"`
+	DropStorageLifecyclePolicy *bool                           `ddl:"keyword" sql:"DROP STORAGE LIFECYCLE POLICY"`
 }
 
 type TableClusteringAction struct {
@@ -260,6 +264,8 @@ type TableColumnAction struct {
 	SetTags            *TableColumnAlterSetTagsAction            `ddl:"keyword"`
 	UnsetTags          *TableColumnAlterUnsetTagsAction          `ddl:"keyword"`
 	DropColumns        *TableColumnAlterDropColumns              `ddl:"keyword"`
+	SetPrivacyDomain   *TableColumnAlterSetPrivacyDomainAction   `ddl:"keyword"`
+	UnsetPrivacyDomain *TableColumnAlterUnsetPrivacyDomainAction `ddl:"keyword"`
 }
 
 type TableColumnAddAction struct {
@@ -321,6 +327,28 @@ type TableColumnAlterUnsetMaskingPolicyAction struct {
 	setMaskingPolicy bool   `ddl:"static" sql:"UNSET MASKING POLICY"`
 }
 
+// TableColumnAlterSetPrivacyDomainAction is based on https://docs.snowflake.com/en/user-guide/diff-privacy/differential-privacy-domains.
+type TableColumnAlterSetPrivacyDomainAction struct {
+	alter            bool   `ddl:"static" sql:"ALTER COLUMN"`
+	ColumnName       string `ddl:"keyword"`
+	setPrivacyDomain bool   `ddl:"static" sql:"SET PRIVACY DOMAIN"`
+
+	// One of
+	In      []StringListItemWrapper `ddl:"keyword,parentheses" sql:"IN"`
+	Between *PrivacyDomainBetween   `ddl:"list,parentheses" sql:"BETWEEN"`
+}
+
+type PrivacyDomainBetween struct {
+	Lower string `ddl:"keyword"`
+	Upper string `ddl:"keyword"`
+}
+
+type TableColumnAlterUnsetPrivacyDomainAction struct {
+	alter              bool   `ddl:"static" sql:"ALTER COLUMN"`
+	ColumnName         string `ddl:"keyword"`
+	unsetPrivacyDomain bool   `ddl:"static" sql:"UNSET PRIVACY DOMAIN"`
+}
+
 type TableColumnAlterSetTagsAction struct {
 	alter      bool             `ddl:"static" sql:"ALTER COLUMN"`
 	ColumnName string           `ddl:"keyword"`
@@ -484,6 +512,23 @@ type TableDropAndAddRowAccessPolicy struct {
 	Add  TableAddRowAccessPolicy  `ddl:"keyword"`
 }
 
+type TableAddPrivacyPolicy struct {
+	add           bool                   `ddl:"static" sql:"ADD"`
+	PrivacyPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"PRIVACY POLICY"`
+	EntityKey     []Column               `ddl:"parameter,parentheses,no_equals" sql:"ENTITY KEY"`
+}
+
+type TableDropPrivacyPolicy struct {
+	drop          bool                   `ddl:"static" sql:"DROP"`
+	PrivacyPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"PRIVACY POLICY"`
+}
+
+type TableAddStorageLifecyclePolicy struct {
+	add                    bool                   `ddl:"static" sql:"ADD"`
+	StorageLifecyclePolicy SchemaObjectIdentifier `ddl:"identifier" sql:"STORAGE LIFECYCLE POLICY"`
+	On                     []string               `ddl:"keyword,parentheses" sql:"ON"`
+}
+
 // dropTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-table
 type dropTableOptions struct {
 	drop     bool                   `ddl:"static" sql:"DROP"`
@@ -682,6 +727,7 @@ type TableColumnDetails struct {
 	PolicyName            *string
 	Collation             *string
 	SchemaEvolutionRecord *string
+	PrivacyDomain         *string
 }
 
 // tableColumnDetailsRow based on https://docs.snowflake.com/en/sql-reference/sql/desc-table
@@ -698,6 +744,7 @@ type tableColumnDetailsRow struct {
 	Comment               sql.NullString `db:"comment"`
 	PolicyName            sql.NullString `db:"policy name"`
 	SchemaEvolutionRecord sql.NullString `db:"schema evolution record"`
+	PrivacyDomain         sql.NullString `db:"privacy domain"`
 }
 
 func (r tableColumnDetailsRow) convert() (*TableColumnDetails, error) {
@@ -730,6 +777,9 @@ func (r tableColumnDetailsRow) convert() (*TableColumnDetails, error) {
 	if r.SchemaEvolutionRecord.Valid {
 		details.SchemaEvolutionRecord = String(r.SchemaEvolutionRecord.String)
 	}
+	if r.PrivacyDomain.Valid {
+		details.PrivacyDomain = String(r.PrivacyDomain.String)
+	}
 	return details, nil
 }
 
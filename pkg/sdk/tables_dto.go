@@ -171,23 +171,27 @@ type OutOfLineForeignKeyRequest struct {
 }
 
 type AlterTableRequest struct {
-	IfExists                  *bool
-	name                      SchemaObjectIdentifier // required
-	NewName                   *SchemaObjectIdentifier
-	SwapWith                  *SchemaObjectIdentifier
-	ClusteringAction          *TableClusteringActionRequest
-	ColumnAction              *TableColumnActionRequest
-	ConstraintAction          *TableConstraintActionRequest
-	ExternalTableAction       *TableExternalTableActionRequest
-	SearchOptimizationAction  *TableSearchOptimizationActionRequest
-	Set                       *TableSetRequest
-	SetTags                   []TagAssociationRequest
-	UnsetTags                 []ObjectIdentifier
-	Unset                     *TableUnsetRequest
-	AddRowAccessPolicy        *TableAddRowAccessPolicyRequest
-	DropRowAccessPolicy       *TableDropRowAccessPolicyRequest
-	DropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicy
-	DropAllAccessRowPolicies  *bool
+	IfExists                   *bool
+	name                       SchemaObjectIdentifier // required
+	NewName                    *SchemaObjectIdentifier
+	SwapWith                   *SchemaObjectIdentifier
+	ClusteringAction           *TableClusteringActionRequest
+	ColumnAction               *TableColumnActionRequest
+	ConstraintAction           *TableConstraintActionRequest
+	ExternalTableAction        *TableExternalTableActionRequest
+	SearchOptimizationAction   *TableSearchOptimizationActionRequest
+	Set                        *TableSetRequest
+	SetTags                    []TagAssociationRequest
+	UnsetTags                  []ObjectIdentifier
+	Unset                      *TableUnsetRequest
+	AddRowAccessPolicy         *TableAddRowAccessPolicyRequest
+	DropRowAccessPolicy        *TableDropRowAccessPolicyRequest
+	DropAndAddRowAccessPolicy  *TableDropAndAddRowAccessPolicy
+	DropAllAccessRowPolicies   *bool
+	AddPrivacyPolicy           *TableAddPrivacyPolicyRequest
+	DropPrivacyPolicy          *TableDropPrivacyPolicyRequest
+	AddStorageLifecyclePolicy  *TableAddStorageLifecyclePolicyRequest
+	DropStorageLifecyclePolicy *bool
 }
 
 type DropTableRequest struct {
@@ -245,6 +249,20 @@ type TableDropAndAddRowAccessPolicyRequest struct {
 	Add  TableAddRowAccessPolicyRequest  // required
 }
 
+type TableAddPrivacyPolicyRequest struct {
+	PrivacyPolicy SchemaObjectIdentifier // required
+	EntityKey     []Column
+}
+
+type TableDropPrivacyPolicyRequest struct {
+	PrivacyPolicy SchemaObjectIdentifier // required
+}
+
+type TableAddStorageLifecyclePolicyRequest struct {
+	StorageLifecyclePolicy SchemaObjectIdentifier // required
+	On                     []string               // required
+}
+
 type TableUnsetRequest struct {
 	DataRetentionTimeInDays    bool
 	MaxDataExtensionTimeInDays bool
@@ -363,6 +381,8 @@ type TableColumnActionRequest struct {
 	UnsetTags           *TableColumnAlterUnsetTagsActionRequest
 	DropColumnsIfExists *bool
 	DropColumns         []string
+	SetPrivacyDomain    *TableColumnAlterSetPrivacyDomainActionRequest
+	UnsetPrivacyDomain  *TableColumnAlterUnsetPrivacyDomainActionRequest
 }
 
 type TableColumnAddActionRequest struct {
@@ -419,6 +439,18 @@ type TableColumnAlterUnsetMaskingPolicyActionRequest struct {
 	ColumnName string // required
 }
 
+type TableColumnAlterSetPrivacyDomainActionRequest struct {
+	ColumnName string // required
+
+	// One of
+	In      []string
+	Between *PrivacyDomainBetween
+}
+
+type TableColumnAlterUnsetPrivacyDomainActionRequest struct {
+	ColumnName string // required
+}
+
 type TableColumnAlterSetTagsActionRequest struct {
 	ColumnName string           // required
 	Tags       []TagAssociation // required
@@ -652,6 +652,26 @@ func (s *AlterTableRequest) WithDropAllAccessRowPolicies(dropAllAccessRowPolicie
 	return s
 }
 
+func (s *AlterTableRequest) WithAddPrivacyPolicy(addPrivacyPolicy *TableAddPrivacyPolicyRequest) *AlterTableRequest {
+	s.AddPrivacyPolicy = addPrivacyPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithDropPrivacyPolicy(dropPrivacyPolicy *TableDropPrivacyPolicyRequest) *AlterTableRequest {
+	s.DropPrivacyPolicy = dropPrivacyPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithAddStorageLifecyclePolicy(addStorageLifecyclePolicy *TableAddStorageLifecyclePolicyRequest) *AlterTableRequest {
+	s.AddStorageLifecyclePolicy = addStorageLifecyclePolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithDropStorageLifecyclePolicy(dropStorageLifecyclePolicy *bool) *AlterTableRequest {
+	s.DropStorageLifecyclePolicy = dropStorageLifecyclePolicy
+	return s
+}
+
 func NewDropTableRequest(
 	name SchemaObjectIdentifier,
 ) *DropTableRequest {
@@ -693,6 +713,37 @@ func NewTableDropRowAccessPolicyRequest(
 	return &s
 }
 
+func NewTableAddPrivacyPolicyRequest(
+	privacyPolicy SchemaObjectIdentifier,
+) *TableAddPrivacyPolicyRequest {
+	s := TableAddPrivacyPolicyRequest{}
+	s.PrivacyPolicy = privacyPolicy
+	return &s
+}
+
+func (s *TableAddPrivacyPolicyRequest) WithEntityKey(entityKey []Column) *TableAddPrivacyPolicyRequest {
+	s.EntityKey = entityKey
+	return s
+}
+
+func NewTableDropPrivacyPolicyRequest(
+	privacyPolicy SchemaObjectIdentifier,
+) *TableDropPrivacyPolicyRequest {
+	s := TableDropPrivacyPolicyRequest{}
+	s.PrivacyPolicy = privacyPolicy
+	return &s
+}
+
+func NewTableAddStorageLifecyclePolicyRequest(
+	storageLifecyclePolicy SchemaObjectIdentifier,
+	on []string,
+) *TableAddStorageLifecyclePolicyRequest {
+	s := TableAddStorageLifecyclePolicyRequest{}
+	s.StorageLifecyclePolicy = storageLifecyclePolicy
+	s.On = on
+	return &s
+}
+
 func NewTableDropAndAddRowAccessPolicyRequest(
 	drop TableDropRowAccessPolicyRequest,
 	add TableAddRowAccessPolicyRequest,
@@ -1147,6 +1198,16 @@ func (s *TableColumnActionRequest) WithDropColumns(dropColumns []string) *TableC
 	return s
 }
 
+func (s *TableColumnActionRequest) WithSetPrivacyDomain(setPrivacyDomain *TableColumnAlterSetPrivacyDomainActionRequest) *TableColumnActionRequest {
+	s.SetPrivacyDomain = setPrivacyDomain
+	return s
+}
+
+func (s *TableColumnActionRequest) WithUnsetPrivacyDomain(unsetPrivacyDomain *TableColumnAlterUnsetPrivacyDomainActionRequest) *TableColumnActionRequest {
+	s.UnsetPrivacyDomain = unsetPrivacyDomain
+	return s
+}
+
 func NewTableColumnAddActionRequest(
 	name string,
 	dataType DataType,
@@ -1313,6 +1374,32 @@ func NewTableColumnAlterUnsetMaskingPolicyActionRequest(
 	return &s
 }
 
+func NewTableColumnAlterSetPrivacyDomainActionRequest(
+	columnName string,
+) *TableColumnAlterSetPrivacyDomainActionRequest {
+	s := TableColumnAlterSetPrivacyDomainActionRequest{}
+	s.ColumnName = columnName
+	return &s
+}
+
+func (s *TableColumnAlterSetPrivacyDomainActionRequest) WithIn(in []string) *TableColumnAlterSetPrivacyDomainActionRequest {
+	s.In = in
+	return s
+}
+
+func (s *TableColumnAlterSetPrivacyDomainActionRequest) WithBetween(between *PrivacyDomainBetween) *TableColumnAlterSetPrivacyDomainActionRequest {
+	s.Between = between
+	return s
+}
+
+func NewTableColumnAlterUnsetPrivacyDomainActionRequest(
+	columnName string,
+) *TableColumnAlterUnsetPrivacyDomainActionRequest {
+	s := TableColumnAlterUnsetPrivacyDomainActionRequest{}
+	s.ColumnName = columnName
+	return &s
+}
+
 func NewTableColumnAlterSetTagsActionRequest(
 	columnName string,
 	tags []TagAssociation,
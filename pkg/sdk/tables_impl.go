@@ -199,24 +199,49 @@ func (s *AlterTableRequest) toOpts() *alterTableOptions {
 		}
 	}
 
+	var addPrivacyPolicy *TableAddPrivacyPolicy
+	if s.AddPrivacyPolicy != nil {
+		addPrivacyPolicy = &TableAddPrivacyPolicy{
+			PrivacyPolicy: s.AddPrivacyPolicy.PrivacyPolicy,
+			EntityKey:     s.AddPrivacyPolicy.EntityKey,
+		}
+	}
+	var dropPrivacyPolicy *TableDropPrivacyPolicy
+	if s.DropPrivacyPolicy != nil {
+		dropPrivacyPolicy = &TableDropPrivacyPolicy{
+			PrivacyPolicy: s.DropPrivacyPolicy.PrivacyPolicy,
+		}
+	}
+	var addStorageLifecyclePolicy *TableAddStorageLifecyclePolicy
+	if s.AddStorageLifecyclePolicy != nil {
+		addStorageLifecyclePolicy = &TableAddStorageLifecyclePolicy{
+			StorageLifecyclePolicy: s.AddStorageLifecyclePolicy.StorageLifecyclePolicy,
+			On:                     s.AddStorageLifecyclePolicy.On,
+		}
+	}
+
 	return &alterTableOptions{
-		IfExists:                  s.IfExists,
-		name:                      s.name,
-		NewName:                   s.NewName,
-		SwapWith:                  s.SwapWith,
-		ClusteringAction:          clusteringAction,
-		ColumnAction:              columnAction,
-		ConstraintAction:          constraintAction,
-		ExternalTableAction:       externalTableAction,
-		SearchOptimizationAction:  searchOptimizationAction,
-		Set:                       tableSet,
-		SetTags:                   tagAssociations,
-		UnsetTags:                 s.UnsetTags,
-		Unset:                     tableUnset,
-		AddRowAccessPolicy:        addRowAccessPolicy,
-		DropRowAccessPolicy:       dropRowAccessPolicy,
-		DropAndAddRowAccessPolicy: dropAndAddRowAccessPolicy,
-		DropAllAccessRowPolicies:  s.DropAllAccessRowPolicies,
+		IfExists:                   s.IfExists,
+		name:                       s.name,
+		NewName:                    s.NewName,
+		SwapWith:                   s.SwapWith,
+		ClusteringAction:           clusteringAction,
+		ColumnAction:               columnAction,
+		ConstraintAction:           constraintAction,
+		ExternalTableAction:        externalTableAction,
+		SearchOptimizationAction:   searchOptimizationAction,
+		Set:                        tableSet,
+		SetTags:                    tagAssociations,
+		UnsetTags:                  s.UnsetTags,
+		Unset:                      tableUnset,
+		AddRowAccessPolicy:         addRowAccessPolicy,
+		DropRowAccessPolicy:        dropRowAccessPolicy,
+		DropAndAddRowAccessPolicy:  dropAndAddRowAccessPolicy,
+		DropAllAccessRowPolicies:   s.DropAllAccessRowPolicies,
+		AddPrivacyPolicy:           addPrivacyPolicy,
+		DropPrivacyPolicy:          dropPrivacyPolicy,
+		AddStorageLifecyclePolicy:  addStorageLifecyclePolicy,
+		DropStorageLifecyclePolicy: s.DropStorageLifecyclePolicy,
 	}
 }
 
@@ -484,6 +509,26 @@ func (r *TableColumnActionRequest) toOpts() *TableColumnAction {
 			},
 		}
 	}
+	if r.SetPrivacyDomain != nil {
+		var in []StringListItemWrapper
+		for _, value := range r.SetPrivacyDomain.In {
+			in = append(in, StringListItemWrapper{Value: value})
+		}
+		return &TableColumnAction{
+			SetPrivacyDomain: &TableColumnAlterSetPrivacyDomainAction{
+				ColumnName: r.SetPrivacyDomain.ColumnName,
+				In:         in,
+				Between:    r.SetPrivacyDomain.Between,
+			},
+		}
+	}
+	if r.UnsetPrivacyDomain != nil {
+		return &TableColumnAction{
+			UnsetPrivacyDomain: &TableColumnAlterUnsetPrivacyDomainAction{
+				ColumnName: r.UnsetPrivacyDomain.ColumnName,
+			},
+		}
+	}
 	return nil
 }
 
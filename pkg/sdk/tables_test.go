@@ -734,7 +734,7 @@ func TestTableAlter(t *testing.T) {
 
 	t.Run("validation: no action", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "AddPrivacyPolicy", "DropPrivacyPolicy", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy"))
 	})
 
 	t.Run("validation: incorrect identifier", func(t *testing.T) {
@@ -748,7 +748,7 @@ func TestTableAlter(t *testing.T) {
 		opts.NewName = Pointer(randomSchemaObjectIdentifier())
 		opts.SwapWith = Pointer(randomSchemaObjectIdentifier())
 
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "AddPrivacyPolicy", "DropPrivacyPolicy", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy"))
 	})
 
 	t.Run("validation: NewName's incorrect identifier", func(t *testing.T) {
@@ -784,7 +784,7 @@ func TestTableAlter(t *testing.T) {
 	t.Run("validation: column action - no option present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.ColumnAction = &TableColumnAction{}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns", "SetPrivacyDomain", "UnsetPrivacyDomain"))
 	})
 
 	t.Run("validation: column action - two options present", func(t *testing.T) {
@@ -796,7 +796,7 @@ func TestTableAlter(t *testing.T) {
 				OldName: "old",
 			},
 		}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns", "SetPrivacyDomain", "UnsetPrivacyDomain"))
 	})
 
 	t.Run("validation: column action alter - no option present", func(t *testing.T) {
@@ -1089,6 +1089,59 @@ func TestTableAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 UNSET MASKING POLICY", id.FullyQualifiedName())
 	})
 
+	t.Run("validation: set privacy domain without values", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				SetPrivacyDomain: &TableColumnAlterSetPrivacyDomainAction{
+					ColumnName: "COLUMN_1",
+				},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("TableColumnAlterSetPrivacyDomainAction", "In", "Between"))
+	})
+
+	t.Run("alter: set privacy domain in", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				SetPrivacyDomain: &TableColumnAlterSetPrivacyDomainAction{
+					ColumnName: "COLUMN_1",
+					In:         []StringListItemWrapper{{Value: "a"}, {Value: "b"}},
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 SET PRIVACY DOMAIN IN ('a', 'b')", id.FullyQualifiedName())
+	})
+
+	t.Run("alter: set privacy domain between", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				SetPrivacyDomain: &TableColumnAlterSetPrivacyDomainAction{
+					ColumnName: "COLUMN_1",
+					Between: &PrivacyDomainBetween{
+						Lower: "0",
+						Upper: "100",
+					},
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 SET PRIVACY DOMAIN BETWEEN (0, 100)", id.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset privacy domain", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				UnsetPrivacyDomain: &TableColumnAlterUnsetPrivacyDomainAction{
+					ColumnName: "COLUMN_1",
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 UNSET PRIVACY DOMAIN", id.FullyQualifiedName())
+	})
+
 	t.Run("alter: set tags", func(t *testing.T) {
 		tagId1 := randomSchemaObjectIdentifier()
 		tagId2 := randomSchemaObjectIdentifierInSchema(tagId1.SchemaId())
@@ -1450,6 +1503,74 @@ func TestTableAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s DROP ALL ROW ACCESS POLICIES`, id.FullyQualifiedName())
 	})
+
+	t.Run("add privacy policy", func(t *testing.T) {
+		privacyPolicyId := randomSchemaObjectIdentifier()
+
+		opts := &alterTableOptions{
+			name: id,
+			AddPrivacyPolicy: &TableAddPrivacyPolicy{
+				PrivacyPolicy: privacyPolicyId,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s ADD PRIVACY POLICY %s`, id.FullyQualifiedName(), privacyPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("add privacy policy with entity key", func(t *testing.T) {
+		privacyPolicyId := randomSchemaObjectIdentifier()
+
+		opts := &alterTableOptions{
+			name: id,
+			AddPrivacyPolicy: &TableAddPrivacyPolicy{
+				PrivacyPolicy: privacyPolicyId,
+				EntityKey:     []Column{{"FIRST_COLUMN"}, {"SECOND_COLUMN"}},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s ADD PRIVACY POLICY %s ENTITY KEY ("FIRST_COLUMN", "SECOND_COLUMN")`, id.FullyQualifiedName(), privacyPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("drop privacy policy", func(t *testing.T) {
+		privacyPolicyId := randomSchemaObjectIdentifier()
+
+		opts := &alterTableOptions{
+			name: id,
+			DropPrivacyPolicy: &TableDropPrivacyPolicy{
+				PrivacyPolicy: privacyPolicyId,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s DROP PRIVACY POLICY %s`, id.FullyQualifiedName(), privacyPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("add storage lifecycle policy", func(t *testing.T) {
+		storageLifecyclePolicyId := randomSchemaObjectIdentifier()
+
+		opts := &alterTableOptions{
+			name: id,
+			AddStorageLifecyclePolicy: &TableAddStorageLifecyclePolicy{
+				StorageLifecyclePolicy: storageLifecyclePolicyId,
+				On:                     []string{"FIRST_COLUMN", "SECOND_COLUMN"},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s ADD STORAGE LIFECYCLE POLICY %s ON (FIRST_COLUMN, SECOND_COLUMN)`, id.FullyQualifiedName(), storageLifecyclePolicyId.FullyQualifiedName())
+	})
+
+	t.Run("validation: add storage lifecycle policy without columns", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			AddStorageLifecyclePolicy: &TableAddStorageLifecyclePolicy{
+				StorageLifecyclePolicy: emptySchemaObjectIdentifier,
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("TableAddStorageLifecyclePolicy", "StorageLifecyclePolicy"), errNotSet("TableAddStorageLifecyclePolicy", "On"))
+	})
+
+	t.Run("drop storage lifecycle policy", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name:                       id,
+			DropStorageLifecyclePolicy: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s DROP STORAGE LIFECYCLE POLICY`, id.FullyQualifiedName())
+	})
 }
 
 func TestTableDrop(t *testing.T) {
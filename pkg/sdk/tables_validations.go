@@ -159,8 +159,12 @@ func (opts *alterTableOptions) validate() error {
 		opts.DropRowAccessPolicy,
 		opts.DropAndAddRowAccessPolicy,
 		opts.DropAllAccessRowPolicies,
+		opts.AddPrivacyPolicy,
+		opts.DropPrivacyPolicy,
+		opts.AddStorageLifecyclePolicy,
+		opts.DropStorageLifecyclePolicy,
 	); !ok {
-		errs = append(errs, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		errs = append(errs, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "AddPrivacyPolicy", "DropPrivacyPolicy", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy"))
 	}
 	if opts.NewName != nil {
 		if !ValidObjectIdentifier(*opts.NewName) {
@@ -192,8 +196,15 @@ func (opts *alterTableOptions) validate() error {
 			columnAction.SetTags,
 			columnAction.UnsetTags,
 			columnAction.DropColumns,
+			columnAction.SetPrivacyDomain,
+			columnAction.UnsetPrivacyDomain,
 		); !ok {
-			errs = append(errs, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+			errs = append(errs, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns", "SetPrivacyDomain", "UnsetPrivacyDomain"))
+		}
+		if setPrivacyDomain := columnAction.SetPrivacyDomain; valueSet(setPrivacyDomain) {
+			if ok := exactlyOneValueSet(setPrivacyDomain.In, setPrivacyDomain.Between); !ok {
+				errs = append(errs, errExactlyOneOf("TableColumnAlterSetPrivacyDomainAction", "In", "Between"))
+			}
 		}
 		for _, alterAction := range columnAction.Alter {
 			if ok := exactlyOneValueSet(
@@ -265,6 +276,14 @@ func (opts *alterTableOptions) validate() error {
 			errs = append(errs, errExactlyOneOf("TableSearchOptimizationAction", "Add", "Drop"))
 		}
 	}
+	if addStorageLifecyclePolicy := opts.AddStorageLifecyclePolicy; valueSet(addStorageLifecyclePolicy) {
+		if !ValidObjectIdentifier(addStorageLifecyclePolicy.StorageLifecyclePolicy) {
+			errs = append(errs, errInvalidIdentifier("TableAddStorageLifecyclePolicy", "StorageLifecyclePolicy"))
+		}
+		if len(addStorageLifecyclePolicy.On) == 0 {
+			errs = append(errs, errNotSet("TableAddStorageLifecyclePolicy", "On"))
+		}
+	}
 	return errors.Join(errs...)
 }
 
@@ -30,6 +30,7 @@ var (
 		ObjectTypeAlert,
 		ObjectTypeBudget,
 		ObjectTypeClassification,
+		ObjectTypeDynamicTable,
 		ObjectTypeExternalFunction,
 		ObjectTypeExternalTable,
 		ObjectTypeFunction,
@@ -58,6 +59,7 @@ var (
 	// TODO(SNOW-1229218): Object types should be able tell their id structure and tagAssociationAllowedObjectTypes should be used to filter correct object types.
 	TagAssociationTagObjectTypeIsSchemaObjectType = []ObjectType{
 		ObjectTypeAlert,
+		ObjectTypeDynamicTable,
 		ObjectTypeExternalFunction,
 		ObjectTypeExternalTable,
 		ObjectTypeGitRepository,
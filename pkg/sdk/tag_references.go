@@ -0,0 +1,152 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type TagReferences interface {
+	GetForEntity(ctx context.Context, request *GetForEntityTagReferenceRequest) ([]TagReference, error)
+}
+
+// getForEntityTagReferenceOptions is based on https://docs.snowflake.com/en/sql-reference/functions/tag_references
+type getForEntityTagReferenceOptions struct {
+	selectEverythingFrom bool                    `ddl:"static" sql:"SELECT * FROM TABLE"`
+	parameters           *tagReferenceParameters `ddl:"list,parentheses,no_comma"`
+}
+
+type tagReferenceParameters struct {
+	functionFullyQualifiedName bool                           `ddl:"static" sql:"SNOWFLAKE.INFORMATION_SCHEMA.TAG_REFERENCES"`
+	arguments                  *tagReferenceFunctionArguments `ddl:"list,parentheses"`
+}
+
+type tagReferenceFunctionArguments struct {
+	objectName   []ObjectIdentifier        `ddl:"parameter,single_quotes,no_equals"`
+	objectDomain *TagReferenceObjectDomain `ddl:"parameter,single_quotes,no_equals"`
+}
+
+type TagReferenceObjectDomain string
+
+const (
+	TagReferenceObjectDomainAccount          TagReferenceObjectDomain = "ACCOUNT"
+	TagReferenceObjectDomainAlert            TagReferenceObjectDomain = "ALERT"
+	TagReferenceObjectDomainColumn           TagReferenceObjectDomain = "COLUMN"
+	TagReferenceObjectDomainComputePool      TagReferenceObjectDomain = "COMPUTE POOL"
+	TagReferenceObjectDomainDatabase         TagReferenceObjectDomain = "DATABASE"
+	TagReferenceObjectDomainDatabaseRole     TagReferenceObjectDomain = "DATABASE ROLE"
+	TagReferenceObjectDomainFailoverGroup    TagReferenceObjectDomain = "FAILOVER GROUP"
+	TagReferenceObjectDomainFunction         TagReferenceObjectDomain = "FUNCTION"
+	TagReferenceObjectDomainIntegration      TagReferenceObjectDomain = "INTEGRATION"
+	TagReferenceObjectDomainNetworkPolicy    TagReferenceObjectDomain = "NETWORK POLICY"
+	TagReferenceObjectDomainProcedure        TagReferenceObjectDomain = "PROCEDURE"
+	TagReferenceObjectDomainReplicationGroup TagReferenceObjectDomain = "REPLICATION GROUP"
+	TagReferenceObjectDomainRole             TagReferenceObjectDomain = "ROLE"
+	TagReferenceObjectDomainSchema           TagReferenceObjectDomain = "SCHEMA"
+	TagReferenceObjectDomainShare            TagReferenceObjectDomain = "SHARE"
+	TagReferenceObjectDomainStage            TagReferenceObjectDomain = "STAGE"
+	TagReferenceObjectDomainStream           TagReferenceObjectDomain = "STREAM"
+	TagReferenceObjectDomainTable            TagReferenceObjectDomain = "TABLE"
+	TagReferenceObjectDomainTask             TagReferenceObjectDomain = "TASK"
+	TagReferenceObjectDomainUser             TagReferenceObjectDomain = "USER"
+	TagReferenceObjectDomainWarehouse        TagReferenceObjectDomain = "WAREHOUSE"
+)
+
+var AllTagReferenceObjectDomains = []TagReferenceObjectDomain{
+	TagReferenceObjectDomainAccount,
+	TagReferenceObjectDomainAlert,
+	TagReferenceObjectDomainColumn,
+	TagReferenceObjectDomainComputePool,
+	TagReferenceObjectDomainDatabase,
+	TagReferenceObjectDomainDatabaseRole,
+	TagReferenceObjectDomainFailoverGroup,
+	TagReferenceObjectDomainFunction,
+	TagReferenceObjectDomainIntegration,
+	TagReferenceObjectDomainNetworkPolicy,
+	TagReferenceObjectDomainProcedure,
+	TagReferenceObjectDomainReplicationGroup,
+	TagReferenceObjectDomainRole,
+	TagReferenceObjectDomainSchema,
+	TagReferenceObjectDomainShare,
+	TagReferenceObjectDomainStage,
+	TagReferenceObjectDomainStream,
+	TagReferenceObjectDomainTable,
+	TagReferenceObjectDomainTask,
+	TagReferenceObjectDomainUser,
+	TagReferenceObjectDomainWarehouse,
+}
+
+func ToTagReferenceObjectDomain(s string) (TagReferenceObjectDomain, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllTagReferenceObjectDomains, TagReferenceObjectDomain(s)) {
+		return "", fmt.Errorf("invalid TagReferenceObjectDomain: %s", s)
+	}
+	return TagReferenceObjectDomain(s), nil
+}
+
+// TagReferenceObjectDomainForObjectType maps the object type to the domain accepted by TAG_REFERENCES.
+// All table-like objects (e.g. views or dynamic tables) are represented by the TABLE domain.
+func TagReferenceObjectDomainForObjectType(objectType ObjectType) (TagReferenceObjectDomain, error) {
+	switch objectType {
+	case ObjectTypeView, ObjectTypeMaterializedView, ObjectTypeExternalTable, ObjectTypeEventTable, ObjectTypeDynamicTable, ObjectTypeIcebergTable:
+		return TagReferenceObjectDomainTable, nil
+	case ObjectTypeExternalFunction:
+		return TagReferenceObjectDomainFunction, nil
+	default:
+		return ToTagReferenceObjectDomain(objectType.String())
+	}
+}
+
+type TagReference struct {
+	TagDatabase    string
+	TagSchema      string
+	TagName        string
+	TagValue       string
+	Level          string
+	ObjectDatabase *string
+	ObjectSchema   *string
+	ObjectName     string
+	Domain         string
+	ColumnName     *string
+}
+
+func (v *TagReference) TagId() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.TagDatabase, v.TagSchema, v.TagName)
+}
+
+type tagReferenceDBRow struct {
+	TagDatabase    string         `db:"TAG_DATABASE"`
+	TagSchema      string         `db:"TAG_SCHEMA"`
+	TagName        string         `db:"TAG_NAME"`
+	TagValue       string         `db:"TAG_VALUE"`
+	Level          string         `db:"LEVEL"`
+	ObjectDatabase sql.NullString `db:"OBJECT_DATABASE"`
+	ObjectSchema   sql.NullString `db:"OBJECT_SCHEMA"`
+	ObjectName     string         `db:"OBJECT_NAME"`
+	Domain         string         `db:"DOMAIN"`
+	ColumnName     sql.NullString `db:"COLUMN_NAME"`
+}
+
+func (row tagReferenceDBRow) convert() (*TagReference, error) {
+	tagReference := TagReference{
+		TagDatabase: row.TagDatabase,
+		TagSchema:   row.TagSchema,
+		TagName:     row.TagName,
+		TagValue:    row.TagValue,
+		Level:       row.Level,
+		ObjectName:  row.ObjectName,
+		Domain:      row.Domain,
+	}
+	if row.ObjectDatabase.Valid {
+		tagReference.ObjectDatabase = &row.ObjectDatabase.String
+	}
+	if row.ObjectSchema.Valid {
+		tagReference.ObjectSchema = &row.ObjectSchema.String
+	}
+	if row.ColumnName.Valid {
+		tagReference.ColumnName = &row.ColumnName.String
+	}
+	return &tagReference, nil
+}
@@ -0,0 +1,21 @@
+package sdk
+
+import "context"
+
+var (
+	_ TagReferences                = new(tagReference)
+	_ convertibleRow[TagReference] = new(tagReferenceDBRow)
+)
+
+type tagReference struct {
+	client *Client
+}
+
+func (v *tagReference) GetForEntity(ctx context.Context, request *GetForEntityTagReferenceRequest) ([]TagReference, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[tagReferenceDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[tagReferenceDBRow, TagReference](dbRows)
+}
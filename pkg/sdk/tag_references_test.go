@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagReferencesGetForEntity(t *testing.T) {
+	t.Run("validation: missing parameters", func(t *testing.T) {
+		opts := &getForEntityTagReferenceOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("getForEntityTagReferenceOptions", "parameters"))
+	})
+
+	t.Run("validation: missing arguments", func(t *testing.T) {
+		opts := &getForEntityTagReferenceOptions{
+			parameters: &tagReferenceParameters{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("tagReferenceParameters", "arguments"))
+	})
+
+	t.Run("validation: missing objectName", func(t *testing.T) {
+		opts := &getForEntityTagReferenceOptions{
+			parameters: &tagReferenceParameters{
+				arguments: &tagReferenceFunctionArguments{
+					objectDomain: Pointer(TagReferenceObjectDomainUser),
+				},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("tagReferenceFunctionArguments", "objectName"))
+	})
+
+	t.Run("validation: missing objectDomain", func(t *testing.T) {
+		opts := &getForEntityTagReferenceOptions{
+			parameters: &tagReferenceParameters{
+				arguments: &tagReferenceFunctionArguments{
+					objectName: []ObjectIdentifier{NewAccountObjectIdentifier("user_name")},
+				},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("tagReferenceFunctionArguments", "objectDomain"))
+	})
+
+	t.Run("user domain", func(t *testing.T) {
+		opts := NewGetForEntityTagReferenceRequest(NewAccountObjectIdentifier("user_name"), TagReferenceObjectDomainUser).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.TAG_REFERENCES ('\"user_name\"', 'USER'))`)
+	})
+
+	t.Run("database role domain", func(t *testing.T) {
+		id := randomDatabaseObjectIdentifier()
+		opts := NewGetForEntityTagReferenceRequest(id, TagReferenceObjectDomainDatabaseRole).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.TAG_REFERENCES ('%s', 'DATABASE ROLE'))`, strings.ReplaceAll(id.FullyQualifiedName(), `"`, `\"`))
+	})
+
+	t.Run("table domain", func(t *testing.T) {
+		id := randomSchemaObjectIdentifier()
+		opts := NewGetForEntityTagReferenceRequest(id, TagReferenceObjectDomainTable).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.TAG_REFERENCES ('%s', 'TABLE'))`, temporaryReplace(id))
+	})
+}
+
+func TestTagReferenceObjectDomainForObjectType(t *testing.T) {
+	testCases := []struct {
+		ObjectType ObjectType
+		Expected   TagReferenceObjectDomain
+	}{
+		{ObjectType: ObjectTypeDatabase, Expected: TagReferenceObjectDomainDatabase},
+		{ObjectType: ObjectTypeDatabaseRole, Expected: TagReferenceObjectDomainDatabaseRole},
+		{ObjectType: ObjectTypeTable, Expected: TagReferenceObjectDomainTable},
+		{ObjectType: ObjectTypeView, Expected: TagReferenceObjectDomainTable},
+		{ObjectType: ObjectTypeDynamicTable, Expected: TagReferenceObjectDomainTable},
+		{ObjectType: ObjectTypeExternalFunction, Expected: TagReferenceObjectDomainFunction},
+		{ObjectType: ObjectTypeWarehouse, Expected: TagReferenceObjectDomainWarehouse},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.ObjectType.String(), func(t *testing.T) {
+			domain, err := TagReferenceObjectDomainForObjectType(tc.ObjectType)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, domain)
+		})
+	}
+
+	t.Run("unsupported object type", func(t *testing.T) {
+		_, err := TagReferenceObjectDomainForObjectType(ObjectTypeSequence)
+		require.ErrorContains(t, err, "invalid TagReferenceObjectDomain: SEQUENCE")
+	})
+}
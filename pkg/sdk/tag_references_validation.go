@@ -0,0 +1,29 @@
+package sdk
+
+import (
+	"errors"
+)
+
+var _ validatable = new(getForEntityTagReferenceOptions)
+
+func (opts *getForEntityTagReferenceOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.parameters) {
+		errs = append(errs, errNotSet("getForEntityTagReferenceOptions", "parameters"))
+	} else {
+		if !valueSet(opts.parameters.arguments) {
+			errs = append(errs, errNotSet("tagReferenceParameters", "arguments"))
+		} else {
+			if opts.parameters.arguments.objectDomain == nil {
+				errs = append(errs, errNotSet("tagReferenceFunctionArguments", "objectDomain"))
+			}
+			if opts.parameters.arguments.objectName == nil {
+				errs = append(errs, errNotSet("tagReferenceFunctionArguments", "objectName"))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
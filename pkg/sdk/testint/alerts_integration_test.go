@@ -4,6 +4,7 @@ package testint
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -73,7 +74,7 @@ func TestInt_AlertsShow(t *testing.T) {
 			In: &sdk.In{
 				Schema: testClientHelper().Ids.SchemaId(),
 			},
-			Limit: sdk.Int(1),
+			Limit: &sdk.LimitFrom{Rows: sdk.Int(1)},
 		}
 		alerts, err := client.Alerts.Show(ctx, showOptions)
 		require.NoError(t, err)
@@ -92,7 +93,9 @@ func TestInt_AlertCreate(t *testing.T) {
 		condition := "SELECT 1"
 		action := "SELECT 1"
 		comment := random.Comment()
-		err := client.Alerts.Create(ctx, id, testClientHelper().Ids.WarehouseId(), schedule, condition, action, &sdk.CreateAlertOptions{
+		err := client.Alerts.Create(ctx, id, condition, action, &sdk.CreateAlertOptions{
+			Warehouse:   sdk.Pointer(testClientHelper().Ids.WarehouseId()),
+			Schedule:    sdk.String(schedule),
 			OrReplace:   sdk.Bool(true),
 			IfNotExists: sdk.Bool(false),
 			Comment:     sdk.String(comment),
@@ -128,7 +131,9 @@ func TestInt_AlertCreate(t *testing.T) {
 		condition := "SELECT 1"
 		action := "SELECT 1"
 		comment := random.Comment()
-		err := client.Alerts.Create(ctx, id, testClientHelper().Ids.WarehouseId(), schedule, condition, action, &sdk.CreateAlertOptions{
+		err := client.Alerts.Create(ctx, id, condition, action, &sdk.CreateAlertOptions{
+			Warehouse:   sdk.Pointer(testClientHelper().Ids.WarehouseId()),
+			Schedule:    sdk.String(schedule),
 			OrReplace:   sdk.Bool(false),
 			IfNotExists: sdk.Bool(true),
 			Comment:     sdk.String(comment),
@@ -163,7 +168,10 @@ func TestInt_AlertCreate(t *testing.T) {
 		schedule := "USING CRON * * * * TUE,THU UTC"
 		condition := "SELECT 1"
 		action := "SELECT 1"
-		err := client.Alerts.Create(ctx, id, testClientHelper().Ids.WarehouseId(), schedule, condition, action, nil)
+		err := client.Alerts.Create(ctx, id, condition, action, &sdk.CreateAlertOptions{
+			Warehouse: sdk.Pointer(testClientHelper().Ids.WarehouseId()),
+			Schedule:  sdk.String(schedule),
+		})
 		require.NoError(t, err)
 		alertDetails, err := client.Alerts.Describe(ctx, id)
 		require.NoError(t, err)
@@ -201,7 +209,10 @@ func TestInt_AlertCreate(t *testing.T) {
 						2
 				end
 		`
-		err := client.Alerts.Create(ctx, id, testClientHelper().Ids.WarehouseId(), schedule, condition, action, nil)
+		err := client.Alerts.Create(ctx, id, condition, action, &sdk.CreateAlertOptions{
+			Warehouse: sdk.Pointer(testClientHelper().Ids.WarehouseId()),
+			Schedule:  sdk.String(schedule),
+		})
 		require.NoError(t, err)
 		alertDetails, err := client.Alerts.Describe(ctx, id)
 		require.NoError(t, err)
@@ -224,6 +235,26 @@ func TestInt_AlertCreate(t *testing.T) {
 		assert.Equal(t, name, alert[0].Name)
 		assert.Equal(t, "", *alert[0].Comment)
 	})
+
+	t.Run("test serverless alert on new data", func(t *testing.T) {
+		table, tableCleanup := testClientHelper().Table.Create(t)
+		t.Cleanup(tableCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		condition := fmt.Sprintf("SELECT * FROM %s", table.ID().FullyQualifiedName())
+		action := "SELECT 1"
+		err := client.Alerts.Create(ctx, id, condition, action, nil)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Alert.DropAlertFunc(t, id))
+
+		alert, err := client.Alerts.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, alert.Warehouse)
+		assert.Empty(t, alert.Schedule)
+		assert.Equal(t, condition, alert.Condition)
+		assert.Equal(t, action, alert.Action)
+		assert.False(t, alert.IsStarted())
+	})
 }
 
 func TestInt_AlertDescribe(t *testing.T) {
@@ -400,7 +431,10 @@ func TestInt_AlertsShowByID(t *testing.T) {
 		t.Helper()
 
 		schedule, condition, action := "USING CRON * * * * * UTC", "SELECT 1", "SELECT 1"
-		err := client.Alerts.Create(ctx, id, warehouseId, schedule, condition, action, &sdk.CreateAlertOptions{})
+		err := client.Alerts.Create(ctx, id, condition, action, &sdk.CreateAlertOptions{
+			Warehouse: sdk.Pointer(warehouseId),
+			Schedule:  sdk.String(schedule),
+		})
 		require.NoError(t, err)
 		t.Cleanup(cleanupAlertHandle(t, id))
 	}
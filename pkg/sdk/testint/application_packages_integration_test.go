@@ -129,6 +129,15 @@ func TestInt_ApplicationPackages(t *testing.T) {
 		require.Len(t, packages, 1)
 		require.Equal(t, *e, packages[0])
 	})
+
+	t.Run("describe application package", func(t *testing.T) {
+		e := createApplicationPackageHandle(t)
+
+		properties, err := client.ApplicationPackages.Describe(ctx, e.ID())
+		require.NoError(t, err)
+		require.NotEmpty(t, properties)
+		assert.Contains(t, properties, sdk.ApplicationPackageProperty{Property: "name", Value: e.Name})
+	})
 }
 
 func TestInt_ApplicationPackagesVersionAndReleaseDirective(t *testing.T) {
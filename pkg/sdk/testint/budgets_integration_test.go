@@ -0,0 +1,107 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Budgets(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	// TODO [SNOW-1007539]: use email of our service user
+	verifiedEmail := "artur.sawicki@snowflake.com"
+
+	t.Run("create, show and drop", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.Budgets.Create(ctx, id, nil)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Budget.DropFunc(t, id))
+
+		budget, err := client.Budgets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, budget.ID())
+		assert.NotEmpty(t, budget.CreatedOn)
+		assert.Equal(t, snowflakeroles.Accountadmin.Name(), budget.Owner)
+
+		err = client.Budgets.Drop(ctx, id, nil)
+		require.NoError(t, err)
+
+		_, err = client.Budgets.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("spending limit and notifications", func(t *testing.T) {
+		budget, budgetCleanup := testClientHelper().Budget.Create(t)
+		t.Cleanup(budgetCleanup)
+		integration, integrationCleanup := testClientHelper().NotificationIntegration.Create(t)
+		t.Cleanup(integrationCleanup)
+
+		err := client.Budgets.SetSpendingLimit(ctx, budget.ID(), 100)
+		require.NoError(t, err)
+
+		spendingLimit, err := client.Budgets.GetSpendingLimit(ctx, budget.ID())
+		require.NoError(t, err)
+		assert.Equal(t, 100, spendingLimit)
+
+		err = client.Budgets.SetEmailNotifications(ctx, budget.ID(), sdk.Pointer(integration.ID()), []string{verifiedEmail})
+		require.NoError(t, err)
+
+		err = client.Budgets.SetNotificationMuteFlag(ctx, budget.ID(), true)
+		require.NoError(t, err)
+
+		mute, err := client.Budgets.GetNotificationMuteFlag(ctx, budget.ID())
+		require.NoError(t, err)
+		assert.True(t, mute)
+	})
+
+	t.Run("add, get and remove linked resources", func(t *testing.T) {
+		budget, budgetCleanup := testClientHelper().Budget.Create(t)
+		t.Cleanup(budgetCleanup)
+		warehouse, warehouseCleanup := testClientHelper().Warehouse.CreateWarehouse(t)
+		t.Cleanup(warehouseCleanup)
+		database, databaseCleanup := testClientHelper().Database.CreateDatabase(t)
+		t.Cleanup(databaseCleanup)
+		task, taskCleanup := testClientHelper().Task.Create(t)
+		t.Cleanup(taskCleanup)
+
+		for _, resource := range []sdk.BudgetResource{
+			{ObjectType: sdk.ObjectTypeWarehouse, Id: warehouse.ID()},
+			{ObjectType: sdk.ObjectTypeDatabase, Id: database.ID()},
+			{ObjectType: sdk.ObjectTypeTask, Id: task.ID()},
+		} {
+			err := client.Budgets.AddResource(ctx, budget.ID(), resource)
+			require.NoError(t, err)
+		}
+
+		linkedResources, err := client.Budgets.GetLinkedResources(ctx, budget.ID())
+		require.NoError(t, err)
+		require.Len(t, linkedResources, 3)
+		assert.ElementsMatch(t,
+			[]string{
+				string(sdk.ObjectTypeWarehouse) + ":" + warehouse.ID().Name(),
+				string(sdk.ObjectTypeDatabase) + ":" + database.ID().Name(),
+				string(sdk.ObjectTypeTask) + ":" + task.ID().Name(),
+			},
+			collections.Map(linkedResources, func(r sdk.BudgetLinkedResource) string { return string(r.Domain) + ":" + r.Name }),
+		)
+
+		err = client.Budgets.RemoveResource(ctx, budget.ID(), sdk.BudgetResource{ObjectType: sdk.ObjectTypeWarehouse, Id: warehouse.ID()})
+		require.NoError(t, err)
+
+		linkedResources, err = client.Budgets.GetLinkedResources(ctx, budget.ID())
+		require.NoError(t, err)
+		require.Len(t, linkedResources, 2)
+		for _, linkedResource := range linkedResources {
+			assert.NotEqual(t, sdk.ObjectTypeWarehouse, linkedResource.Domain)
+		}
+	})
+}
@@ -0,0 +1,115 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_CatalogIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	findProperty := func(t *testing.T, properties []sdk.CatalogIntegrationProperty, name string) sdk.CatalogIntegrationProperty {
+		t.Helper()
+		for _, property := range properties {
+			if property.Name == name {
+				return property
+			}
+		}
+		t.Fatalf("property %s not found", name)
+		return sdk.CatalogIntegrationProperty{}
+	}
+
+	t.Run("create - object store", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.CatalogIntegrations.Create(ctx, sdk.NewCreateCatalogIntegrationRequest(id, sdk.CatalogIntegrationCatalogSourceObjectStore, sdk.CatalogIntegrationTableFormatIceberg, true).
+			WithComment("comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().CatalogIntegration.DropFunc(t, id))
+
+		catalogIntegration, err := client.CatalogIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), catalogIntegration.Name)
+		assert.Equal(t, "CATALOG", catalogIntegration.Category)
+		assert.True(t, catalogIntegration.Enabled)
+		assert.Equal(t, "comment", catalogIntegration.Comment)
+		assert.NotEmpty(t, catalogIntegration.CreatedOn)
+
+		properties, err := client.CatalogIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, string(sdk.CatalogIntegrationCatalogSourceObjectStore), findProperty(t, properties, "CATALOG_SOURCE").Value)
+		assert.Equal(t, string(sdk.CatalogIntegrationTableFormatIceberg), findProperty(t, properties, "TABLE_FORMAT").Value)
+	})
+
+	t.Run("create - glue", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.CatalogIntegrations.Create(ctx, sdk.NewCreateCatalogIntegrationRequest(id, sdk.CatalogIntegrationCatalogSourceGlue, sdk.CatalogIntegrationTableFormatIceberg, false).
+			WithGlueAwsRoleArn("arn:aws:iam::123456789012:role/myrole").
+			WithGlueCatalogId("123456789012").
+			WithGlueRegion("us-west-2").
+			WithCatalogNamespace("namespace").
+			WithRefreshIntervalSeconds(60),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().CatalogIntegration.DropFunc(t, id))
+
+		properties, err := client.CatalogIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, string(sdk.CatalogIntegrationCatalogSourceGlue), findProperty(t, properties, "CATALOG_SOURCE").Value)
+		assert.Equal(t, "namespace", findProperty(t, properties, "CATALOG_NAMESPACE").Value)
+		assert.Equal(t, "60", findProperty(t, properties, "REFRESH_INTERVAL_SECONDS").Value)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		id, cleanup := testClientHelper().CatalogIntegration.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).WithSet(*sdk.NewCatalogIntegrationSetRequest().
+			WithRefreshIntervalSeconds(120).
+			WithComment("new comment"),
+		))
+		require.NoError(t, err)
+
+		catalogIntegration, err := client.CatalogIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", catalogIntegration.Comment)
+
+		err = client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).WithUnset(*sdk.NewCatalogIntegrationUnsetRequest().WithComment(true)))
+		require.NoError(t, err)
+
+		catalogIntegration, err = client.CatalogIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, catalogIntegration.Comment)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		id, cleanup := testClientHelper().CatalogIntegration.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.CatalogIntegrations.Drop(ctx, sdk.NewDropCatalogIntegrationRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.CatalogIntegrations.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("show: with like", func(t *testing.T) {
+		id1, cleanup1 := testClientHelper().CatalogIntegration.Create(t)
+		t.Cleanup(cleanup1)
+		_, cleanup2 := testClientHelper().CatalogIntegration.Create(t)
+		t.Cleanup(cleanup2)
+
+		catalogIntegrations, err := client.CatalogIntegrations.Show(ctx, sdk.NewShowCatalogIntegrationRequest().WithLike(sdk.Like{Pattern: sdk.String(id1.Name())}))
+		require.NoError(t, err)
+		require.Len(t, catalogIntegrations, 1)
+		assert.Equal(t, id1.Name(), catalogIntegrations[0].Name)
+	})
+}
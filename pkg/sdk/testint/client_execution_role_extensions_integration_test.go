@@ -0,0 +1,67 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Client_WithExecutionRole(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	role, roleCleanup := testClientHelper().Role.CreateRoleGrantedToCurrentUser(t)
+	t.Cleanup(roleCleanup)
+	testClientHelper().Grant.GrantPrivilegesOnDatabaseToAccountRole(t, role.ID(), testClientHelper().Ids.DatabaseId(), []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeCreateSchema}, false)
+
+	currentRole, err := client.ContextFunctions.CurrentRole(ctx)
+	require.NoError(t, err)
+
+	t.Run("statements are run with the execution role", func(t *testing.T) {
+		roleClient, release, err := client.WithExecutionRole(ctx, role.ID())
+		require.NoError(t, err)
+		t.Cleanup(release)
+
+		roleClientCurrentRole, err := roleClient.ContextFunctions.CurrentRole(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, role.ID(), roleClientCurrentRole)
+
+		schemaId := testClientHelper().Ids.RandomDatabaseObjectIdentifier()
+		err = roleClient.Schemas.Create(ctx, schemaId, nil)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Schema.DropSchemaFunc(t, schemaId))
+
+		schema, err := roleClient.Schemas.ShowByID(ctx, schemaId)
+		require.NoError(t, err)
+		assert.Equal(t, role.ID().Name(), schema.Owner)
+
+		clientCurrentRole, err := client.ContextFunctions.CurrentRole(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, currentRole, clientCurrentRole)
+	})
+
+	t.Run("the connection is released", func(t *testing.T) {
+		roleClient, release, err := client.WithExecutionRole(ctx, role.ID())
+		require.NoError(t, err)
+		release()
+
+		// the dedicated connection is given back to the pool, so the released client cannot be used anymore
+		_, err = roleClient.ContextFunctions.CurrentRole(ctx)
+		require.ErrorIs(t, err, sql.ErrConnDone)
+
+		clientCurrentRole, err := client.ContextFunctions.CurrentRole(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, currentRole, clientCurrentRole)
+	})
+
+	t.Run("non-existing role", func(t *testing.T) {
+		_, _, err := client.WithExecutionRole(ctx, NonExistingAccountObjectIdentifier)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "using the execution role")
+	})
+}
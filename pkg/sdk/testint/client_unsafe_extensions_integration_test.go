@@ -65,3 +65,40 @@ func TestInt_Client_UnsafeQuery(t *testing.T) {
 		assert.Contains(t, names, db3.Name)
 	})
 }
+
+func TestInt_Client_UnsafeQueryWithColumns(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	t.Run("test bind arguments and column metadata", func(t *testing.T) {
+		results, columns, err := client.QueryUnsafeWithColumns(ctx, "SELECT ? AS TEXT_COLUMN, 1.5::NUMBER(10, 2) AS NUMBER_COLUMN, NULL::VARCHAR(20) AS NULL_COLUMN", "value")
+		require.NoError(t, err)
+
+		require.Len(t, results, 1)
+		row := results[0]
+		require.NotNil(t, row["TEXT_COLUMN"])
+		assert.Equal(t, "value", *row["TEXT_COLUMN"])
+		require.NotNil(t, row["NULL_COLUMN"])
+		assert.Nil(t, *row["NULL_COLUMN"])
+
+		require.Len(t, columns, 3)
+		assert.Equal(t, "TEXT_COLUMN", columns[0].Name)
+		assert.Equal(t, "TEXT", columns[0].DatabaseType)
+		assert.Equal(t, "NUMBER_COLUMN", columns[1].Name)
+		assert.Equal(t, "FIXED", columns[1].DatabaseType)
+		require.NotNil(t, columns[1].Precision)
+		assert.Equal(t, int64(10), *columns[1].Precision)
+		require.NotNil(t, columns[1].Scale)
+		assert.Equal(t, int64(2), *columns[1].Scale)
+		assert.Equal(t, "NULL_COLUMN", columns[2].Name)
+		require.NotNil(t, columns[2].Length)
+		assert.Equal(t, int64(20), *columns[2].Length)
+		require.NotNil(t, columns[2].Nullable)
+		assert.True(t, *columns[2].Nullable)
+	})
+
+	t.Run("test multiple statements are rejected", func(t *testing.T) {
+		_, _, err := client.QueryUnsafeWithColumns(ctx, "SELECT 1; SELECT 2")
+		require.Error(t, err)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/stretchr/testify/assert"
@@ -31,7 +32,7 @@ func TestInt_DynamicTableCreateAndDrop(t *testing.T) {
 		}
 		query := "select id from " + tableTest.ID().FullyQualifiedName()
 		comment := random.Comment()
-		err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(name, testClientHelper().Ids.WarehouseId(), targetLag, query).WithOrReplace(true).WithComment(&comment))
+		err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(name, testClientHelper().Ids.WarehouseId(), query).WithTargetLag(targetLag).WithOrReplace(true).WithComment(&comment))
 		require.NoError(t, err)
 		t.Cleanup(func() {
 			err = client.DynamicTables.Drop(ctx, sdk.NewDropDynamicTableRequest(name))
@@ -61,9 +62,9 @@ func TestInt_DynamicTableCreateAndDrop(t *testing.T) {
 		query, err := tracking.AppendMetadata(plainQuery, tracking.NewVersionedResourceMetadata(resources.DynamicTable, tracking.CreateOperation))
 		require.NoError(t, err)
 
-		err = client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), sdk.TargetLag{
+		err = client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), query).WithTargetLag(sdk.TargetLag{
 			MaximumDuration: sdk.String("2 minutes"),
-		}, query))
+		}))
 		require.NoError(t, err)
 
 		dynamicTable, err := client.DynamicTables.ShowByID(ctx, id)
@@ -79,7 +80,7 @@ func TestInt_DynamicTableCreateAndDrop(t *testing.T) {
 		}
 		query := "select id from " + tableTest.ID().FullyQualifiedName()
 		comment := random.Comment()
-		err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), targetLag, query).WithOrReplace(true).WithComment(&comment))
+		err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), query).WithTargetLag(targetLag).WithOrReplace(true).WithComment(&comment))
 		require.NoError(t, err)
 		t.Cleanup(func() {
 			err = client.DynamicTables.Drop(ctx, sdk.NewDropDynamicTableRequest(id))
@@ -98,6 +99,75 @@ func TestInt_DynamicTableCreateAndDrop(t *testing.T) {
 		require.Contains(t, entity.Text, "refresh_mode = 'AUTO'")
 	})
 
+	t.Run("create or alter", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		targetLag := sdk.TargetLag{
+			MaximumDuration: sdk.String("2 minutes"),
+		}
+		query := "select id from " + tableTest.ID().FullyQualifiedName()
+		err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), query).WithTargetLag(targetLag).WithOrAlter(true))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().DynamicTable.DropDynamicTableFunc(t, id))
+
+		comment := random.Comment()
+		err = client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), query).WithTargetLag(targetLag).WithOrAlter(true).WithComment(&comment))
+		require.NoError(t, err)
+
+		entity, err := client.DynamicTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, comment, entity.Comment)
+		require.Equal(t, *targetLag.MaximumDuration, entity.TargetLag)
+	})
+
+	t.Run("create with all options", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		query := "select id from " + tableTest.ID().FullyQualifiedName()
+		comment := random.Comment()
+		err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), query).
+			WithTransient(true).
+			WithTargetLag(sdk.TargetLag{MaximumDuration: sdk.String("2 minutes")}).
+			WithInitializationWarehouse(testClientHelper().Ids.WarehouseId()).
+			WithRefreshMode(sdk.DynamicTableRefreshModeFull).
+			WithInitialize(sdk.DynamicTableInitializeOnSchedule).
+			WithClusterBy([]string{"id"}).
+			WithDataRetentionTimeInDays(0).
+			WithMaxDataExtensionTimeInDays(5).
+			WithComment(&comment).
+			WithTag([]sdk.TagAssociation{{Name: tag.ID(), Value: "v1"}}),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().DynamicTable.DropDynamicTableFunc(t, id))
+
+		entity, err := client.DynamicTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, comment, entity.Comment)
+		require.Equal(t, "LINEAR(id)", entity.ClusterBy)
+		require.Equal(t, sdk.DynamicTableRefreshModeFull, entity.RefreshMode)
+
+		tagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeDynamicTable)
+		require.NoError(t, err)
+		require.Equal(t, "v1", *tagValue)
+
+		parameters, err := client.DynamicTables.ShowParameters(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "5", helpers.FindParameter(t, parameters, sdk.DynamicTableParameterMaxDataExtensionTimeInDays).Value)
+		assert.Equal(t, sdk.ParameterTypeTable, helpers.FindParameter(t, parameters, sdk.DynamicTableParameterMaxDataExtensionTimeInDays).Level)
+	})
+
+	t.Run("create with disabled scheduler", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		query := "select id from " + tableTest.ID().FullyQualifiedName()
+		err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), query).WithScheduler(sdk.DynamicTableSchedulerDisable))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().DynamicTable.DropDynamicTableFunc(t, id))
+
+		_, err = client.DynamicTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+	})
+
 	t.Run("test complete with refresh mode and initialize", func(t *testing.T) {
 		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
 		targetLag := sdk.TargetLag{
@@ -107,7 +177,7 @@ func TestInt_DynamicTableCreateAndDrop(t *testing.T) {
 		comment := random.Comment()
 		refreshMode := sdk.DynamicTableRefreshModeFull
 		initialize := sdk.DynamicTableInitializeOnSchedule
-		err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), targetLag, query).WithOrReplace(true).WithInitialize(initialize).WithRefreshMode(refreshMode).WithComment(&comment))
+		err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), query).WithTargetLag(targetLag).WithOrReplace(true).WithInitialize(initialize).WithRefreshMode(refreshMode).WithComment(&comment))
 		require.NoError(t, err)
 		t.Cleanup(func() {
 			err = client.DynamicTables.Drop(ctx, sdk.NewDropDynamicTableRequest(id))
@@ -199,7 +269,7 @@ func TestInt_DynamicTableAlter(t *testing.T) {
 
 		err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithSuspend(sdk.Bool(true)).WithResume(sdk.Bool(true)))
 		require.Error(t, err)
-		sdk.ErrorsEqual(t, sdk.JoinErrors(sdk.ErrExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set")), err)
+		sdk.ErrorsEqual(t, sdk.JoinErrors(sdk.ErrExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "RenameTo", "ClusterBy", "DropClusteringKey", "Set", "Unset")), err)
 	})
 
 	t.Run("alter with set", func(t *testing.T) {
@@ -216,6 +286,84 @@ func TestInt_DynamicTableAlter(t *testing.T) {
 			require.Equal(t, value, entities[0].TargetLag)
 		}
 	})
+
+	t.Run("alter with set and unset", func(t *testing.T) {
+		dynamicTable, dynamicTableCleanup := testClientHelper().DynamicTable.CreateDynamicTable(t, table.ID())
+		t.Cleanup(dynamicTableCleanup)
+
+		comment := random.Comment()
+		err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithSet(sdk.NewDynamicTableSetRequest().
+			WithInitializationWarehouse(testClientHelper().Ids.WarehouseId()).
+			WithDataRetentionTimeInDays(2).
+			WithMaxDataExtensionTimeInDays(5).
+			WithComment(comment),
+		))
+		require.NoError(t, err)
+
+		entity, err := client.DynamicTables.ShowByID(ctx, dynamicTable.ID())
+		require.NoError(t, err)
+		require.Equal(t, comment, entity.Comment)
+
+		parameters, err := client.DynamicTables.ShowParameters(ctx, dynamicTable.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "2", helpers.FindParameter(t, parameters, sdk.DynamicTableParameterDataRetentionTimeInDays).Value)
+		assert.Equal(t, sdk.ParameterTypeTable, helpers.FindParameter(t, parameters, sdk.DynamicTableParameterDataRetentionTimeInDays).Level)
+		assert.Equal(t, "5", helpers.FindParameter(t, parameters, sdk.DynamicTableParameterMaxDataExtensionTimeInDays).Value)
+		assert.Equal(t, sdk.ParameterTypeTable, helpers.FindParameter(t, parameters, sdk.DynamicTableParameterMaxDataExtensionTimeInDays).Level)
+
+		err = client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithUnset(sdk.NewDynamicTableUnsetRequest().
+			WithInitializationWarehouse(true).
+			WithDataRetentionTimeInDays(true).
+			WithMaxDataExtensionTimeInDays(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		entity, err = client.DynamicTables.ShowByID(ctx, dynamicTable.ID())
+		require.NoError(t, err)
+		require.Empty(t, entity.Comment)
+
+		parameters, err = client.DynamicTables.ShowParameters(ctx, dynamicTable.ID())
+		require.NoError(t, err)
+		assert.NotEqual(t, sdk.ParameterTypeTable, helpers.FindParameter(t, parameters, sdk.DynamicTableParameterDataRetentionTimeInDays).Level)
+		assert.NotEqual(t, sdk.ParameterTypeTable, helpers.FindParameter(t, parameters, sdk.DynamicTableParameterMaxDataExtensionTimeInDays).Level)
+	})
+
+	t.Run("alter with rename", func(t *testing.T) {
+		dynamicTable, dynamicTableCleanup := testClientHelper().DynamicTable.CreateDynamicTable(t, table.ID())
+		t.Cleanup(dynamicTableCleanup)
+
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().DynamicTable.DropDynamicTableFunc(t, newId))
+
+		_, err = client.DynamicTables.ShowByID(ctx, dynamicTable.ID())
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+
+		entity, err := client.DynamicTables.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		require.Equal(t, newId.Name(), entity.Name)
+	})
+
+	t.Run("alter with cluster by and drop clustering key", func(t *testing.T) {
+		dynamicTable, dynamicTableCleanup := testClientHelper().DynamicTable.CreateDynamicTable(t, table.ID())
+		t.Cleanup(dynamicTableCleanup)
+
+		err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithClusterBy([]string{`"ID"`}))
+		require.NoError(t, err)
+
+		entity, err := client.DynamicTables.ShowByID(ctx, dynamicTable.ID())
+		require.NoError(t, err)
+		require.Equal(t, `LINEAR("ID")`, entity.ClusterBy)
+
+		err = client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithDropClusteringKey(true))
+		require.NoError(t, err)
+
+		entity, err = client.DynamicTables.ShowByID(ctx, dynamicTable.ID())
+		require.NoError(t, err)
+		require.Empty(t, entity.ClusterBy)
+	})
 }
 
 func TestInt_DynamicTablesShowByID(t *testing.T) {
@@ -244,7 +392,7 @@ func TestInt_DynamicTablesShowByID(t *testing.T) {
 			MaximumDuration: sdk.String("2 minutes"),
 		}
 		query := "select id from " + tableTest.ID().FullyQualifiedName()
-		err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, warehouseId, targetLag, query).WithOrReplace(true))
+		err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, warehouseId, query).WithTargetLag(targetLag).WithOrReplace(true))
 		require.NoError(t, err)
 		t.Cleanup(cleanupDynamicTableHandle(t, id))
 	}
@@ -0,0 +1,175 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ExternalAccessIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	findProperty := func(t *testing.T, properties []sdk.ExternalAccessIntegrationProperty, name string) sdk.ExternalAccessIntegrationProperty {
+		t.Helper()
+		for _, property := range properties {
+			if property.Name == name {
+				return property
+			}
+		}
+		t.Fatalf("property %s not found", name)
+		return sdk.ExternalAccessIntegrationProperty{}
+	}
+
+	networkRule, networkRuleCleanup := testClientHelper().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	secondNetworkRule, secondNetworkRuleCleanup := testClientHelper().NetworkRule.Create(t)
+	t.Cleanup(secondNetworkRuleCleanup)
+
+	apiAuthenticationIntegration, apiAuthenticationIntegrationCleanup := testClientHelper().SecurityIntegration.CreateApiAuthenticationWithClientCredentialsFlow(t)
+	t.Cleanup(apiAuthenticationIntegrationCleanup)
+
+	secretId, secretCleanup := testClientHelper().Secret.CreateRandomPasswordSecret(t)
+	t.Cleanup(secretCleanup)
+
+	t.Run("create: basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.ExternalAccessIntegrations.Create(ctx, sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRule.ID()}, true))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ExternalAccessIntegration.DropExternalAccessIntegrationFunc(t, id))
+
+		details, err := testClientHelper().ExternalAccessIntegration.Describe(t, id)
+		require.NoError(t, err)
+
+		assert.True(t, details.Enabled)
+		assert.Equal(t, []sdk.SchemaObjectIdentifier{networkRule.ID()}, details.AllowedNetworkRules)
+		assert.Empty(t, details.AllowedApiAuthenticationIntegrations)
+		assert.Empty(t, details.AllowedAuthenticationSecrets)
+		assert.Empty(t, details.Comment)
+	})
+
+	t.Run("create: complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.ExternalAccessIntegrations.Create(ctx, sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRule.ID(), secondNetworkRule.ID()}, false).
+			WithAllowedApiAuthenticationIntegrations([]sdk.AccountObjectIdentifier{apiAuthenticationIntegration.ID()}).
+			WithAllowedAuthenticationSecrets([]sdk.SchemaObjectIdentifier{secretId}).
+			WithComment("comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ExternalAccessIntegration.DropExternalAccessIntegrationFunc(t, id))
+
+		externalAccessIntegration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, externalAccessIntegration.Enabled)
+		assert.Equal(t, "comment", externalAccessIntegration.Comment)
+
+		details, err := testClientHelper().ExternalAccessIntegration.Describe(t, id)
+		require.NoError(t, err)
+
+		assert.False(t, details.Enabled)
+		assert.ElementsMatch(t, []sdk.SchemaObjectIdentifier{networkRule.ID(), secondNetworkRule.ID()}, details.AllowedNetworkRules)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{apiAuthenticationIntegration.ID()}, details.AllowedApiAuthenticationIntegrations)
+		assert.Equal(t, []sdk.SchemaObjectIdentifier{secretId}, details.AllowedAuthenticationSecrets)
+		assert.Equal(t, "comment", details.Comment)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		id, cleanup := testClientHelper().ExternalAccessIntegration.CreateExternalAccessIntegration(t, networkRule.ID())
+		t.Cleanup(cleanup)
+
+		err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(*sdk.NewExternalAccessIntegrationSetRequest().
+			WithAllowedNetworkRules([]sdk.SchemaObjectIdentifier{secondNetworkRule.ID()}).
+			WithAllowedApiAuthenticationIntegrations([]sdk.AccountObjectIdentifier{apiAuthenticationIntegration.ID()}).
+			WithAllowedAuthenticationSecrets([]sdk.SchemaObjectIdentifier{secretId}).
+			WithEnabled(false).
+			WithComment("comment"),
+		))
+		require.NoError(t, err)
+
+		details, err := testClientHelper().ExternalAccessIntegration.Describe(t, id)
+		require.NoError(t, err)
+
+		assert.False(t, details.Enabled)
+		assert.Equal(t, []sdk.SchemaObjectIdentifier{secondNetworkRule.ID()}, details.AllowedNetworkRules)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{apiAuthenticationIntegration.ID()}, details.AllowedApiAuthenticationIntegrations)
+		assert.Equal(t, []sdk.SchemaObjectIdentifier{secretId}, details.AllowedAuthenticationSecrets)
+		assert.Equal(t, "comment", details.Comment)
+
+		err = client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(*sdk.NewExternalAccessIntegrationUnsetRequest().
+			WithAllowedApiAuthenticationIntegrations(true).
+			WithAllowedAuthenticationSecrets(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		details, err = testClientHelper().ExternalAccessIntegration.Describe(t, id)
+		require.NoError(t, err)
+
+		assert.Empty(t, details.AllowedApiAuthenticationIntegrations)
+		assert.Empty(t, details.AllowedAuthenticationSecrets)
+		assert.Empty(t, details.Comment)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		id, cleanup := testClientHelper().ExternalAccessIntegration.CreateExternalAccessIntegration(t, networkRule.ID())
+		t.Cleanup(cleanup)
+
+		err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("drop safely", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.ExternalAccessIntegrations.DropSafely(ctx, id)
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		id, cleanup := testClientHelper().ExternalAccessIntegration.CreateExternalAccessIntegration(t, networkRule.ID())
+		t.Cleanup(cleanup)
+
+		externalAccessIntegration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assert.Equal(t, id.Name(), externalAccessIntegration.Name)
+		assert.Equal(t, "EXTERNAL_ACCESS", externalAccessIntegration.Type)
+		assert.Equal(t, "SECURITY", externalAccessIntegration.Category)
+		assert.True(t, externalAccessIntegration.Enabled)
+		assert.Empty(t, externalAccessIntegration.Comment)
+		assert.NotEmpty(t, externalAccessIntegration.CreatedOn)
+	})
+
+	t.Run("show: with like", func(t *testing.T) {
+		id1, cleanup1 := testClientHelper().ExternalAccessIntegration.CreateExternalAccessIntegration(t, networkRule.ID())
+		t.Cleanup(cleanup1)
+		_, cleanup2 := testClientHelper().ExternalAccessIntegration.CreateExternalAccessIntegration(t, networkRule.ID())
+		t.Cleanup(cleanup2)
+
+		externalAccessIntegrations, err := client.ExternalAccessIntegrations.Show(ctx, sdk.NewShowExternalAccessIntegrationRequest().WithLike(sdk.Like{Pattern: sdk.String(id1.Name())}))
+		require.NoError(t, err)
+		require.Len(t, externalAccessIntegrations, 1)
+		assert.Equal(t, id1.Name(), externalAccessIntegrations[0].Name)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		id, cleanup := testClientHelper().ExternalAccessIntegration.CreateExternalAccessIntegration(t, networkRule.ID())
+		t.Cleanup(cleanup)
+
+		properties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+
+		assert.Equal(t, "true", findProperty(t, properties, "ENABLED").Value)
+		assert.Contains(t, findProperty(t, properties, "ALLOWED_NETWORK_RULES").Value, networkRule.ID().Name())
+	})
+}
@@ -0,0 +1,159 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_HybridTables(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	t.Run("create - basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.HybridTables.Create(ctx, sdk.NewCreateHybridTableRequest(id, testClientHelper().HybridTable.DefaultColumnsConstraintsAndIndexes()))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().HybridTable.DropFunc(t, id))
+
+		hybridTable, err := client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), hybridTable.Name)
+		assert.Equal(t, id.DatabaseName(), hybridTable.DatabaseName)
+		assert.Equal(t, id.SchemaName(), hybridTable.SchemaName)
+		assert.Equal(t, snowflakeroles.Accountadmin.Name(), hybridTable.Owner)
+		assert.NotEmpty(t, hybridTable.CreatedOn)
+	})
+
+	t.Run("create - complete", func(t *testing.T) {
+		parentId, parentCleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(parentCleanup)
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		columns := []sdk.HybridTableColumnRequest{
+			*sdk.NewHybridTableColumnRequest("ID", sdk.DataTypeNumber).WithNotNull(true),
+			*sdk.NewHybridTableColumnRequest("NAME", "VARCHAR(100)").WithDefaultExpression("'unknown'").WithComment("name column"),
+			*sdk.NewHybridTableColumnRequest("EMAIL", "VARCHAR(200)"),
+			*sdk.NewHybridTableColumnRequest("PARENT_ID", sdk.DataTypeNumber),
+		}
+		err := client.HybridTables.Create(ctx, sdk.NewCreateHybridTableRequest(id, *sdk.NewHybridTableColumnsConstraintsAndIndexesRequest(columns).
+			WithOutOfLineConstraints([]sdk.HybridTableOutOfLineConstraintRequest{
+				*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.HybridTableConstraintTypePrimaryKey, []string{"ID"}).WithName("PK"),
+				*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.HybridTableConstraintTypeUnique, []string{"EMAIL"}),
+				*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.HybridTableConstraintTypeForeignKey, []string{"PARENT_ID"}).
+					WithReferences(*sdk.NewHybridTableForeignKeyReferenceRequest(parentId, []string{"ID"})),
+			}).
+			WithIndexes([]sdk.HybridTableIndexDefinitionRequest{
+				*sdk.NewHybridTableIndexDefinitionRequest("IDX_NAME", []string{"NAME"}).WithIncludeColumns([]string{"EMAIL"}),
+			})).
+			WithDataRetentionTimeInDays(1).
+			WithComment("comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().HybridTable.DropFunc(t, id))
+
+		hybridTable, err := client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), hybridTable.Name)
+		assert.Equal(t, "comment", *hybridTable.Comment)
+
+		indexes, err := client.HybridTables.ShowIndexes(ctx, sdk.NewShowIndexesHybridTableRequest().WithIn(id))
+		require.NoError(t, err)
+
+		var nameIndex *sdk.HybridTableIndex
+		for _, index := range indexes {
+			if index.Name == "IDX_NAME" {
+				nameIndex = &index
+			}
+		}
+		require.NotNil(t, nameIndex)
+		assert.False(t, nameIndex.IsUnique)
+		assert.Equal(t, []string{"NAME"}, nameIndex.Columns)
+		assert.Equal(t, []string{"EMAIL"}, nameIndex.IncludedColumns)
+		assert.Equal(t, id.Name(), nameIndex.TableName)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		id, cleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithSet(*sdk.NewHybridTableSetRequest().
+			WithDataRetentionTimeInDays(2).
+			WithComment("new comment"),
+		))
+		require.NoError(t, err)
+
+		hybridTable, err := client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", *hybridTable.Comment)
+
+		err = client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithUnset(*sdk.NewHybridTableUnsetRequest().
+			WithDataRetentionTimeInDays(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		hybridTable, err = client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, hybridTable.Comment)
+	})
+
+	t.Run("create and drop index", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		_, cleanup := testClientHelper().HybridTable.CreateWithRequest(t, sdk.NewCreateHybridTableRequest(id, *sdk.NewHybridTableColumnsConstraintsAndIndexesRequest([]sdk.HybridTableColumnRequest{
+			*sdk.NewHybridTableColumnRequest("ID", sdk.DataTypeNumber),
+			*sdk.NewHybridTableColumnRequest("NAME", "VARCHAR(100)"),
+		}).WithOutOfLineConstraints([]sdk.HybridTableOutOfLineConstraintRequest{
+			*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.HybridTableConstraintTypePrimaryKey, []string{"ID"}),
+		})))
+		t.Cleanup(cleanup)
+
+		err := client.HybridTables.CreateIndex(ctx, sdk.NewCreateIndexHybridTableRequest("IDX_NAME", id, []string{"NAME"}))
+		require.NoError(t, err)
+
+		indexes, err := client.HybridTables.ShowIndexes(ctx, sdk.NewShowIndexesHybridTableRequest().WithLike(sdk.Like{Pattern: sdk.String("IDX_NAME")}).WithIn(id))
+		require.NoError(t, err)
+		require.Len(t, indexes, 1)
+		assert.Equal(t, []string{"NAME"}, indexes[0].Columns)
+		assert.Empty(t, indexes[0].IncludedColumns)
+
+		err = client.HybridTables.DropIndex(ctx, sdk.NewDropIndexHybridTableRequest(indexes[0].ID()))
+		require.NoError(t, err)
+
+		indexes, err = client.HybridTables.ShowIndexes(ctx, sdk.NewShowIndexesHybridTableRequest().WithLike(sdk.Like{Pattern: sdk.String("IDX_NAME")}).WithIn(id))
+		require.NoError(t, err)
+		assert.Empty(t, indexes)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		id, cleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.HybridTables.Drop(ctx, sdk.NewDropHybridTableRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.HybridTables.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("show: with like", func(t *testing.T) {
+		id1, cleanup1 := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(cleanup1)
+		_, cleanup2 := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(cleanup2)
+
+		hybridTables, err := client.HybridTables.Show(ctx, sdk.NewShowHybridTableRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(id1.Name())}).
+			WithIn(sdk.In{Schema: id1.SchemaId()}),
+		)
+		require.NoError(t, err)
+		require.Len(t, hybridTables, 1)
+		assert.Equal(t, id1.Name(), hybridTables[0].Name)
+	})
+}
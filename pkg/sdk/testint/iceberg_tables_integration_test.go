@@ -0,0 +1,210 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_IcebergTables(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	awsBaseUrl := testenvs.GetOrSkipTest(t, testenvs.AwsExternalBucketUrl)
+	awsRoleArn := testenvs.GetOrSkipTest(t, testenvs.AwsExternalRoleArn)
+
+	externalVolumeId, externalVolumeCleanup := testClientHelper().ExternalVolume.CreateWritableOnS3(t, awsBaseUrl, awsRoleArn)
+	t.Cleanup(externalVolumeCleanup)
+
+	columns := []sdk.IcebergTableColumnRequest{
+		*sdk.NewIcebergTableColumnRequest("id", sdk.DataTypeNumber).WithNotNull(true),
+		*sdk.NewIcebergTableColumnRequest("name", sdk.DataTypeVARCHAR).WithComment("column comment"),
+	}
+
+	assertIcebergTable := func(t *testing.T, icebergTable *sdk.IcebergTable, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, icebergTable.CreatedOn)
+		assert.Equal(t, id.Name(), icebergTable.Name)
+		assert.Equal(t, id.DatabaseName(), icebergTable.DatabaseName)
+		assert.Equal(t, id.SchemaName(), icebergTable.SchemaName)
+		assert.NotEmpty(t, icebergTable.Owner)
+		require.NotNil(t, icebergTable.ExternalVolumeName)
+		assert.Equal(t, externalVolumeId.Name(), *icebergTable.ExternalVolumeName)
+		require.NotNil(t, icebergTable.Comment)
+		assert.Equal(t, comment, *icebergTable.Comment)
+	}
+
+	t.Run("create - basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.IcebergTables.Create(ctx, sdk.NewCreateIcebergTableRequest(id, columns).
+			WithExternalVolume(externalVolumeId).
+			WithCatalog("SNOWFLAKE").
+			WithBaseLocation(id.Name()),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().IcebergTable.DropFunc(t, id))
+
+		icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertIcebergTable(t, icebergTable, id, "")
+	})
+
+	t.Run("create - complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.IcebergTables.Create(ctx, sdk.NewCreateIcebergTableRequest(id, columns).
+			WithIfNotExists(true).
+			WithClusterBy([]string{`"id"`}).
+			WithExternalVolume(externalVolumeId).
+			WithCatalog("SNOWFLAKE").
+			WithBaseLocation(id.Name()).
+			WithStorageSerializationPolicy(sdk.StorageSerializationPolicyCompatible).
+			WithDataRetentionTimeInDays(1).
+			WithMaxDataExtensionTimeInDays(2).
+			WithChangeTracking(true).
+			WithDefaultDdlCollation("en-ci").
+			WithComment("comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().IcebergTable.DropFunc(t, id))
+
+		icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertIcebergTable(t, icebergTable, id, "comment")
+
+		columnDetails, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+		require.NoError(t, err)
+		require.Len(t, columnDetails, 2)
+		assert.False(t, columnDetails[0].IsNullable)
+		require.NotNil(t, columnDetails[1].Comment)
+		assert.Equal(t, "column comment", *columnDetails[1].Comment)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		icebergTable, cleanup := testClientHelper().IcebergTable.Create(t, externalVolumeId)
+		t.Cleanup(cleanup)
+		id := icebergTable.ID()
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSet(*sdk.NewIcebergTableSetRequest().
+			WithDataRetentionTimeInDays(1).
+			WithChangeTracking(true).
+			WithComment("new comment"),
+		))
+		require.NoError(t, err)
+
+		icebergTable, err = client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertIcebergTable(t, icebergTable, id, "new comment")
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithUnset(*sdk.NewIcebergTableUnsetRequest().
+			WithDataRetentionTimeInDays(true).
+			WithChangeTracking(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		icebergTable, err = client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertIcebergTable(t, icebergTable, id, "")
+	})
+
+	t.Run("alter: columns", func(t *testing.T) {
+		icebergTable, cleanup := testClientHelper().IcebergTable.Create(t, externalVolumeId)
+		t.Cleanup(cleanup)
+		id := icebergTable.ID()
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithAddColumn(*sdk.NewIcebergTableColumnRequest("added", sdk.DataTypeVARCHAR)))
+		require.NoError(t, err)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithAlterColumnComment(*sdk.NewIcebergTableAlterColumnCommentRequest("added").WithComment("added comment")))
+		require.NoError(t, err)
+
+		columnDetails, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+		require.NoError(t, err)
+		require.Len(t, columnDetails, 2)
+		require.NotNil(t, columnDetails[1].Comment)
+		assert.Equal(t, "added comment", *columnDetails[1].Comment)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithRenameColumn(*sdk.NewIcebergTableRenameColumnRequest("added", "renamed")))
+		require.NoError(t, err)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithDropColumns([]string{`"renamed"`}))
+		require.NoError(t, err)
+
+		columnDetails, err = client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+		require.NoError(t, err)
+		require.Len(t, columnDetails, 1)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		icebergTable, cleanup := testClientHelper().IcebergTable.Create(t, externalVolumeId)
+		t.Cleanup(cleanup)
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(icebergTable.ID()).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().IcebergTable.DropFunc(t, newId))
+
+		_, err = client.IcebergTables.ShowByID(ctx, newId)
+		require.NoError(t, err)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		icebergTable, cleanup := testClientHelper().IcebergTable.Create(t, externalVolumeId)
+		t.Cleanup(cleanup)
+
+		err := client.IcebergTables.Drop(ctx, sdk.NewDropIcebergTableRequest(icebergTable.ID()))
+		require.NoError(t, err)
+
+		_, err = client.IcebergTables.ShowByID(ctx, icebergTable.ID())
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("show: with like and in", func(t *testing.T) {
+		icebergTable1, cleanup1 := testClientHelper().IcebergTable.Create(t, externalVolumeId)
+		t.Cleanup(cleanup1)
+		icebergTable2, cleanup2 := testClientHelper().IcebergTable.Create(t, externalVolumeId)
+		t.Cleanup(cleanup2)
+
+		icebergTables, err := client.IcebergTables.Show(ctx, sdk.NewShowIcebergTableRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(icebergTable1.Name)}).
+			WithIn(sdk.In{Schema: icebergTable1.ID().SchemaId()}),
+		)
+		require.NoError(t, err)
+		require.Len(t, icebergTables, 1)
+		assert.Equal(t, icebergTable1.Name, icebergTables[0].Name)
+
+		icebergTables, err = client.IcebergTables.Show(ctx, sdk.NewShowIcebergTableRequest().WithIn(sdk.In{Schema: icebergTable2.ID().SchemaId()}))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(icebergTables), 2)
+	})
+
+	t.Run("create from catalog: object store", func(t *testing.T) {
+		catalogIntegrationId, catalogIntegrationCleanup := testClientHelper().CatalogIntegration.Create(t)
+		t.Cleanup(catalogIntegrationCleanup)
+
+		sourceTable, sourceTableCleanup := testClientHelper().IcebergTable.Create(t, externalVolumeId)
+		t.Cleanup(sourceTableCleanup)
+		require.NotNil(t, sourceTable.BaseLocation)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		err := client.IcebergTables.CreateFromCatalog(ctx, sdk.NewCreateFromCatalogIcebergTableRequest(id, catalogIntegrationId).
+			WithExternalVolume(externalVolumeId).
+			WithMetadataFilePath(*sourceTable.BaseLocation+"/metadata/00001-metadata.json").
+			WithComment("comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().IcebergTable.DropFunc(t, id))
+
+		icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, icebergTable.CatalogName)
+		assert.Equal(t, catalogIntegrationId.Name(), *icebergTable.CatalogName)
+	})
+}
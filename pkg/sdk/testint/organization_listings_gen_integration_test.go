@@ -0,0 +1,83 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_OrganizationListings(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	share, shareCleanup := testClientHelper().Share.CreateShare(t)
+	t.Cleanup(shareCleanup)
+	t.Cleanup(testClientHelper().Grant.GrantPrivilegeOnDatabaseToShare(t, testClientHelper().Ids.DatabaseId(), share.ID(), []sdk.ObjectPrivilege{sdk.ObjectPrivilegeUsage}))
+
+	t.Run("create organization listing: with share", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		manifest, title := testClientHelper().Listing.BasicOrganizationManifest(t)
+		comment := random.Comment()
+
+		err := client.OrganizationListings.Create(ctx, sdk.NewCreateOrganizationListingRequest(id, manifest).
+			WithWith(*sdk.NewOrganizationListingWithRequest().WithShare(share.ID())).
+			WithPublish(false).
+			WithComment(comment))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Listing.DropFunc(t, id))
+
+		listing, err := testClientHelper().Listing.Show(t, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), listing.Name)
+		assert.Equal(t, title, listing.Title)
+		assert.Equal(t, sdk.ListingStateDraft, listing.State)
+		assert.Equal(t, comment, *listing.Comment)
+		assert.Equal(t, "INTERNAL", *listing.Distribution)
+	})
+
+	t.Run("create organization listing: if not exists", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		manifest, _ := testClientHelper().Listing.BasicOrganizationManifest(t)
+
+		err := client.OrganizationListings.Create(ctx, sdk.NewCreateOrganizationListingRequest(id, manifest).
+			WithWith(*sdk.NewOrganizationListingWithRequest().WithShare(share.ID())))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Listing.DropFunc(t, id))
+
+		err = client.OrganizationListings.Create(ctx, sdk.NewCreateOrganizationListingRequest(id, manifest).
+			WithIfNotExists(true).
+			WithWith(*sdk.NewOrganizationListingWithRequest().WithShare(share.ID())))
+		require.NoError(t, err)
+	})
+
+	t.Run("show organization listings", func(t *testing.T) {
+		secondShare, secondShareCleanup := testClientHelper().Share.CreateShare(t)
+		t.Cleanup(secondShareCleanup)
+		t.Cleanup(testClientHelper().Grant.GrantPrivilegeOnDatabaseToShare(t, testClientHelper().Ids.DatabaseId(), secondShare.ID(), []sdk.ObjectPrivilege{sdk.ObjectPrivilegeUsage}))
+
+		listing, listingCleanup := testClientHelper().Listing.CreateOrganizationListingWithShare(t, secondShare.ID())
+		t.Cleanup(listingCleanup)
+
+		organizationListings, err := client.OrganizationListings.Show(ctx, sdk.NewShowOrganizationListingRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(listing.Title)}))
+		require.NoError(t, err)
+		require.Len(t, organizationListings, 1)
+
+		organizationListing := organizationListings[0]
+		assert.Equal(t, listing.GlobalName, organizationListing.GlobalName)
+		assert.Equal(t, listing.Title, organizationListing.Title)
+		assert.NotEmpty(t, organizationListing.UniformListingLocator)
+	})
+
+	t.Run("show organization listings: no matches", func(t *testing.T) {
+		organizationListings, err := client.OrganizationListings.Show(ctx, sdk.NewShowOrganizationListingRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(NonExistingAccountObjectIdentifier.Name())}))
+		require.NoError(t, err)
+		assert.Empty(t, organizationListings)
+	})
+}
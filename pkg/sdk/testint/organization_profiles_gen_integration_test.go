@@ -0,0 +1,112 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_OrganizationProfiles(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	assertOrganizationProfile := func(t *testing.T, organizationProfile *sdk.OrganizationProfile, id sdk.AccountObjectIdentifier, title string) {
+		t.Helper()
+		assert.Equal(t, id.Name(), organizationProfile.Name)
+		assert.NotEmpty(t, organizationProfile.CreatedOn)
+		assert.Equal(t, title, *organizationProfile.Title)
+	}
+
+	t.Run("create organization profile: no optionals", func(t *testing.T) {
+		manifest, title := testClientHelper().OrganizationProfile.BasicManifest(t)
+		request := sdk.NewCreateOrganizationProfileRequest(testClientHelper().Ids.RandomAccountObjectIdentifier(), manifest)
+
+		organizationProfile, cleanup := testClientHelper().OrganizationProfile.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertOrganizationProfile(t, organizationProfile, request.GetName(), title)
+		assert.Nil(t, organizationProfile.PublishedOn)
+	})
+
+	t.Run("create organization profile: published", func(t *testing.T) {
+		manifest, title := testClientHelper().OrganizationProfile.BasicManifest(t)
+		request := sdk.NewCreateOrganizationProfileRequest(testClientHelper().Ids.RandomAccountObjectIdentifier(), manifest).
+			WithIfNotExists(true).
+			WithPublish(true)
+
+		organizationProfile, cleanup := testClientHelper().OrganizationProfile.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertOrganizationProfile(t, organizationProfile, request.GetName(), title)
+		assert.NotEmpty(t, organizationProfile.PublishedOn)
+	})
+
+	t.Run("alter organization profile: publish and unpublish", func(t *testing.T) {
+		manifest, _ := testClientHelper().OrganizationProfile.BasicManifest(t)
+		organizationProfile, cleanup := testClientHelper().OrganizationProfile.CreateWithRequest(t, *sdk.NewCreateOrganizationProfileRequest(testClientHelper().Ids.RandomAccountObjectIdentifier(), manifest))
+		t.Cleanup(cleanup)
+		id := organizationProfile.ID()
+
+		err := client.OrganizationProfiles.Alter(ctx, sdk.NewAlterOrganizationProfileRequest(id).WithPublish(true))
+		require.NoError(t, err)
+
+		organizationProfile, err = testClientHelper().OrganizationProfile.Show(t, id)
+		require.NoError(t, err)
+		assert.NotEmpty(t, organizationProfile.PublishedOn)
+
+		err = client.OrganizationProfiles.Alter(ctx, sdk.NewAlterOrganizationProfileRequest(id).WithUnpublish(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("alter organization profile: as", func(t *testing.T) {
+		manifest, _ := testClientHelper().OrganizationProfile.BasicManifest(t)
+		organizationProfile, cleanup := testClientHelper().OrganizationProfile.CreateWithRequest(t, *sdk.NewCreateOrganizationProfileRequest(testClientHelper().Ids.RandomAccountObjectIdentifier(), manifest))
+		t.Cleanup(cleanup)
+		id := organizationProfile.ID()
+
+		newManifest, newTitle := testClientHelper().OrganizationProfile.BasicManifest(t)
+		err := client.OrganizationProfiles.Alter(ctx, sdk.NewAlterOrganizationProfileRequest(id).WithAs(newManifest))
+		require.NoError(t, err)
+
+		organizationProfile, err = testClientHelper().OrganizationProfile.Show(t, id)
+		require.NoError(t, err)
+		assertOrganizationProfile(t, organizationProfile, id, newTitle)
+	})
+
+	t.Run("alter organization profile: rename", func(t *testing.T) {
+		manifest, _ := testClientHelper().OrganizationProfile.BasicManifest(t)
+		organizationProfile, cleanup := testClientHelper().OrganizationProfile.CreateWithRequest(t, *sdk.NewCreateOrganizationProfileRequest(testClientHelper().Ids.RandomAccountObjectIdentifier(), manifest))
+		t.Cleanup(cleanup)
+		id := organizationProfile.ID()
+
+		newId := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		err := client.OrganizationProfiles.Alter(ctx, sdk.NewAlterOrganizationProfileRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().OrganizationProfile.DropFunc(t, newId))
+
+		_, err = client.OrganizationProfiles.ShowByID(ctx, newId)
+		require.NoError(t, err)
+	})
+
+	t.Run("drop organization profile: existing", func(t *testing.T) {
+		organizationProfile, cleanup := testClientHelper().OrganizationProfile.Create(t)
+		t.Cleanup(cleanup)
+		id := organizationProfile.ID()
+
+		err := client.OrganizationProfiles.Drop(ctx, sdk.NewDropOrganizationProfileRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.OrganizationProfiles.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop organization profile: non-existing", func(t *testing.T) {
+		err := client.OrganizationProfiles.Drop(ctx, sdk.NewDropOrganizationProfileRequest(NonExistingAccountObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,142 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_PackagesPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	assertPackagesPolicy := func(t *testing.T, packagesPolicy *sdk.PackagesPolicy, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, packagesPolicy.CreatedOn)
+		assert.Equal(t, id.Name(), packagesPolicy.Name)
+		assert.Equal(t, id.DatabaseName(), packagesPolicy.DatabaseName)
+		assert.Equal(t, id.SchemaName(), packagesPolicy.SchemaName)
+		assert.Equal(t, "PACKAGES_POLICY", packagesPolicy.Kind)
+		assert.Equal(t, "ACCOUNTADMIN", packagesPolicy.Owner)
+		assert.Equal(t, comment, packagesPolicy.Comment)
+		assert.Equal(t, "ROLE", packagesPolicy.OwnerRoleType)
+	}
+
+	t.Run("create packages policy: no optionals", func(t *testing.T) {
+		request := sdk.NewCreatePackagesPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier())
+
+		packagesPolicy, cleanup := testClientHelper().PackagesPolicy.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertPackagesPolicy(t, packagesPolicy, request.GetName(), "")
+
+		description, err := client.PackagesPolicies.Describe(ctx, request.GetName())
+		require.NoError(t, err)
+		assert.Equal(t, request.GetName().Name(), description.Name)
+		assert.Equal(t, "PYTHON", description.Language)
+		assert.Equal(t, []string{"*"}, description.Allowlist)
+		assert.Empty(t, description.Blocklist)
+		assert.Empty(t, description.AdditionalCreationBlocklist)
+	})
+
+	t.Run("create packages policy: full", func(t *testing.T) {
+		request := sdk.NewCreatePackagesPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier()).
+			WithAllowlist([]sdk.StringListItemWrapper{{Value: "numpy"}, {Value: "pandas"}}).
+			WithBlocklist([]sdk.StringListItemWrapper{{Value: "scikit-learn"}}).
+			WithAdditionalCreationBlocklist([]sdk.StringListItemWrapper{{Value: "requests"}}).
+			WithComment("some comment")
+
+		packagesPolicy, cleanup := testClientHelper().PackagesPolicy.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertPackagesPolicy(t, packagesPolicy, request.GetName(), "some comment")
+
+		description, err := client.PackagesPolicies.Describe(ctx, request.GetName())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"numpy", "pandas"}, description.Allowlist)
+		assert.Equal(t, []string{"scikit-learn"}, description.Blocklist)
+		assert.Equal(t, []string{"requests"}, description.AdditionalCreationBlocklist)
+		assert.Equal(t, "some comment", description.Comment)
+	})
+
+	t.Run("drop packages policy: existing", func(t *testing.T) {
+		packagesPolicy, cleanup := testClientHelper().PackagesPolicy.CreateWithRequest(t, *sdk.NewCreatePackagesPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier()))
+		t.Cleanup(cleanup)
+		id := packagesPolicy.ID()
+
+		err := client.PackagesPolicies.Drop(ctx, sdk.NewDropPackagesPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.PackagesPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop packages policy: non-existing", func(t *testing.T) {
+		err := client.PackagesPolicies.Drop(ctx, sdk.NewDropPackagesPolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter packages policy: set and unset", func(t *testing.T) {
+		id, cleanup := testClientHelper().PackagesPolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithSet(*sdk.NewPackagesPolicySetRequest().
+			WithAllowlist([]sdk.StringListItemWrapper{{Value: "numpy"}}).
+			WithBlocklist([]sdk.StringListItemWrapper{{Value: "scikit-learn"}}).
+			WithAdditionalCreationBlocklist([]sdk.StringListItemWrapper{{Value: "requests"}}).
+			WithComment("new comment"),
+		))
+		require.NoError(t, err)
+
+		returnedPackagesPolicy, err := client.PackagesPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertPackagesPolicy(t, returnedPackagesPolicy, id, "new comment")
+
+		description, err := client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"numpy"}, description.Allowlist)
+		assert.Equal(t, []string{"scikit-learn"}, description.Blocklist)
+		assert.Equal(t, []string{"requests"}, description.AdditionalCreationBlocklist)
+
+		err = client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithUnset(*sdk.NewPackagesPolicyUnsetRequest().
+			WithAllowlist(true).
+			WithBlocklist(true).
+			WithAdditionalCreationBlocklist(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		returnedPackagesPolicy, err = client.PackagesPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertPackagesPolicy(t, returnedPackagesPolicy, id, "")
+
+		description, err = client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"*"}, description.Allowlist)
+		assert.Empty(t, description.Blocklist)
+		assert.Empty(t, description.AdditionalCreationBlocklist)
+	})
+
+	t.Run("show packages policy: with like", func(t *testing.T) {
+		id, cleanup := testClientHelper().PackagesPolicy.Create(t)
+		t.Cleanup(cleanup)
+		_, cleanup2 := testClientHelper().PackagesPolicy.Create(t)
+		t.Cleanup(cleanup2)
+
+		returnedPackagesPolicies, err := client.PackagesPolicies.Show(ctx, sdk.NewShowPackagesPolicyRequest().WithLike(sdk.Like{Pattern: sdk.String(id.Name())}))
+		require.NoError(t, err)
+
+		assert.Len(t, returnedPackagesPolicies, 1)
+		assert.Equal(t, id, returnedPackagesPolicies[0].ID())
+	})
+
+	t.Run("describe packages policy: non-existing", func(t *testing.T) {
+		_, err := client.PackagesPolicies.Describe(ctx, NonExistingSchemaObjectIdentifier)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
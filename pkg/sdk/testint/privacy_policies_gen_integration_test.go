@@ -0,0 +1,211 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_PrivacyPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	budgetBody := "PRIVACY_BUDGET(BUDGET_NAME => 'analysts', BUDGET_LIMIT => 233, MAX_BUDGET_PER_AGGREGATE => 1)"
+
+	assertPrivacyPolicy := func(t *testing.T, privacyPolicy *sdk.PrivacyPolicy, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, privacyPolicy.CreatedOn)
+		assert.Equal(t, id.Name(), privacyPolicy.Name)
+		assert.Equal(t, id.DatabaseName(), privacyPolicy.DatabaseName)
+		assert.Equal(t, id.SchemaName(), privacyPolicy.SchemaName)
+		assert.Equal(t, "PRIVACY_POLICY", privacyPolicy.Kind)
+		assert.Equal(t, "ACCOUNTADMIN", privacyPolicy.Owner)
+		assert.Equal(t, comment, privacyPolicy.Comment)
+		assert.Equal(t, "ROLE", privacyPolicy.OwnerRoleType)
+	}
+
+	assertPrivacyPolicyDescription := func(t *testing.T, privacyPolicyDescription *sdk.PrivacyPolicyDescription, id sdk.SchemaObjectIdentifier, expectedBody string) {
+		t.Helper()
+		assert.Equal(t, sdk.PrivacyPolicyDescription{
+			Name:       id.Name(),
+			Signature:  "()",
+			ReturnType: "PRIVACY_BUDGET",
+			Body:       expectedBody,
+		}, *privacyPolicyDescription)
+	}
+
+	t.Run("create privacy policy: no optionals", func(t *testing.T) {
+		request := sdk.NewCreatePrivacyPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), "NO_PRIVACY_POLICY()")
+
+		privacyPolicy, cleanup := testClientHelper().PrivacyPolicy.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertPrivacyPolicy(t, privacyPolicy, request.GetName(), "")
+	})
+
+	t.Run("create privacy policy: full", func(t *testing.T) {
+		request := sdk.NewCreatePrivacyPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), budgetBody).
+			WithComment("some comment")
+
+		privacyPolicy, cleanup := testClientHelper().PrivacyPolicy.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertPrivacyPolicy(t, privacyPolicy, request.GetName(), "some comment")
+
+		description, err := client.PrivacyPolicies.Describe(ctx, request.GetName())
+		require.NoError(t, err)
+		assertPrivacyPolicyDescription(t, description, request.GetName(), budgetBody)
+	})
+
+	t.Run("drop privacy policy: existing", func(t *testing.T) {
+		privacyPolicy, cleanup := testClientHelper().PrivacyPolicy.Create(t)
+		t.Cleanup(cleanup)
+		id := privacyPolicy.ID()
+
+		err := client.PrivacyPolicies.Drop(ctx, sdk.NewDropPrivacyPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.PrivacyPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop privacy policy: non-existing", func(t *testing.T) {
+		err := client.PrivacyPolicies.Drop(ctx, sdk.NewDropPrivacyPolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter privacy policy: rename", func(t *testing.T) {
+		privacyPolicy, cleanup := testClientHelper().PrivacyPolicy.Create(t)
+		oldId := privacyPolicy.ID()
+		t.Cleanup(cleanup)
+
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		err := client.PrivacyPolicies.Alter(ctx, sdk.NewAlterPrivacyPolicyRequest(oldId).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().PrivacyPolicy.DropFunc(t, newId))
+
+		_, err = client.PrivacyPolicies.ShowByID(ctx, oldId)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		returnedPrivacyPolicy, err := client.PrivacyPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertPrivacyPolicy(t, returnedPrivacyPolicy, newId, "")
+	})
+
+	t.Run("alter privacy policy: set and unset comment, set body", func(t *testing.T) {
+		privacyPolicy, cleanup := testClientHelper().PrivacyPolicy.Create(t)
+		t.Cleanup(cleanup)
+		id := privacyPolicy.ID()
+
+		err := client.PrivacyPolicies.Alter(ctx, sdk.NewAlterPrivacyPolicyRequest(id).WithSetComment("new comment"))
+		require.NoError(t, err)
+
+		err = client.PrivacyPolicies.Alter(ctx, sdk.NewAlterPrivacyPolicyRequest(id).WithSetBody(budgetBody))
+		require.NoError(t, err)
+
+		returnedPrivacyPolicy, err := client.PrivacyPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertPrivacyPolicy(t, returnedPrivacyPolicy, id, "new comment")
+
+		description, err := client.PrivacyPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assertPrivacyPolicyDescription(t, description, id, budgetBody)
+
+		err = client.PrivacyPolicies.Alter(ctx, sdk.NewAlterPrivacyPolicyRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		returnedPrivacyPolicy, err = client.PrivacyPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertPrivacyPolicy(t, returnedPrivacyPolicy, id, "")
+	})
+
+	t.Run("show privacy policy: with like", func(t *testing.T) {
+		privacyPolicy, cleanup := testClientHelper().PrivacyPolicy.Create(t)
+		t.Cleanup(cleanup)
+		_, cleanup2 := testClientHelper().PrivacyPolicy.Create(t)
+		t.Cleanup(cleanup2)
+
+		returnedPrivacyPolicies, err := client.PrivacyPolicies.Show(ctx, sdk.NewShowPrivacyPolicyRequest().WithLike(sdk.Like{Pattern: sdk.String(privacyPolicy.Name)}))
+		require.NoError(t, err)
+
+		assert.Len(t, returnedPrivacyPolicies, 1)
+		assert.Contains(t, returnedPrivacyPolicies, *privacyPolicy)
+	})
+
+	t.Run("attach privacy policy to a table and set privacy domains", func(t *testing.T) {
+		privacyPolicy, cleanup := testClientHelper().PrivacyPolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		table, tableCleanup := testClientHelper().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+			*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+			*sdk.NewTableColumnRequest("AGE", sdk.DataTypeNumber),
+			*sdk.NewTableColumnRequest("COUNTRY", sdk.DataTypeVARCHAR),
+		})
+		t.Cleanup(tableCleanup)
+
+		err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithAddPrivacyPolicy(
+			sdk.NewTableAddPrivacyPolicyRequest(privacyPolicy.ID()).WithEntityKey([]sdk.Column{{Value: "ID"}}),
+		))
+		require.NoError(t, err)
+
+		references, err := testClientHelper().PolicyReferences.GetPolicyReferences(t, table.ID(), sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Len(t, references, 1)
+		assert.Equal(t, sdk.PolicyKindPrivacyPolicy, references[0].PolicyKind)
+		assert.Equal(t, privacyPolicy.ID().Name(), references[0].PolicyName)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithColumnAction(sdk.NewTableColumnActionRequest().WithSetPrivacyDomain(
+			sdk.NewTableColumnAlterSetPrivacyDomainActionRequest("AGE").WithBetween(&sdk.PrivacyDomainBetween{Lower: "0", Upper: "120"}),
+		)))
+		require.NoError(t, err)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithColumnAction(sdk.NewTableColumnActionRequest().WithSetPrivacyDomain(
+			sdk.NewTableColumnAlterSetPrivacyDomainActionRequest("COUNTRY").WithIn([]string{"PL", "DE"}),
+		)))
+		require.NoError(t, err)
+
+		columns, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(table.ID()))
+		require.NoError(t, err)
+		ageColumn, err := collections.FindFirst(columns, func(c sdk.TableColumnDetails) bool { return c.Name == "AGE" })
+		require.NoError(t, err)
+		assert.NotNil(t, ageColumn.PrivacyDomain)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithColumnAction(sdk.NewTableColumnActionRequest().WithUnsetPrivacyDomain(
+			sdk.NewTableColumnAlterUnsetPrivacyDomainActionRequest("AGE"),
+		)))
+		require.NoError(t, err)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithDropPrivacyPolicy(sdk.NewTableDropPrivacyPolicyRequest(privacyPolicy.ID())))
+		require.NoError(t, err)
+
+		references, err = testClientHelper().PolicyReferences.GetPolicyReferences(t, table.ID(), sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Empty(t, references)
+	})
+
+	t.Run("attach privacy policy to a view", func(t *testing.T) {
+		privacyPolicy, cleanup := testClientHelper().PrivacyPolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		table, tableCleanup := testClientHelper().Table.Create(t)
+		t.Cleanup(tableCleanup)
+		view, viewCleanup := testClientHelper().View.CreateView(t, "SELECT id FROM "+table.ID().FullyQualifiedName())
+		t.Cleanup(viewCleanup)
+
+		err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(view.ID()).WithAddPrivacyPolicy(*sdk.NewViewAddPrivacyPolicyRequest(privacyPolicy.ID())))
+		require.NoError(t, err)
+
+		references, err := testClientHelper().PolicyReferences.GetPolicyReferences(t, view.ID(), sdk.PolicyEntityDomainView)
+		require.NoError(t, err)
+		require.Len(t, references, 1)
+		assert.Equal(t, sdk.PolicyKindPrivacyPolicy, references[0].PolicyKind)
+
+		err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(view.ID()).WithDropPrivacyPolicy(*sdk.NewViewDropPrivacyPolicyRequest(privacyPolicy.ID())))
+		require.NoError(t, err)
+	})
+}
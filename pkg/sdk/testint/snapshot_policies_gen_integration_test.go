@@ -0,0 +1,131 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_SnapshotPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	assertSnapshotPolicy := func(t *testing.T, snapshotPolicy *sdk.SnapshotPolicy, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, snapshotPolicy.CreatedOn)
+		assert.Equal(t, id.Name(), snapshotPolicy.Name)
+		assert.Equal(t, id.DatabaseName(), snapshotPolicy.DatabaseName)
+		assert.Equal(t, id.SchemaName(), snapshotPolicy.SchemaName)
+		assert.Equal(t, "ACCOUNTADMIN", snapshotPolicy.Owner)
+		assert.Equal(t, comment, snapshotPolicy.Comment)
+		assert.Equal(t, "ROLE", snapshotPolicy.OwnerRoleType)
+	}
+
+	t.Run("create snapshot policy: no optionals", func(t *testing.T) {
+		request := sdk.NewCreateSnapshotPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier()).
+			WithExpireAfterDays(1)
+
+		snapshotPolicy, cleanup := testClientHelper().SnapshotPolicy.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertSnapshotPolicy(t, snapshotPolicy, request.GetName(), "")
+		assert.Equal(t, sdk.Int(1), snapshotPolicy.ExpireAfterDays)
+	})
+
+	t.Run("create snapshot policy: full", func(t *testing.T) {
+		request := sdk.NewCreateSnapshotPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier()).
+			WithSchedule("60 MINUTE").
+			WithExpireAfterDays(7).
+			WithComment("some comment")
+
+		snapshotPolicy, cleanup := testClientHelper().SnapshotPolicy.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertSnapshotPolicy(t, snapshotPolicy, request.GetName(), "some comment")
+		assert.Equal(t, sdk.String("60 MINUTE"), snapshotPolicy.Schedule)
+		assert.Equal(t, sdk.Int(7), snapshotPolicy.ExpireAfterDays)
+	})
+
+	t.Run("drop snapshot policy: existing", func(t *testing.T) {
+		snapshotPolicy, cleanup := testClientHelper().SnapshotPolicy.Create(t)
+		t.Cleanup(cleanup)
+		id := snapshotPolicy.ID()
+
+		err := client.SnapshotPolicies.Drop(ctx, sdk.NewDropSnapshotPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.SnapshotPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop snapshot policy: non-existing", func(t *testing.T) {
+		err := client.SnapshotPolicies.Drop(ctx, sdk.NewDropSnapshotPolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter snapshot policy: rename", func(t *testing.T) {
+		snapshotPolicy, cleanup := testClientHelper().SnapshotPolicy.Create(t)
+		oldId := snapshotPolicy.ID()
+		t.Cleanup(cleanup)
+
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		err := client.SnapshotPolicies.Alter(ctx, sdk.NewAlterSnapshotPolicyRequest(oldId).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().SnapshotPolicy.DropFunc(t, newId))
+
+		_, err = client.SnapshotPolicies.ShowByID(ctx, oldId)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		returnedSnapshotPolicy, err := client.SnapshotPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertSnapshotPolicy(t, returnedSnapshotPolicy, newId, "")
+	})
+
+	t.Run("alter snapshot policy: set and unset", func(t *testing.T) {
+		snapshotPolicy, cleanup := testClientHelper().SnapshotPolicy.Create(t)
+		t.Cleanup(cleanup)
+		id := snapshotPolicy.ID()
+
+		err := client.SnapshotPolicies.Alter(ctx, sdk.NewAlterSnapshotPolicyRequest(id).WithSet(*sdk.NewSnapshotPolicySetRequest().
+			WithSchedule("USING CRON 0 0 * * * UTC").
+			WithExpireAfterDays(14).
+			WithComment("new comment"),
+		))
+		require.NoError(t, err)
+
+		returnedSnapshotPolicy, err := client.SnapshotPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertSnapshotPolicy(t, returnedSnapshotPolicy, id, "new comment")
+		assert.Equal(t, sdk.String("USING CRON 0 0 * * * UTC"), returnedSnapshotPolicy.Schedule)
+		assert.Equal(t, sdk.Int(14), returnedSnapshotPolicy.ExpireAfterDays)
+
+		err = client.SnapshotPolicies.Alter(ctx, sdk.NewAlterSnapshotPolicyRequest(id).WithUnset(*sdk.NewSnapshotPolicyUnsetRequest().
+			WithSchedule(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		returnedSnapshotPolicy, err = client.SnapshotPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertSnapshotPolicy(t, returnedSnapshotPolicy, id, "")
+		assert.Nil(t, returnedSnapshotPolicy.Schedule)
+	})
+
+	t.Run("show snapshot policy: with like", func(t *testing.T) {
+		snapshotPolicy, cleanup := testClientHelper().SnapshotPolicy.Create(t)
+		t.Cleanup(cleanup)
+		_, cleanup2 := testClientHelper().SnapshotPolicy.Create(t)
+		t.Cleanup(cleanup2)
+
+		returnedSnapshotPolicies, err := client.SnapshotPolicies.Show(ctx, sdk.NewShowSnapshotPolicyRequest().WithLike(sdk.Like{Pattern: sdk.String(snapshotPolicy.Name)}))
+		require.NoError(t, err)
+
+		assert.Len(t, returnedSnapshotPolicies, 1)
+		assert.Contains(t, returnedSnapshotPolicies, *snapshotPolicy)
+	})
+}
@@ -0,0 +1,177 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_SnapshotSets(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	table, tableCleanup := testClientHelper().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	assertSnapshotSet := func(t *testing.T, snapshotSet *sdk.SnapshotSet, id sdk.SchemaObjectIdentifier, objectKind string, objectName string, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, snapshotSet.CreatedOn)
+		assert.Equal(t, id.Name(), snapshotSet.Name)
+		assert.Equal(t, id.DatabaseName(), snapshotSet.DatabaseName)
+		assert.Equal(t, id.SchemaName(), snapshotSet.SchemaName)
+		assert.Equal(t, objectKind, snapshotSet.ObjectKind)
+		assert.Equal(t, objectName, snapshotSet.ObjectName)
+		assert.Equal(t, "ACCOUNTADMIN", snapshotSet.Owner)
+		assert.Equal(t, comment, snapshotSet.Comment)
+		assert.Equal(t, "ROLE", snapshotSet.OwnerRoleType)
+	}
+
+	t.Run("create snapshot set: for table, no optionals", func(t *testing.T) {
+		request := sdk.NewCreateSnapshotSetRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), *sdk.NewSnapshotSetTargetRequest().WithTable(table.ID()))
+
+		snapshotSet, cleanup := testClientHelper().SnapshotSet.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertSnapshotSet(t, snapshotSet, request.GetName(), "TABLE", table.ID().Name(), "")
+		assert.Nil(t, snapshotSet.SnapshotPolicy)
+	})
+
+	t.Run("create snapshot set: for schema, full", func(t *testing.T) {
+		snapshotPolicy, snapshotPolicyCleanup := testClientHelper().SnapshotPolicy.Create(t)
+		t.Cleanup(snapshotPolicyCleanup)
+		schema, schemaCleanup := testClientHelper().Schema.CreateSchema(t)
+		t.Cleanup(schemaCleanup)
+
+		request := sdk.NewCreateSnapshotSetRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), *sdk.NewSnapshotSetTargetRequest().WithSchema(schema.ID())).
+			WithSnapshotPolicy(snapshotPolicy.ID()).
+			WithComment("some comment")
+
+		snapshotSet, cleanup := testClientHelper().SnapshotSet.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertSnapshotSet(t, snapshotSet, request.GetName(), "SCHEMA", schema.ID().Name(), "some comment")
+		require.NotNil(t, snapshotSet.SnapshotPolicy)
+		assert.Equal(t, snapshotPolicy.ID().FullyQualifiedName(), snapshotSet.SnapshotPolicy.FullyQualifiedName())
+	})
+
+	t.Run("create snapshot set: for database", func(t *testing.T) {
+		database, databaseCleanup := testClientHelper().Database.CreateDatabase(t)
+		t.Cleanup(databaseCleanup)
+
+		request := sdk.NewCreateSnapshotSetRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), *sdk.NewSnapshotSetTargetRequest().WithDatabase(database.ID()))
+
+		snapshotSet, cleanup := testClientHelper().SnapshotSet.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertSnapshotSet(t, snapshotSet, request.GetName(), "DATABASE", database.ID().Name(), "")
+	})
+
+	t.Run("drop snapshot set: existing", func(t *testing.T) {
+		snapshotSet, cleanup := testClientHelper().SnapshotSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+		id := snapshotSet.ID()
+
+		err := client.SnapshotSets.Drop(ctx, sdk.NewDropSnapshotSetRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.SnapshotSets.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop snapshot set: non-existing", func(t *testing.T) {
+		err := client.SnapshotSets.Drop(ctx, sdk.NewDropSnapshotSetRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter snapshot set: apply, suspend and resume snapshot policy", func(t *testing.T) {
+		snapshotPolicy, snapshotPolicyCleanup := testClientHelper().SnapshotPolicy.Create(t)
+		t.Cleanup(snapshotPolicyCleanup)
+		snapshotSet, cleanup := testClientHelper().SnapshotSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+		id := snapshotSet.ID()
+
+		err := client.SnapshotSets.Alter(ctx, sdk.NewAlterSnapshotSetRequest(id).WithApplySnapshotPolicy(snapshotPolicy.ID()))
+		require.NoError(t, err)
+
+		returnedSnapshotSet, err := client.SnapshotSets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, returnedSnapshotSet.SnapshotPolicy)
+		assert.Equal(t, snapshotPolicy.ID().FullyQualifiedName(), returnedSnapshotSet.SnapshotPolicy.FullyQualifiedName())
+
+		err = client.SnapshotSets.Alter(ctx, sdk.NewAlterSnapshotSetRequest(id).WithSuspendSnapshotPolicy(true))
+		require.NoError(t, err)
+
+		returnedSnapshotSet, err = client.SnapshotSets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.String("SUSPENDED"), returnedSnapshotSet.SnapshotPolicyStatus)
+
+		err = client.SnapshotSets.Alter(ctx, sdk.NewAlterSnapshotSetRequest(id).WithResumeSnapshotPolicy(true))
+		require.NoError(t, err)
+
+		returnedSnapshotSet, err = client.SnapshotSets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.String("ACTIVE"), returnedSnapshotSet.SnapshotPolicyStatus)
+	})
+
+	t.Run("alter snapshot set: set and unset comment", func(t *testing.T) {
+		snapshotSet, cleanup := testClientHelper().SnapshotSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+		id := snapshotSet.ID()
+
+		err := client.SnapshotSets.Alter(ctx, sdk.NewAlterSnapshotSetRequest(id).WithSetComment("new comment"))
+		require.NoError(t, err)
+
+		returnedSnapshotSet, err := client.SnapshotSets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", returnedSnapshotSet.Comment)
+
+		err = client.SnapshotSets.Alter(ctx, sdk.NewAlterSnapshotSetRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		returnedSnapshotSet, err = client.SnapshotSets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, returnedSnapshotSet.Comment)
+	})
+
+	t.Run("add, show and delete snapshots", func(t *testing.T) {
+		snapshotSet, cleanup := testClientHelper().SnapshotSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+		id := snapshotSet.ID()
+
+		testClientHelper().SnapshotSet.AddSnapshot(t, id)
+
+		snapshots, err := client.SnapshotSets.ShowSnapshots(ctx, sdk.NewShowSnapshotsSnapshotSetRequest().WithIn(id))
+		require.NoError(t, err)
+		require.Len(t, snapshots, 1)
+		assert.NotEmpty(t, snapshots[0].CreatedOn)
+		assert.NotEmpty(t, snapshots[0].SnapshotId)
+		assert.Equal(t, id.Name(), snapshots[0].SnapshotSetName)
+		assert.Equal(t, id.DatabaseName(), snapshots[0].DatabaseName)
+		assert.Equal(t, id.SchemaName(), snapshots[0].SchemaName)
+
+		err = client.SnapshotSets.Alter(ctx, sdk.NewAlterSnapshotSetRequest(id).WithDeleteSnapshotIdentifier(snapshots[0].SnapshotId))
+		require.NoError(t, err)
+
+		snapshots, err = client.SnapshotSets.ShowSnapshots(ctx, sdk.NewShowSnapshotsSnapshotSetRequest().WithIn(id))
+		require.NoError(t, err)
+		assert.Empty(t, snapshots)
+	})
+
+	t.Run("show snapshot set: with like", func(t *testing.T) {
+		snapshotSet, cleanup := testClientHelper().SnapshotSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+		_, cleanup2 := testClientHelper().SnapshotSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup2)
+
+		returnedSnapshotSets, err := client.SnapshotSets.Show(ctx, sdk.NewShowSnapshotSetRequest().WithLike(sdk.Like{Pattern: sdk.String(snapshotSet.Name)}))
+		require.NoError(t, err)
+
+		assert.Len(t, returnedSnapshotSets, 1)
+		assert.Contains(t, returnedSnapshotSets, *snapshotSet)
+	})
+}
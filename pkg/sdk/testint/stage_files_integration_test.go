@@ -0,0 +1,94 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testfiles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_StageFiles(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	md5Of := func(content string) string {
+		sum := md5.Sum([]byte(content)) //nolint:gosec
+		return hex.EncodeToString(sum[:])
+	}
+
+	t.Run("put, list and remove a single file", func(t *testing.T) {
+		stage, stageCleanup := testClientHelper().Stage.CreateStage(t)
+		t.Cleanup(stageCleanup)
+
+		content := "def handler(): return 1"
+		filePath := testfiles.TestFile(t, "handler.py", []byte(content))
+		location := sdk.NewStageLocation(stage.ID(), "some/path")
+
+		uploads, err := client.StageFiles.Put(ctx, filePath, location, &sdk.PutStageFilesOptions{
+			AutoCompress: sdk.Bool(false),
+			Overwrite:    sdk.Bool(true),
+		})
+		require.NoError(t, err)
+		require.Len(t, uploads, 1)
+		assert.Equal(t, "handler.py", uploads[0].Source)
+		assert.Equal(t, "handler.py", uploads[0].Target)
+		assert.Equal(t, "UPLOADED", uploads[0].Status)
+
+		files, err := client.StageFiles.List(ctx, location, nil)
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, "some/path/handler.py", files[0].PathOnStage())
+		assert.Equal(t, int64(len(content)), files[0].Size)
+		assert.Equal(t, md5Of(content), files[0].Md5)
+		assert.NotEmpty(t, files[0].LastModified)
+
+		err = client.StageFiles.Remove(ctx, sdk.NewStageLocation(stage.ID(), "some/path/handler.py"), nil)
+		require.NoError(t, err)
+
+		files, err = client.StageFiles.List(ctx, location, nil)
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+
+	t.Run("put a directory with a wildcard and list with pattern", func(t *testing.T) {
+		stage, stageCleanup := testClientHelper().Stage.CreateStage(t)
+		t.Cleanup(stageCleanup)
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.py"), []byte("a"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.py"), []byte("b"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "c.txt"), []byte("c"), 0o600))
+		location := sdk.NewStageLocation(stage.ID(), "")
+
+		uploads, err := client.StageFiles.Put(ctx, filepath.Join(dir, "*.py"), location, &sdk.PutStageFilesOptions{
+			AutoCompress: sdk.Bool(false),
+		})
+		require.NoError(t, err)
+		require.Len(t, uploads, 2)
+
+		files, err := client.StageFiles.List(ctx, location, &sdk.ListStageFilesOptions{Pattern: sdk.String(`.*a\.py`)})
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, "a.py", files[0].PathOnStage())
+
+		err = client.StageFiles.Remove(ctx, location, &sdk.RemoveStageFilesOptions{Pattern: sdk.String(`.*\.py`)})
+		require.NoError(t, err)
+
+		files, err = client.StageFiles.List(ctx, location, nil)
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+
+	t.Run("list on a missing stage", func(t *testing.T) {
+		_, err := client.StageFiles.List(ctx, sdk.NewStageLocation(testClientHelper().Ids.RandomSchemaObjectIdentifier(), ""), nil)
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,185 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_StorageLifecyclePolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	args := []sdk.StorageLifecyclePolicyArgRequest{*sdk.NewStorageLifecyclePolicyArgRequest("CREATED_ON", testdatatypes.DataTypeTimestampNTZ)}
+	body := "CREATED_ON < DATEADD('day', -90, CURRENT_TIMESTAMP())"
+
+	assertStorageLifecyclePolicy := func(t *testing.T, storageLifecyclePolicy *sdk.StorageLifecyclePolicy, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, storageLifecyclePolicy.CreatedOn)
+		assert.Equal(t, id.Name(), storageLifecyclePolicy.Name)
+		assert.Equal(t, id.DatabaseName(), storageLifecyclePolicy.DatabaseName)
+		assert.Equal(t, id.SchemaName(), storageLifecyclePolicy.SchemaName)
+		assert.Equal(t, "STORAGE_LIFECYCLE_POLICY", storageLifecyclePolicy.Kind)
+		assert.Equal(t, "ACCOUNTADMIN", storageLifecyclePolicy.Owner)
+		assert.Equal(t, comment, storageLifecyclePolicy.Comment)
+		assert.Equal(t, "ROLE", storageLifecyclePolicy.OwnerRoleType)
+	}
+
+	t.Run("create storage lifecycle policy: no optionals", func(t *testing.T) {
+		request := sdk.NewCreateStorageLifecyclePolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), args, body)
+
+		storageLifecyclePolicy, cleanup := testClientHelper().StorageLifecyclePolicy.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertStorageLifecyclePolicy(t, storageLifecyclePolicy, request.GetName(), "")
+
+		description, err := client.StorageLifecyclePolicies.Describe(ctx, request.GetName())
+		require.NoError(t, err)
+		assert.Equal(t, request.GetName().Name(), description.Name)
+		assert.Equal(t, []sdk.TableColumnSignature{{Name: "CREATED_ON", Type: testdatatypes.DataTypeTimestampNTZ}}, description.Signature)
+		assert.Equal(t, "BOOLEAN", description.ReturnType)
+		assert.Equal(t, body, description.Body)
+		assert.Nil(t, description.ArchiveTier)
+		assert.Nil(t, description.ArchiveForDays)
+	})
+
+	t.Run("create storage lifecycle policy: full", func(t *testing.T) {
+		request := sdk.NewCreateStorageLifecyclePolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), args, body).
+			WithArchiveTier(sdk.StorageLifecyclePolicyArchiveTierCool).
+			WithArchiveForDays(180).
+			WithComment("some comment")
+
+		storageLifecyclePolicy, cleanup := testClientHelper().StorageLifecyclePolicy.CreateWithRequest(t, *request)
+		t.Cleanup(cleanup)
+
+		assertStorageLifecyclePolicy(t, storageLifecyclePolicy, request.GetName(), "some comment")
+
+		description, err := client.StorageLifecyclePolicies.Describe(ctx, request.GetName())
+		require.NoError(t, err)
+		assert.Equal(t, body, description.Body)
+		assert.Equal(t, sdk.Pointer(sdk.StorageLifecyclePolicyArchiveTierCool), description.ArchiveTier)
+		assert.Equal(t, sdk.Int(180), description.ArchiveForDays)
+	})
+
+	t.Run("drop storage lifecycle policy: existing", func(t *testing.T) {
+		storageLifecyclePolicy, cleanup := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup)
+		id := storageLifecyclePolicy.ID()
+
+		err := client.StorageLifecyclePolicies.Drop(ctx, sdk.NewDropStorageLifecyclePolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.StorageLifecyclePolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop storage lifecycle policy: non-existing", func(t *testing.T) {
+		err := client.StorageLifecyclePolicies.Drop(ctx, sdk.NewDropStorageLifecyclePolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter storage lifecycle policy: rename", func(t *testing.T) {
+		storageLifecyclePolicy, cleanup := testClientHelper().StorageLifecyclePolicy.Create(t)
+		oldId := storageLifecyclePolicy.ID()
+		t.Cleanup(cleanup)
+
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(oldId).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().StorageLifecyclePolicy.DropFunc(t, newId))
+
+		_, err = client.StorageLifecyclePolicies.ShowByID(ctx, oldId)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		returnedStorageLifecyclePolicy, err := client.StorageLifecyclePolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertStorageLifecyclePolicy(t, returnedStorageLifecyclePolicy, newId, "")
+	})
+
+	t.Run("alter storage lifecycle policy: set body, set and unset", func(t *testing.T) {
+		storageLifecyclePolicy, cleanup := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup)
+		id := storageLifecyclePolicy.ID()
+
+		newBody := "CREATED_ON < DATEADD('day', -30, CURRENT_TIMESTAMP())"
+		err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(id).WithSetBody(newBody))
+		require.NoError(t, err)
+
+		err = client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(id).WithSet(*sdk.NewStorageLifecyclePolicySetRequest().
+			WithArchiveForDays(90).
+			WithComment("new comment"),
+		))
+		require.NoError(t, err)
+
+		returnedStorageLifecyclePolicy, err := client.StorageLifecyclePolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertStorageLifecyclePolicy(t, returnedStorageLifecyclePolicy, id, "new comment")
+
+		description, err := client.StorageLifecyclePolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, newBody, description.Body)
+		assert.Equal(t, sdk.Int(90), description.ArchiveForDays)
+
+		err = client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(id).WithUnset(*sdk.NewStorageLifecyclePolicyUnsetRequest().
+			WithArchiveForDays(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		returnedStorageLifecyclePolicy, err = client.StorageLifecyclePolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertStorageLifecyclePolicy(t, returnedStorageLifecyclePolicy, id, "")
+
+		description, err = client.StorageLifecyclePolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Nil(t, description.ArchiveForDays)
+	})
+
+	t.Run("show storage lifecycle policy: with like", func(t *testing.T) {
+		storageLifecyclePolicy, cleanup := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup)
+		_, cleanup2 := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup2)
+
+		returnedStorageLifecyclePolicies, err := client.StorageLifecyclePolicies.Show(ctx, sdk.NewShowStorageLifecyclePolicyRequest().WithLike(sdk.Like{Pattern: sdk.String(storageLifecyclePolicy.Name)}))
+		require.NoError(t, err)
+
+		assert.Len(t, returnedStorageLifecyclePolicies, 1)
+		assert.Contains(t, returnedStorageLifecyclePolicies, *storageLifecyclePolicy)
+	})
+
+	t.Run("attach storage lifecycle policy to a table", func(t *testing.T) {
+		storageLifecyclePolicy, cleanup := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		table, tableCleanup := testClientHelper().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+			*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+			*sdk.NewTableColumnRequest("LOADED_AT", sdk.DataTypeTimestampNTZ),
+		})
+		t.Cleanup(tableCleanup)
+
+		err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithAddStorageLifecyclePolicy(
+			sdk.NewTableAddStorageLifecyclePolicyRequest(storageLifecyclePolicy.ID(), []string{"LOADED_AT"}),
+		))
+		require.NoError(t, err)
+
+		references, err := testClientHelper().PolicyReferences.GetPolicyReferences(t, table.ID(), sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Len(t, references, 1)
+		assert.Equal(t, sdk.PolicyKindStorageLifecyclePolicy, references[0].PolicyKind)
+		assert.Equal(t, storageLifecyclePolicy.ID().Name(), references[0].PolicyName)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithDropStorageLifecyclePolicy(sdk.Bool(true)))
+		require.NoError(t, err)
+
+		references, err = testClientHelper().PolicyReferences.GetPolicyReferences(t, table.ID(), sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Empty(t, references)
+	})
+}
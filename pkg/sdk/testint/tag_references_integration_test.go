@@ -0,0 +1,63 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_TagReferences(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+	t.Cleanup(tagCleanup)
+
+	t.Run("role domain", func(t *testing.T) {
+		role, roleCleanup := testClientHelper().Role.CreateRole(t)
+		t.Cleanup(roleCleanup)
+
+		testClientHelper().Tag.Set(t, sdk.ObjectTypeRole, role.ID(), []sdk.TagAssociation{{Name: tag.ID(), Value: "value"}})
+
+		tagReferences, err := client.TagReferences.GetForEntity(ctx, sdk.NewGetForEntityTagReferenceRequest(role.ID(), sdk.TagReferenceObjectDomainRole))
+		require.NoError(t, err)
+
+		tagReference, err := collections.FindFirst(tagReferences, func(reference sdk.TagReference) bool {
+			return reference.TagId().FullyQualifiedName() == tag.ID().FullyQualifiedName()
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "value", tagReference.TagValue)
+		assert.Equal(t, "ROLE", tagReference.Level)
+		assert.Equal(t, "ROLE", tagReference.Domain)
+		assert.Equal(t, role.ID().Name(), tagReference.ObjectName)
+		assert.Nil(t, tagReference.ObjectDatabase)
+		assert.Nil(t, tagReference.ObjectSchema)
+		assert.Nil(t, tagReference.ColumnName)
+	})
+
+	t.Run("table domain with inherited tag", func(t *testing.T) {
+		schema, schemaCleanup := testClientHelper().Schema.CreateSchema(t)
+		t.Cleanup(schemaCleanup)
+
+		testClientHelper().Tag.Set(t, sdk.ObjectTypeSchema, schema.ID(), []sdk.TagAssociation{{Name: tag.ID(), Value: "schema value"}})
+
+		table, tableCleanup := testClientHelper().Table.CreateInSchema(t, schema.ID())
+		t.Cleanup(tableCleanup)
+
+		tagReferences, err := client.TagReferences.GetForEntity(ctx, sdk.NewGetForEntityTagReferenceRequest(table.ID(), sdk.TagReferenceObjectDomainTable))
+		require.NoError(t, err)
+
+		tagReference, err := collections.FindFirst(tagReferences, func(reference sdk.TagReference) bool {
+			return reference.TagId().FullyQualifiedName() == tag.ID().FullyQualifiedName()
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "schema value", tagReference.TagValue)
+		assert.Equal(t, "SCHEMA", tagReference.Level)
+		assert.Equal(t, "TABLE", tagReference.Domain)
+	})
+}
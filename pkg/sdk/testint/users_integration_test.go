@@ -1585,6 +1585,51 @@ func TestInt_Users(t *testing.T) {
 		)
 	})
 
+	t.Run("create and alter: workload identity", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		err := client.Users.Create(ctx, id, &sdk.CreateUserOptions{
+			ObjectProperties: &sdk.UserObjectProperties{
+				Type: sdk.Pointer(sdk.UserTypeService),
+				WorkloadIdentity: &sdk.UserWorkloadIdentity{Oidc: &sdk.UserWorkloadIdentityOidc{
+					Issuer:           "https://token.actions.githubusercontent.com",
+					Subject:          "repo:org/repo:ref:refs/heads/main",
+					OidcAudienceList: []sdk.StringListItemWrapper{{Value: "snowflakecomputing.com"}},
+				}},
+			},
+		})
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().User.DropUserFunc(t, id))
+
+		methods, err := client.Users.ShowWorkloadIdentityAuthenticationMethods(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, methods, 1)
+		assert.Equal(t, "OIDC", methods[0].Type)
+		assert.Contains(t, methods[0].AdditionalInfo, "https://token.actions.githubusercontent.com")
+		assert.Contains(t, methods[0].AdditionalInfo, "repo:org/repo:ref:refs/heads/main")
+
+		err = client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Set: &sdk.UserSet{
+			ObjectProperties: &sdk.UserAlterObjectProperties{UserObjectProperties: sdk.UserObjectProperties{
+				WorkloadIdentity: &sdk.UserWorkloadIdentity{Gcp: &sdk.UserWorkloadIdentityGcp{Subject: "123456789"}},
+			}},
+		}})
+		require.NoError(t, err)
+
+		methods, err = client.Users.ShowWorkloadIdentityAuthenticationMethods(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, methods, 1)
+		assert.Equal(t, "GCP", methods[0].Type)
+		assert.Contains(t, methods[0].AdditionalInfo, "123456789")
+
+		err = client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Unset: &sdk.UserUnset{
+			ObjectProperties: &sdk.UserObjectPropertiesUnset{WorkloadIdentity: sdk.Bool(true)},
+		}})
+		require.NoError(t, err)
+
+		methods, err = client.Users.ShowWorkloadIdentityAuthenticationMethods(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, methods)
+	})
+
 	t.Run("describe: when user exists", func(t *testing.T) {
 		userDetails, err := client.Users.Describe(ctx, user.ID())
 		require.NoError(t, err)
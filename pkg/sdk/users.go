@@ -17,8 +17,10 @@ var (
 	_ validatable = new(DropUserOptions)
 	_ validatable = new(describeUserOptions)
 	_ validatable = new(ShowUserOptions)
+	_ validatable = new(showUserWorkloadIdentityAuthenticationMethodsOptions)
 
-	_ convertibleRow[User] = new(userDBRow)
+	_ convertibleRow[User]                                     = new(userDBRow)
+	_ convertibleRow[UserWorkloadIdentityAuthenticationMethod] = new(userWorkloadIdentityAuthenticationMethodDBRow)
 )
 
 type Users interface {
@@ -31,6 +33,7 @@ type Users interface {
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*User, error)
 	ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*User, error)
 	ShowParameters(ctx context.Context, id AccountObjectIdentifier) ([]*Parameter, error)
+	ShowWorkloadIdentityAuthenticationMethods(ctx context.Context, id AccountObjectIdentifier) ([]UserWorkloadIdentityAuthenticationMethod, error)
 
 	AddProgrammaticAccessToken(ctx context.Context, request *AddUserProgrammaticAccessTokenRequest) (*AddProgrammaticAccessTokenResult, error)
 	ModifyProgrammaticAccessToken(ctx context.Context, request *ModifyUserProgrammaticAccessTokenRequest) error
@@ -249,6 +252,11 @@ func (opts *CreateUserOptions) validate() error {
 			return err
 		}
 	}
+	if valueSet(opts.ObjectProperties) && valueSet(opts.ObjectProperties.WorkloadIdentity) {
+		if err := opts.ObjectProperties.WorkloadIdentity.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -290,9 +298,69 @@ type UserObjectProperties struct {
 	RSAPublicKey2         *string                  `ddl:"parameter,single_quotes" sql:"RSA_PUBLIC_KEY_2"`
 	RSAPublicKey2Fp       *string                  `ddl:"parameter,single_quotes" sql:"RSA_PUBLIC_KEY_2_FP"`
 	Type                  *UserType                `ddl:"parameter,no_quotes" sql:"TYPE"`
+	WorkloadIdentity      *UserWorkloadIdentity    `ddl:"list,parentheses,no_comma" sql:"WORKLOAD_IDENTITY ="`
 	Comment               *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
+// UserWorkloadIdentity is based on https://docs.snowflake.com/en/user-guide/workload-identity-federation.
+// It can be set only for users with TYPE = SERVICE.
+type UserWorkloadIdentity struct {
+	// one of
+	Aws   *UserWorkloadIdentityAws   `ddl:"keyword"`
+	Gcp   *UserWorkloadIdentityGcp   `ddl:"keyword"`
+	Azure *UserWorkloadIdentityAzure `ddl:"keyword"`
+	Oidc  *UserWorkloadIdentityOidc  `ddl:"keyword"`
+}
+
+type UserWorkloadIdentityAws struct {
+	workloadIdentityType bool   `ddl:"static" sql:"TYPE = AWS"`
+	Arn                  string `ddl:"parameter,single_quotes" sql:"ARN"`
+}
+
+type UserWorkloadIdentityGcp struct {
+	workloadIdentityType bool   `ddl:"static" sql:"TYPE = GCP"`
+	Subject              string `ddl:"parameter,single_quotes" sql:"SUBJECT"`
+}
+
+type UserWorkloadIdentityAzure struct {
+	workloadIdentityType bool   `ddl:"static" sql:"TYPE = AZURE"`
+	Issuer               string `ddl:"parameter,single_quotes" sql:"ISSUER"`
+	Subject              string `ddl:"parameter,single_quotes" sql:"SUBJECT"`
+}
+
+type UserWorkloadIdentityOidc struct {
+	workloadIdentityType bool                    `ddl:"static" sql:"TYPE = OIDC"`
+	Issuer               string                  `ddl:"parameter,single_quotes" sql:"ISSUER"`
+	Subject              string                  `ddl:"parameter,single_quotes" sql:"SUBJECT"`
+	OidcAudienceList     []StringListItemWrapper `ddl:"parameter,parentheses" sql:"OIDC_AUDIENCE_LIST"`
+}
+
+func (opts *UserWorkloadIdentity) validate() error {
+	var errs []error
+	if !exactlyOneValueSet(opts.Aws, opts.Gcp, opts.Azure, opts.Oidc) {
+		errs = append(errs, errExactlyOneOf("UserWorkloadIdentity", "Aws", "Gcp", "Azure", "Oidc"))
+	}
+	if valueSet(opts.Aws) && !valueSet(opts.Aws.Arn) {
+		errs = append(errs, errNotSet("UserWorkloadIdentityAws", "Arn"))
+	}
+	if valueSet(opts.Gcp) && !valueSet(opts.Gcp.Subject) {
+		errs = append(errs, errNotSet("UserWorkloadIdentityGcp", "Subject"))
+	}
+	if valueSet(opts.Azure) && !valueSet(opts.Azure.Issuer) {
+		errs = append(errs, errNotSet("UserWorkloadIdentityAzure", "Issuer"))
+	}
+	if valueSet(opts.Azure) && !valueSet(opts.Azure.Subject) {
+		errs = append(errs, errNotSet("UserWorkloadIdentityAzure", "Subject"))
+	}
+	if valueSet(opts.Oidc) && !valueSet(opts.Oidc.Issuer) {
+		errs = append(errs, errNotSet("UserWorkloadIdentityOidc", "Issuer"))
+	}
+	if valueSet(opts.Oidc) && !valueSet(opts.Oidc.Subject) {
+		errs = append(errs, errNotSet("UserWorkloadIdentityOidc", "Subject"))
+	}
+	return errors.Join(errs...)
+}
+
 type UserAlterObjectProperties struct {
 	UserObjectProperties
 	DisableMfa *bool `ddl:"parameter,no_quotes" sql:"DISABLE_MFA"`
@@ -327,6 +395,7 @@ type UserObjectPropertiesUnset struct {
 	RSAPublicKey          *bool `ddl:"keyword" sql:"RSA_PUBLIC_KEY"`
 	RSAPublicKey2         *bool `ddl:"keyword" sql:"RSA_PUBLIC_KEY_2"`
 	Type                  *bool `ddl:"keyword" sql:"TYPE"`
+	WorkloadIdentity      *bool `ddl:"keyword" sql:"WORKLOAD_IDENTITY"`
 	Comment               *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
@@ -453,6 +522,11 @@ func (opts *UserSet) validate() error {
 			return err
 		}
 	}
+	if valueSet(opts.ObjectProperties) && valueSet(opts.ObjectProperties.WorkloadIdentity) {
+		if err := opts.ObjectProperties.WorkloadIdentity.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -734,6 +808,70 @@ func (v *users) ShowParameters(ctx context.Context, id AccountObjectIdentifier)
 	})
 }
 
+// UserWorkloadIdentityAuthenticationMethod is a single row returned by SHOW USER WORKLOAD IDENTITY AUTHENTICATION METHODS.
+type UserWorkloadIdentityAuthenticationMethod struct {
+	Name           string
+	Type           string
+	Comment        string
+	LastUsed       *time.Time
+	CreatedOn      time.Time
+	AdditionalInfo string
+}
+
+type userWorkloadIdentityAuthenticationMethodDBRow struct {
+	Name           string         `db:"name"`
+	Type           string         `db:"type"`
+	Comment        sql.NullString `db:"comment"`
+	LastUsed       sql.NullTime   `db:"last_used"`
+	CreatedOn      time.Time      `db:"created_on"`
+	AdditionalInfo sql.NullString `db:"additional_info"`
+}
+
+func (row userWorkloadIdentityAuthenticationMethodDBRow) convert() (*UserWorkloadIdentityAuthenticationMethod, error) {
+	method := &UserWorkloadIdentityAuthenticationMethod{
+		Name:      row.Name,
+		Type:      row.Type,
+		CreatedOn: row.CreatedOn,
+	}
+	if row.Comment.Valid {
+		method.Comment = row.Comment.String
+	}
+	if row.LastUsed.Valid {
+		method.LastUsed = &row.LastUsed.Time
+	}
+	if row.AdditionalInfo.Valid {
+		method.AdditionalInfo = row.AdditionalInfo.String
+	}
+	return method, nil
+}
+
+// showUserWorkloadIdentityAuthenticationMethodsOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-user-workload-identity-authentication-methods.
+type showUserWorkloadIdentityAuthenticationMethodsOptions struct {
+	show    bool                    `ddl:"static" sql:"SHOW USER WORKLOAD IDENTITY AUTHENTICATION METHODS"`
+	forUser AccountObjectIdentifier `ddl:"identifier" sql:"FOR USER"`
+}
+
+func (opts *showUserWorkloadIdentityAuthenticationMethodsOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.forUser) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *users) ShowWorkloadIdentityAuthenticationMethods(ctx context.Context, id AccountObjectIdentifier) ([]UserWorkloadIdentityAuthenticationMethod, error) {
+	opts := &showUserWorkloadIdentityAuthenticationMethodsOptions{
+		forUser: id,
+	}
+	dbRows, err := validateAndQuery[userWorkloadIdentityAuthenticationMethodDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[userWorkloadIdentityAuthenticationMethodDBRow, UserWorkloadIdentityAuthenticationMethod](dbRows)
+}
+
 func (v *users) AddProgrammaticAccessToken(ctx context.Context, request *AddUserProgrammaticAccessTokenRequest) (*AddProgrammaticAccessTokenResult, error) {
 	return v.client.UserProgrammaticAccessTokens.Add(ctx, request)
 }
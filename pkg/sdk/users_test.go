@@ -67,6 +67,85 @@ func TestUserCreate(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s TYPE = LEGACY_SERVICE`, id.FullyQualifiedName())
 	})
 
+	t.Run("with workload identity - aws", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				Type:             Pointer(UserTypeService),
+				WorkloadIdentity: &UserWorkloadIdentity{Aws: &UserWorkloadIdentityAws{Arn: "arn:aws:iam::123456789012:role/test"}},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s TYPE = SERVICE WORKLOAD_IDENTITY = (TYPE = AWS ARN = 'arn:aws:iam::123456789012:role/test')`, id.FullyQualifiedName())
+	})
+
+	t.Run("with workload identity - gcp", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{Gcp: &UserWorkloadIdentityGcp{Subject: "123456789"}},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s WORKLOAD_IDENTITY = (TYPE = GCP SUBJECT = '123456789')`, id.FullyQualifiedName())
+	})
+
+	t.Run("with workload identity - azure", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{Azure: &UserWorkloadIdentityAzure{Issuer: "https://login.microsoftonline.com/tenant/v2.0", Subject: "subject"}},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s WORKLOAD_IDENTITY = (TYPE = AZURE ISSUER = 'https://login.microsoftonline.com/tenant/v2.0' SUBJECT = 'subject')`, id.FullyQualifiedName())
+	})
+
+	t.Run("with workload identity - oidc", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{Oidc: &UserWorkloadIdentityOidc{
+					Issuer:           "https://token.actions.githubusercontent.com",
+					Subject:          "repo:org/repo:ref:refs/heads/main",
+					OidcAudienceList: []StringListItemWrapper{{Value: "snowflakecomputing.com"}, {Value: "other"}},
+				}},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s WORKLOAD_IDENTITY = (TYPE = OIDC ISSUER = 'https://token.actions.githubusercontent.com' SUBJECT = 'repo:org/repo:ref:refs/heads/main' OIDC_AUDIENCE_LIST = ('snowflakecomputing.com', 'other'))`, id.FullyQualifiedName())
+	})
+
+	t.Run("validation: workload identity without type", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("UserWorkloadIdentity", "Aws", "Gcp", "Azure", "Oidc"))
+	})
+
+	t.Run("validation: workload identity with more than one type", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{
+					Aws: &UserWorkloadIdentityAws{Arn: "arn"},
+					Gcp: &UserWorkloadIdentityGcp{Subject: "subject"},
+				},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("UserWorkloadIdentity", "Aws", "Gcp", "Azure", "Oidc"))
+	})
+
+	t.Run("validation: workload identity without required fields", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{Oidc: &UserWorkloadIdentityOidc{}},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("UserWorkloadIdentityOidc", "Issuer"))
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("UserWorkloadIdentityOidc", "Subject"))
+	})
+
 	t.Run("with complete options - no type", func(t *testing.T) {
 		tagId := randomSchemaObjectIdentifier()
 		tags := []TagAssociation{
@@ -175,6 +254,40 @@ func TestUserAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET TYPE = LEGACY_SERVICE", id.FullyQualifiedName())
 	})
 
+	t.Run("set workload identity", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				ObjectProperties: &UserAlterObjectProperties{UserObjectProperties: UserObjectProperties{
+					WorkloadIdentity: &UserWorkloadIdentity{Gcp: &UserWorkloadIdentityGcp{Subject: "123456789"}},
+				}},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET WORKLOAD_IDENTITY = (TYPE = GCP SUBJECT = '123456789')", id.FullyQualifiedName())
+	})
+
+	t.Run("validation: set invalid workload identity", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				ObjectProperties: &UserAlterObjectProperties{UserObjectProperties: UserObjectProperties{
+					WorkloadIdentity: &UserWorkloadIdentity{Aws: &UserWorkloadIdentityAws{}},
+				}},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("UserWorkloadIdentityAws", "Arn"))
+	})
+
+	t.Run("unset workload identity", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				ObjectProperties: &UserObjectPropertiesUnset{WorkloadIdentity: Bool(true)},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET WORKLOAD_IDENTITY", id.FullyQualifiedName())
+	})
+
 	t.Run("validation: no unset", func(t *testing.T) {
 		opts := &AlterUserOptions{
 			name:  id,
@@ -550,6 +663,22 @@ func TestUserDescribe(t *testing.T) {
 	})
 }
 
+func TestUserShowWorkloadIdentityAuthenticationMethods(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &showUserWorkloadIdentityAuthenticationMethodsOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("for user", func(t *testing.T) {
+		opts := &showUserWorkloadIdentityAuthenticationMethodsOptions{
+			forUser: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW USER WORKLOAD IDENTITY AUTHENTICATION METHODS FOR USER %s", id.FullyQualifiedName())
+	})
+}
+
 func Test_User_ToGeographyOutputFormat(t *testing.T) {
 	type test struct {
 		input string
@@ -186,6 +186,17 @@ var viewSetAggregationPolicy = g.NewQueryStruct("ViewSetAggregationPolicy").
 var viewUnsetAggregationPolicy = g.NewQueryStruct("ViewUnsetAggregationPolicy").
 	SQL("UNSET AGGREGATION POLICY")
 
+var viewAddPrivacyPolicy = g.NewQueryStruct("ViewAddPrivacyPolicy").
+	SQL("ADD").
+	Identifier("PrivacyPolicy", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("PRIVACY POLICY").Required()).
+	ListAssignment("ENTITY KEY", "Column", g.ParameterOptions().NoEquals().Parentheses()).
+	WithValidation(g.ValidIdentifier, "PrivacyPolicy")
+
+var viewDropPrivacyPolicy = g.NewQueryStruct("ViewDropPrivacyPolicy").
+	SQL("DROP").
+	Identifier("PrivacyPolicy", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("PRIVACY POLICY").Required()).
+	WithValidation(g.ValidIdentifier, "PrivacyPolicy")
+
 var viewSetColumnMaskingPolicy = g.NewQueryStruct("ViewSetColumnMaskingPolicy").
 	// In the docs there is a MODIFY alternative, but for simplicity only one is supported here.
 	SQL("ALTER").
@@ -293,6 +304,8 @@ var ViewsDef = g.NewInterface(
 			OptionalSQL("DROP ALL ROW ACCESS POLICIES").
 			OptionalQueryStructField("SetAggregationPolicy", viewSetAggregationPolicy, g.KeywordOptions()).
 			OptionalQueryStructField("UnsetAggregationPolicy", viewUnsetAggregationPolicy, g.KeywordOptions()).
+			OptionalQueryStructField("AddPrivacyPolicy", viewAddPrivacyPolicy, g.KeywordOptions()).
+			OptionalQueryStructField("DropPrivacyPolicy", viewDropPrivacyPolicy, g.KeywordOptions()).
 			OptionalQueryStructField("SetMaskingPolicyOnColumn", viewSetColumnMaskingPolicy, g.KeywordOptions()).
 			OptionalQueryStructField("UnsetMaskingPolicyOnColumn", viewUnsetColumnMaskingPolicy, g.KeywordOptions()).
 			OptionalQueryStructField("SetProjectionPolicyOnColumn", viewSetProjectionPolicy, g.KeywordOptions()).
@@ -303,7 +316,7 @@ var ViewsDef = g.NewInterface(
 			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking",
 				"UnsetSecure", "SetTags", "UnsetTags", "AddDataMetricFunction", "DropDataMetricFunction", "ModifyDataMetricFunction", "SetDataMetricSchedule", "UnsetDataMetricSchedule",
 				"AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy",
-				"DropAllRowAccessPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetMaskingPolicyOnColumn",
+				"DropAllRowAccessPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "AddPrivacyPolicy", "DropPrivacyPolicy", "SetMaskingPolicyOnColumn",
 				"UnsetMaskingPolicyOnColumn", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn", "SetTagsOnColumn",
 				"UnsetTagsOnColumn").
 			WithValidation(g.ConflictingFields, "IfExists", "SetSecure").
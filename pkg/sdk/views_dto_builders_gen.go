@@ -247,6 +247,16 @@ func (s *AlterViewRequest) WithUnsetAggregationPolicy(unsetAggregationPolicy Vie
 	return s
 }
 
+func (s *AlterViewRequest) WithAddPrivacyPolicy(addPrivacyPolicy ViewAddPrivacyPolicyRequest) *AlterViewRequest {
+	s.AddPrivacyPolicy = &addPrivacyPolicy
+	return s
+}
+
+func (s *AlterViewRequest) WithDropPrivacyPolicy(dropPrivacyPolicy ViewDropPrivacyPolicyRequest) *AlterViewRequest {
+	s.DropPrivacyPolicy = &dropPrivacyPolicy
+	return s
+}
+
 func (s *AlterViewRequest) WithSetMaskingPolicyOnColumn(setMaskingPolicyOnColumn ViewSetColumnMaskingPolicyRequest) *AlterViewRequest {
 	s.SetMaskingPolicyOnColumn = &setMaskingPolicyOnColumn
 	return s
@@ -365,6 +375,27 @@ func NewViewUnsetAggregationPolicyRequest() *ViewUnsetAggregationPolicyRequest {
 	return &s
 }
 
+func NewViewAddPrivacyPolicyRequest(
+	privacyPolicy SchemaObjectIdentifier,
+) *ViewAddPrivacyPolicyRequest {
+	s := ViewAddPrivacyPolicyRequest{}
+	s.PrivacyPolicy = privacyPolicy
+	return &s
+}
+
+func (s *ViewAddPrivacyPolicyRequest) WithEntityKey(entityKey []Column) *ViewAddPrivacyPolicyRequest {
+	s.EntityKey = entityKey
+	return s
+}
+
+func NewViewDropPrivacyPolicyRequest(
+	privacyPolicy SchemaObjectIdentifier,
+) *ViewDropPrivacyPolicyRequest {
+	s := ViewDropPrivacyPolicyRequest{}
+	s.PrivacyPolicy = privacyPolicy
+	return &s
+}
+
 func NewViewSetColumnMaskingPolicyRequest(
 	name string,
 	maskingPolicy SchemaObjectIdentifier,
@@ -80,6 +80,8 @@ type AlterViewRequest struct {
 	DropAllRowAccessPolicies      *bool
 	SetAggregationPolicy          *ViewSetAggregationPolicyRequest
 	UnsetAggregationPolicy        *ViewUnsetAggregationPolicyRequest
+	AddPrivacyPolicy              *ViewAddPrivacyPolicyRequest
+	DropPrivacyPolicy             *ViewDropPrivacyPolicyRequest
 	SetMaskingPolicyOnColumn      *ViewSetColumnMaskingPolicyRequest
 	UnsetMaskingPolicyOnColumn    *ViewUnsetColumnMaskingPolicyRequest
 	SetProjectionPolicyOnColumn   *ViewSetProjectionPolicyRequest
@@ -130,6 +132,15 @@ type ViewSetAggregationPolicyRequest struct {
 type ViewUnsetAggregationPolicyRequest struct {
 }
 
+type ViewAddPrivacyPolicyRequest struct {
+	PrivacyPolicy SchemaObjectIdentifier // required
+	EntityKey     []Column
+}
+
+type ViewDropPrivacyPolicyRequest struct {
+	PrivacyPolicy SchemaObjectIdentifier // required
+}
+
 type ViewSetColumnMaskingPolicyRequest struct {
 	Name          string                 // required
 	MaskingPolicy SchemaObjectIdentifier // required
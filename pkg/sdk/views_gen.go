@@ -92,6 +92,8 @@ type AlterViewOptions struct {
 	DropAllRowAccessPolicies      *bool                          `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
 	SetAggregationPolicy          *ViewSetAggregationPolicy      `ddl:"keyword"`
 	UnsetAggregationPolicy        *ViewUnsetAggregationPolicy    `ddl:"keyword"`
+	AddPrivacyPolicy              *ViewAddPrivacyPolicy          `ddl:"keyword"`
+	DropPrivacyPolicy             *ViewDropPrivacyPolicy         `ddl:"keyword"`
 	SetMaskingPolicyOnColumn      *ViewSetColumnMaskingPolicy    `ddl:"keyword"`
 	UnsetMaskingPolicyOnColumn    *ViewUnsetColumnMaskingPolicy  `ddl:"keyword"`
 	SetProjectionPolicyOnColumn   *ViewSetProjectionPolicy       `ddl:"keyword"`
@@ -166,6 +168,17 @@ type ViewUnsetAggregationPolicy struct {
 	unsetAggregationPolicy bool `ddl:"static" sql:"UNSET AGGREGATION POLICY"`
 }
 
+type ViewAddPrivacyPolicy struct {
+	add           bool                   `ddl:"static" sql:"ADD"`
+	PrivacyPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"PRIVACY POLICY"`
+	EntityKey     []Column               `ddl:"parameter,parentheses,no_equals" sql:"ENTITY KEY"`
+}
+
+type ViewDropPrivacyPolicy struct {
+	drop          bool                   `ddl:"static" sql:"DROP"`
+	PrivacyPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"PRIVACY POLICY"`
+}
+
 type ViewSetColumnMaskingPolicy struct {
 	alter         bool                   `ddl:"static" sql:"ALTER"`
 	column        bool                   `ddl:"static" sql:"COLUMN"`
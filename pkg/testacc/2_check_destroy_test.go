@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -193,6 +194,12 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.PrimaryConnection: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Connections.ShowByID)
 	},
+	resources.Budget: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Budgets.ShowByID)
+	},
+	resources.CatalogIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.CatalogIntegrations.ShowByID)
+	},
 	resources.ComputePool: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ComputePools.ShowByID)
 	},
@@ -202,6 +209,9 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.Database: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Databases.ShowByID)
 	},
+	resources.DatabaseFromListing: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Databases.ShowByID)
+	},
 	resources.DatabaseRole: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.DatabaseRoles.ShowByID)
 	},
@@ -223,6 +233,9 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.ExternalVolume: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ExternalVolumes.ShowByID)
 	},
+	resources.ExternallyManagedIcebergTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.IcebergTables.ShowByID)
+	},
 	resources.FailoverGroup: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.FailoverGroups.ShowByID)
 	},
@@ -247,6 +260,12 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.GitRepository: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.GitRepositories.ShowByID)
 	},
+	resources.HybridTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.HybridTables.ShowByID)
+	},
+	resources.IcebergTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.IcebergTables.ShowByID)
+	},
 	resources.ImageRepository: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ImageRepositories.ShowByID)
 	},
@@ -286,12 +305,21 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.OauthIntegrationForPartnerApplications: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.SecurityIntegrations.ShowByID)
 	},
+	resources.OrganizationListing: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Listings.ShowByID)
+	},
+	resources.PackagesPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.PackagesPolicies.ShowByID)
+	},
 	resources.PasswordPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.PasswordPolicies.ShowByID)
 	},
 	resources.Pipe: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Pipes.ShowByID)
 	},
+	resources.PrivacyPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.PrivacyPolicies.ShowByID)
+	},
 	resources.ProcedureJava: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Procedures.ShowByID)
 	},
@@ -358,12 +386,21 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.SharedDatabase: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Databases.ShowByID)
 	},
+	resources.SnapshotPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.SnapshotPolicies.ShowByID)
+	},
+	resources.SnapshotSet: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.SnapshotSets.ShowByID)
+	},
 	resources.Stage: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Stages.ShowByID)
 	},
 	resources.StorageIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.StorageIntegrations.ShowByID)
 	},
+	resources.StorageLifecyclePolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.StorageLifecyclePolicies.ShowByID)
+	},
 	resources.StreamOnDirectoryTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Streams.ShowByID)
 	},
@@ -388,6 +425,9 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.Task: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Tasks.ShowByID)
 	},
+	resources.TaskGraph: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Tasks.ShowByID)
+	},
 	resources.User: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Users.ShowByID)
 	},
@@ -742,3 +782,66 @@ func CheckUserProgrammaticAccessTokenDestroy(t *testing.T) func(*terraform.State
 		return nil
 	}
 }
+
+func CheckUserRsaKeyPairDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+	return func(s *terraform.State) error {
+		client := TestAccProvider.Meta().(*provider.Context).Client
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resources.UserRsaKeyPair.String() {
+				continue
+			}
+			userId, err := sdk.ParseAccountObjectIdentifier(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			userDetails, err := client.Users.Describe(context.Background(), userId)
+			if err != nil {
+				if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+					continue
+				}
+				return err
+			}
+			for _, fingerprint := range []string{rs.Primary.Attributes["public_key_fingerprint"], rs.Primary.Attributes["previous_public_key_fingerprint"]} {
+				if fingerprint != "" && (userDetails.RsaPublicKeyFp.Value == fingerprint || userDetails.RsaPublicKey2Fp.Value == fingerprint) {
+					return fmt.Errorf("rsa public key with fingerprint %s is still set for user %s", fingerprint, userId.Name())
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func CheckStageFileDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+	return func(s *terraform.State) error {
+		client := TestAccProvider.Meta().(*provider.Context).Client
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resources.StageFile.String() {
+				continue
+			}
+			stageId, err := sdk.ParseSchemaObjectIdentifier(rs.Primary.Attributes["stage"])
+			if err != nil {
+				return err
+			}
+			stageFiles, err := client.StageFiles.List(context.Background(), sdk.NewStageLocation(stageId, rs.Primary.Attributes["path"]), nil)
+			if err != nil {
+				if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+					continue
+				}
+				return err
+			}
+			filesCount, err := strconv.Atoi(rs.Primary.Attributes["files.#"])
+			if err != nil {
+				return err
+			}
+			for i := range filesCount {
+				path := rs.Primary.Attributes[fmt.Sprintf("files.%d.path", i)]
+				if slices.ContainsFunc(stageFiles, func(stageFile sdk.StageFile) bool { return stageFile.PathOnStage() == path }) {
+					return fmt.Errorf("file %s on stage %s still exists", path, stageId.FullyQualifiedName())
+				}
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,186 @@
+package testacc
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// assertTags checks both the tags attribute in the state and the tag values set on the object in Snowflake.
+func assertTags(t *testing.T, resourceReference string, id sdk.ObjectIdentifier, objectType sdk.ObjectType, tags ...sdk.TagAssociation) resource.TestCheckFunc {
+	t.Helper()
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(resourceReference, "tags.%", strconv.Itoa(len(tags))),
+	}
+	for _, tag := range tags {
+		checks = append(checks,
+			resource.TestCheckResourceAttr(resourceReference, fmt.Sprintf("tags.%s", tag.Name.FullyQualifiedName()), tag.Value),
+			assertTagValue(t, sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(tag.Name.FullyQualifiedName()), id, objectType, tag.Value),
+		)
+	}
+	return resource.ComposeAggregateTestCheckFunc(checks...)
+}
+
+func assertTagValue(t *testing.T, tagId sdk.SchemaObjectIdentifier, id sdk.ObjectIdentifier, objectType sdk.ObjectType, expectedValue string) resource.TestCheckFunc {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		value, err := testClient().Tag.GetForObject(t, tagId, id, objectType)
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			return fmt.Errorf("tag %s for object %s expected to be %s, got nothing", tagId.FullyQualifiedName(), id.FullyQualifiedName(), expectedValue)
+		}
+		if *value != expectedValue {
+			return fmt.Errorf("tag %s for object %s expected to be %s, got %s", tagId.FullyQualifiedName(), id.FullyQualifiedName(), expectedValue, *value)
+		}
+		return nil
+	}
+}
+
+func assertTagsUnset(t *testing.T, id sdk.ObjectIdentifier, objectType sdk.ObjectType, tagIds ...sdk.SchemaObjectIdentifier) resource.TestCheckFunc {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		for _, tagId := range tagIds {
+			if err := assertTagUnset(t, tagId, id, objectType); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// assertImportedTags checks the tags attribute of the single imported object.
+func assertImportedTags(tags ...sdk.TagAssociation) resource.ImportStateCheckFunc {
+	return func(is []*terraform.InstanceState) error {
+		if len(is) != 1 {
+			return fmt.Errorf("expected one imported object, got %d", len(is))
+		}
+		attributes := is[0].Attributes
+		if attributes["tags.%"] != strconv.Itoa(len(tags)) {
+			return fmt.Errorf("expected %d imported tags, got %s", len(tags), attributes["tags.%"])
+		}
+		for _, tag := range tags {
+			key := fmt.Sprintf("tags.%s", tag.Name.FullyQualifiedName())
+			if attributes[key] != tag.Value {
+				return fmt.Errorf("expected imported %s to be %s, got %s", key, tag.Value, attributes[key])
+			}
+		}
+		return nil
+	}
+}
+
+// tagsTestSteps returns the common scenario for the tags attribute: setting tags on creation, changing them in place,
+// detecting the external changes of the managed tags (the tags set outside Terraform are ignored), importing, and removing them from the configuration.
+// The configFunc should return the configuration of the tested resource with the given tags set.
+func tagsTestSteps(t *testing.T, resourceReference string, id sdk.ObjectIdentifier, objectType sdk.ObjectType, configFunc func(tags ...sdk.TagAssociation) string) []resource.TestStep {
+	t.Helper()
+
+	tag, tagCleanup := testClient().Tag.CreateTag(t)
+	t.Cleanup(tagCleanup)
+
+	otherTag, otherTagCleanup := testClient().Tag.CreateTag(t)
+	t.Cleanup(otherTagCleanup)
+
+	tagValue := sdk.TagAssociation{Name: tag.ID(), Value: "value"}
+	tagChangedValue := sdk.TagAssociation{Name: tag.ID(), Value: "changed value"}
+	otherTagValue := sdk.TagAssociation{Name: otherTag.ID(), Value: "other value"}
+
+	return []resource.TestStep{
+		// create with tags
+		{
+			Config: configFunc(tagValue),
+			Check:  assertTags(t, resourceReference, id, objectType, tagValue),
+		},
+		// change the value and add another tag
+		{
+			Config: configFunc(tagChangedValue, otherTagValue),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: assertTags(t, resourceReference, id, objectType, tagChangedValue, otherTagValue),
+		},
+		// change the value externally
+		{
+			PreConfig: func() {
+				testClient().Tag.Set(t, objectType, id, []sdk.TagAssociation{tagValue})
+			},
+			Config: configFunc(tagChangedValue, otherTagValue),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: assertTags(t, resourceReference, id, objectType, tagChangedValue, otherTagValue),
+		},
+		// unset the tag externally
+		{
+			PreConfig: func() {
+				testClient().Tag.Unset(t, objectType, id, []sdk.ObjectIdentifier{otherTag.ID()})
+			},
+			Config: configFunc(tagChangedValue, otherTagValue),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: assertTags(t, resourceReference, id, objectType, tagChangedValue, otherTagValue),
+		},
+		// remove one of the tags from the config
+		{
+			Config: configFunc(tagChangedValue),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				assertTags(t, resourceReference, id, objectType, tagChangedValue),
+				assertTagsUnset(t, id, objectType, otherTag.ID()),
+			),
+		},
+		// set the tag not present in the config externally (it is not managed by the resource)
+		{
+			PreConfig: func() {
+				testClient().Tag.Set(t, objectType, id, []sdk.TagAssociation{otherTagValue})
+			},
+			Config: configFunc(tagChangedValue),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectEmptyPlan(),
+				},
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				assertTags(t, resourceReference, id, objectType, tagChangedValue),
+				assertTagValue(t, otherTag.ID(), id, objectType, otherTagValue.Value),
+			),
+		},
+		// import reads all the tags set directly on the object
+		{
+			ResourceName:     resourceReference,
+			ImportState:      true,
+			ImportStateCheck: assertImportedTags(tagChangedValue, otherTagValue),
+		},
+		// remove tags from the config
+		{
+			Config: configFunc(),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				assertTags(t, resourceReference, id, objectType),
+				assertTagsUnset(t, id, objectType, tag.ID()),
+				assertTagValue(t, otherTag.ID(), id, objectType, otherTagValue.Value),
+			),
+		},
+	}
+}
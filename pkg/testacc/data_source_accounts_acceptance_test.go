@@ -95,6 +95,13 @@ func TestAcc_Accounts_Complete(t *testing.T) {
 						HasMovedToOrganizationEmpty().
 						HasMovedOnEmpty().
 						HasOrganizationUrlExpirationOnEmpty(),
+					assert.Check(resource.TestCheckResourceAttr("data.snowflake_accounts.test", "accounts.0.connection_details.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr("data.snowflake_accounts.test", "accounts.0.connection_details.0.organization_name", account.OrganizationName)),
+					assert.Check(resource.TestCheckResourceAttr("data.snowflake_accounts.test", "accounts.0.connection_details.0.account_name", account.AccountName)),
+					assert.Check(resource.TestCheckResourceAttr("data.snowflake_accounts.test", "accounts.0.connection_details.0.account_url", *account.AccountURL)),
+					assert.Check(resource.TestCheckResourceAttr("data.snowflake_accounts.test", "accounts.0.connection_details.0.account_locator", account.AccountLocator)),
+					assert.Check(resource.TestCheckResourceAttr("data.snowflake_accounts.test", "accounts.0.connection_details.0.account_locator_url", *account.AccountLocatorUrl)),
+					assert.Check(resource.TestCheckResourceAttr("data.snowflake_accounts.test", "accounts.0.connection_details.0.region", account.SnowflakeRegion)),
 				),
 			},
 		},
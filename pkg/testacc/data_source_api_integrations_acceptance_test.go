@@ -0,0 +1,53 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ApiIntegrations_basic(t *testing.T) {
+	apiIntegration, apiIntegrationCleanup := testClient().ApiIntegration.CreateApiIntegration(t)
+	t.Cleanup(apiIntegrationCleanup)
+	_, otherApiIntegrationCleanup := testClient().ApiIntegration.CreateApiIntegration(t)
+	t.Cleanup(otherApiIntegrationCleanup)
+
+	dataSourceModel := datasourcemodel.ApiIntegrations("test").
+		WithLike(apiIntegration.ID().Name())
+	dataSourceWithoutDescribeModel := datasourcemodel.ApiIntegrations("test").
+		WithLike(apiIntegration.ID().Name()).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "api_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "api_integrations.0.show_output.0.name", apiIntegration.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "api_integrations.0.show_output.0.api_type", "EXTERNAL_API")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "api_integrations.0.describe_output.0.enabled.0.value", "true")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "api_integrations.0.describe_output.0.api_provider.0.value", string(sdk.ApiIntegrationAwsApiGateway))),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceWithoutDescribeModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "api_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "api_integrations.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
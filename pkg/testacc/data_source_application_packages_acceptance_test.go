@@ -0,0 +1,52 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ApplicationPackages_basic(t *testing.T) {
+	applicationPackage, applicationPackageCleanup := testClient().ApplicationPackage.CreateApplicationPackage(t)
+	t.Cleanup(applicationPackageCleanup)
+	_, otherApplicationPackageCleanup := testClient().ApplicationPackage.CreateApplicationPackage(t)
+	t.Cleanup(otherApplicationPackageCleanup)
+
+	dataSourceModel := datasourcemodel.ApplicationPackages("test").
+		WithLike(applicationPackage.ID().Name())
+	dataSourceWithoutDescribeModel := datasourcemodel.ApplicationPackages("test").
+		WithLike(applicationPackage.ID().Name()).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "application_packages.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "application_packages.0.show_output.0.name", applicationPackage.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "application_packages.0.show_output.0.owner", snowflakeroles.Accountadmin.Name())),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "application_packages.0.describe_output.0.property")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceWithoutDescribeModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "application_packages.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "application_packages.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
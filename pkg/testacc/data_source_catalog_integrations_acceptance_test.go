@@ -0,0 +1,65 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_CatalogIntegrations(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+
+	catalogIntegrationModel := model.CatalogIntegration("test", id.Name(), string(sdk.CatalogIntegrationCatalogSourceObjectStore), true, string(sdk.CatalogIntegrationTableFormatIceberg)).
+		WithComment(comment)
+
+	dataSourceModel := datasourcemodel.CatalogIntegrations("test").
+		WithLike(id.Name()).
+		WithDependsOn(catalogIntegrationModel.ResourceReference())
+
+	dataSourceWithoutDescribe := datasourcemodel.CatalogIntegrations("test").
+		WithLike(id.Name()).
+		WithWithDescribe(false).
+		WithDependsOn(catalogIntegrationModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, catalogIntegrationModel, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "catalog_integrations.#", "1")),
+					resourceshowoutputassert.CatalogIntegrationsDatasourceShowOutput(t, "snowflake_catalog_integrations.test").
+						HasCreatedOnNotEmpty().
+						HasName(id.Name()).
+						HasEnabled(true).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "catalog_integrations.0.describe_output.0.catalog_source.0.value", string(sdk.CatalogIntegrationCatalogSourceObjectStore))),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "catalog_integrations.0.describe_output.0.table_format.0.value", string(sdk.CatalogIntegrationTableFormatIceberg))),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, catalogIntegrationModel, dataSourceWithoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "catalog_integrations.#", "1")),
+					resourceshowoutputassert.CatalogIntegrationsDatasourceShowOutput(t, "snowflake_catalog_integrations.test").
+						HasName(id.Name()),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "catalog_integrations.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
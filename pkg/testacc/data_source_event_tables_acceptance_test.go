@@ -0,0 +1,57 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EventTables_basic(t *testing.T) {
+	eventTable, eventTableCleanup := testClient().EventTable.Create(t)
+	t.Cleanup(eventTableCleanup)
+	_, otherEventTableCleanup := testClient().EventTable.Create(t)
+	t.Cleanup(otherEventTableCleanup)
+
+	dataSourceModel := datasourcemodel.EventTables("test").
+		WithLike(eventTable.ID().Name()).
+		WithInValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"database": tfconfig.StringVariable(testClient().Ids.DatabaseId().Name()),
+		}))
+	dataSourceWithoutDescribeModel := datasourcemodel.EventTables("test").
+		WithLike(eventTable.ID().Name()).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.show_output.0.name", eventTable.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.show_output.0.database_name", eventTable.ID().DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.show_output.0.schema_name", eventTable.ID().SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.describe_output.0.name", eventTable.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.describe_output.0.kind", "EVENT_TABLE")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceWithoutDescribeModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "event_tables.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "event_tables.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,55 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalAccessIntegrations_basic(t *testing.T) {
+	networkRule, networkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+	externalAccessIntegrationId, externalAccessIntegrationCleanup := testClient().ExternalAccessIntegration.CreateExternalAccessIntegration(t, networkRule.ID())
+	t.Cleanup(externalAccessIntegrationCleanup)
+	_, otherExternalAccessIntegrationCleanup := testClient().ExternalAccessIntegration.CreateExternalAccessIntegration(t, networkRule.ID())
+	t.Cleanup(otherExternalAccessIntegrationCleanup)
+
+	dataSourceModel := datasourcemodel.ExternalAccessIntegrations("test").
+		WithLike(externalAccessIntegrationId.Name())
+	dataSourceWithoutDescribeModel := datasourcemodel.ExternalAccessIntegrations("test").
+		WithLike(externalAccessIntegrationId.Name()).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.0.show_output.0.name", externalAccessIntegrationId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.0.show_output.0.type", "EXTERNAL_ACCESS")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.0.show_output.0.enabled", "true")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.0.describe_output.0.enabled.0.value", "true")),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "external_access_integrations.0.describe_output.0.allowed_network_rules.0.value")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceWithoutDescribeModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "external_access_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "external_access_integrations.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
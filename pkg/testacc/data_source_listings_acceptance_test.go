@@ -0,0 +1,53 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Listings_basic(t *testing.T) {
+	listing, listingCleanup := testClient().Listing.Create(t)
+	t.Cleanup(listingCleanup)
+	_, otherListingCleanup := testClient().Listing.Create(t)
+	t.Cleanup(otherListingCleanup)
+
+	dataSourceModel := datasourcemodel.Listings("test").
+		WithLike(listing.ID().Name())
+	dataSourceWithoutDescribeModel := datasourcemodel.Listings("test").
+		WithLike(listing.ID().Name()).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "listings.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "listings.0.show_output.0.name", listing.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "listings.0.show_output.0.state", string(sdk.ListingStateDraft))),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "listings.0.describe_output.0.name", listing.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "listings.0.describe_output.0.state", string(sdk.ListingStateDraft))),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceWithoutDescribeModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "listings.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "listings.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NotificationIntegrations_basic(t *testing.T) {
+	notificationIntegration, notificationIntegrationCleanup := testClient().NotificationIntegration.Create(t)
+	t.Cleanup(notificationIntegrationCleanup)
+	_, otherNotificationIntegrationCleanup := testClient().NotificationIntegration.Create(t)
+	t.Cleanup(otherNotificationIntegrationCleanup)
+
+	dataSourceModel := datasourcemodel.NotificationIntegrations("test").
+		WithLike(notificationIntegration.ID().Name())
+	dataSourceWithoutDescribeModel := datasourcemodel.NotificationIntegrations("test").
+		WithLike(notificationIntegration.ID().Name()).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "notification_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "notification_integrations.0.show_output.0.name", notificationIntegration.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "notification_integrations.0.show_output.0.category", "NOTIFICATION")),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "notification_integrations.0.describe_output.0.enabled.0.value")),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "notification_integrations.0.describe_output.0.direction.0.value")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceWithoutDescribeModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "notification_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "notification_integrations.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_OrganizationListings_basic(t *testing.T) {
+	share, shareCleanup := secondaryTestClient().Share.CreateShare(t)
+	t.Cleanup(shareCleanup)
+	t.Cleanup(secondaryTestClient().Grant.GrantPrivilegeOnDatabaseToShare(t, secondaryTestClient().Ids.DatabaseId(), share.ID(), []sdk.ObjectPrivilege{sdk.ObjectPrivilegeUsage}))
+
+	listing, listingCleanup := secondaryTestClient().Listing.CreateOrganizationListingWithShare(t, share.ID())
+	t.Cleanup(listingCleanup)
+
+	dataSourceModel := datasourcemodel.OrganizationListings("test").
+		WithLike(listing.Title)
+	dataSourceWithNoMatchesModel := datasourcemodel.OrganizationListings("test").
+		WithLike(NonExistingAccountObjectIdentifier.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "organization_listings.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "organization_listings.0.show_output.0.global_name", listing.GlobalName)),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "organization_listings.0.show_output.0.title", listing.Title)),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "organization_listings.0.show_output.0.uniform_listing_locator")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceWithNoMatchesModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithNoMatchesModel.DatasourceReference(), "organization_listings.#", "0")),
+				),
+			},
+		},
+	})
+}
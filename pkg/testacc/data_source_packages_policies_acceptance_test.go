@@ -0,0 +1,58 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_PackagesPolicies_basic(t *testing.T) {
+	packagesPolicy, packagesPolicyCleanup := testClient().PackagesPolicy.CreateWithRequest(t, *sdk.NewCreatePackagesPolicyRequest(testClient().Ids.RandomSchemaObjectIdentifier()).
+		WithAllowlist([]sdk.StringListItemWrapper{{Value: "numpy"}}).
+		WithComment("some comment"),
+	)
+	t.Cleanup(packagesPolicyCleanup)
+	_, otherPackagesPolicyCleanup := testClient().PackagesPolicy.Create(t)
+	t.Cleanup(otherPackagesPolicyCleanup)
+
+	dataSourceModel := datasourcemodel.PackagesPolicies("test").
+		WithLike(packagesPolicy.ID().Name())
+	dataSourceWithoutDescribeModel := datasourcemodel.PackagesPolicies("test").
+		WithLike(packagesPolicy.ID().Name()).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "packages_policies.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "packages_policies.0.show_output.0.name", packagesPolicy.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "packages_policies.0.show_output.0.comment", "some comment")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "packages_policies.0.describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "packages_policies.0.describe_output.0.language", "PYTHON")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "packages_policies.0.describe_output.0.allowlist.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "packages_policies.0.describe_output.0.allowlist.0", "numpy")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceWithoutDescribeModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "packages_policies.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribeModel.DatasourceReference(), "packages_policies.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
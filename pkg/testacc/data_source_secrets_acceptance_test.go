@@ -25,9 +25,9 @@ func TestAcc_Secrets_BasicUseCase_DifferentFiltering(t *testing.T) {
 	secretId2 := testClient().Ids.RandomSchemaObjectIdentifierWithPrefix(prefix)
 	secretId3 := testClient().Ids.RandomSchemaObjectIdentifier()
 
-	secretModel1 := model.SecretWithGenericString("test", secretId1.DatabaseName(), secretId1.SchemaName(), secretId1.Name(), "test_secret_string1")
-	secretModel2 := model.SecretWithGenericString("test1", secretId2.DatabaseName(), secretId2.SchemaName(), secretId2.Name(), "test_secret_string2")
-	secretModel3 := model.SecretWithGenericString("test2", secretId3.DatabaseName(), secretId3.SchemaName(), secretId3.Name(), "test_secret_string3")
+	secretModel1 := model.SecretWithGenericString("test", secretId1.DatabaseName(), secretId1.SchemaName(), secretId1.Name()).WithSecretString("test_secret_string1")
+	secretModel2 := model.SecretWithGenericString("test1", secretId2.DatabaseName(), secretId2.SchemaName(), secretId2.Name()).WithSecretString("test_secret_string2")
+	secretModel3 := model.SecretWithGenericString("test2", secretId3.DatabaseName(), secretId3.SchemaName(), secretId3.Name()).WithSecretString("test_secret_string3")
 
 	datasourceModelLikeExact := datasourcemodel.Secrets("test").
 		WithWithDescribe(false).
@@ -133,10 +133,10 @@ func TestAcc_Secrets_CompleteUseCase(t *testing.T) {
 	clientCredsId := testClient().Ids.RandomSchemaObjectIdentifierWithPrefix(prefix + "_cli")
 	authCodeId := testClient().Ids.RandomSchemaObjectIdentifierWithPrefix(prefix + "_aut")
 
-	genericModel := model.SecretWithGenericString("gen", genericId.DatabaseName(), genericId.SchemaName(), genericId.Name(), "generic_value").WithComment(comment)
-	basicModel := model.SecretWithBasicAuthentication("bas", basicId.DatabaseName(), basicId.SchemaName(), basicId.Name(), "pwd", "user1")
+	genericModel := model.SecretWithGenericString("gen", genericId.DatabaseName(), genericId.SchemaName(), genericId.Name()).WithSecretString("generic_value").WithComment(comment)
+	basicModel := model.SecretWithBasicAuthentication("bas", basicId.DatabaseName(), basicId.SchemaName(), basicId.Name(), "user1").WithPassword("pwd")
 	clientCredsModel := model.SecretWithClientCredentials("cli", clientCredsId.DatabaseName(), clientCredsId.SchemaName(), clientCredsId.Name(), apiIntegrationId.Name(), []string{"scope1", "scope2"})
-	authCodeModel := model.SecretWithAuthorizationCodeGrant("aut", authCodeId.DatabaseName(), authCodeId.SchemaName(), authCodeId.Name(), apiIntegrationId.Name(), "refresh_token_value", time.Now().Add(24*time.Hour).Format(time.DateTime)).WithComment(comment)
+	authCodeModel := model.SecretWithAuthorizationCodeGrant("aut", authCodeId.DatabaseName(), authCodeId.SchemaName(), authCodeId.Name(), apiIntegrationId.Name(), time.Now().Add(24*time.Hour).Format(time.DateTime)).WithOauthRefreshToken("refresh_token_value").WithComment(comment)
 
 	genericSecretNoDescribe := datasourcemodel.Secrets("test").
 		WithLike(genericId.Name()).
@@ -138,7 +138,7 @@ func TestAcc_SecurityIntegrations_CompleteUseCase(t *testing.T) {
 		WithExternalOauthScopeDelimiter(".").
 		WithExternalOauthScopeMappingAttribute(externalOauthMappingAttribute)
 
-	apiAuthAuthCodeModel := model.ApiAuthenticationIntegrationWithAuthorizationCodeGrant("test5", apiAuthAuthCodeIntegrationId.Name(), true, "foo", apiAuthPass1).
+	apiAuthAuthCodeModel := model.ApiAuthenticationIntegrationWithAuthorizationCodeGrant("test5", apiAuthAuthCodeIntegrationId.Name(), true, "foo").WithOauthClientSecret(apiAuthPass1).
 		WithComment(comment).
 		WithOauthAccessTokenValidity(42).
 		WithOauthAuthorizationEndpoint("https://example.com").
@@ -147,7 +147,7 @@ func TestAcc_SecurityIntegrations_CompleteUseCase(t *testing.T) {
 		WithOauthTokenEndpoint("https://example.com").
 		WithOauthAllowedScopesValue(config.SetVariable(config.StringVariable("foo")))
 
-	apiAuthClientCredsModel := model.ApiAuthenticationIntegrationWithClientCredentials("test6", apiAuthClientCredentialsIntegrationId.Name(), true, apiAuthPass1, apiAuthPass2).
+	apiAuthClientCredsModel := model.ApiAuthenticationIntegrationWithClientCredentials("test6", apiAuthClientCredentialsIntegrationId.Name(), true, apiAuthPass1).WithOauthClientSecret(apiAuthPass2).
 		WithComment(comment).
 		WithOauthAccessTokenValidity(42).
 		WithOauthClientAuthMethod(string(sdk.ApiAuthenticationSecurityIntegrationOauthClientAuthMethodClientSecretPost)).
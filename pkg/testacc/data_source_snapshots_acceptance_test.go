@@ -0,0 +1,74 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SnapshotSets_basic(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+	snapshotSet, snapshotSetCleanup := testClient().SnapshotSet.CreateForTable(t, table.ID())
+	t.Cleanup(snapshotSetCleanup)
+	_, otherSnapshotSetCleanup := testClient().SnapshotSet.CreateForTable(t, table.ID())
+	t.Cleanup(otherSnapshotSetCleanup)
+
+	dataSourceModel := datasourcemodel.SnapshotSets("test").
+		WithLike(snapshotSet.ID().Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "snapshot_sets.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "snapshot_sets.0.show_output.0.name", snapshotSet.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "snapshot_sets.0.show_output.0.object_kind", "TABLE")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "snapshot_sets.0.show_output.0.object_name", table.ID().Name())),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Snapshots_basic(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+	snapshotSet, snapshotSetCleanup := testClient().SnapshotSet.CreateForTable(t, table.ID())
+	t.Cleanup(snapshotSetCleanup)
+
+	testClient().SnapshotSet.AddSnapshot(t, snapshotSet.ID())
+	snapshots := testClient().SnapshotSet.ShowSnapshots(t, snapshotSet.ID())
+
+	dataSourceModel := datasourcemodel.Snapshots("test").
+		WithInSnapshotSet(snapshotSet.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "snapshots.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "snapshots.0.show_output.0.snapshot_id", snapshots[0].SnapshotId)),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "snapshots.0.show_output.0.snapshot_set_name", snapshotSet.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "snapshots.0.show_output.0.created_on")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,104 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SqlQuery_basic(t *testing.T) {
+	dataSourceModel := datasourcemodel.SqlQuery("test", "SELECT ? AS TEXT_VALUE, ?::NUMBER(10, 2) AS NUMBER_VALUE, NULL::VARCHAR AS NULL_VALUE, TRUE AS BOOLEAN_VALUE").
+		WithBindVariables("value", "1.5")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "rows.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "rows.0.%", "3")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "rows.0.TEXT_VALUE", "value")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "rows.0.NUMBER_VALUE", "1.50")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "rows.0.BOOLEAN_VALUE", "true")),
+					assert.Check(resource.TestCheckNoResourceAttr(dataSourceModel.DatasourceReference(), "rows.0.NULL_VALUE")),
+
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "columns.#", "4")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "columns.0.name", "TEXT_VALUE")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "columns.0.type", "TEXT")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "columns.1.name", "NUMBER_VALUE")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "columns.1.type", "FIXED")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "columns.1.precision", "10")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "columns.1.scale", "2")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "columns.2.name", "NULL_VALUE")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "columns.2.nullable", "true")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "columns.3.name", "BOOLEAN_VALUE")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "columns.3.type", "BOOLEAN")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_SqlQuery_showCommandAtPlanTime(t *testing.T) {
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+
+	dataSourceModel := datasourcemodel.SqlQuery("test", "SHOW DATABASES LIKE ?").
+		WithBindVariables(database.ID().Name())
+	// the result of the query is used in the configuration of another object
+	schemaModel := model.Schema("test", database.ID().Name(), testClient().Ids.Alpha()).
+		WithCommentValue(accconfig.UnquotedWrapperVariable(dataSourceModel.DatasourceReference() + ".rows[0].owner"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Schema),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel, schemaModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "rows.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "rows.0.name", database.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(schemaModel.ResourceReference(), "comment", "ACCOUNTADMIN")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_SqlQuery_validations(t *testing.T) {
+	dmlModel := datasourcemodel.SqlQuery("test", "DELETE FROM some_table")
+	multipleStatementsModel := datasourcemodel.SqlQuery("test", "SELECT 1; DROP DATABASE some_database")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, dmlModel),
+				ExpectError: regexp.MustCompile("only read-only statements starting with one of"),
+			},
+			{
+				Config:      accconfig.FromModels(t, multipleStatementsModel),
+				ExpectError: regexp.MustCompile("only a single statement is allowed, got 2"),
+			},
+		},
+	})
+}
@@ -69,7 +69,8 @@ func TestAcc_Account_Minimal(t *testing.T) {
 						HasNoComment().
 						HasNoConsumptionBillingEntity().
 						HasIsOrgAdminString(r.BooleanDefault).
-						HasGracePeriodInDaysString("3"),
+						HasGracePeriodInDaysString("3").
+						HasConnectionDetails(organizationName, id.Name(), region),
 					resourceshowoutputassert.AccountShowOutput(t, configModel.ResourceReference()).
 						HasOrganizationName(organizationName).
 						HasAccountName(id.Name()).
@@ -299,7 +300,8 @@ func TestAcc_Account_Rename(t *testing.T) {
 					resourceassert.AccountResource(t, newConfigModel.ResourceReference()).
 						HasNameString(newId.Name()).
 						HasFullyQualifiedNameString(newAccountId.FullyQualifiedName()).
-						HasAdminUserType(sdk.UserTypeService),
+						HasAdminUserType(sdk.UserTypeService).
+						HasConnectionDetails(organizationName, newId.Name(), testClient().Context.CurrentRegion(t)),
 					resourceshowoutputassert.AccountShowOutput(t, newConfigModel.ResourceReference()).
 						HasOrganizationName(organizationName).
 						HasAccountName(newId.Name()),
@@ -0,0 +1,84 @@
+//go:build account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AccountBudget_basic(t *testing.T) {
+	// TODO [SNOW-1007539]: use email of our service user
+	verifiedEmail := "artur.sawicki@snowflake.com"
+	t.Cleanup(func() { testClient().Budget.DeactivateAccountBudget(t) })
+
+	basicModel := model.AccountBudget("test", 100)
+	updatedModel := model.AccountBudget("test", 150).
+		WithNotificationEmails(verifiedEmail).
+		WithMuteNotifications(r.BooleanTrue)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, basicModel),
+				Check: assertThat(t,
+					resourceassert.AccountBudgetResource(t, basicModel.ResourceReference()).
+						HasSpendingLimitString("100").
+						HasMuteNotificationsString(r.BooleanDefault).
+						HasNotificationEmailsEmpty(),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "id", sdk.AccountRootBudgetId.FullyQualifiedName())),
+				),
+			},
+			// import
+			{
+				ResourceName:      basicModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: accconfig.FromModels(t, updatedModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(updatedModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AccountBudgetResource(t, updatedModel.ResourceReference()).
+						HasSpendingLimitString("150").
+						HasMuteNotificationsString(r.BooleanTrue),
+					assert.Check(resource.TestCheckTypeSetElemAttr(updatedModel.ResourceReference(), "notification_emails.*", verifiedEmail)),
+				),
+			},
+			// external change
+			{
+				PreConfig: func() {
+					testClient().Budget.SetSpendingLimit(t, sdk.AccountRootBudgetId, 500)
+				},
+				Config: accconfig.FromModels(t, updatedModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(updatedModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AccountBudgetResource(t, updatedModel.ResourceReference()).
+						HasSpendingLimitString("150"),
+				),
+			},
+		},
+	})
+}
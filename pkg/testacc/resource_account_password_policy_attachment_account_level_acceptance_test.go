@@ -0,0 +1,73 @@
+//go:build account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_AccountPasswordPolicyAttachment_DetachedOutsideTerraform(t *testing.T) {
+	testClient().EnsureValidNonProdAccountIsUsed(t)
+
+	passwordPolicy, passwordPolicyCleanup := testClient().PasswordPolicy.CreatePasswordPolicy(t)
+	t.Cleanup(passwordPolicyCleanup)
+
+	// Only one password policy can be attached to the account, so the currently attached one is detached for the time of the test.
+	attachedPolicies, err := testClient().PolicyReferences.GetPolicyReferences(t, sdk.NewAccountObjectIdentifier(testClient().GetAccountLocator()), sdk.PolicyEntityDomainAccount)
+	require.NoError(t, err)
+	if attachedPolicy, err := collections.FindFirst(attachedPolicies, func(p sdk.PolicyReference) bool { return p.PolicyKind == sdk.PolicyKindPasswordPolicy }); err == nil {
+		attachedPolicyId := sdk.NewSchemaObjectIdentifier(*attachedPolicy.PolicyDb, *attachedPolicy.PolicySchema, attachedPolicy.PolicyName)
+		testClient().Account.Alter(t, &sdk.AlterAccountOptions{Unset: &sdk.AccountUnset{PasswordPolicy: sdk.Bool(true)}})
+		t.Cleanup(func() {
+			testClient().Account.Alter(t, &sdk.AlterAccountOptions{Set: &sdk.AccountSet{PasswordPolicy: &attachedPolicyId}})
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: accountPasswordPolicyAttachmentExistingPolicyConfig(passwordPolicy.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_password_policy_attachment.att", "password_policy", passwordPolicy.ID().FullyQualifiedName()),
+				),
+			},
+			// detach the policy outside of Terraform and expect the attachment to be recreated
+			{
+				PreConfig: func() {
+					testClient().Account.Alter(t, &sdk.AlterAccountOptions{Unset: &sdk.AccountUnset{PasswordPolicy: sdk.Bool(true)}})
+				},
+				Config: accountPasswordPolicyAttachmentExistingPolicyConfig(passwordPolicy.ID()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_account_password_policy_attachment.att", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_password_policy_attachment.att", "password_policy", passwordPolicy.ID().FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
+func accountPasswordPolicyAttachmentExistingPolicyConfig(passwordPolicyId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_account_password_policy_attachment" "att" {
+	password_policy = %s
+}
+`, strconv.Quote(passwordPolicyId.FullyQualifiedName()))
+}
@@ -250,3 +250,20 @@ func TestAcc_AccountRole_WithQuotedName(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_AccountRole_Tags(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+
+	accountRoleModel := model.AccountRole("test", id.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.AccountRole),
+		Steps: tagsTestSteps(t, accountRoleModel.ResourceReference(), id, sdk.ObjectTypeRole, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, accountRoleModel.WithTags(tags...))
+		}),
+	})
+}
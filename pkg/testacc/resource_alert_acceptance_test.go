@@ -3,96 +3,46 @@
 package testacc
 
 import (
-	"bytes"
 	"fmt"
-	"strconv"
 	"testing"
-	"text/template"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
-type (
-	AccAlertTestSettings struct {
-		WarehouseName string
-		DatabaseName  string
-		Alert         *AlertSettings
-	}
-
-	AlertSettings struct {
-		Name      string
-		Enabled   bool
-		Schema    string
-		Condition string
-		Action    string
-		Schedule  int
-		Comment   string
-	}
-)
-
-func TestAcc_Alert(t *testing.T) {
+func TestAcc_Alert_basic(t *testing.T) {
 	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	warehouseId := testClient().Ids.WarehouseId()
+	comment, changedComment := random.Comment(), random.Comment()
+	condition, changedCondition := "select 0 as c", "select 1 as c"
+	action, changedAction := "select 0 as c", "select 1 as c"
+	cron := "0 9 * * MON UTC"
 
-	alertInitialState := &AccAlertTestSettings{ //nolint
-		WarehouseName: TestWarehouseName,
-		DatabaseName:  TestDatabaseName,
-		Alert: &AlertSettings{
-			Name:      id.Name(),
-			Condition: "select 0 as c",
-			Action:    "select 0 as c",
-			Schema:    TestSchemaName,
-			Enabled:   true,
-			Schedule:  5,
-			Comment:   "dummy",
-		},
-	}
-
-	// Changes: condition, action, comment, schedule.
-	alertStepOne := &AccAlertTestSettings{ //nolint
-		WarehouseName: TestWarehouseName,
-		DatabaseName:  TestDatabaseName,
-		Alert: &AlertSettings{
-			Name:      id.Name(),
-			Condition: "select 1 as c",
-			Action:    "select 1 as c",
-			Schema:    TestSchemaName,
-			Enabled:   true,
-			Schedule:  15,
-			Comment:   "test",
-		},
-	}
+	modelBasic := model.AlertWithId("test", id, false, condition, action).
+		WithWarehouse(warehouseId.Name()).
+		WithScheduleMinutes(5)
 
-	// Changes: condition, action, comment, schedule.
-	alertStepTwo := &AccAlertTestSettings{ //nolint
-		WarehouseName: TestWarehouseName,
-		DatabaseName:  TestDatabaseName,
-		Alert: &AlertSettings{
-			Name:      id.Name(),
-			Condition: "select 2 as c",
-			Action:    "select 2 as c",
-			Schema:    TestSchemaName,
-			Enabled:   true,
-			Schedule:  25,
-			Comment:   "text",
-		},
-	}
+	modelComplete := model.AlertWithId("test", id, true, changedCondition, changedAction).
+		WithWarehouse(warehouseId.Name()).
+		WithScheduleCron(cron).
+		WithComment(comment)
 
-	// Changes: condition, action, comment, schedule.
-	alertStepThree := &AccAlertTestSettings{ //nolint
-		WarehouseName: TestWarehouseName,
-		DatabaseName:  TestDatabaseName,
-		Alert: &AlertSettings{
-			Name:      id.Name(),
-			Condition: "select 2 as c",
-			Action:    "select 2 as c",
-			Schema:    TestSchemaName,
-			Enabled:   false,
-			Schedule:  5,
-		},
-	}
+	modelCompleteWithDifferentValues := model.AlertWithId("test", id, true, changedCondition, changedAction).
+		WithWarehouse(warehouseId.Name()).
+		WithScheduleMinutes(10).
+		WithComment(changedComment)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -101,142 +51,234 @@ func TestAcc_Alert(t *testing.T) {
 		},
 		CheckDestroy: CheckDestroy(t, resources.Alert),
 		Steps: []resource.TestStep{
+			// create with only required attributes and a warehouse schedule
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.AlertResource(t, modelBasic.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasStartedString("false").
+						HasWarehouseString(warehouseId.Name()).
+						HasScheduleMinutes(5).
+						HasConditionString(condition).
+						HasActionString(action).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.AlertShowOutput(t, modelBasic.ResourceReference()).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasWarehouse(warehouseId.Name()).
+						HasSchedule("5 MINUTE").
+						HasState(sdk.AlertStateSuspended).
+						HasCondition(condition).
+						HasAction(action),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.condition", condition)),
+				),
+			},
+			// import minimal state
 			{
-				Config: alertConfig(alertInitialState),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", strconv.FormatBool(alertInitialState.Alert.Enabled)),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "fully_qualified_name", id.FullyQualifiedName()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "database", TestDatabaseName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "schema", TestSchemaName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "condition", alertInitialState.Alert.Condition),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "action", alertInitialState.Alert.Action),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "comment", alertInitialState.Alert.Comment),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.interval", strconv.Itoa(alertInitialState.Alert.Schedule)),
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedAlertResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasStartedString("false").
+						HasWarehouseString(warehouseId.Name()).
+						HasConditionString(condition).
+						HasActionString(action).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
 				),
 			},
+			// add optional attributes, modify condition and action in place, and start the alert
 			{
-				Config: alertConfig(alertStepOne),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", strconv.FormatBool(alertStepOne.Alert.Enabled)),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "fully_qualified_name", id.FullyQualifiedName()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "database", TestDatabaseName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "schema", TestSchemaName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "condition", alertStepOne.Alert.Condition),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "action", alertStepOne.Alert.Action),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "comment", alertStepOne.Alert.Comment),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.interval", strconv.Itoa(alertStepOne.Alert.Schedule)),
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AlertResource(t, modelComplete.ResourceReference()).
+						HasStartedString("true").
+						HasScheduleCron(cron).
+						HasConditionString(changedCondition).
+						HasActionString(changedAction).
+						HasCommentString(comment),
+					resourceshowoutputassert.AlertShowOutput(t, modelComplete.ResourceReference()).
+						HasSchedule(fmt.Sprintf("USING CRON %s", cron)).
+						HasState(sdk.AlertStateStarted).
+						HasCondition(changedCondition).
+						HasAction(changedAction).
+						HasComment(comment),
 				),
 			},
+			// alter a started alert
 			{
-				Config: alertConfig(alertStepTwo),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", strconv.FormatBool(alertStepTwo.Alert.Enabled)),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "fully_qualified_name", id.FullyQualifiedName()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "database", TestDatabaseName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "schema", TestSchemaName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "condition", alertStepTwo.Alert.Condition),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "action", alertStepTwo.Alert.Action),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "comment", alertStepTwo.Alert.Comment),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.interval", strconv.Itoa(alertStepTwo.Alert.Schedule)),
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AlertResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasStartedString("true").
+						HasScheduleMinutes(10).
+						HasCommentString(changedComment),
+					resourceshowoutputassert.AlertShowOutput(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasSchedule("10 MINUTE").
+						HasState(sdk.AlertStateStarted).
+						HasComment(changedComment),
 				),
 			},
+			// change externally
 			{
-				Config: alertConfig(alertStepThree),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", strconv.FormatBool(alertStepThree.Alert.Enabled)),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "fully_qualified_name", id.FullyQualifiedName()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "database", TestDatabaseName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "schema", TestSchemaName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "condition", alertStepThree.Alert.Condition),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "action", alertStepThree.Alert.Action),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "comment", alertStepThree.Alert.Comment),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.interval", strconv.Itoa(alertStepThree.Alert.Schedule)),
+				PreConfig: func() {
+					testClient().Alert.Alter(t, id, &sdk.AlterAlertOptions{Action: sdk.Pointer(sdk.AlertActionSuspend)})
+					testClient().Alert.Alter(t, id, &sdk.AlterAlertOptions{ModifyCondition: &[]string{condition}})
+					testClient().Alert.Alter(t, id, &sdk.AlterAlertOptions{Set: &sdk.AlertSet{Comment: sdk.String(comment)}})
+				},
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AlertResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasStartedString("true").
+						HasConditionString(changedCondition).
+						HasCommentString(changedComment),
+					resourceshowoutputassert.AlertShowOutput(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasState(sdk.AlertStateStarted).
+						HasCondition(changedCondition).
+						HasComment(changedComment),
 				),
 			},
+			// unset
 			{
-				Config: alertConfig(alertInitialState),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", strconv.FormatBool(alertInitialState.Alert.Enabled)),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "fully_qualified_name", id.FullyQualifiedName()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "database", TestDatabaseName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "schema", TestSchemaName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "condition", alertInitialState.Alert.Condition),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "action", alertInitialState.Alert.Action),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "comment", alertInitialState.Alert.Comment),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.interval", strconv.Itoa(alertInitialState.Alert.Schedule)),
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AlertResource(t, modelBasic.ResourceReference()).
+						HasStartedString("false").
+						HasScheduleMinutes(5).
+						HasConditionString(condition).
+						HasActionString(action).
+						HasCommentString(""),
+					resourceshowoutputassert.AlertShowOutput(t, modelBasic.ResourceReference()).
+						HasState(sdk.AlertStateSuspended).
+						HasComment(""),
 				),
 			},
 		},
 	})
 }
 
-func alertConfig(settings *AccAlertTestSettings) string { //nolint
-	config, err := template.New("alert_acceptance_test_config").Parse(`
-resource "snowflake_alert" "test_alert" {
-	name     	      = "{{ .Alert.Name }}"
-	database  	      = "{{ .DatabaseName }}"
-	schema   	      = "{{ .Alert.Schema }}"
-	warehouse 	      = "{{ .WarehouseName }}"
-	alert_schedule 	  {
-		interval = "{{ .Alert.Schedule }}"
-	}
-	condition         = "{{ .Alert.Condition }}"
-	action            = "{{ .Alert.Action }}"
-	enabled  	      = {{ .Alert.Enabled }}
-	comment           = "{{ .Alert.Comment }}"
-}
-	`)
-	if err != nil {
-		fmt.Println(err)
-	}
+func TestAcc_Alert_serverlessOnNewData(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
 
-	var result bytes.Buffer
-	err = config.Execute(&result, settings) //nolint
-	if err != nil {
-		fmt.Println(err)
-	}
-	return result.String()
-}
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	warehouseId := testClient().Ids.WarehouseId()
+	condition := fmt.Sprintf("select * from %s", table.ID().FullyQualifiedName())
+	action := "select 0 as c"
+
+	modelOnNewData := model.AlertWithId("test", id, false, condition, action)
+	modelScheduledWithWarehouse := model.AlertWithId("test", id, false, condition, action).
+		WithWarehouse(warehouseId.Name()).
+		WithScheduleMinutes(5)
 
-// Can't reproduce the issue, leaving the test for now.
-func TestAcc_Alert_Issue3117(t *testing.T) {
-	id := testClient().Ids.RandomSchemaObjectIdentifierWithPrefix("small caps with spaces")
 	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
 		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
 			tfversion.RequireAbove(tfversion.Version1_5_0),
 		},
 		CheckDestroy: CheckDestroy(t, resources.Alert),
 		Steps: []resource.TestStep{
+			// create serverless alert on new data
 			{
-				PreConfig:         func() { SetV097CompatibleConfigPathEnv(t) },
-				ExternalProviders: ExternalProviderWithExactVersion("0.92.0"),
-				Config:            alertIssue3117Config(id, testClient().Ids.WarehouseId(), "test_alert"),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
+				Config: accconfig.FromModels(t, modelOnNewData),
+				Check: assertThat(t,
+					resourceassert.AlertResource(t, modelOnNewData.ResourceReference()).
+						HasNameString(id.Name()).
+						HasStartedString("false").
+						HasWarehouseString("").
+						HasNoScheduleSet().
+						HasConditionString(condition),
+					resourceshowoutputassert.AlertShowOutput(t, modelOnNewData.ResourceReference()).
+						HasWarehouse("").
+						HasSchedule("").
+						HasState(sdk.AlertStateSuspended),
 				),
 			},
+			// set warehouse and schedule
 			{
-				PreConfig:                func() { UnsetConfigPathEnv(t) },
-				ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
-				Config:                   alertIssue3117Config(id, testClient().Ids.WarehouseId(), "test_alert"),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
+				Config: accconfig.FromModels(t, modelScheduledWithWarehouse),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelScheduledWithWarehouse.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AlertResource(t, modelScheduledWithWarehouse.ResourceReference()).
+						HasWarehouseString(warehouseId.Name()).
+						HasScheduleMinutes(5),
+					resourceshowoutputassert.AlertShowOutput(t, modelScheduledWithWarehouse.ResourceReference()).
+						HasWarehouse(warehouseId.Name()).
+						HasSchedule("5 MINUTE"),
 				),
 			},
+			// unset warehouse and schedule
+			{
+				Config: accconfig.FromModels(t, modelOnNewData),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelOnNewData.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AlertResource(t, modelOnNewData.ResourceReference()).
+						HasWarehouseString("").
+						HasNoScheduleSet(),
+					resourceshowoutputassert.AlertShowOutput(t, modelOnNewData.ResourceReference()).
+						HasWarehouse("").
+						HasSchedule(""),
+				),
+			},
+			// import
+			{
+				Config:            accconfig.FromModels(t, modelOnNewData),
+				ResourceName:      modelOnNewData.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-// Can't reproduce the issue, leaving the test for now.
-func TestAcc_Alert_Issue3117_PatternMatching(t *testing.T) {
-	suffix := testClient().Ids.Alpha()
-	id1 := testClient().Ids.NewSchemaObjectIdentifier("prefix1" + suffix)
-	id2 := testClient().Ids.NewSchemaObjectIdentifier("prefix_" + suffix)
+func TestAcc_Alert_complete(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	warehouseId := testClient().Ids.WarehouseId()
+	comment := random.Comment()
+	cron := "0 9 * * MON UTC"
+
+	modelComplete := model.AlertWithId("test", id, true, "select 0 as c", "select 0 as c").
+		WithWarehouse(warehouseId.Name()).
+		WithScheduleCron(cron).
+		WithComment(comment)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -246,73 +288,88 @@ func TestAcc_Alert_Issue3117_PatternMatching(t *testing.T) {
 		CheckDestroy: CheckDestroy(t, resources.Alert),
 		Steps: []resource.TestStep{
 			{
-				Config: alertIssue3117Config(id1, testClient().Ids.WarehouseId(), "test_alert_1"),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert_1", "name", id1.Name()),
+				Config: accconfig.FromModels(t, modelComplete),
+				Check: assertThat(t,
+					resourceassert.AlertResource(t, modelComplete.ResourceReference()).
+						HasNameString(id.Name()).
+						HasStartedString("true").
+						HasWarehouseString(warehouseId.Name()).
+						HasScheduleCron(cron).
+						HasCommentString(comment).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.AlertShowOutput(t, modelComplete.ResourceReference()).
+						HasName(id.Name()).
+						HasState(sdk.AlertStateStarted).
+						HasComment(comment),
 				),
 			},
 			{
-				Config: alertIssue3117Config(id1, testClient().Ids.WarehouseId(), "test_alert_1") + alertIssue3117Config(id2, testClient().Ids.WarehouseId(), "test_alert_2"),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert_1", "name", id1.Name()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert_2", "name", id2.Name()),
-				),
+				Config:            accconfig.FromModels(t, modelComplete),
+				ResourceName:      modelComplete.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-// Can't reproduce the issue, leaving the test for now.
-func TestAcc_Alert_Issue3117_IgnoreQuotedIdentifierCase(t *testing.T) {
-	database, databaseCleanup := testClient().Database.CreateDatabase(t)
-	t.Cleanup(databaseCleanup)
-
-	schema, schemaCleanup := testClient().Schema.CreateSchemaInDatabase(t, database.ID())
-	t.Cleanup(schemaCleanup)
+func TestAcc_Alert_migrateFromVersion_2_11_0(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	warehouseId := testClient().Ids.WarehouseId()
 
-	id := testClient().Ids.NewSchemaObjectIdentifierInSchema("small_"+testClient().Ids.Alpha(), schema.ID())
+	alertModel := model.AlertWithId("test", id, false, "select 0 as c", "select 0 as c").
+		WithWarehouse(warehouseId.Name()).
+		WithScheduleMinutes(5)
+	providerModel := providermodel.SnowflakeProvider().WithPreviewFeaturesEnabled(string(previewfeatures.AlertResource))
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
 		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
 			tfversion.RequireAbove(tfversion.Version1_5_0),
 		},
 		CheckDestroy: CheckDestroy(t, resources.Alert),
 		Steps: []resource.TestStep{
 			{
-				PreConfig: func() {
-					testClient().Database.Alter(t, database.ID(), &sdk.AlterDatabaseOptions{
-						Set: &sdk.DatabaseSet{
-							QuotedIdentifiersIgnoreCase: sdk.Bool(true),
-						},
-					})
+				ExternalProviders: ExternalProviderWithExactVersion("2.11.0"),
+				Config:            accconfig.FromModels(t, providerModel) + alertConfigV2_11_0(id, warehouseId),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr("snowflake_alert.test", "id", helpers.EncodeSnowflakeID(id))),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+				Config:                   accconfig.FromModels(t, providerModel, alertModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
 				},
-				Config: alertIssue3117Config(id, testClient().Ids.WarehouseId(), "test_alert"),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
+				Check: assertThat(t,
+					resourceassert.AlertResource(t, alertModel.ResourceReference()).
+						HasNameString(id.Name()).
+						HasStartedString("false").
+						HasScheduleMinutes(5),
+					assert.Check(resource.TestCheckResourceAttr(alertModel.ResourceReference(), "id", id.FullyQualifiedName())),
+					resourceshowoutputassert.AlertShowOutput(t, alertModel.ResourceReference()).
+						HasName(id.Name()),
 				),
 			},
 		},
 	})
 }
 
-func alertIssue3117Config(alertId sdk.SchemaObjectIdentifier, warehouseId sdk.AccountObjectIdentifier, resourceName string) string {
+func alertConfigV2_11_0(id sdk.SchemaObjectIdentifier, warehouseId sdk.AccountObjectIdentifier) string {
 	return fmt.Sprintf(`
-resource "snowflake_alert" "%[5]s" {
+resource "snowflake_alert" "test" {
   database  = "%[1]s"
   schema    = "%[2]s"
   name      = "%[3]s"
   warehouse = "%[4]s"
-
+  condition = "select 0 as c"
+  action    = "select 0 as c"
+  enabled   = false
   alert_schedule {
-    interval = 1 #check every minute for new alerts
+    interval = 5
   }
-
-  action    = "select 0 as c"
-  condition = "select 0 as c"
-
-  enabled   = true
-  comment   = "Alert config for GH issue 3117"
 }
-`, alertId.DatabaseName(), alertId.SchemaName(), alertId.Name(), warehouseId.Name(), resourceName)
+`, id.DatabaseName(), id.SchemaName(), id.Name(), warehouseId.Name())
 }
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"testing"
 
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
 	resourcehelpers "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
@@ -330,3 +332,20 @@ resource "snowflake_api_authentication_integration_with_client_credentials" "tes
 }
 `, name)
 }
+
+func TestAcc_ApiAuthenticationIntegrationWithClientCredentials_Tags(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	integrationModel := model.ApiAuthenticationIntegrationWithClientCredentials("test", id.Name(), true, "foo").
+		WithOauthClientSecret("foo")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ApiAuthenticationIntegrationWithClientCredentials),
+		Steps: tagsTestSteps(t, integrationModel.ResourceReference(), id, sdk.ObjectTypeIntegration, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, integrationModel.WithTags(tags...))
+		}),
+	})
+}
@@ -4,6 +4,8 @@ package testacc
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
 	resourcehelpers "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -11,6 +13,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -304,3 +307,40 @@ resource "snowflake_api_authentication_integration_with_jwt_bearer" "test" {
 }
 `, name)
 }
+
+func TestAcc_ApiAuthenticationIntegrationWithJwtBearer_Tags(t *testing.T) {
+	// TODO [SNOW-1452191]: unskip
+	t.Skip("Skip because of the error: Invalid value specified for property 'OAUTH_CLIENT_SECRET'")
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ApiAuthenticationIntegrationWithJwtBearer),
+		Steps: tagsTestSteps(t, "snowflake_api_authentication_integration_with_jwt_bearer.test", id, sdk.ObjectTypeIntegration, func(tags ...sdk.TagAssociation) string {
+			return apiAuthenticationIntegrationWithJwtBearerConfigWithTags(id.Name(), tags...)
+		}),
+	})
+}
+
+func apiAuthenticationIntegrationWithJwtBearerConfigWithTags(name string, tags ...sdk.TagAssociation) string {
+	tagEntries := make([]string, len(tags))
+	for i, tag := range tags {
+		tagEntries[i] = fmt.Sprintf("    %s = %s", strconv.Quote(tag.Name.FullyQualifiedName()), strconv.Quote(tag.Value))
+	}
+	return fmt.Sprintf(`
+resource "snowflake_api_authentication_integration_with_jwt_bearer" "test" {
+  enabled                = true
+  name                   = "%s"
+  oauth_client_id        = "foo"
+  oauth_client_secret    = "foo"
+  oauth_assertion_issuer = "foo"
+  tags = {
+%s
+  }
+}
+`, name, strings.Join(tagEntries, "\n"))
+}
@@ -0,0 +1,152 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Budget_basic(t *testing.T) {
+	// TODO [SNOW-1007539]: use email of our service user
+	verifiedEmail := "artur.sawicki@snowflake.com"
+
+	warehouse, warehouseCleanup := testClient().Warehouse.CreateWarehouse(t)
+	t.Cleanup(warehouseCleanup)
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+	task, taskCleanup := testClient().Task.Create(t)
+	t.Cleanup(taskCleanup)
+	integration, integrationCleanup := testClient().NotificationIntegration.Create(t)
+	t.Cleanup(integrationCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	basicModel := model.BudgetWithId("test", id, 100)
+	completeModel := model.BudgetWithId("test", id, 200).
+		WithNotificationEmails(verifiedEmail).
+		WithNotificationIntegration(integration.ID().Name()).
+		WithMuteNotifications(r.BooleanTrue).
+		WithWarehouses(warehouse.ID()).
+		WithDatabases(database.ID()).
+		WithTasks(task.ID())
+	updatedModel := model.BudgetWithId("test", id, 200).
+		WithNotificationEmails(verifiedEmail).
+		WithNotificationIntegration(integration.ID().Name()).
+		WithMuteNotifications(r.BooleanFalse).
+		WithDatabases(database.ID())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Budget),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, basicModel),
+				Check: assertThat(t,
+					resourceassert.BudgetResource(t, basicModel.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasSpendingLimitString("100").
+						HasMuteNotificationsString(r.BooleanDefault).
+						HasNotificationEmailsEmpty().
+						HasWarehousesEmpty().
+						HasDatabasesEmpty().
+						HasTasksEmpty().
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.database_name", id.DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.schema_name", id.SchemaName())),
+				),
+			},
+			// import
+			{
+				ResourceName:      basicModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set all the fields and add the monitored objects
+			{
+				Config: accconfig.FromModels(t, completeModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(completeModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BudgetResource(t, completeModel.ResourceReference()).
+						HasSpendingLimitString("200").
+						HasMuteNotificationsString(r.BooleanTrue).
+						HasNotificationIntegrationString(integration.ID().Name()),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "notification_emails.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(completeModel.ResourceReference(), "notification_emails.*", verifiedEmail)),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "warehouses.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(completeModel.ResourceReference(), "warehouses.*", warehouse.ID().FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "databases.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(completeModel.ResourceReference(), "databases.*", database.ID().FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "tasks.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(completeModel.ResourceReference(), "tasks.*", task.ID().FullyQualifiedName())),
+				),
+			},
+			// import - the notification settings are not returned by Snowflake
+			{
+				ResourceName:            completeModel.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"notification_emails", "notification_integration"},
+			},
+			// no changes
+			{
+				Config: accconfig.FromModels(t, completeModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// remove the monitored objects
+			{
+				Config: accconfig.FromModels(t, updatedModel),
+				Check: assertThat(t,
+					resourceassert.BudgetResource(t, updatedModel.ResourceReference()).
+						HasMuteNotificationsString(r.BooleanFalse).
+						HasWarehousesEmpty().
+						HasTasksEmpty(),
+					assert.Check(resource.TestCheckResourceAttr(updatedModel.ResourceReference(), "databases.#", "1")),
+				),
+			},
+			// external change: the spending limit is changed and a warehouse is added to the budget
+			{
+				PreConfig: func() {
+					testClient().Budget.SetSpendingLimit(t, id, 300)
+					testClient().Budget.AddResource(t, id, sdk.BudgetResource{ObjectType: sdk.ObjectTypeWarehouse, Id: warehouse.ID()})
+				},
+				Config: accconfig.FromModels(t, updatedModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(updatedModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BudgetResource(t, updatedModel.ResourceReference()).
+						HasSpendingLimitString("200").
+						HasWarehousesEmpty(),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,153 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_CatalogIntegration_basic(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+
+	modelBasic := model.CatalogIntegration("test", id.Name(), string(sdk.CatalogIntegrationCatalogSourceObjectStore), true, string(sdk.CatalogIntegrationTableFormatIceberg))
+
+	modelComplete := model.CatalogIntegration("test", id.Name(), string(sdk.CatalogIntegrationCatalogSourceObjectStore), true, string(sdk.CatalogIntegrationTableFormatIceberg)).
+		WithRefreshIntervalSeconds(60).
+		WithComment(comment)
+
+	modelCompleteWithDifferentValues := model.CatalogIntegration("test", id.Name(), string(sdk.CatalogIntegrationCatalogSourceObjectStore), true, string(sdk.CatalogIntegrationTableFormatIceberg)).
+		WithRefreshIntervalSeconds(120).
+		WithComment(changedComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.CatalogIntegration),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.CatalogIntegrationResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasCatalogSourceString(string(sdk.CatalogIntegrationCatalogSourceObjectStore)).
+						HasTableFormatString(string(sdk.CatalogIntegrationTableFormatIceberg)).
+						HasEnabledString("true").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.CatalogIntegrationShowOutput(t, modelBasic.ResourceReference()).
+						HasName(id.Name()).
+						HasType("CATALOG").
+						HasCategory("CATALOG").
+						HasEnabled(true).
+						HasComment("").
+						HasCreatedOnNotEmpty(),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.catalog_source.0.value", string(sdk.CatalogIntegrationCatalogSourceObjectStore))),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.table_format.0.value", string(sdk.CatalogIntegrationTableFormatIceberg))),
+				),
+			},
+			// import minimal state
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedCatalogIntegrationResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasCatalogSourceString(string(sdk.CatalogIntegrationCatalogSourceObjectStore)).
+						HasTableFormatString(string(sdk.CatalogIntegrationTableFormatIceberg)).
+						HasEnabledString("true").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.CheckImport(importchecks.TestCheckResourceAttrInstanceState(helpers.EncodeResourceIdentifier(id), "describe_output.0.catalog_source.0.value", string(sdk.CatalogIntegrationCatalogSourceObjectStore))),
+				),
+			},
+			// add optional attributes
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.CatalogIntegrationResource(t, modelComplete.ResourceReference()).
+						HasNameString(id.Name()).
+						HasRefreshIntervalSecondsString("60").
+						HasCommentString(comment),
+					resourceshowoutputassert.CatalogIntegrationShowOutput(t, modelComplete.ResourceReference()).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.refresh_interval_seconds.0.value", "60")),
+				),
+			},
+			// alter
+			{
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.CatalogIntegrationResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasNameString(id.Name()).
+						HasRefreshIntervalSecondsString("120").
+						HasCommentString(changedComment),
+					resourceshowoutputassert.CatalogIntegrationShowOutput(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasComment(changedComment),
+				),
+			},
+			// change externally
+			{
+				PreConfig: func() {
+					testClient().CatalogIntegration.Alter(t, sdk.NewAlterCatalogIntegrationRequest(id).WithSet(
+						*sdk.NewCatalogIntegrationSetRequest().WithComment(comment),
+					))
+				},
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.CatalogIntegrationResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasCommentString(changedComment),
+				),
+			},
+			// unset
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.CatalogIntegrationResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasCommentString(""),
+					resourceshowoutputassert.CatalogIntegrationShowOutput(t, modelBasic.ResourceReference()).
+						HasComment(""),
+				),
+			},
+		},
+	})
+}
@@ -1318,3 +1318,20 @@ func databaseConfigBasicWithExternalVolumeAndCatalog(databaseName string, extern
 		catalog = "%v"
 	}`, databaseName, externalVolumeName, catalogName)
 }
+
+func TestAcc_Database_Tags(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+
+	databaseModel := model.Database("test", id.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Database),
+		Steps: tagsTestSteps(t, databaseModel.ResourceReference(), id, sdk.ObjectTypeDatabase, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, databaseModel.WithTags(tags...))
+		}),
+	})
+}
@@ -0,0 +1,142 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/planchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_DatabaseFromListing_basic(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	newId := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+
+	share, shareCleanup := secondaryTestClient().Share.CreateShare(t)
+	t.Cleanup(shareCleanup)
+
+	sharedDatabase, sharedDatabaseCleanup := secondaryTestClient().Database.CreateDatabase(t)
+	t.Cleanup(sharedDatabaseCleanup)
+
+	t.Cleanup(secondaryTestClient().Grant.GrantPrivilegeOnDatabaseToShare(t, sharedDatabase.ID(), share.ID(), []sdk.ObjectPrivilege{sdk.ObjectPrivilegeUsage}))
+
+	listing, listingCleanup := secondaryTestClient().Listing.CreatePublishedWithShareAndTargetAccounts(t, share.ID(), testClient().Account.GetAccountIdentifier(t))
+	t.Cleanup(listingCleanup)
+
+	modelBasic := model.DatabaseFromListing("test", id.Name(), listing.GlobalName)
+	modelWithComment := model.DatabaseFromListing("test", id.Name(), listing.GlobalName).
+		WithComment(comment)
+	modelRenamed := model.DatabaseFromListing("test", newId.Name(), listing.GlobalName).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DatabaseFromListing),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.DatabaseFromListingResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasListingString(listing.GlobalName).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.DatabaseShowOutput(t, modelBasic.ResourceReference()).
+						HasName(id.Name()).
+						HasKind("IMPORTED DATABASE").
+						HasComment(""),
+				),
+			},
+			// import
+			{
+				Config:                  accconfig.FromModels(t, modelBasic),
+				ResourceName:            modelBasic.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"listing"},
+			},
+			// set comment
+			{
+				Config: accconfig.FromModels(t, modelWithComment),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithComment.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DatabaseFromListingResource(t, modelWithComment.ResourceReference()).
+						HasCommentString(comment),
+					resourceshowoutputassert.DatabaseShowOutput(t, modelWithComment.ResourceReference()).
+						HasComment(comment),
+				),
+			},
+			// rename
+			{
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+						planchecks.ExpectComputed(modelRenamed.ResourceReference(), "fully_qualified_name", true),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DatabaseFromListingResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+				),
+			},
+			// external drop
+			{
+				PreConfig: func() {
+					testClient().Database.DropDatabaseFunc(t, newId)()
+				},
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DatabaseFromListingResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DatabaseFromListing_invalidListing(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+
+	modelWithNonExistingListing := model.DatabaseFromListing("test", id.Name(), "ORGDATACLOUD$INTERNAL$NON_EXISTING")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DatabaseFromListing),
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, modelWithNonExistingListing),
+				ExpectError: regexp.MustCompile(`does not exist`),
+			},
+		},
+	})
+}
@@ -278,3 +278,20 @@ func TestAcc_DatabaseRole_IdentifierQuotingDiffSuppression(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_DatabaseRole_Tags(t *testing.T) {
+	id := testClient().Ids.RandomDatabaseObjectIdentifier()
+
+	databaseRoleModel := model.DatabaseRole("test", id.DatabaseName(), id.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DatabaseRole),
+		Steps: tagsTestSteps(t, databaseRoleModel.ResourceReference(), id, sdk.ObjectTypeDatabaseRole, func(tags ...sdk.TagAssociation) string {
+			return config.FromModels(t, databaseRoleModel.WithTags(tags...))
+		}),
+	})
+}
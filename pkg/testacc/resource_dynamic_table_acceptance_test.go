@@ -7,7 +7,16 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/config"
@@ -72,22 +81,24 @@ func TestAcc_DynamicTable_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "query", fmt.Sprintf("select \"id\" from \"%v\".\"%v\".\"%v\"", TestDatabaseName, TestSchemaName, tableId.Name())),
 					resource.TestCheckResourceAttr(resourceName, "comment", comment),
 
-					// computed attributes
-
-					// - not used at this time
-					//  resource.TestCheckResourceAttrSet(resourceName, "cluster_by"),
-					resource.TestCheckResourceAttrSet(resourceName, "rows"),
-					resource.TestCheckResourceAttrSet(resourceName, "bytes"),
-					resource.TestCheckResourceAttrSet(resourceName, "owner"),
-					// - not used at this time
-					// resource.TestCheckResourceAttrSet(resourceName, "automatic_clustering"),
-					resource.TestCheckResourceAttrSet(resourceName, "scheduling_state"),
-					resource.TestCheckResourceAttrSet(resourceName, "last_suspended_on"),
-					resource.TestCheckResourceAttrSet(resourceName, "is_clone"),
-					resource.TestCheckResourceAttrSet(resourceName, "is_replica"),
-					resource.TestCheckResourceAttrSet(resourceName, "data_timestamp"),
-
-					resource.TestCheckResourceAttrWith(resourceName, "created_on", func(value string) error {
+					resource.TestCheckResourceAttr(resourceName, "started", "true"),
+
+					resource.TestCheckResourceAttr(resourceName, "show_output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "show_output.0.name", dynamicTableId.Name()),
+					resource.TestCheckResourceAttr(resourceName, "show_output.0.target_lag", "2 minutes"),
+					resource.TestCheckResourceAttr(resourceName, "show_output.0.scheduling_state", string(sdk.DynamicTableSchedulingStateActive)),
+					resource.TestCheckResourceAttrSet(resourceName, "show_output.0.rows"),
+					resource.TestCheckResourceAttrSet(resourceName, "show_output.0.bytes"),
+					resource.TestCheckResourceAttrSet(resourceName, "show_output.0.owner"),
+					resource.TestCheckResourceAttrSet(resourceName, "show_output.0.is_clone"),
+					resource.TestCheckResourceAttrSet(resourceName, "show_output.0.is_replica"),
+					resource.TestCheckResourceAttrSet(resourceName, "show_output.0.data_timestamp"),
+
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters.0.data_retention_time_in_days.0.value"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters.0.max_data_extension_time_in_days.0.value"),
+
+					resource.TestCheckResourceAttrWith(resourceName, "show_output.0.created_on", func(value string) error {
 						createdOn = value
 						return nil
 					}),
@@ -108,7 +119,7 @@ func TestAcc_DynamicTable_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "target_lag.0.downstream", "true"),
 					resource.TestCheckResourceAttr(resourceName, "comment", newComment),
 
-					resource.TestCheckResourceAttrWith(resourceName, "created_on", func(value string) error {
+					resource.TestCheckResourceAttrWith(resourceName, "show_output.0.created_on", func(value string) error {
 						if value != createdOn {
 							return fmt.Errorf("created_on changed from %v to %v", createdOn, value)
 						}
@@ -123,7 +134,7 @@ func TestAcc_DynamicTable_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "initialize", string(sdk.DynamicTableInitializeOnSchedule)),
 
-					resource.TestCheckResourceAttrWith(resourceName, "created_on", func(value string) error {
+					resource.TestCheckResourceAttrWith(resourceName, "show_output.0.created_on", func(value string) error {
 						if value == createdOn {
 							return fmt.Errorf("expected created_on to change but was not changed")
 						}
@@ -140,7 +151,7 @@ func TestAcc_DynamicTable_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "initialize", string(sdk.DynamicTableInitializeOnSchedule)),
 					resource.TestCheckResourceAttr(resourceName, "refresh_mode", string(sdk.DynamicTableRefreshModeFull)),
 
-					resource.TestCheckResourceAttrWith(resourceName, "created_on", func(value string) error {
+					resource.TestCheckResourceAttrWith(resourceName, "show_output.0.created_on", func(value string) error {
 						if value == createdOn {
 							return fmt.Errorf("expected created_on to change but was not changed")
 						}
@@ -155,6 +166,8 @@ func TestAcc_DynamicTable_basic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// transient is not returned by Snowflake
+				ImportStateVerifyIgnore: []string{"transient"},
 			},
 		},
 	})
@@ -480,3 +493,376 @@ func TestAcc_DynamicTable_issue3355_timeout(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_DynamicTable_complete(t *testing.T) {
+	table, tableCleanup := testClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(tableCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+	warehouseId := testClient().Ids.WarehouseId()
+	comment := random.Comment()
+
+	query := fmt.Sprintf(`select "id" from %s`, table.ID().FullyQualifiedName())
+	changedQuery := fmt.Sprintf(`select "id", "id" + 1 as "next_id" from %s`, table.ID().FullyQualifiedName())
+
+	modelBasic := model.DynamicTableWithId("test", id, query, warehouseId).
+		WithTargetLag([]sdk.TargetLag{{MaximumDuration: sdk.String("2 minutes")}})
+
+	modelComplete := model.DynamicTableWithId("test", id, query, warehouseId).
+		WithTargetLag([]sdk.TargetLag{{Downstream: sdk.Bool(true)}}).
+		WithStarted(false).
+		WithInitializationWarehouse(warehouseId.Name()).
+		WithClusterBy(`"id"`).
+		WithDataRetentionTimeInDays(1).
+		WithMaxDataExtensionTimeInDays(5).
+		WithImmutableWhere(`"id" < 100`).
+		WithComment(comment)
+
+	modelCompleteWithChangedQuery := model.DynamicTableWithId("test", id, changedQuery, warehouseId).
+		WithTargetLag([]sdk.TargetLag{{Downstream: sdk.Bool(true)}}).
+		WithStarted(false).
+		WithInitializationWarehouse(warehouseId.Name()).
+		WithClusterBy(`"id"`).
+		WithDataRetentionTimeInDays(1).
+		WithMaxDataExtensionTimeInDays(5).
+		WithImmutableWhere(`"id" < 100`).
+		WithComment(comment)
+
+	modelRenamed := model.DynamicTableWithId("test", newId, changedQuery, warehouseId).
+		WithTargetLag([]sdk.TargetLag{{MaximumDuration: sdk.String("2 minutes")}})
+
+	modelWithoutTargetLag := model.DynamicTableWithId("test", newId, changedQuery, warehouseId)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DynamicTable),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasWarehouseString(warehouseId.Name()).
+						HasStartedString("true").
+						HasTransientString("false").
+						HasRefreshModeString(string(sdk.DynamicTableRefreshModeAuto)).
+						HasInitializeString(string(sdk.DynamicTableInitializeOnCreate)).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.DynamicTableShowOutput(t, modelBasic.ResourceReference()).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasTargetLag("2 minutes").
+						HasWarehouse(warehouseId.Name()).
+						HasSchedulingState(sdk.DynamicTableSchedulingStateActive).
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "target_lag.0.maximum_duration", "2 minutes")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "parameters.#", "1")),
+				),
+			},
+			// set all the optional attributes in place and suspend the dynamic table
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, modelComplete.ResourceReference()).
+						HasStartedString("false").
+						HasInitializationWarehouseString(warehouseId.Name()).
+						HasDataRetentionTimeInDaysString("1").
+						HasMaxDataExtensionTimeInDaysString("5").
+						HasImmutableWhereString(`"id" < 100`).
+						HasCommentString(comment),
+					resourceshowoutputassert.DynamicTableShowOutput(t, modelComplete.ResourceReference()).
+						HasTargetLag("DOWNSTREAM").
+						HasClusterBy(`LINEAR("id")`).
+						HasSchedulingState(sdk.DynamicTableSchedulingStateSuspended).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "target_lag.0.downstream", "true")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "parameters.0.data_retention_time_in_days.0.value", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "parameters.0.max_data_extension_time_in_days.0.value", "5")),
+				),
+			},
+			// change the query with CREATE OR ALTER
+			{
+				Config: accconfig.FromModels(t, modelCompleteWithChangedQuery),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithChangedQuery.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, modelCompleteWithChangedQuery.ResourceReference()).
+						HasQueryString(changedQuery).
+						HasStartedString("false").
+						HasDataRetentionTimeInDaysString("1").
+						HasCommentString(comment),
+					resourceshowoutputassert.DynamicTableShowOutput(t, modelCompleteWithChangedQuery.ResourceReference()).
+						HasSchedulingState(sdk.DynamicTableSchedulingStateSuspended).
+						HasComment(comment),
+				),
+			},
+			// import
+			{
+				ResourceName:            modelCompleteWithChangedQuery.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"transient", "initialization_warehouse", "cluster_by", "immutable_where"},
+			},
+			// rename and unset the optional attributes
+			{
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()).
+						HasStartedString("true").
+						HasCommentString(""),
+					resourceshowoutputassert.DynamicTableShowOutput(t, modelRenamed.ResourceReference()).
+						HasName(newId.Name()).
+						HasTargetLag("2 minutes").
+						HasClusterBy("").
+						HasSchedulingState(sdk.DynamicTableSchedulingStateActive).
+						HasComment(""),
+				),
+			},
+			// remove the target lag (it is reset to DOWNSTREAM)
+			{
+				Config: accconfig.FromModels(t, modelWithoutTargetLag),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithoutTargetLag.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, modelWithoutTargetLag.ResourceReference()).
+						HasTargetLagEmpty(),
+					resourceshowoutputassert.DynamicTableShowOutput(t, modelWithoutTargetLag.ResourceReference()).
+						HasTargetLag("DOWNSTREAM"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DynamicTable_TransientWithQueryChange(t *testing.T) {
+	table, tableCleanup := testClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(tableCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	query := fmt.Sprintf(`select "id" from %s`, table.ID().FullyQualifiedName())
+	changedQuery := fmt.Sprintf(`select "id", "id" + 1 as "next_id" from %s`, table.ID().FullyQualifiedName())
+
+	dynamicTableModel := model.DynamicTableWithId("test", id, query, testClient().Ids.WarehouseId()).
+		WithTargetLag([]sdk.TargetLag{{MaximumDuration: sdk.String("2 minutes")}}).
+		WithTransient(true)
+	dynamicTableModelWithChangedQuery := model.DynamicTableWithId("test", id, changedQuery, testClient().Ids.WarehouseId()).
+		WithTargetLag([]sdk.TargetLag{{MaximumDuration: sdk.String("2 minutes")}}).
+		WithTransient(true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DynamicTable),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dynamicTableModel),
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, dynamicTableModel.ResourceReference()).
+						HasTransientString("true").
+						HasQueryString(query),
+				),
+			},
+			// CREATE OR ALTER keeps the table transient
+			{
+				Config: accconfig.FromModels(t, dynamicTableModelWithChangedQuery),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(dynamicTableModelWithChangedQuery.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, dynamicTableModelWithChangedQuery.ResourceReference()).
+						HasTransientString("true").
+						HasQueryString(changedQuery),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DynamicTable_SchedulerDisabled(t *testing.T) {
+	table, tableCleanup := testClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(tableCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	query := fmt.Sprintf(`select "id" from %s`, table.ID().FullyQualifiedName())
+
+	modelWithoutScheduler := model.DynamicTableWithId("test", id, query, testClient().Ids.WarehouseId()).
+		WithScheduler(string(sdk.DynamicTableSchedulerDisable))
+	modelWithScheduler := model.DynamicTableWithId("test", id, query, testClient().Ids.WarehouseId()).
+		WithScheduler(string(sdk.DynamicTableSchedulerEnable)).
+		WithTargetLag([]sdk.TargetLag{{MaximumDuration: sdk.String("2 minutes")}})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DynamicTable),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelWithoutScheduler),
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, modelWithoutScheduler.ResourceReference()).
+						HasSchedulerString(string(sdk.DynamicTableSchedulerDisable)).
+						HasTargetLagEmpty(),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, modelWithScheduler),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithScheduler.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, modelWithScheduler.ResourceReference()).
+						HasSchedulerString(string(sdk.DynamicTableSchedulerEnable)),
+					resourceshowoutputassert.DynamicTableShowOutput(t, modelWithScheduler.ResourceReference()).
+						HasTargetLag("2 minutes"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DynamicTable_Iceberg(t *testing.T) {
+	awsBaseUrl := testenvs.GetOrSkipTest(t, testenvs.AwsExternalBucketUrl)
+	awsRoleArn := testenvs.GetOrSkipTest(t, testenvs.AwsExternalRoleArn)
+
+	externalVolumeId, externalVolumeCleanup := testClient().ExternalVolume.CreateWritableOnS3(t, awsBaseUrl, awsRoleArn)
+	t.Cleanup(externalVolumeCleanup)
+
+	table, tableCleanup := testClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(tableCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	query := fmt.Sprintf(`select "id" from %s`, table.ID().FullyQualifiedName())
+	baseLocation := random.AlphaN(10)
+
+	dynamicTableModel := model.DynamicTableWithId("test", id, query, testClient().Ids.WarehouseId()).
+		WithTargetLag([]sdk.TargetLag{{MaximumDuration: sdk.String("2 minutes")}}).
+		WithIceberg(externalVolumeId.Name(), baseLocation)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DynamicTable),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dynamicTableModel),
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, dynamicTableModel.ResourceReference()).
+						HasNameString(id.Name()),
+					resourceshowoutputassert.DynamicTableShowOutput(t, dynamicTableModel.ResourceReference()).
+						HasName(id.Name()),
+					assert.Check(resource.TestCheckResourceAttr(dynamicTableModel.ResourceReference(), "iceberg.0.external_volume", externalVolumeId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dynamicTableModel.ResourceReference(), "iceberg.0.base_location", baseLocation)),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DynamicTable_Tags(t *testing.T) {
+	table, tableCleanup := testClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(tableCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	query := fmt.Sprintf(`select "id" from %s`, table.ID().FullyQualifiedName())
+
+	dynamicTableModel := model.DynamicTableWithId("test", id, query, testClient().Ids.WarehouseId()).
+		WithTargetLag([]sdk.TargetLag{{MaximumDuration: sdk.String("2 minutes")}})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DynamicTable),
+		Steps: tagsTestSteps(t, dynamicTableModel.ResourceReference(), id, sdk.ObjectTypeDynamicTable, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, dynamicTableModel.WithTags(tags...))
+		}),
+	})
+}
+
+func TestAcc_DynamicTable_migrateFromVersion_2_11_0(t *testing.T) {
+	table, tableCleanup := testClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(tableCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	query := fmt.Sprintf(`select "id" from %s`, table.ID().FullyQualifiedName())
+
+	dynamicTableModel := model.DynamicTableWithId("test", id, query, testClient().Ids.WarehouseId()).
+		WithTargetLag([]sdk.TargetLag{{MaximumDuration: sdk.String("2 minutes")}})
+	providerModel := providermodel.SnowflakeProvider().WithPreviewFeaturesEnabled(string(previewfeatures.DynamicTableResource))
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DynamicTable),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: ExternalProviderWithExactVersion("2.11.0"),
+				Config:            accconfig.FromModels(t, providerModel, dynamicTableModel),
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, dynamicTableModel.ResourceReference()).
+						HasNameString(id.Name()),
+					assert.Check(resource.TestCheckResourceAttr(dynamicTableModel.ResourceReference(), "id", helpers.EncodeSnowflakeID(id))),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+				Config:                   accconfig.FromModels(t, providerModel, dynamicTableModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DynamicTableResource(t, dynamicTableModel.ResourceReference()).
+						HasNameString(id.Name()).
+						HasStartedString("true"),
+					assert.Check(resource.TestCheckResourceAttr(dynamicTableModel.ResourceReference(), "id", id.FullyQualifiedName())),
+					resourceshowoutputassert.DynamicTableShowOutput(t, dynamicTableModel.ResourceReference()).
+						HasName(id.Name()),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExecutionRole_schema(t *testing.T) {
+	role, roleCleanup := testClient().Role.CreateRoleGrantedToCurrentUser(t)
+	t.Cleanup(roleCleanup)
+	testClient().Grant.GrantPrivilegesOnDatabaseToAccountRole(t, role.ID(), testClient().Ids.DatabaseId(), []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeCreateSchema}, false)
+
+	id := testClient().Ids.RandomDatabaseObjectIdentifier()
+	resourceReference := "snowflake_schema.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Schema),
+		Steps: []resource.TestStep{
+			{
+				Config: schemaWithExecutionRoleConfig(id, role.ID(), "comment"),
+				Check: assertThat(t,
+					resourceshowoutputassert.SchemaShowOutput(t, resourceReference).
+						HasName(id.Name()).
+						HasOwner(role.ID().Name()).
+						HasComment("comment"),
+					assert.Check(resource.TestCheckResourceAttr(resourceReference, "execution_role", role.ID().Name())),
+				),
+			},
+			// the object owned by the execution role can be altered
+			{
+				Config: schemaWithExecutionRoleConfig(id, role.ID(), "changed comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceshowoutputassert.SchemaShowOutput(t, resourceReference).
+						HasOwner(role.ID().Name()).
+						HasComment("changed comment"),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"execution_role"},
+			},
+		},
+	})
+}
+
+func schemaWithExecutionRoleConfig(id sdk.DatabaseObjectIdentifier, executionRole sdk.AccountObjectIdentifier, comment string) string {
+	s := `
+resource "snowflake_schema" "test" {
+	database       = "%s"
+	name           = "%s"
+	comment        = "%s"
+	execution_role = "%s"
+}
+`
+	return fmt.Sprintf(s, id.DatabaseName(), id.Name(), comment, executionRole.Name())
+}
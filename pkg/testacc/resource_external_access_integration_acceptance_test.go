@@ -0,0 +1,234 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalAccessIntegration_basic(t *testing.T) {
+	networkRule, networkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	secondNetworkRule, secondNetworkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(secondNetworkRuleCleanup)
+
+	apiAuthenticationIntegration, apiAuthenticationIntegrationCleanup := testClient().SecurityIntegration.CreateApiAuthenticationWithClientCredentialsFlow(t)
+	t.Cleanup(apiAuthenticationIntegrationCleanup)
+
+	secretId, secretCleanup := testClient().Secret.CreateRandomPasswordSecret(t)
+	t.Cleanup(secretCleanup)
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+
+	modelBasic := model.ExternalAccessIntegrationWithNetworkRules("test", id.Name(), true, networkRule.ID())
+
+	modelComplete := model.ExternalAccessIntegrationWithNetworkRules("test", id.Name(), false, networkRule.ID(), secondNetworkRule.ID()).
+		WithAllowedApiAuthenticationIntegrations(apiAuthenticationIntegration.ID()).
+		WithAllowedAuthenticationSecrets(secretId).
+		WithComment(comment)
+
+	modelCompleteWithDifferentValues := model.ExternalAccessIntegrationWithNetworkRules("test", id.Name(), true, secondNetworkRule.ID()).
+		WithAllowedApiAuthenticationIntegrations(apiAuthenticationIntegration.ID()).
+		WithAllowedAuthenticationSecrets(secretId).
+		WithComment(changedComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ExternalAccessIntegration),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasAllowedNetworkRules(networkRule.ID()).
+						HasAllowedApiAuthenticationIntegrations().
+						HasAllowedAuthenticationSecrets().
+						HasEnabledString("true").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.ExternalAccessIntegrationShowOutput(t, modelBasic.ResourceReference()).
+						HasName(id.Name()).
+						HasType("EXTERNAL_ACCESS").
+						HasCategory("SECURITY").
+						HasEnabled(true).
+						HasComment("").
+						HasCreatedOnNotEmpty(),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.enabled.0.value", "true")),
+					assert.Check(resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.allowed_network_rules.0.value")),
+				),
+			},
+			// import minimal state
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedExternalAccessIntegrationResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasEnabledString("true").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.CheckImport(importchecks.TestCheckResourceAttrInstanceState(helpers.EncodeResourceIdentifier(id), "allowed_network_rules.#", "1")),
+					assert.CheckImport(importchecks.TestCheckResourceAttrInstanceState(helpers.EncodeResourceIdentifier(id), "allowed_network_rules.0", networkRule.ID().FullyQualifiedName())),
+				),
+			},
+			// add optional attributes
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelComplete.ResourceReference()).
+						HasNameString(id.Name()).
+						HasAllowedNetworkRules(networkRule.ID(), secondNetworkRule.ID()).
+						HasAllowedApiAuthenticationIntegrations(apiAuthenticationIntegration.ID()).
+						HasAllowedAuthenticationSecrets(secretId).
+						HasEnabledString("false").
+						HasCommentString(comment),
+					resourceshowoutputassert.ExternalAccessIntegrationShowOutput(t, modelComplete.ResourceReference()).
+						HasEnabled(false).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.enabled.0.value", "false")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.comment.0.value", comment)),
+				),
+			},
+			// alter
+			{
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasNameString(id.Name()).
+						HasAllowedNetworkRules(secondNetworkRule.ID()).
+						HasEnabledString("true").
+						HasCommentString(changedComment),
+					resourceshowoutputassert.ExternalAccessIntegrationShowOutput(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasEnabled(true).
+						HasComment(changedComment),
+				),
+			},
+			// change externally
+			{
+				PreConfig: func() {
+					testClient().ExternalAccessIntegration.Alter(t, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(
+						*sdk.NewExternalAccessIntegrationSetRequest().
+							WithAllowedNetworkRules([]sdk.SchemaObjectIdentifier{networkRule.ID()}).
+							WithComment(comment),
+					))
+					testClient().ExternalAccessIntegration.Alter(t, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(
+						*sdk.NewExternalAccessIntegrationUnsetRequest().WithAllowedAuthenticationSecrets(true),
+					))
+				},
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasAllowedNetworkRules(secondNetworkRule.ID()).
+						HasAllowedAuthenticationSecrets(secretId).
+						HasCommentString(changedComment),
+				),
+			},
+			// unset
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasAllowedNetworkRules(networkRule.ID()).
+						HasAllowedApiAuthenticationIntegrations().
+						HasAllowedAuthenticationSecrets().
+						HasEnabledString("true").
+						HasCommentString(""),
+					resourceshowoutputassert.ExternalAccessIntegrationShowOutput(t, modelBasic.ResourceReference()).
+						HasEnabled(true).
+						HasComment(""),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ExternalAccessIntegration_complete(t *testing.T) {
+	networkRule, networkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	secretId, secretCleanup := testClient().Secret.CreateRandomPasswordSecret(t)
+	t.Cleanup(secretCleanup)
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+
+	modelComplete := model.ExternalAccessIntegrationWithNetworkRules("test", id.Name(), true, networkRule.ID()).
+		WithAllowedAuthenticationSecrets(secretId).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ExternalAccessIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelComplete.ResourceReference()).
+						HasNameString(id.Name()).
+						HasAllowedNetworkRules(networkRule.ID()).
+						HasAllowedApiAuthenticationIntegrations().
+						HasAllowedAuthenticationSecrets(secretId).
+						HasEnabledString("true").
+						HasCommentString(comment).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.ExternalAccessIntegrationShowOutput(t, modelComplete.ResourceReference()).
+						HasName(id.Name()).
+						HasEnabled(true).
+						HasComment(comment),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, modelComplete),
+				ResourceName:      modelComplete.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -751,3 +751,21 @@ resource "snowflake_external_oauth_integration" "test" {
 }
 `, name, issuer)
 }
+
+func TestAcc_ExternalOauthIntegration_Tags(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	issuer := random.String()
+	integrationModel := model.ExternalOauthSecurityIntegration("test", id.Name(), true, issuer, string(sdk.ExternalOauthSecurityIntegrationSnowflakeUserMappingAttributeEmailAddress), []string{"foo"}, string(sdk.ExternalOauthSecurityIntegrationTypeCustom)).
+		WithExternalOauthJwsKeysUrlValue(config.SetVariable(config.StringVariable("https://example.com")))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ExternalOauthSecurityIntegration),
+		Steps: tagsTestSteps(t, integrationModel.ResourceReference(), id, sdk.ObjectTypeIntegration, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, integrationModel.WithTags(tags...))
+		}),
+	})
+}
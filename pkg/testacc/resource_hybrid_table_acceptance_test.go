@@ -0,0 +1,242 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_HybridTable_basic(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+
+	columns := []sdk.HybridTableColumnRequest{
+		*sdk.NewHybridTableColumnRequest("ID", sdk.DataTypeNumber),
+		*sdk.NewHybridTableColumnRequest("NAME", "VARCHAR(100)"),
+		*sdk.NewHybridTableColumnRequest("EMAIL", "VARCHAR(200)"),
+	}
+
+	modelBasic := model.HybridTableWithId("test", id, columns, []string{"ID"})
+
+	modelComplete := model.HybridTableWithId("test", id, columns, []string{"ID"}).
+		WithIndex([]sdk.HybridTableIndexDefinitionRequest{
+			*sdk.NewHybridTableIndexDefinitionRequest("IDX_NAME", []string{"NAME"}),
+		}).
+		WithDataRetentionTimeInDays(1).
+		WithComment(comment)
+
+	modelCompleteWithDifferentValues := model.HybridTableWithId("test", id, columns, []string{"ID"}).
+		WithIndex([]sdk.HybridTableIndexDefinitionRequest{
+			*sdk.NewHybridTableIndexDefinitionRequest("IDX_NAME", []string{"NAME"}).WithIncludeColumns([]string{"EMAIL"}),
+			*sdk.NewHybridTableIndexDefinitionRequest("IDX_EMAIL", []string{"EMAIL"}),
+		}).
+		WithDataRetentionTimeInDays(2).
+		WithComment(changedComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.HybridTable),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.HybridTableResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasDataRetentionTimeInDaysString("-1").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.HybridTableShowOutput(t, modelBasic.ResourceReference()).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasComment("").
+						HasCreatedOnNotEmpty(),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "column.#", "3")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "column.0.name", "ID")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "column.1.type", "VARCHAR(100)")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "primary_key.0.columns.0", "ID")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "index.#", "0")),
+				),
+			},
+			// import minimal state
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedHybridTableResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.CheckImport(importchecks.TestCheckResourceAttrInstanceState(helpers.EncodeResourceIdentifier(id), "column.#", "3")),
+				),
+			},
+			// add optional attributes and an index
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.HybridTableResource(t, modelComplete.ResourceReference()).
+						HasDataRetentionTimeInDaysString("1").
+						HasCommentString(comment),
+					resourceshowoutputassert.HybridTableShowOutput(t, modelComplete.ResourceReference()).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "index.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "index.0.name", "IDX_NAME")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "index.0.columns.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "index.0.columns.0", "NAME")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "index.0.include_columns.#", "0")),
+				),
+			},
+			// alter indexes in place
+			{
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.HybridTableResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasDataRetentionTimeInDaysString("2").
+						HasCommentString(changedComment),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "index.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "index.0.name", "IDX_NAME")),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "index.0.include_columns.0", "EMAIL")),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "index.1.name", "IDX_EMAIL")),
+				),
+			},
+			// change externally
+			{
+				PreConfig: func() {
+					testClient().HybridTable.Alter(t, sdk.NewAlterHybridTableRequest(id).WithSet(
+						*sdk.NewHybridTableSetRequest().WithComment(comment),
+					))
+				},
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.HybridTableResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasCommentString(changedComment),
+				),
+			},
+			// unset
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.HybridTableResource(t, modelBasic.ResourceReference()).
+						HasDataRetentionTimeInDaysString("-1").
+						HasCommentString(""),
+					resourceshowoutputassert.HybridTableShowOutput(t, modelBasic.ResourceReference()).
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "index.#", "0")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_HybridTable_complete(t *testing.T) {
+	parentId, parentCleanup := testClient().HybridTable.Create(t)
+	t.Cleanup(parentCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	columns := []sdk.HybridTableColumnRequest{
+		*sdk.NewHybridTableColumnRequest("id", sdk.DataTypeNumber).WithAutoincrement(true),
+		*sdk.NewHybridTableColumnRequest("name", "VARCHAR(100)").WithNotNull(true).WithDefaultExpression("'unknown'").WithComment("name column"),
+		*sdk.NewHybridTableColumnRequest("email", "VARCHAR(200)").WithCollate("en-ci"),
+		*sdk.NewHybridTableColumnRequest("parent_id", sdk.DataTypeNumber),
+	}
+
+	modelComplete := model.HybridTable("test", id.DatabaseName(), id.SchemaName(), id.Name(), columns, []sdk.HybridTableOutOfLineConstraintRequest{
+		*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.HybridTableConstraintTypePrimaryKey, []string{"id"}).WithName("pk"),
+	}).
+		WithUniqueKey([]sdk.HybridTableOutOfLineConstraintRequest{
+			*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.HybridTableConstraintTypeUnique, []string{"email"}),
+		}).
+		WithForeignKey([]sdk.HybridTableOutOfLineConstraintRequest{
+			*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.HybridTableConstraintTypeForeignKey, []string{"parent_id"}).
+				WithReferences(*sdk.NewHybridTableForeignKeyReferenceRequest(parentId, []string{"ID"})),
+		}).
+		WithIndex([]sdk.HybridTableIndexDefinitionRequest{
+			*sdk.NewHybridTableIndexDefinitionRequest("idx_name", []string{"name"}).WithIncludeColumns([]string{"email"}),
+		}).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.HybridTable),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				Check: assertThat(t,
+					resourceassert.HybridTableResource(t, modelComplete.ResourceReference()).
+						HasNameString(id.Name()).
+						HasCommentString(comment).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.HybridTableShowOutput(t, modelComplete.ResourceReference()).
+						HasName(id.Name()).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.#", "4")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.0.name", "id")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.0.autoincrement", "true")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.0.not_null", "false")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.1.not_null", "true")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.1.default", "'unknown'")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.1.comment", "name column")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.2.collate", "en-ci")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "index.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "index.0.name", "idx_name")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "index.0.columns.0", "name")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "index.0.include_columns.0", "email")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
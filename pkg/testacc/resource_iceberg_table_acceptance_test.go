@@ -0,0 +1,131 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_IcebergTable_basic(t *testing.T) {
+	awsBaseUrl := testenvs.GetOrSkipTest(t, testenvs.AwsExternalBucketUrl)
+	awsRoleArn := testenvs.GetOrSkipTest(t, testenvs.AwsExternalRoleArn)
+
+	externalVolumeId, externalVolumeCleanup := testClient().ExternalVolume.CreateWritableOnS3(t, awsBaseUrl, awsRoleArn)
+	t.Cleanup(externalVolumeCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+	baseLocation := random.AlphaN(10)
+
+	columns := []sdk.IcebergTableColumnRequest{
+		*sdk.NewIcebergTableColumnRequest("ID", sdk.DataTypeNumber).WithNotNull(true),
+	}
+	changedColumns := []sdk.IcebergTableColumnRequest{
+		*sdk.NewIcebergTableColumnRequest("ID", sdk.DataTypeNumber).WithNotNull(true).WithComment(comment),
+		*sdk.NewIcebergTableColumnRequest("NAME", sdk.DataTypeVARCHAR),
+	}
+
+	modelBasic := model.IcebergTableWithId("test", id, columns).
+		WithExternalVolume(externalVolumeId.Name()).
+		WithBaseLocation(baseLocation)
+
+	modelComplete := model.IcebergTableWithId("test", id, changedColumns).
+		WithExternalVolume(externalVolumeId.Name()).
+		WithBaseLocation(baseLocation).
+		WithDataRetentionTimeInDays(1).
+		WithChangeTracking("true").
+		WithComment(changedComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.IcebergTable),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.IcebergTableResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasExternalVolumeString(externalVolumeId.Name()).
+						HasBaseLocationString(baseLocation).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.IcebergTableShowOutput(t, modelBasic.ResourceReference()).
+						HasCreatedOnNotEmpty().
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasExternalVolumeName(externalVolumeId.Name()).
+						HasCatalogName("SNOWFLAKE").
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "column.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "column.0.name", "ID")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "column.0.not_null", "true")),
+				),
+			},
+			// import
+			{
+				Config:                  accconfig.FromModels(t, modelBasic),
+				ResourceName:            modelBasic.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"external_volume", "base_location", "data_retention_time_in_days", "max_data_extension_time_in_days", "change_tracking", "column.0.type"},
+			},
+			// add columns and optional attributes
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.IcebergTableResource(t, modelComplete.ResourceReference()).
+						HasNameString(id.Name()).
+						HasDataRetentionTimeInDaysString("1").
+						HasChangeTrackingString("true").
+						HasCommentString(changedComment),
+					resourceshowoutputassert.IcebergTableShowOutput(t, modelComplete.ResourceReference()).
+						HasComment(changedComment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.0.comment", comment)),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.1.name", "NAME")),
+				),
+			},
+			// remove columns and optional attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.IcebergTableResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasCommentString(""),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "column.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "column.0.comment", "")),
+				),
+			},
+		},
+	})
+}
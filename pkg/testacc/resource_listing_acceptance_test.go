@@ -842,6 +842,86 @@ func TestAcc_Listing_NewVersions_FromStage(t *testing.T) {
 	})
 }
 
+func TestAcc_Listing_StructuredManifest(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	title := testClient().Ids.WithTestObjectSuffix("title")
+	newTitle := testClient().Ids.WithTestObjectSuffix("new_title")
+	targetAccount := secondaryTestClient().Account.GetAccountIdentifier(t)
+
+	share, shareCleanup := testClient().Share.CreateShare(t)
+	t.Cleanup(shareCleanup)
+
+	t.Cleanup(testClient().Grant.GrantPrivilegeOnDatabaseToShare(t, testClient().Ids.DatabaseId(), share.ID(), []sdk.ObjectPrivilege{sdk.ObjectPrivilegeUsage}))
+
+	structuredManifest := func(title string) map[string]tfconfig.Variable {
+		return map[string]tfconfig.Variable{
+			"title":       tfconfig.StringVariable(title),
+			"subtitle":    tfconfig.StringVariable("subtitle"),
+			"description": tfconfig.StringVariable("description"),
+			"listing_terms": tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+				"type": tfconfig.StringVariable("OFFLINE"),
+			})),
+			"targets": tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+				"accounts": tfconfig.SetVariable(tfconfig.StringVariable(fmt.Sprintf("%s.%s", targetAccount.OrganizationName(), targetAccount.AccountName()))),
+			})),
+			"usage_examples": tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+				"title": tfconfig.StringVariable("example"),
+				"query": tfconfig.StringVariable("SELECT 1"),
+			})),
+		}
+	}
+
+	modelBasic := model.ListingWithStructuredManifest("test", id.Name(), structuredManifest(title)).
+		WithShare(share.ID().Name()).
+		WithPublish(r.BooleanFalse)
+	modelWithNewTitle := model.ListingWithStructuredManifest("test", id.Name(), structuredManifest(newTitle)).
+		WithShare(share.ID().Name()).
+		WithPublish(r.BooleanFalse)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Listing),
+		Steps: []resource.TestStep{
+			// create with structured manifest
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.ListingResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasManifestStructuredTitle(title).
+						HasShareString(share.ID().FullyQualifiedName()).
+						HasPublishString(r.BooleanFalse).
+						HasVersionsLength(0),
+					resourceshowoutputassert.ListingShowOutput(t, modelBasic.ResourceReference()).
+						HasName(id.Name()).
+						HasTitle(title).
+						HasSubtitle("subtitle").
+						HasState(sdk.ListingStateDraft),
+				),
+			},
+			// change structured manifest (expect in-place update)
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithNewTitle.ResourceReference(), plancheck.ResourceActionUpdate),
+						planchecks.ExpectComputed(modelWithNewTitle.ResourceReference(), "versions", true),
+					},
+				},
+				Config: accconfig.FromModels(t, modelWithNewTitle),
+				Check: assertThat(t,
+					resourceassert.ListingResource(t, modelWithNewTitle.ResourceReference()).
+						HasManifestStructuredTitle(newTitle),
+					resourceshowoutputassert.ListingShowOutput(t, modelWithNewTitle.ResourceReference()).
+						HasTitle(newTitle),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_Listing_Validations(t *testing.T) {
 	id := testClient().Ids.RandomAccountObjectIdentifier()
 	manifest, _ := testClient().Listing.BasicManifestWithUnquotedValues(t)
@@ -869,6 +949,28 @@ func TestAcc_Listing_Validations(t *testing.T) {
 
 	modelWithInvalidName := model.ListingWithInlineManifest("test", "_invalid_name", manifest)
 
+	modelWithCustomTermsWithoutLink := model.ListingWithStructuredManifest("test", id.Name(), map[string]tfconfig.Variable{
+		"title": tfconfig.StringVariable("title"),
+		"listing_terms": tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"type": tfconfig.StringVariable("CUSTOM"),
+		})),
+	})
+
+	modelWithEmptyTargets := model.ListingWithStructuredManifest("test", id.Name(), map[string]tfconfig.Variable{
+		"title":   tfconfig.StringVariable("title"),
+		"targets": tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{})),
+	})
+
+	modelWithInlinedAndStructuredManifest := listingModelWithoutManifest("test", id.Name()).
+		WithManifestValue(tfconfig.ListVariable(
+			tfconfig.MapVariable(map[string]tfconfig.Variable{
+				"from_string": tfconfig.StringVariable(manifest),
+				"structured": tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+					"title": tfconfig.StringVariable("title"),
+				})),
+			}),
+		))
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
 		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
@@ -896,6 +998,21 @@ func TestAcc_Listing_Validations(t *testing.T) {
 				PlanOnly:    true,
 				ExpectError: regexp.MustCompile(`Listing name must start with an alphabetic character and cannot contain spaces or special characters except for underscores`),
 			},
+			{
+				Config:      accconfig.FromModels(t, modelWithCustomTermsWithoutLink),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`listing_terms.link is required when listing_terms.type is CUSTOM`),
+			},
+			{
+				Config:      accconfig.FromModels(t, modelWithEmptyTargets),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`at least one of targets.accounts or targets.regions has to be set`),
+			},
+			{
+				Config:      accconfig.FromModels(t, modelWithInlinedAndStructuredManifest),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`only one of .*manifest.0.from_stage,manifest.0.from_string,manifest.0.structured.* can be specified`),
+			},
 		},
 	})
 }
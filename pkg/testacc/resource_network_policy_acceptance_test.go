@@ -464,3 +464,19 @@ func networkPolicyConfigBasicWithQuotedName(networkPolicyId sdk.AccountObjectIde
 		name = "%v"
 	}`, quotedId)
 }
+
+func TestAcc_NetworkPolicy_Tags(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	networkPolicyModel := model.NetworkPolicy("test", id.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.NetworkPolicy),
+		Steps: tagsTestSteps(t, networkPolicyModel.ResourceReference(), id, sdk.ObjectTypeNetworkPolicy, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, networkPolicyModel.WithTags(tags...))
+		}),
+	})
+}
@@ -615,3 +615,19 @@ func TestAcc_OauthIntegrationForCustomClients_WithQuotedName(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_OauthIntegrationForCustomClients_Tags(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	integrationModel := model.OauthIntegrationForCustomClients("test", id.Name(), string(sdk.OauthSecurityIntegrationClientTypeConfidential), "https://example.com/callback")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resourcenames.OauthIntegrationForCustomClients),
+		Steps: tagsTestSteps(t, integrationModel.ResourceReference(), id, sdk.ObjectTypeIntegration, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, integrationModel.WithTags(tags...))
+		}),
+	})
+}
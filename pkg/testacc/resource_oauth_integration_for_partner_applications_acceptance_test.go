@@ -882,3 +882,20 @@ func TestAcc_OauthIntegrationForPartnerApplications_WithPrivilegedRolesBlockedLi
 		},
 	})
 }
+
+func TestAcc_OauthIntegrationForPartnerApplications_Tags(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	integrationModel := model.OauthIntegrationForPartnerApplications("test", id.Name(), string(sdk.OauthSecurityIntegrationClientLooker)).
+		WithOauthRedirectUri("https://example.com/callback")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.OauthIntegrationForPartnerApplications),
+		Steps: tagsTestSteps(t, integrationModel.ResourceReference(), id, sdk.ObjectTypeIntegration, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, integrationModel.WithTags(tags...))
+		}),
+	})
+}
@@ -0,0 +1,177 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/planchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_OrganizationListing_basic(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	newId := testClient().Ids.RandomAccountObjectIdentifier()
+	title := random.AlphaN(20)
+	newTitle := random.AlphaN(20)
+	comment := random.Comment()
+	accountName := testClient().Account.GetAccountIdentifier(t).AccountName()
+
+	share, shareCleanup := testClient().Share.CreateShare(t)
+	t.Cleanup(shareCleanup)
+	t.Cleanup(testClient().Grant.GrantPrivilegeOnDatabaseToShare(t, testClient().Ids.DatabaseId(), share.ID(), []sdk.ObjectPrivilege{sdk.ObjectPrivilegeUsage}))
+
+	modelBasic := model.OrganizationListingWithShare("test", id.Name(), title, share.ID()).
+		WithDiscoveryTargetsAllInternalAccounts().
+		WithAccessTargetsAllInternalAccounts()
+	modelComplete := model.OrganizationListingWithShare("test", id.Name(), newTitle, share.ID()).
+		WithDescription("description").
+		WithDiscoveryTargetsAllInternalAccounts().
+		WithAccessTargetsAccount(accountName, "ACCOUNTADMIN").
+		WithAccessRegions("ALL").
+		WithPublish(r.BooleanTrue).
+		WithComment(comment)
+	modelRenamed := model.OrganizationListingWithShare("test", newId.Name(), newTitle, share.ID()).
+		WithDescription("description").
+		WithDiscoveryTargetsAllInternalAccounts().
+		WithAccessTargetsAccount(accountName, "ACCOUNTADMIN").
+		WithAccessRegions("ALL").
+		WithPublish(r.BooleanFalse)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.OrganizationListing),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.OrganizationListingResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasShareString(share.ID().Name()).
+						HasTitleString(title).
+						HasPublishString(r.BooleanDefault).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.ListingShowOutput(t, modelBasic.ResourceReference()).
+						HasName(id.Name()).
+						HasTitle(title).
+						HasDistribution("INTERNAL").
+						HasComment(""),
+				),
+			},
+			// import
+			{
+				Config:                  accconfig.FromModels(t, modelBasic),
+				ResourceName:            modelBasic.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"discovery_targets", "access_targets", "publish"},
+			},
+			// update manifest, publish and set comment
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.OrganizationListingResource(t, modelComplete.ResourceReference()).
+						HasTitleString(newTitle).
+						HasDescriptionString("description").
+						HasPublishString(r.BooleanTrue).
+						HasCommentString(comment),
+					resourceshowoutputassert.ListingShowOutput(t, modelComplete.ResourceReference()).
+						HasTitle(newTitle).
+						HasState(sdk.ListingStatePublished).
+						HasComment(comment),
+				),
+			},
+			// rename, unpublish and unset comment
+			{
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+						planchecks.ExpectComputed(modelRenamed.ResourceReference(), "fully_qualified_name", true),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.OrganizationListingResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasPublishString(r.BooleanFalse).
+						HasCommentString("").
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+					resourceshowoutputassert.ListingShowOutput(t, modelRenamed.ResourceReference()).
+						HasName(newId.Name()).
+						HasState(sdk.ListingStateUnpublished).
+						HasComment(""),
+				),
+			},
+			// external drop
+			{
+				PreConfig: func() {
+					testClient().Listing.DropFunc(t, newId)()
+				},
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.OrganizationListingResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_OrganizationListing_Validations(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	shareId := testClient().Ids.RandomAccountObjectIdentifier()
+
+	modelWithoutShare := model.OrganizationListing("test", id.Name(), "title")
+	modelWithInvalidTarget := model.OrganizationListingWithShare("test", id.Name(), "title", shareId).
+		WithAccessTargetsValue(tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"all_internal_accounts": tfconfig.BoolVariable(true),
+			"account":               tfconfig.StringVariable("ACCOUNT"),
+		})))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.OrganizationListing),
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, modelWithoutShare),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`one of\s+` + "`" + `application_package,share` + "`" + `\s+must be specified`),
+			},
+			{
+				Config:      accconfig.FromModels(t, modelWithInvalidTarget),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`access_targets: all_internal_accounts cannot be combined with account and roles`),
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_PackagesPolicy_basic(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	basicModel := model.PackagesPolicyWithId("test", id)
+	completeModel := model.PackagesPolicyWithId("test", id).
+		WithAllowlist("numpy", "pandas").
+		WithBlocklist("scikit-learn").
+		WithAdditionalCreationBlocklist("requests").
+		WithComment("some comment")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.PackagesPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, basicModel),
+				Check: assertThat(t,
+					resourceassert.PackagesPolicyResource(t, basicModel.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasAllowlistEmpty().
+						HasBlocklistEmpty().
+						HasAdditionalCreationBlocklistEmpty().
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.kind", "PACKAGES_POLICY")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.0.language", "PYTHON")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.0.allowlist.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.0.allowlist.0", "*")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.0.blocklist.#", "0")),
+				),
+			},
+			// import
+			{
+				ResourceName:      basicModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set all the lists and the comment
+			{
+				Config: accconfig.FromModels(t, completeModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(completeModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PackagesPolicyResource(t, completeModel.ResourceReference()).
+						HasCommentString("some comment"),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "allowlist.#", "2")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(completeModel.ResourceReference(), "allowlist.*", "numpy")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(completeModel.ResourceReference(), "allowlist.*", "pandas")),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "blocklist.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(completeModel.ResourceReference(), "blocklist.*", "scikit-learn")),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "additional_creation_blocklist.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(completeModel.ResourceReference(), "additional_creation_blocklist.*", "requests")),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "show_output.0.comment", "some comment")),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "describe_output.0.allowlist.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "describe_output.0.comment", "some comment")),
+				),
+			},
+			// import - complete
+			{
+				ResourceName:      completeModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// external change
+			{
+				PreConfig: func() {
+					testClient().PackagesPolicy.Alter(t, *sdk.NewAlterPackagesPolicyRequest(id).WithSet(*sdk.NewPackagesPolicySetRequest().
+						WithBlocklist([]sdk.StringListItemWrapper{{Value: "scipy"}}).
+						WithComment("external comment"),
+					))
+				},
+				Config: accconfig.FromModels(t, completeModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(completeModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PackagesPolicyResource(t, completeModel.ResourceReference()).
+						HasCommentString("some comment"),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "blocklist.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(completeModel.ResourceReference(), "blocklist.*", "scikit-learn")),
+				),
+			},
+			// unset all the lists and the comment
+			{
+				Config: accconfig.FromModels(t, basicModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(basicModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PackagesPolicyResource(t, basicModel.ResourceReference()).
+						HasAllowlistEmpty().
+						HasBlocklistEmpty().
+						HasAdditionalCreationBlocklistEmpty().
+						HasCommentString(""),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.0.allowlist.0", "*")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_PackagesPolicy_explicitAllowAll(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	allowAllModel := model.PackagesPolicyWithId("test", id).
+		WithAllowlist("*")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.PackagesPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, allowAllModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(allowAllModel.ResourceReference(), "allowlist.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(allowAllModel.ResourceReference(), "allowlist.*", "*")),
+				),
+			},
+			// no changes are planned
+			{
+				Config: accconfig.FromModels(t, allowAllModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -6,41 +6,58 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/planchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
-	"github.com/hashicorp/terraform-plugin-testing/config"
+	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
-func TestAcc_PasswordPolicy(t *testing.T) {
+func TestAcc_PasswordPolicy_basic(t *testing.T) {
 	id := testClient().Ids.RandomSchemaObjectIdentifier()
-	comment := random.Comment()
-
-	m := func(minLength int, maxLength int, minUpperCaseChars int, minLowerCaseChars int, minNumericChars int, minSpecialChars int, minAgeDays int, maxAgeDays int, maxRetries int, lockoutTimeMins int, history int, comment string) map[string]config.Variable {
-		return map[string]config.Variable{
-			"name":                 config.StringVariable(id.Name()),
-			"database":             config.StringVariable(id.DatabaseName()),
-			"schema":               config.StringVariable(id.SchemaName()),
-			"min_length":           config.IntegerVariable(minLength),
-			"max_length":           config.IntegerVariable(maxLength),
-			"min_upper_case_chars": config.IntegerVariable(minUpperCaseChars),
-			"min_lower_case_chars": config.IntegerVariable(minLowerCaseChars),
-			"min_numeric_chars":    config.IntegerVariable(minNumericChars),
-			"min_special_chars":    config.IntegerVariable(minSpecialChars),
-			"min_age_days":         config.IntegerVariable(minAgeDays),
-			"max_age_days":         config.IntegerVariable(maxAgeDays),
-			"max_retries":          config.IntegerVariable(maxRetries),
-			"lockout_time_mins":    config.IntegerVariable(lockoutTimeMins),
-			"history":              config.IntegerVariable(history),
-			"comment":              config.StringVariable(comment),
-		}
-	}
-	variables1 := m(10, 30, 2, 3, 4, 5, 6, 7, 8, 9, 10, comment)
-	variables2 := m(20, 50, 1, 2, 3, 4, 5, 6, 7, 8, 9, comment)
-	variables3 := m(20, 50, 1, 2, 3, 4, 5, 6, 7, 8, 9, "")
+	newId := testClient().Ids.RandomSchemaObjectIdentifierInSchema(id.SchemaId())
+	comment, changedComment := random.Comment(), random.Comment()
+
+	modelBasic := model.PasswordPolicyWithId("test", id)
+
+	modelComplete := model.PasswordPolicyWithId("test", id).
+		WithMinLength(10).
+		WithMaxLength(30).
+		WithMinUpperCaseChars(2).
+		WithMinLowerCaseChars(3).
+		WithMinNumericChars(4).
+		WithMinSpecialChars(5).
+		WithMinAgeDays(6).
+		WithMaxAgeDays(7).
+		WithMaxRetries(8).
+		WithLockoutTimeMins(9).
+		WithHistory(10).
+		WithComment(comment)
+
+	modelCompleteWithDifferentValues := model.PasswordPolicyWithId("test", id).
+		WithMinLength(20).
+		WithMaxLength(50).
+		WithMinUpperCaseChars(1).
+		WithMinLowerCaseChars(2).
+		WithMinNumericChars(3).
+		WithMinSpecialChars(4).
+		WithMinAgeDays(5).
+		WithMaxAgeDays(0).
+		WithMaxRetries(7).
+		WithLockoutTimeMins(8).
+		WithHistory(9).
+		WithComment(changedComment)
+
+	modelRenamed := model.PasswordPolicyWithId("test", newId)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -49,78 +66,147 @@ func TestAcc_PasswordPolicy(t *testing.T) {
 		},
 		CheckDestroy: CheckDestroy(t, resources.PasswordPolicy),
 		Steps: []resource.TestStep{
+			// create with only required attributes - Snowflake defaults are used
 			{
-				ConfigDirectory: ConfigurationDirectory("TestAcc_PasswordPolicy/basic"),
-				ConfigVariables: variables1,
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "name", id.Name()),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "database", TestDatabaseName),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "schema", TestSchemaName),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_length", "10"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "max_length", "30"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_upper_case_chars", "2"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_lower_case_chars", "3"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_numeric_chars", "4"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_special_chars", "5"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_age_days", "6"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "max_age_days", "7"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "max_retries", "8"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "lockout_time_mins", "9"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "history", "10"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "comment", comment),
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.PasswordPolicyResource(t, modelBasic.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasMinLengthString("-1").
+						HasMaxLengthString("-1").
+						HasMinUpperCaseCharsString("-1").
+						HasMinLowerCaseCharsString("-1").
+						HasMinNumericCharsString("-1").
+						HasMinSpecialCharsString("-1").
+						HasMinAgeDaysString("-1").
+						HasMaxAgeDaysString("-1").
+						HasMaxRetriesString("-1").
+						HasLockoutTimeMinsString("-1").
+						HasHistoryString("-1").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					resourceshowoutputassert.PasswordPolicyShowOutput(t, modelBasic.ResourceReference()).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasKind(string(sdk.PolicyKindPasswordPolicy)).
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.password_min_length")),
+					assert.Check(resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.password_history")),
 				),
 			},
+			// set all optional attributes
 			{
-				ConfigDirectory: ConfigurationDirectory("TestAcc_PasswordPolicy/basic"),
-				ConfigVariables: variables2,
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_length", "20"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "max_length", "50"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_upper_case_chars", "1"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_lower_case_chars", "2"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_numeric_chars", "3"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_special_chars", "4"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_age_days", "5"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "max_age_days", "6"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "max_retries", "7"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "lockout_time_mins", "8"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "history", "9"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "comment", comment),
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PasswordPolicyResource(t, modelComplete.ResourceReference()).
+						HasMinLengthString("10").
+						HasMaxLengthString("30").
+						HasMinUpperCaseCharsString("2").
+						HasMinLowerCaseCharsString("3").
+						HasMinNumericCharsString("4").
+						HasMinSpecialCharsString("5").
+						HasMinAgeDaysString("6").
+						HasMaxAgeDaysString("7").
+						HasMaxRetriesString("8").
+						HasLockoutTimeMinsString("9").
+						HasHistoryString("10").
+						HasCommentString(comment),
+					resourceshowoutputassert.PasswordPolicyShowOutput(t, modelComplete.ResourceReference()).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.password_min_length", "10")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.password_max_length", "30")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.password_history", "10")),
 				),
 			},
+			// import complete state
 			{
-				ConfigDirectory: ConfigurationDirectory("TestAcc_PasswordPolicy/basic"),
-				ConfigVariables: variables3,
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "comment", ""),
+				Config:       accconfig.FromModels(t, modelComplete),
+				ResourceName: modelComplete.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedPasswordPolicyResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasMinLengthString("10").
+						HasMaxLengthString("30").
+						HasMinUpperCaseCharsString("2").
+						HasMinLowerCaseCharsString("3").
+						HasMinNumericCharsString("4").
+						HasMinSpecialCharsString("5").
+						HasMinAgeDaysString("6").
+						HasMaxAgeDaysString("7").
+						HasMaxRetriesString("8").
+						HasLockoutTimeMinsString("9").
+						HasHistoryString("10").
+						HasCommentString(comment).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			// change all optional attributes (including setting max_age_days to zero)
+			{
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PasswordPolicyResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasMinLengthString("20").
+						HasMaxLengthString("50").
+						HasMinUpperCaseCharsString("1").
+						HasMinLowerCaseCharsString("2").
+						HasMinNumericCharsString("3").
+						HasMinSpecialCharsString("4").
+						HasMinAgeDaysString("5").
+						HasMaxAgeDaysString("0").
+						HasMaxRetriesString("7").
+						HasLockoutTimeMinsString("8").
+						HasHistoryString("9").
+						HasCommentString(changedComment),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "describe_output.0.password_max_age_days", "0")),
 				),
 			},
+			// unset all optional attributes and rename
 			{
-				ConfigDirectory:   ConfigurationDirectory("TestAcc_PasswordPolicy/basic"),
-				ConfigVariables:   variables3,
-				ResourceName:      "snowflake_password_policy.pa",
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PasswordPolicyResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasMinLengthString("-1").
+						HasMaxAgeDaysString("-1").
+						HasHistoryString("-1").
+						HasCommentString("").
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+					resourceshowoutputassert.PasswordPolicyShowOutput(t, modelRenamed.ResourceReference()).
+						HasName(newId.Name()).
+						HasComment(""),
+				),
 			},
 		},
 	})
 }
 
-func TestAcc_PasswordPolicyMaxAgeDays(t *testing.T) {
-	oldId := testClient().Ids.RandomSchemaObjectIdentifier()
-	newId := testClient().Ids.RandomSchemaObjectIdentifier()
-
-	m := func(maxAgeDays int) map[string]config.Variable {
-		return map[string]config.Variable{
-			"name":         config.StringVariable(oldId.Name()),
-			"database":     config.StringVariable(oldId.DatabaseName()),
-			"schema":       config.StringVariable(oldId.SchemaName()),
-			"max_age_days": config.IntegerVariable(maxAgeDays),
-		}
-	}
+func TestAcc_PasswordPolicy_externalChanges(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
 
-	configValueWithNewName := m(10)
-	configValueWithNewName["name"] = config.StringVariable(newId.Name())
+	passwordPolicyModel := model.PasswordPolicyWithId("test", id).
+		WithMinLength(10)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -129,64 +215,118 @@ func TestAcc_PasswordPolicyMaxAgeDays(t *testing.T) {
 		},
 		CheckDestroy: CheckDestroy(t, resources.PasswordPolicy),
 		Steps: []resource.TestStep{
-			// Creation sets zero properly
 			{
-				ConfigDirectory: ConfigurationDirectory("TestAcc_PasswordPolicy/withMaxAgeDays"),
-				ConfigVariables: m(0),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "max_age_days", "0"),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "fully_qualified_name", oldId.FullyQualifiedName()),
+				Config: accconfig.FromModels(t, passwordPolicyModel),
+				Check: assertThat(t,
+					resourceassert.PasswordPolicyResource(t, passwordPolicyModel.ResourceReference()).
+						HasMinLengthString("10").
+						HasMaxRetriesString("-1"),
 				),
 			},
+			// change the set value and the value falling back to Snowflake default externally
 			{
-				ConfigDirectory: ConfigurationDirectory("TestAcc_PasswordPolicy/withMaxAgeDays"),
-				ConfigVariables: m(10),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "max_age_days", "10"),
+				PreConfig: func() {
+					testClient().PasswordPolicy.Alter(t, id, &sdk.AlterPasswordPolicyOptions{
+						Set: &sdk.PasswordPolicySet{
+							PasswordMinLength:  sdk.Int(12),
+							PasswordMaxRetries: sdk.Int(3),
+						},
+					})
+				},
+				Config: accconfig.FromModels(t, passwordPolicyModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(passwordPolicyModel.ResourceReference(), plancheck.ResourceActionUpdate),
+						planchecks.ExpectChange(passwordPolicyModel.ResourceReference(), "min_length", tfjson.ActionUpdate, sdk.String("12"), sdk.String("10")),
+						planchecks.ExpectChange(passwordPolicyModel.ResourceReference(), "max_retries", tfjson.ActionUpdate, sdk.String("3"), sdk.String("-1")),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PasswordPolicyResource(t, passwordPolicyModel.ResourceReference()).
+						HasMinLengthString("10").
+						HasMaxRetriesString("-1"),
+					assert.Check(resource.TestCheckResourceAttr(passwordPolicyModel.ResourceReference(), "describe_output.0.password_min_length", "10")),
 				),
 			},
-			// Update sets zero properly
+		},
+	})
+}
+
+func TestAcc_PasswordPolicy_migrateFromVersion_2_11_0(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	passwordPolicyModel := model.PasswordPolicyWithId("test", id).
+		WithMinLength(10).
+		WithMaxLength(30).
+		WithMinUpperCaseChars(2).
+		WithMinLowerCaseChars(3).
+		WithMinNumericChars(4).
+		WithMinSpecialChars(5).
+		WithMinAgeDays(6).
+		WithMaxAgeDays(7).
+		WithMaxRetries(8).
+		WithLockoutTimeMins(9).
+		WithHistory(10)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.PasswordPolicy),
+		Steps: []resource.TestStep{
 			{
-				ConfigDirectory: ConfigurationDirectory("TestAcc_PasswordPolicy/withMaxAgeDays"),
-				ConfigVariables: m(0),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "max_age_days", "0"),
+				ExternalProviders: ExternalProviderWithExactVersion("2.11.0"),
+				Config:            passwordPolicyConfigV2_11_0(id),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr("snowflake_password_policy.test", "id", helpers.EncodeSnowflakeID(id))),
 				),
 			},
-			// Rename + Unsets properly
 			{
-				ConfigDirectory: ConfigurationDirectory("TestAcc_PasswordPolicy/noOptionals"),
-				ConfigVariables: map[string]config.Variable{
-					"name":     config.StringVariable(newId.Name()),
-					"database": config.StringVariable(newId.DatabaseName()),
-					"schema":   config.StringVariable(newId.SchemaName()),
-				},
+				ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+				Config:                   accconfig.FromModels(t, passwordPolicyModel),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction("snowflake_password_policy.pa", plancheck.ResourceActionUpdate),
+						plancheck.ExpectEmptyPlan(),
 					},
 				},
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "name", newId.Name()),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "fully_qualified_name", newId.FullyQualifiedName()),
-					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "max_age_days", "90"),
+				Check: assertThat(t,
+					resourceassert.PasswordPolicyResource(t, passwordPolicyModel.ResourceReference()).
+						HasNameString(id.Name()).
+						HasMinLengthString("10").
+						HasHistoryString("10"),
+					assert.Check(resource.TestCheckResourceAttr(passwordPolicyModel.ResourceReference(), "id", id.FullyQualifiedName())),
+					assert.Check(resource.TestCheckNoResourceAttr(passwordPolicyModel.ResourceReference(), "or_replace")),
+					assert.Check(resource.TestCheckNoResourceAttr(passwordPolicyModel.ResourceReference(), "if_not_exists")),
+					resourceshowoutputassert.PasswordPolicyShowOutput(t, passwordPolicyModel.ResourceReference()).
+						HasName(id.Name()),
 				),
 			},
-			{
-				ConfigDirectory: ConfigurationDirectory("TestAcc_PasswordPolicy/noOptionals"),
-				ConfigVariables: map[string]config.Variable{
-					"name":     config.StringVariable(oldId.Name()),
-					"database": config.StringVariable(oldId.DatabaseName()),
-					"schema":   config.StringVariable(oldId.SchemaName()),
-				},
-				ResourceName:      "snowflake_password_policy.pa",
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
 		},
 	})
 }
 
+func passwordPolicyConfigV2_11_0(id sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_password_policy" "test" {
+  database             = "%[1]s"
+  schema               = "%[2]s"
+  name                 = "%[3]s"
+  or_replace           = true
+  min_length           = 10
+  max_length           = 30
+  min_upper_case_chars = 2
+  min_lower_case_chars = 3
+  min_numeric_chars    = 4
+  min_special_chars    = 5
+  min_age_days         = 6
+  max_age_days         = 7
+  max_retries          = 8
+  lockout_time_mins    = 9
+  history              = 10
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name())
+}
+
 func TestAcc_PasswordPolicy_migrateFromVersion_0_94_1(t *testing.T) {
 	id := testClient().Ids.RandomSchemaObjectIdentifier()
 
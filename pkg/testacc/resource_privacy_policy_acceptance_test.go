@@ -0,0 +1,228 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_PrivacyPolicy_basic(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+	budgetBody := "PRIVACY_BUDGET(BUDGET_NAME => 'analysts')"
+
+	basicModel := model.PrivacyPolicyWithId("test", id, "NO_PRIVACY_POLICY()")
+	completeModel := model.PrivacyPolicyWithId("test", id, budgetBody).
+		WithComment("some comment")
+	renamedModel := model.PrivacyPolicyWithId("test", newId, budgetBody)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.PrivacyPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, basicModel),
+				Check: assertThat(t,
+					resourceassert.PrivacyPolicyResource(t, basicModel.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasBodyString("NO_PRIVACY_POLICY()").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.kind", "PRIVACY_POLICY")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.0.return_type", "PRIVACY_BUDGET")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.0.body", "NO_PRIVACY_POLICY()")),
+				),
+			},
+			// import
+			{
+				ResourceName:      basicModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set the body and the comment
+			{
+				Config: accconfig.FromModels(t, completeModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(completeModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PrivacyPolicyResource(t, completeModel.ResourceReference()).
+						HasBodyString(budgetBody).
+						HasCommentString("some comment"),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "show_output.0.comment", "some comment")),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "describe_output.0.body", budgetBody)),
+				),
+			},
+			// external change
+			{
+				PreConfig: func() {
+					testClient().PrivacyPolicy.Alter(t, *sdk.NewAlterPrivacyPolicyRequest(id).WithSetComment("external comment"))
+				},
+				Config: accconfig.FromModels(t, completeModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(completeModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PrivacyPolicyResource(t, completeModel.ResourceReference()).
+						HasCommentString("some comment"),
+				),
+			},
+			// rename and unset the comment
+			{
+				Config: accconfig.FromModels(t, renamedModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(renamedModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PrivacyPolicyResource(t, renamedModel.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasCommentString("").
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_PrivacyPolicyAttachment_table(t *testing.T) {
+	privacyPolicy, privacyPolicyCleanup := testClient().PrivacyPolicy.Create(t)
+	t.Cleanup(privacyPolicyCleanup)
+	table, tableCleanup := testClient().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+		*sdk.NewTableColumnRequest("AGE", sdk.DataTypeNumber),
+	})
+	t.Cleanup(tableCleanup)
+
+	attachmentModel := model.PrivacyPolicyAttachmentToTable("test", privacyPolicy.ID(), table.ID())
+	attachmentWithEntityKeyModel := model.PrivacyPolicyAttachmentToTable("test", privacyPolicy.ID(), table.ID()).
+		WithEntityKey("ID")
+	privacyDomainModel := model.TableColumnPrivacyDomain("test", "AGE", table.ID().FullyQualifiedName()).
+		WithRange("0", "120").
+		WithDependsOn(attachmentWithEntityKeyModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, attachmentModel),
+				Check: assertThat(t,
+					resourceassert.PrivacyPolicyAttachmentResource(t, attachmentModel.ResourceReference()).
+						HasPrivacyPolicyString(privacyPolicy.ID().FullyQualifiedName()).
+						HasTableString(table.ID().FullyQualifiedName()).
+						HasNoView().
+						HasEntityKeyEmpty(),
+					assert.Check(resource.TestCheckResourceAttr(attachmentModel.ResourceReference(), "id", helpers.EncodeResourceIdentifier(string(sdk.PolicyEntityDomainTable), table.ID().FullyQualifiedName(), privacyPolicy.ID().FullyQualifiedName()))),
+				),
+			},
+			// import
+			{
+				ResourceName:      attachmentModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set the entity key and the privacy domain
+			{
+				Config: accconfig.FromModels(t, attachmentWithEntityKeyModel, privacyDomainModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(attachmentWithEntityKeyModel.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+						plancheck.ExpectResourceAction(privacyDomainModel.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.TableColumnPrivacyDomainResource(t, privacyDomainModel.ResourceReference()).
+						HasTableString(table.ID().FullyQualifiedName()).
+						HasColumnString("AGE").
+						HasValuesEmpty(),
+					assert.Check(resource.TestCheckResourceAttr(attachmentWithEntityKeyModel.ResourceReference(), "entity_key.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(attachmentWithEntityKeyModel.ResourceReference(), "entity_key.*", "ID")),
+					assert.Check(resource.TestCheckResourceAttr(privacyDomainModel.ResourceReference(), "range.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(privacyDomainModel.ResourceReference(), "range.0.lower", "0")),
+					assert.Check(resource.TestCheckResourceAttr(privacyDomainModel.ResourceReference(), "range.0.upper", "120")),
+				),
+			},
+			// external change: the privacy domain is unset and the policy is detached
+			{
+				PreConfig: func() {
+					testClient().PrivacyPolicy.UnsetPrivacyDomain(t, table.ID(), "AGE")
+					testClient().PrivacyPolicy.DropFromTable(t, table.ID(), privacyPolicy.ID())
+				},
+				Config: accconfig.FromModels(t, attachmentWithEntityKeyModel, privacyDomainModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(attachmentWithEntityKeyModel.ResourceReference(), plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction(privacyDomainModel.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PrivacyPolicyAttachmentResource(t, attachmentWithEntityKeyModel.ResourceReference()).
+						HasPrivacyPolicyString(privacyPolicy.ID().FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_PrivacyPolicyAttachment_view(t *testing.T) {
+	privacyPolicy, privacyPolicyCleanup := testClient().PrivacyPolicy.Create(t)
+	t.Cleanup(privacyPolicyCleanup)
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+	view, viewCleanup := testClient().View.CreateView(t, "SELECT id FROM "+table.ID().FullyQualifiedName())
+	t.Cleanup(viewCleanup)
+
+	attachmentModel := model.PrivacyPolicyAttachmentToView("test", privacyPolicy.ID(), view.ID())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, attachmentModel),
+				Check: assertThat(t,
+					resourceassert.PrivacyPolicyAttachmentResource(t, attachmentModel.ResourceReference()).
+						HasPrivacyPolicyString(privacyPolicy.ID().FullyQualifiedName()).
+						HasViewString(view.ID().FullyQualifiedName()).
+						HasNoTable().
+						HasEntityKeyEmpty(),
+				),
+			},
+			// import
+			{
+				ResourceName:      attachmentModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -1143,3 +1143,28 @@ func TestAcc_Saml2Integration_IdentifierQuotingDiffSuppression(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_Saml2Integration_Tags(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	issuer := testClient().Ids.Alpha()
+	cert := random.GenerateX509(t)
+	temporaryVariableName := "saml2_x509_cert"
+	temporaryVariableModel, configVariables := accconfig.SecretStringVariableModelWithConfigVariables(temporaryVariableName, cert)
+	integrationModel := model.Saml2SecurityIntegrationVar("test", id.Name(), issuer, string(sdk.Saml2SecurityIntegrationSaml2ProviderCustom), "https://example.com", temporaryVariableName)
+
+	steps := tagsTestSteps(t, integrationModel.ResourceReference(), id, sdk.ObjectTypeIntegration, func(tags ...sdk.TagAssociation) string {
+		return accconfig.FromModels(t, integrationModel.WithTags(tags...), temporaryVariableModel)
+	})
+	for i := range steps {
+		steps[i].ConfigVariables = configVariables
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Saml2SecurityIntegration),
+		Steps:        steps,
+	})
+}
@@ -1135,3 +1135,20 @@ func TestAcc_Schema_EmptyParameterAsDefaultValue(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_Schema_Tags(t *testing.T) {
+	id := testClient().Ids.RandomDatabaseObjectIdentifier()
+
+	schemaModel := model.Schema("test", id.DatabaseName(), id.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Schema),
+		Steps: tagsTestSteps(t, schemaModel.ResourceReference(), id, sdk.ObjectTypeSchema, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, schemaModel.WithTags(tags...))
+		}),
+	})
+}
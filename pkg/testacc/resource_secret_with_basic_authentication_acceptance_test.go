@@ -27,9 +27,9 @@ func TestAcc_SecretWithBasicAuthentication_BasicUseCase(t *testing.T) {
 	password := random.String()
 	currentRole := testClient().Context.CurrentRole(t).Name()
 
-	basic := model.SecretWithBasicAuthentication("test", id.DatabaseName(), id.SchemaName(), id.Name(), password, username)
+	basic := model.SecretWithBasicAuthentication("test", id.DatabaseName(), id.SchemaName(), id.Name(), username).WithPassword(password)
 
-	complete := model.SecretWithBasicAuthentication("test", id.DatabaseName(), id.SchemaName(), id.Name(), password+"_updated", username+"_updated").
+	complete := model.SecretWithBasicAuthentication("test", id.DatabaseName(), id.SchemaName(), id.Name(), username+"_updated").WithPassword(password + "_updated").
 		WithComment(comment)
 
 	assertBasic := []assert.TestCheckFuncProvider{
@@ -211,7 +211,7 @@ func TestAcc_SecretWithBasicAuthentication_BasicUseCase(t *testing.T) {
 func TestAcc_SecretWithBasicAuthentication_CreateWithEmptyCredentials(t *testing.T) {
 	id := testClient().Ids.RandomSchemaObjectIdentifier()
 	name := id.Name()
-	secretModelEmptyCredentials := model.SecretWithBasicAuthentication("s", id.DatabaseName(), id.SchemaName(), name, "", "")
+	secretModelEmptyCredentials := model.SecretWithBasicAuthentication("s", id.DatabaseName(), id.SchemaName(), name, "").WithPassword("")
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -241,7 +241,7 @@ func TestAcc_SecretWithBasicAuthentication_CreateWithEmptyCredentials(t *testing
 func TestAcc_SecretWithBasicAuthentication_ExternalSecretTypeChange(t *testing.T) {
 	id := testClient().Ids.RandomSchemaObjectIdentifier()
 	name := id.Name()
-	secretModel := model.SecretWithBasicAuthentication("s", id.DatabaseName(), id.SchemaName(), name, "test_pswd", "test_usr")
+	secretModel := model.SecretWithBasicAuthentication("s", id.DatabaseName(), id.SchemaName(), name, "test_usr").WithPassword("test_pswd")
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
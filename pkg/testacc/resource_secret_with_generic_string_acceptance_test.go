@@ -17,6 +17,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -26,9 +27,9 @@ func TestAcc_SecretWithGenericString_BasicUseCase(t *testing.T) {
 	secretString := random.String()
 	currentRole := testClient().Context.CurrentRole(t).Name()
 
-	basic := model.SecretWithGenericString("test", id.DatabaseName(), id.SchemaName(), id.Name(), secretString)
+	basic := model.SecretWithGenericString("test", id.DatabaseName(), id.SchemaName(), id.Name()).WithSecretString(secretString)
 
-	complete := model.SecretWithGenericString("test", id.DatabaseName(), id.SchemaName(), id.Name(), secretString+"_updated").
+	complete := model.SecretWithGenericString("test", id.DatabaseName(), id.SchemaName(), id.Name()).WithSecretString(secretString + "_updated").
 		WithComment(comment)
 
 	assertBasic := []assert.TestCheckFuncProvider{
@@ -201,7 +202,7 @@ func TestAcc_SecretWithGenericString_BasicUseCase(t *testing.T) {
 func TestAcc_SecretWithGenericString_EmptySecretString(t *testing.T) {
 	id := testClient().Ids.RandomSchemaObjectIdentifier()
 
-	emptySecretModel := model.SecretWithGenericString("test", id.DatabaseName(), id.SchemaName(), id.Name(), "")
+	emptySecretModel := model.SecretWithGenericString("test", id.DatabaseName(), id.SchemaName(), id.Name()).WithSecretString("")
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -270,7 +271,7 @@ func TestAcc_SecretWithGenericString_ExternalSecretTypeChange(t *testing.T) {
 	id := testClient().Ids.RandomSchemaObjectIdentifier()
 	name := id.Name()
 
-	secretModel := model.SecretWithGenericString("s", id.DatabaseName(), id.SchemaName(), name, "test_usr")
+	secretModel := model.SecretWithGenericString("s", id.DatabaseName(), id.SchemaName(), name).WithSecretString("test_usr")
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -316,3 +317,96 @@ func TestAcc_SecretWithGenericString_ExternalSecretTypeChange(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_SecretWithGenericString_WriteOnly(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	secretString := random.String()
+
+	plain := model.SecretWithGenericString("test", id.DatabaseName(), id.SchemaName(), id.Name()).WithSecretString(secretString)
+	writeOnly := model.SecretWithGenericString("test", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithSecretStringWo(secretString).
+		WithSecretStringWoVersion(1)
+	writeOnlyChangedValue := model.SecretWithGenericString("test", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithSecretStringWo(secretString + "_rotated").
+		WithSecretStringWoVersion(1)
+	writeOnlyRotated := model.SecretWithGenericString("test", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithSecretStringWo(secretString + "_rotated").
+		WithSecretStringWoVersion(2)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.SecretWithGenericString),
+		Steps: []resource.TestStep{
+			// Create with the write-only value
+			{
+				Config: config.FromModels(t, writeOnly),
+				Check: assertThat(t,
+					resourceassert.SecretWithGenericStringResource(t, writeOnly.ResourceReference()).
+						HasSecretStringEmpty().
+						HasNoSecretStringWo().
+						HasSecretStringWoHashNotEmpty().
+						HasSecretStringWoVersionString("1"),
+					objectassert.Secret(t, id).
+						HasSecretType(string(sdk.SecretTypeGenericString)),
+				),
+			},
+			// Change of the write-only value alone is detected with its hash
+			{
+				Config: config.FromModels(t, writeOnlyChangedValue),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(writeOnlyChangedValue.ResourceReference(), plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(writeOnlyChangedValue.ResourceReference(), tfjsonpath.New("secret_string_wo_hash")),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SecretWithGenericStringResource(t, writeOnlyChangedValue.ResourceReference()).
+						HasNoSecretStringWo().
+						HasSecretStringWoHashNotEmpty().
+						HasSecretStringWoVersionString("1"),
+				),
+			},
+			// No changes when the write-only value stays the same
+			{
+				Config: config.FromModels(t, writeOnlyChangedValue),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Send the same value again by changing the version
+			{
+				Config: config.FromModels(t, writeOnlyRotated),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(writeOnlyRotated.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SecretWithGenericStringResource(t, writeOnlyRotated.ResourceReference()).
+						HasNoSecretStringWo().
+						HasSecretStringWoVersionString("2"),
+				),
+			},
+			// Switch back to the value stored in the state
+			{
+				Config: config.FromModels(t, plain),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(plain.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SecretWithGenericStringResource(t, plain.ResourceReference()).
+						HasSecretStringString(secretString).
+						HasNoSecretStringWo().
+						HasSecretStringWoHashEmpty(),
+				),
+			},
+		},
+	})
+}
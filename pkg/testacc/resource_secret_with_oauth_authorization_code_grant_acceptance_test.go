@@ -32,8 +32,8 @@ func TestAcc_SecretWithOauthAuthorizationCodeGrant_BasicUseCase(t *testing.T) {
 	oauthRefreshTokenExpiryTime := time.Now().Add(24 * time.Hour).Format(time.DateTime)
 	currentRole := testClient().Context.CurrentRole(t).Name()
 
-	basic := model.SecretWithAuthorizationCodeGrant("test", id.DatabaseName(), id.SchemaName(), id.Name(), apiIntegration.Name, oauthRefreshToken, oauthRefreshTokenExpiryTime)
-	complete := model.SecretWithAuthorizationCodeGrant("test", id.DatabaseName(), id.SchemaName(), id.Name(), apiIntegration.Name, oauthRefreshToken+"_updated", oauthRefreshTokenExpiryTime).
+	basic := model.SecretWithAuthorizationCodeGrant("test", id.DatabaseName(), id.SchemaName(), id.Name(), apiIntegration.Name, oauthRefreshTokenExpiryTime).WithOauthRefreshToken(oauthRefreshToken)
+	complete := model.SecretWithAuthorizationCodeGrant("test", id.DatabaseName(), id.SchemaName(), id.Name(), apiIntegration.Name, oauthRefreshTokenExpiryTime).WithOauthRefreshToken(oauthRefreshToken + "_updated").
 		WithComment(comment)
 
 	assertBasic := []assert.TestCheckFuncProvider{
@@ -224,10 +224,10 @@ func TestAcc_SecretWithAuthorizationCodeGrant_CompleteUseCase_DifferentTimeForma
 	refreshTokenExpiryDateTime := time.Now().Add(4 * 24 * time.Hour).Format(time.DateTime)
 	refreshTokenExpiryWithPDT := fmt.Sprintf("%s %s", time.Now().Add(4*24*time.Hour).Format("2006-01-02 15:04"), "PDT")
 
-	secretModelDateOnly := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), "test_token", refreshTokenExpiryDateOnly)
-	secretModelWithoutSeconds := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), "test_token", refreshTokenExpiryWithoutSeconds)
-	secretModelDateTime := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), "test_token", refreshTokenExpiryDateTime)
-	secretModelWithPDT := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), "test_token", refreshTokenExpiryWithPDT)
+	secretModelDateOnly := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), refreshTokenExpiryDateOnly).WithOauthRefreshToken("test_token")
+	secretModelWithoutSeconds := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), refreshTokenExpiryWithoutSeconds).WithOauthRefreshToken("test_token")
+	secretModelDateTime := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), refreshTokenExpiryDateTime).WithOauthRefreshToken("test_token")
+	secretModelWithPDT := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), refreshTokenExpiryWithPDT).WithOauthRefreshToken("test_token")
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -295,7 +295,7 @@ func TestAcc_SecretWithAuthorizationCodeGrant_ExternalRefreshTokenExpiryTimeChan
 	externalRefreshTokenExpiryTime := time.Now().Add(10 * 24 * time.Hour)
 	refreshToken := "test_token"
 
-	secretModel := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), refreshToken, refreshTokenExpiryDateTime).WithComment(comment)
+	secretModel := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), refreshTokenExpiryDateTime).WithOauthRefreshToken(refreshToken).WithComment(comment)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -365,7 +365,7 @@ func TestAcc_SecretWithAuthorizationCodeGrant_ExternalSecretTypeChange(t *testin
 	id := testClient().Ids.RandomSchemaObjectIdentifier()
 	name := id.Name()
 
-	secretModel := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), "test_refresh_token", time.Now().Add(24*time.Hour).Format(time.DateOnly))
+	secretModel := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, apiIntegration.ID().Name(), time.Now().Add(24*time.Hour).Format(time.DateOnly)).WithOauthRefreshToken("test_refresh_token")
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -423,7 +423,7 @@ func TestAcc_SecretWithAuthorizationCodeGrant_ExternalSecretTypeChangeToOAuthCli
 	id := testClient().Ids.RandomSchemaObjectIdentifier()
 	name := id.Name()
 
-	secretModel := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, integrationId.Name(), "test_refresh_token", time.Now().Add(24*time.Hour).Format(time.DateOnly))
+	secretModel := model.SecretWithAuthorizationCodeGrant("s", id.DatabaseName(), id.SchemaName(), name, integrationId.Name(), time.Now().Add(24*time.Hour).Format(time.DateOnly)).WithOauthRefreshToken("test_refresh_token")
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
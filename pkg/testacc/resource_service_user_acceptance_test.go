@@ -10,6 +10,7 @@ import (
 
 	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectparametersassert"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
@@ -589,6 +590,121 @@ func TestAcc_ServiceUser_handleExternalTypeChange(t *testing.T) {
 	})
 }
 
+func TestAcc_ServiceUser_WorkloadIdentity(t *testing.T) {
+	userId := testClient().Ids.RandomAccountObjectIdentifier()
+
+	issuer := "https://token.actions.githubusercontent.com"
+	subject := "repo:org/repo:ref:refs/heads/main"
+	gcpSubject := "123456789012345678901"
+
+	userModelNoWorkloadIdentity := model.ServiceUserWithDefaultMeta(userId.Name())
+	userModelWithOidc := model.ServiceUserWithDefaultMeta(userId.Name()).
+		WithWorkloadIdentityOidc(issuer, subject, "snowflakecomputing.com")
+	userModelWithGcp := model.ServiceUserWithDefaultMeta(userId.Name()).
+		WithWorkloadIdentityGcp(gcpSubject)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ServiceUser),
+		Steps: []resource.TestStep{
+			// create with OIDC workload identity
+			{
+				Config: config.FromModels(t, userModelWithOidc),
+				Check: assertThat(t,
+					resourceassert.ServiceUserResource(t, userModelWithOidc.ResourceReference()).HasNameString(userId.Name()),
+					assert.Check(resource.TestCheckResourceAttr(userModelWithOidc.ResourceReference(), "workload_identity.0.oidc.0.issuer", issuer)),
+					assert.Check(resource.TestCheckResourceAttr(userModelWithOidc.ResourceReference(), "workload_identity.0.oidc.0.subject", subject)),
+					assert.Check(resource.TestCheckResourceAttr(userModelWithOidc.ResourceReference(), "workload_identity.0.oidc.0.oidc_audience_list.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(userModelWithOidc.ResourceReference(), "describe_output.0.workload_identity.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(userModelWithOidc.ResourceReference(), "describe_output.0.workload_identity.0.type", "OIDC")),
+				),
+			},
+			// change to GCP workload identity
+			{
+				Config: config.FromModels(t, userModelWithGcp),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(userModelWithGcp.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(userModelWithGcp.ResourceReference(), "workload_identity.0.gcp.0.subject", gcpSubject)),
+					assert.Check(resource.TestCheckResourceAttr(userModelWithGcp.ResourceReference(), "describe_output.0.workload_identity.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(userModelWithGcp.ResourceReference(), "describe_output.0.workload_identity.0.type", "GCP")),
+				),
+			},
+			// external change of the workload identity type is detected
+			{
+				PreConfig: func() {
+					testClient().User.SetWorkloadIdentity(t, userId, sdk.UserWorkloadIdentity{Oidc: &sdk.UserWorkloadIdentityOidc{Issuer: issuer, Subject: subject}})
+				},
+				Config: config.FromModels(t, userModelWithGcp),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(userModelWithGcp.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(userModelWithGcp.ResourceReference(), "workload_identity.0.gcp.0.subject", gcpSubject)),
+					assert.Check(resource.TestCheckResourceAttr(userModelWithGcp.ResourceReference(), "describe_output.0.workload_identity.0.type", "GCP")),
+				),
+			},
+			// external change of the workload identity details is detected
+			{
+				PreConfig: func() {
+					testClient().User.SetWorkloadIdentity(t, userId, sdk.UserWorkloadIdentity{Gcp: &sdk.UserWorkloadIdentityGcp{Subject: gcpSubject + "0"}})
+				},
+				Config: config.FromModels(t, userModelWithGcp),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(userModelWithGcp.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(userModelWithGcp.ResourceReference(), "workload_identity.0.gcp.0.subject", gcpSubject)),
+					assert.Check(resource.TestCheckResourceAttrWith(userModelWithGcp.ResourceReference(), "describe_output.0.workload_identity.0.additional_info", func(value string) error {
+						if strings.Contains(value, gcpSubject+"0") {
+							return fmt.Errorf("expected the subject to be changed back to %s, got %s", gcpSubject, value)
+						}
+						return nil
+					})),
+				),
+			},
+			// external removal of the workload identity is detected
+			{
+				PreConfig: func() {
+					testClient().User.UnsetWorkloadIdentity(t, userId)
+				},
+				Config: config.FromModels(t, userModelWithGcp),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(userModelWithGcp.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(userModelWithGcp.ResourceReference(), "describe_output.0.workload_identity.#", "1")),
+				),
+			},
+			// unset workload identity
+			{
+				Config: config.FromModels(t, userModelNoWorkloadIdentity),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(userModelNoWorkloadIdentity.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ServiceUserResource(t, userModelNoWorkloadIdentity.ResourceReference()).HasWorkloadIdentityEmpty(),
+					assert.Check(resource.TestCheckResourceAttr(userModelNoWorkloadIdentity.ResourceReference(), "describe_output.0.workload_identity.#", "0")),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_ServiceUser_setIncompatibleAttributes(t *testing.T) {
 	userId := testClient().Ids.RandomAccountObjectIdentifier()
 
@@ -237,6 +237,24 @@ func TestAcc_CreateSharedDatabase_InvalidValues(t *testing.T) {
 	})
 }
 
+func TestAcc_CreateSharedDatabase_Tags(t *testing.T) {
+	shareExternalId := createShareableDatabase(t)
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	sharedDatabaseModel := model.SharedDatabase("test", id.Name(), shareExternalId.FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.SharedDatabase),
+		Steps: tagsTestSteps(t, sharedDatabaseModel.ResourceReference(), id, sdk.ObjectTypeDatabase, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, sharedDatabaseModel.WithTags(tags...))
+		}),
+	})
+}
+
 // createShareableDatabase creates a database on the secondary account and enables database sharing on the primary account.
 // TODO(SNOW-1431726): Later on, this function should be moved to more sophisticated helpers.
 func createShareableDatabase(t *testing.T) sdk.ExternalObjectIdentifier {
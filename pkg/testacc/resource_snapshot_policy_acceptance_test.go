@@ -0,0 +1,155 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SnapshotPolicy_basic(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	basicModel := model.SnapshotPolicyWithId("test", id).
+		WithExpireAfterDays(1)
+	completeModel := model.SnapshotPolicyWithId("test", id).
+		WithSchedule("60 MINUTE").
+		WithExpireAfterDays(7).
+		WithComment("some comment")
+	renamedModel := model.SnapshotPolicyWithId("test", newId).
+		WithExpireAfterDays(1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.SnapshotPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, basicModel),
+				Check: assertThat(t,
+					resourceassert.SnapshotPolicyResource(t, basicModel.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasRetentionLockString("false").
+						HasScheduleString("").
+						HasExpireAfterDaysString("1").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.expire_after_days", "1")),
+				),
+			},
+			// import
+			{
+				ResourceName:      basicModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set the schedule, the expiration and the comment
+			{
+				Config: accconfig.FromModels(t, completeModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(completeModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SnapshotPolicyResource(t, completeModel.ResourceReference()).
+						HasScheduleString("60 MINUTE").
+						HasExpireAfterDaysString("7").
+						HasCommentString("some comment"),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "show_output.0.schedule", "60 MINUTE")),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "show_output.0.comment", "some comment")),
+				),
+			},
+			// external change
+			{
+				PreConfig: func() {
+					testClient().SnapshotPolicy.Alter(t, *sdk.NewAlterSnapshotPolicyRequest(id).WithSet(*sdk.NewSnapshotPolicySetRequest().WithExpireAfterDays(3)))
+				},
+				Config: accconfig.FromModels(t, completeModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(completeModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SnapshotPolicyResource(t, completeModel.ResourceReference()).
+						HasExpireAfterDaysString("7"),
+				),
+			},
+			// rename and unset the schedule and the comment
+			{
+				Config: accconfig.FromModels(t, renamedModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(renamedModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SnapshotPolicyResource(t, renamedModel.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasScheduleString("").
+						HasExpireAfterDaysString("1").
+						HasCommentString("").
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_SnapshotPolicy_retentionLock(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	withoutLockModel := model.SnapshotPolicyWithId("test", id).
+		WithExpireAfterDays(1)
+	withLockModel := model.SnapshotPolicyWithId("test", id).
+		WithExpireAfterDays(1).
+		WithRetentionLock(true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.SnapshotPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, withoutLockModel),
+				Check: assertThat(t,
+					resourceassert.SnapshotPolicyResource(t, withoutLockModel.ResourceReference()).
+						HasRetentionLockString("false"),
+				),
+			},
+			// the retention lock cannot be added in place
+			{
+				Config: accconfig.FromModels(t, withLockModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(withLockModel.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SnapshotPolicyResource(t, withLockModel.ResourceReference()).
+						HasRetentionLockString("true"),
+					assert.Check(resource.TestCheckResourceAttr(withLockModel.ResourceReference(), "show_output.0.has_retention_lock", "true")),
+				),
+			},
+		},
+	})
+}
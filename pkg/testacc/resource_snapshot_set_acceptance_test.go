@@ -0,0 +1,184 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SnapshotSet_basic(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+	snapshotPolicy, snapshotPolicyCleanup := testClient().SnapshotPolicy.Create(t)
+	t.Cleanup(snapshotPolicyCleanup)
+	otherSnapshotPolicy, otherSnapshotPolicyCleanup := testClient().SnapshotPolicy.Create(t)
+	t.Cleanup(otherSnapshotPolicyCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	basicModel := model.SnapshotSetForTable("test", id, table.ID())
+	withPolicyModel := model.SnapshotSetForTable("test", id, table.ID()).
+		WithSnapshotPolicy(snapshotPolicy.ID().FullyQualifiedName()).
+		WithComment("some comment")
+	withOtherPolicyModel := model.SnapshotSetForTable("test", id, table.ID()).
+		WithSnapshotPolicy(otherSnapshotPolicy.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.SnapshotSet),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, basicModel),
+				Check: assertThat(t,
+					resourceassert.SnapshotSetResource(t, basicModel.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasForTableString(table.ID().FullyQualifiedName()).
+						HasNoForDatabase().
+						HasNoForSchema().
+						HasSnapshotPolicyString("").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.object_kind", "TABLE")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.object_name", table.ID().Name())),
+				),
+			},
+			// import
+			{
+				ResourceName:      basicModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// apply the snapshot policy and set the comment
+			{
+				Config: accconfig.FromModels(t, withPolicyModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(withPolicyModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SnapshotSetResource(t, withPolicyModel.ResourceReference()).
+						HasSnapshotPolicyString(snapshotPolicy.ID().FullyQualifiedName()).
+						HasCommentString("some comment"),
+					assert.Check(resource.TestCheckResourceAttr(withPolicyModel.ResourceReference(), "show_output.0.snapshot_policy", snapshotPolicy.ID().FullyQualifiedName())),
+				),
+			},
+			// external change
+			{
+				PreConfig: func() {
+					testClient().SnapshotSet.Alter(t, *sdk.NewAlterSnapshotSetRequest(id).WithSetComment("external comment"))
+				},
+				Config: accconfig.FromModels(t, withPolicyModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(withPolicyModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SnapshotSetResource(t, withPolicyModel.ResourceReference()).
+						HasCommentString("some comment"),
+				),
+			},
+			// apply another snapshot policy and unset the comment
+			{
+				Config: accconfig.FromModels(t, withOtherPolicyModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(withOtherPolicyModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SnapshotSetResource(t, withOtherPolicyModel.ResourceReference()).
+						HasSnapshotPolicyString(otherSnapshotPolicy.ID().FullyQualifiedName()).
+						HasCommentString(""),
+				),
+			},
+			// removing the snapshot policy recreates the snapshot set
+			{
+				Config: accconfig.FromModels(t, basicModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(basicModel.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SnapshotSetResource(t, basicModel.ResourceReference()).
+						HasSnapshotPolicyString(""),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_SnapshotSet_targets(t *testing.T) {
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := testClient().Schema.CreateSchema(t)
+	t.Cleanup(schemaCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	forDatabaseModel := model.SnapshotSetForDatabase("test", id, database.ID())
+	forSchemaModel := model.SnapshotSetForSchema("test", id, schema.ID())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.SnapshotSet),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, forDatabaseModel),
+				Check: assertThat(t,
+					resourceassert.SnapshotSetResource(t, forDatabaseModel.ResourceReference()).
+						HasForDatabaseString(database.ID().FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(forDatabaseModel.ResourceReference(), "show_output.0.object_kind", "DATABASE")),
+				),
+			},
+			// import
+			{
+				ResourceName:      forDatabaseModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// changing the target recreates the snapshot set
+			{
+				Config: accconfig.FromModels(t, forSchemaModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(forSchemaModel.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SnapshotSetResource(t, forSchemaModel.ResourceReference()).
+						HasForSchemaString(schema.ID().FullyQualifiedName()).
+						HasNoForDatabase(),
+					assert.Check(resource.TestCheckResourceAttr(forSchemaModel.ResourceReference(), "show_output.0.object_kind", "SCHEMA")),
+				),
+			},
+			// import
+			{
+				ResourceName:      forSchemaModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
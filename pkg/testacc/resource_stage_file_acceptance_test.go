@@ -0,0 +1,185 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_StageFile_basic(t *testing.T) {
+	stage, stageCleanup := testClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	dir := t.TempDir()
+	handlerPath := filepath.Join(dir, "handler.py")
+	writeFile := func(name string, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	writeFile("handler.py", "def handler(): return 1")
+
+	modelFile := model.StageFile("test", handlerPath, stage.ID().FullyQualifiedName()).
+		WithPath("functions")
+	modelDirectory := model.StageFile("test", dir, stage.ID().FullyQualifiedName()).
+		WithPath("functions").
+		WithPattern("*.js")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckStageFileDestroy(t),
+		Steps: []resource.TestStep{
+			// upload a single file
+			{
+				Config: accconfig.FromModels(t, modelFile),
+				Check: assertThat(t,
+					resourceassert.StageFileResource(t, modelFile.ResourceReference()).
+						HasStageString(stage.ID().FullyQualifiedName()).
+						HasPathString("functions").
+						HasSourceString(handlerPath).
+						HasNoPattern().
+						HasSourceHashNotEmpty(),
+					assert.Check(resource.TestCheckResourceAttr(modelFile.ResourceReference(), "files.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelFile.ResourceReference(), "files.0.path", "functions/handler.py")),
+					assert.Check(resource.TestCheckResourceAttrSet(modelFile.ResourceReference(), "files.0.size")),
+					assert.Check(resource.TestCheckResourceAttrSet(modelFile.ResourceReference(), "files.0.md5")),
+					assert.Check(stageFilesOnStage(t, stage.ID(), "functions", "functions/handler.py")),
+				),
+			},
+			// no changes
+			{
+				Config: accconfig.FromModels(t, modelFile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// re-upload after a local change
+			{
+				PreConfig: func() {
+					writeFile("handler.py", "def handler(): return 42")
+				},
+				Config: accconfig.FromModels(t, modelFile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelFile.ResourceReference(), plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(modelFile.ResourceReference(), tfjsonpath.New("files")),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(modelFile.ResourceReference(), "files.#", "1")),
+					assert.Check(resource.TestCheckResourceAttrSet(modelFile.ResourceReference(), "files.0.size")),
+				),
+			},
+			// re-upload after an external removal
+			{
+				PreConfig: func() {
+					testClient().Stage.RemoveFromStage(t, stage.Location(), "functions/handler.py")
+				},
+				Config: accconfig.FromModels(t, modelFile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelFile.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StageFileResource(t, modelFile.ResourceReference()).
+						HasSourceHashNotEmpty(),
+					assert.Check(stageFilesOnStage(t, stage.ID(), "functions", "functions/handler.py")),
+				),
+			},
+			// switch to a directory with a pattern; the previous file is removed
+			{
+				PreConfig: func() {
+					writeFile("a.js", "a")
+					writeFile("b.js", "bb")
+				},
+				Config: accconfig.FromModels(t, modelDirectory),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelDirectory.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StageFileResource(t, modelDirectory.ResourceReference()).
+						HasSourceString(dir).
+						HasPatternString("*.js"),
+					assert.Check(resource.TestCheckResourceAttr(modelDirectory.ResourceReference(), "files.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelDirectory.ResourceReference(), "files.0.path", "functions/a.js")),
+					assert.Check(resource.TestCheckResourceAttr(modelDirectory.ResourceReference(), "files.1.path", "functions/b.js")),
+					assert.Check(stageFilesOnStage(t, stage.ID(), "functions", "functions/a.js", "functions/b.js")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_StageFile_validations(t *testing.T) {
+	stageId := testClient().Ids.RandomSchemaObjectIdentifier()
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "handler.py")
+	require.NoError(t, os.WriteFile(filePath, []byte("def handler(): return 1"), 0o600))
+
+	modelInvalidPath := model.StageFile("test", filePath, stageId.FullyQualifiedName()).
+		WithPath("/functions/")
+	modelPatternForFile := model.StageFile("test", filePath, stageId.FullyQualifiedName()).
+		WithPattern("*.py")
+	modelNothingMatched := model.StageFile("test", dir, stageId.FullyQualifiedName()).
+		WithPattern("*.java")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, modelInvalidPath),
+				ExpectError: regexp.MustCompile("cannot start or end with `/`"),
+			},
+			{
+				Config:      accconfig.FromModels(t, modelPatternForFile),
+				ExpectError: regexp.MustCompile("pattern can only be used when source is a directory"),
+			},
+			{
+				Config:      accconfig.FromModels(t, modelNothingMatched),
+				ExpectError: regexp.MustCompile("no files to upload found in directory"),
+			},
+		},
+	})
+}
+
+// stageFilesOnStage checks that exactly the given files are on the stage under the given path.
+func stageFilesOnStage(t *testing.T, stageId sdk.SchemaObjectIdentifier, path string, expectedPaths ...string) resource.TestCheckFunc {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		stageFiles := testClient().Stage.ListFiles(t, stageId, path)
+		paths := make([]string, len(stageFiles))
+		for i, stageFile := range stageFiles {
+			paths[i] = stageFile.PathOnStage()
+		}
+		if !slices.Equal(paths, expectedPaths) {
+			return fmt.Errorf("expected files %v on stage %s, got %v", expectedPaths, stageId.FullyQualifiedName(), paths)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,234 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StorageLifecyclePolicy_basic(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+	argument := []sdk.TableColumnSignature{{Name: "CREATED_ON", Type: testdatatypes.DataTypeTimestampNTZ}}
+	body := "CREATED_ON < DATEADD('day', -90, CURRENT_TIMESTAMP())"
+	newBody := "CREATED_ON < DATEADD('day', -30, CURRENT_TIMESTAMP())"
+
+	basicModel := model.StorageLifecyclePolicyFromId("test", id, argument, body)
+	completeModel := model.StorageLifecyclePolicyFromId("test", id, argument, newBody).
+		WithArchiveForDays(90).
+		WithComment("some comment")
+	renamedModel := model.StorageLifecyclePolicyFromId("test", newId, argument, newBody)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.StorageLifecyclePolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, basicModel),
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyResource(t, basicModel.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasBodyString(body).
+						HasArchiveTierString("").
+						HasArchiveForDaysString("0").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "argument.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "argument.0.name", "CREATED_ON")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "argument.0.type", testdatatypes.DataTypeTimestampNTZ.ToSql())),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "show_output.0.kind", "STORAGE_LIFECYCLE_POLICY")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.0.return_type", "BOOLEAN")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.0.body", body)),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "describe_output.0.archive_tier", "")),
+				),
+			},
+			// import
+			{
+				ResourceName:      basicModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set the body, the retention and the comment
+			{
+				Config: accconfig.FromModels(t, completeModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(completeModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyResource(t, completeModel.ResourceReference()).
+						HasBodyString(newBody).
+						HasArchiveForDaysString("90").
+						HasCommentString("some comment"),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "show_output.0.comment", "some comment")),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "describe_output.0.body", newBody)),
+					assert.Check(resource.TestCheckResourceAttr(completeModel.ResourceReference(), "describe_output.0.archive_for_days", "90")),
+				),
+			},
+			// external change
+			{
+				PreConfig: func() {
+					testClient().StorageLifecyclePolicy.Alter(t, *sdk.NewAlterStorageLifecyclePolicyRequest(id).WithSet(*sdk.NewStorageLifecyclePolicySetRequest().
+						WithArchiveForDays(30).
+						WithComment("external comment"),
+					))
+				},
+				Config: accconfig.FromModels(t, completeModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(completeModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyResource(t, completeModel.ResourceReference()).
+						HasArchiveForDaysString("90").
+						HasCommentString("some comment"),
+				),
+			},
+			// rename and unset the retention and the comment
+			{
+				Config: accconfig.FromModels(t, renamedModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(renamedModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyResource(t, renamedModel.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasArchiveForDaysString("0").
+						HasCommentString("").
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_StorageLifecyclePolicy_archiveTier(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	argument := []sdk.TableColumnSignature{{Name: "CREATED_ON", Type: testdatatypes.DataTypeTimestampNTZ}}
+	body := "CREATED_ON < DATEADD('day', -90, CURRENT_TIMESTAMP())"
+
+	coolModel := model.StorageLifecyclePolicyFromId("test", id, argument, body).
+		WithArchiveTier("cool").
+		WithArchiveForDays(365)
+	coldModel := model.StorageLifecyclePolicyFromId("test", id, argument, body).
+		WithArchiveTier(string(sdk.StorageLifecyclePolicyArchiveTierCold)).
+		WithArchiveForDays(365)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.StorageLifecyclePolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, coolModel),
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyResource(t, coolModel.ResourceReference()).
+						HasArchiveTierString("cool").
+						HasArchiveForDaysString("365"),
+					assert.Check(resource.TestCheckResourceAttr(coolModel.ResourceReference(), "describe_output.0.archive_tier", string(sdk.StorageLifecyclePolicyArchiveTierCool))),
+				),
+			},
+			// import
+			{
+				ResourceName:            coolModel.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"archive_tier"},
+			},
+			// changing the archive tier recreates the policy
+			{
+				Config: accconfig.FromModels(t, coldModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(coldModel.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyResource(t, coldModel.ResourceReference()).
+						HasArchiveTierString(string(sdk.StorageLifecyclePolicyArchiveTierCold)),
+					assert.Check(resource.TestCheckResourceAttr(coldModel.ResourceReference(), "describe_output.0.archive_tier", string(sdk.StorageLifecyclePolicyArchiveTierCold))),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_StorageLifecyclePolicyAttachment_basic(t *testing.T) {
+	storageLifecyclePolicy, storageLifecyclePolicyCleanup := testClient().StorageLifecyclePolicy.Create(t)
+	t.Cleanup(storageLifecyclePolicyCleanup)
+	table, tableCleanup := testClient().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+		*sdk.NewTableColumnRequest("LOADED_AT", sdk.DataTypeTimestampNTZ),
+	})
+	t.Cleanup(tableCleanup)
+
+	attachmentModel := model.StorageLifecyclePolicyAttachment("test", []string{"LOADED_AT"}, storageLifecyclePolicy.ID().FullyQualifiedName(), table.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, attachmentModel),
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyAttachmentResource(t, attachmentModel.ResourceReference()).
+						HasStorageLifecyclePolicyString(storageLifecyclePolicy.ID().FullyQualifiedName()).
+						HasTableString(table.ID().FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(attachmentModel.ResourceReference(), "on.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(attachmentModel.ResourceReference(), "on.0", "LOADED_AT")),
+					assert.Check(resource.TestCheckResourceAttr(attachmentModel.ResourceReference(), "id", helpers.EncodeResourceIdentifier(table.ID().FullyQualifiedName(), storageLifecyclePolicy.ID().FullyQualifiedName()))),
+				),
+			},
+			// import
+			{
+				ResourceName:      attachmentModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// external change: the policy is detached
+			{
+				PreConfig: func() {
+					testClient().StorageLifecyclePolicy.DropFromTable(t, table.ID())
+				},
+				Config: accconfig.FromModels(t, attachmentModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(attachmentModel.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyAttachmentResource(t, attachmentModel.ResourceReference()).
+						HasStorageLifecyclePolicyString(storageLifecyclePolicy.ID().FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
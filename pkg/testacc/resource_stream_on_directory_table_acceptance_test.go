@@ -448,3 +448,22 @@ func TestAcc_StreamOnDirectoryTable_ExternalStreamTypeChange(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_StreamOnDirectoryTable_Tags(t *testing.T) {
+	stage, cleanupStage := testClient().Stage.CreateStageWithDirectory(t)
+	t.Cleanup(cleanupStage)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	streamModel := model.StreamOnDirectoryTable("test", id.DatabaseName(), id.SchemaName(), id.Name(), stage.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.StreamOnDirectoryTable),
+		Steps: tagsTestSteps(t, streamModel.ResourceReference(), id, sdk.ObjectTypeStream, func(tags ...sdk.TagAssociation) string {
+			return config.FromModels(t, streamModel.WithTags(tags...))
+		}),
+	})
+}
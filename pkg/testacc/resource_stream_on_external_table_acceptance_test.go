@@ -787,3 +787,26 @@ func TestAcc_StreamOnExternalTable_ExternalStreamTypeChange(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_StreamOnExternalTable_Tags(t *testing.T) {
+	stage, cleanupStage := testClient().Stage.CreateStageWithURL(t)
+	t.Cleanup(cleanupStage)
+
+	externalTable, cleanupExternalTable := testClient().ExternalTable.CreateWithLocation(t, stage.Location())
+	t.Cleanup(cleanupExternalTable)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	streamModel := model.StreamOnExternalTable("test", id.DatabaseName(), id.SchemaName(), id.Name(), externalTable.ID().FullyQualifiedName()).
+		WithInsertOnly(r.BooleanTrue)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.StreamOnExternalTable),
+		Steps: tagsTestSteps(t, streamModel.ResourceReference(), id, sdk.ObjectTypeStream, func(tags ...sdk.TagAssociation) string {
+			return config.FromModels(t, streamModel.WithTags(tags...))
+		}),
+	})
+}
@@ -808,3 +808,22 @@ func TestAcc_StreamOnTable_ExternalStreamTypeChange(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_StreamOnTable_Tags(t *testing.T) {
+	table, cleanupTable := testClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(cleanupTable)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	streamModel := model.StreamOnTable("test", id.DatabaseName(), id.SchemaName(), id.Name(), table.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.StreamOnTable),
+		Steps: tagsTestSteps(t, streamModel.ResourceReference(), id, sdk.ObjectTypeStream, func(tags ...sdk.TagAssociation) string {
+			return config.FromModels(t, streamModel.WithTags(tags...))
+		}),
+	})
+}
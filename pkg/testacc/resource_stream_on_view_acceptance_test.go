@@ -842,3 +842,26 @@ func TestAcc_StreamOnView_ExternalStreamTypeChange(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_StreamOnView_Tags(t *testing.T) {
+	table, cleanupTable := testClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(cleanupTable)
+
+	statement := fmt.Sprintf("SELECT * FROM %s", table.ID().FullyQualifiedName())
+	view, cleanupView := testClient().View.CreateView(t, statement)
+	t.Cleanup(cleanupView)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	streamModel := model.StreamOnView("test", id.DatabaseName(), id.SchemaName(), id.Name(), view.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.StreamOnView),
+		Steps: tagsTestSteps(t, streamModel.ResourceReference(), id, sdk.ObjectTypeStream, func(tags ...sdk.TagAssociation) string {
+			return config.FromModels(t, streamModel.WithTags(tags...))
+		}),
+	})
+}
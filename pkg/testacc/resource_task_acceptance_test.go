@@ -2338,3 +2338,20 @@ resource "snowflake_task" "test" {
 		comment,
 	)
 }
+
+func TestAcc_Task_Tags(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	taskModel := model.TaskWithId("test", id, false, "SELECT 1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Task),
+		Steps: tagsTestSteps(t, taskModel.ResourceReference(), id, sdk.ObjectTypeTask, func(tags ...sdk.TagAssociation) string {
+			return config.FromModels(t, taskModel.WithTags(tags...))
+		}),
+	})
+}
@@ -0,0 +1,222 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_TaskGraph_basic(t *testing.T) {
+	schema, schemaCleanup := testClient().Schema.CreateSchema(t)
+	t.Cleanup(schemaCleanup)
+
+	rootId := testClient().Ids.RandomSchemaObjectIdentifierInSchema(schema.ID())
+	firstId := testClient().Ids.RandomSchemaObjectIdentifierInSchema(schema.ID())
+	secondId := testClient().Ids.RandomSchemaObjectIdentifierInSchema(schema.ID())
+	thirdId := testClient().Ids.RandomSchemaObjectIdentifierInSchema(schema.ID())
+	finalizerId := testClient().Ids.RandomSchemaObjectIdentifierInSchema(schema.ID())
+	taskConfig := `{"output_dir": "/temp/test_directory/", "learning_rate": 0.1}`
+
+	root := model.TaskGraphTask{Name: rootId.Name(), SqlStatement: "SELECT 1"}
+	first := model.TaskGraphTask{Name: firstId.Name(), SqlStatement: "SELECT 2", After: []string{rootId.Name()}}
+	second := model.TaskGraphTask{Name: secondId.Name(), SqlStatement: "SELECT 3", After: []string{firstId.Name()}}
+	finalizer := model.TaskGraphTask{Name: finalizerId.Name(), SqlStatement: "SELECT 4"}
+
+	basicModel := model.TaskGraphWithRoot("test", schema.ID(), root, true).
+		WithTask([]model.TaskGraphTask{second, first}).
+		WithFinalizer([]model.TaskGraphTask{finalizer}).
+		WithScheduleMinutes(5).
+		WithConfig(taskConfig)
+
+	// the second task is moved after the new third task, and the first task is removed
+	updatedRoot := root
+	updatedRoot.SqlStatement = "SELECT 10"
+	updatedRoot.Comment = "root comment"
+	third := model.TaskGraphTask{Name: thirdId.Name(), SqlStatement: "SELECT 5", After: []string{rootId.Name()}}
+	updatedSecond := second
+	updatedSecond.After = []string{thirdId.Name()}
+	updatedSecond.When = "1 = 1"
+
+	updatedModel := model.TaskGraphWithRoot("test", schema.ID(), updatedRoot, true).
+		WithTask([]model.TaskGraphTask{updatedSecond, third}).
+		WithFinalizer([]model.TaskGraphTask{finalizer}).
+		WithScheduleCron("10 * * * * UTC").
+		WithAllowOverlappingExecution(r.BooleanTrue)
+
+	suspendedModel := model.TaskGraphWithRoot("test", schema.ID(), updatedRoot, false).
+		WithTask([]model.TaskGraphTask{updatedSecond, third}).
+		WithScheduleCron("10 * * * * UTC").
+		WithAllowOverlappingExecution(r.BooleanTrue)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.TaskGraph),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, basicModel),
+				Check: assertThat(t,
+					resourceassert.TaskGraphResource(t, basicModel.ResourceReference()).
+						HasDatabaseString(schema.ID().DatabaseName()).
+						HasSchemaString(schema.ID().Name()).
+						HasStartedString(r.BooleanTrue).
+						HasConfigString(taskConfig).
+						HasAllowOverlappingExecutionString(r.BooleanDefault).
+						HasFullyQualifiedNameString(rootId.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "schedule.0.minutes", "5")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "root.0.name", rootId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "task.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "task.0.name", secondId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "task.1.name", firstId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(basicModel.ResourceReference(), "finalizer.0.name", finalizerId.Name())),
+					objectassert.Task(t, rootId).
+						HasState(sdk.TaskStateStarted).
+						HasSchedule("5 MINUTE").
+						HasTaskRelations(sdk.TaskRelations{FinalizerTask: &finalizerId}),
+					objectassert.Task(t, firstId).
+						HasState(sdk.TaskStateStarted).
+						HasPredecessorsInAnyOrder(rootId),
+					objectassert.Task(t, secondId).
+						HasState(sdk.TaskStateStarted).
+						HasPredecessorsInAnyOrder(firstId),
+					objectassert.Task(t, finalizerId).
+						HasState(sdk.TaskStateStarted),
+				),
+			},
+			// import
+			{
+				ResourceName:            basicModel.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"task"},
+			},
+			// change the whole graph in a single update
+			{
+				Config: accconfig.FromModels(t, updatedModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(updatedModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.TaskGraphResource(t, updatedModel.ResourceReference()).
+						HasStartedString(r.BooleanTrue).
+						HasConfigString("").
+						HasAllowOverlappingExecutionString(r.BooleanTrue),
+					assert.Check(resource.TestCheckResourceAttr(updatedModel.ResourceReference(), "schedule.0.using_cron", "10 * * * * UTC")),
+					assert.Check(resource.TestCheckResourceAttr(updatedModel.ResourceReference(), "root.0.sql_statement", "SELECT 10")),
+					assert.Check(resource.TestCheckResourceAttr(updatedModel.ResourceReference(), "root.0.comment", "root comment")),
+					assert.Check(resource.TestCheckResourceAttr(updatedModel.ResourceReference(), "task.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(updatedModel.ResourceReference(), "task.0.when", "1 = 1")),
+					objectassert.Task(t, rootId).
+						HasState(sdk.TaskStateStarted).
+						HasDefinition("SELECT 10"),
+					objectassert.Task(t, secondId).
+						HasState(sdk.TaskStateStarted).
+						HasPredecessorsInAnyOrder(thirdId),
+					objectassert.Task(t, thirdId).
+						HasState(sdk.TaskStateStarted).
+						HasPredecessorsInAnyOrder(rootId),
+					assert.Check(func(_ *terraform.State) error {
+						if _, err := testClient().Task.Show(t, firstId); err == nil {
+							return fmt.Errorf("task %s should be dropped", firstId.FullyQualifiedName())
+						}
+						return nil
+					}),
+				),
+			},
+			// no changes
+			{
+				Config: accconfig.FromModels(t, updatedModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// suspend the graph and remove the finalizer
+			{
+				Config: accconfig.FromModels(t, suspendedModel),
+				Check: assertThat(t,
+					resourceassert.TaskGraphResource(t, suspendedModel.ResourceReference()).
+						HasStartedString(r.BooleanFalse),
+					assert.Check(resource.TestCheckResourceAttr(suspendedModel.ResourceReference(), "finalizer.#", "0")),
+					objectassert.Task(t, rootId).
+						HasState(sdk.TaskStateSuspended),
+					assert.Check(func(_ *terraform.State) error {
+						if _, err := testClient().Task.Show(t, finalizerId); err == nil {
+							return fmt.Errorf("task %s should be dropped", finalizerId.FullyQualifiedName())
+						}
+						return nil
+					}),
+				),
+			},
+			// external change: a task added to the graph outside of Terraform is removed
+			{
+				PreConfig: func() {
+					_, taskCleanup := testClient().Task.CreateWithAfter(t, rootId)
+					t.Cleanup(taskCleanup)
+				},
+				Config: accconfig.FromModels(t, suspendedModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(suspendedModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(suspendedModel.ResourceReference(), "task.#", "2")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_TaskGraph_validations(t *testing.T) {
+	schemaId := testClient().Ids.SchemaId()
+	rootName := testClient().Ids.Alpha()
+
+	root := model.TaskGraphTask{Name: rootName, SqlStatement: "SELECT 1"}
+	cycleModel := model.TaskGraphWithRoot("test", schemaId, root, false).
+		WithTask([]model.TaskGraphTask{
+			{Name: "A", SqlStatement: "SELECT 1", After: []string{rootName, "B"}},
+			{Name: "B", SqlStatement: "SELECT 1", After: []string{"A"}},
+		})
+	undeclaredPredecessorModel := model.TaskGraphWithRoot("test", schemaId, root, false).
+		WithTask([]model.TaskGraphTask{
+			{Name: "A", SqlStatement: "SELECT 1", After: []string{"OTHER"}},
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, cycleModel),
+				ExpectError: regexp.MustCompile("task graph contains a cycle between tasks: A, B"),
+			},
+			{
+				Config:      accconfig.FromModels(t, undeclaredPredecessorModel),
+				ExpectError: regexp.MustCompile("predecessor OTHER of task A is not declared in the task graph"),
+			},
+		},
+	})
+}
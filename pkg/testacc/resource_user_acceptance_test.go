@@ -866,6 +866,98 @@ func TestAcc_User_issue1535_withRemovedPassword(t *testing.T) {
 	})
 }
 
+func TestAcc_User_WriteOnlyPassword(t *testing.T) {
+	userId := testClient().Ids.RandomAccountObjectIdentifier()
+	pass := random.Password()
+	newPass := random.Password()
+
+	userModelWriteOnlyPassword := model.UserWithDefaultMeta(userId.Name()).
+		WithPasswordWo(pass).
+		WithPasswordWoVersion(1)
+	userModelWriteOnlyPasswordChanged := model.UserWithDefaultMeta(userId.Name()).
+		WithPasswordWo(newPass).
+		WithPasswordWoVersion(1)
+	userModelWriteOnlyPasswordRotated := model.UserWithDefaultMeta(userId.Name()).
+		WithPasswordWo(newPass).
+		WithPasswordWoVersion(2)
+	userModelPassword := model.UserWithDefaultMeta(userId.Name()).
+		WithPassword(pass)
+	userModelWithoutPassword := model.UserWithDefaultMeta(userId.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.User),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, userModelPassword),
+				Check: assertThat(t,
+					resourceassert.UserResource(t, userModelPassword.ResourceReference()).
+						HasPasswordString(pass).
+						HasPasswordWoHashEmpty(),
+					objectassert.User(t, userId).
+						HasHasPassword(true),
+				),
+			},
+			// switching to the write-only attribute without the version does not unset the password
+			{
+				Config: config.FromModels(t, userModelWriteOnlyPassword),
+				Check: assertThat(t,
+					resourceassert.UserResource(t, userModelWriteOnlyPassword.ResourceReference()).
+						HasEmptyPassword().
+						HasNoPasswordWo().
+						HasPasswordWoHashNotEmpty().
+						HasPasswordWoVersionString("1"),
+					objectassert.User(t, userId).
+						HasHasPassword(true),
+				),
+			},
+			// change of the write-only value is detected without the version change
+			{
+				Config: config.FromModels(t, userModelWriteOnlyPasswordChanged),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(userModelWriteOnlyPasswordChanged.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.UserResource(t, userModelWriteOnlyPasswordChanged.ResourceReference()).
+						HasNoPasswordWo().
+						HasPasswordWoHashNotEmpty().
+						HasPasswordWoVersionString("1"),
+					objectassert.User(t, userId).
+						HasHasPassword(true),
+				),
+			},
+			// the same value is sent again after the version change
+			{
+				Config: config.FromModels(t, userModelWriteOnlyPasswordRotated),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						planchecks.ExpectChange(userModelWriteOnlyPasswordRotated.ResourceReference(), "password_wo_version", tfjson.ActionUpdate, sdk.String("1"), sdk.String("2")),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.UserResource(t, userModelWriteOnlyPasswordRotated.ResourceReference()).
+						HasNoPasswordWo().
+						HasPasswordWoVersionString("2"),
+					objectassert.User(t, userId).
+						HasHasPassword(true),
+				),
+			},
+			{
+				Config: config.FromModels(t, userModelWithoutPassword),
+				Check: assertThat(t,
+					objectassert.User(t, userId).
+						HasHasPassword(false),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_User_issue1155_handleChangesToDaysToExpiry(t *testing.T) {
 	userId := testClient().Ids.RandomAccountObjectIdentifier()
 
@@ -1671,3 +1763,20 @@ func TestAcc_User_gh3655(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_User_Tags(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+
+	userModel := model.User("test", id.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.User),
+		Steps: tagsTestSteps(t, userModel.ResourceReference(), id, sdk.ObjectTypeUser, func(tags ...sdk.TagAssociation) string {
+			return config.FromModels(t, userModel.WithTags(tags...))
+		}),
+	})
+}
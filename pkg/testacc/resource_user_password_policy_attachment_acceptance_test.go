@@ -4,10 +4,12 @@ package testacc
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAcc_UserPasswordPolicyAttachment(t *testing.T) {
@@ -68,3 +70,50 @@ resource "snowflake_user_password_policy_attachment" "ppa" {
 }
 `, userId.Name(), passwordPolicyId.DatabaseName(), passwordPolicyId.SchemaName(), passwordPolicyId.Name())
 }
+
+func TestAcc_UserPasswordPolicyAttachment_DetachedOutsideTerraform(t *testing.T) {
+	user, userCleanup := testClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+
+	passwordPolicy, passwordPolicyCleanup := testClient().PasswordPolicy.CreatePasswordPolicy(t)
+	t.Cleanup(passwordPolicyCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: userPasswordPoliciesProviderFactory,
+		CheckDestroy:             CheckUserPasswordPolicyAttachmentDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: userPasswordPolicyAttachmentExistingObjectsConfig(user.ID(), passwordPolicy.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user_password_policy_attachment.ppa", "user_name", user.ID().Name()),
+					resource.TestCheckResourceAttr("snowflake_user_password_policy_attachment.ppa", "password_policy_name", passwordPolicy.ID().FullyQualifiedName()),
+				),
+			},
+			// detach the policy outside of Terraform and expect the attachment to be recreated
+			{
+				PreConfig: func() {
+					testClient().User.Alter(t, user.ID(), &sdk.AlterUserOptions{Unset: &sdk.UserUnset{PasswordPolicy: sdk.Bool(true)}})
+				},
+				Config: userPasswordPolicyAttachmentExistingObjectsConfig(user.ID(), passwordPolicy.ID()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_user_password_policy_attachment.ppa", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user_password_policy_attachment.ppa", "user_name", user.ID().Name()),
+					resource.TestCheckResourceAttr("snowflake_user_password_policy_attachment.ppa", "password_policy_name", passwordPolicy.ID().FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
+func userPasswordPolicyAttachmentExistingObjectsConfig(userId sdk.AccountObjectIdentifier, passwordPolicyId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_user_password_policy_attachment" "ppa" {
+	user_name = "%[1]s"
+	password_policy_name = %[2]s
+}
+`, userId.Name(), strconv.Quote(passwordPolicyId.FullyQualifiedName()))
+}
@@ -645,6 +645,88 @@ func TestAcc_UserProgrammaticAccessToken_rotating(t *testing.T) {
 	})
 }
 
+func TestAcc_UserProgrammaticAccessToken_rotatingBeforeExpiry(t *testing.T) {
+	user, userCleanup := testClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+
+	modelOutsideRenewalWindow := model.UserProgrammaticAccessToken("test", id.Name(), user.ID().Name()).
+		WithDaysToExpiry(10).
+		WithRotateBeforeExpiryDays(2)
+	modelInsideRenewalWindow := model.UserProgrammaticAccessToken("test", id.Name(), user.ID().Name()).
+		WithDaysToExpiry(10).
+		WithRotateBeforeExpiryDays(10).
+		WithExpireRotatedTokenAfterHours(1)
+
+	var token string
+	assertTokenRotated := assert.Check(resource.TestCheckResourceAttrWith(modelOutsideRenewalWindow.ResourceReference(), "token", func(value string) error {
+		if value == "" || value == token {
+			return fmt.Errorf("token was not rotated, but should be")
+		}
+		token = value
+		return nil
+	}))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckUserProgrammaticAccessTokenDestroy(t),
+		Steps: []resource.TestStep{
+			// create the token outside of the renewal window
+			{
+				Config: accconfig.FromModels(t, modelOutsideRenewalWindow),
+				Check: assertThat(t,
+					resourceassert.UserProgrammaticAccessTokenResource(t, modelOutsideRenewalWindow.ResourceReference()).
+						HasRotateBeforeExpiryDaysString("2").
+						HasNoRotatedTokenName(),
+					assertTokenRotated,
+				),
+			},
+			// do not rotate the token outside of the renewal window
+			{
+				Config: accconfig.FromModels(t, modelOutsideRenewalWindow),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelOutsideRenewalWindow.ResourceReference(), plancheck.ResourceActionNoop),
+						planchecks.ExpectComputed(modelOutsideRenewalWindow.ResourceReference(), "token", false),
+						planchecks.ExpectComputed(modelOutsideRenewalWindow.ResourceReference(), "rotated_token_name", false),
+					},
+				},
+			},
+			// rotate the token inside the renewal window and keep the previous secret valid for the grace period
+			{
+				Config: accconfig.FromModels(t, modelInsideRenewalWindow),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelInsideRenewalWindow.ResourceReference(), plancheck.ResourceActionUpdate),
+						planchecks.ExpectComputed(modelInsideRenewalWindow.ResourceReference(), "token", true),
+						planchecks.ExpectComputed(modelInsideRenewalWindow.ResourceReference(), "rotated_token_name", true),
+						planchecks.ExpectComputed(modelInsideRenewalWindow.ResourceReference(), "show_output", true),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.UserProgrammaticAccessTokenResource(t, modelInsideRenewalWindow.ResourceReference()).
+						HasRotateBeforeExpiryDaysString("10").
+						HasRotatedTokenNameNotEmpty(),
+					assertTokenRotated,
+					assert.Check(resource.TestCheckResourceAttrWith(modelInsideRenewalWindow.ResourceReference(), "rotated_token_name", func(value string) error {
+						rotatedToken := testClient().User.ShowProgrammaticAccessToken(t, user.ID(), sdk.NewAccountObjectIdentifier(value))
+						if rotatedToken.Status != sdk.ProgrammaticAccessTokenStatusActive {
+							return fmt.Errorf("the rotated token should be active during the grace period, got status %s", rotatedToken.Status)
+						}
+						return nil
+					})),
+				),
+				// the rotated token has the same lifetime, so it is still inside the renewal window
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAcc_UserProgrammaticAccessToken_RotatingWithExternalProvider(t *testing.T) {
 	user, userCleanup := testClient().User.CreateUser(t)
 	t.Cleanup(userCleanup)
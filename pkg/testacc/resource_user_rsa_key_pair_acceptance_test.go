@@ -0,0 +1,312 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/youmark/pkcs8"
+)
+
+func TestAcc_UserRsaKeyPair_basic(t *testing.T) {
+	user, userCleanup := testClient().User.CreateServiceUser(t)
+	t.Cleanup(userCleanup)
+
+	privateKeyFile := filepath.Join(t.TempDir(), "rsa_key.p8")
+
+	modelBasic := model.UserRsaKeyPair("test", privateKeyFile, user.ID().Name()).
+		WithKeeper("1")
+	modelWithChangedKeeper := model.UserRsaKeyPair("test", privateKeyFile, user.ID().Name()).
+		WithKeeper("2")
+	modelWithoutPreviousKey := model.UserRsaKeyPair("test", privateKeyFile, user.ID().Name()).
+		WithKeeper("2").
+		WithRetainPreviousKey(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckUserRsaKeyPairDestroy(t),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.UserRsaKeyPairResource(t, modelBasic.ResourceReference()).
+						HasUserString(user.ID().Name()).
+						HasPrivateKeyFileString(privateKeyFile).
+						HasKeySizeString("2048").
+						HasKeeperString("1").
+						HasRetainPreviousKeyString("true").
+						HasNoRotationPeriodDays().
+						HasActiveSlotString("RSA_PUBLIC_KEY").
+						HasPublicKeyNotEmpty().
+						HasPublicKeyFingerprintNotEmpty().
+						HasPreviousPublicKeyFingerprintString("").
+						HasRotatedOnNotEmpty(),
+					assert.Check(assertUserRsaPublicKeyFingerprints(t, user.ID(), modelBasic.ResourceReference(), "public_key_fingerprint", "")),
+					assert.Check(assertUserRsaPrivateKeyFile(t, modelBasic.ResourceReference(), privateKeyFile, "")),
+				),
+			},
+			// changing the keeper rotates the key pair into the free slot and keeps the previous key
+			{
+				Config: accconfig.FromModels(t, modelWithChangedKeeper),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithChangedKeeper.ResourceReference(), plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(modelWithChangedKeeper.ResourceReference(), tfjsonpath.New("public_key")),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.UserRsaKeyPairResource(t, modelWithChangedKeeper.ResourceReference()).
+						HasActiveSlotString("RSA_PUBLIC_KEY_2").
+						HasPreviousPublicKeyFingerprintNotEmpty(),
+					assert.Check(assertUserRsaPublicKeyFingerprints(t, user.ID(), modelWithChangedKeeper.ResourceReference(), "previous_public_key_fingerprint", "public_key_fingerprint")),
+					assert.Check(assertUserRsaPrivateKeyFile(t, modelWithChangedKeeper.ResourceReference(), privateKeyFile, "")),
+				),
+			},
+			// disabling retain_previous_key unsets the previous key
+			{
+				Config: accconfig.FromModels(t, modelWithoutPreviousKey),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithoutPreviousKey.ResourceReference(), plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(modelWithoutPreviousKey.ResourceReference(), tfjsonpath.New("active_slot"), knownvalue.StringExact("RSA_PUBLIC_KEY_2")),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.UserRsaKeyPairResource(t, modelWithoutPreviousKey.ResourceReference()).
+						HasActiveSlotString("RSA_PUBLIC_KEY_2").
+						HasPreviousPublicKeyFingerprintString(""),
+					assert.Check(assertUserRsaPublicKeyFingerprints(t, user.ID(), modelWithoutPreviousKey.ResourceReference(), "", "public_key_fingerprint")),
+					assert.Check(assertUserRsaPrivateKeyFile(t, modelWithoutPreviousKey.ResourceReference(), privateKeyFile, "")),
+				),
+			},
+			// external unset of the public key rotates the key pair
+			{
+				PreConfig: func() {
+					testClient().User.Alter(t, user.ID(), &sdk.AlterUserOptions{Unset: &sdk.UserUnset{ObjectProperties: &sdk.UserObjectPropertiesUnset{RSAPublicKey2: sdk.Bool(true)}}})
+				},
+				Config: accconfig.FromModels(t, modelWithoutPreviousKey),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithoutPreviousKey.ResourceReference(), plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(modelWithoutPreviousKey.ResourceReference(), tfjsonpath.New("public_key")),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.UserRsaKeyPairResource(t, modelWithoutPreviousKey.ResourceReference()).
+						HasActiveSlotString("RSA_PUBLIC_KEY").
+						HasPreviousPublicKeyFingerprintString(""),
+					assert.Check(assertUserRsaPublicKeyFingerprints(t, user.ID(), modelWithoutPreviousKey.ResourceReference(), "public_key_fingerprint", "")),
+					assert.Check(assertUserRsaPrivateKeyFile(t, modelWithoutPreviousKey.ResourceReference(), privateKeyFile, "")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_UserRsaKeyPair_encryptedPrivateKey(t *testing.T) {
+	user, userCleanup := testClient().User.CreateServiceUser(t)
+	t.Cleanup(userCleanup)
+
+	privateKeyFile := filepath.Join(t.TempDir(), "rsa_key.p8")
+	passphrase := random.Password()
+	passphrase2 := random.Password()
+
+	modelEncrypted := model.UserRsaKeyPair("test", privateKeyFile, user.ID().Name()).
+		WithKeySize(4096).
+		WithPrivateKeyPassphraseWo(passphrase).
+		WithPrivateKeyPassphraseWoVersion(1)
+	modelWithChangedPassphrase := model.UserRsaKeyPair("test", privateKeyFile, user.ID().Name()).
+		WithKeySize(4096).
+		WithPrivateKeyPassphraseWo(passphrase2).
+		WithPrivateKeyPassphraseWoVersion(1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: CheckUserRsaKeyPairDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelEncrypted),
+				Check: assertThat(t,
+					resourceassert.UserRsaKeyPairResource(t, modelEncrypted.ResourceReference()).
+						HasKeySizeString("4096").
+						HasNoPrivateKeyPassphraseWo().
+						HasPrivateKeyPassphraseWoVersionString("1").
+						HasPrivateKeyPassphraseWoHashNotEmpty().
+						HasActiveSlotString("RSA_PUBLIC_KEY"),
+					assert.Check(assertUserRsaPublicKeyFingerprints(t, user.ID(), modelEncrypted.ResourceReference(), "public_key_fingerprint", "")),
+					assert.Check(assertUserRsaPrivateKeyFile(t, modelEncrypted.ResourceReference(), privateKeyFile, passphrase)),
+				),
+			},
+			// changing the passphrase rotates the key pair
+			{
+				Config: accconfig.FromModels(t, modelWithChangedPassphrase),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithChangedPassphrase.ResourceReference(), plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(modelWithChangedPassphrase.ResourceReference(), tfjsonpath.New("public_key")),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.UserRsaKeyPairResource(t, modelWithChangedPassphrase.ResourceReference()).
+						HasActiveSlotString("RSA_PUBLIC_KEY_2").
+						HasPreviousPublicKeyFingerprintNotEmpty(),
+					assert.Check(assertUserRsaPublicKeyFingerprints(t, user.ID(), modelWithChangedPassphrase.ResourceReference(), "previous_public_key_fingerprint", "public_key_fingerprint")),
+					assert.Check(assertUserRsaPrivateKeyFile(t, modelWithChangedPassphrase.ResourceReference(), privateKeyFile, passphrase2)),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_UserRsaKeyPair_privateKeyFileInMissingDirectory(t *testing.T) {
+	user, userCleanup := testClient().User.CreateServiceUser(t)
+	t.Cleanup(userCleanup)
+
+	modelBasic := model.UserRsaKeyPair("test", filepath.Join(t.TempDir(), "missing", "rsa_key.p8"), user.ID().Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckUserRsaKeyPairDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, modelBasic),
+				ExpectError: regexp.MustCompile("creating a temporary file for the private key"),
+			},
+		},
+	})
+}
+
+func TestAcc_UserRsaKeyPair_bothSlotsTaken(t *testing.T) {
+	user, userCleanup := testClient().User.CreateServiceUser(t)
+	t.Cleanup(userCleanup)
+
+	publicKey, _ := random.GenerateRSAPublicKey(t)
+	publicKey2, _ := random.GenerateRSAPublicKey(t)
+	testClient().User.Alter(t, user.ID(), &sdk.AlterUserOptions{Set: &sdk.UserSet{ObjectProperties: &sdk.UserAlterObjectProperties{
+		UserObjectProperties: sdk.UserObjectProperties{
+			RSAPublicKey:  sdk.String(publicKey),
+			RSAPublicKey2: sdk.String(publicKey2),
+		},
+	}}})
+
+	privateKeyFile := filepath.Join(t.TempDir(), "rsa_key.p8")
+	modelBasic := model.UserRsaKeyPair("test", privateKeyFile, user.ID().Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckUserRsaKeyPairDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, modelBasic),
+				ExpectError: regexp.MustCompile("both RSA_PUBLIC_KEY and RSA_PUBLIC_KEY_2 are already set"),
+			},
+		},
+	})
+	if _, err := os.Stat(privateKeyFile); !os.IsNotExist(err) {
+		t.Errorf("expected no private key file at %s, got err = %v", privateKeyFile, err)
+	}
+}
+
+// assertUserRsaPrivateKeyFile checks that the private key file holds the private key (decrypted with the passphrase, when it is not empty) of the public key from the state.
+func assertUserRsaPrivateKeyFile(t *testing.T, resourceReference string, privateKeyFile string, passphrase string) resource.TestCheckFunc {
+	t.Helper()
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceReference]
+		if !ok {
+			return fmt.Errorf("resource %s not found in the state", resourceReference)
+		}
+		content, err := os.ReadFile(privateKeyFile)
+		if err != nil {
+			return err
+		}
+		block, _ := pem.Decode(content)
+		if block == nil {
+			return fmt.Errorf("no PEM block found in %s", privateKeyFile)
+		}
+		var privateKey *rsa.PrivateKey
+		if passphrase == "" {
+			privateKey, err = pkcs8.ParsePKCS8PrivateKeyRSA(block.Bytes)
+		} else {
+			privateKey, err = pkcs8.ParsePKCS8PrivateKeyRSA(block.Bytes, []byte(passphrase))
+		}
+		if err != nil {
+			return err
+		}
+		publicKeyDer, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+		if err != nil {
+			return err
+		}
+		if expected, actual := rs.Primary.Attributes["public_key"], base64.StdEncoding.EncodeToString(publicKeyDer); expected != actual {
+			return fmt.Errorf("expected the private key of public key %q, got the private key of %q", expected, actual)
+		}
+		return nil
+	}
+}
+
+// assertUserRsaPublicKeyFingerprints checks that the user properties RSA_PUBLIC_KEY_FP and RSA_PUBLIC_KEY_2_FP match the given resource attributes (empty attribute name means the property is unset).
+func assertUserRsaPublicKeyFingerprints(t *testing.T, userId sdk.AccountObjectIdentifier, resourceReference string, firstSlotAttribute string, secondSlotAttribute string) resource.TestCheckFunc {
+	t.Helper()
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceReference]
+		if !ok {
+			return fmt.Errorf("resource %s not found in the state", resourceReference)
+		}
+		userDetails, err := testClient().User.Describe(t, userId)
+		if err != nil {
+			return err
+		}
+		for _, slot := range []struct {
+			attribute string
+			actual    *sdk.StringProperty
+		}{
+			{attribute: firstSlotAttribute, actual: userDetails.RsaPublicKeyFp},
+			{attribute: secondSlotAttribute, actual: userDetails.RsaPublicKey2Fp},
+		} {
+			expected := ""
+			if slot.attribute != "" {
+				expected = rs.Primary.Attributes[slot.attribute]
+			}
+			actual := ""
+			if slot.actual != nil {
+				actual = slot.actual.Value
+			}
+			if expected != actual {
+				return fmt.Errorf("expected user fingerprint %q (attribute %q), got %q", expected, slot.attribute, actual)
+			}
+		}
+		return nil
+	}
+}
@@ -1481,3 +1481,21 @@ func TestAcc_View_Issue3676_fix(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_View_Tags(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	statement := "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES"
+
+	viewModel := model.View("test", id.DatabaseName(), id.SchemaName(), id.Name(), statement)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.View),
+		Steps: tagsTestSteps(t, viewModel.ResourceReference(), id, sdk.ObjectTypeView, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, viewModel.WithTags(tags...))
+		}),
+	})
+}
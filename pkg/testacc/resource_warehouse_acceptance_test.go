@@ -3210,3 +3210,20 @@ func funcTestAcc_Warehouse_Generation_MigrateStandardWithoutGeneration_UpdatedEx
 		},
 	})
 }
+
+func TestAcc_Warehouse_Tags(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+
+	warehouseModel := model.Warehouse("test", id.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Warehouse),
+		Steps: tagsTestSteps(t, warehouseModel.ResourceReference(), id, sdk.ObjectTypeWarehouse, func(tags ...sdk.TagAssociation) string {
+			return accconfig.FromModels(t, warehouseModel.WithTags(tags...))
+		}),
+	})
+}
@@ -1,5 +0,0 @@
-resource "snowflake_password_policy" "pa" {
-  name     = var.name
-  database = var.database
-  schema   = var.schema
-}
\ No newline at end of file
@@ -1,11 +0,0 @@
-variable "name" {
-  type = string
-}
-
-variable "database" {
-  type = string
-}
-
-variable "schema" {
-  type = string
-}
@@ -1,15 +0,0 @@
-variable "name" {
-  type = string
-}
-
-variable "database" {
-  type = string
-}
-
-variable "schema" {
-  type = string
-}
-
-variable "max_age_days" {
-  type = number
-}